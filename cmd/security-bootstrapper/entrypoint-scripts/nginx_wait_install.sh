@@ -90,6 +90,15 @@ server {
       proxy_pass_request_body off;
     }
 
+    # Client credentials token exchange, which is public since its clients don't have a token yet
+
+    location = /security-proxy-auth/token {
+      resolver                127.0.0.11 valid=30s;
+      proxy_pass              http://$upstream_proxyauth:59842/token;
+      proxy_redirect          off;
+      proxy_set_header        Host $host;
+    }
+
     # Rewriting rules (variable usage required to avoid nginx crash if host not resolveable at time of boot)
     # resolver required to enable name resolution at runtime, points at docker DNS resolver
 
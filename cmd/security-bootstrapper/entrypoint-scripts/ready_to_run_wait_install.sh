@@ -37,5 +37,12 @@ echo "$(date) Executing waitFor with $@ waiting on tcp://${STAGEGATE_BOOTSTRAPPE
   -uri tcp://"${STAGEGATE_BOOTSTRAPPER_HOST}":"${STAGEGATE_READY_TORUNPORT}" \
   -timeout "${STAGEGATE_WAITFOR_TIMEOUT}"
 
+# optionally gating on the readiness conditions of the service configured in StageGate.Readiness
+if [ -n "${EDGEX_READINESS_SERVICE}" ]; then
+  echo "$(date) Executing waitForReady for service ${EDGEX_READINESS_SERVICE}"
+  /edgex-init/security-bootstrapper --configDir=/edgex-init/res waitForReady \
+    -service "${EDGEX_READINESS_SERVICE}"
+fi
+
 echo "$(date) Starting $@ ..."
 exec "$@"
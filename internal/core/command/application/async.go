@@ -0,0 +1,181 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/google/uuid"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const defaultAsyncResultTTL = 10 * time.Minute
+
+// AsyncCommandManager issues commands in the background and keeps their results in memory so they can be polled
+// via the command result API. The result of each job is also published to the MessageBus when it completes.
+type AsyncCommandManager struct {
+	dic     *di.Container
+	mutex   sync.RWMutex
+	jobs    map[string]*commandDTOs.CommandJob
+	pending int
+}
+
+// NewAsyncCommandManager creates a new initialized AsyncCommandManager
+func NewAsyncCommandManager(dic *di.Container) *AsyncCommandManager {
+	return &AsyncCommandManager{
+		dic:  dic,
+		jobs: make(map[string]*commandDTOs.CommandJob),
+	}
+}
+
+// AsyncCommandManagerName contains the name of command's application.AsyncCommandManager instance in the DIC.
+var AsyncCommandManagerName = di.TypeInstanceToName(AsyncCommandManager{})
+
+// AsyncCommandManagerFrom helper function queries the DIC and returns the application.AsyncCommandManager instance.
+func AsyncCommandManagerFrom(get di.Get) *AsyncCommandManager {
+	return get(AsyncCommandManagerName).(*AsyncCommandManager)
+}
+
+// Issue starts the specified command in the background and returns the id of the job used to poll its result.
+func (m *AsyncCommandManager) Issue(req commandDTOs.IssueCommandRequest, origin CommandOrigin) (string, errors.EdgeX) {
+	maxPending := commandContainer.ConfigurationFrom(m.dic.Get).AsyncCommand.MaxPendingJobs
+
+	m.mutex.Lock()
+	if maxPending > 0 && m.pending >= maxPending {
+		m.mutex.Unlock()
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable,
+			fmt.Sprintf("number of pending asynchronous commands reached the maximum of %d", maxPending), nil)
+	}
+	job := &commandDTOs.CommandJob{
+		Id:          uuid.NewString(),
+		DeviceName:  req.DeviceName,
+		CommandName: req.CommandName,
+		Method:      req.Method,
+		Status:      commandDTOs.JobStatusPending,
+		Created:     time.Now().UnixMilli(),
+	}
+	m.jobs[job.Id] = job
+	m.pending++
+	m.mutex.Unlock()
+
+	go m.run(job.Id, req, origin)
+
+	return job.Id, nil
+}
+
+// Job returns a copy of the job with the specified id.
+func (m *AsyncCommandManager) Job(id string) (commandDTOs.CommandJob, errors.EdgeX) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return commandDTOs.CommandJob{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("command job %s does not exist or has expired", id), nil)
+	}
+	return *job, nil
+}
+
+// StartPurging removes completed jobs whose result is older than AsyncCommand.ResultTTL until the context is done.
+func (m *AsyncCommandManager) StartPurging(ctx context.Context, wg *sync.WaitGroup) {
+	lc := bootstrapContainer.LoggingClientFrom(m.dic.Get)
+	ttl := defaultAsyncResultTTL
+	if configured := commandContainer.ConfigurationFrom(m.dic.Get).AsyncCommand.ResultTTL; configured != "" {
+		parsed, err := time.ParseDuration(configured)
+		if err != nil || parsed <= 0 {
+			lc.Errorf("invalid AsyncCommand.ResultTTL '%s', using default %s", configured, ttl)
+		} else {
+			ttl = parsed
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(ttl / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting asynchronous command result purging")
+				return
+			case <-ticker.C:
+				m.purge(time.Now().Add(-ttl).UnixMilli())
+			}
+		}
+	}()
+}
+
+func (m *AsyncCommandManager) purge(before int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for id, job := range m.jobs {
+		if job.Status != commandDTOs.JobStatusPending && job.Completed < before {
+			delete(m.jobs, id)
+		}
+	}
+}
+
+func (m *AsyncCommandManager) run(id string, req commandDTOs.IssueCommandRequest, origin CommandOrigin) {
+	response := issueCommand(req, origin, m.dic)
+
+	m.mutex.Lock()
+	job := m.jobs[id]
+	job.Completed = time.Now().UnixMilli()
+	job.StatusCode = response.StatusCode
+	job.Message = response.Message
+	job.Event = response.Event
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		job.Status = commandDTOs.JobStatusCompleted
+	} else {
+		job.Status = commandDTOs.JobStatusFailed
+	}
+	m.pending--
+	result := *job
+	m.mutex.Unlock()
+
+	m.publish(result)
+}
+
+func (m *AsyncCommandManager) publish(job commandDTOs.CommandJob) {
+	lc := bootstrapContainer.LoggingClientFrom(m.dic.Get)
+	messagingClient := bootstrapContainer.MessagingClientFrom(m.dic.Get)
+	if messagingClient == nil {
+		lc.Debugf("MessageBus client not available, result of command job %s is only available via polling", job.Id)
+		return
+	}
+
+	publishTopic := common.BuildTopic(
+		commandContainer.ConfigurationFrom(m.dic.Get).MessageBus.GetBaseTopicPrefix(),
+		pkgCommon.CoreCommandResultPublishTopic,
+		job.Id,
+	)
+
+	payload, _ := json.Marshal(commandDTOs.NewCommandJobResponse("", "", http.StatusOK, job))
+	envelope := types.NewMessageEnvelope(payload, context.Background())
+	envelope.ContentType = common.ContentTypeJSON
+
+	if err := messagingClient.Publish(envelope, publishTopic); err != nil {
+		lc.Errorf("unable to publish result of command job %s to topic '%s': %v", job.Id, publishTopic, err)
+		return
+	}
+
+	lc.Debugf("Published result of command job %s to topic '%s'", job.Id, publishTopic)
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+func newAsyncTestManager(maxPendingJobs int) *AsyncCommandManager {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				AsyncCommand: config.AsyncCommandInfo{
+					ResultTTL:      "1m",
+					MaxPendingJobs: maxPendingJobs,
+				},
+			}
+		},
+	})
+	return NewAsyncCommandManager(dic)
+}
+
+func TestAsyncCommandManagerIssueLimit(t *testing.T) {
+	manager := newAsyncTestManager(1)
+	manager.pending = 1
+
+	_, err := manager.Issue(commandDTOs.IssueCommandRequest{DeviceName: testDeviceName, CommandName: command1, Method: "get"}, CommandOrigin{})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Empty(t, manager.jobs)
+}
+
+func TestAsyncCommandManagerJob(t *testing.T) {
+	manager := newAsyncTestManager(0)
+	manager.jobs["job1"] = &commandDTOs.CommandJob{Id: "job1", Status: commandDTOs.JobStatusCompleted, StatusCode: http.StatusOK}
+
+	tests := []struct {
+		name          string
+		jobId         string
+		errorExpected bool
+		expectedKind  errors.ErrKind
+	}{
+		{"valid - job exists", "job1", false, ""},
+		{"invalid - job not found", "unknown", true, errors.KindEntityDoesNotExist},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := manager.Job(tt.jobId)
+			if tt.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.jobId, job.Id)
+		})
+	}
+}
+
+func TestAsyncCommandManagerPurge(t *testing.T) {
+	manager := newAsyncTestManager(0)
+	manager.jobs["expired"] = &commandDTOs.CommandJob{Id: "expired", Status: commandDTOs.JobStatusCompleted, Completed: 100}
+	manager.jobs["failed"] = &commandDTOs.CommandJob{Id: "failed", Status: commandDTOs.JobStatusFailed, Completed: 100}
+	manager.jobs["recent"] = &commandDTOs.CommandJob{Id: "recent", Status: commandDTOs.JobStatusCompleted, Completed: 300}
+	manager.jobs["pending"] = &commandDTOs.CommandJob{Id: "pending", Status: commandDTOs.JobStatusPending}
+
+	manager.purge(200)
+
+	assert.NotContains(t, manager.jobs, "expired")
+	assert.NotContains(t, manager.jobs, "failed")
+	assert.Contains(t, manager.jobs, "recent")
+	assert.Contains(t, manager.jobs, "pending")
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/google/uuid"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// CommandOrigin identifies where a command was received from, it is recorded in the command audit event.
+type CommandOrigin struct {
+	// Source is one of the AuditSource constants
+	Source string
+	// Requester identifies the requester as well as possible, i.e. the remote address or the request topic
+	Requester string
+}
+
+// AuditCommand publishes the audit event of the specified command to the internal MessageBus when CommandAudit is
+// enabled. A statusCode of 0 means the command was not issued via REST and only errorMessage determines the outcome.
+func AuditCommand(origin CommandOrigin, req commandDTOs.IssueCommandRequest, statusCode int, errorMessage string, start time.Time, dic *di.Container) {
+	configuration := commandContainer.ConfigurationFrom(dic.Get)
+	if !configuration.CommandAudit.Enabled {
+		return
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if messagingClient == nil {
+		lc.Errorf("MessageBus client not available, unable to publish audit event of command '%s' for device '%s'", req.CommandName, req.DeviceName)
+		return
+	}
+
+	auditEvent := commandDTOs.CommandAuditEvent{
+		Id:          uuid.NewString(),
+		Timestamp:   start.UnixMilli(),
+		Source:      origin.Source,
+		Requester:   origin.Requester,
+		DeviceName:  req.DeviceName,
+		CommandName: req.CommandName,
+		Method:      req.Method,
+		QueryParams: req.QueryParams,
+		Settings:    req.Settings,
+		Success:     errorMessage == "" && (statusCode == 0 || (statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices)),
+		StatusCode:  statusCode,
+		Message:     errorMessage,
+		Latency:     time.Since(start).Nanoseconds(),
+	}
+
+	publishTopic := common.BuildTopic(
+		configuration.MessageBus.GetBaseTopicPrefix(),
+		configuration.CommandAudit.PublishTopic,
+		req.DeviceName,
+		common.URLEncode(req.CommandName),
+		req.Method,
+	)
+
+	payload, _ := json.Marshal(auditEvent)
+	envelope := types.NewMessageEnvelope(payload, context.Background())
+	envelope.ContentType = common.ContentTypeJSON
+
+	if err := messagingClient.Publish(envelope, publishTopic); err != nil {
+		lc.Errorf("unable to publish audit event of command '%s' for device '%s' to topic '%s': %v", req.CommandName, req.DeviceName, publishTopic, err)
+		return
+	}
+
+	lc.Debugf("Published audit event of command '%s' for device '%s' to topic '%s'", req.CommandName, req.DeviceName, publishTopic)
+}
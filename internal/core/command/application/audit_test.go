@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+func TestAuditCommand(t *testing.T) {
+	origin := CommandOrigin{Source: commandDTOs.AuditSourceREST, Requester: "127.0.0.1:12345"}
+	req := commandDTOs.IssueCommandRequest{
+		DeviceName:  testDeviceName,
+		CommandName: command1,
+		Method:      "set",
+		Settings:    map[string]any{"resource": "value"},
+	}
+	expectedTopic := "edgex/core/commandaudit/" + testDeviceName + "/" + command1 + "/set"
+
+	tests := []struct {
+		name            string
+		enabled         bool
+		statusCode      int
+		errorMessage    string
+		expectedSuccess bool
+	}{
+		{"disabled", false, http.StatusOK, "", true},
+		{"success", true, http.StatusOK, "", true},
+		{"failed with status code", true, http.StatusNotFound, "device not found", false},
+		{"failed without status code", true, 0, "timed out", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var published types.MessageEnvelope
+			mockMessaging := &mocks.MessageClient{}
+			mockMessaging.On("Publish", mock.Anything, expectedTopic).Run(func(args mock.Arguments) {
+				published = args.Get(0).(types.MessageEnvelope)
+			}).Return(nil)
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				commandContainer.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{
+						MessageBus:   bootstrapConfig.MessageBusInfo{BaseTopicPrefix: "edgex"},
+						CommandAudit: config.CommandAuditInfo{Enabled: tt.enabled, PublishTopic: "core/commandaudit"},
+					}
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+				bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+					return mockMessaging
+				},
+			})
+
+			AuditCommand(origin, req, tt.statusCode, tt.errorMessage, time.Now(), dic)
+
+			if !tt.enabled {
+				mockMessaging.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+				return
+			}
+			mockMessaging.AssertCalled(t, "Publish", mock.Anything, expectedTopic)
+			var auditEvent commandDTOs.CommandAuditEvent
+			require.NoError(t, json.Unmarshal(published.Payload, &auditEvent))
+			assert.Equal(t, origin.Source, auditEvent.Source)
+			assert.Equal(t, origin.Requester, auditEvent.Requester)
+			assert.Equal(t, req.DeviceName, auditEvent.DeviceName)
+			assert.Equal(t, req.Settings, auditEvent.Settings)
+			assert.Equal(t, tt.expectedSuccess, auditEvent.Success)
+			assert.Equal(t, tt.statusCode, auditEvent.StatusCode)
+			assert.Equal(t, tt.errorMessage, auditEvent.Message)
+		})
+	}
+}
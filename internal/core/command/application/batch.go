@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// IssueBatchCommands issues the specified get and set commands concurrently and returns the response of each command
+// in the same order as the requests. The number of commands issued at the same time is limited by
+// CommandBatch.MaxConcurrency.
+func IssueBatchCommands(reqs []commandDTOs.IssueCommandRequest, origin CommandOrigin, dic *di.Container) ([]commandDTOs.IssueCommandResponse, errors.EdgeX) {
+	batchConfig := commandContainer.ConfigurationFrom(dic.Get).CommandBatch
+	if batchConfig.MaxCommands > 0 && len(reqs) > batchConfig.MaxCommands {
+		return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded,
+			fmt.Sprintf("number of commands %d exceeds the maximum of %d", len(reqs), batchConfig.MaxCommands), nil)
+	}
+
+	concurrency := batchConfig.MaxConcurrency
+	if concurrency <= 0 || concurrency > len(reqs) {
+		concurrency = len(reqs)
+	}
+
+	responses := make([]commandDTOs.IssueCommandResponse, len(reqs))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, req commandDTOs.IssueCommandRequest) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			responses[i] = issueCommand(req, origin, dic)
+		}(i, req)
+	}
+	wg.Wait()
+
+	return responses, nil
+}
+
+// issueCommand issues the specified get or set command and publishes its audit event
+func issueCommand(req commandDTOs.IssueCommandRequest, origin CommandOrigin, dic *di.Container) commandDTOs.IssueCommandResponse {
+	start := time.Now()
+	response := issueCommandRequest(req, dic)
+	AuditCommand(origin, req, response.StatusCode, auditErrorMessage(response), start, dic)
+	return response
+}
+
+func issueCommandRequest(req commandDTOs.IssueCommandRequest, dic *di.Container) commandDTOs.IssueCommandResponse {
+	queryParams := encodeQueryParams(req.QueryParams)
+
+	if strings.EqualFold(req.Method, "set") {
+		response, err := IssueSetCommandByName(req.DeviceName, req.CommandName, queryParams, req.Settings, dic)
+		if err != nil {
+			return commandDTOs.NewIssueCommandResponse(req, err.Error(), err.Code(), nil)
+		}
+		return commandDTOs.NewIssueCommandResponse(req, response.Message, response.StatusCode, nil)
+	}
+
+	response, err := IssueGetCommandByName(req.DeviceName, req.CommandName, queryParams, dic)
+	if err != nil {
+		return commandDTOs.NewIssueCommandResponse(req, err.Error(), err.Code(), nil)
+	}
+	// If ds-returnevent is false, there will be no event returned
+	if response == nil {
+		return commandDTOs.NewIssueCommandResponse(req, "", http.StatusOK, nil)
+	}
+
+	var event *dtos.Event
+	if response.Event.Id != "" {
+		event = &response.Event
+	}
+	return commandDTOs.NewIssueCommandResponse(req, response.Message, response.StatusCode, event)
+}
+
+// auditErrorMessage returns the message of the failed command response, responses of successful commands may also
+// contain a message which isn't an error
+func auditErrorMessage(response commandDTOs.IssueCommandResponse) string {
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return ""
+	}
+	return response.Message
+}
+
+func encodeQueryParams(queryParams map[string]string) string {
+	values := url.Values{}
+	for key, value := range queryParams {
+		values.Set(key, value)
+	}
+	return values.Encode()
+}
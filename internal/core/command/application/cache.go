@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	gometrics "github.com/rcrowley/go-metrics"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	commandCacheHitsMetricName   = "CommandCacheHits"
+	commandCacheMissesMetricName = "CommandCacheMisses"
+
+	defaultCommandCacheTTL = 2 * time.Second
+)
+
+type cachedEventResponse struct {
+	response responses.EventResponse
+	expires  time.Time
+}
+
+// CommandCache caches the responses of get commands for a short time, so repeated get commands are served without
+// issuing them to the device service.
+type CommandCache struct {
+	mutex            sync.Mutex
+	ttl              time.Duration
+	maxEntries       int
+	excludedProfiles []string
+	entries          map[string]cachedEventResponse
+	hitsCounter      gometrics.Counter
+	missesCounter    gometrics.Counter
+}
+
+// NewCommandCache creates a new initialized CommandCache and registers its metrics
+func NewCommandCache(dic *di.Container) *CommandCache {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	cacheInfo := commandContainer.ConfigurationFrom(dic.Get).CommandCache
+
+	cache := &CommandCache{
+		ttl:              defaultCommandCacheTTL,
+		maxEntries:       cacheInfo.MaxEntries,
+		excludedProfiles: cacheInfo.ExcludedProfiles,
+		entries:          make(map[string]cachedEventResponse),
+		hitsCounter:      gometrics.NewCounter(),
+		missesCounter:    gometrics.NewCounter(),
+	}
+	if cacheInfo.TTL != "" {
+		ttl, err := time.ParseDuration(cacheInfo.TTL)
+		if err != nil || ttl <= 0 {
+			lc.Errorf("invalid CommandCache.TTL '%s', using default %s", cacheInfo.TTL, cache.ttl)
+		} else {
+			cache.ttl = ttl
+		}
+	}
+
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. Command cache metrics will not be collected.")
+		return cache
+	}
+	for name, counter := range map[string]gometrics.Counter{
+		commandCacheHitsMetricName:   cache.hitsCounter,
+		commandCacheMissesMetricName: cache.missesCounter,
+	} {
+		if err := metricsManager.Register(name, counter, nil); err != nil {
+			lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		lc.Infof("Registered metrics counter %s", name)
+	}
+
+	return cache
+}
+
+// CommandCacheName contains the name of command's application.CommandCache instance in the DIC.
+var CommandCacheName = di.TypeInstanceToName(CommandCache{})
+
+// CommandCacheFrom helper function queries the DIC and returns the application.CommandCache instance, or nil when
+// the command cache isn't enabled.
+func CommandCacheFrom(get di.Get) *CommandCache {
+	cache, ok := get(CommandCacheName).(*CommandCache)
+	if !ok {
+		return nil
+	}
+	return cache
+}
+
+// Get returns the cached response of the get command, the returned key is used to store the response on a cache
+// miss. An empty key means the command must not be cached.
+func (c *CommandCache) Get(deviceName string, commandName string, queryParams string) (*responses.EventResponse, string) {
+	if c == nil {
+		return nil, ""
+	}
+
+	key, cacheable := commandCacheKey(deviceName, commandName, queryParams)
+	if !cacheable {
+		return nil, ""
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, exists := c.entries[key]
+	if !exists || time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		c.missesCounter.Inc(1)
+		return nil, key
+	}
+
+	c.hitsCounter.Inc(1)
+	response := entry.response
+	return &response, key
+}
+
+// Set caches the response of the get command issued to a device of the specified profile
+func (c *CommandCache) Set(key string, profileName string, response *responses.EventResponse) {
+	if c == nil || key == "" || response == nil || response.StatusCode != http.StatusOK {
+		return
+	}
+	for _, excluded := range c.excludedProfiles {
+		if excluded == profileName {
+			return
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	if c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= c.maxEntries {
+			return
+		}
+	}
+
+	c.entries[key] = cachedEventResponse{response: *response, expires: now.Add(c.ttl)}
+}
+
+// commandCacheKey returns the cache key built from the device name, command name and the query parameters sorted by
+// key. Commands pushing their event to core data are not cacheable as the event would not be pushed on a cache hit.
+func commandCacheKey(deviceName string, commandName string, queryParams string) (string, bool) {
+	values, err := url.ParseQuery(queryParams)
+	if err != nil {
+		return "", false
+	}
+	if values.Get(common.PushEvent) == common.ValueTrue {
+		return "", false
+	}
+	values.Del(pkgCommon.CommandTimeout)
+
+	return deviceName + "/" + commandName + "?" + values.Encode(), true
+}
@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+const testCacheProfileName = "test-profile"
+
+func newCommandCacheForTest(maxEntries int) *CommandCache {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				CommandCache: config.CommandCacheInfo{
+					Enabled:          true,
+					TTL:              "1m",
+					MaxEntries:       maxEntries,
+					ExcludedProfiles: []string{"excluded-profile"},
+				},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	return NewCommandCache(dic)
+}
+
+func TestCommandCache(t *testing.T) {
+	response := &responses.EventResponse{BaseResponse: common.NewBaseResponse("", "", http.StatusOK)}
+	failedResponse := &responses.EventResponse{BaseResponse: common.NewBaseResponse("", "", http.StatusInternalServerError)}
+
+	tests := []struct {
+		name           string
+		storeQuery     string
+		lookupQuery    string
+		profileName    string
+		response       *responses.EventResponse
+		expectedCached bool
+	}{
+		{"valid - cached", "", "", testCacheProfileName, response, true},
+		{"valid - query parameters in different order", "a=1&b=2", "b=2&a=1", testCacheProfileName, response, true},
+		{"valid - cmd-timeout ignored", "a=1", "a=1&cmd-timeout=5s", testCacheProfileName, response, true},
+		{"invalid - different query parameters", "a=1", "a=2", testCacheProfileName, response, false},
+		{"invalid - excluded profile", "", "", "excluded-profile", response, false},
+		{"invalid - failed response", "", "", testCacheProfileName, failedResponse, false},
+		{"invalid - push event", "ds-pushevent=true", "ds-pushevent=true", testCacheProfileName, response, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newCommandCacheForTest(0)
+
+			_, key := cache.Get(testDeviceName, command1, tt.storeQuery)
+			cache.Set(key, tt.profileName, tt.response)
+
+			cached, _ := cache.Get(testDeviceName, command1, tt.lookupQuery)
+			if tt.expectedCached {
+				require.NotNil(t, cached)
+				assert.Equal(t, *tt.response, *cached)
+				assert.Equal(t, int64(1), cache.hitsCounter.Count())
+				return
+			}
+			assert.Nil(t, cached)
+			assert.Equal(t, int64(0), cache.hitsCounter.Count())
+		})
+	}
+}
+
+func TestCommandCacheExpiredAndFull(t *testing.T) {
+	response := &responses.EventResponse{BaseResponse: common.NewBaseResponse("", "", http.StatusOK)}
+	cache := newCommandCacheForTest(1)
+
+	_, key1 := cache.Get(testDeviceName, command1, "")
+	cache.Set(key1, testCacheProfileName, response)
+	_, key2 := cache.Get(testDeviceName, command2, "")
+	cache.Set(key2, testCacheProfileName, response)
+
+	cached, _ := cache.Get(testDeviceName, command2, "")
+	assert.Nil(t, cached, "cache is full, response should not be cached")
+
+	cache.entries[key1] = cachedEventResponse{response: *response, expires: time.Now().Add(-time.Second)}
+	cached, _ = cache.Get(testDeviceName, command1, "")
+	assert.Nil(t, cached, "cached response is expired")
+}
+
+func TestCommandCacheDisabled(t *testing.T) {
+	var cache *CommandCache
+
+	cached, key := cache.Get(testDeviceName, command1, "")
+	assert.Nil(t, cached)
+	assert.Empty(t, key)
+	cache.Set(key, testCacheProfileName, &responses.EventResponse{})
+}
+
+func TestIssueGetCommandByNameCachedDeviceLocked(t *testing.T) {
+	const serviceName = "test-service"
+	device := responses.DeviceResponse{Device: dtos.Device{Name: testDeviceName, ProfileName: testCacheProfileName,
+		ServiceName: serviceName, AdminState: models.Unlocked, OperatingState: models.Up}}
+	lockedDevice := device
+	lockedDevice.Device.AdminState = models.Locked
+	service := responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: serviceName, BaseAddress: "http://localhost:59999",
+		AdminState: models.Unlocked}}
+	response := &responses.EventResponse{BaseResponse: common.NewBaseResponse("", "", http.StatusOK)}
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(device, nil).Once()
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(lockedDevice, nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", context.Background(), serviceName).Return(service, nil)
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", context.Background(), service.Service.BaseAddress, testDeviceName, command1, "").Return(response, nil).Once()
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{CommandCache: config.CommandCacheInfo{Enabled: true, TTL: "1m"}}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cache := NewCommandCache(dic)
+	dic.Update(di.ServiceConstructorMap{
+		CommandCacheName: func(get di.Get) interface{} {
+			return cache
+		},
+	})
+
+	res, err := IssueGetCommandByName(testDeviceName, command1, "", dic)
+	require.NoError(t, err)
+	assert.Equal(t, response, res)
+	cached, _ := cache.Get(testDeviceName, command1, "")
+	require.NotNil(t, cached, "response should be cached")
+
+	// the device is locked while its response is cached
+	_, err = IssueGetCommandByName(testDeviceName, command1, "", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceLocked, errors.Kind(err))
+	dsccMock.AssertNumberOfCalls(t, "GetCommand", 1)
+}
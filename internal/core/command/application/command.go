@@ -193,6 +193,11 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
+	queryParams, _, err = transformCommand(deviceName, commandName, "get", queryParams, nil, dic)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+
 	// retrieve device information through Metadata DeviceClient
 	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
 	if dc == nil {
@@ -212,16 +217,33 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
+	err = CheckCommandState(deviceResponse.Device, deviceServiceResponse.Service)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the cached readings are only returned once the device and its service are checked to accept the command
+	cache := CommandCacheFrom(dic.Get)
+	cached, cacheKey := cache.Get(deviceName, commandName, queryParams)
+	if cached != nil {
+		return cached, nil
+	}
 
 	// Issue command by passing the base address of device service into DeviceServiceCommandClient
 	dscc := bootstrapContainer.DeviceServiceCommandClientFrom(dic.Get)
 	if dscc == nil {
 		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	res, err = dscc.GetCommand(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams)
+	ctx, cancel, queryParams, err := commandContext(deviceName, commandName, queryParams, dic)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	defer cancel()
+	res, err = dscc.GetCommand(ctx, deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
+	cache.Set(cacheKey, deviceResponse.Device.ProfileName, res)
 
 	return res, nil
 }
@@ -237,6 +259,11 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
+	queryParams, settings, err = transformCommand(deviceName, commandName, "set", queryParams, settings, dic)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+
 	// retrieve device information through Metadata DeviceClient
 	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
 	if dc == nil {
@@ -256,11 +283,24 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
+	err = CheckCommandState(deviceResponse.Device, deviceServiceResponse.Service)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
 
 	// Issue command by passing the base address of device service into DeviceServiceCommandClient
 	dscc := bootstrapContainer.DeviceServiceCommandClientFrom(dic.Get)
 	if dscc == nil {
 		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	return dscc.SetCommandWithObject(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams, settings)
+	err = validateSetCommand(deviceResponse.Device.ProfileName, commandName, settings, dic)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	ctx, cancel, queryParams, err := commandContext(deviceName, commandName, queryParams, dic)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	defer cancel()
+	return dscc.SetCommandWithObject(ctx, deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams, settings)
 }
@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// DeviceNamesByGroupName returns the names of the member devices of the device group, as resolved by core-metadata
+func DeviceNamesByGroupName(ctx context.Context, groupName string, dic *di.Container) ([]string, errors.EdgeX) {
+	clientInfo, ok := commandContainer.ConfigurationFrom(dic.Get).Clients[common.CoreMetaDataServiceKey]
+	if !ok || clientInfo == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "core-metadata client configuration is missing", nil)
+	}
+
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, "0")
+	requestParams.Set(common.Limit, strconv.Itoa(-1))
+	requestPath := utils.EscapeAndJoinPath(common.ApiDeviceRoute, pkgCommon.Group, common.Name, groupName)
+	authInjector := secret.NewJWTSecretProvider(bootstrapContainer.SecretProviderExtFrom(dic.Get))
+
+	var res responses.MultiDevicesResponse
+	err := utils.GetRequest(ctx, &res, clientInfo.Url(), requestPath, requestParams, authInjector)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to get the devices of device group %s", groupName), err)
+	}
+
+	deviceNames := make([]string, len(res.Devices))
+	for i, device := range res.Devices {
+		deviceNames[i] = device.Name
+	}
+	return deviceNames, nil
+}
+
+// IssueGroupCommand issues the get or set command to each member device of the device group and returns the response
+// of each command, in the order of the members
+func IssueGroupCommand(ctx context.Context, groupName string, commandName string, method string, queryParams map[string]string,
+	settings map[string]any, origin CommandOrigin, dic *di.Container) ([]commandDTOs.IssueCommandResponse, errors.EdgeX) {
+	deviceNames, err := DeviceNamesByGroupName(ctx, groupName, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(deviceNames) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device group %s has no device", groupName), nil)
+	}
+
+	reqs := make([]commandDTOs.IssueCommandRequest, len(deviceNames))
+	for i, deviceName := range deviceNames {
+		reqs[i] = commandDTOs.IssueCommandRequest{
+			DeviceName:  deviceName,
+			CommandName: commandName,
+			Method:      method,
+			QueryParams: queryParams,
+			Settings:    settings,
+		}
+	}
+	return IssueBatchCommands(reqs, origin, dic)
+}
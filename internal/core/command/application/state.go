@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// CheckCommandState returns an error when the device or its device service is not in a state to process commands, so
+// the command fails immediately instead of waiting for the device service to reject it or for the request to time out.
+func CheckCommandState(device dtos.Device, deviceService dtos.DeviceService) errors.EdgeX {
+	if deviceService.AdminState == models.Locked {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, fmt.Sprintf("device service %s is locked", deviceService.Name), nil)
+	}
+	if device.AdminState == models.Locked {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, fmt.Sprintf("device %s is locked", device.Name), nil)
+	}
+	if device.OperatingState == models.Down {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, fmt.Sprintf("device %s is down", device.Name), nil)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCommandState(t *testing.T) {
+	tests := []struct {
+		name                 string
+		deviceAdminState     string
+		deviceOperatingState string
+		serviceAdminState    string
+		expectedKind         errors.ErrKind
+		expectedMessage      string
+	}{
+		{"valid", models.Unlocked, models.Up, models.Unlocked, "", ""},
+		{"valid - unknown operating state", models.Unlocked, models.Unknown, models.Unlocked, "", ""},
+		{"invalid - device service locked", models.Unlocked, models.Up, models.Locked, errors.KindServiceLocked, "device service"},
+		{"invalid - device locked", models.Locked, models.Up, models.Unlocked, errors.KindServiceLocked, "device testDevice is locked"},
+		{"invalid - device down", models.Unlocked, models.Down, models.Unlocked, errors.KindServiceLocked, "device testDevice is down"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := dtos.Device{Name: "testDevice", AdminState: tt.deviceAdminState, OperatingState: tt.deviceOperatingState}
+			deviceService := dtos.DeviceService{Name: "testService", AdminState: tt.serviceAdminState}
+
+			err := CheckCommandState(device, deviceService)
+			if tt.expectedKind == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedKind, errors.Kind(err))
+			assert.Contains(t, err.Error(), tt.expectedMessage)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// ResolveCommandTimeout returns the timeout to apply to the specified command. The requested timeout, i.e. the value of
+// the cmd-timeout query parameter, takes precedence over the configured overrides, and the default timeout is used when
+// neither applies.
+func ResolveCommandTimeout(deviceName string, commandName string, requestedTimeout string, defaultTimeout time.Duration, dic *di.Container) (time.Duration, errors.EdgeX) {
+	timeoutConfig := commandContainer.ConfigurationFrom(dic.Get).CommandTimeout
+
+	if len(requestedTimeout) > 0 {
+		timeout, err := time.ParseDuration(requestedTimeout)
+		if err != nil || timeout <= 0 {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid query parameter, %s has to be a positive duration", pkgCommon.CommandTimeout), err)
+		}
+
+		if len(timeoutConfig.MaxTimeout) > 0 {
+			maxTimeout, err := time.ParseDuration(timeoutConfig.MaxTimeout)
+			if err != nil {
+				return 0, errors.NewCommonEdgeX(errors.KindServerError, "failed to parse CommandTimeout.MaxTimeout", err)
+			}
+			if timeout > maxTimeout {
+				return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid query parameter, %s exceeds the maximum of %s", pkgCommon.CommandTimeout, maxTimeout), nil)
+			}
+		}
+
+		return timeout, nil
+	}
+
+	override := ""
+	for _, o := range timeoutConfig.Overrides {
+		if o.DeviceName != deviceName {
+			continue
+		}
+		if o.CommandName == commandName {
+			override = o.Timeout
+			break
+		}
+		if len(o.CommandName) == 0 {
+			override = o.Timeout
+		}
+	}
+	if len(override) == 0 {
+		return defaultTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(override)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to parse CommandTimeout override for device %s", deviceName), err)
+	}
+	return timeout, nil
+}
+
+// commandContext extracts the cmd-timeout query parameter from the raw query string and returns a context which is
+// cancelled once the command timeout elapses, along with the query string to pass on to the device service.
+func commandContext(deviceName string, commandName string, rawQuery string, dic *di.Container) (context.Context, context.CancelFunc, string, errors.EdgeX) {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse query parameters", err)
+	}
+
+	requestedTimeout := values.Get(pkgCommon.CommandTimeout)
+	if values.Has(pkgCommon.CommandTimeout) {
+		values.Del(pkgCommon.CommandTimeout)
+		rawQuery = values.Encode()
+	}
+
+	timeout, edgexErr := ResolveCommandTimeout(deviceName, commandName, requestedTimeout, 0, dic)
+	if edgexErr != nil {
+		return nil, nil, "", edgexErr
+	}
+
+	if timeout == 0 {
+		return context.Background(), func() {}, rawQuery, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	return ctx, cancel, rawQuery, nil
+}
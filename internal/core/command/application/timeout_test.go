@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+func TestResolveCommandTimeout(t *testing.T) {
+	defaultTimeout := 10 * time.Second
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				CommandTimeout: config.CommandTimeoutInfo{
+					MaxTimeout: "1m",
+					Overrides: []config.CommandTimeoutOverride{
+						{DeviceName: testDeviceName, CommandName: command1, Timeout: "30s"},
+						{DeviceName: testDeviceName, Timeout: "20s"},
+					},
+				},
+			}
+		},
+	})
+
+	tests := []struct {
+		name             string
+		deviceName       string
+		commandName      string
+		requestedTimeout string
+		expectedTimeout  time.Duration
+		expectedError    bool
+	}{
+		{"valid - default timeout", "otherDevice", command1, "", defaultTimeout, false},
+		{"valid - command override", testDeviceName, command1, "", 30 * time.Second, false},
+		{"valid - device override", testDeviceName, command2, "", 20 * time.Second, false},
+		{"valid - requested timeout", testDeviceName, command1, "45s", 45 * time.Second, false},
+		{"invalid - requested timeout exceeds maximum", testDeviceName, command1, "2m", 0, true},
+		{"invalid - requested timeout not a duration", testDeviceName, command1, "abc", 0, true},
+		{"invalid - requested timeout not positive", testDeviceName, command1, "-1s", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeout, err := ResolveCommandTimeout(tt.deviceName, tt.commandName, tt.requestedTimeout, defaultTimeout, dic)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTimeout, timeout)
+		})
+	}
+}
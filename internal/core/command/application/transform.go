@@ -0,0 +1,259 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// CommandTransformer rewrites the query parameters and settings of a command before it is forwarded to the device
+// service. Transformers are registered with RegisterCommandTransformer and referenced by name in the
+// CommandTransform rules.
+type CommandTransformer interface {
+	Transform(req *commandDTOs.IssueCommandRequest) error
+}
+
+// CommandTransformerFunc is an adapter allowing the use of ordinary functions as CommandTransformer
+type CommandTransformerFunc func(req *commandDTOs.IssueCommandRequest) error
+
+// Transform calls f(req)
+func (f CommandTransformerFunc) Transform(req *commandDTOs.IssueCommandRequest) error {
+	return f(req)
+}
+
+var (
+	transformersMutex sync.RWMutex
+	transformers      = make(map[string]CommandTransformer)
+)
+
+// RegisterCommandTransformer registers the named transformer, it must be called before the service is bootstrapped
+func RegisterCommandTransformer(name string, transformer CommandTransformer) {
+	transformersMutex.Lock()
+	defer transformersMutex.Unlock()
+	transformers[name] = transformer
+}
+
+func commandTransformer(name string) (CommandTransformer, bool) {
+	transformersMutex.RLock()
+	defer transformersMutex.RUnlock()
+	transformer, ok := transformers[name]
+	return transformer, ok
+}
+
+type commandTransformRule struct {
+	config.CommandTransformRule
+	transformer CommandTransformer
+}
+
+// CommandTransformPipeline applies the CommandTransform rules to the commands matching them
+type CommandTransformPipeline struct {
+	rules []commandTransformRule
+}
+
+// NewCommandTransformPipeline creates the CommandTransformPipeline of the configured rules, rules referencing an
+// unregistered transformer are skipped.
+func NewCommandTransformPipeline(dic *di.Container) *CommandTransformPipeline {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	ruleConfigs := commandContainer.ConfigurationFrom(dic.Get).CommandTransform.Rules
+
+	pipeline := &CommandTransformPipeline{}
+	for i, ruleConfig := range ruleConfigs {
+		rule := commandTransformRule{CommandTransformRule: ruleConfig}
+		if ruleConfig.Transformer != "" {
+			transformer, ok := commandTransformer(ruleConfig.Transformer)
+			if !ok {
+				lc.Errorf("CommandTransform rule %d skipped, transformer '%s' is not registered", i, ruleConfig.Transformer)
+				continue
+			}
+			rule.transformer = transformer
+		}
+		pipeline.rules = append(pipeline.rules, rule)
+	}
+	return pipeline
+}
+
+// CommandTransformPipelineName contains the name of command's application.CommandTransformPipeline instance in the DIC.
+var CommandTransformPipelineName = di.TypeInstanceToName(CommandTransformPipeline{})
+
+// CommandTransformPipelineFrom helper function queries the DIC and returns the application.CommandTransformPipeline
+// instance, or nil when command transformation isn't enabled.
+func CommandTransformPipelineFrom(get di.Get) *CommandTransformPipeline {
+	pipeline, ok := get(CommandTransformPipelineName).(*CommandTransformPipeline)
+	if !ok {
+		return nil
+	}
+	return pipeline
+}
+
+// Matches returns whether any rule applies to the command request, so that the request is left untouched otherwise
+func (p *CommandTransformPipeline) Matches(req commandDTOs.IssueCommandRequest) bool {
+	if p == nil {
+		return false
+	}
+	for _, rule := range p.rules {
+		if rule.matches(req) {
+			return true
+		}
+	}
+	return false
+}
+
+// Transform applies the matching rules to the command request, the query parameters and settings of the request
+// are replaced rather than modified in place.
+func (p *CommandTransformPipeline) Transform(req *commandDTOs.IssueCommandRequest) errors.EdgeX {
+	if p == nil {
+		return nil
+	}
+
+	req.QueryParams = copyMap(req.QueryParams)
+	req.Settings = copyMap(req.Settings)
+	for _, rule := range p.rules {
+		if !rule.matches(*req) {
+			continue
+		}
+		if err := rule.apply(req); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("failed to transform %s command %s of device %s", req.Method, req.CommandName, req.DeviceName), err)
+		}
+	}
+	return nil
+}
+
+func (r commandTransformRule) matches(req commandDTOs.IssueCommandRequest) bool {
+	return (r.DeviceName == "" || r.DeviceName == req.DeviceName) &&
+		(r.CommandName == "" || r.CommandName == req.CommandName) &&
+		(r.Method == "" || strings.EqualFold(r.Method, req.Method))
+}
+
+func (r commandTransformRule) apply(req *commandDTOs.IssueCommandRequest) error {
+	renameKeys(req.QueryParams, r.RenameQueryParams)
+	for name, value := range r.SetQueryParams {
+		req.QueryParams[name] = value
+	}
+	for _, name := range r.RemoveQueryParams {
+		delete(req.QueryParams, name)
+	}
+
+	renameKeys(req.Settings, r.RenameSettings)
+	for name, conversion := range r.ConvertSettings {
+		value, exists := req.Settings[name]
+		if !exists {
+			continue
+		}
+		converted, err := convertUnit(value, conversion)
+		if err != nil {
+			return fmt.Errorf("failed to convert setting %s: %w", name, err)
+		}
+		req.Settings[name] = converted
+	}
+
+	if r.transformer != nil {
+		return r.transformer.Transform(req)
+	}
+	return nil
+}
+
+// convertUnit applies the conversion to the numeric value, which is either a string as expected by the device
+// service, a JSON number or a CBOR integer. The converted value has the same type as the value, except for the
+// integers which are converted to float64 as the converted value may not be integral.
+func convertUnit(value any, conversion config.UnitConversion) (any, error) {
+	scale := conversion.Scale
+	if scale == 0 {
+		scale = 1
+	}
+
+	switch v := value.(type) {
+	case float64:
+		return v*scale + conversion.Offset, nil
+	case int:
+		return float64(v)*scale + conversion.Offset, nil
+	case int64:
+		return float64(v)*scale + conversion.Offset, nil
+	case uint64:
+		return float64(v)*scale + conversion.Offset, nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a number", v)
+		}
+		return json.Number(strconv.FormatFloat(f*scale+conversion.Offset, 'f', -1, 64)), nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a number", v)
+		}
+		return strconv.FormatFloat(f*scale+conversion.Offset, 'f', -1, 64), nil
+	default:
+		return nil, fmt.Errorf("%v is not a number", value)
+	}
+}
+
+func renameKeys[V any](m map[string]V, names map[string]string) {
+	for name, newName := range names {
+		value, exists := m[name]
+		if !exists {
+			continue
+		}
+		delete(m, name)
+		m[newName] = value
+	}
+}
+
+func copyMap[V any](m map[string]V) map[string]V {
+	copied := make(map[string]V, len(m))
+	for key, value := range m {
+		copied[key] = value
+	}
+	return copied
+}
+
+// transformCommand applies the CommandTransform rules to the encoded query parameters and the settings of the
+// command issued via REST. The query parameters are returned unchanged when command transformation isn't enabled.
+func transformCommand(deviceName string, commandName string, method string, queryParams string, settings map[string]any,
+	dic *di.Container) (string, map[string]any, errors.EdgeX) {
+	pipeline := CommandTransformPipelineFrom(dic.Get)
+	if pipeline == nil {
+		return queryParams, settings, nil
+	}
+
+	values, err := url.ParseQuery(queryParams)
+	if err != nil {
+		return "", nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse query parameters", err)
+	}
+	req := commandDTOs.IssueCommandRequest{
+		DeviceName:  deviceName,
+		CommandName: commandName,
+		Method:      method,
+		QueryParams: make(map[string]string, len(values)),
+		Settings:    settings,
+	}
+	for key := range values {
+		req.QueryParams[key] = values.Get(key)
+	}
+
+	if err := pipeline.Transform(&req); err != nil {
+		return "", nil, err
+	}
+	return encodeQueryParams(req.QueryParams), req.Settings, nil
+}
+
+// TransformCommand applies the CommandTransform rules to the command request, when command transformation is enabled
+func TransformCommand(req *commandDTOs.IssueCommandRequest, dic *di.Container) errors.EdgeX {
+	return CommandTransformPipelineFrom(dic.Get).Transform(req)
+}
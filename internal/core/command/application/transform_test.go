@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	edgexErrors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+func TestCommandTransformPipeline_Transform(t *testing.T) {
+	failing := CommandTransformerFunc(func(req *commandDTOs.IssueCommandRequest) error {
+		return errors.New("failed")
+	})
+	autoMode := CommandTransformerFunc(func(req *commandDTOs.IssueCommandRequest) error {
+		req.Settings["Mode"] = "AUTO"
+		return nil
+	})
+	pipeline := &CommandTransformPipeline{rules: []commandTransformRule{
+		{CommandTransformRule: config.CommandTransformRule{
+			DeviceName:        "thermostat",
+			Method:            "set",
+			RenameQueryParams: map[string]string{"legacy": "modern"},
+			SetQueryParams:    map[string]string{"added": "true"},
+			RemoveQueryParams: []string{"obsolete"},
+			RenameSettings:    map[string]string{"temp_f": "Temperature"},
+			ConvertSettings:   map[string]config.UnitConversion{"Temperature": {Scale: 0.5, Offset: -10}, "Pressure": {Offset: 1}},
+		}},
+		{CommandTransformRule: config.CommandTransformRule{CommandName: "mode"}, transformer: autoMode},
+		{CommandTransformRule: config.CommandTransformRule{DeviceName: "broken"}, transformer: failing},
+	}}
+
+	tests := []struct {
+		name                string
+		req                 commandDTOs.IssueCommandRequest
+		expectedQueryParams map[string]string
+		expectedSettings    map[string]any
+		expectedError       bool
+	}{
+		{"valid - renamed and converted string setting",
+			commandDTOs.IssueCommandRequest{DeviceName: "thermostat", CommandName: "temp", Method: "set",
+				QueryParams: map[string]string{"legacy": "1", "obsolete": "1"}, Settings: map[string]any{"temp_f": "100"}},
+			map[string]string{"modern": "1", "added": "true"}, map[string]any{"Temperature": "40"}, false},
+		{"valid - converted JSON number setting",
+			commandDTOs.IssueCommandRequest{DeviceName: "thermostat", CommandName: "temp", Method: "set",
+				Settings: map[string]any{"Temperature": float64(50), "Pressure": float64(2)}},
+			map[string]string{"added": "true"}, map[string]any{"Temperature": float64(15), "Pressure": float64(3)}, false},
+		{"valid - converted integer settings",
+			commandDTOs.IssueCommandRequest{DeviceName: "thermostat", CommandName: "temp", Method: "set",
+				Settings: map[string]any{"Temperature": int64(-50), "Pressure": uint64(2)}},
+			map[string]string{"added": "true"}, map[string]any{"Temperature": float64(-35), "Pressure": float64(3)}, false},
+		{"valid - converted json.Number setting",
+			commandDTOs.IssueCommandRequest{DeviceName: "thermostat", CommandName: "temp", Method: "set",
+				Settings: map[string]any{"Temperature": json.Number("51")}},
+			map[string]string{"added": "true"}, map[string]any{"Temperature": json.Number("15.5")}, false},
+		{"valid - registered transformer",
+			commandDTOs.IssueCommandRequest{DeviceName: "thermostat", CommandName: "mode", Method: "set", Settings: map[string]any{"Mode": "auto"}},
+			map[string]string{"added": "true"}, map[string]any{"Mode": "AUTO"}, false},
+		{"valid - no matching rule",
+			commandDTOs.IssueCommandRequest{DeviceName: "thermostat", CommandName: "temp", Method: "get", QueryParams: map[string]string{"legacy": "1"}},
+			map[string]string{"legacy": "1"}, map[string]any{}, false},
+		{"invalid - setting not a number",
+			commandDTOs.IssueCommandRequest{DeviceName: "thermostat", CommandName: "temp", Method: "set", Settings: map[string]any{"Temperature": "hot"}},
+			nil, nil, true},
+		{"invalid - transformer failed",
+			commandDTOs.IssueCommandRequest{DeviceName: "broken", CommandName: "temp", Method: "get"},
+			nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			err := pipeline.Transform(&req)
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, edgexErrors.KindContractInvalid, edgexErrors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedQueryParams, req.QueryParams)
+			assert.Equal(t, tt.expectedSettings, req.Settings)
+		})
+	}
+}
+
+func TestCommandTransformPipeline_TransformNil(t *testing.T) {
+	var pipeline *CommandTransformPipeline
+	req := commandDTOs.IssueCommandRequest{QueryParams: map[string]string{"key": "value"}}
+
+	err := pipeline.Transform(&req)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key": "value"}, req.QueryParams)
+}
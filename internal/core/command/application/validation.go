@@ -0,0 +1,281 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+// patternProperty is the optional resource property containing the regular expression String values must match
+const patternProperty = "pattern"
+
+// ValidateSetCommand validates the settings of the set command issued to the device against the device profile, when
+// SetCommandValidation is enabled.
+func ValidateSetCommand(deviceName string, commandName string, settings map[string]any, dic *di.Container) errors.EdgeX {
+	if !commandContainer.ConfigurationFrom(dic.Get).SetCommandValidation.Enabled {
+		return nil
+	}
+
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return validateSetCommand(deviceResponse.Device.ProfileName, commandName, settings, dic)
+}
+
+// validateSetCommand validates the settings of the set command against the device profile, when SetCommandValidation
+// is enabled.
+func validateSetCommand(profileName string, commandName string, settings map[string]any, dic *di.Container) errors.EdgeX {
+	if !commandContainer.ConfigurationFrom(dic.Get).SetCommandValidation.Enabled {
+		return nil
+	}
+
+	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
+	if dpc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
+	}
+	profileResponse, err := dpc.DeviceProfileByName(context.Background(), profileName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return validateSetCommandSettings(profileResponse.Profile, commandName, settings)
+}
+
+// validateSetCommandSettings checks that each setting refers to a writable resource of the command and that its value
+// conforms to the value type, minimum, maximum and mask of the resource.
+func validateSetCommandSettings(profile dtos.DeviceProfile, commandName string, settings map[string]any) errors.EdgeX {
+	// a set command is either a device command or a device resource
+	var operations []dtos.ResourceOperation
+	found := false
+	for _, command := range profile.DeviceCommands {
+		if command.Name == commandName {
+			if !strings.Contains(command.ReadWrite, common.ReadWrite_W) {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device command %s is not writable", commandName), nil)
+			}
+			operations = command.ResourceOperations
+			found = true
+			break
+		}
+	}
+	if !found {
+		operations = []dtos.ResourceOperation{{DeviceResource: commandName}}
+	}
+
+	for _, operation := range operations {
+		value, exists := settings[operation.DeviceResource]
+		if !exists {
+			// the device service falls back on the default value if there is one
+			continue
+		}
+		resource, exists := deviceResourcesByName(profile.DeviceResources, operation.DeviceResource)
+		if !exists {
+			return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+				fmt.Sprintf("device resource %s not found in device profile %s", operation.DeviceResource, profile.Name), nil)
+		}
+		if !strings.Contains(resource.Properties.ReadWrite, common.ReadWrite_W) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device resource %s is not writable", resource.Name), nil)
+		}
+		if err := validateResourceValue(resource, operation.Mappings, value); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid value for device resource %s: %s", resource.Name, err.Error()), nil)
+		}
+	}
+
+	for name := range settings {
+		if !containsResource(operations, name) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device resource %s is not part of command %s", name, commandName), nil)
+		}
+	}
+
+	return nil
+}
+
+func containsResource(operations []dtos.ResourceOperation, name string) bool {
+	for _, operation := range operations {
+		if operation.DeviceResource == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateResourceValue validates the value set to the resource. The value is either a string as expected by the
+// device service, or the equivalent JSON value.
+func validateResourceValue(resource dtos.DeviceResource, mappings map[string]string, value any) error {
+	valueType := resource.Properties.ValueType
+	switch valueType {
+	case common.ValueTypeBinary, common.ValueTypeObject:
+		// the content of binary and object values is device specific
+		return nil
+	}
+
+	if strings.HasSuffix(valueType, "Array") {
+		elements, err := arrayElements(value)
+		if err != nil {
+			return err
+		}
+		for _, element := range elements {
+			if err := validateScalarValue(strings.TrimSuffix(valueType, "Array"), resource.Properties, element); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	str, err := scalarString(value)
+	if err != nil {
+		return err
+	}
+	// the device service maps the mapped value back to the raw value
+	for raw, mapped := range mappings {
+		if mapped == str {
+			str = raw
+			break
+		}
+	}
+	return validateScalarValue(valueType, resource.Properties, str)
+}
+
+func arrayElements(value any) ([]string, error) {
+	var array []any
+	switch v := value.(type) {
+	case []any:
+		array = v
+	case string:
+		if err := json.Unmarshal([]byte(v), &array); err != nil {
+			return nil, fmt.Errorf("'%s' is not an array", v)
+		}
+	default:
+		return nil, fmt.Errorf("%v is not an array", value)
+	}
+
+	elements := make([]string, len(array))
+	for i, element := range array {
+		str, err := scalarString(element)
+		if err != nil {
+			return nil, err
+		}
+		elements[i] = str
+	}
+	return elements, nil
+}
+
+func scalarString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case uint64:
+		// CBOR encoded integers
+		return strconv.FormatUint(v, 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("%v is not a scalar value", value)
+	}
+}
+
+func validateScalarValue(valueType string, properties dtos.ResourceProperties, value string) error {
+	var number float64
+	switch valueType {
+	case common.ValueTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("'%s' is not a %s", value, valueType)
+		}
+		return nil
+	case common.ValueTypeString:
+		return checkPattern(value, properties.Optional)
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64:
+		bitSize, _ := strconv.Atoi(strings.TrimPrefix(valueType, "Uint"))
+		u, err := strconv.ParseUint(value, 10, bitSize)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a %s", value, valueType)
+		}
+		if err := checkMask(u, properties.Mask); err != nil {
+			return err
+		}
+		number = float64(u)
+	case common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64:
+		bitSize, _ := strconv.Atoi(strings.TrimPrefix(valueType, "Int"))
+		i, err := strconv.ParseInt(value, 10, bitSize)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a %s", value, valueType)
+		}
+		// the mask applies to the two's complement of the value within its bit size, i.e. 0xff for -1 of an Int8
+		if err := checkMask(uint64(i)&(uint64(1)<<bitSize-1), properties.Mask); err != nil {
+			return err
+		}
+		number = float64(i)
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		bitSize, _ := strconv.Atoi(strings.TrimPrefix(valueType, "Float"))
+		f, err := strconv.ParseFloat(value, bitSize)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a %s", value, valueType)
+		}
+		number = f
+	default:
+		return fmt.Errorf("unsupported value type %s", valueType)
+	}
+
+	if properties.Minimum != nil && number < *properties.Minimum {
+		return fmt.Errorf("%s is less than the minimum %v", value, *properties.Minimum)
+	}
+	if properties.Maximum != nil && number > *properties.Maximum {
+		return fmt.Errorf("%s is greater than the maximum %v", value, *properties.Maximum)
+	}
+	return nil
+}
+
+// checkPattern verifies the string value matches the regular expression of the "pattern" optional property, if any
+func checkPattern(value string, optional map[string]any) error {
+	pattern, ok := optional[patternProperty].(string)
+	if !ok || pattern == "" {
+		return nil
+	}
+	matched, err := regexp.MatchString(pattern, value)
+	if err != nil {
+		return fmt.Errorf("invalid pattern '%s': %s", pattern, err.Error())
+	}
+	if !matched {
+		return fmt.Errorf("'%s' does not match the pattern '%s'", value, pattern)
+	}
+	return nil
+}
+
+// checkMask verifies the value has no bits set outside the mask of the resource
+func checkMask(value uint64, mask *uint64) error {
+	if mask == nil || *mask == 0 {
+		return nil
+	}
+	if value&^*mask != 0 {
+		return fmt.Errorf("%d has bits set outside the mask %#x", value, *mask)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSetCommandSettings(t *testing.T) {
+	minimum := float64(-10)
+	maximum := float64(100)
+	mask := uint64(0x0F)
+	byteMask := uint64(0xFF)
+	int64Mask := uint64(0xFFFFFFFFFFFFFF00)
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "test-profile"},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "int", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_RW, Minimum: &minimum, Maximum: &maximum}},
+			{Name: "uint", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeUint8, ReadWrite: common.ReadWrite_W, Mask: &mask}},
+			{Name: "int8", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt8, ReadWrite: common.ReadWrite_W, Mask: &byteMask}},
+			{Name: "int16", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_W, Mask: &byteMask}},
+			{Name: "int64", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt64, ReadWrite: common.ReadWrite_W, Mask: &int64Mask}},
+			{Name: "float", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, Maximum: &maximum}},
+			{Name: "bool", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_RW}},
+			{Name: "string", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_RW,
+				Optional: map[string]any{patternProperty: "^[a-z]+$"}}},
+			{Name: "array", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32Array, ReadWrite: common.ReadWrite_RW, Maximum: &maximum}},
+			{Name: "readOnly", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt32, ReadWrite: common.ReadWrite_R}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "command", ReadWrite: common.ReadWrite_RW, ResourceOperations: []dtos.ResourceOperation{
+				{DeviceResource: "int"},
+				{DeviceResource: "bool", Mappings: map[string]string{"true": "on", "false": "off"}},
+			}},
+			{Name: "readOnlyCommand", ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "int"}}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		commandName  string
+		settings     map[string]any
+		expectedKind errors.ErrKind
+	}{
+		{"valid - int string", "int", map[string]any{"int": "42"}, ""},
+		{"valid - int JSON number", "int", map[string]any{"int": float64(-10)}, ""},
+		{"valid - int CBOR unsigned integer", "int", map[string]any{"int": uint64(42)}, ""},
+		{"valid - int CBOR negative integer", "int", map[string]any{"int": int64(-10)}, ""},
+		{"valid - uint within mask", "uint", map[string]any{"uint": "15"}, ""},
+		{"valid - negative int8 within mask", "int8", map[string]any{"int8": "-1"}, ""},
+		{"valid - minimum int8 within mask", "int8", map[string]any{"int8": int64(-128)}, ""},
+		{"valid - negative int64 within mask", "int64", map[string]any{"int64": "-256"}, ""},
+		{"valid - float", "float", map[string]any{"float": "12.5"}, ""},
+		{"valid - string matching pattern", "string", map[string]any{"string": "abc"}, ""},
+		{"valid - array string", "array", map[string]any{"array": "[1, 2, 3]"}, ""},
+		{"valid - array JSON", "array", map[string]any{"array": []any{float64(1), float64(2)}}, ""},
+		{"valid - device command with mapped value", "command", map[string]any{"int": "1", "bool": "on"}, ""},
+		{"valid - device command with default value", "command", map[string]any{"int": "1"}, ""},
+		{"invalid - int not a number", "int", map[string]any{"int": "abc"}, errors.KindContractInvalid},
+		{"invalid - int below minimum", "int", map[string]any{"int": "-11"}, errors.KindContractInvalid},
+		{"invalid - int above maximum", "int", map[string]any{"int": "101"}, errors.KindContractInvalid},
+		{"invalid - int out of range of value type", "int", map[string]any{"int": "40000"}, errors.KindContractInvalid},
+		{"invalid - uint outside mask", "uint", map[string]any{"uint": "16"}, errors.KindContractInvalid},
+		{"invalid - negative int16 outside mask", "int16", map[string]any{"int16": "-1"}, errors.KindContractInvalid},
+		{"invalid - negative int64 outside mask", "int64", map[string]any{"int64": "-1"}, errors.KindContractInvalid},
+		{"invalid - bool", "bool", map[string]any{"bool": "maybe"}, errors.KindContractInvalid},
+		{"invalid - string not matching pattern", "string", map[string]any{"string": "ABC"}, errors.KindContractInvalid},
+		{"invalid - array element above maximum", "array", map[string]any{"array": "[1, 200]"}, errors.KindContractInvalid},
+		{"invalid - not an array", "array", map[string]any{"array": "1"}, errors.KindContractInvalid},
+		{"invalid - read only resource", "readOnly", map[string]any{"readOnly": "1"}, errors.KindContractInvalid},
+		{"invalid - read only command", "readOnlyCommand", map[string]any{"int": "1"}, errors.KindContractInvalid},
+		{"invalid - resource not part of command", "command", map[string]any{"float": "1"}, errors.KindContractInvalid},
+		{"invalid - unknown resource", "unknown", map[string]any{"unknown": "1"}, errors.KindEntityDoesNotExist},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetCommandSettings(profile, tt.commandName, tt.settings)
+			if tt.expectedKind == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedKind, errors.Kind(err))
+		})
+	}
+}
@@ -18,17 +18,56 @@ package config
 
 import (
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/health"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 // ConfigurationStruct contains the configuration properties for the core-command service.
 type ConfigurationStruct struct {
-	Writable     WritableInfo
-	Clients      bootstrapConfig.ClientsCollection
-	Databases    map[string]bootstrapConfig.Database
-	Registry     bootstrapConfig.RegistryInfo
-	Service      bootstrapConfig.ServiceInfo
-	MessageBus   bootstrapConfig.MessageBusInfo
-	ExternalMQTT bootstrapConfig.ExternalMQTTInfo
+	Writable             WritableInfo
+	Clients              bootstrapConfig.ClientsCollection
+	Databases            map[string]bootstrapConfig.Database
+	Registry             bootstrapConfig.RegistryInfo
+	Service              bootstrapConfig.ServiceInfo
+	MessageBus           bootstrapConfig.MessageBusInfo
+	ExternalMQTT         bootstrapConfig.ExternalMQTTInfo
+	ExternalMQTTFailover ExternalMQTTFailoverInfo
+	ExternalMQTTTLS      ExternalMQTTTLSInfo
+	ExternalAMQP         ExternalAMQPInfo
+	ExternalACL          ExternalACLInfo
+	ExternalRateLimit    ExternalRateLimitInfo
+	CommandBatch         CommandBatchInfo
+	CommandTimeout       CommandTimeoutInfo
+	AsyncCommand         AsyncCommandInfo
+	CommandAudit         CommandAuditInfo
+	CommandCache         CommandCacheInfo
+	SetCommandValidation SetCommandValidationInfo
+	CommandQuery         CommandQueryInfo
+	CommandTransform     CommandTransformInfo
+	CommandTransport     CommandTransportInfo
+	RBAC                 rbac.Info
+	// MaxEnvelopeSize is the maximum size in kilobytes of the MessageEnvelopes of the requests received from the internal
+	// MessageBus, and of the messages received from the external MQTT and AMQP brokers, 0 for unlimited
+	MaxEnvelopeSize int64
+	// MutualTLS configures mutual TLS on the REST API and for the requests to the other services
+	MutualTLS pkgHandlers.MutualTLSInfo
+	// Grpc configures the gRPC API served alongside the REST API
+	Grpc pkgHandlers.GrpcInfo
+	// Audit configures the audit log of the security relevant operations
+	Audit audit.Info
+	// APIKey configures the authentication of the headless clients with the API keys of the secret store
+	APIKey apikey.Info
+	// Tracing configures the distributed tracing spans exported to an OpenTelemetry collector
+	Tracing tracing.Info
+	// Health configures the aggregated health report of the EdgeX services
+	Health health.Info
+	// JetStream configures the NATS JetStream durable consumers of the MessageBus subscriptions
+	JetStream pkgHandlers.JetStreamInfo
 }
 
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
@@ -36,6 +75,252 @@ type WritableInfo struct {
 	LogLevel        string
 	InsecureSecrets bootstrapConfig.InsecureSecrets
 	Telemetry       bootstrapConfig.TelemetryInfo
+	CommandRetry    CommandRetryInfo
+}
+
+// CommandRetryInfo contains the retry settings applied when a command request sent to a device service via the
+// internal MessageBus fails. A request which timed out may still have been executed by the device service, so the set
+// commands are only retried when the request couldn't be sent, unless they are declared idempotent by an override.
+type CommandRetryInfo struct {
+	// MaxRetries is the number of times a failed request is retried, 0 disables retry
+	MaxRetries int
+	// InitialBackoff is the duration to wait before the first retry, doubled on each subsequent retry
+	InitialBackoff string
+	// MaxBackoff is the upper limit of the duration to wait between retries
+	MaxBackoff string
+	// Jitter is the fraction, between 0 and 1, of the backoff which is randomly added to or subtracted from it
+	Jitter float64
+	// Overrides replaces the retry policy of specific devices or commands
+	Overrides []CommandRetryOverride
+}
+
+// CommandRetryOverride replaces the retry policy of a command, or of all the commands of a device when CommandName is
+// empty. The override of a command takes precedence over the override of its device.
+type CommandRetryOverride struct {
+	DeviceName  string
+	CommandName string
+	// MaxRetries is the number of times a failed request of the command is retried, 0 disables retry
+	MaxRetries int
+	// Idempotent set commands are retried on any failure like the get commands, including the timeouts
+	Idempotent bool
+}
+
+// ExternalMQTTFailoverInfo contains the ordered list of failover brokers for the external MQTT connection.
+type ExternalMQTTFailoverInfo struct {
+	BrokerUrls []string
+}
+
+// ExternalMQTTTLSInfo contains the TLS settings of the external MQTT connection which complement ExternalMQTT.AuthMode.
+// The client certificate, key and CA are loaded from the ExternalMQTT.SecretName secret.
+type ExternalMQTTTLSInfo struct {
+	// MinVersion is the minimum TLS version, "1.2" or "1.3"
+	MinVersion string
+	// MaxVersion is the maximum TLS version, "1.2" or "1.3"
+	MaxVersion string
+	// CipherSuites lists the names of the cipher suites enabled for TLS 1.2
+	CipherSuites []string
+	// PinnedCAFingerprints lists the SHA-256 fingerprints of the CA certificates trusted to issue the broker's certificate
+	PinnedCAFingerprints []string
+	// ReloadInterval is how often the credentials are reloaded from the secret store, in addition to when the secret is updated
+	ReloadInterval string
+}
+
+// ExternalAMQPInfo contains the settings of the connection to the external AMQP 1.0 broker, i.e. RabbitMQ or Azure
+// Service Bus, from which the command requests are received in addition to the external MQTT broker. The subject of
+// the request messages replaces the levels of the MQTT request topics following the wildcard, i.e.
+// <device-name>/<command-name>/<method> for the command requests and <device-name> or "all" for the command queries.
+type ExternalAMQPInfo struct {
+	Enabled bool
+	// Url is the url of the broker, i.e. amqp://localhost:5672 or amqps://<namespace>.servicebus.windows.net
+	Url string
+	// ContainerId identifies core-command to the broker
+	ContainerId string
+	// ConnectTimeout is the timeout of the connection to the broker
+	ConnectTimeout string
+	// RetryInterval is the time waited before connecting again when the connection to the broker fails
+	RetryInterval string
+	// Credit is the number of request messages the broker may deliver before they are handled
+	Credit         uint32
+	SkipCertVerify bool
+	// SecretName is the name of the secret holding the username and password of AuthMode usernamepassword
+	SecretName string
+	// AuthMode is either none or usernamepassword
+	AuthMode string
+	// CommandRequestAddress is the address, i.e. the queue, of the command requests
+	CommandRequestAddress string
+	// CommandResponseAddress is the address of the command responses, unless the request has a reply-to address
+	CommandResponseAddress string
+	// CommandQueryRequestAddress is the address of the command query requests
+	CommandQueryRequestAddress string
+	// CommandQueryResponseAddress is the address of the command query responses, unless the request has a reply-to
+	// address
+	CommandQueryResponseAddress string
+}
+
+// ExternalACLInfo contains the access control rules applied to command requests received from the external MQTT broker.
+// When enabled, a request is only forwarded if the rule with the longest TopicPrefix matching whole levels of the request
+// topic allows it, so that the prefix edgex/command/request/device1 doesn't match the topics of device10.
+// MQTT does not expose the publisher's client ID to subscribers, so individual external clients are identified by
+// giving each its own request topic prefix and restricting publishing on it with the broker's ACL.
+type ExternalACLInfo struct {
+	Enabled bool
+	Rules   []ExternalACLRule
+}
+
+// ExternalACLRule maps an external request topic prefix to the devices and methods it is permitted to use.
+// A '*' entry in AllowedDevices allows any device which is not explicitly listed in DeniedDevices.
+// An empty Methods list permits both 'get' and 'set'.
+type ExternalACLRule struct {
+	TopicPrefix    string
+	Methods        []string
+	AllowedDevices []string
+	DeniedDevices  []string
+}
+
+// ExternalRateLimitInfo contains the token bucket rate limits applied to command requests received from the external
+// MQTT broker. Requests exceeding either the global or the device limit are rejected.
+type ExternalRateLimitInfo struct {
+	Enabled bool
+	// Global limits the requests for all devices together
+	Global RateLimit
+	// PerDevice limits the requests for each device which has no entry in Devices
+	PerDevice RateLimit
+	// Devices overrides the PerDevice limit for specific devices
+	Devices []DeviceRateLimit
+}
+
+// RateLimit defines a token bucket, a RequestsPerSecond of 0 means no limit
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket is refilled
+	RequestsPerSecond float64
+	// Burst is the size of the bucket, i.e. the number of requests accepted at once, defaults to RequestsPerSecond
+	Burst int
+}
+
+// DeviceRateLimit defines the RateLimit of a specific device
+type DeviceRateLimit struct {
+	DeviceName        string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// CommandBatchInfo contains the limits applied when issuing a batch of commands.
+type CommandBatchInfo struct {
+	// MaxCommands is the maximum number of commands accepted in a single batch, 0 means no limit
+	MaxCommands int
+	// MaxConcurrency is the maximum number of commands issued to device services at the same time, 0 means no limit
+	MaxConcurrency int
+}
+
+// CommandTimeoutInfo contains the settings used to override the request timeout of individual commands.
+type CommandTimeoutInfo struct {
+	// MaxTimeout is the upper limit of the timeout which can be requested with the cmd-timeout query parameter,
+	// empty means no limit
+	MaxTimeout string
+	// Overrides contains the timeouts of specific devices or commands, used when no timeout is requested
+	Overrides []CommandTimeoutOverride
+}
+
+// CommandTimeoutOverride specifies the timeout of the named command of the named device. An empty CommandName applies
+// the timeout to all commands of the device which don't have an override of their own.
+type CommandTimeoutOverride struct {
+	DeviceName  string
+	CommandName string
+	Timeout     string
+}
+
+// AsyncCommandInfo contains the settings of commands issued asynchronously with the cmd-async query parameter.
+type AsyncCommandInfo struct {
+	// ResultTTL is how long the result of a completed asynchronous command is kept for polling
+	ResultTTL string
+	// MaxPendingJobs is the maximum number of asynchronous commands pending completion, 0 means no limit
+	MaxPendingJobs int
+}
+
+// CommandAuditInfo contains the settings of the audit events published for every command issued via core-command.
+type CommandAuditInfo struct {
+	// Enabled indicates whether audit events are published to the internal MessageBus
+	Enabled bool
+	// PublishTopic is the topic, below the MessageBus base topic, the audit events are published to.
+	// /<device-name>/<command-name>/<method> is added to this topic.
+	PublishTopic string
+}
+
+// CommandCacheInfo contains the settings of the cache of get command responses. Only the responses of commands issued
+// without ds-pushevent=true are cached, so the cache must only be enabled for devices whose get commands don't have
+// side effects.
+type CommandCacheInfo struct {
+	Enabled bool
+	// TTL is how long a get command response is served from the cache
+	TTL string
+	// MaxEntries is the maximum number of cached responses, 0 means no limit
+	MaxEntries int
+	// ExcludedProfiles lists the device profiles whose command responses are never cached
+	ExcludedProfiles []string
+}
+
+// SetCommandValidationInfo contains the settings of the validation of set command payloads against the device profile.
+// String values are also validated against the regular expression of the "pattern" optional resource property.
+type SetCommandValidationInfo struct {
+	Enabled bool
+}
+
+// CommandQueryInfo contains the settings of the command queries received via the MessageBus and the external MQTT
+// broker.
+type CommandQueryInfo struct {
+	// MaxDevicesPerResponse is the maximum number of devices in a single command query response. When more devices are
+	// requested, the response contains a continuation token used to request the next part. 0 means no limit
+	MaxDevicesPerResponse int
+}
+
+// CommandTransformInfo contains the rules transforming the query parameters and settings of commands before they are
+// forwarded to the device services.
+type CommandTransformInfo struct {
+	Enabled bool
+	// Rules are applied in order, every matching rule is applied
+	Rules []CommandTransformRule
+}
+
+// CommandTransformRule specifies the transformations of the commands matching DeviceName, CommandName and Method. An
+// empty DeviceName, CommandName or Method matches any. The transformations are applied in the order of the fields.
+type CommandTransformRule struct {
+	DeviceName  string
+	CommandName string
+	// Method is either "get" or "set"
+	Method string
+	// RenameQueryParams maps the names of query parameters to their new names
+	RenameQueryParams map[string]string
+	// SetQueryParams contains the query parameters added to the command, replacing any existing value
+	SetQueryParams map[string]string
+	// RemoveQueryParams lists the query parameters removed from the command
+	RemoveQueryParams []string
+	// RenameSettings maps the names of set command settings, i.e. legacy field names, to their device resource names
+	RenameSettings map[string]string
+	// ConvertSettings contains the linear unit conversions of numeric set command settings by device resource name
+	ConvertSettings map[string]UnitConversion
+	// Transformer is the name of a transformer registered with application.RegisterCommandTransformer, applied last
+	Transformer string
+}
+
+// The CommandTransport types
+const (
+	CommandTransportHTTP       = "http"
+	CommandTransportMessageBus = "messagebus"
+)
+
+// CommandTransportInfo contains the settings of how the commands received via the REST and gRPC APIs are sent to the
+// device services.
+type CommandTransportInfo struct {
+	// Type is either "http", the default, to call the command API of the device services, or "messagebus" to send the
+	// commands via the internal MessageBus request/response path, like the commands received from the MessageBus. The
+	// latter allows to command the device services which don't serve a REST API.
+	Type string
+}
+
+// UnitConversion converts a value to value * Scale + Offset, a Scale of 0 is treated as 1
+type UnitConversion struct {
+	Scale  float64
+	Offset float64
 }
 
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
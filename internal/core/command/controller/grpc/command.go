@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/protoconv"
+	edgexpb "github.com/edgexfoundry/edgex-go/proto/edgex/v3"
+)
+
+// CoreCommandServer implements the CoreCommand gRPC service with the application of core-command, which issues the
+// commands to the device services like the CommandController of the REST API
+type CoreCommandServer struct {
+	edgexpb.UnimplementedCoreCommandServer
+	dic *di.Container
+}
+
+// NewCoreCommandServer creates and initializes a CoreCommandServer
+func NewCoreCommandServer(dic *di.Container) *CoreCommandServer {
+	return &CoreCommandServer{dic: dic}
+}
+
+func (s *CoreCommandServer) IssueGetCommand(_ context.Context, req *edgexpb.IssueGetCommandRequest) (*edgexpb.IssueGetCommandResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Set(common.PushEvent, strconv.FormatBool(req.GetPushEvent()))
+	queryParams.Set(common.ReturnEvent, strconv.FormatBool(!req.GetSkipReturnEvent()))
+	response, edgexErr := application.IssueGetCommandByName(req.GetDeviceName(), req.GetCommandName(), queryParams.Encode(), s.dic)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+
+	res := &edgexpb.IssueGetCommandResponse{}
+	if response == nil || req.GetSkipReturnEvent() {
+		return res, nil
+	}
+	event, err := protoconv.FromEventDTO(response.Event)
+	if err != nil {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the event", err))
+	}
+	res.Event = event
+	return res, nil
+}
+
+func (s *CoreCommandServer) IssueSetCommand(_ context.Context, req *edgexpb.IssueSetCommandRequest) (*edgexpb.IssueSetCommandResponse, error) {
+	settings := protoconv.FromStruct(req.GetSettings())
+	if len(settings) == 0 {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindContractInvalid, "settings can not be empty", nil))
+	}
+	if _, err := application.IssueSetCommandByName(req.GetDeviceName(), req.GetCommandName(), "", settings, s.dic); err != nil {
+		return nil, protoconv.Status(err)
+	}
+	return &edgexpb.IssueSetCommandResponse{}, nil
+}
@@ -9,29 +9,36 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/gorilla/mux"
 )
 
 type CommandController struct {
-	dic *di.Container
+	reader io.DtoReader
+	dic    *di.Container
 }
 
 // NewCommandController creates and initializes an CommandController
 func NewCommandController(dic *di.Container) *CommandController {
 	return &CommandController{
-		dic: dic,
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
 	}
 }
 
@@ -107,17 +114,37 @@ func (cc *CommandController) IssueGetCommandByName(w http.ResponseWriter, r *htt
 		return
 	}
 
+	async, err := parseAsyncParameter(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	req := commandDTOs.IssueCommandRequest{
+		DeviceName:  deviceName,
+		CommandName: commandName,
+		Method:      "get",
+		QueryParams: commandQueryParams(r),
+	}
+	if async {
+		cc.issueAsyncCommand(w, r, req)
+		return
+	}
+
+	start := time.Now()
 	response, err := application.IssueGetCommandByName(deviceName, commandName, queryParams, cc.dic)
 	if err != nil {
+		auditCommand(r, req, 0, err, start, cc.dic)
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
 	// encode and send out the response
 	if response != nil {
+		auditCommand(r, req, response.StatusCode, nil, start, cc.dic)
 		utils.WriteHttpHeader(w, ctx, response.StatusCode)
 		pkg.EncodeAndWriteResponse(response, w, lc)
 	} else {
 		// If dsReturnEvent is no, there will be no content returned in the http response
+		auditCommand(r, req, http.StatusOK, nil, start, cc.dic)
 		utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	}
 }
@@ -140,13 +167,167 @@ func (cc *CommandController) IssueSetCommandByName(w http.ResponseWriter, r *htt
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+
+	async, err := parseAsyncParameter(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	req := commandDTOs.IssueCommandRequest{
+		DeviceName:  deviceName,
+		CommandName: commandName,
+		Method:      "set",
+		QueryParams: commandQueryParams(r),
+		Settings:    settings,
+	}
+	if async {
+		cc.issueAsyncCommand(w, r, req)
+		return
+	}
+
+	start := time.Now()
 	response, err := application.IssueSetCommandByName(deviceName, commandName, queryParams, settings, cc.dic)
 	if err != nil {
+		auditCommand(r, req, 0, err, start, cc.dic)
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
+	auditCommand(r, req, response.StatusCode, nil, start, cc.dic)
 
 	utils.WriteHttpHeader(w, ctx, response.StatusCode)
 	// encode and send out the response
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+func (cc *CommandController) IssueBatchCommands(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+
+	var reqDTOs []commandDTOs.IssueCommandRequest
+	err := cc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	responses, err := application.IssueBatchCommands(reqDTOs, restOrigin(r), cc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	// encode and send out the response
+	pkg.EncodeAndWriteResponse(responses, w, lc)
+}
+
+func (cc *CommandController) IssueGroupGetCommand(w http.ResponseWriter, r *http.Request) {
+	cc.issueGroupCommand(w, r, "get", nil)
+}
+
+func (cc *CommandController) IssueGroupSetCommand(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+
+	settings, err := utils.ParseBodyToMap(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, r.Context(), lc, err, "")
+		return
+	}
+	cc.issueGroupCommand(w, r, "set", settings)
+}
+
+// issueGroupCommand issues the command to each member device of the device group and responds with the response of
+// each command
+func (cc *CommandController) issueGroupCommand(w http.ResponseWriter, r *http.Request, method string, settings map[string]any) {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	vars := mux.Vars(r)
+	groupName := vars[common.Name]
+	commandName := vars[common.Command]
+
+	responses, err := application.IssueGroupCommand(ctx, groupName, commandName, method, commandQueryParams(r), settings, restOrigin(r), cc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	// encode and send out the response
+	pkg.EncodeAndWriteResponse(responses, w, lc)
+}
+
+func (cc *CommandController) CommandResultByJobId(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	vars := mux.Vars(r)
+	jobId := vars[pkgCommon.JobId]
+
+	job, err := application.AsyncCommandManagerFrom(cc.dic.Get).Job(jobId)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commandDTOs.NewCommandJobResponse("", "", http.StatusOK, job)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// issueAsyncCommand starts the command in the background and responds with the id of the job used to poll its result
+func (cc *CommandController) issueAsyncCommand(w http.ResponseWriter, r *http.Request, req commandDTOs.IssueCommandRequest) {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+
+	jobId, err := application.AsyncCommandManagerFrom(cc.dic.Get).Issue(req, restOrigin(r))
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseWithIdResponse("", "", http.StatusAccepted, jobId)
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	// encode and send out the response
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func parseAsyncParameter(r *http.Request) (bool, errors.EdgeX) {
+	async := utils.ParseQueryStringToString(r, pkgCommon.CommandAsync, common.ValueFalse)
+	if async != common.ValueTrue && async != common.ValueFalse {
+		return false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid query parameter, %s has to be %s or %s", pkgCommon.CommandAsync, common.ValueTrue, common.ValueFalse), nil)
+	}
+	return async == common.ValueTrue, nil
+}
+
+// commandQueryParams returns the query parameters to forward to the device service, without the cmd-async parameter
+func commandQueryParams(r *http.Request) map[string]string {
+	queryParams := make(map[string]string)
+	for key, values := range r.URL.Query() {
+		if key == pkgCommon.CommandAsync || len(values) == 0 {
+			continue
+		}
+		queryParams[key] = values[0]
+	}
+	return queryParams
+}
+
+func restOrigin(r *http.Request) application.CommandOrigin {
+	return application.CommandOrigin{Source: commandDTOs.AuditSourceREST, Requester: r.RemoteAddr}
+}
+
+// auditCommand publishes the audit event of the command issued synchronously via REST
+func auditCommand(r *http.Request, req commandDTOs.IssueCommandRequest, statusCode int, err errors.EdgeX, start time.Time, dic *di.Container) {
+	if err != nil {
+		application.AuditCommand(restOrigin(r), req, err.Code(), err.Error(), start, dic)
+		return
+	}
+	application.AuditCommand(restOrigin(r), req, statusCode, "", start, dic)
+}
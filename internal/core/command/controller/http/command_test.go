@@ -16,6 +16,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
@@ -494,3 +496,88 @@ func TestIssueSetCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueBatchCommands(t *testing.T) {
+	var nonExistName = "nonExist"
+
+	expectedBaseResponse := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	expectedEventResponse := buildEventResponse()
+	expectedDeviceResponse := buildDeviceResponse()
+	expectedDeviceServiceResponse := buildDeviceServiceResponse()
+	testSettings := buildTestSettings()
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(expectedDeviceResponse, nil)
+	dcMock.On("DeviceByName", context.Background(), nonExistName).Return(responseDTO.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "fail to query device by name", nil))
+
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(expectedDeviceServiceResponse, nil)
+
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", context.Background(), testBaseAddress, testDeviceName, testCommandName, "").Return(&expectedEventResponse, nil)
+	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, testDeviceName, testCommandName, "", testSettings).Return(expectedBaseResponse, nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cc := NewCommandController(dic)
+	assert.NotNil(t, cc)
+
+	validGet := commandDTOs.IssueCommandRequest{BaseRequest: commonDTO.NewBaseRequest(), DeviceName: testDeviceName, CommandName: testCommandName, Method: "get"}
+	validSet := commandDTOs.IssueCommandRequest{BaseRequest: commonDTO.NewBaseRequest(), DeviceName: testDeviceName, CommandName: testCommandName, Method: "set", Settings: testSettings}
+	nonExistDevice := validGet
+	nonExistDevice.DeviceName = nonExistName
+	invalidMethod := validGet
+	invalidMethod.Method = "delete"
+	missingSettings := validSet
+	missingSettings.Settings = nil
+
+	tests := []struct {
+		name                string
+		request             []commandDTOs.IssueCommandRequest
+		expectedStatusCode  int
+		expectedStatusCodes []int
+	}{
+		{"Valid - get and set commands", []commandDTOs.IssueCommandRequest{validGet, validSet}, http.StatusMultiStatus, []int{http.StatusOK, http.StatusOK}},
+		{"Valid - partial failure", []commandDTOs.IssueCommandRequest{validGet, nonExistDevice}, http.StatusMultiStatus, []int{http.StatusOK, http.StatusNotFound}},
+		{"Invalid - invalid method", []commandDTOs.IssueCommandRequest{invalidMethod}, http.StatusBadRequest, nil},
+		{"Invalid - set command without settings", []commandDTOs.IssueCommandRequest{missingSettings}, http.StatusBadRequest, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceCommandsRoute, bytes.NewReader(jsonData))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(cc.IssueBatchCommands)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusMultiStatus {
+				return
+			}
+			var res []commandDTOs.IssueCommandResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, len(testCase.expectedStatusCodes))
+			for i, expectedStatusCode := range testCase.expectedStatusCodes {
+				assert.Equal(t, testCase.request[i].RequestId, res[i].RequestId, "RequestId not as expected")
+				assert.Equal(t, expectedStatusCode, res[i].StatusCode, "Response status code not as expected")
+			}
+			assert.NotNil(t, res[0].Event, "Event should be returned for the get command")
+		})
+	}
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+)
+
+const aclWildcard = "*"
+
+// authorizeExternalRequest checks the external command request against the configured ACL rules and returns an
+// error describing why the request is rejected, or nil when the request is permitted.
+func authorizeExternalRequest(acl config.ExternalACLInfo, topic string, deviceName string, method string) error {
+	if !acl.Enabled {
+		return nil
+	}
+
+	rule, found := matchExternalACLRule(acl.Rules, topic)
+	if !found {
+		return forbiddenError("no ACL rule matches request topic '%s'", topic)
+	}
+
+	if len(rule.Methods) > 0 && !containsFold(rule.Methods, method) {
+		return forbiddenError("method '%s' is not allowed for request topic prefix '%s'", method, rule.TopicPrefix)
+	}
+
+	if contains(rule.DeniedDevices, deviceName) {
+		return forbiddenError("device '%s' is denied for request topic prefix '%s'", deviceName, rule.TopicPrefix)
+	}
+
+	if !contains(rule.AllowedDevices, deviceName) && !contains(rule.AllowedDevices, aclWildcard) {
+		return forbiddenError("device '%s' is not allowed for request topic prefix '%s'", deviceName, rule.TopicPrefix)
+	}
+
+	return nil
+}
+
+// matchExternalACLRule returns the rule with the longest TopicPrefix that matches the specified topic
+func matchExternalACLRule(rules []config.ExternalACLRule, topic string) (config.ExternalACLRule, bool) {
+	var matched config.ExternalACLRule
+	found := false
+	for _, rule := range rules {
+		if !topicHasPrefix(topic, rule.TopicPrefix) {
+			continue
+		}
+		if !found || len(strings.TrimSuffix(rule.TopicPrefix, "/")) > len(strings.TrimSuffix(matched.TopicPrefix, "/")) {
+			matched = rule
+			found = true
+		}
+	}
+
+	return matched, found
+}
+
+// topicHasPrefix checks whether the topic starts with the levels of the prefix, so that the prefix of a device doesn't
+// match the topics of the devices whose names start with its name
+func topicHasPrefix(topic string, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return topic == prefix || strings.HasPrefix(topic, prefix+"/")
+}
+
+func forbiddenError(format string, args ...any) error {
+	return fmt.Errorf("%d %s: %s", http.StatusForbidden, http.StatusText(http.StatusForbidden), fmt.Sprintf(format, args...))
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
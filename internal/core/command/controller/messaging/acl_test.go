@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+)
+
+func TestAuthorizeExternalRequest(t *testing.T) {
+	acl := config.ExternalACLInfo{
+		Enabled: true,
+		Rules: []config.ExternalACLRule{
+			{
+				TopicPrefix:    "edgex/command/request",
+				AllowedDevices: []string{aclWildcard},
+				DeniedDevices:  []string{"restricted-device"},
+			},
+			{
+				TopicPrefix:    "edgex/command/request/dashboard",
+				Methods:        []string{"get"},
+				AllowedDevices: []string{testDeviceName},
+			},
+			{
+				TopicPrefix:    "edgex/command/request/device1/",
+				Methods:        []string{"get"},
+				AllowedDevices: []string{"device1"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		acl           config.ExternalACLInfo
+		topic         string
+		deviceName    string
+		method        string
+		expectedError bool
+	}{
+		{"valid - acl disabled", config.ExternalACLInfo{}, "unknown/topic", testDeviceName, "set", false},
+		{"valid - wildcard allowed device", acl, "edgex/command/request/anyDevice/testCommand/set", "anyDevice", "set", false},
+		{"valid - longest prefix allows get", acl, "edgex/command/request/dashboard/testDevice/testCommand/get", testDeviceName, "get", false},
+		{"valid - device prefix doesn't match a device sharing its name", acl, "edgex/command/request/device10/testCommand/set", "device10", "set", false},
+		{"valid - device prefix doesn't match a device extending its name", acl, "edgex/command/request/device1-admin/testCommand/set", "device1-admin", "set", false},
+		{"valid - dashboard prefix doesn't match a sibling level", acl, "edgex/command/request/dashboard2/testCommand/set", "dashboard2", "set", false},
+		{"invalid - device prefix matches its device", acl, "edgex/command/request/device1/testCommand/set", "device1", "set", true},
+		{"invalid - no matching rule", acl, "unknown/request/testDevice/testCommand/get", testDeviceName, "get", true},
+		{"invalid - denied device", acl, "edgex/command/request/restricted-device/testCommand/get", "restricted-device", "get", true},
+		{"invalid - method not allowed", acl, "edgex/command/request/dashboard/testDevice/testCommand/set", testDeviceName, "set", true},
+		{"invalid - device not allowed", acl, "edgex/command/request/dashboard/otherDevice/testCommand/get", "otherDevice", "get", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeExternalRequest(tt.acl, tt.topic, tt.deviceName, tt.method)
+			if tt.expectedError {
+				assert.ErrorContains(t, err, "403")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
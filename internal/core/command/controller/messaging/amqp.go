@@ -0,0 +1,324 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapMessaging "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/amqp"
+)
+
+const (
+	defaultAMQPRetryInterval = 5 * time.Second
+	defaultAMQPCredit        = 10
+)
+
+// ExternalAMQP receives the command requests and the command query requests from the external AMQP 1.0 broker, and
+// sends their responses back to the broker. The requests are handled like the requests received from the external
+// MQTT broker, the topic of a request being its address followed by its subject. A request message is accepted once
+// its response is sent, so that the broker delivers it again if the connection fails in the meantime.
+type ExternalAMQP struct {
+	requestTimeout time.Duration
+	rateLimiter    *externalRateLimiter
+	dic            *di.Container
+	info           config.ExternalAMQPInfo
+	options        amqp.Options
+}
+
+// amqpSession contains the links of a connection to the external AMQP broker
+type amqpSession struct {
+	ctx             context.Context
+	conn            *amqp.Conn
+	commandReceiver *amqp.Receiver
+	queryReceiver   *amqp.Receiver
+
+	mutex   sync.Mutex
+	senders map[string]*amqp.Sender
+}
+
+// NewExternalAMQP is a factory method that returns an initialized ExternalAMQP receiver struct.
+func NewExternalAMQP(requestTimeout time.Duration, dic *di.Container) *ExternalAMQP {
+	return &ExternalAMQP{
+		requestTimeout: requestTimeout,
+		rateLimiter:    newExternalRateLimiter(container.ConfigurationFrom(dic.Get).ExternalRateLimit),
+		dic:            dic,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It connects to the external AMQP broker and receives the
+// requests until the service stops, connecting again to the broker every ExternalAMQP.RetryInterval when the
+// connection fails.
+func (e *ExternalAMQP) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	e.info = container.ConfigurationFrom(dic.Get).ExternalAMQP
+	if e.info.CommandRequestAddress == "" || e.info.CommandQueryRequestAddress == "" {
+		lc.Error("missing CommandRequestAddress and/or CommandQueryRequestAddress for external AMQP connection. Must be present in [ExternalAMQP] section")
+		return false
+	}
+
+	retryInterval := defaultAMQPRetryInterval
+	if len(e.info.RetryInterval) > 0 {
+		var err error
+		if retryInterval, err = time.ParseDuration(e.info.RetryInterval); err != nil {
+			lc.Errorf("invalid AMQP RetryInterval '%s': %s", e.info.RetryInterval, err.Error())
+			return false
+		}
+	}
+
+	options, err := newAMQPOptions(e.info, dic)
+	if err != nil {
+		lc.Errorf("Invalid external AMQP configuration: %s", err.Error())
+		return false
+	}
+	e.options = options
+
+	for startupTimer.HasNotElapsed() {
+		select {
+		case <-ctx.Done():
+			return false
+		default:
+			session, err := e.connect(ctx)
+			if err != nil {
+				lc.Warnf("Unable to connect to external AMQP broker @ %s: %s", e.info.Url, err.Error())
+				startupTimer.SleepForInterval()
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				e.run(ctx, session, retryInterval)
+			}()
+
+			lc.Infof("Connected to external AMQP broker @ %s with AuthMode='%s'", e.info.Url, e.info.AuthMode)
+			return true
+		}
+	}
+
+	lc.Error("Connecting to external AMQP broker time out")
+	return false
+}
+
+// newAMQPOptions returns the options of the connections to the broker, with the credentials of the secret store when
+// the AuthMode is usernamepassword. The receivers accept the messages up to MaxEnvelopeSize, so that an oversized
+// message is refused while it's received rather than once it's buffered.
+func newAMQPOptions(info config.ExternalAMQPInfo, dic *di.Container) (amqp.Options, error) {
+	options := amqp.Options{
+		ContainerID: info.ContainerId,
+		TLSConfig:   &tls.Config{InsecureSkipVerify: info.SkipCertVerify},
+	}
+	if maxEnvelopeSize := container.ConfigurationFrom(dic.Get).MaxEnvelopeSize; maxEnvelopeSize > 0 {
+		options.MaxMessageSize = uint64(maxEnvelopeSize) * 1024
+	}
+
+	switch info.AuthMode {
+	case "", bootstrapMessaging.AuthModeNone:
+	case bootstrapMessaging.AuthModeUsernamePassword:
+		secretData, err := loadAMQPSecretData(info, dic)
+		if err != nil {
+			return amqp.Options{}, err
+		}
+		options.Username = secretData.Username
+		options.Password = secretData.Password
+	default:
+		return amqp.Options{}, fmt.Errorf("unsupported AuthMode '%s', only '%s' and '%s' are supported", info.AuthMode,
+			bootstrapMessaging.AuthModeNone, bootstrapMessaging.AuthModeUsernamePassword)
+	}
+	return options, nil
+}
+
+func loadAMQPSecretData(info config.ExternalAMQPInfo, dic *di.Container) (*bootstrapMessaging.SecretData, error) {
+	secretProvider := bootstrapContainer.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return nil, errors.New("secret provider not available")
+	}
+	secretData, err := bootstrapMessaging.GetSecretData(info.AuthMode, info.SecretName, secretProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve secret data: %w", err)
+	}
+	if err = bootstrapMessaging.ValidateSecretData(info.AuthMode, info.SecretName, secretData); err != nil {
+		return nil, err
+	}
+	return secretData, nil
+}
+
+// connect connects to the broker and attaches the receivers of the request addresses
+func (e *ExternalAMQP) connect(ctx context.Context) (*amqpSession, error) {
+	connectCtx := ctx
+	if len(e.info.ConnectTimeout) > 0 {
+		timeout, err := time.ParseDuration(e.info.ConnectTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AMQP ConnectTimeout '%s': %s", e.info.ConnectTimeout, err.Error())
+		}
+		var cancel context.CancelFunc
+		connectCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	conn, err := amqp.Dial(connectCtx, e.info.Url, e.options)
+	if err != nil {
+		return nil, err
+	}
+	credit := e.info.Credit
+	if credit == 0 {
+		credit = defaultAMQPCredit
+	}
+	session := &amqpSession{ctx: ctx, conn: conn, senders: make(map[string]*amqp.Sender)}
+	if session.commandReceiver, err = conn.NewReceiver(connectCtx, e.info.CommandRequestAddress, credit); err == nil {
+		session.queryReceiver, err = conn.NewReceiver(connectCtx, e.info.CommandQueryRequestAddress, credit)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return session, nil
+}
+
+// run serves the requests of the session, and of the sessions of the following connections when it fails, until the
+// context is done
+func (e *ExternalAMQP) run(ctx context.Context, session *amqpSession, retryInterval time.Duration) {
+	lc := bootstrapContainer.LoggingClientFrom(e.dic.Get)
+	for {
+		err := e.serve(session)
+		if ctx.Err() != nil {
+			lc.Info("Disconnected from external AMQP broker")
+			return
+		}
+		lc.Warnf("Connection to external AMQP broker '%s' lost: %v", e.info.Url, err)
+
+		for session = nil; session == nil; {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+			if session, err = e.connect(ctx); err != nil {
+				lc.Warnf("Unable to connect to external AMQP broker @ %s: %s", e.info.Url, err.Error())
+			}
+		}
+		lc.Infof("Connected to external AMQP broker @ %s with AuthMode='%s'", e.info.Url, e.info.AuthMode)
+	}
+}
+
+// serve handles the requests received on the session until its connection fails or its context is done, and closes
+// the connection. The requests are handled concurrently, each being accepted once its response is sent.
+func (e *ExternalAMQP) serve(session *amqpSession) error {
+	lc := bootstrapContainer.LoggingClientFrom(e.dic.Get)
+	errs := make(chan error, 2)
+	var handlers sync.WaitGroup
+	receive := func(receiver *amqp.Receiver, handle func(*amqpSession, *amqp.Message)) {
+		for {
+			msg, err := receiver.Receive(session.ctx)
+			if err != nil {
+				errs <- err
+				return
+			}
+			handlers.Add(1)
+			go func() {
+				defer handlers.Done()
+				handle(session, msg)
+				if err := receiver.Accept(msg); err != nil {
+					lc.Warnf("Failed to accept the request message %s received from external AMQP broker: %v", msg.MessageID, err)
+				}
+			}()
+		}
+	}
+	go receive(session.commandReceiver, e.handleCommandRequest)
+	go receive(session.queryReceiver, e.handleCommandQueryRequest)
+
+	err := <-errs
+	_ = session.conn.Close()
+	<-errs
+	handlers.Wait()
+	return err
+}
+
+func (e *ExternalAMQP) handleCommandQueryRequest(session *amqpSession, msg *amqp.Message) {
+	if msg.Subject == "" || strings.Contains(msg.Subject, "/") {
+		lc := bootstrapContainer.LoggingClientFrom(e.dic.Get)
+		externalCommandQueryErrorsCounters[errorTypeInvalidTopic].Inc(1)
+		lc.Errorf("Invalid subject '%s' of the command query request received from external AMQP broker, expected '<device-name>' or '%s'", msg.Subject, common.All)
+		lc.Warn("Not sending error message back due to insufficient information on the request")
+		return
+	}
+	broker := e.newBroker(session, msg, e.info.CommandQueryResponseAddress)
+	handleCommandQueryRequest(broker, common.BuildTopic(e.info.CommandQueryRequestAddress, msg.Subject), msg.Data, e.dic)
+}
+
+func (e *ExternalAMQP) handleCommandRequest(session *amqpSession, msg *amqp.Message) {
+	if strings.Count(msg.Subject, "/") != 2 {
+		lc := bootstrapContainer.LoggingClientFrom(e.dic.Get)
+		externalCommandErrorsCounters[errorTypeInvalidTopic].Inc(1)
+		lc.Errorf("Invalid subject '%s' of the command request received from external AMQP broker, expected '<device-name>/<command-name>/<method>'", msg.Subject)
+		lc.Warn("Not sending error message back due to insufficient information on the request")
+		return
+	}
+	broker := e.newBroker(session, msg, e.info.CommandResponseAddress)
+	handleCommandRequest(broker, common.BuildTopic(e.info.CommandRequestAddress, msg.Subject), msg.Data, e.requestTimeout, e.rateLimiter, e.dic)
+}
+
+// newBroker returns the externalBroker sending the response of the request to its reply-to address, or to the
+// response address when it has none. The subject of the response is its topic without the response address, and its
+// correlation-id is the message-id of the request.
+func (e *ExternalAMQP) newBroker(session *amqpSession, request *amqp.Message, responseAddress string) externalBroker {
+	return externalBroker{
+		system:                     "amqp",
+		auditSource:                commandDTOs.AuditSourceExternalAMQP,
+		queryResponseTopic:         e.info.CommandQueryResponseAddress,
+		commandResponseTopicPrefix: e.info.CommandResponseAddress,
+		publish: func(topic string, payload []byte) error {
+			address := request.ReplyTo
+			if address == "" {
+				address = responseAddress
+			}
+			response := &amqp.Message{
+				MessageID:     uuid.NewString(),
+				CorrelationID: request.MessageID,
+				Subject:       strings.TrimPrefix(strings.TrimPrefix(topic, responseAddress), "/"),
+				Data:          payload,
+			}
+
+			ctx, cancel := context.WithTimeout(session.ctx, e.requestTimeout)
+			defer cancel()
+			sender, err := session.sender(ctx, address)
+			if err != nil {
+				return err
+			}
+			return sender.Send(ctx, response)
+		},
+	}
+}
+
+// sender returns the sender of the address, which is attached on first use
+func (s *amqpSession) sender(ctx context.Context, address string) (*amqp.Sender, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if sender, ok := s.senders[address]; ok {
+		return sender, nil
+	}
+	sender, err := s.conn.NewSender(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	s.senders[address] = sender
+	return sender, nil
+}
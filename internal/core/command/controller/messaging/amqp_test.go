@@ -0,0 +1,167 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	secretMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	bootstrapMessaging "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/amqp"
+)
+
+func newExternalAMQPTestDic(info config.ExternalAMQPInfo, secretProvider *secretMocks.SecretProvider) *di.Container {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{ExternalAMQP: info}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	if secretProvider != nil {
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+				return secretProvider
+			},
+		})
+	}
+	return dic
+}
+
+func TestExternalAMQPBootstrapHandler(t *testing.T) {
+	// the port of a closed listener, to which the connections are refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	unreachableUrl := "amqp://" + listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	valid := config.ExternalAMQPInfo{
+		Enabled:                    true,
+		Url:                        unreachableUrl,
+		ConnectTimeout:             "1s",
+		RetryInterval:              "1s",
+		AuthMode:                   bootstrapMessaging.AuthModeNone,
+		CommandRequestAddress:      "edgex.command.request",
+		CommandResponseAddress:     "edgex.command.response",
+		CommandQueryRequestAddress: "edgex.commandquery.request",
+	}
+	noRequestAddress := valid
+	noRequestAddress.CommandQueryRequestAddress = ""
+	invalidRetryInterval := valid
+	invalidRetryInterval.RetryInterval = "invalid"
+	invalidAuthMode := valid
+	invalidAuthMode.AuthMode = bootstrapMessaging.AuthModeCert
+	noSecretProvider := valid
+	noSecretProvider.AuthMode = bootstrapMessaging.AuthModeUsernamePassword
+
+	tests := []struct {
+		name string
+		info config.ExternalAMQPInfo
+	}{
+		{"missing request address", noRequestAddress},
+		{"invalid retry interval", invalidRetryInterval},
+		{"unsupported auth mode", invalidAuthMode},
+		{"secret provider not available", noSecretProvider},
+		{"broker unreachable", valid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dic := newExternalAMQPTestDic(tt.info, nil)
+			var wg sync.WaitGroup
+			ok := NewExternalAMQP(time.Second, dic).BootstrapHandler(context.Background(), &wg, startup.NewTimer(1, 1), dic)
+			assert.False(t, ok)
+			wg.Wait()
+		})
+	}
+}
+
+func Test_newAMQPOptions(t *testing.T) {
+	secretProvider := &secretMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "amqp").Return(map[string]string{
+		bootstrapMessaging.SecretUsernameKey: "edgex",
+		bootstrapMessaging.SecretPasswordKey: "password",
+	}, nil)
+
+	tests := []struct {
+		name             string
+		authMode         string
+		expectedUsername string
+		expectedPassword string
+		maxEnvelopeSize  int64
+		expectedMaxSize  uint64
+	}{
+		{"no auth mode", "", "", "", 0, 0},
+		{"auth mode none", bootstrapMessaging.AuthModeNone, "", "", 0, 0},
+		{"auth mode usernamepassword", bootstrapMessaging.AuthModeUsernamePassword, "edgex", "password", 0, 0},
+		{"max envelope size", bootstrapMessaging.AuthModeNone, "", "", 2, 2048},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := config.ExternalAMQPInfo{ContainerId: "ex-core-command", SecretName: "amqp", AuthMode: tt.authMode, SkipCertVerify: true}
+			dic := newExternalAMQPTestDic(info, secretProvider)
+			container.ConfigurationFrom(dic.Get).MaxEnvelopeSize = tt.maxEnvelopeSize
+			options, err := newAMQPOptions(info, dic)
+			require.NoError(t, err)
+			assert.Equal(t, "ex-core-command", options.ContainerID)
+			assert.Equal(t, tt.expectedUsername, options.Username)
+			assert.Equal(t, tt.expectedPassword, options.Password)
+			assert.Equal(t, tt.expectedMaxSize, options.MaxMessageSize)
+			assert.True(t, options.TLSConfig.InsecureSkipVerify)
+		})
+	}
+}
+
+func TestExternalAMQP_invalidSubject(t *testing.T) {
+	info := config.ExternalAMQPInfo{
+		CommandRequestAddress:       "edgex.command.request",
+		CommandResponseAddress:      "edgex.command.response",
+		CommandQueryRequestAddress:  "edgex.commandquery.request",
+		CommandQueryResponseAddress: "edgex.commandquery.response",
+	}
+	dic := newExternalAMQPTestDic(info, nil)
+	e := NewExternalAMQP(time.Second, dic)
+	e.info = info
+
+	tests := []struct {
+		name    string
+		query   bool
+		subject string
+	}{
+		{"command request without method", false, "testDevice/testCommand"},
+		{"command request without subject", false, ""},
+		{"command query request without subject", true, ""},
+		{"command query request with command", true, "testDevice/testCommand"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the requests with an invalid subject are dropped without response, the session not being used
+			msg := &amqp.Message{MessageID: "1", Subject: tt.subject}
+			if tt.query {
+				count := externalCommandQueryErrorsCounters[errorTypeInvalidTopic].Count()
+				e.handleCommandQueryRequest(nil, msg)
+				assert.Equal(t, count+1, externalCommandQueryErrorsCounters[errorTypeInvalidTopic].Count())
+				return
+			}
+			count := externalCommandErrorsCounters[errorTypeInvalidTopic].Count()
+			e.handleCommandRequest(nil, msg)
+			assert.Equal(t, count+1, externalCommandErrorsCounters[errorTypeInvalidTopic].Count())
+		})
+	}
+}
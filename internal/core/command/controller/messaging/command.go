@@ -0,0 +1,140 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+// deviceServiceCommandClient sends the commands to the device services via the internal MessageBus request/response
+// path, the same way as the commands received from the MessageBus are forwarded to them
+type deviceServiceCommandClient struct {
+	requestTimeout time.Duration
+	dic            *di.Container
+}
+
+// NewDeviceServiceCommandClient returns the DeviceServiceCommandClient sending the commands to the device services via
+// the internal MessageBus rather than calling their REST API, so that the device services which only communicate via
+// the MessageBus can be commanded via the REST API as well. The base address of the device service is ignored, the
+// request topic being determined from the device. The requests time out with the context, or after requestTimeout if
+// the context has no deadline, and are retried according to the Writable.CommandRetry configuration.
+func NewDeviceServiceCommandClient(requestTimeout time.Duration, dic *di.Container) interfaces.DeviceServiceCommandClient {
+	return &deviceServiceCommandClient{requestTimeout: requestTimeout, dic: dic}
+}
+
+func (c *deviceServiceCommandClient) GetCommand(ctx context.Context, _ string, deviceName string, commandName string, queryParams string) (*responses.EventResponse, errors.EdgeX) {
+	requestEnvelope, err := newDeviceRequestEnvelope(nil, queryParams)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	responseEnvelope, err := c.request(ctx, requestEnvelope, deviceName, commandName, "get")
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	res := responses.EventResponse{BaseResponse: commonDTO.NewBaseResponse(responseEnvelope.RequestID, "", http.StatusOK)}
+	// the device service doesn't return the event when ds-returnevent=false
+	if len(responseEnvelope.Payload) == 0 {
+		return &res, nil
+	}
+	var decodeErr error
+	if responseEnvelope.ContentType == common.ContentTypeCBOR {
+		decodeErr = cbor.Unmarshal(responseEnvelope.Payload, &res)
+	} else {
+		decodeErr = json.Unmarshal(responseEnvelope.Payload, &res)
+	}
+	if decodeErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to decode the get command response", decodeErr)
+	}
+	return &res, nil
+}
+
+func (c *deviceServiceCommandClient) SetCommand(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string, settings map[string]string) (commonDTO.BaseResponse, errors.EdgeX) {
+	objectSettings := make(map[string]interface{}, len(settings))
+	for name, value := range settings {
+		objectSettings[name] = value
+	}
+	return c.SetCommandWithObject(ctx, baseUrl, deviceName, commandName, queryParams, objectSettings)
+}
+
+func (c *deviceServiceCommandClient) SetCommandWithObject(ctx context.Context, _ string, deviceName string, commandName string, queryParams string, settings map[string]interface{}) (commonDTO.BaseResponse, errors.EdgeX) {
+	payload, err := json.Marshal(settings)
+	if err != nil {
+		return commonDTO.BaseResponse{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the set command settings", err)
+	}
+	requestEnvelope, err := newDeviceRequestEnvelope(payload, queryParams)
+	if err != nil {
+		return commonDTO.BaseResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	responseEnvelope, err := c.request(ctx, requestEnvelope, deviceName, commandName, "set")
+	if err != nil {
+		return commonDTO.BaseResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return commonDTO.NewBaseResponse(responseEnvelope.RequestID, "", http.StatusOK), nil
+}
+
+// request sends the command request to the device service of the device and returns the response, the response with
+// an error is returned as an error
+func (c *deviceServiceCommandClient) request(ctx context.Context, requestEnvelope types.MessageEnvelope, deviceName string, commandName string, method string) (*types.MessageEnvelope, errors.EdgeX) {
+	messageBus := bootstrapContainer.MessagingClientFrom(c.dic.Get)
+	if messageBus == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil MessageBus client returned", nil)
+	}
+
+	baseTopic := container.ConfigurationFrom(c.dic.Get).MessageBus.GetBaseTopicPrefix()
+	topicPrefix := common.BuildTopic(baseTopic, common.CoreCommandDeviceRequestPublishTopic)
+	deviceServiceName, deviceRequestTopic, err := validateRequestTopic(ctx, topicPrefix, deviceName, commandName, method, c.dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "invalid request topic", err)
+	}
+	deviceResponseTopicPrefix := common.BuildTopic(baseTopic, common.ResponseTopic, deviceServiceName)
+
+	requestTimeout := c.requestTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		requestTimeout = time.Until(deadline)
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	lc.Debugf("Sending Command Device Request to internal MessageBus. Topic: %s, Correlation-id: %s", deviceRequestTopic, requestEnvelope.CorrelationID)
+	response, err := requestDevice(ctx, messageBus, requestEnvelope, deviceName, commandName, method, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout, c.dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("request to topic '%s' failed", deviceRequestTopic), err)
+	}
+	if response.ErrorCode == 1 {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, string(response.Payload), nil)
+	}
+	return response, nil
+}
+
+// newDeviceRequestEnvelope returns the request envelope of the command with the raw query string as query parameters
+func newDeviceRequestEnvelope(payload []byte, rawQuery string) (types.MessageEnvelope, errors.EdgeX) {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return types.MessageEnvelope{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse query parameters", err)
+	}
+	queryParams := make(map[string]string, len(values))
+	for name := range values {
+		queryParams[name] = values.Get(name)
+	}
+	return types.NewMessageEnvelopeForRequest(payload, queryParams), nil
+}
@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+const (
+	testCommandServiceName = "device-simple"
+	testCommandDeviceName  = "device1"
+)
+
+func newDeviceServiceCommandClientTestDIC(messageBus *mocks.MessageClient) *di.Container {
+	deviceClient := &clientMocks.DeviceClient{}
+	deviceClient.On("DeviceByName", mock.Anything, testCommandDeviceName).Return(
+		responses.DeviceResponse{Device: dtos.Device{Name: testCommandDeviceName, ServiceName: testCommandServiceName}}, nil)
+	deviceServiceClient := &clientMocks.DeviceServiceClient{}
+	deviceServiceClient.On("DeviceServiceByName", mock.Anything, testCommandServiceName).Return(
+		responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: testCommandServiceName}}, nil)
+
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{MessageBus: bootstrapConfig.MessageBusInfo{BaseTopicPrefix: baseTopic}}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return messageBus
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return deviceClient
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return deviceServiceClient
+		},
+	})
+}
+
+func TestDeviceServiceCommandClientGetCommand(t *testing.T) {
+	expectedRequestTopic := common.BuildTopic(baseTopic, common.CoreCommandDeviceRequestPublishTopic, testCommandServiceName, testCommandDeviceName, testCommandName, "get")
+	expectedResponseTopicPrefix := common.BuildTopic(baseTopic, common.ResponseTopic, testCommandServiceName)
+	event := dtos.NewEvent("TestProfile", testCommandDeviceName, testCommandName)
+	eventResponse := responses.NewEventResponse("", "", http.StatusOK, event)
+	eventPayload, err := json.Marshal(eventResponse)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		response      types.MessageEnvelope
+		expectedEvent bool
+		expectedError bool
+	}{
+		{"valid", types.MessageEnvelope{ContentType: common.ContentTypeJSON, Payload: eventPayload}, true, false},
+		{"valid - without event", types.MessageEnvelope{ContentType: common.ContentTypeJSON}, false, false},
+		{"invalid - error response", types.MessageEnvelope{ErrorCode: 1, Payload: []byte("device is locked")}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := tt.response
+			messageBus := &mocks.MessageClient{}
+			messageBus.On("Request", mock.Anything, expectedRequestTopic, expectedResponseTopicPrefix, 5*time.Second).Return(&response, nil)
+			client := NewDeviceServiceCommandClient(5*time.Second, newDeviceServiceCommandClientTestDIC(messageBus))
+
+			res, edgexErr := client.GetCommand(context.Background(), "http://localhost:59900", testCommandDeviceName, testCommandName, "ds-pushevent=true")
+			request, ok := messageBus.Calls[0].Arguments.Get(0).(types.MessageEnvelope)
+			require.True(t, ok)
+			assert.Equal(t, map[string]string{common.PushEvent: common.ValueTrue}, request.QueryParams)
+			if tt.expectedError {
+				require.Error(t, edgexErr)
+				assert.Contains(t, edgexErr.Error(), "device is locked")
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, http.StatusOK, res.StatusCode)
+			if tt.expectedEvent {
+				assert.Equal(t, event.Id, res.Event.Id)
+			} else {
+				assert.Empty(t, res.Event.Id)
+			}
+		})
+	}
+}
+
+func TestDeviceServiceCommandClientSetCommandWithObject(t *testing.T) {
+	expectedRequestTopic := common.BuildTopic(baseTopic, common.CoreCommandDeviceRequestPublishTopic, testCommandServiceName, testCommandDeviceName, testCommandName, "set")
+	messageBus := &mocks.MessageClient{}
+	messageBus.On("Request", mock.Anything, expectedRequestTopic, mock.Anything, mock.Anything).Return(&types.MessageEnvelope{}, nil)
+	client := NewDeviceServiceCommandClient(5*time.Second, newDeviceServiceCommandClientTestDIC(messageBus))
+
+	// the timeout of the context takes precedence over the default request timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res, edgexErr := client.SetCommandWithObject(ctx, "", testCommandDeviceName, testCommandName, "", map[string]any{testCommandName: 1})
+	require.NoError(t, edgexErr)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	request, ok := messageBus.Calls[0].Arguments.Get(0).(types.MessageEnvelope)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"testCommand": 1}`, string(request.Payload))
+	assert.LessOrEqual(t, messageBus.Calls[0].Arguments.Get(3).(time.Duration), time.Second)
+}
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	pkgEnvelope "github.com/edgexfoundry/edgex-go/internal/pkg/envelope"
+)
+
+// envelopeFormat is the format of the MessageEnvelope received from the external MQTT broker, which the response is
+// sent with as well
+type envelopeFormat struct {
+	// encoding of the envelope, JSON or CBOR
+	encoding string
+	// apiVersion of the envelope and of its payload
+	apiVersion string
+}
+
+// defaultEnvelopeFormat is the format of the responses to the requests which aren't decoded
+var defaultEnvelopeFormat = envelopeFormat{encoding: common.ContentTypeJSON, apiVersion: common.ApiVersion}
+
+// decodeExternalEnvelope decodes the MessageEnvelope received from the external MQTT broker. The envelope may be
+// encoded either as JSON or as CBOR, and its payload may be either JSON or CBOR. The envelope of a supported ApiVersion
+// other than the current one is converted to the current one. The encoding and the ApiVersion of the envelope are
+// returned so that the response can be sent in the same format the requester used.
+func decodeExternalEnvelope(message []byte) (types.MessageEnvelope, envelopeFormat, error) {
+	var envelope types.MessageEnvelope
+	format := envelopeFormat{encoding: common.ContentTypeJSON}
+	if json.Valid(message) {
+		if err := json.Unmarshal(message, &envelope); err != nil {
+			return types.MessageEnvelope{}, format, err
+		}
+	} else {
+		format.encoding = common.ContentTypeCBOR
+		if err := cbor.Unmarshal(message, &envelope); err != nil {
+			return types.MessageEnvelope{}, format, fmt.Errorf("failed to decode MessageEnvelope as JSON or CBOR: %v", err)
+		}
+	}
+
+	apiVersion, err := pkgEnvelope.Upgrade(&envelope)
+	if err != nil {
+		return types.MessageEnvelope{}, format, err
+	}
+	format.apiVersion = apiVersion
+
+	if err := validateExternalEnvelope(&envelope); err != nil {
+		return types.MessageEnvelope{}, format, err
+	}
+
+	return envelope, format, nil
+}
+
+// validateExternalEnvelope applies the same validation as types.NewMessageEnvelopeFromJSON while also accepting
+// CBOR encoded payloads. The missing CorrelationID is generated by correlateExternalRequest rather than here, so that
+// the generated ones are logged and counted.
+func validateExternalEnvelope(envelope *types.MessageEnvelope) error {
+	if _, err := uuid.Parse(envelope.RequestID); err != nil {
+		return fmt.Errorf("error parsing RequestID: %s", err.Error())
+	}
+
+	if envelope.CorrelationID != "" {
+		if _, err := uuid.Parse(envelope.CorrelationID); err != nil {
+			return fmt.Errorf("error parsing CorrelationID: %s", err.Error())
+		}
+	}
+
+	if envelope.ContentType != common.ContentTypeJSON && envelope.ContentType != common.ContentTypeCBOR {
+		return fmt.Errorf("ContentType is not %s or %s", common.ContentTypeJSON, common.ContentTypeCBOR)
+	}
+
+	if envelope.QueryParams == nil {
+		envelope.QueryParams = make(map[string]string)
+	}
+
+	return nil
+}
+
+// encodeExternalEnvelope converts the MessageEnvelope to the ApiVersion of the format and encodes it using the encoding
+// of the format, JSON is used by default
+func encodeExternalEnvelope(envelope types.MessageEnvelope, format envelopeFormat) ([]byte, error) {
+	if err := pkgEnvelope.Downgrade(&envelope, format.apiVersion); err != nil {
+		return nil, err
+	}
+
+	if format.encoding == common.ContentTypeCBOR {
+		return cbor.Marshal(&envelope)
+	}
+
+	return json.Marshal(&envelope)
+}
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	pkgEnvelope "github.com/edgexfoundry/edgex-go/internal/pkg/envelope"
+)
+
+func TestDecodeExternalEnvelope(t *testing.T) {
+	validJSONEnvelope := testCommandRequestPayload()
+	validCBOREnvelope := testCommandRequestPayload()
+	validCBOREnvelope.ContentType = common.ContentTypeCBOR
+	validCBOREnvelope.Payload = []byte{0xa1, 0x61, 0x61, 0x01}
+	v2Envelope := testCommandRequestPayload()
+	v2Envelope.ApiVersion = pkgEnvelope.ApiVersionV2
+	invalidApiVersion := testCommandRequestPayload()
+	invalidApiVersion.ApiVersion = "v1"
+	invalidContentType := testCommandRequestPayload()
+	invalidContentType.ContentType = common.ContentTypeXML
+
+	tests := []struct {
+		name               string
+		envelope           types.MessageEnvelope
+		encoding           string
+		expectedEncoding   string
+		expectedApiVersion string
+		expectedError      bool
+	}{
+		{"valid - JSON envelope with JSON payload", validJSONEnvelope, common.ContentTypeJSON, common.ContentTypeJSON, common.ApiVersion, false},
+		{"valid - JSON envelope with CBOR payload", validCBOREnvelope, common.ContentTypeJSON, common.ContentTypeJSON, common.ApiVersion, false},
+		{"valid - CBOR envelope with JSON payload", validJSONEnvelope, common.ContentTypeCBOR, common.ContentTypeCBOR, common.ApiVersion, false},
+		{"valid - CBOR envelope with CBOR payload", validCBOREnvelope, common.ContentTypeCBOR, common.ContentTypeCBOR, common.ApiVersion, false},
+		{"valid - v2 envelope", v2Envelope, common.ContentTypeJSON, common.ContentTypeJSON, pkgEnvelope.ApiVersionV2, false},
+		{"invalid - unsupported api version", invalidApiVersion, common.ContentTypeCBOR, common.ContentTypeCBOR, "", true},
+		{"invalid - unsupported content type", invalidContentType, common.ContentTypeJSON, common.ContentTypeJSON, common.ApiVersion, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var message []byte
+			var err error
+			if tt.encoding == common.ContentTypeCBOR {
+				message, err = cbor.Marshal(tt.envelope)
+			} else {
+				message, err = json.Marshal(tt.envelope)
+			}
+			require.NoError(t, err)
+
+			result, format, err := decodeExternalEnvelope(message)
+			assert.Equal(t, tt.expectedEncoding, format.encoding)
+			assert.Equal(t, tt.expectedApiVersion, format.apiVersion)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, result.ApiVersion)
+			assert.Equal(t, tt.envelope.RequestID, result.RequestID)
+			assert.Equal(t, tt.envelope.ContentType, result.ContentType)
+			assert.Equal(t, tt.envelope.Payload, result.Payload)
+		})
+	}
+}
+
+func TestEncodeExternalEnvelope(t *testing.T) {
+	envelope := testCommandRequestPayload()
+
+	jsonBytes, err := encodeExternalEnvelope(envelope, envelopeFormat{encoding: common.ContentTypeJSON, apiVersion: common.ApiVersion})
+	require.NoError(t, err)
+	assert.True(t, json.Valid(jsonBytes))
+
+	cborBytes, err := encodeExternalEnvelope(envelope, envelopeFormat{encoding: common.ContentTypeCBOR, apiVersion: common.ApiVersion})
+	require.NoError(t, err)
+	var decoded types.MessageEnvelope
+	require.NoError(t, cbor.Unmarshal(cborBytes, &decoded))
+	assert.Equal(t, envelope.RequestID, decoded.RequestID)
+
+	// the response is sent with the ApiVersion of the request
+	v2Bytes, err := encodeExternalEnvelope(envelope, envelopeFormat{encoding: common.ContentTypeJSON, apiVersion: pkgEnvelope.ApiVersionV2})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(v2Bytes, &decoded))
+	assert.Equal(t, pkgEnvelope.ApiVersionV2, decoded.ApiVersion)
+}
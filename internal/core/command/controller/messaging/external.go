@@ -7,7 +7,7 @@
 package messaging
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -18,10 +18,15 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	edgexErr "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/google/uuid"
 
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 func OnConnectHandler(requestTimeout time.Duration, dic *di.Container) mqtt.OnConnectHandler {
@@ -47,137 +52,303 @@ func OnConnectHandler(requestTimeout time.Duration, dic *di.Container) mqtt.OnCo
 	}
 }
 
-func commandQueryHandler(dic *di.Container) mqtt.MessageHandler {
-	return func(client mqtt.Client, message mqtt.Message) {
-		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
-		lc.Debugf("Received command query request from external message broker on topic '%s' with %d bytes", message.Topic(), len(message.Payload()))
+// externalBroker is the external message broker from which the command requests are received, and to which their
+// responses are published
+type externalBroker struct {
+	// system is the messaging system of the broker recorded in the spans, i.e. "mqtt"
+	system string
+	// auditSource is the Source of the CommandAuditEvents of the command requests
+	auditSource string
+	// queryResponseTopic is the topic of the command query responses
+	queryResponseTopic string
+	// commandResponseTopicPrefix is the prefix of the topics of the command responses
+	commandResponseTopicPrefix string
+	publish                    func(topic string, payload []byte) error
+}
 
-		requestEnvelope, err := types.NewMessageEnvelopeFromJSON(message.Payload())
-		if err != nil {
-			lc.Errorf("Failed to decode request MessageEnvelope: %s", err.Error())
-			lc.Warn("Not publishing error message back due to insufficient information on response topic")
-			return
-		}
+// newExternalMQTTBroker returns the externalBroker publishing the responses with the MQTT client and the QoS, retain
+// flag and response topics of the ExternalMQTT configuration
+func newExternalMQTTBroker(client mqtt.Client, dic *di.Container) externalBroker {
+	externalMQTTInfo := container.ConfigurationFrom(dic.Get).ExternalMQTT
+	return externalBroker{
+		system:                     "mqtt",
+		auditSource:                commandDTOs.AuditSourceExternalMQTT,
+		queryResponseTopic:         externalMQTTInfo.Topics[common.ExternalCommandQueryResponseTopicKey],
+		commandResponseTopicPrefix: externalMQTTInfo.Topics[common.ExternalCommandResponseTopicPrefixKey],
+		publish: func(topic string, payload []byte) error {
+			token := client.Publish(topic, externalMQTTInfo.QoS, externalMQTTInfo.Retain, payload)
+			token.Wait()
+			return token.Error()
+		},
+	}
+}
 
-		externalMQTTInfo := container.ConfigurationFrom(dic.Get).ExternalMQTT
-		responseTopic := externalMQTTInfo.Topics[common.ExternalCommandQueryResponseTopicKey]
-		if responseTopic == "" {
-			lc.Error("QueryResponseTopic not provided in External.Topics")
-			lc.Warn("Not publishing error message back due to insufficient information on response topic")
-			return
-		}
+func commandQueryHandler(dic *di.Container) mqtt.MessageHandler {
+	return func(client mqtt.Client, message mqtt.Message) {
+		handleCommandQueryRequest(newExternalMQTTBroker(client, dic), message.Topic(), message.Payload(), dic)
+	}
+}
 
-		// example topic scheme: edgex/commandquery/request/<device-name>
-		// deviceName is expected to be at last topic level.
-		topicLevels := strings.Split(message.Topic(), "/")
-		deviceName := topicLevels[len(topicLevels)-1]
-		if strings.EqualFold(deviceName, common.All) {
-			deviceName = common.All
-		}
+func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt.MessageHandler {
+	rateLimiter := newExternalRateLimiter(container.ConfigurationFrom(dic.Get).ExternalRateLimit)
+	return func(client mqtt.Client, message mqtt.Message) {
+		handleCommandRequest(newExternalMQTTBroker(client, dic), message.Topic(), message.Payload(), requestTimeout, rateLimiter, dic)
+	}
+}
 
-		responseEnvelope, err := getCommandQueryResponseEnvelope(requestEnvelope, deviceName, dic)
-		if err != nil {
-			responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
-		}
+// handleCommandQueryRequest handles the command query request received from the external broker on the topic, and
+// publishes its response to the broker
+func handleCommandQueryRequest(broker externalBroker, topic string, payload []byte, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	lc.Debugf("Received command query request from external message broker on topic '%s' with %d bytes", topic, len(payload))
+	externalCommandQueryRequestsCounter.Inc(1)
+
+	received := time.Now()
+	defer externalCommandQueryLatencyTimer.UpdateSince(received)
+
+	responseTopic := broker.queryResponseTopic
+	if responseTopic == "" {
+		lc.Error("QueryResponseTopic not provided in External.Topics")
+		lc.Warn("Not publishing error message back due to insufficient information on response topic")
+		return
+	}
 
-		qos := externalMQTTInfo.QoS
-		retain := externalMQTTInfo.Retain
+	if err := checkEnvelopeSize(len(payload), dic); err != nil {
+		externalCommandQueryErrorsCounters[errorTypeTooLarge].Inc(1)
+		responseEnvelope := types.NewMessageEnvelopeWithError("", err.Error())
 		responseEnvelope.ReceivedTopic = responseTopic
-		publishMessage(client, responseTopic, qos, retain, responseEnvelope, lc)
+		publishMessage(broker, responseTopic, responseEnvelope, defaultEnvelopeFormat, lc)
+		return
+	}
+	requestEnvelope, format, err := decodeExternalEnvelope(payload)
+	if err != nil {
+		externalCommandQueryErrorsCounters[errorTypeDecode].Inc(1)
+		lc.Errorf("Failed to decode request MessageEnvelope: %s", err.Error())
+		lc.Warn("Not publishing error message back due to insufficient information on response topic")
+		return
+	}
+	correlateExternalRequest(&requestEnvelope, topic, lc)
+	_, span := tracing.TracerFrom(dic.Get).StartMessageSpan(context.Background(), tracing.SpanKindConsumer, broker.system, topic, requestEnvelope)
+	defer span.End()
+	delete(requestEnvelope.QueryParams, tracing.TraceParentHeader)
+
+	// example topic scheme: edgex/commandquery/request/<device-name>
+	// deviceName is expected to be at last topic level.
+	topicLevels := strings.Split(topic, "/")
+	deviceName := topicLevels[len(topicLevels)-1]
+	if strings.EqualFold(deviceName, common.All) {
+		deviceName = common.All
 	}
+
+	responseEnvelope, err := getCommandQueryResponseEnvelope(requestEnvelope, deviceName, dic)
+	if err != nil {
+		span.SetError(err)
+		externalCommandQueryErrorsCounters[errorTypeQuery].Inc(1)
+		responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+	}
+
+	responseEnvelope.ReceivedTopic = responseTopic
+	correlateExternalResponse(&responseEnvelope, requestEnvelope, span)
+	publishMessage(broker, responseTopic, responseEnvelope, format, lc)
+	lc.Debugf("Command query request completed in %s. Request-id: %s, Correlation-id: %s", time.Since(received), requestEnvelope.RequestID, requestEnvelope.CorrelationID)
 }
 
-func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt.MessageHandler {
-	return func(client mqtt.Client, message mqtt.Message) {
-		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
-		lc.Debugf("Received command request from external message broker on topic '%s' with %d bytes", message.Topic(), len(message.Payload()))
+// handleCommandRequest handles the command request received from the external broker on the topic, and publishes its
+// response to the broker
+func handleCommandRequest(broker externalBroker, topic string, payload []byte, requestTimeout time.Duration,
+	rateLimiter *externalRateLimiter, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	lc.Debugf("Received command request from external message broker on topic '%s' with %d bytes", topic, len(payload))
+	externalCommandRequestsCounter.Inc(1)
+	received := time.Now()
+	defer externalCommandLatencyTimer.UpdateSince(received)
+
+	topicLevels := strings.Split(topic, "/")
+	length := len(topicLevels)
+	if length < 3 {
+		externalCommandErrorsCounters[errorTypeInvalidTopic].Inc(1)
+		lc.Error("Failed to parse and construct response topic scheme, expected request topic scheme: '#/<device-name>/<command-name>/<method>")
+		lc.Warn("Not publishing error message back due to insufficient information on response topic")
+		return
+	}
 
-		externalMQTTInfo := container.ConfigurationFrom(dic.Get).ExternalMQTT
-		qos := externalMQTTInfo.QoS
-		retain := externalMQTTInfo.Retain
+	// expected external command request/response topic scheme: #/<device-name>/<command-name>/<method>
+	deviceName := topicLevels[length-3]
+	commandName := topicLevels[length-2]
+	unescapedCommandName, err := url.QueryUnescape(commandName)
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeInvalidTopic].Inc(1)
+		lc.Errorf("Failed to unescape command name '%s': %s", commandName, err.Error())
+		lc.Warn("Not publishing error message back due to insufficient information on response topic")
+		return
+	}
+	method := topicLevels[length-1]
+	if !strings.EqualFold(method, "get") && !strings.EqualFold(method, "set") {
+		externalCommandErrorsCounters[errorTypeInvalidTopic].Inc(1)
+		lc.Errorf("Unknown request method: %s, only 'get' or 'set' is allowed", method)
+		lc.Warn("Not publishing error message back due to insufficient information on response topic")
+		return
+	}
 
-		requestEnvelope, err := types.NewMessageEnvelopeFromJSON(message.Payload())
-		if err != nil {
-			lc.Errorf("Failed to decode request MessageEnvelope: %s", err.Error())
-			lc.Warn("Not publishing error message back due to insufficient information on response topic")
-			return
-		}
+	externalResponseTopic := common.BuildTopic(broker.commandResponseTopicPrefix, deviceName, commandName, method)
 
-		topicLevels := strings.Split(message.Topic(), "/")
-		length := len(topicLevels)
-		if length < 3 {
-			lc.Error("Failed to parse and construct response topic scheme, expected request topic scheme: '#/<device-name>/<command-name>/<method>")
-			lc.Warn("Not publishing error message back due to insufficient information on response topic")
-			return
-		}
+	// the oversized requests are rejected before being decoded
+	if err = checkEnvelopeSize(len(payload), dic); err != nil {
+		externalCommandErrorsCounters[errorTypeTooLarge].Inc(1)
+		responseEnvelope := types.NewMessageEnvelopeWithError("", err.Error())
+		publishMessage(broker, externalResponseTopic, responseEnvelope, defaultEnvelopeFormat, lc)
+		return
+	}
+	requestEnvelope, format, err := decodeExternalEnvelope(payload)
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeDecode].Inc(1)
+		lc.Errorf("Failed to decode request MessageEnvelope: %s", err.Error())
+		lc.Warn("Not publishing error message back due to insufficient information on response topic")
+		return
+	}
+	correlateExternalRequest(&requestEnvelope, topic, lc)
+	ctx, span := tracing.TracerFrom(dic.Get).StartMessageSpan(context.Background(), tracing.SpanKindConsumer, broker.system, topic, requestEnvelope)
+	defer span.End()
+	// the traceparent is only meant for core-command, the device service request being traced with the span
+	delete(requestEnvelope.QueryParams, tracing.TraceParentHeader)
+
+	respond := func(responseEnvelope types.MessageEnvelope) {
+		correlateExternalResponse(&responseEnvelope, requestEnvelope, span)
+		publishMessage(broker, externalResponseTopic, responseEnvelope, format, lc)
+		lc.Debugf("Command request completed in %s. Request-id: %s, Correlation-id: %s", time.Since(received), requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+	}
 
-		// expected external command request/response topic scheme: #/<device-name>/<command-name>/<method>
-		deviceName := topicLevels[length-3]
-		commandName := topicLevels[length-2]
-		_, err = url.QueryUnescape(topicLevels[length-2])
-		if err != nil {
-			lc.Errorf("Failed to unescape command name '%s': %s", commandName, err.Error())
-			lc.Warn("Not publishing error message back due to insufficient information on response topic")
-			return
-		}
-		method := topicLevels[length-1]
-		if !strings.EqualFold(method, "get") && !strings.EqualFold(method, "set") {
-			lc.Errorf("Unknown request method: %s, only 'get' or 'set' is allowed", method)
-			lc.Warn("Not publishing error message back due to insufficient information on response topic")
-			return
+	err = authorizeExternalRequest(container.ConfigurationFrom(dic.Get).ExternalACL, topic, deviceName, method)
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeUnauthorized].Inc(1)
+		respond(types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error()))
+		return
+	}
+
+	internalBaseTopic := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
+	topicPrefix := common.BuildTopic(internalBaseTopic, common.CoreCommandDeviceRequestPublishTopic)
+
+	deviceServiceName, deviceRequestTopic, err := validateRequestTopic(ctx, topicPrefix, deviceName, commandName, method, dic)
+	if err != nil {
+		// set commands may target a group of devices by device group, device profile or device label name instead
+		// of a device, which is only resolved when no device has the name
+		if strings.EqualFold(method, "set") && edgexErr.Kind(err) == edgexErr.KindEntityDoesNotExist {
+			if groupDevices, groupErr := resolveDeviceGroup(deviceName, dic); groupErr == nil {
+				lc.Debugf("Issuing set command '%s' to %d devices of group '%s'", unescapedCommandName, len(groupDevices), deviceName)
+				responseEnvelope := issueGroupSetCommand(requestEnvelope, groupDevices, unescapedCommandName, broker.auditSource, topic, rateLimiter, dic)
+				responseEnvelope.ReceivedTopic = externalResponseTopic
+				respond(responseEnvelope)
+				return
+			}
 		}
+		externalCommandErrorsCounters[errorTypeInvalidRequest].Inc(1)
+		respond(types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error()))
+		return
+	}
 
-		externalResponseTopic := common.BuildTopic(externalMQTTInfo.Topics[common.ExternalCommandResponseTopicPrefixKey], deviceName, commandName, method)
+	err = rateLimiter.allow(deviceName, time.Now())
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeRateLimited].Inc(1)
+		respond(types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error()))
+		return
+	}
 
-		internalBaseTopic := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
-		topicPrefix := common.BuildTopic(internalBaseTopic, common.CoreCommandDeviceRequestPublishTopic)
+	err = transformCommandRequest(&requestEnvelope, deviceName, unescapedCommandName, method, dic)
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeInvalidRequest].Inc(1)
+		respond(types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error()))
+		return
+	}
 
-		deviceServiceName, deviceRequestTopic, err := validateRequestTopic(topicPrefix, deviceName, commandName, method, dic)
-		if err != nil {
-			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
-			publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
-			return
-		}
+	err = validateGetCommandQueryParameters(requestEnvelope.QueryParams)
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeInvalidRequest].Inc(1)
+		respond(types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error()))
+		return
+	}
 
-		err = validateGetCommandQueryParameters(requestEnvelope.QueryParams)
-		if err != nil {
-			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
-			publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
-			return
-		}
+	err = validateSetCommandPayload(requestEnvelope, deviceName, unescapedCommandName, method, dic)
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeInvalidRequest].Inc(1)
+		respond(types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error()))
+		return
+	}
 
-		deviceResponseTopicPrefix := common.BuildTopic(internalBaseTopic, common.ResponseTopic, deviceServiceName)
+	commandTimeout, err := resolveCommandTimeout(requestEnvelope, deviceName, unescapedCommandName, requestTimeout, dic)
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeInvalidRequest].Inc(1)
+		respond(types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error()))
+		return
+	}
 
-		lc.Debugf("Sending Command request to internal MessageBus. Topic: %s, Request-id: %s Correlation-id: %s", deviceRequestTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
-		lc.Debugf("Expecting response on topic: %s/%s", deviceResponseTopicPrefix, requestEnvelope.RequestID)
+	deviceResponseTopicPrefix := common.BuildTopic(internalBaseTopic, common.ResponseTopic, deviceServiceName)
+
+	lc.Debugf("Sending Command request to internal MessageBus. Topic: %s, Request-id: %s Correlation-id: %s", deviceRequestTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+	lc.Debugf("Expecting response on topic: %s/%s", deviceResponseTopicPrefix, requestEnvelope.RequestID)
+
+	internalMessageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+
+	origin := application.CommandOrigin{Source: broker.auditSource, Requester: topic}
+	start := time.Now()
+	// Request waits for the response and returns it.
+	response, err := requestDevice(ctx, internalMessageBus, requestEnvelope, deviceName, unescapedCommandName, method, deviceRequestTopic, deviceResponseTopicPrefix, commandTimeout, dic)
+	externalCommandDeviceRequestLatencyTimer.UpdateSince(start)
+	auditCommandRequest(origin, requestEnvelope, deviceName, unescapedCommandName, method, response, err, start, dic)
+	if err != nil {
+		span.SetError(err)
+		externalCommandErrorsCounters[errorTypeDeviceRequest].Inc(1)
+		errorMessage := fmt.Sprintf("Failed to send DeviceCommand request with internal MessageBus: %v", err)
+		respond(types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, errorMessage))
+		return
+	}
 
-		internalMessageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+	lc.Debugf("Command response received from internal MessageBus. Topic: %s, Request-id: %s Correlation-id: %s", response.ReceivedTopic, response.RequestID, response.CorrelationID)
+	if response.ErrorCode == 1 {
+		externalCommandErrorsCounters[errorTypeDeviceResponse].Inc(1)
+	}
 
-		// Request waits for the response and returns it.
-		response, err := internalMessageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
-		if err != nil {
-			errorMessage := fmt.Sprintf("Failed to send DeviceCommand request with internal MessageBus: %v", err)
-			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, errorMessage)
-			publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
-			return
-		}
+	response.ReceivedTopic = externalResponseTopic
+	respond(*response)
+}
 
-		lc.Debugf("Command response received from internal MessageBus. Topic: %s, Request-id: %s Correlation-id: %s", response.ReceivedTopic, response.RequestID, response.CorrelationID)
+// correlateExternalRequest generates the CorrelationID of the external request received without one, so that the logs,
+// the internal MessageBus requests, the audit events and the response of the request are correlated
+func correlateExternalRequest(requestEnvelope *types.MessageEnvelope, topic string, lc logger.LoggingClient) {
+	if requestEnvelope.CorrelationID != "" {
+		return
+	}
+	requestEnvelope.CorrelationID = uuid.NewString()
+	externalCorrelationIdsGeneratedCounter.Inc(1)
+	lc.Debugf("Generated Correlation-id %s for the request received on topic '%s'. Request-id: %s", requestEnvelope.CorrelationID, topic, requestEnvelope.RequestID)
+}
 
-		response.ReceivedTopic = externalResponseTopic
-		publishMessage(client, externalResponseTopic, qos, retain, *response, lc)
+// correlateExternalResponse sets the CorrelationID of the external request to its response, as the error responses
+// are created with a new one, and the traceparent of the span of the request so that the requester can continue the
+// trace
+func correlateExternalResponse(responseEnvelope *types.MessageEnvelope, requestEnvelope types.MessageEnvelope, span *tracing.Span) {
+	responseEnvelope.CorrelationID = requestEnvelope.CorrelationID
+	if span == nil {
+		return
+	}
+	if responseEnvelope.QueryParams == nil {
+		responseEnvelope.QueryParams = make(map[string]string)
 	}
+	responseEnvelope.QueryParams[tracing.TraceParentHeader] = span.Context().TraceParent()
 }
 
-func publishMessage(client mqtt.Client, responseTopic string, qos byte, retain bool, message types.MessageEnvelope, lc logger.LoggingClient) {
+func publishMessage(broker externalBroker, responseTopic string, message types.MessageEnvelope, format envelopeFormat, lc logger.LoggingClient) {
 	if message.ErrorCode == 1 {
 		lc.Error(string(message.Payload))
 	}
 
-	envelopeBytes, _ := json.Marshal(&message)
+	envelopeBytes, err := encodeExternalEnvelope(message, format)
+	if err != nil {
+		lc.Errorf("Could not encode response MessageEnvelope as %s %s: %s", format.encoding, format.apiVersion, err.Error())
+		return
+	}
 
-	if token := client.Publish(responseTopic, qos, retain, envelopeBytes); token.Wait() && token.Error() != nil {
-		lc.Errorf("Could not publish to external message broker on topic '%s': %s", responseTopic, token.Error())
+	if err = broker.publish(responseTopic, envelopeBytes); err != nil {
+		lc.Errorf("Could not publish to external message broker on topic '%s': %s", responseTopic, err)
 	} else {
 		lc.Debugf("Published response message to external message broker on topic '%s' with %d bytes", responseTopic, len(envelopeBytes))
 	}
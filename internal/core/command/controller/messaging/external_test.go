@@ -15,12 +15,15 @@ import (
 	"time"
 
 	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	lcMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger/mocks"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	edgexErr "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -33,6 +36,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 const (
@@ -153,6 +157,7 @@ func Test_commandQueryHandler(t *testing.T) {
 	lc.On("Error", mock.Anything).Return(nil)
 	lc.On("Errorf", mock.Anything, mock.Anything).Return(nil)
 	lc.On("Debugf", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	lc.On("Debugf", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	lc.On("Warn", mock.Anything).Return(nil)
 	dc := &clientMocks.DeviceClient{}
 	dc.On("AllDevices", context.Background(), []string(nil), common.DefaultOffset, common.DefaultLimit).Return(allDevicesResponse, nil)
@@ -275,12 +280,12 @@ func Test_commandRequestHandler(t *testing.T) {
 	lc.On("Debugf", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	lc.On("Warn", mock.Anything).Return(nil)
 	dc := &clientMocks.DeviceClient{}
-	dc.On("DeviceByName", context.Background(), testDeviceName).Return(deviceResponse, nil)
-	dc.On("DeviceByName", context.Background(), unknownDevice).Return(responses.DeviceResponse{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "unknown device", nil))
-	dc.On("DeviceByName", context.Background(), unknownServiceDevice).Return(unknownServiceDeviceResponse, nil)
+	dc.On("DeviceByName", mock.Anything, testDeviceName).Return(deviceResponse, nil)
+	dc.On("DeviceByName", mock.Anything, unknownDevice).Return(responses.DeviceResponse{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "unknown device", nil))
+	dc.On("DeviceByName", mock.Anything, unknownServiceDevice).Return(unknownServiceDeviceResponse, nil)
 	dsc := &clientMocks.DeviceServiceClient{}
-	dsc.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(deviceServiceResponse, nil)
-	dsc.On("DeviceServiceByName", context.Background(), unknownService).Return(responses.DeviceServiceResponse{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "unknown device service", nil))
+	dsc.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).Return(deviceServiceResponse, nil)
+	dsc.On("DeviceServiceByName", mock.Anything, unknownService).Return(responses.DeviceServiceResponse{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "unknown device service", nil))
 	client := &internalMessagingMocks.MessageClient{}
 	client.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(expectedResponse, nil)
 	dic := di.NewContainer(di.ServiceConstructorMap{
@@ -374,6 +379,103 @@ func Test_commandRequestHandler(t *testing.T) {
 	}
 }
 
+func Test_commandRequestHandlerTooLarge(t *testing.T) {
+	lc := &lcMocks.LoggingClient{}
+	lc.On("Error", mock.Anything).Return(nil)
+	lc.On("Debugf", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	client := &internalMessagingMocks.MessageClient{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				MaxEnvelopeSize: 1,
+				ExternalMQTT: bootstrapConfig.ExternalMQTTInfo{
+					Retain: true,
+					Topics: map[string]string{
+						common.ExternalCommandResponseTopicPrefixKey: testExternalCommandResponseTopicPrefix,
+					},
+				},
+			}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return lc
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return client
+		},
+	})
+
+	payload := testCommandRequestPayload()
+	payload.Payload = make([]byte, 1024)
+	payloadBytes, err := json.Marshal(payload)
+	require.NoError(t, err)
+	message := &mocks.Message{}
+	message.On("Payload").Return(payloadBytes)
+	message.On("Topic").Return(testExternalCommandRequestTopicExample)
+	token := &mocks.Token{}
+	token.On("Wait").Return(true)
+	token.On("Error").Return(nil)
+	var published []byte
+	mqttClient := &mocks.Client{}
+	mqttClient.On("Publish", mock.Anything, byte(0), true, mock.Anything).Run(func(args mock.Arguments) {
+		published = args.Get(3).([]byte)
+	}).Return(token)
+
+	commandRequestHandler(time.Second, dic)(mqttClient, message)
+
+	expectedResponseTopic := common.BuildTopic(testExternalCommandResponseTopicPrefix, testDeviceName, testCommandName, testMethod)
+	mqttClient.AssertCalled(t, "Publish", expectedResponseTopic, byte(0), true, mock.Anything)
+	var responseEnvelope types.MessageEnvelope
+	require.NoError(t, json.Unmarshal(published, &responseEnvelope))
+	assert.Equal(t, 1, responseEnvelope.ErrorCode)
+	assert.Contains(t, string(responseEnvelope.Payload), "MaxEnvelopeSize")
+	client.AssertNotCalled(t, "Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCorrelateExternalRequest(t *testing.T) {
+	lc := &lcMocks.LoggingClient{}
+	lc.On("Debugf", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	correlated := testCommandRequestPayload()
+	correlationID := correlated.CorrelationID
+	correlateExternalRequest(&correlated, testExternalCommandRequestTopicExample, lc)
+	assert.Equal(t, correlationID, correlated.CorrelationID, "the CorrelationID of the request should be kept")
+	lc.AssertNotCalled(t, "Debugf", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	uncorrelated := testCommandRequestPayload()
+	uncorrelated.CorrelationID = ""
+	generated := externalCorrelationIdsGeneratedCounter.Count()
+	correlateExternalRequest(&uncorrelated, testExternalCommandRequestTopicExample, lc)
+	_, err := uuid.Parse(uncorrelated.CorrelationID)
+	assert.NoError(t, err, "a CorrelationID should be generated")
+	assert.Equal(t, generated+1, externalCorrelationIdsGeneratedCounter.Count())
+}
+
+func TestCorrelateExternalResponse(t *testing.T) {
+	requestEnvelope := testCommandRequestPayload()
+	// the traces aren't sampled so that the spans aren't exported
+	tracer, err := tracing.NewTracer(tracing.Info{Enabled: true, Endpoint: "http://localhost:4318/v1/traces"}, "core-command", logger.NewMockClient())
+	require.NoError(t, err)
+	_, span := tracer.StartMessageSpan(context.Background(), tracing.SpanKindConsumer, "mqtt", testExternalCommandRequestTopicExample, requestEnvelope)
+	defer span.End()
+
+	tests := []struct {
+		name                string
+		span                *tracing.Span
+		expectedTraceParent string
+	}{
+		{"tracing enabled", span, span.Context().TraceParent()},
+		{"tracing disabled", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, "error")
+			correlateExternalResponse(&responseEnvelope, requestEnvelope, tt.span)
+			assert.Equal(t, requestEnvelope.CorrelationID, responseEnvelope.CorrelationID)
+			assert.Equal(t, tt.expectedTraceParent, responseEnvelope.QueryParams[tracing.TraceParentHeader])
+		})
+	}
+}
+
 func testCommandQueryPayload() types.MessageEnvelope {
 	payload := types.NewMessageEnvelopeForRequest(nil, nil)
 
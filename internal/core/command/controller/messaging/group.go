@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// resolveDeviceGroup returns the names of the member devices of the device group named group, the names of the devices
+// associated with the device profile named group or, when there are none, the names of the devices labeled with group.
+func resolveDeviceGroup(group string, dic *di.Container) ([]string, error) {
+	// a device group which doesn't exist results in an error, in which case the group is resolved as profile or label
+	deviceNames, edgexErr := application.DeviceNamesByGroupName(context.Background(), group, dic)
+	if edgexErr == nil && len(deviceNames) > 0 {
+		return deviceNames, nil
+	}
+
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return nil, errors.New("nil Device Client")
+	}
+
+	var devices []dtos.Device
+	// a profile which doesn't exist results in an error, in which case the group is resolved as label
+	profileDevices, err := dc.DevicesByProfileName(context.Background(), group, 0, -1)
+	if err == nil {
+		devices = profileDevices.Devices
+	}
+	if len(devices) == 0 {
+		labelDevices, err := dc.AllDevices(context.Background(), []string{group}, 0, -1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Devices by label %s: %v", group, err)
+		}
+		devices = labelDevices.Devices
+	}
+	if len(devices) == 0 {
+		return nil, fmt.Errorf("no device, device profile or device label found for %s", group)
+	}
+
+	deviceNames = make([]string, len(devices))
+	for i, device := range devices {
+		deviceNames[i] = device.Name
+	}
+	return deviceNames, nil
+}
+
+// issueGroupSetCommand issues the external set command request to the devices of the group and returns the
+// aggregated response envelope, or an error envelope if the request fails. Each device of the group is checked against
+// the ExternalACL and counted against the rate limits like a request targeting the device itself, the devices which
+// are denied or rate limited being reported in the aggregated response without being issued the command.
+func issueGroupSetCommand(requestEnvelope types.MessageEnvelope, deviceNames []string, commandName string,
+	auditSource string, requestTopic string, rateLimiter *externalRateLimiter, dic *di.Container) types.MessageEnvelope {
+	settings, err := decodeSetCommandSettings(requestEnvelope)
+	if err != nil {
+		externalCommandErrorsCounters[errorTypeInvalidRequest].Inc(1)
+		return types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+	}
+
+	acl := container.ConfigurationFrom(dic.Get).ExternalACL
+	commandResponses := make([]commandDTOs.IssueCommandResponse, len(deviceNames))
+	var reqs []commandDTOs.IssueCommandRequest
+	var issued []int
+	for i, deviceName := range deviceNames {
+		req := commandDTOs.IssueCommandRequest{
+			DeviceName:  deviceName,
+			CommandName: commandName,
+			Method:      "set",
+			QueryParams: requestEnvelope.QueryParams,
+			Settings:    settings,
+		}
+		if err := authorizeExternalRequest(acl, requestTopic, deviceName, req.Method); err != nil {
+			externalCommandErrorsCounters[errorTypeUnauthorized].Inc(1)
+			commandResponses[i] = commandDTOs.NewIssueCommandResponse(req, err.Error(), http.StatusForbidden, nil)
+			continue
+		}
+		if err := rateLimiter.allow(deviceName, time.Now()); err != nil {
+			externalCommandErrorsCounters[errorTypeRateLimited].Inc(1)
+			commandResponses[i] = commandDTOs.NewIssueCommandResponse(req, err.Error(), http.StatusTooManyRequests, nil)
+			continue
+		}
+		reqs = append(reqs, req)
+		issued = append(issued, i)
+	}
+
+	if len(reqs) > 0 {
+		origin := application.CommandOrigin{Source: auditSource, Requester: requestTopic}
+		issuedResponses, edgexError := application.IssueBatchCommands(reqs, origin, dic)
+		if edgexError != nil {
+			externalCommandErrorsCounters[errorTypeDeviceRequest].Inc(1)
+			return types.NewMessageEnvelopeWithError(requestEnvelope.RequestID,
+				fmt.Sprintf("failed to issue set command to device group: %s", edgexError.Error()))
+		}
+		for i, response := range issuedResponses {
+			commandResponses[issued[i]] = response
+		}
+	}
+
+	responseBytes, err := json.Marshal(commandResponses)
+	if err != nil {
+		return types.NewMessageEnvelopeWithError(requestEnvelope.RequestID,
+			fmt.Sprintf("failed to json encoding group command response payload: %s", err.Error()))
+	}
+
+	responseEnvelope, err := types.NewMessageEnvelopeForResponse(responseBytes, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+	if err != nil {
+		return types.NewMessageEnvelopeWithError(requestEnvelope.RequestID,
+			fmt.Sprintf("failed to create response MessageEnvelope: %s", err.Error()))
+	}
+	return responseEnvelope
+}
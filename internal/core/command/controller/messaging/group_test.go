@@ -0,0 +1,199 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	edgexErr "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+func TestResolveDeviceGroup(t *testing.T) {
+	deviceGroup := "test-group"
+	profileGroup := "test-profile"
+	labelGroup := "test-label"
+	unknownGroup := "unknown"
+	notFound := edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "not found", nil)
+
+	dc := &clientMocks.DeviceClient{}
+	dc.On("DevicesByProfileName", context.Background(), profileGroup, 0, -1).Return(responses.MultiDevicesResponse{
+		Devices: []dtos.Device{{Name: "device1"}, {Name: "device2"}},
+	}, nil)
+	dc.On("DevicesByProfileName", context.Background(), labelGroup, 0, -1).Return(responses.MultiDevicesResponse{}, notFound)
+	dc.On("DevicesByProfileName", context.Background(), unknownGroup, 0, -1).Return(responses.MultiDevicesResponse{}, notFound)
+	dc.On("AllDevices", context.Background(), []string{labelGroup}, 0, -1).Return(responses.MultiDevicesResponse{
+		Devices: []dtos.Device{{Name: "device3"}},
+	}, nil)
+	dc.On("AllDevices", context.Background(), []string{unknownGroup}, 0, -1).Return(responses.MultiDevicesResponse{}, nil)
+
+	// core-metadata only knows the device group
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/device/group/name/"+deviceGroup {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(responses.MultiDevicesResponse{Devices: []dtos.Device{{Name: "device4"}, {Name: "device5"}}})
+	}))
+	defer metadata.Close()
+	metadataUrl, err := url.Parse(metadata.URL)
+	require.NoError(t, err)
+	metadataPort, err := strconv.Atoi(metadataUrl.Port())
+	require.NoError(t, err)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Clients: bootstrapConfig.ClientsCollection{
+					"core-metadata": {Protocol: "http", Host: metadataUrl.Hostname(), Port: metadataPort},
+				},
+			}
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dc
+		},
+	})
+
+	tests := []struct {
+		name            string
+		group           string
+		expectedDevices []string
+		expectedError   bool
+	}{
+		{"valid - device group", deviceGroup, []string{"device4", "device5"}, false},
+		{"valid - device profile", profileGroup, []string{"device1", "device2"}, false},
+		{"valid - device label", labelGroup, []string{"device3"}, false},
+		{"invalid - unknown group", unknownGroup, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deviceNames, err := resolveDeviceGroup(tt.group, dic)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDevices, deviceNames)
+		})
+	}
+}
+
+func TestIssueGroupSetCommand(t *testing.T) {
+	requestTopic := "unittest/external/request/test-group/testCommand/set"
+	settings := map[string]any{"temperature": "20"}
+	jsonSettings, err := json.Marshal(settings)
+	require.NoError(t, err)
+	cborSettings, err := cbor.Marshal(settings)
+	require.NoError(t, err)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				ExternalACL: config.ExternalACLInfo{
+					Enabled: true,
+					Rules: []config.ExternalACLRule{
+						{TopicPrefix: "unittest/external/request", AllowedDevices: []string{"device1", "device2"}, DeniedDevices: []string{"device2"}},
+					},
+				},
+			}
+		},
+	})
+	rateLimiter := newExternalRateLimiter(config.ExternalRateLimitInfo{
+		Enabled:   true,
+		PerDevice: config.RateLimit{RequestsPerSecond: 0.001, Burst: 1},
+	})
+
+	tests := []struct {
+		name             string
+		contentType      string
+		payload          []byte
+		expectedStatuses []int
+		expectedError    bool
+	}{
+		// device1 is allowed once by the rate limit, device2 is denied and device3 isn't allowed
+		{"json - denied devices", common.ContentTypeJSON, jsonSettings, []int{http.StatusTooManyRequests, http.StatusForbidden, http.StatusForbidden}, false},
+		{"cbor - denied devices", common.ContentTypeCBOR, cborSettings, []int{http.StatusTooManyRequests, http.StatusForbidden, http.StatusForbidden}, false},
+		{"invalid - settings not matching the content type", common.ContentTypeCBOR, jsonSettings, nil, true},
+	}
+	// the single token of device1 is taken beforehand, so that no command is issued
+	require.NoError(t, rateLimiter.allow("device1", time.Now()))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestEnvelope := types.MessageEnvelope{
+				RequestID:     uuid.NewString(),
+				CorrelationID: uuid.NewString(),
+				ContentType:   tt.contentType,
+				Payload:       tt.payload,
+				QueryParams:   map[string]string{},
+			}
+			responseEnvelope := issueGroupSetCommand(requestEnvelope, []string{"device1", "device2", "device3"}, "testCommand", commandDTOs.AuditSourceExternalMQTT, requestTopic, rateLimiter, dic)
+			if tt.expectedError {
+				assert.Equal(t, 1, responseEnvelope.ErrorCode)
+				return
+			}
+			require.Equal(t, 0, responseEnvelope.ErrorCode, string(responseEnvelope.Payload))
+
+			var commandResponses []commandDTOs.IssueCommandResponse
+			require.NoError(t, json.Unmarshal(responseEnvelope.Payload, &commandResponses))
+			require.Len(t, commandResponses, len(tt.expectedStatuses))
+			for i, response := range commandResponses {
+				assert.Equal(t, tt.expectedStatuses[i], response.StatusCode, response.Message)
+			}
+		})
+	}
+}
+
+func TestDecodeSetCommandSettings(t *testing.T) {
+	settings := map[string]any{"temperature": "20", "config": map[string]any{"mode": "auto"}}
+	jsonSettings, err := json.Marshal(settings)
+	require.NoError(t, err)
+	cborSettings, err := cbor.Marshal(settings)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		contentType   string
+		payload       []byte
+		expectedError bool
+	}{
+		{"valid - json", common.ContentTypeJSON, jsonSettings, false},
+		{"valid - cbor", common.ContentTypeCBOR, cborSettings, false},
+		{"invalid - cbor decoded as json", common.ContentTypeJSON, cborSettings, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := decodeSetCommandSettings(types.MessageEnvelope{ContentType: tt.contentType, Payload: tt.payload})
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, settings, decoded)
+		})
+	}
+}
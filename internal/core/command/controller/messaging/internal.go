@@ -23,7 +23,13 @@ import (
 
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgEnvelope "github.com/edgexfoundry/edgex-go/internal/pkg/envelope"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 // SubscribeCommandRequests subscribes command requests from EdgeX service (e.g., Application Service)
@@ -43,7 +49,11 @@ func SubscribeCommandRequests(ctx context.Context, requestTimeout time.Duration,
 	}
 
 	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
-	err := messageBus.Subscribe(topics, messageErrors)
+	subscriber, edgexErr := pkgHandlers.SubscriptionClient(ctx, container.ConfigurationFrom(dic.Get).JetStream, common.CoreCommandServiceKey+"-requests", requestCommandTopic, dic)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	err := subscriber.Subscribe(topics, messageErrors)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -75,6 +85,9 @@ func processDeviceCommandRequest(
 	var err error
 
 	lc.Debugf("Command device request received on internal MessageBus. Topic: %s, Request-id: %s, Correlation-id: %s", requestEnvelope.ReceivedTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+	messageBusType := container.ConfigurationFrom(dic.Get).MessageBus.Type
+	ctx, span := tracing.TracerFrom(dic.Get).StartMessageSpan(context.Background(), tracing.SpanKindConsumer, messageBusType, requestEnvelope.ReceivedTopic, requestEnvelope)
+	defer span.End()
 
 	if len(strings.TrimSpace(requestEnvelope.RequestID)) == 0 {
 		lc.Errorf("RequestId not set in Command request received on internal MessageBus")
@@ -84,6 +97,19 @@ func processDeviceCommandRequest(
 
 	// internal response topic scheme: <ResponseTopicPrefix>/<service-name>/<request-id>
 	internalResponseTopic := common.BuildTopic(baseTopic, common.ResponseTopic, common.CoreCommandServiceKey, requestEnvelope.RequestID)
+	messageBus, err = upgradeRequestEnvelope(messageBus, &requestEnvelope)
+	if err == nil {
+		err = checkEnvelopeSize(len(requestEnvelope.Payload), dic)
+	}
+	if err != nil {
+		lc.Error(err.Error())
+		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+		if err != nil {
+			lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		}
+		return
+	}
 	topicLevels := strings.Split(requestEnvelope.ReceivedTopic, "/")
 	length := len(topicLevels)
 	if length < 3 {
@@ -124,7 +150,7 @@ func processDeviceCommandRequest(
 
 	topicPrefix := common.BuildTopic(baseTopic, common.CoreCommandDeviceRequestPublishTopic)
 	// internal command request topic scheme: <DeviceRequestTopicPrefix>/<device-service>/<device>/<command-name>/<method>
-	deviceServiceName, deviceRequestTopic, err := validateRequestTopic(topicPrefix, deviceName, commandName, method, dic)
+	deviceServiceName, deviceRequestTopic, err := validateRequestTopic(ctx, topicPrefix, deviceName, commandName, method, dic)
 	if err != nil {
 		err = fmt.Errorf("invalid request topic: %s", err.Error())
 		lc.Error(err.Error())
@@ -136,6 +162,17 @@ func processDeviceCommandRequest(
 		return
 	}
 
+	err = transformCommandRequest(&requestEnvelope, deviceName, commandName, method, dic)
+	if err != nil {
+		lc.Errorf(err.Error())
+		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+		if err != nil {
+			lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		}
+		return
+	}
+
 	err = validateGetCommandQueryParameters(requestEnvelope.QueryParams)
 	if err != nil {
 		lc.Errorf(err.Error())
@@ -147,13 +184,39 @@ func processDeviceCommandRequest(
 		return
 	}
 
+	err = validateSetCommandPayload(requestEnvelope, deviceName, commandName, method, dic)
+	if err != nil {
+		lc.Errorf(err.Error())
+		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+		if err != nil {
+			lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		}
+		return
+	}
+
+	commandTimeout, err := resolveCommandTimeout(requestEnvelope, deviceName, commandName, requestTimeout, dic)
+	if err != nil {
+		lc.Errorf(err.Error())
+		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+		if err != nil {
+			lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		}
+		return
+	}
+
 	deviceResponseTopicPrefix := common.BuildTopic(baseTopic, common.ResponseTopic, deviceServiceName)
 
 	lc.Debugf("Sending Command Device Request to internal MessageBus. Topic: %s, Correlation-id: %s", deviceRequestTopic, requestEnvelope.CorrelationID)
 	lc.Debugf("Expecting response on topic: %s/%s", deviceResponseTopicPrefix, requestEnvelope.RequestID)
 
-	response, err := messageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
+	origin := application.CommandOrigin{Source: commandDTOs.AuditSourceMessageBus, Requester: requestEnvelope.ReceivedTopic}
+	start := time.Now()
+	response, err := requestDevice(ctx, messageBus, requestEnvelope, deviceName, commandName, method, deviceRequestTopic, deviceResponseTopicPrefix, commandTimeout, dic)
+	auditCommandRequest(origin, requestEnvelope, deviceName, commandName, method, response, err, start, dic)
 	if err != nil {
+		span.SetError(err)
 		lc.Errorf("Request to topic '%s' failed: %s", deviceRequestTopic, err.Error())
 		return
 	}
@@ -188,7 +251,11 @@ func SubscribeCommandQueryRequests(ctx context.Context, dic *di.Container) error
 
 	lc.Infof("Subscribing to internal command query requests on topic: %s", queryRequestTopic)
 
-	err := messageBus.Subscribe(topics, messageErrors)
+	subscriber, edgexErr := pkgHandlers.SubscriptionClient(ctx, container.ConfigurationFrom(dic.Get).JetStream, common.CoreCommandServiceKey+"-query-requests", queryRequestTopic, dic)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	err := subscriber.Subscribe(topics, messageErrors)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -233,7 +300,14 @@ func processCommandQueryRequest(
 		deviceName = common.All
 	}
 
-	responseEnvelope, err := getCommandQueryResponseEnvelope(requestEnvelope, deviceName, dic)
+	var responseEnvelope types.MessageEnvelope
+	messageBus, err := upgradeRequestEnvelope(messageBus, &requestEnvelope)
+	if err == nil {
+		err = checkEnvelopeSize(len(requestEnvelope.Payload), dic)
+	}
+	if err == nil {
+		responseEnvelope, err = getCommandQueryResponseEnvelope(requestEnvelope, deviceName, dic)
+	}
 	if err != nil {
 		lc.Error(err.Error())
 		responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
@@ -251,3 +325,100 @@ func processCommandQueryRequest(
 
 	lc.Debugf("Command query response sent to internal MessageBus. Topic: %s, Correlation-id: %s", internalQueryResponseTopic, requestEnvelope.CorrelationID)
 }
+
+// SubscribeBatchCommandRequests subscribes batch command requests from EdgeX service (e.g., Application Service)
+// via internal MessageBus
+func SubscribeBatchCommandRequests(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	baseTopic := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
+	batchRequestTopic := common.BuildTopic(baseTopic, pkgCommon.CoreCommandBatchRequestSubscribeTopic)
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []types.TopicChannel{
+		{
+			Topic:    batchRequestTopic,
+			Messages: messages,
+		},
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+
+	lc.Infof("Subscribing to internal batch command requests on topic: %s", batchRequestTopic)
+
+	subscriber, edgexErr := pkgHandlers.SubscriptionClient(ctx, container.ConfigurationFrom(dic.Get).JetStream, common.CoreCommandServiceKey+"-batch-requests", batchRequestTopic, dic)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	err := subscriber.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", batchRequestTopic)
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+			case requestEnvelope := <-messages:
+				// batch commands may take a while, so don't block receiving subsequent requests
+				go processBatchCommandRequest(messageBus, requestEnvelope, baseTopic, lc, dic)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func processBatchCommandRequest(
+	messageBus messaging.MessageClient,
+	requestEnvelope types.MessageEnvelope,
+	baseTopic string,
+	lc logger.LoggingClient,
+	dic *di.Container,
+) {
+	lc.Debugf("Batch command request received on internal MessageBus. Topic: %s, Request-id: %s, Correlation-id: %s", requestEnvelope.ReceivedTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+
+	if len(strings.TrimSpace(requestEnvelope.RequestID)) == 0 {
+		lc.Errorf("RequestId not set in batch command request received on internal MessageBus")
+		lc.Warn("Not publishing error message back due to insufficient information to publish on response topic")
+		return
+	}
+
+	// internal response topic scheme: <ResponseTopicPrefix>/<service-name>/<request-id>
+	internalResponseTopic := common.BuildTopic(baseTopic, common.ResponseTopic, common.CoreCommandServiceKey, requestEnvelope.RequestID)
+
+	var responseEnvelope types.MessageEnvelope
+	messageBus, err := upgradeRequestEnvelope(messageBus, &requestEnvelope)
+	if err == nil {
+		err = checkEnvelopeSize(len(requestEnvelope.Payload), dic)
+	}
+	if err == nil {
+		responseEnvelope, err = getBatchCommandResponseEnvelope(requestEnvelope, dic)
+	}
+	if err != nil {
+		lc.Error(err.Error())
+		responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+	}
+
+	err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+	if err != nil {
+		lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		return
+	}
+
+	lc.Debugf("Batch command response sent to internal MessageBus. Topic: %s, Correlation-id: %s", internalResponseTopic, requestEnvelope.CorrelationID)
+}
+
+// upgradeRequestEnvelope converts the request envelope to the current ApiVersion and returns the MessageBus client
+// publishing the responses converted to the ApiVersion of the request
+func upgradeRequestEnvelope(messageBus messaging.MessageClient, requestEnvelope *types.MessageEnvelope) (messaging.MessageClient, error) {
+	apiVersion, err := pkgEnvelope.Upgrade(requestEnvelope)
+	if err != nil {
+		return messageBus, err
+	}
+	return pkgEnvelope.NewPublisher(messageBus, apiVersion), nil
+}
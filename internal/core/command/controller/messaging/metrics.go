@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	gometrics "github.com/rcrowley/go-metrics"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+const (
+	externalMQTTFailoversMetricName               = "ExternalMQTTFailovers"
+	externalCommandQueryRequestsMetricName        = "ExternalCommandQueryRequests"
+	externalCommandQueryErrorsMetricName          = "ExternalCommandQueryErrors"
+	externalCommandQueryLatencyMetricName         = "ExternalCommandQueryLatency"
+	externalCommandRequestsMetricName             = "ExternalCommandRequests"
+	externalCommandErrorsMetricName               = "ExternalCommandErrors"
+	externalCommandLatencyMetricName              = "ExternalCommandLatency"
+	externalCommandDeviceRequestLatencyMetricName = "ExternalCommandDeviceRequestLatency"
+	externalCorrelationIdsGeneratedMetricName     = "ExternalCorrelationIdsGenerated"
+
+	errorTypeTagName = "errorType"
+)
+
+// Types of the errors counted by the external command handlers
+const (
+	errorTypeDecode         = "Decode"
+	errorTypeTooLarge       = "TooLarge"
+	errorTypeInvalidTopic   = "InvalidTopic"
+	errorTypeUnauthorized   = "Unauthorized"
+	errorTypeRateLimited    = "RateLimited"
+	errorTypeInvalidRequest = "InvalidRequest"
+	errorTypeQuery          = "Query"
+	errorTypeDeviceRequest  = "DeviceRequest"
+	errorTypeDeviceResponse = "DeviceResponse"
+)
+
+var (
+	externalMQTTFailoversCounter        = gometrics.NewCounter()
+	externalCommandQueryRequestsCounter = gometrics.NewCounter()
+	externalCommandQueryErrorsCounters  = newErrorCounters(errorTypeDecode, errorTypeTooLarge, errorTypeInvalidTopic, errorTypeQuery)
+	externalCommandRequestsCounter      = gometrics.NewCounter()
+	externalCommandErrorsCounters       = newErrorCounters(errorTypeDecode, errorTypeTooLarge, errorTypeInvalidTopic, errorTypeUnauthorized,
+		errorTypeRateLimited, errorTypeInvalidRequest, errorTypeDeviceRequest, errorTypeDeviceResponse)
+	// externalCommandQueryLatencyTimer measures the time from receiving the external command query request to publishing its response
+	externalCommandQueryLatencyTimer = gometrics.NewTimer()
+	// externalCommandLatencyTimer measures the time from receiving the external command request to publishing its response
+	externalCommandLatencyTimer = gometrics.NewTimer()
+	// externalCommandDeviceRequestLatencyTimer measures the time the device service takes to respond via the internal MessageBus
+	externalCommandDeviceRequestLatencyTimer = gometrics.NewTimer()
+	// externalCorrelationIdsGeneratedCounter counts the external requests received without CorrelationID
+	externalCorrelationIdsGeneratedCounter = gometrics.NewCounter()
+)
+
+func newErrorCounters(errorTypes ...string) map[string]gometrics.Counter {
+	counters := make(map[string]gometrics.Counter, len(errorTypes))
+	for _, errorType := range errorTypes {
+		counters[errorType] = gometrics.NewCounter()
+	}
+	return counters
+}
+
+// OnExternalMQTTFailover counts the failovers of the external MQTT connection from one broker to another
+func OnExternalMQTTFailover(_ string, _ string) {
+	externalMQTTFailoversCounter.Inc(1)
+}
+
+// RegisterMetrics registers the messaging metrics with the service's MetricsManager. Must be called after the
+// MetricsManager has been bootstrapped. The error counters are registered per error type, with the error type as tag,
+// so all of them are enabled by their common metric name.
+func RegisterMetrics(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. Messaging metrics will not be collected.")
+		return
+	}
+
+	register := func(name string, item any, tags map[string]string) {
+		if err := metricsManager.Register(name, item, tags); err != nil {
+			lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			return
+		}
+		lc.Infof("Registered metrics %s", name)
+	}
+
+	register(externalMQTTFailoversMetricName, externalMQTTFailoversCounter, nil)
+	register(externalCommandQueryRequestsMetricName, externalCommandQueryRequestsCounter, nil)
+	register(externalCommandQueryLatencyMetricName, externalCommandQueryLatencyTimer, nil)
+	register(externalCommandRequestsMetricName, externalCommandRequestsCounter, nil)
+	register(externalCommandLatencyMetricName, externalCommandLatencyTimer, nil)
+	register(externalCommandDeviceRequestLatencyMetricName, externalCommandDeviceRequestLatencyTimer, nil)
+	register(externalCorrelationIdsGeneratedMetricName, externalCorrelationIdsGeneratedCounter, nil)
+	for errorType, counter := range externalCommandQueryErrorsCounters {
+		register(externalCommandQueryErrorsMetricName+"-"+errorType, counter, map[string]string{errorTypeTagName: errorType})
+	}
+	for errorType, counter := range externalCommandErrorsCounters {
+		register(externalCommandErrorsMetricName+"-"+errorType, counter, map[string]string{errorTypeTagName: errorType})
+	}
+}
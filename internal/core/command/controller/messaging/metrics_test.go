@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	lcMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegisterMetrics(t *testing.T) {
+	lc := &lcMocks.LoggingClient{}
+	lc.On("Infof", mock.Anything, mock.Anything).Return(nil)
+	metricsManager := &bootstrapMocks.MetricsManager{}
+	metricsManager.On("Register", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return lc
+		},
+		bootstrapContainer.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+			return metricsManager
+		},
+	})
+
+	RegisterMetrics(dic)
+
+	metricsManager.AssertCalled(t, "Register", externalMQTTFailoversMetricName, externalMQTTFailoversCounter, map[string]string(nil))
+	metricsManager.AssertCalled(t, "Register", externalCommandLatencyMetricName, externalCommandLatencyTimer, map[string]string(nil))
+	metricsManager.AssertCalled(t, "Register", externalCommandErrorsMetricName+"-"+errorTypeRateLimited,
+		externalCommandErrorsCounters[errorTypeRateLimited], map[string]string{errorTypeTagName: errorTypeRateLimited})
+	metricsManager.AssertCalled(t, "Register", externalCommandQueryErrorsMetricName+"-"+errorTypeQuery,
+		externalCommandQueryErrorsCounters[errorTypeQuery], map[string]string{errorTypeTagName: errorTypeQuery})
+	metricsManager.AssertCalled(t, "Register", externalCorrelationIdsGeneratedMetricName, externalCorrelationIdsGeneratedCounter, map[string]string(nil))
+	metricsManager.AssertNumberOfCalls(t, "Register", 7+len(externalCommandQueryErrorsCounters)+len(externalCommandErrorsCounters))
+}
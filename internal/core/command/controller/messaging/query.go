@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// commandQueryPage is the part of the devices returned by a command query of all devices, it is also the content of
+// the continuation token of the next part
+type commandQueryPage struct {
+	Offset int `json:"offset"`
+	// Limit is the number of devices remaining to be returned, -1 means all
+	Limit int `json:"limit"`
+}
+
+// parseCommandQueryPage returns the page requested with the offset and limit query parameters or, when provided, the
+// continuation token of the previous part.
+func parseCommandQueryPage(queryParams map[string]string) (commandQueryPage, error) {
+	page := commandQueryPage{Offset: common.DefaultOffset, Limit: common.DefaultLimit}
+
+	if token, ok := queryParams[pkgCommon.CommandContinuation]; ok {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return page, fmt.Errorf("invalid '%s' query parameter: %s", pkgCommon.CommandContinuation, err.Error())
+		}
+		if err = json.Unmarshal(decoded, &page); err != nil {
+			return page, fmt.Errorf("invalid '%s' query parameter: %s", pkgCommon.CommandContinuation, err.Error())
+		}
+		return page, nil
+	}
+
+	var err error
+	if offsetRaw, ok := queryParams[common.Offset]; ok {
+		page.Offset, err = strconv.Atoi(offsetRaw)
+		if err != nil {
+			return page, fmt.Errorf("failed to convert 'offset' query parameter to intger: %s", err.Error())
+		}
+	}
+	if limitRaw, ok := queryParams[common.Limit]; ok {
+		page.Limit, err = strconv.Atoi(limitRaw)
+		if err != nil {
+			return page, fmt.Errorf("failed to convert 'limit' query parameter to integer: %s", err.Error())
+		}
+	}
+	return page, nil
+}
+
+// chunkLimit returns the number of devices to return in this part, at most maxDevices when it is greater than 0
+func (p commandQueryPage) chunkLimit(maxDevices int) int {
+	if maxDevices > 0 && (p.Limit < 0 || p.Limit > maxDevices) {
+		return maxDevices
+	}
+	return p.Limit
+}
+
+// continuationToken returns the token of the next part after count devices were returned out of totalCount, or an
+// empty token when this is the last part
+func (p commandQueryPage) continuationToken(count int, totalCount uint32, maxDevices int) (string, error) {
+	if count == 0 || count < p.chunkLimit(maxDevices) || p.Limit == count {
+		return "", nil
+	}
+
+	next := commandQueryPage{Offset: p.Offset + count, Limit: p.Limit}
+	if next.Offset >= int(totalCount) {
+		return "", nil
+	}
+	if p.Limit > 0 {
+		next.Limit = p.Limit - count
+	}
+
+	encoded, err := json.Marshal(next)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode '%s' query parameter: %s", pkgCommon.CommandContinuation, err.Error())
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
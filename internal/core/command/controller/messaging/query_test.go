@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func TestParseCommandQueryPage(t *testing.T) {
+	tests := []struct {
+		name          string
+		queryParams   map[string]string
+		expectedPage  commandQueryPage
+		expectedError bool
+	}{
+		{"valid - default", nil, commandQueryPage{Offset: common.DefaultOffset, Limit: common.DefaultLimit}, false},
+		{"valid - offset and limit", map[string]string{common.Offset: "5", common.Limit: "-1"}, commandQueryPage{Offset: 5, Limit: -1}, false},
+		{"valid - continuation token", map[string]string{pkgCommon.CommandContinuation: "eyJvZmZzZXQiOjIwLCJsaW1pdCI6LTF9", common.Offset: "5"},
+			commandQueryPage{Offset: 20, Limit: -1}, false},
+		{"invalid - offset", map[string]string{common.Offset: "invalid"}, commandQueryPage{}, true},
+		{"invalid - limit", map[string]string{common.Limit: "invalid"}, commandQueryPage{}, true},
+		{"invalid - continuation token encoding", map[string]string{pkgCommon.CommandContinuation: "%%"}, commandQueryPage{}, true},
+		{"invalid - continuation token content", map[string]string{pkgCommon.CommandContinuation: "aW52YWxpZA"}, commandQueryPage{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := parseCommandQueryPage(tt.queryParams)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPage, page)
+		})
+	}
+}
+
+func TestCommandQueryPageContinuation(t *testing.T) {
+	tests := []struct {
+		name               string
+		page               commandQueryPage
+		maxDevices         int
+		count              int
+		totalCount         uint32
+		expectedChunkLimit int
+		expectedNext       *commandQueryPage
+	}{
+		{"no chunking", commandQueryPage{Offset: 0, Limit: 20}, 0, 20, 50, 20, nil},
+		{"no chunking - all", commandQueryPage{Offset: 0, Limit: -1}, 0, 50, 50, -1, nil},
+		{"limit within chunk", commandQueryPage{Offset: 0, Limit: 5}, 10, 5, 50, 5, nil},
+		{"first chunk of all", commandQueryPage{Offset: 0, Limit: -1}, 10, 10, 25, 10, &commandQueryPage{Offset: 10, Limit: -1}},
+		{"last chunk of all", commandQueryPage{Offset: 20, Limit: -1}, 10, 5, 25, 10, nil},
+		{"last full chunk of all", commandQueryPage{Offset: 10, Limit: -1}, 10, 10, 20, 10, nil},
+		{"first chunk of limit", commandQueryPage{Offset: 0, Limit: 15}, 10, 10, 50, 10, &commandQueryPage{Offset: 10, Limit: 5}},
+		{"last chunk of limit", commandQueryPage{Offset: 10, Limit: 5}, 10, 5, 50, 5, nil},
+		{"no devices", commandQueryPage{Offset: 0, Limit: -1}, 10, 0, 0, 10, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedChunkLimit, tt.page.chunkLimit(tt.maxDevices))
+
+			token, err := tt.page.continuationToken(tt.count, tt.totalCount, tt.maxDevices)
+			require.NoError(t, err)
+			if tt.expectedNext == nil {
+				assert.Empty(t, token)
+				return
+			}
+
+			next, err := parseCommandQueryPage(map[string]string{pkgCommon.CommandContinuation: token})
+			require.NoError(t, err)
+			assert.Equal(t, *tt.expectedNext, next)
+		})
+	}
+}
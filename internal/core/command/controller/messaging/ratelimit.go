@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+)
+
+// tokenBucket is refilled at rate tokens per second up to burst tokens, each request takes one token
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(requestsPerSecond float64, burst int, now time.Time) *tokenBucket {
+	size := float64(burst)
+	if size <= 0 {
+		size = math.Max(1, math.Ceil(requestsPerSecond))
+	}
+	return &tokenBucket{rate: requestsPerSecond, burst: size, tokens: size, last: now}
+}
+
+// available refills the bucket and returns whether a token can be taken
+func (b *tokenBucket) available(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	return b.tokens >= 1
+}
+
+func (b *tokenBucket) take() {
+	if b != nil {
+		b.tokens--
+	}
+}
+
+// externalRateLimiter limits the external command requests globally and per device
+type externalRateLimiter struct {
+	mutex   sync.Mutex
+	info    config.ExternalRateLimitInfo
+	global  *tokenBucket
+	devices map[string]*tokenBucket
+}
+
+func newExternalRateLimiter(info config.ExternalRateLimitInfo) *externalRateLimiter {
+	limiter := &externalRateLimiter{
+		info:    info,
+		devices: make(map[string]*tokenBucket),
+	}
+	if info.Global.RequestsPerSecond > 0 {
+		limiter.global = newTokenBucket(info.Global.RequestsPerSecond, info.Global.Burst, time.Now())
+	}
+	return limiter
+}
+
+// allow returns an error when the request for the specified device exceeds the global or the device rate limit, the
+// request is only counted against the limits when it is allowed.
+func (l *externalRateLimiter) allow(deviceName string, now time.Time) error {
+	if !l.info.Enabled {
+		return nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.global.available(now) {
+		return tooManyRequestsError("global rate limit of %v requests per second exceeded", l.info.Global.RequestsPerSecond)
+	}
+
+	device, exists := l.devices[deviceName]
+	if !exists {
+		device = l.newDeviceBucket(deviceName, now)
+		l.devices[deviceName] = device
+	}
+	if !device.available(now) {
+		return tooManyRequestsError("rate limit of %v requests per second exceeded for device '%s'", device.rate, deviceName)
+	}
+
+	l.global.take()
+	device.take()
+	return nil
+}
+
+// newDeviceBucket returns the bucket of the specified device, or nil when the device isn't limited
+func (l *externalRateLimiter) newDeviceBucket(deviceName string, now time.Time) *tokenBucket {
+	limit := l.info.PerDevice
+	for _, override := range l.info.Devices {
+		if override.DeviceName == deviceName {
+			limit = config.RateLimit{RequestsPerSecond: override.RequestsPerSecond, Burst: override.Burst}
+			break
+		}
+	}
+	if limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+	return newTokenBucket(limit.RequestsPerSecond, limit.Burst, now)
+}
+
+func tooManyRequestsError(format string, args ...any) error {
+	return fmt.Errorf("%d %s: %s", http.StatusTooManyRequests, http.StatusText(http.StatusTooManyRequests), fmt.Sprintf(format, args...))
+}
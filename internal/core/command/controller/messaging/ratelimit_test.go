@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+)
+
+func TestExternalRateLimiterAllow(t *testing.T) {
+	info := config.ExternalRateLimitInfo{
+		Enabled:   true,
+		Global:    config.RateLimit{RequestsPerSecond: 10, Burst: 3},
+		PerDevice: config.RateLimit{RequestsPerSecond: 1, Burst: 2},
+		Devices: []config.DeviceRateLimit{
+			{DeviceName: "unlimited-device"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		info            config.ExternalRateLimitInfo
+		requests        []string
+		expectedAllowed []bool
+	}{
+		{"valid - disabled", config.ExternalRateLimitInfo{}, []string{testDeviceName, testDeviceName, testDeviceName}, []bool{true, true, true}},
+		{"invalid - device burst exceeded", info, []string{testDeviceName, testDeviceName, testDeviceName}, []bool{true, true, false}},
+		{"invalid - global burst exceeded", info, []string{"device1", "device2", "device3", "device4"}, []bool{true, true, true, false}},
+		{"valid - device override without limit", info, []string{"unlimited-device", "unlimited-device", "unlimited-device"}, []bool{true, true, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newExternalRateLimiter(tt.info)
+			now := time.Now()
+			for i, deviceName := range tt.requests {
+				err := limiter.allow(deviceName, now)
+				if tt.expectedAllowed[i] {
+					assert.NoError(t, err, "request %d", i)
+				} else {
+					assert.ErrorContains(t, err, "429", "request %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestExternalRateLimiterRefill(t *testing.T) {
+	limiter := newExternalRateLimiter(config.ExternalRateLimitInfo{
+		Enabled:   true,
+		PerDevice: config.RateLimit{RequestsPerSecond: 2, Burst: 1},
+	})
+	now := time.Now()
+
+	assert.NoError(t, limiter.allow(testDeviceName, now))
+	assert.Error(t, limiter.allow(testDeviceName, now.Add(100*time.Millisecond)))
+	assert.NoError(t, limiter.allow(testDeviceName, now.Add(600*time.Millisecond)))
+}
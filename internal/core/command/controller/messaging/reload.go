@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// NewInternalSubscriptions returns the subscriptions to the command, command query and batch command requests from
+// the internal MessageBus, which are renewed when the MessageBus BaseTopicPrefix changes
+func NewInternalSubscriptions(requestTimeout time.Duration, dic *di.Container) *pkgHandlers.MessageBusSubscriptions {
+	return pkgHandlers.NewMessageBusSubscriptions(
+		func(ctx context.Context) errors.EdgeX {
+			if err := SubscribeCommandRequests(ctx, requestTimeout, dic); err != nil {
+				return errors.NewCommonEdgeX(errors.Kind(err), "failed to subscribe commands request from internal message bus", err)
+			}
+			if err := SubscribeCommandQueryRequests(ctx, dic); err != nil {
+				return errors.NewCommonEdgeX(errors.Kind(err), "failed to subscribe command query request from internal message bus", err)
+			}
+			if err := SubscribeBatchCommandRequests(ctx, dic); err != nil {
+				return errors.NewCommonEdgeX(errors.Kind(err), "failed to subscribe batch command request from internal message bus", err)
+			}
+			return nil
+		},
+		func(baseTopicPrefix string) []string {
+			return []string{
+				common.BuildTopic(baseTopicPrefix, common.CoreCommandRequestSubscribeTopic),
+				common.BuildTopic(baseTopicPrefix, common.CoreCommandQueryRequestSubscribeTopic),
+				common.BuildTopic(baseTopicPrefix, pkgCommon.CoreCommandBatchRequestSubscribeTopic),
+			}
+		})
+}
+
+// OnExternalMQTTChanged applies the changes of the ExternalMQTT configuration: the changed request topics or QoS are
+// subscribed to again and the changed connection settings reconnect the external MQTT client. The changed response
+// topics and Retain apply to the next responses.
+func OnExternalMQTTChanged(externalMQTT *pkgHandlers.ExternalMQTT, requestTimeout time.Duration, dic *di.Container) func(bootstrapConfig.ExternalMQTTInfo) {
+	return func(updated bootstrapConfig.ExternalMQTTInfo) {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		current := &container.ConfigurationFrom(dic.Get).ExternalMQTT
+
+		if current.Enabled != updated.Enabled || current.AuthMode != updated.AuthMode ||
+			current.SecretName != updated.SecretName || current.SkipCertVerify != updated.SkipCertVerify {
+			lc.Warn("The ExternalMQTT Enabled, AuthMode, SecretName or SkipCertVerify settings changed, core-command must be restarted to apply them")
+		}
+		previousRequestTopics := externalRequestTopics(*current)
+		resubscribe := current.QoS != updated.QoS || !equalTopics(previousRequestTopics, externalRequestTopics(updated))
+		reconnect := current.Url != updated.Url || current.ClientId != updated.ClientId ||
+			current.ConnectTimeout != updated.ConnectTimeout || current.AutoReconnect != updated.AutoReconnect ||
+			current.KeepAlive != updated.KeepAlive
+
+		// the handlers read the topics, QoS and Retain from the configuration for every message
+		current.Topics = updated.Topics
+		current.QoS = updated.QoS
+		current.Retain = updated.Retain
+
+		if reconnect {
+			// the request topics are subscribed to once connected
+			if err := externalMQTT.Reconnect(updated, dic); err != nil {
+				lc.Errorf("Failed to reconnect to the external MQTT broker with the updated settings: %v", err)
+				return
+			}
+			current.Url = updated.Url
+			current.ClientId = updated.ClientId
+			current.ConnectTimeout = updated.ConnectTimeout
+			current.AutoReconnect = updated.AutoReconnect
+			current.KeepAlive = updated.KeepAlive
+			return
+		}
+		if !resubscribe {
+			return
+		}
+
+		client := bootstrapContainer.ExternalMQTTMessagingClientFrom(dic.Get)
+		if token := client.Unsubscribe(previousRequestTopics...); token.Wait() && token.Error() != nil {
+			lc.Warnf("Failed to unsubscribe from the previous external MQTT topics %v: %s", previousRequestTopics, token.Error().Error())
+		}
+		lc.Infof("The ExternalMQTT request topics or QoS changed, subscribing to the request topics again")
+		OnConnectHandler(requestTimeout, dic)(client)
+	}
+}
+
+func externalRequestTopics(externalMQTTInfo bootstrapConfig.ExternalMQTTInfo) []string {
+	return []string{
+		externalMQTTInfo.Topics[common.CommandQueryRequestTopicKey],
+		externalMQTTInfo.Topics[common.CommandRequestTopicKey],
+	}
+}
+
+func equalTopics(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
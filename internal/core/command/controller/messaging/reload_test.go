@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging/mocks"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+)
+
+func TestOnExternalMQTTChanged(t *testing.T) {
+	externalMQTTInfo := bootstrapConfig.ExternalMQTTInfo{
+		Url: "tcp://localhost:1883",
+		Topics: map[string]string{
+			common.CommandRequestTopicKey:               testExternalCommandRequestTopic,
+			common.CommandQueryRequestTopicKey:          testQueryRequestTopic,
+			common.ExternalCommandQueryResponseTopicKey: testQueryResponseTopic,
+		},
+	}
+	updatedTopics := map[string]string{
+		common.CommandRequestTopicKey:               "updated/external/request/#",
+		common.CommandQueryRequestTopicKey:          "updated/commandquery/request/#",
+		common.ExternalCommandQueryResponseTopicKey: testQueryResponseTopic,
+	}
+	updatedResponseTopics := map[string]string{
+		common.CommandRequestTopicKey:               testExternalCommandRequestTopic,
+		common.CommandQueryRequestTopicKey:          testQueryRequestTopic,
+		common.ExternalCommandQueryResponseTopicKey: "updated/commandquery/response",
+	}
+
+	tests := []struct {
+		name                string
+		topics              map[string]string
+		qos                 byte
+		retain              bool
+		expectedResubscribe bool
+	}{
+		{"unchanged", externalMQTTInfo.Topics, 0, false, false},
+		{"request topics", updatedTopics, 0, false, true},
+		{"QoS", externalMQTTInfo.Topics, 1, false, true},
+		{"response topic and retain", updatedResponseTopics, 0, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configuration := &config.ConfigurationStruct{ExternalMQTT: externalMQTTInfo}
+			token := &mocks.Token{}
+			token.On("Wait").Return(true)
+			token.On("Error").Return(nil)
+			client := &mocks.Client{}
+			client.On("Unsubscribe", testQueryRequestTopic, testExternalCommandRequestTopic).Return(token)
+			client.On("Subscribe", mock.Anything, mock.Anything, mock.Anything).Return(token)
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return configuration
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+				bootstrapContainer.ExternalMQTTMessagingClientName: func(get di.Get) interface{} {
+					return client
+				},
+			})
+
+			updated := externalMQTTInfo
+			updated.Topics = tt.topics
+			updated.QoS = tt.qos
+			updated.Retain = tt.retain
+			OnExternalMQTTChanged(pkgHandlers.NewExternalMQTT(nil, nil, nil, pkgHandlers.TLSInfo{}), time.Second, dic)(updated)
+
+			assert.Equal(t, tt.topics, configuration.ExternalMQTT.Topics)
+			assert.Equal(t, tt.qos, configuration.ExternalMQTT.QoS)
+			assert.Equal(t, tt.retain, configuration.ExternalMQTT.Retain)
+			if !tt.expectedResubscribe {
+				client.AssertNotCalled(t, "Unsubscribe", mock.Anything, mock.Anything)
+				client.AssertNotCalled(t, "Subscribe", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			client.AssertCalled(t, "Unsubscribe", testQueryRequestTopic, testExternalCommandRequestTopic)
+			client.AssertCalled(t, "Subscribe", tt.topics[common.CommandQueryRequestTopicKey], tt.qos, mock.Anything)
+			client.AssertCalled(t, "Subscribe", tt.topics[common.CommandRequestTopicKey], tt.qos, mock.Anything)
+		})
+	}
+}
+
+func TestOnExternalMQTTChangedReconnectFailure(t *testing.T) {
+	configuration := &config.ConfigurationStruct{ExternalMQTT: bootstrapConfig.ExternalMQTTInfo{Url: "tcp://localhost:1883"}}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+
+	// the external MQTT client isn't connected, so the reconnection fails and the connection settings are kept
+	updated := configuration.ExternalMQTT
+	updated.Url = "tcp://edgex-mqtt-broker:1883"
+	OnExternalMQTTChanged(pkgHandlers.NewExternalMQTT(nil, nil, nil, pkgHandlers.TLSInfo{}), time.Second, dic)(updated)
+	assert.Equal(t, "tcp://localhost:1883", configuration.ExternalMQTT.Url)
+}
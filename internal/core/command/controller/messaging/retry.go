@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+)
+
+const (
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+)
+
+// undeliveredRequestErrors are the errors returned by MessageClient.Request before the request is published, in which
+// case the request hasn't reached the device service
+var undeliveredRequestErrors = []string{"unable to create response subscription", "unable to create publish request"}
+
+// commandRetryPolicy is the retry policy of the requests of a command
+type commandRetryPolicy struct {
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	jitter         float64
+	// anyFailure retries the requests which may have been executed by the device service, i.e. which timed out, which
+	// is only safe for the get commands and the idempotent set commands
+	anyFailure bool
+}
+
+// resolveRetryPolicy returns the retry policy of the command, the override of the command taking precedence over the
+// override of its device
+func resolveRetryPolicy(retryInfo config.CommandRetryInfo, deviceName string, commandName string, method string, lc logger.LoggingClient) commandRetryPolicy {
+	policy := commandRetryPolicy{
+		maxRetries:     retryInfo.MaxRetries,
+		initialBackoff: parseBackoff(retryInfo.InitialBackoff, defaultInitialBackoff, lc),
+		maxBackoff:     parseBackoff(retryInfo.MaxBackoff, defaultMaxBackoff, lc),
+		jitter:         retryInfo.Jitter,
+		anyFailure:     strings.EqualFold(method, "get"),
+	}
+	var override *config.CommandRetryOverride
+	for i, o := range retryInfo.Overrides {
+		if o.DeviceName != deviceName {
+			continue
+		}
+		if o.CommandName == commandName {
+			override = &retryInfo.Overrides[i]
+			break
+		}
+		if len(o.CommandName) == 0 && override == nil {
+			override = &retryInfo.Overrides[i]
+		}
+	}
+	if override != nil {
+		policy.maxRetries = override.MaxRetries
+		policy.anyFailure = policy.anyFailure || override.Idempotent
+	}
+	return policy
+}
+
+// retryable returns whether the request which failed with the error may be retried according to the policy
+func (p commandRetryPolicy) retryable(err error) bool {
+	if p.anyFailure {
+		return true
+	}
+	for _, undelivered := range undeliveredRequestErrors {
+		if strings.HasPrefix(err.Error(), undelivered) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestWithRetry sends the request via the internal MessageBus and waits for the response. Failed requests are
+// retried with exponential backoff and jitter according to the retry policy, until the context is done.
+func requestWithRetry(
+	ctx context.Context,
+	messageBus messaging.MessageClient,
+	requestEnvelope types.MessageEnvelope,
+	requestTopic string,
+	responseTopicPrefix string,
+	requestTimeout time.Duration,
+	policy commandRetryPolicy,
+	lc logger.LoggingClient) (*types.MessageEnvelope, error) {
+	response, err := messageBus.Request(requestEnvelope, requestTopic, responseTopicPrefix, requestTimeout)
+	for attempt := 0; err != nil && attempt < policy.maxRetries && policy.retryable(err); attempt++ {
+		wait := backoffDuration(attempt, policy.initialBackoff, policy.maxBackoff, policy.jitter)
+		lc.Warnf("Request to topic '%s' failed: %s, retrying in %s (%d/%d). Request-id: %s, Correlation-id: %s", requestTopic, err.Error(), wait, attempt+1, policy.maxRetries, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("request to topic '%s' not retried: %w, last failure: %s", requestTopic, ctx.Err(), err.Error())
+		case <-timer.C:
+		}
+		response, err = messageBus.Request(requestEnvelope, requestTopic, responseTopicPrefix, requestTimeout)
+	}
+
+	return response, err
+}
+
+// requestDevice sends the device request of the command via the internal MessageBus like requestWithRetry, within the
+// producer span of the request. The response with an error fails the span as well.
+func requestDevice(
+	ctx context.Context,
+	messageBus messaging.MessageClient,
+	requestEnvelope types.MessageEnvelope,
+	deviceName string,
+	commandName string,
+	method string,
+	requestTopic string,
+	responseTopicPrefix string,
+	requestTimeout time.Duration,
+	dic *di.Container) (*types.MessageEnvelope, error) {
+	configuration := container.ConfigurationFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	ctx, span := tracing.TracerFrom(dic.Get).StartMessageSpan(ctx, tracing.SpanKindProducer, configuration.MessageBus.Type, requestTopic, requestEnvelope)
+	defer span.End()
+
+	policy := resolveRetryPolicy(configuration.Writable.CommandRetry, deviceName, commandName, method, lc)
+	response, err := requestWithRetry(ctx, messageBus, requestEnvelope, requestTopic, responseTopicPrefix, requestTimeout, policy, lc)
+	span.SetError(err)
+	if response != nil && response.ErrorCode == 1 {
+		span.SetError(errors.New(string(response.Payload)))
+	}
+	return response, err
+}
+
+// backoffDuration returns the exponential backoff for the specified attempt, capped by maxBackoff and randomly
+// varied by the jitter fraction.
+func backoffDuration(attempt int, initialBackoff time.Duration, maxBackoff time.Duration, jitter float64) time.Duration {
+	backoff := initialBackoff
+	for i := 0; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	if jitter > 1 {
+		jitter = 1
+	}
+	if jitter > 0 {
+		delta := float64(backoff) * jitter
+		backoff = time.Duration(float64(backoff) - delta + rand.Float64()*2*delta) // nolint:gosec
+	}
+
+	return backoff
+}
+
+func parseBackoff(value string, defaultValue time.Duration, lc logger.LoggingClient) time.Duration {
+	if len(value) == 0 {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		lc.Warnf("Failed to parse CommandRetry backoff '%s', using default value %s: %s", value, defaultValue, err.Error())
+		return defaultValue
+	}
+
+	return duration
+}
@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	lcMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger/mocks"
+	internalMessagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+)
+
+func TestRequestWithRetry(t *testing.T) {
+	lc := &lcMocks.LoggingClient{}
+	lc.On("Warnf", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	getPolicy := commandRetryPolicy{maxRetries: 2, initialBackoff: time.Millisecond, maxBackoff: 2 * time.Millisecond, anyFailure: true}
+	setPolicy := commandRetryPolicy{maxRetries: 2, initialBackoff: time.Millisecond, maxBackoff: 2 * time.Millisecond}
+	timeoutErr := errors.New("timed out waiting for response on 'response/device-simple/1'")
+	undeliveredErr := errors.New("unable to create publish request to 'request': connection lost")
+	expectedResponse := &types.MessageEnvelope{}
+
+	tests := []struct {
+		name             string
+		policy           commandRetryPolicy
+		failure          error
+		failures         int
+		expectedError    bool
+		expectedRequests int
+	}{
+		{"valid - no failure", getPolicy, nil, 0, false, 1},
+		{"valid - get succeed after timeouts", getPolicy, timeoutErr, 2, false, 3},
+		{"valid - set succeed after undelivered requests", setPolicy, undeliveredErr, 2, false, 3},
+		{"invalid - get retries exhausted", getPolicy, timeoutErr, 3, true, 3},
+		{"invalid - set not retried after timeout", setPolicy, timeoutErr, 1, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &internalMessagingMocks.MessageClient{}
+			if tt.failures > 0 {
+				client.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, tt.failure).Times(tt.failures)
+			}
+			client.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(expectedResponse, nil)
+
+			response, err := requestWithRetry(context.Background(), client, testCommandRequestPayload(), "request", "response", time.Second, tt.policy, lc)
+			client.AssertNumberOfCalls(t, "Request", tt.expectedRequests)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expectedResponse, response)
+		})
+	}
+}
+
+func TestRequestWithRetryContextDone(t *testing.T) {
+	lc := &lcMocks.LoggingClient{}
+	lc.On("Warnf", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	client := &internalMessagingMocks.MessageClient{}
+	client.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("timed out waiting for response"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	policy := commandRetryPolicy{maxRetries: 5, initialBackoff: time.Minute, maxBackoff: time.Minute, anyFailure: true}
+	_, err := requestWithRetry(ctx, client, testCommandRequestPayload(), "request", "response", time.Second, policy, lc)
+	require.ErrorIs(t, err, context.Canceled)
+	client.AssertNumberOfCalls(t, "Request", 1)
+}
+
+func TestResolveRetryPolicy(t *testing.T) {
+	lc := logger.NewMockClient()
+	retryInfo := config.CommandRetryInfo{
+		MaxRetries:     2,
+		InitialBackoff: "10ms",
+		MaxBackoff:     "1s",
+		Overrides: []config.CommandRetryOverride{
+			{DeviceName: "Flaky-Sensor", MaxRetries: 5},
+			{DeviceName: "Thermostat", CommandName: "SetPoint", MaxRetries: 3, Idempotent: true},
+			{DeviceName: "Thermostat", MaxRetries: 0},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		commandName        string
+		method             string
+		expectedMaxRetries int
+		expectedAnyFailure bool
+	}{
+		{"get without override", "Random-Device", "Temperature", "get", 2, true},
+		{"set without override", "Random-Device", "Temperature", "set", 2, false},
+		{"device override", "Flaky-Sensor", "Humidity", "get", 5, true},
+		{"command override takes precedence", "Thermostat", "SetPoint", "set", 3, true},
+		{"device override of other command", "Thermostat", "Mode", "set", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := resolveRetryPolicy(retryInfo, tt.deviceName, tt.commandName, tt.method, lc)
+			assert.Equal(t, tt.expectedMaxRetries, policy.maxRetries)
+			assert.Equal(t, tt.expectedAnyFailure, policy.anyFailure)
+			assert.Equal(t, 10*time.Millisecond, policy.initialBackoff)
+			assert.Equal(t, time.Second, policy.maxBackoff)
+		})
+	}
+}
+
+func TestBackoffDuration(t *testing.T) {
+	initial := 100 * time.Millisecond
+	max := time.Second
+
+	assert.Equal(t, initial, backoffDuration(0, initial, max, 0))
+	assert.Equal(t, 400*time.Millisecond, backoffDuration(2, initial, max, 0))
+	assert.Equal(t, max, backoffDuration(10, initial, max, 0))
+
+	for i := 0; i < 10; i++ {
+		backoff := backoffDuration(0, initial, max, 0.5)
+		assert.GreaterOrEqual(t, backoff, 50*time.Millisecond)
+		assert.LessOrEqual(t, backoff, 150*time.Millisecond)
+	}
+}
@@ -12,29 +12,40 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
+	"reflect"
+	"strings"
+	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/fxamacker/cbor/v2"
 
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandDTOs "github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 )
 
-// validateRequestTopic validates the request topic by checking the existence of device and device service,
-// returns the internal device request topic and service name to which the command request will be sent.
-func validateRequestTopic(prefix string, deviceName string, commandName string, method string, dic *di.Container) (string, string, error) {
+// settingsDecMode decodes the CBOR encoded set command settings with the nested maps keyed by string, like the JSON
+// encoded ones, so that the settings can be re-encoded as JSON
+var settingsDecMode, _ = cbor.DecOptions{DefaultMapType: reflect.TypeOf(map[string]any(nil))}.DecMode()
+
+// validateRequestTopic validates the request topic by checking the existence and the state of device and device service,
+// returns the internal device request topic and service name to which the command request will be sent. The error
+// returned when the device doesn't exist wraps the EdgeX error of core-metadata, so that its kind can be checked.
+func validateRequestTopic(ctx context.Context, prefix string, deviceName string, commandName string, method string, dic *di.Container) (string, string, error) {
 	// retrieve device information through Metadata DeviceClient
 	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
 	if dc == nil {
 		return "", "", errors.New("nil Device Client")
 	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	deviceResponse, err := dc.DeviceByName(ctx, deviceName)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get Device by name %s: %v", deviceName, err)
+		return "", "", fmt.Errorf("failed to get Device by name %s: %w", deviceName, err)
 	}
 
 	// retrieve device service information through Metadata DeviceClient
@@ -42,16 +53,59 @@ func validateRequestTopic(prefix string, deviceName string, commandName string,
 	if dsc == nil {
 		return "", "", errors.New("nil DeviceService Client")
 	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceServiceResponse, err := dsc.DeviceServiceByName(ctx, deviceResponse.Device.ServiceName)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get DeviceService by name %s: %v", deviceResponse.Device.ServiceName, err)
 	}
+	if err = application.CheckCommandState(deviceResponse.Device, deviceServiceResponse.Service); err != nil {
+		return "", "", err
+	}
 
 	// expected internal command request topic scheme: <prefix>/<device-service>/<device>/<command-name>/<method>
 	return deviceServiceResponse.Service.Name, common.BuildTopic(prefix, deviceServiceResponse.Service.Name, deviceName, commandName, method), nil
 
 }
 
+// checkEnvelopeSize returns an error when the size in bytes of the request envelope exceeds MaxEnvelopeSize, so that the
+// oversized requests are rejected before being processed
+func checkEnvelopeSize(size int, dic *di.Container) error {
+	maxEnvelopeSize := container.ConfigurationFrom(dic.Get).MaxEnvelopeSize
+	if maxEnvelopeSize > 0 && int64(size) > maxEnvelopeSize*1024 {
+		return fmt.Errorf("request size %d bytes exceeds MaxEnvelopeSize(%d KB)", size, maxEnvelopeSize)
+	}
+	return nil
+}
+
+// decodeSetCommandSettings decodes the settings of the set command request according to the ContentType of the envelope
+func decodeSetCommandSettings(requestEnvelope types.MessageEnvelope) (map[string]any, error) {
+	var settings map[string]any
+	var err error
+	if requestEnvelope.ContentType == common.ContentTypeCBOR {
+		err = settingsDecMode.Unmarshal(requestEnvelope.Payload, &settings)
+	} else {
+		err = json.Unmarshal(requestEnvelope.Payload, &settings)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode set command settings: %s", err.Error())
+	}
+	return settings, nil
+}
+
+// encodeSetCommandSettings encodes the settings of the set command request according to the ContentType of the envelope
+func encodeSetCommandSettings(settings map[string]any, contentType string) ([]byte, error) {
+	var payload []byte
+	var err error
+	if contentType == common.ContentTypeCBOR {
+		payload, err = cbor.Marshal(settings)
+	} else {
+		payload, err = json.Marshal(settings)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode set command settings: %s", err.Error())
+	}
+	return payload, nil
+}
+
 // validateGetCommandQueryParameters validates the value is valid for device service's reserved query parameters
 func validateGetCommandQueryParameters(queryParams map[string]string) error {
 	if dsReturnEvent, ok := queryParams[common.ReturnEvent]; ok {
@@ -68,34 +122,124 @@ func validateGetCommandQueryParameters(queryParams map[string]string) error {
 	return nil
 }
 
-// getCommandQueryResponseEnvelope returns the MessageEnvelope containing the DeviceCoreCommand payload bytes
+// validateSetCommandPayload validates the settings of the set command request against the device profile, when
+// SetCommandValidation is enabled
+func validateSetCommandPayload(requestEnvelope types.MessageEnvelope, deviceName string, commandName string, method string, dic *di.Container) error {
+	if !strings.EqualFold(method, "set") || !container.ConfigurationFrom(dic.Get).SetCommandValidation.Enabled {
+		return nil
+	}
+
+	settings, err := decodeSetCommandSettings(requestEnvelope)
+	if err != nil {
+		return err
+	}
+
+	if err := application.ValidateSetCommand(deviceName, commandName, settings, dic); err != nil {
+		return err
+	}
+	return nil
+}
+
+// transformCommandRequest applies the CommandTransform rules to the query parameters and set command settings of the
+// request, when command transformation is enabled. The settings are decoded and encoded according to the ContentType of
+// the envelope, and the request is left untouched when no rule applies to it.
+func transformCommandRequest(requestEnvelope *types.MessageEnvelope, deviceName string, commandName string, method string, dic *di.Container) error {
+	pipeline := application.CommandTransformPipelineFrom(dic.Get)
+	req := commandDTOs.IssueCommandRequest{
+		DeviceName:  deviceName,
+		CommandName: commandName,
+		Method:      strings.ToLower(method),
+		QueryParams: requestEnvelope.QueryParams,
+	}
+	if !pipeline.Matches(req) {
+		return nil
+	}
+	if req.Method == "set" {
+		settings, err := decodeSetCommandSettings(*requestEnvelope)
+		if err != nil {
+			return err
+		}
+		req.Settings = settings
+	}
+
+	if err := pipeline.Transform(&req); err != nil {
+		return err
+	}
+
+	requestEnvelope.QueryParams = req.QueryParams
+	if req.Method == "set" {
+		payload, err := encodeSetCommandSettings(req.Settings, requestEnvelope.ContentType)
+		if err != nil {
+			return err
+		}
+		requestEnvelope.Payload = payload
+	}
+	return nil
+}
+
+// resolveCommandTimeout returns the timeout of the command request and removes the cmd-timeout query parameter from
+// the request, as it is not meant for the device service.
+func resolveCommandTimeout(requestEnvelope types.MessageEnvelope, deviceName string, commandName string, defaultTimeout time.Duration, dic *di.Container) (time.Duration, error) {
+	requestedTimeout := requestEnvelope.QueryParams[pkgCommon.CommandTimeout]
+	delete(requestEnvelope.QueryParams, pkgCommon.CommandTimeout)
+
+	timeout, err := application.ResolveCommandTimeout(deviceName, commandName, requestedTimeout, defaultTimeout, dic)
+	if err != nil {
+		return 0, err
+	}
+	return timeout, nil
+}
+
+// auditCommandRequest publishes the audit event of the command request forwarded to the device service via the
+// internal MessageBus, response is nil if the request failed with err.
+func auditCommandRequest(origin application.CommandOrigin, requestEnvelope types.MessageEnvelope, deviceName string, commandName string, method string,
+	response *types.MessageEnvelope, err error, start time.Time, dic *di.Container) {
+	req := commandDTOs.IssueCommandRequest{
+		DeviceName:  deviceName,
+		CommandName: commandName,
+		Method:      strings.ToLower(method),
+		QueryParams: requestEnvelope.QueryParams,
+	}
+	if req.Method == "set" {
+		// the settings which can't be decoded are not recorded, the request being audited regardless
+		req.Settings, _ = decodeSetCommandSettings(requestEnvelope)
+	}
+
+	var errorMessage string
+	if err != nil {
+		errorMessage = err.Error()
+	} else if response != nil && response.ErrorCode == 1 {
+		errorMessage = string(response.Payload)
+	}
+
+	application.AuditCommand(origin, req, 0, errorMessage, start, dic)
+}
+
+// getCommandQueryResponseEnvelope returns the MessageEnvelope containing the DeviceCoreCommand payload bytes. When
+// more devices remain to be returned, the cmd-continuation query parameter of the envelope contains the token
+// requesting the next part.
 func getCommandQueryResponseEnvelope(requestEnvelope types.MessageEnvelope, deviceName string, dic *di.Container) (types.MessageEnvelope, error) {
 	var commandsResponse any
-	var err error
+	var continuationToken string
 
 	switch deviceName {
 	case common.All:
-		offset, limit := common.DefaultOffset, common.DefaultLimit
-		if requestEnvelope.QueryParams != nil {
-			if offsetRaw, ok := requestEnvelope.QueryParams[common.Offset]; ok {
-				offset, err = strconv.Atoi(offsetRaw)
-				if err != nil {
-					return types.MessageEnvelope{}, fmt.Errorf("failed to convert 'offset' query parameter to intger: %s", err.Error())
-				}
-			}
-			if limitRaw, ok := requestEnvelope.QueryParams[common.Limit]; ok {
-				limit, err = strconv.Atoi(limitRaw)
-				if err != nil {
-					return types.MessageEnvelope{}, fmt.Errorf("failed to convert 'limit' query parameter to integer: %s", err.Error())
-				}
-			}
+		page, err := parseCommandQueryPage(requestEnvelope.QueryParams)
+		if err != nil {
+			return types.MessageEnvelope{}, err
 		}
 
-		commands, totalCounts, edgexError := application.AllCommands(offset, limit, dic)
+		maxDevices := container.ConfigurationFrom(dic.Get).CommandQuery.MaxDevicesPerResponse
+		commands, totalCounts, edgexError := application.AllCommands(page.Offset, page.chunkLimit(maxDevices), dic)
 		if edgexError != nil {
 			return types.MessageEnvelope{}, fmt.Errorf("failed to get all commands: %s", edgexError.Error())
 		}
 
+		continuationToken, err = page.continuationToken(len(commands), totalCounts, maxDevices)
+		if err != nil {
+			return types.MessageEnvelope{}, err
+		}
+
 		commandsResponse = responses.NewMultiDeviceCoreCommandsResponse(requestEnvelope.RequestID, "", http.StatusOK, totalCounts, commands)
 	default:
 		commands, edgexError := application.CommandsByDeviceName(deviceName, dic)
@@ -111,6 +255,36 @@ func getCommandQueryResponseEnvelope(requestEnvelope types.MessageEnvelope, devi
 		return types.MessageEnvelope{}, fmt.Errorf("failed to json encoding device commands payload: %s", err.Error())
 	}
 
+	responseEnvelope, err := types.NewMessageEnvelopeForResponse(responseBytes, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+	if err != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to create response MessageEnvelope: %s", err.Error())
+	}
+	if continuationToken != "" {
+		responseEnvelope.QueryParams[pkgCommon.CommandContinuation] = continuationToken
+	}
+
+	return responseEnvelope, nil
+}
+
+// getBatchCommandResponseEnvelope issues the batch of commands contained in the request payload and returns the
+// MessageEnvelope containing the IssueCommandResponse payload bytes
+func getBatchCommandResponseEnvelope(requestEnvelope types.MessageEnvelope, dic *di.Container) (types.MessageEnvelope, error) {
+	var reqDTOs []commandDTOs.IssueCommandRequest
+	if err := json.Unmarshal(requestEnvelope.Payload, &reqDTOs); err != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to decode batch command request payload: %s", err.Error())
+	}
+
+	origin := application.CommandOrigin{Source: commandDTOs.AuditSourceMessageBus, Requester: requestEnvelope.ReceivedTopic}
+	commandResponses, edgexError := application.IssueBatchCommands(reqDTOs, origin, dic)
+	if edgexError != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to issue batch commands: %s", edgexError.Error())
+	}
+
+	responseBytes, err := json.Marshal(commandResponses)
+	if err != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to json encoding batch command response payload: %s", err.Error())
+	}
+
 	responseEnvelope, err := types.NewMessageEnvelopeForResponse(responseBytes, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
 	if err != nil {
 		return types.MessageEnvelope{}, fmt.Errorf("failed to create response MessageEnvelope: %s", err.Error())
@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"encoding/json"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+func TestTransformCommandRequest(t *testing.T) {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				CommandTransform: config.CommandTransformInfo{
+					Rules: []config.CommandTransformRule{
+						{DeviceName: "Thermostat", Method: "set", RenameSettings: map[string]string{"temp": "Temperature"}},
+						{DeviceName: "Boiler", Method: "set", ConvertSettings: map[string]config.UnitConversion{"Temperature": {Scale: 2, Offset: 1}}},
+					},
+				},
+			}
+		},
+	})
+	pipeline := application.NewCommandTransformPipeline(dic)
+	dic.Update(di.ServiceConstructorMap{
+		application.CommandTransformPipelineName: func(get di.Get) interface{} {
+			return pipeline
+		},
+	})
+
+	settings := map[string]any{"temp": "20"}
+	expectedSettings := map[string]any{"Temperature": "20"}
+	jsonSettings, err := json.Marshal(settings)
+	require.NoError(t, err)
+	cborSettings, err := cbor.Marshal(settings)
+	require.NoError(t, err)
+	// the CBOR integers are decoded as uint64 and int64
+	cborIntegerSettings, err := cbor.Marshal(map[string]any{"Temperature": 20})
+	require.NoError(t, err)
+	cborNegativeSettings, err := cbor.Marshal(map[string]any{"Temperature": -20})
+	require.NoError(t, err)
+	invalidCBOR := []byte{0xff}
+
+	tests := []struct {
+		name              string
+		deviceName        string
+		contentType       string
+		payload           []byte
+		expectedSettings  map[string]any
+		expectedUntouched bool
+		expectedError     bool
+	}{
+		{"valid - json settings transformed", "Thermostat", common.ContentTypeJSON, jsonSettings, expectedSettings, false, false},
+		{"valid - cbor settings transformed", "Thermostat", common.ContentTypeCBOR, cborSettings, expectedSettings, false, false},
+		{"valid - cbor integer setting converted", "Boiler", common.ContentTypeCBOR, cborIntegerSettings, map[string]any{"Temperature": float64(41)}, false, false},
+		{"valid - cbor negative integer setting converted", "Boiler", common.ContentTypeCBOR, cborNegativeSettings, map[string]any{"Temperature": float64(-39)}, false, false},
+		{"valid - no rule applies", "Random-Device", common.ContentTypeCBOR, invalidCBOR, nil, true, false},
+		{"invalid - undecodable settings", "Thermostat", common.ContentTypeCBOR, invalidCBOR, nil, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestEnvelope := types.MessageEnvelope{ContentType: tt.contentType, Payload: tt.payload}
+			err := transformCommandRequest(&requestEnvelope, tt.deviceName, "SetPoint", "set", dic)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectedUntouched {
+				assert.Equal(t, tt.payload, requestEnvelope.Payload)
+				return
+			}
+			assert.Equal(t, tt.contentType, requestEnvelope.ContentType)
+			decoded, err := decodeSetCommandSettings(requestEnvelope)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSettings, decoded)
+		})
+	}
+}
+
+func TestValidateSetCommandPayload(t *testing.T) {
+	maximum := float64(100)
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "Thermostat-Profile"},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "SetPoint", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_RW, Maximum: &maximum}},
+		},
+	}
+	dcMock := &clientMocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, "Thermostat").Return(responses.DeviceResponse{Device: dtos.Device{Name: "Thermostat", ProfileName: profile.Name}}, nil)
+	dpcMock := &clientMocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, profile.Name).Return(responses.DeviceProfileResponse{Profile: profile}, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{SetCommandValidation: config.SetCommandValidationInfo{Enabled: true}}
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+	})
+
+	encode := func(settings map[string]any, contentType string) []byte {
+		payload, err := encodeSetCommandSettings(settings, contentType)
+		require.NoError(t, err)
+		return payload
+	}
+
+	tests := []struct {
+		name          string
+		contentType   string
+		payload       []byte
+		expectedError bool
+	}{
+		{"valid - json", common.ContentTypeJSON, encode(map[string]any{"SetPoint": 42}, common.ContentTypeJSON), false},
+		{"valid - cbor", common.ContentTypeCBOR, encode(map[string]any{"SetPoint": 42}, common.ContentTypeCBOR), false},
+		{"invalid - cbor above maximum", common.ContentTypeCBOR, encode(map[string]any{"SetPoint": 420}, common.ContentTypeCBOR), true},
+		{"invalid - undecodable cbor", common.ContentTypeCBOR, []byte{0xff}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestEnvelope := types.MessageEnvelope{ContentType: tt.contentType, Payload: tt.payload}
+			err := validateSetCommandPayload(requestEnvelope, "Thermostat", "SetPoint", "set", dic)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// Source of the command recorded in the CommandAuditEvent
+const (
+	AuditSourceREST         = "REST"
+	AuditSourceMessageBus   = "MessageBus"
+	AuditSourceExternalMQTT = "ExternalMQTT"
+	AuditSourceExternalAMQP = "ExternalAMQP"
+)
+
+// CommandAuditEvent records who issued a command, its parameters and its outcome.
+type CommandAuditEvent struct {
+	Id          string            `json:"id"`
+	Timestamp   int64             `json:"timestamp"`
+	Source      string            `json:"source"`
+	Requester   string            `json:"requester,omitempty"`
+	DeviceName  string            `json:"deviceName"`
+	CommandName string            `json:"commandName"`
+	Method      string            `json:"method"`
+	QueryParams map[string]string `json:"queryParams,omitempty"`
+	Settings    map[string]any    `json:"settings,omitempty"`
+	Success     bool              `json:"success"`
+	StatusCode  int               `json:"statusCode,omitempty"`
+	Message     string            `json:"message,omitempty"`
+	// Latency is the time taken to execute the command in nanoseconds
+	Latency int64 `json:"latency"`
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// IssueCommandRequest defines the Request Content for issuing a single get or set command as part of a batch.
+type IssueCommandRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceName            string            `json:"deviceName" validate:"required,edgex-dto-none-empty-string"`
+	CommandName           string            `json:"commandName" validate:"required,edgex-dto-none-empty-string"`
+	Method                string            `json:"method" validate:"required,oneof='get' 'set'"`
+	QueryParams           map[string]string `json:"queryParams,omitempty"`
+	Settings              map[string]any    `json:"settings,omitempty" validate:"required_if=Method set"`
+}
+
+// Validate satisfies the Validator interface
+func (r IssueCommandRequest) Validate() error {
+	err := common.Validate(r)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the IssueCommandRequest type
+func (r *IssueCommandRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceName  string
+		CommandName string
+		Method      string
+		QueryParams map[string]string
+		Settings    map[string]any
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = IssueCommandRequest(alias)
+
+	// validate IssueCommandRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IssueCommandResponse defines the Response Content for a single command issued as part of a batch.
+type IssueCommandResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	DeviceName             string      `json:"deviceName"`
+	CommandName            string      `json:"commandName"`
+	Method                 string      `json:"method"`
+	Event                  *dtos.Event `json:"event,omitempty"`
+}
+
+// NewIssueCommandResponse creates an IssueCommandResponse for the specified request
+func NewIssueCommandResponse(req IssueCommandRequest, message string, statusCode int, event *dtos.Event) IssueCommandResponse {
+	return IssueCommandResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(req.RequestId, message, statusCode),
+		DeviceName:   req.DeviceName,
+		CommandName:  req.CommandName,
+		Method:       req.Method,
+		Event:        event,
+	}
+}
@@ -0,0 +1,45 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// Status of the CommandJob
+const (
+	JobStatusPending   = "PENDING"
+	JobStatusCompleted = "COMPLETED"
+	JobStatusFailed    = "FAILED"
+)
+
+// CommandJob defines the state and result of a command issued asynchronously.
+type CommandJob struct {
+	Id          string      `json:"id"`
+	DeviceName  string      `json:"deviceName"`
+	CommandName string      `json:"commandName"`
+	Method      string      `json:"method"`
+	Status      string      `json:"status"`
+	Created     int64       `json:"created"`
+	Completed   int64       `json:"completed,omitempty"`
+	StatusCode  int         `json:"statusCode,omitempty"`
+	Message     string      `json:"message,omitempty"`
+	Event       *dtos.Event `json:"event,omitempty"`
+}
+
+// CommandJobResponse defines the Response Content for GET CommandJob DTO.
+type CommandJobResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Job                    CommandJob `json:"job"`
+}
+
+func NewCommandJobResponse(requestId string, message string, statusCode int, job CommandJob) CommandJobResponse {
+	return CommandJobResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Job:          job,
+	}
+}
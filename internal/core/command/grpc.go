@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"google.golang.org/grpc"
+
+	commandGrpc "github.com/edgexfoundry/edgex-go/internal/core/command/controller/grpc"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	edgexpb "github.com/edgexfoundry/edgex-go/proto/edgex/v3"
+)
+
+// grpcIssueSetCommandMethod is the full method of the set commands, which the permissionTable maps to the command
+// permission like the REST route
+const grpcIssueSetCommandMethod = "/edgex.v3.CoreCommand/IssueSetCommand"
+
+// grpcServices is the CoreCommand gRPC service, authenticated like the REST API
+var grpcServices = pkgHandlers.GrpcServices{
+	Register: func(server *grpc.Server, dic *di.Container) {
+		edgexpb.RegisterCoreCommandServer(server, commandGrpc.NewCoreCommandServer(dic))
+	},
+	AuthenticationHook: newAuthenticationHook,
+	HttpMethods: map[string]string{
+		grpcIssueSetCommandMethod: http.MethodPut,
+	},
+}
@@ -18,7 +18,9 @@ package command
 
 import (
 	"context"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
@@ -27,6 +29,15 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	clients "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http"
 	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/health"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 // Bootstrap contains references to dependencies required by the BootstrapHandler.
@@ -45,15 +56,69 @@ func NewBootstrap(router *mux.Router, serviceName string) *Bootstrap {
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the command service.
 func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !audit.BootstrapAuditor(commandContainer.ConfigurationFrom(dic.Get).Audit, b.serviceName, dic) {
+		return false
+	}
+	if !apikey.BootstrapValidator(ctx, wg, commandContainer.ConfigurationFrom(dic.Get).APIKey, dic) {
+		return false
+	}
+	if !tracing.BootstrapTracer(ctx, wg, commandContainer.ConfigurationFrom(dic.Get).Tracing, b.serviceName, dic) {
+		return false
+	}
+	if !health.BootstrapAggregator(ctx, wg, commandContainer.ConfigurationFrom(dic.Get).Health, dic) {
+		return false
+	}
 	LoadRestRoutes(b.router, dic, b.serviceName)
+	messaging.RegisterMetrics(dic)
 
 	// DeviceServiceCommandClient is not part of the common clients handled by the NewClientsBootstrap handler
+	switch transport := commandContainer.ConfigurationFrom(dic.Get).CommandTransport.Type; strings.ToLower(transport) {
+	case "", config.CommandTransportHTTP:
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} { // add API DeviceServiceCommandClient
+				jwtSecretProvider := secret.NewJWTSecretProvider(container.SecretProviderExtFrom(get))
+				return clients.NewDeviceServiceCommandClient(jwtSecretProvider)
+			},
+		})
+	case config.CommandTransportMessageBus:
+		// Service.RequestTimeout is validated by the messaging bootstrap handler
+		requestTimeout, _ := time.ParseDuration(commandContainer.ConfigurationFrom(dic.Get).Service.RequestTimeout)
+		deviceServiceCommandClient := messaging.NewDeviceServiceCommandClient(requestTimeout, dic)
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+				return deviceServiceCommandClient
+			},
+		})
+	default:
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("Unknown CommandTransport.Type '%s', expected '%s' or '%s'", transport, config.CommandTransportHTTP, config.CommandTransportMessageBus)
+		return false
+	}
+
+	asyncCommandManager := application.NewAsyncCommandManager(dic)
+	asyncCommandManager.StartPurging(ctx, wg)
 	dic.Update(di.ServiceConstructorMap{
-		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} { // add API DeviceServiceCommandClient
-			jwtSecretProvider := secret.NewJWTSecretProvider(container.SecretProviderExtFrom(get))
-			return clients.NewDeviceServiceCommandClient(jwtSecretProvider)
+		application.AsyncCommandManagerName: func(get di.Get) interface{} {
+			return asyncCommandManager
 		},
 	})
 
+	if commandContainer.ConfigurationFrom(dic.Get).CommandCache.Enabled {
+		commandCache := application.NewCommandCache(dic)
+		dic.Update(di.ServiceConstructorMap{
+			application.CommandCacheName: func(get di.Get) interface{} {
+				return commandCache
+			},
+		})
+	}
+
+	if commandContainer.ConfigurationFrom(dic.Get).CommandTransform.Enabled {
+		transformPipeline := application.NewCommandTransformPipeline(dic)
+		dic.Update(di.ServiceConstructorMap{
+			application.CommandTransformPipelineName: func(get di.Get) interface{} {
+				return transformPipeline
+			},
+		})
+	}
+
 	return true
 }
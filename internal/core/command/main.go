@@ -35,6 +35,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 
@@ -61,7 +62,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		},
 	})
 
-	httpServer := handlers.NewHttpServer(router, true)
+	httpServer := pkgHandlers.NewHttpServer(router, true, &configuration.MutualTLS)
+	grpcServer := pkgHandlers.NewGrpcServer(&configuration.Grpc, httpServer, grpcServices)
 
 	bootstrap.Run(
 		ctx,
@@ -76,50 +78,65 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		bootstrapConfig.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
 			handlers.NewClientsBootstrap(f.InDevMode()).BootstrapHandler,
-			MessagingBootstrapHandler,
+			messagingBootstrapHandler(f),
 			handlers.NewServiceMetrics(common.CoreCommandServiceKey).BootstrapHandler, // Must be after Messaging
 			NewBootstrap(router, common.CoreCommandServiceKey).BootstrapHandler,
 			httpServer.BootstrapHandler,
+			grpcServer.BootstrapHandler, // Must be after the HttpServer, which loads the mutual TLS certificate
 			handlers.NewStartMessage(common.CoreCommandServiceKey, edgex.Version).BootstrapHandler,
 		})
 
 	// code here!
 }
 
-// MessagingBootstrapHandler sets up the MessageBus and External MQTT connections as well as subscriptions
-func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
-	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
-	configuration := container.ConfigurationFrom(dic.Get)
+// messagingBootstrapHandler sets up the MessageBus, External MQTT and External AMQP connections as well as
+// subscriptions, which follow the changes of the MessageBus and ExternalMQTT configuration in the Configuration Provider
+func messagingBootstrapHandler(f flags.Common) interfaces.BootstrapHandler {
+	return func(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		configuration := container.ConfigurationFrom(dic.Get)
 
-	if len(configuration.Service.RequestTimeout) == 0 {
-		lc.Error("Service.RequestTimeout found empty in service's configuration, missing common config? Use -cp or -cc flags for common config")
-		return false
-	}
+		if len(configuration.Service.RequestTimeout) == 0 {
+			lc.Error("Service.RequestTimeout found empty in service's configuration, missing common config? Use -cp or -cc flags for common config")
+			return false
+		}
 
-	requestTimeout, err := time.ParseDuration(configuration.Service.RequestTimeout)
-	if err != nil {
-		lc.Errorf("Failed to parse Service.RequestTimeout configuration value: %v", err)
-		return false
-	}
+		requestTimeout, err := time.ParseDuration(configuration.Service.RequestTimeout)
+		if err != nil {
+			lc.Errorf("Failed to parse Service.RequestTimeout configuration value: %v", err)
+			return false
+		}
+
+		if configuration.ExternalMQTT.Enabled {
+			externalMQTT := pkgHandlers.NewExternalMQTT(
+				messaging.OnConnectHandler(requestTimeout, dic),
+				configuration.ExternalMQTTFailover.BrokerUrls,
+				messaging.OnExternalMQTTFailover,
+				pkgHandlers.TLSInfo(configuration.ExternalMQTTTLS))
+			if !externalMQTT.BootstrapHandler(ctx, wg, startupTimer, dic) {
+				return false
+			}
+			pkgHandlers.ListenForConfigChanges(ctx, wg, f, dic, pkgHandlers.ExternalMQTTSection, configuration.ExternalMQTT,
+				messaging.OnExternalMQTTChanged(externalMQTT, requestTimeout, dic))
+		}
 
-	if configuration.ExternalMQTT.Enabled {
-		if !handlers.NewExternalMQTT(messaging.OnConnectHandler(requestTimeout, dic)).BootstrapHandler(ctx, wg, startupTimer, dic) {
+		if !handlers.MessagingBootstrapHandler(ctx, wg, startupTimer, dic) {
 			return false
 		}
-	}
+		subscriptions := messaging.NewInternalSubscriptions(requestTimeout, dic)
+		if err := subscriptions.Subscribe(ctx, dic); err != nil {
+			lc.Errorf("Failed to subscribe requests from internal message bus, %v", err)
+			return false
+		}
+		pkgHandlers.ListenForMessageBusChanges(ctx, wg, f, dic, subscriptions)
 
-	if !handlers.MessagingBootstrapHandler(ctx, wg, startupTimer, dic) {
-		return false
-	}
-	if err := messaging.SubscribeCommandRequests(ctx, requestTimeout, dic); err != nil {
-		lc.Errorf("Failed to subscribe commands request from internal message bus, %v", err)
-		return false
-	}
+		// the requests received from the external AMQP broker are forwarded with the internal MessageBus client
+		if configuration.ExternalAMQP.Enabled {
+			if !messaging.NewExternalAMQP(requestTimeout, dic).BootstrapHandler(ctx, wg, startupTimer, dic) {
+				return false
+			}
+		}
 
-	if err := messaging.SubscribeCommandQueryRequests(ctx, dic); err != nil {
-		lc.Errorf("Failed to subscribe command query request from internal message bus, %v", err)
-		return false
+		return true
 	}
-
-	return true
 }
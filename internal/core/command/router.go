@@ -17,17 +17,46 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/gorilla/mux"
 
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	commandController "github.com/edgexfoundry/edgex-go/internal/core/command/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/health"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
+// permissionTable defines the permissions of the core-command routes which differ from the default permission of their
+// method, issuing set commands, including through the gRPC API, requires the command permission and querying the audit
+// records and the API keys the audit permission
+var permissionTable = rbac.PermissionTable{
+	rbac.RouteKey(http.MethodPut, common.ApiDeviceNameCommandNameRoute):         rbac.PermissionCommand,
+	rbac.RouteKey(http.MethodPut, grpcIssueSetCommandMethod):                    rbac.PermissionCommand,
+	rbac.RouteKey(http.MethodPost, pkgCommon.ApiDeviceCommandsRoute):            rbac.PermissionCommand,
+	rbac.RouteKey(http.MethodPut, pkgCommon.ApiDeviceGroupNameCommandNameRoute): rbac.PermissionCommand,
+	rbac.RouteKey(http.MethodGet, pkgCommon.ApiAuditRoute):                      rbac.PermissionAudit,
+	rbac.RouteKey(http.MethodGet, pkgCommon.ApiApiKeyRoute):                     rbac.PermissionAudit,
+}
+
+// newAuthenticationHook returns the hook authenticating the requests with an API key or the authentication of the
+// go-mod-bootstrap, and authorizing them with the permissionTable. It also authenticates the gRPC calls.
+func newAuthenticationHook(dic *di.Container) func(inner http.HandlerFunc) http.HandlerFunc {
+	lc := container.LoggingClientFrom(dic.Get)
+	secretProvider := container.SecretProviderExtFrom(dic.Get)
+	authenticationHook := rbac.AuthorizationHandlerFunc(commandContainer.ConfigurationFrom(dic.Get).RBAC, permissionTable,
+		handlers.AutoConfigAuthenticationFunc(secretProvider, lc), lc)
+	return apikey.AuthenticationHandlerFunc(apikey.ValidatorFrom(dic.Get), permissionTable, authenticationHook, lc)
+}
+
 func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	// r.UseEncodedPath() tells the router to match the encoded original path to the routes
 	r.UseEncodedPath()
 
 	lc := container.LoggingClientFrom(dic.Get)
-	secretProvider := container.SecretProviderExtFrom(dic.Get)
-	authenticationHook := handlers.AutoConfigAuthenticationFunc(secretProvider, lc)
+	authenticationHook := newAuthenticationHook(dic)
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
@@ -38,8 +67,33 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiDeviceByNameRoute, authenticationHook(cmd.CommandsByDeviceName)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceNameCommandNameRoute, authenticationHook(cmd.IssueGetCommandByName)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceNameCommandNameRoute, authenticationHook(cmd.IssueSetCommandByName)).Methods(http.MethodPut)
+	r.HandleFunc(pkgCommon.ApiDeviceCommandsRoute, authenticationHook(cmd.IssueBatchCommands)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiDeviceGroupNameCommandNameRoute, authenticationHook(cmd.IssueGroupGetCommand)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceGroupNameCommandNameRoute, authenticationHook(cmd.IssueGroupSetCommand)).Methods(http.MethodPut)
+	r.HandleFunc(pkgCommon.ApiCommandResultByJobIdRoute, authenticationHook(cmd.CommandResultByJobId)).Methods(http.MethodGet)
+
+	// Audit
+	auditor := audit.AuditorFrom(dic.Get)
+	if auditor != nil {
+		maxResultCount := container.ConfigurationFrom(dic.Get).GetBootstrap().Service.MaxResultCount
+		r.HandleFunc(pkgCommon.ApiAuditRoute, authenticationHook(audit.QueryHandler(auditor, lc, maxResultCount))).Methods(http.MethodGet)
+	}
+
+	// API keys
+	if validator := apikey.ValidatorFrom(dic.Get); validator != nil {
+		r.HandleFunc(pkgCommon.ApiApiKeyRoute, authenticationHook(apikey.KeysHandler(validator, lc))).Methods(http.MethodGet)
+	}
+
+	// Health
+	if aggregator := health.AggregatorFrom(dic.Get); aggregator != nil {
+		r.HandleFunc(common.ApiHealthRoute, authenticationHook(health.ReportHandler(aggregator, lc))).Methods(http.MethodGet)
+		r.HandleFunc(pkgCommon.ApiHealthMetricsRoute, authenticationHook(health.MetricsHandler(aggregator, lc))).Methods(http.MethodGet)
+	}
 
 	r.Use(correlation.ManageHeader)
+	r.Use(tracing.Middleware(tracing.TracerFrom(dic.Get)))
+	r.Use(audit.Middleware(auditor))
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 	r.Use(correlation.UrlDecodeMiddleware(container.LoggingClientFrom(dic.Get)))
+	r.Use(pkgHandlers.RequestLimitMiddleware(container.ConfigurationFrom(dic.Get).GetBootstrap().Service.MaxRequestSize, container.LoggingClientFrom(dic.Get)))
 }
@@ -0,0 +1,186 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// StartAggregation starts aggregating the readings of each elapsed window, and purging the aggregates older than
+// MaxAge, until the context is canceled. The first window aggregated is the one following the service start.
+func StartAggregation(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	aggregation := container.ConfigurationFrom(dic.Get).Aggregation
+
+	window, err := time.ParseDuration(aggregation.Window)
+	if err != nil || window <= 0 {
+		lc.Errorf("Reading aggregation disabled, invalid Window '%s'", aggregation.Window)
+		return
+	}
+	var maxAge time.Duration
+	if aggregation.MaxAge != "" {
+		maxAge, err = time.ParseDuration(aggregation.MaxAge)
+		if err != nil {
+			lc.Errorf("Reading aggregates will be kept indefinitely, invalid MaxAge '%s'", aggregation.MaxAge)
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		windowStart := time.Now().Truncate(window).Add(window).UnixNano()
+		ticker := time.NewTicker(window)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				windowStart = aggregateElapsedWindows(windowStart, int64(window), time.Now().UnixNano(), dic)
+				if maxAge > 0 {
+					if err := container.DBClientFrom(dic.Get).DeleteReadingAggregatesByAge(int64(maxAge)); err != nil {
+						lc.Errorf("Failed to purge reading aggregates: %s", err.Error())
+					}
+				}
+			}
+		}
+	}()
+}
+
+// aggregateElapsedWindows aggregates the readings of the windows elapsed between windowStart and now, and returns the
+// start of the next window to aggregate. A window failing to be aggregated is retried on the next call.
+func aggregateElapsedWindows(windowStart int64, window int64, now int64, dic *di.Container) int64 {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	for ; windowStart+window <= now; windowStart += window {
+		aggregates, err := aggregateReadings(windowStart, windowStart+window, dic)
+		if err == nil {
+			err = dbClient.AddReadingAggregates(aggregates)
+		}
+		if err != nil {
+			lc.Errorf("Failed to aggregate the readings from %d to %d: %s", windowStart, windowStart+window, err.Error())
+			return windowStart
+		}
+		lc.Debugf("Aggregated the readings from %d to %d into %d aggregates", windowStart, windowStart+window, len(aggregates))
+	}
+	return windowStart
+}
+
+type aggregateKey struct {
+	deviceName   string
+	resourceName string
+}
+
+// aggregateReadings computes the aggregate of the numeric readings of each device resource whose origin is within
+// [start, end). Non-numeric readings are ignored.
+func aggregateReadings(start int64, end int64, dic *di.Container) ([]dataModels.ReadingAggregate, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	pageSize := container.ConfigurationFrom(dic.Get).Service.MaxResultCount
+
+	aggregates := make(map[aggregateKey]*dataModels.ReadingAggregate)
+	var keys []aggregateKey
+	for offset := 0; ; offset += pageSize {
+		readings, err := dbClient.ReadingsByTimeRange(int(start), int(end-1), offset, pageSize)
+		if err != nil {
+			if offset > 0 && errors.Kind(err) == errors.KindRangeNotSatisfiable {
+				break
+			}
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+
+		for _, reading := range readings {
+			simpleReading, ok := reading.(models.SimpleReading)
+			if !ok || !isNumericValueType(simpleReading.ValueType) {
+				continue
+			}
+			value, parseErr := strconv.ParseFloat(simpleReading.Value, 64)
+			if parseErr != nil || math.IsNaN(value) {
+				continue
+			}
+
+			key := aggregateKey{deviceName: simpleReading.DeviceName, resourceName: simpleReading.ResourceName}
+			aggregate, exists := aggregates[key]
+			if !exists {
+				aggregate = &dataModels.ReadingAggregate{
+					DeviceName:   simpleReading.DeviceName,
+					ProfileName:  simpleReading.ProfileName,
+					ResourceName: simpleReading.ResourceName,
+					Start:        start,
+					End:          end,
+					Min:          value,
+					Max:          value,
+				}
+				aggregates[key] = aggregate
+				keys = append(keys, key)
+			}
+			aggregate.Count++
+			aggregate.Sum += value
+			aggregate.Min = math.Min(aggregate.Min, value)
+			aggregate.Max = math.Max(aggregate.Max, value)
+		}
+
+		if len(readings) < pageSize {
+			break
+		}
+	}
+
+	result := make([]dataModels.ReadingAggregate, len(keys))
+	for i, key := range keys {
+		result[i] = *aggregates[key]
+	}
+	return result, nil
+}
+
+func isNumericValueType(valueType string) bool {
+	switch valueType {
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+		common.ValueTypeFloat32, common.ValueTypeFloat64:
+		return true
+	}
+	return false
+}
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange query the reading aggregates of the device resource whose
+// window starts within the time range, by offset and limit
+func ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start, end, offset, limit int, dic *di.Container) (aggregates []dataDTOs.ReadingAggregate, totalCount uint32, err errors.EdgeX) {
+	if deviceName == "" {
+		return aggregates, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
+	}
+	if resourceName == "" {
+		return aggregates, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	}
+
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	aggregateModels, err := dbClient.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, offset, limit)
+	if err == nil {
+		totalCount, err = dbClient.ReadingAggregateCountByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end)
+	}
+	if err != nil {
+		return aggregates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	aggregates = make([]dataDTOs.ReadingAggregate, len(aggregateModels))
+	for i, aggregate := range aggregateModels {
+		aggregates[i] = dataDTOs.FromReadingAggregateModelToDTO(aggregate)
+	}
+	return aggregates, totalCount, nil
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func simpleReading(deviceName string, resourceName string, valueType string, value string) models.SimpleReading {
+	return models.SimpleReading{
+		BaseReading: models.BaseReading{
+			DeviceName:   deviceName,
+			ProfileName:  testProfileName,
+			ResourceName: resourceName,
+			ValueType:    valueType,
+		},
+		Value: value,
+	}
+}
+
+func TestAggregateReadings(t *testing.T) {
+	readings := []models.Reading{
+		simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "2.5e+01"),
+		simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "15"),
+		simpleReading(testDeviceName, "humidity", common.ValueTypeInt16, "40"),
+		simpleReading(testDeviceName, "status", common.ValueTypeString, "ok"),
+		simpleReading(testDeviceName, "samples", common.ValueTypeInt16Array, "[1, 2]"),
+		simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "20"),
+	}
+
+	dbClientMock := &dbMock.DBClient{}
+	// MaxResultCount is 20 in the mock DIC
+	dbClientMock.On("ReadingsByTimeRange", 100, 199, 0, 20).Return(readings, nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	aggregates, err := aggregateReadings(100, 200, dic)
+	require.NoError(t, err)
+	expected := []dataModels.ReadingAggregate{
+		{DeviceName: testDeviceName, ProfileName: testProfileName, ResourceName: "temperature", Start: 100, End: 200, Count: 3, Min: 15, Max: 25, Sum: 60},
+		{DeviceName: testDeviceName, ProfileName: testProfileName, ResourceName: "humidity", Start: 100, End: 200, Count: 1, Min: 40, Max: 40, Sum: 40},
+	}
+	assert.Equal(t, expected, aggregates)
+}
+
+func TestAggregateElapsedWindows(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByTimeRange", mock.Anything, mock.Anything, 0, 20).Return([]models.Reading{}, nil)
+	dbClientMock.On("AddReadingAggregates", mock.Anything).Return(nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	next := aggregateElapsedWindows(100, 100, 350, dic)
+	assert.Equal(t, int64(300), next, "next window start not as expected")
+	dbClientMock.AssertCalled(t, "ReadingsByTimeRange", 100, 199, 0, 20)
+	dbClientMock.AssertCalled(t, "ReadingsByTimeRange", 200, 299, 0, 20)
+	dbClientMock.AssertNumberOfCalls(t, "AddReadingAggregates", 2)
+}
@@ -17,6 +17,7 @@ package application
 import (
 	"context"
 	"sync"
+	"time"
 
 	gometrics "github.com/rcrowley/go-metrics"
 
@@ -24,19 +25,46 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 )
 
 const (
-	eventsPersistedMetricName   = "EventsPersisted"
-	readingsPersistedMetricName = "ReadingsPersisted"
+	eventsPersistedMetricName    = "EventsPersisted"
+	readingsPersistedMetricName  = "ReadingsPersisted"
+	eventsDeduplicatedMetricName = "EventsDeduplicated"
+	invalidReadingsMetricName    = "InvalidReadings"
+	lateEventsMetricName         = "LateEvents"
 )
 
 // CoreDataApp encapsulates the Core Data Application functionality
 // TODO: Extend this App usage beyond Events.
 type CoreDataApp struct {
-	lc                       logger.LoggingClient
-	eventsPersistedCounter   gometrics.Counter
-	readingsPersistedCounter gometrics.Counter
+	lc                        logger.LoggingClient
+	eventsPersistedCounter    gometrics.Counter
+	readingsPersistedCounter  gometrics.Counter
+	eventsDeduplicatedCounter gometrics.Counter
+	invalidReadingsCounter    gometrics.Counter
+	lateEventsCounter         gometrics.Counter
+	// deduplicator is nil when Deduplication is disabled
+	deduplicator *eventDeduplicator
+	// validator is nil when ReadingValidation is disabled
+	validator *readingValidator
+	// batcher is nil when WriteBatching is disabled
+	batcher *eventBatcher
+	// schemaValidator is nil when SchemaValidation is disabled
+	schemaValidator *schemaValidator
+	// latenessChecker is nil when Lateness is disabled
+	latenessChecker *latenessChecker
+	// influxForwarder is nil when InfluxExport is disabled
+	influxForwarder *influxForwarder
+	// storeAndForwarder is nil when StoreAndForward is disabled
+	storeAndForwarder *storeAndForwarder
+	streams           eventStreams
+	// enricher is nil when EventEnrichment is disabled
+	enricher *eventEnricher
 }
 
 // NewCoreDataApp create a new initialized Core Data application
@@ -47,6 +75,98 @@ func NewCoreDataApp(dic *di.Container) *CoreDataApp {
 
 	app.eventsPersistedCounter = gometrics.NewCounter()
 	app.readingsPersistedCounter = gometrics.NewCounter()
+	app.eventsDeduplicatedCounter = gometrics.NewCounter()
+	app.invalidReadingsCounter = gometrics.NewCounter()
+	app.lateEventsCounter = gometrics.NewCounter()
+
+	configuration := container.ConfigurationFrom(dic.Get)
+	deduplication := configuration.Deduplication
+	if deduplication.Enabled {
+		window, err := time.ParseDuration(deduplication.Window)
+		if err != nil || window <= 0 {
+			app.lc.Errorf("Event deduplication disabled, invalid Window '%s'", deduplication.Window)
+		} else {
+			app.deduplicator = newEventDeduplicator(window)
+		}
+	}
+	if configuration.ReadingValidation.Enabled {
+		for i, rule := range configuration.ReadingValidation.Rules {
+			switch rule.Action {
+			case "", config.ReadingValidationActionReject, config.ReadingValidationActionClamp, config.ReadingValidationActionTag:
+			default:
+				app.lc.Errorf("ReadingValidation rule %d has unknown action '%s', the readings violating it will be rejected", i, rule.Action)
+			}
+		}
+		app.validator = newReadingValidator(configuration.ReadingValidation.Rules)
+	}
+	schemaValidation := configuration.SchemaValidation
+	if schemaValidation.Enabled {
+		cacheTTL, err := time.ParseDuration(schemaValidation.CacheTTL)
+		if err != nil || cacheTTL < 0 {
+			app.lc.Errorf("Schema validation disabled, invalid CacheTTL '%s'", schemaValidation.CacheTTL)
+		} else {
+			app.schemaValidator = newSchemaValidator(cacheTTL)
+		}
+	}
+	lateness := configuration.Lateness
+	if lateness.Enabled {
+		maxLateness, err := time.ParseDuration(lateness.MaxLateness)
+		var maxEarliness time.Duration
+		if err == nil && lateness.MaxEarliness != "" {
+			maxEarliness, err = time.ParseDuration(lateness.MaxEarliness)
+		}
+		switch {
+		case err != nil || maxLateness <= 0 || maxEarliness < 0:
+			app.lc.Errorf("Lateness window disabled, invalid MaxLateness '%s' or MaxEarliness '%s'", lateness.MaxLateness, lateness.MaxEarliness)
+		case lateness.Action != config.LatenessActionReject && lateness.Action != config.LatenessActionTag && lateness.Action != config.LatenessActionRoute:
+			app.lc.Errorf("Lateness window disabled, unknown Action '%s'", lateness.Action)
+		default:
+			app.latenessChecker = newLatenessChecker(maxLateness, maxEarliness, lateness.Action)
+		}
+	}
+	writeBatching := configuration.WriteBatching
+	if writeBatching.Enabled {
+		flushInterval, err := time.ParseDuration(writeBatching.FlushInterval)
+		if err != nil || flushInterval <= 0 || writeBatching.MaxSize <= 0 {
+			app.lc.Errorf("Write batching disabled, invalid MaxSize %d or FlushInterval '%s'", writeBatching.MaxSize, writeBatching.FlushInterval)
+		} else {
+			app.batcher = newEventBatcher(writeBatching.MaxSize, flushInterval)
+		}
+	}
+	if configuration.InfluxExport.Enabled {
+		forwarder, err := newInfluxForwarder(configuration.InfluxExport, app.lc)
+		if err != nil {
+			app.lc.Errorf("InfluxDB export disabled, invalid configuration: %v", err)
+		} else {
+			app.influxForwarder = forwarder
+		}
+	}
+	if configuration.StoreAndForward.Enabled {
+		forwarder, err := newStoreAndForwarder(configuration.StoreAndForward, app.lc)
+		if err != nil {
+			app.lc.Errorf("Store-and-forward disabled, invalid configuration: %v", err)
+		} else {
+			app.storeAndForwarder = forwarder
+		}
+	}
+
+	eventEnrichment := configuration.EventEnrichment
+	if eventEnrichment.Enabled {
+		cacheTTL, err := time.ParseDuration(eventEnrichment.CacheTTL)
+		if err != nil || cacheTTL < 0 {
+			app.lc.Errorf("Event enrichment disabled, invalid CacheTTL '%s'", eventEnrichment.CacheTTL)
+		} else {
+			for _, field := range eventEnrichment.Fields {
+				switch field {
+				case config.EventEnrichmentFieldLabels, config.EventEnrichmentFieldLocation, config.EventEnrichmentFieldProfileName:
+				default:
+					app.lc.Errorf("EventEnrichment has unknown field '%s', it will be ignored", field)
+				}
+			}
+			app.enricher = newEventEnricher(eventEnrichment.Fields, cacheTTL)
+		}
+	}
+
 	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
 	if metricsManager == nil {
 		app.lc.Error("Metric Manager not available. Events and Readings metrics will not be collected.")
@@ -64,6 +184,21 @@ func NewCoreDataApp(dic *di.Container) *CoreDataApp {
 	}
 	app.lc.Infof("Registered metrics counter %s", readingsPersistedMetricName)
 
+	if err := metricsManager.Register(eventsDeduplicatedMetricName, app.eventsDeduplicatedCounter, nil); err != nil {
+		app.lc.Errorf("%s metrics will not be collected: %s", eventsDeduplicatedMetricName, err.Error())
+	}
+	app.lc.Infof("Registered metrics counter %s", eventsDeduplicatedMetricName)
+
+	if err := metricsManager.Register(invalidReadingsMetricName, app.invalidReadingsCounter, nil); err != nil {
+		app.lc.Errorf("%s metrics will not be collected: %s", invalidReadingsMetricName, err.Error())
+	}
+	app.lc.Infof("Registered metrics counter %s", invalidReadingsMetricName)
+
+	if err := metricsManager.Register(lateEventsMetricName, app.lateEventsCounter, nil); err != nil {
+		app.lc.Errorf("%s metrics will not be collected: %s", lateEventsMetricName, err.Error())
+	}
+	app.lc.Infof("Registered metrics counter %s", lateEventsMetricName)
+
 	return app
 }
 
@@ -76,7 +211,7 @@ func CoreDataAppFrom(get di.Get) *CoreDataApp {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs creation of the CoreDataApp.
-func BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	app := NewCoreDataApp(dic)
 
 	dic.Update(di.ServiceConstructorMap{
@@ -85,5 +220,61 @@ func BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic
 		},
 	})
 
+	if app.batcher != nil {
+		wg.Add(1)
+		go app.batcher.run(ctx, wg, app, dic)
+	}
+	if app.influxForwarder != nil {
+		wg.Add(1)
+		go app.influxForwarder.run(ctx, wg, dic)
+	}
+	if app.storeAndForwarder != nil {
+		wg.Add(1)
+		go app.storeAndForwarder.run(ctx, wg, dic)
+	}
+
+	readingSubscription := container.ConfigurationFrom(dic.Get).ReadingSubscription
+	if readingSubscription.Enabled {
+		manager := NewReadingSubscriptionManager(readingSubscription.MaxSubscriptions, app.lc)
+		dic.Update(di.ServiceConstructorMap{
+			ReadingSubscriptionManagerName: func(get di.Get) interface{} {
+				return manager
+			},
+		})
+	}
+
+	if container.ConfigurationFrom(dic.Get).ParquetExport.Enabled {
+		manager := NewParquetExportManager(dic)
+		dic.Update(di.ServiceConstructorMap{
+			ParquetExportManagerName: func(get di.Get) interface{} {
+				return manager
+			},
+		})
+	}
+
+	binaryOffload := container.ConfigurationFrom(dic.Get).BinaryOffload
+	if binaryOffload.Enabled {
+		var store *BinaryStore
+		var err errors.EdgeX
+		switch binaryOffload.Target {
+		case "", config.BinaryOffloadTargetFilesystem:
+			store, err = NewBinaryStore(binaryOffload.Directory, binaryOffload.MinSize, app.lc)
+		case config.BinaryOffloadTargetS3:
+			store, err = NewS3BinaryStore(binaryOffload.S3, binaryOffload.MinSize, dic)
+		default:
+			app.lc.Errorf("Failed to enable the binary offload: unknown Target '%s'", binaryOffload.Target)
+			return false
+		}
+		if err != nil {
+			app.lc.Errorf("Failed to enable the binary offload: %v", err)
+			return false
+		}
+		dic.Update(di.ServiceConstructorMap{
+			BinaryStoreName: func(get di.Get) interface{} {
+				return store
+			},
+		})
+	}
+
 	return true
 }
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/backup"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// Backup returns the backup of all the events persisted by core-data, read from the primary database one page of at
+// most pageSize events at a time. The pages following the first one are read after the cursor of the previous page,
+// so the events added during the backup, which are newer than the first page, neither shift the pages nor are backed
+// up.
+func (a *CoreDataApp) Backup(pageSize int, dic *di.Container) (backup.Backup, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	binaryStore := BinaryStoreFrom(dic.Get)
+
+	content := dataDTOs.BackupContent{Events: []dtos.Event{}}
+	eventModels, err := dbClient.AllEvents(0, pageSize)
+	for ; err == nil && len(eventModels) > 0; eventModels, err = dbClient.AllEventsAfter(nextCursor(eventModels), pageSize) {
+		for _, e := range eventModels {
+			content.Events = append(content.Events, dtos.FromEventModelToDTO(binaryStore.LoadEvent(e)))
+		}
+		if len(eventModels) < pageSize {
+			break
+		}
+	}
+	if err != nil {
+		return backup.Backup{}, errors.NewCommonEdgeX(errors.Kind(err), "fail to back up the events", err)
+	}
+
+	return backup.New(common.CoreDataServiceKey, content)
+}
+
+func nextCursor(eventModels []models.Event) utils.Cursor {
+	last := eventModels[len(eventModels)-1]
+	return utils.Cursor{Score: last.Origin, Id: last.Id}
+}
+
+// Restore adds all the events of the backup, at most pageSize events at a time. The events are neither published nor
+// exported again, and either all of them or none of them are restored: the events already added are deleted when
+// adding one fails, such as an event which already exists.
+func (a *CoreDataApp) Restore(b backup.Backup, pageSize int, ctx context.Context, dic *di.Container) (content dataDTOs.BackupContent, err errors.EdgeX) {
+	if err = b.Open(common.CoreDataServiceKey, &content); err != nil {
+		return content, errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, e := range content.Events {
+		if err := common.Validate(e); err != nil {
+			return content, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid event %s", e.Id), err)
+		}
+	}
+
+	if pageSize <= 0 {
+		pageSize = len(content.Events)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	binaryStore := BinaryStoreFrom(dic.Get)
+	var restored []models.Event
+	rollback := func() {
+		for _, e := range restored {
+			if err := dbClient.DeleteEventById(e.Id); err != nil {
+				a.lc.Errorf("Unable to roll back the restore of event %s, Correlation-ID: %s, Error: %v", e.Id, correlation.FromContext(ctx), err)
+				continue
+			}
+			binaryStore.Remove(e)
+		}
+	}
+	for start := 0; start < len(content.Events); start += pageSize {
+		end := start + pageSize
+		if end > len(content.Events) {
+			end = len(content.Events)
+		}
+		events := make([]models.Event, 0, end-start)
+		for _, e := range content.Events[start:end] {
+			events = append(events, binaryStore.Offload(requests.AddEventReqToEventModel(requests.AddEventRequest{Event: e})))
+		}
+		if _, err := dbClient.AddEvents(events); err != nil {
+			binaryStore.Remove(events...)
+			rollback()
+			return content, errors.NewCommonEdgeX(errors.Kind(err), "fail to restore the events", err)
+		}
+		restored = append(restored, events...)
+	}
+
+	a.lc.Infof("Backup created at %d restored. Events: %d, Correlation-ID: %s", b.Created, len(content.Events), correlation.FromContext(ctx))
+	return content, nil
+}
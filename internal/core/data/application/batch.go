@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// queuedEvent is an event waiting to be added to the database by the eventBatcher
+type queuedEvent struct {
+	// received is the event as received, which is forgotten by the deduplicator when it fails to be added
+	received models.Event
+	// prepared is the event as it must be added to the database
+	prepared models.Event
+	ctx      context.Context
+}
+
+// eventBatcher queues the events to add them to the database in batches, instead of one write per event
+type eventBatcher struct {
+	events        chan queuedEvent
+	maxSize       int
+	flushInterval time.Duration
+}
+
+func newEventBatcher(maxSize int, flushInterval time.Duration) *eventBatcher {
+	return &eventBatcher{
+		events:        make(chan queuedEvent, maxSize),
+		maxSize:       maxSize,
+		flushInterval: flushInterval,
+	}
+}
+
+// queue queues the event, blocking while the queue is full so that the ingestion slows down when the database can't
+// keep up
+func (b *eventBatcher) queue(q queuedEvent) errors.EdgeX {
+	select {
+	case b.events <- q:
+		return nil
+	case <-q.ctx.Done():
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the event can't be queued, the service is stopping", nil)
+	}
+}
+
+// run adds the queued events to the database once maxSize events are queued, or every flushInterval, until ctx is
+// done. The events still queued when ctx is done are added before returning.
+func (b *eventBatcher) run(ctx context.Context, wg *sync.WaitGroup, app *CoreDataApp, dic *di.Container) {
+	defer wg.Done()
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]queuedEvent, 0, b.maxSize)
+	add := func(q queuedEvent) {
+		batch = append(batch, q)
+		if len(batch) >= b.maxSize {
+			app.addEvents(batch, dic)
+			batch = batch[:0]
+		}
+	}
+	flush := func() {
+		if len(batch) > 0 {
+			app.addEvents(batch, dic)
+			batch = batch[:0]
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case q := <-b.events:
+					add(q)
+				default:
+					flush()
+					return
+				}
+			}
+		case q := <-b.events:
+			add(q)
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// addEvents adds a batch of queued events to the database. When the batch fails to be added, i.e. one of its events
+// already exists, the events are added one by one so that only the failing ones are dropped.
+func (a *CoreDataApp) addEvents(batch []queuedEvent, dic *di.Container) {
+	dbClient := container.DBClientFrom(dic.Get)
+	events := make([]models.Event, len(batch))
+	for i, q := range batch {
+		events[i] = q.prepared
+	}
+	addedEvents, err := dbClient.AddEvents(events)
+	if err == nil {
+		for i, addedEvent := range addedEvents {
+			a.eventPersisted(addedEvent, batch[i].ctx, dic)
+		}
+		return
+	}
+
+	a.lc.Warnf("Failed to persist a batch of %d events, persisting them one by one: %v", len(batch), err)
+	for _, q := range batch {
+		addedEvent, err := dbClient.AddEvent(q.prepared)
+		if err != nil {
+			BinaryStoreFrom(dic.Get).Remove(q.prepared)
+			if a.deduplicator != nil {
+				a.deduplicator.forget(q.received)
+			}
+			a.lc.Errorf("fail to persist the event, Correlation-id: %s, %v", correlation.FromContext(q.ctx), err)
+			continue
+		}
+		a.eventPersisted(addedEvent, q.ctx, dic)
+	}
+}
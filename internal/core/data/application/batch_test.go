@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func newBatchingDIC(dbClient *dbMock.DBClient, writeBatching config.WriteBatchingInfo) *di.Container {
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable:      config.WritableInfo{PersistData: true},
+				Deduplication: config.DeduplicationInfo{Enabled: true, Window: "10m"},
+				WriteBatching: writeBatching,
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+	})
+	return dic
+}
+
+func returnEvents(events []models.Event) []models.Event {
+	return events
+}
+
+func TestEventBatcherRun(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvents", mock.Anything).Return(returnEvents, nil)
+	dic := newBatchingDIC(dbClientMock, config.WriteBatchingInfo{Enabled: true, MaxSize: 2, FlushInterval: "1h"})
+	app := NewCoreDataApp(dic)
+	require.NotNil(t, app.batcher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go app.batcher.run(ctx, wg, app, dic)
+
+	for _, value := range []string{"1", "2", "3"} {
+		require.NoError(t, app.QueueEvent(dedupTestEvent(value), ctx, dic))
+	}
+	require.Eventually(t, func() bool {
+		return app.eventsPersistedCounter.Count() == 2
+	}, time.Second, time.Millisecond, "a full batch should be written without waiting for the flush interval")
+
+	cancel()
+	wg.Wait()
+	assert.Equal(t, int64(3), app.eventsPersistedCounter.Count(), "the queued events should be written when stopping")
+	dbClientMock.AssertNumberOfCalls(t, "AddEvents", 2)
+	dbClientMock.AssertNotCalled(t, "AddEvent", mock.Anything)
+}
+
+func TestEventBatcherFlushInterval(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvents", mock.Anything).Return(returnEvents, nil)
+	dic := newBatchingDIC(dbClientMock, config.WriteBatchingInfo{Enabled: true, MaxSize: 100, FlushInterval: "10ms"})
+	app := NewCoreDataApp(dic)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go app.batcher.run(ctx, wg, app, dic)
+
+	require.NoError(t, app.QueueEvent(dedupTestEvent("1"), ctx, dic))
+	require.Eventually(t, func() bool {
+		return app.eventsPersistedCounter.Count() == 1
+	}, time.Second, time.Millisecond)
+}
+
+func TestAddEventsFallback(t *testing.T) {
+	failing := dedupTestEvent("2")
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvents", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil))
+	dbClientMock.On("AddEvent", mock.MatchedBy(func(e models.Event) bool { return e.Id == failing.Id })).
+		Return(models.Event{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil))
+	dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, nil)
+	dic := newBatchingDIC(dbClientMock, config.WriteBatchingInfo{Enabled: true, MaxSize: 3, FlushInterval: "1h"})
+	app := NewCoreDataApp(dic)
+
+	var batch []queuedEvent
+	for _, e := range []models.Event{dedupTestEvent("1"), failing, dedupTestEvent("3")} {
+		require.False(t, app.deduplicator.isDuplicate(e, time.Now()))
+		batch = append(batch, queuedEvent{received: e, prepared: e, ctx: context.Background()})
+	}
+	app.addEvents(batch, dic)
+
+	dbClientMock.AssertNumberOfCalls(t, "AddEvent", 3)
+	assert.Equal(t, int64(2), app.eventsPersistedCounter.Count())
+	assert.False(t, app.deduplicator.isDuplicate(dedupTestEvent("2"), time.Now()), "the event failing to be added should be forgotten")
+	assert.True(t, app.deduplicator.isDuplicate(dedupTestEvent("1"), time.Now()))
+}
+
+func TestQueueEventNotBatching(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, nil)
+	dic := newBatchingDIC(dbClientMock, config.WriteBatchingInfo{Enabled: true, MaxSize: 0, FlushInterval: "1s"})
+	app := NewCoreDataApp(dic)
+	require.Nil(t, app.batcher, "batching should be disabled when MaxSize is invalid")
+
+	require.NoError(t, app.QueueEvent(dedupTestEvent("1"), context.Background(), dic))
+	dbClientMock.AssertNumberOfCalls(t, "AddEvent", 1)
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// eventDeduplicator remembers the events received within the window to detect the events received more than once
+type eventDeduplicator struct {
+	mutex  sync.Mutex
+	window time.Duration
+	seen   map[string]time.Time
+	// keys lists the keys of seen in the order they were received, so the expired keys are the first ones
+	keys []dedupKey
+}
+
+type dedupKey struct {
+	key      string
+	received time.Time
+}
+
+func newEventDeduplicator(window time.Duration) *eventDeduplicator {
+	return &eventDeduplicator{
+		window: window,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// isDuplicate returns whether an event with the same device name, source name, origin and readings was received within
+// the window, otherwise the event is remembered.
+func (d *eventDeduplicator) isDuplicate(e models.Event, now time.Time) bool {
+	key := eventDedupKey(e)
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.expire(now)
+	if _, exists := d.seen[key]; exists {
+		return true
+	}
+	d.seen[key] = now
+	d.keys = append(d.keys, dedupKey{key: key, received: now})
+	return false
+}
+
+// forget forgets the event, so it is no longer a duplicate when received again, i.e. when it failed to be persisted
+func (d *eventDeduplicator) forget(e models.Event) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.seen, eventDedupKey(e))
+}
+
+// expire forgets the events received before the window
+func (d *eventDeduplicator) expire(now time.Time) {
+	expired := 0
+	for _, k := range d.keys {
+		if now.Sub(k.received) < d.window {
+			break
+		}
+		// the key may have been forgotten and received again since
+		if received, exists := d.seen[k.key]; exists && received.Equal(k.received) {
+			delete(d.seen, k.key)
+		}
+		expired++
+	}
+	if expired > 0 {
+		d.keys = d.keys[expired:]
+	}
+}
+
+// eventDedupKey returns the key identifying the event by device name, source name, origin and the hash of its readings.
+// The ids are excluded since a device service may generate new ones when retransmitting the event.
+func eventDedupKey(e models.Event) string {
+	h := sha256.New()
+	for _, r := range e.Readings {
+		switch reading := r.(type) {
+		case models.SimpleReading:
+			writeBaseReading(h, reading.BaseReading)
+			writeField(h, []byte(reading.Value))
+		case models.BinaryReading:
+			writeBaseReading(h, reading.BaseReading)
+			writeField(h, reading.BinaryValue)
+			writeField(h, []byte(reading.MediaType))
+		case models.ObjectReading:
+			writeBaseReading(h, reading.BaseReading)
+			value, _ := json.Marshal(reading.ObjectValue)
+			writeField(h, value)
+		}
+	}
+	return e.DeviceName + "|" + e.SourceName + "|" + strconv.FormatInt(e.Origin, 10) + "|" + hex.EncodeToString(h.Sum(nil))
+}
+
+func writeBaseReading(h hash.Hash, r models.BaseReading) {
+	writeField(h, []byte(r.ResourceName))
+	writeField(h, []byte(r.ValueType))
+	writeField(h, []byte(strconv.FormatInt(r.Origin, 10)))
+}
+
+// writeField writes the length prefixed field, so the concatenation of the fields is unambiguous
+func writeField(h hash.Hash, field []byte) {
+	_ = binary.Write(h, binary.BigEndian, uint32(len(field)))
+	_, _ = h.Write(field)
+}
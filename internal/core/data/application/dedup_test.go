@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func dedupTestEvent(value string) models.Event {
+	reading := simpleReading(testDeviceName, testDeviceResourceName, common.ValueTypeInt32, value)
+	reading.Id = time.Now().String()
+	reading.Origin = testOriginTime
+	return models.Event{
+		Id:         time.Now().String(),
+		DeviceName: testDeviceName,
+		SourceName: testSourceName,
+		Origin:     testOriginTime,
+		Readings:   []models.Reading{reading},
+	}
+}
+
+func TestEventDeduplicator(t *testing.T) {
+	now := time.Now()
+	d := newEventDeduplicator(time.Minute)
+
+	assert.False(t, d.isDuplicate(dedupTestEvent("1"), now), "first event should not be a duplicate")
+	assert.True(t, d.isDuplicate(dedupTestEvent("1"), now.Add(time.Second)), "retransmitted event with new ids should be a duplicate")
+	assert.False(t, d.isDuplicate(dedupTestEvent("2"), now.Add(time.Second)), "event with different readings should not be a duplicate")
+
+	otherSource := dedupTestEvent("1")
+	otherSource.SourceName = "otherSource"
+	assert.False(t, d.isDuplicate(otherSource, now.Add(time.Second)), "event with different source should not be a duplicate")
+
+	assert.False(t, d.isDuplicate(dedupTestEvent("1"), now.Add(time.Minute)), "event received after the window should not be a duplicate")
+
+	d.forget(dedupTestEvent("1"))
+	assert.False(t, d.isDuplicate(dedupTestEvent("1"), now.Add(time.Minute)), "forgotten event should not be a duplicate")
+}
+
+func TestAddEventDeduplication(t *testing.T) {
+	tests := []struct {
+		name          string
+		persistData   bool
+		expectedAdded int
+	}{
+		{"persisted", true, 1},
+		// the events which aren't persisted are only forwarded when accepted
+		{"not persisted", false, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, nil)
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{
+						Writable:      config.WritableInfo{PersistData: testCase.persistData},
+						Deduplication: config.DeduplicationInfo{Enabled: true, Window: "10m"},
+					}
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+
+			app := NewCoreDataApp(dic)
+			require.NotNil(t, app.deduplicator)
+			for i := 0; i < 3; i++ {
+				err := app.AddEvent(dedupTestEvent("1"), context.Background(), dic)
+				require.NoError(t, err)
+			}
+			dbClientMock.AssertNumberOfCalls(t, "AddEvent", testCase.expectedAdded)
+			assert.Equal(t, int64(2), app.eventsDeduplicatedCounter.Count())
+		})
+	}
+}
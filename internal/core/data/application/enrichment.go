@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+)
+
+// Tags of the events enriched with the metadata of their device
+const (
+	DeviceLabelsTag      = "deviceLabels"
+	DeviceLocationTag    = "deviceLocation"
+	DeviceProfileNameTag = "deviceProfileName"
+)
+
+// eventEnricher adds the metadata of their device, fetched from core-metadata and cached, to the tags of the events
+type eventEnricher struct {
+	fields   []string
+	cacheTTL time.Duration
+	mutex    sync.Mutex
+	devices  map[string]cachedDevice
+}
+
+// cachedDevice contains the tags added to the events of a device, nil when the device doesn't exist
+type cachedDevice struct {
+	tags    map[string]any
+	fetched time.Time
+}
+
+func newEventEnricher(fields []string, cacheTTL time.Duration) *eventEnricher {
+	return &eventEnricher{fields: fields, cacheTTL: cacheTTL, devices: make(map[string]cachedDevice)}
+}
+
+// deviceTags returns the tags added to the events of the device, nil when the device doesn't exist
+func (en *eventEnricher) deviceTags(deviceName string, ctx context.Context, dic *di.Container) (map[string]any, errors.EdgeX) {
+	en.mutex.Lock()
+	cached, exists := en.devices[deviceName]
+	en.mutex.Unlock()
+	if exists && time.Since(cached.fetched) < en.cacheTTL {
+		return cached.tags, nil
+	}
+
+	client := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if client == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "core-metadata DeviceClient is not available", nil)
+	}
+	response, err := client.DeviceByName(ctx, deviceName)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query device %s", deviceName), err)
+	}
+
+	var tags map[string]any
+	if err == nil {
+		tags = make(map[string]any, len(en.fields))
+		for _, field := range en.fields {
+			switch field {
+			case config.EventEnrichmentFieldLabels:
+				if len(response.Device.Labels) > 0 {
+					tags[DeviceLabelsTag] = response.Device.Labels
+				}
+			case config.EventEnrichmentFieldLocation:
+				if response.Device.Location != nil {
+					tags[DeviceLocationTag] = response.Device.Location
+				}
+			case config.EventEnrichmentFieldProfileName:
+				tags[DeviceProfileNameTag] = response.Device.ProfileName
+			}
+		}
+	}
+	en.mutex.Lock()
+	en.devices[deviceName] = cachedDevice{tags: tags, fetched: time.Now()}
+	en.mutex.Unlock()
+	return tags, nil
+}
+
+// invalidate drops the cached device, so that it is fetched again from core-metadata for its next event
+func (en *eventEnricher) invalidate(deviceName string) {
+	en.mutex.Lock()
+	delete(en.devices, deviceName)
+	en.mutex.Unlock()
+}
+
+// enrichEvent returns the event with the metadata of its device added to its tags, the tags of the event taking
+// precedence. The event is returned as is when its device can't be fetched, so that the ingestion doesn't depend on the
+// availability of core-metadata.
+func (a *CoreDataApp) enrichEvent(e models.Event, ctx context.Context, dic *di.Container) models.Event {
+	if a.enricher == nil {
+		return e
+	}
+	deviceTags, err := a.enricher.deviceTags(e.DeviceName, ctx, dic)
+	if err != nil {
+		a.lc.Errorf("Unable to enrich the event with the metadata of device %s, accepting it as is: %v", e.DeviceName, err)
+		return e
+	}
+	if len(deviceTags) == 0 {
+		return e
+	}
+
+	tags := make(map[string]any, len(e.Tags)+len(deviceTags))
+	for k, v := range deviceTags {
+		tags[k] = v
+	}
+	for k, v := range e.Tags {
+		tags[k] = v
+	}
+	e.Tags = tags
+	return e
+}
+
+// InvalidateDeviceMetadata drops the cached metadata of the device, called when core-metadata publishes a system event
+// about the device
+func (a *CoreDataApp) InvalidateDeviceMetadata(deviceName string) {
+	if a.enricher == nil {
+		return
+	}
+	a.enricher.invalidate(deviceName)
+	a.lc.Debugf("Cached metadata of device %s invalidated", deviceName)
+}
+
+// EventEnrichmentEnabled returns whether the accepted events are enriched with the metadata of their device
+func (a *CoreDataApp) EventEnrichmentEnabled() bool {
+	return a.enricher != nil
+}
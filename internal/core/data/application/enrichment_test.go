@@ -0,0 +1,159 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+const (
+	unknownDeviceName     = "UnknownDevice"
+	unavailableDeviceName = "UnavailableDevice"
+)
+
+func newEventEnrichmentDIC(dcMock *clientMocks.DeviceClient, dbClientMock *dbMock.DBClient, fields []string) *di.Container {
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable:        config.WritableInfo{PersistData: true},
+				EventEnrichment: config.EventEnrichmentInfo{Enabled: true, Fields: fields, CacheTTL: "5m"},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+	})
+	return dic
+}
+
+func newEnrichmentDeviceClient() *clientMocks.DeviceClient {
+	device := dtos.Device{
+		Name:        testDeviceName,
+		ProfileName: testProfileName,
+		Labels:      []string{"floor-1", "hvac"},
+		Location:    map[string]any{"building": "A"},
+	}
+	dcMock := &clientMocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(responses.DeviceResponse{Device: device}, nil)
+	dcMock.On("DeviceByName", mock.Anything, unknownDeviceName).
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist", nil))
+	dcMock.On("DeviceByName", mock.Anything, unavailableDeviceName).
+		Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "core-metadata is unavailable", nil))
+	return dcMock
+}
+
+func TestEnrichEvent(t *testing.T) {
+	allFields := []string{config.EventEnrichmentFieldLabels, config.EventEnrichmentFieldLocation, config.EventEnrichmentFieldProfileName}
+
+	tests := []struct {
+		name         string
+		fields       []string
+		deviceName   string
+		tags         map[string]any
+		expectedTags map[string]any
+	}{
+		{"all fields", allFields, testDeviceName, nil, map[string]any{
+			DeviceLabelsTag:      []string{"floor-1", "hvac"},
+			DeviceLocationTag:    map[string]any{"building": "A"},
+			DeviceProfileNameTag: testProfileName,
+		}},
+		{"selected fields", []string{config.EventEnrichmentFieldProfileName}, testDeviceName, nil, map[string]any{
+			DeviceProfileNameTag: testProfileName,
+		}},
+		{"event tags take precedence", []string{config.EventEnrichmentFieldProfileName}, testDeviceName,
+			map[string]any{DeviceProfileNameTag: "OwnProfile", "site": "plant"}, map[string]any{
+				DeviceProfileNameTag: "OwnProfile",
+				"site":               "plant",
+			}},
+		{"unknown device", allFields, unknownDeviceName, map[string]any{"site": "plant"}, map[string]any{"site": "plant"}},
+		{"core-metadata unavailable", allFields, unavailableDeviceName, nil, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := newEventEnrichmentDIC(newEnrichmentDeviceClient(), &dbMock.DBClient{}, testCase.fields)
+			app := NewCoreDataApp(dic)
+			require.True(t, app.EventEnrichmentEnabled())
+
+			event := models.Event{DeviceName: testCase.deviceName, ProfileName: testProfileName, Tags: testCase.tags}
+			enriched := app.enrichEvent(event, context.Background(), dic)
+			assert.Equal(t, testCase.expectedTags, enriched.Tags)
+			assert.Equal(t, testCase.tags, event.Tags, "the tags of the event received must not be modified")
+		})
+	}
+}
+
+func TestEventEnricherCache(t *testing.T) {
+	dcMock := newEnrichmentDeviceClient()
+	dic := newEventEnrichmentDIC(dcMock, &dbMock.DBClient{}, []string{config.EventEnrichmentFieldLabels})
+	app := NewCoreDataApp(dic)
+	require.True(t, app.EventEnrichmentEnabled())
+
+	for i := 0; i < 3; i++ {
+		app.enrichEvent(models.Event{DeviceName: testDeviceName}, context.Background(), dic)
+		app.enrichEvent(models.Event{DeviceName: unknownDeviceName}, context.Background(), dic)
+	}
+	// the devices, including the unknown ones, are fetched once while cached
+	dcMock.AssertNumberOfCalls(t, "DeviceByName", 2)
+
+	app.InvalidateDeviceMetadata(testDeviceName)
+	app.enrichEvent(models.Event{DeviceName: testDeviceName}, context.Background(), dic)
+	app.enrichEvent(models.Event{DeviceName: unknownDeviceName}, context.Background(), dic)
+	dcMock.AssertNumberOfCalls(t, "DeviceByName", 3)
+}
+
+func TestAddEventEnrichment(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, nil)
+	dic := newEventEnrichmentDIC(newEnrichmentDeviceClient(), dbClientMock, []string{config.EventEnrichmentFieldLabels})
+	app := NewCoreDataApp(dic)
+
+	err := app.AddEvent(models.Event{DeviceName: testDeviceName, ProfileName: testProfileName}, context.Background(), dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "AddEvent", mock.MatchedBy(func(e models.Event) bool {
+		return assert.ObjectsAreEqual(map[string]any{DeviceLabelsTag: []string{"floor-1", "hvac"}}, e.Tags)
+	}))
+}
+
+func TestNewCoreDataAppEventEnrichment(t *testing.T) {
+	tests := []struct {
+		name            string
+		cacheTTL        string
+		expectedEnabled bool
+	}{
+		{"valid", "5m", true},
+		{"invalid CacheTTL", "5 minutes", false},
+		{"negative CacheTTL", "-1m", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := newEventEnrichmentDIC(&clientMocks.DeviceClient{}, &dbMock.DBClient{}, []string{config.EventEnrichmentFieldLabels})
+			container.ConfigurationFrom(dic.Get).EventEnrichment.CacheTTL = testCase.cacheTTL
+			app := NewCoreDataApp(dic)
+			assert.Equal(t, testCase.expectedEnabled, app.EventEnrichmentEnabled())
+		})
+	}
+}
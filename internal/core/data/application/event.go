@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	msgTypes "github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
@@ -21,12 +22,20 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/google/uuid"
 )
 
-const CoreDataEventTopicPrefix = "core"
+const (
+	CoreDataEventTopicPrefix = "core"
+	// maxEventCountIntervals is the maximum number of intervals the events can be counted in at once
+	maxEventCountIntervals = 10000
+)
 
 // ValidateEvent validates if e is a valid event with corresponding device profile name and device name and source name
 // ValidateEvent throws error when profileName or deviceName doesn't match to e
@@ -46,37 +55,125 @@ func (a *CoreDataApp) ValidateEvent(e models.Event, profileName string, deviceNa
 // The AddEvent function accepts the new event model from the controller functions
 // and invokes addEvent function in the infrastructure layer
 func (a *CoreDataApp) AddEvent(e models.Event, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
+	accepted, ok, err := a.prepareEvent(e, ctx, dic)
+	if err != nil || !ok {
+		return err
+	}
+
 	configuration := container.ConfigurationFrom(dic.Get)
 	if !configuration.Writable.PersistData {
+		// the binary values are only offloaded for the events persisted, so that they are deleted with the events
+		ReadingSubscriptionManagerFrom(dic.Get).Dispatch(accepted, ctx, dic)
+		a.streamEvent(accepted, dic)
+		a.influxForwarder.forward(accepted)
+		a.storeAndForwarder.store(accepted, dic)
 		return nil
 	}
 
-	dbClient := container.DBClientFrom(dic.Get)
+	binaryStore := BinaryStoreFrom(dic.Get)
+	prepared := binaryStore.Offload(accepted)
+	_, span := tracing.TracerFrom(dic.Get).StartDatabaseSpan(ctx, configuration.Database.Type, "AddEvent")
+	addedEvent, err := container.DBClientFrom(dic.Get).AddEvent(prepared)
+	span.SetError(err)
+	span.End()
+	if err != nil {
+		binaryStore.Remove(prepared)
+		if a.deduplicator != nil {
+			a.deduplicator.forget(e)
+		}
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	a.eventPersisted(addedEvent, ctx, dic)
 
-	// Add the event and readings to the database
-	if configuration.Writable.PersistData {
-		correlationId := correlation.FromContext(ctx)
-		addedEvent, err := dbClient.AddEvent(e)
-		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+	return nil
+}
+
+// QueueEvent adds the event like AddEvent, but when WriteBatching is enabled the event is queued and added in a batch
+// of events by the eventBatcher, so the errors of the database are only logged
+func (a *CoreDataApp) QueueEvent(e models.Event, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if a.batcher == nil || !container.ConfigurationFrom(dic.Get).Writable.PersistData {
+		return a.AddEvent(e, ctx, dic)
+	}
+
+	accepted, ok, err := a.prepareEvent(e, ctx, dic)
+	if err != nil || !ok {
+		return err
+	}
+	return a.batcher.queue(queuedEvent{received: e, prepared: BinaryStoreFrom(dic.Get).Offload(accepted), ctx: ctx})
+}
+
+// prepareEvent returns the event received as it is accepted, with its invalid readings removed and the metadata of its
+// device added to its tags, whether or not it is persisted. The event isn't accepted when it is a duplicate or routed
+// to the late data topic.
+func (a *CoreDataApp) prepareEvent(e models.Event, ctx context.Context, dic *di.Container) (models.Event, bool, errors.EdgeX) {
+	if err := validateParentEventIds(e); err != nil {
+		return e, false, err
+	}
+
+	if a.schemaValidator != nil {
+		if err := a.checkSchema(e, ctx, dic); err != nil {
+			return e, false, err
+		}
+	}
+
+	if a.latenessChecker != nil {
+		var accepted bool
+		var err errors.EdgeX
+		if e, accepted, err = a.checkLateness(e, ctx, dic); err != nil || !accepted {
+			return e, false, err
 		}
-		e = addedEvent
+	}
 
+	if a.deduplicator != nil && a.deduplicator.isDuplicate(e, time.Now()) {
 		a.lc.Debugf(
-			"Event created on DB successfully. Event-id: %s, Correlation-id: %s ",
-			e.Id,
-			correlationId,
+			"Duplicate event discarded. Device Name: %s, Source Name: %s, Origin: %d, Correlation-id: %s ",
+			e.DeviceName,
+			e.SourceName,
+			e.Origin,
+			correlation.FromContext(ctx),
 		)
+		a.eventsDeduplicatedCounter.Inc(1)
+		return e, false, nil
+	}
 
-		a.eventsPersistedCounter.Inc(1)
-		a.readingsPersistedCounter.Inc(int64(len(addedEvent.Readings)))
+	if a.validator != nil {
+		var violations []models.Reading
+		readingCount := len(e.Readings)
+		e, violations = a.validator.validate(e)
+		if len(violations) > 0 {
+			a.invalidReadingsCounter.Inc(int64(len(violations)))
+			a.publishViolations(e, violations, ctx, dic)
+		}
+		if readingCount > 0 && len(e.Readings) == 0 {
+			return e, false, errors.NewCommonEdgeX(errors.KindContractInvalid, "all readings of the event are invalid", nil)
+		}
 	}
 
-	return nil
+	return a.enrichEvent(e, ctx, dic), true, nil
+}
+
+// eventPersisted updates the metrics, dispatches the readings, streams, exports and forwards the event added to the
+// database
+func (a *CoreDataApp) eventPersisted(addedEvent models.Event, ctx context.Context, dic *di.Container) {
+	a.lc.Debugf(
+		"Event created on DB successfully. Event-id: %s, Correlation-id: %s ",
+		addedEvent.Id,
+		correlation.FromContext(ctx),
+	)
+
+	a.eventsPersistedCounter.Inc(1)
+	a.readingsPersistedCounter.Inc(int64(len(addedEvent.Readings)))
+	if manager := ReadingSubscriptionManagerFrom(dic.Get); manager != nil {
+		manager.Dispatch(BinaryStoreFrom(dic.Get).LoadEvent(addedEvent), ctx, dic)
+	}
+	a.streamEvent(addedEvent, dic)
+	a.influxForwarder.forward(addedEvent)
+	a.storeAndForwarder.store(BinaryStoreFrom(dic.Get).LoadEvent(addedEvent), dic)
 }
 
-// PublishEvent publishes incoming AddEventRequest in the format of []byte through MessageClient
-func (a *CoreDataApp) PublishEvent(data []byte, serviceName string, profileName string, deviceName string, sourceName string, ctx context.Context, dic *di.Container) {
+// PublishEvent publishes incoming AddEventRequest in the format of []byte through MessageClient, with the tenant of
+// the event, if any, in the envelope
+func (a *CoreDataApp) PublishEvent(data []byte, serviceName string, profileName string, deviceName string, sourceName string, tenant string, ctx context.Context, dic *di.Container) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	msgClient := bootstrapContainer.MessagingClientFrom(dic.Get)
 	configuration := container.ConfigurationFrom(dic.Get)
@@ -86,8 +183,14 @@ func (a *CoreDataApp) PublishEvent(data []byte, serviceName string, profileName
 	publishTopic := common.BuildTopic(basePrefix, common.EventsPublishTopic, CoreDataEventTopicPrefix, serviceName, profileName, deviceName, url.QueryEscape(sourceName))
 	lc.Debugf("Publishing AddEventRequest to MessageBus. Topic: %s; %s: %s", publishTopic, common.CorrelationHeader, correlationId)
 
-	msgEnvelope := msgTypes.NewMessageEnvelope(data, ctx)
+	msgEnvelope := newMessageEnvelope(data, ctx, dic)
+	if tenant != "" {
+		msgEnvelope.QueryParams[pkgCommon.Tenant] = tenant
+	}
+	_, span := tracing.TracerFrom(dic.Get).StartMessageSpan(ctx, tracing.SpanKindProducer, configuration.MessageBus.Type, publishTopic, msgEnvelope)
 	err := msgClient.Publish(msgEnvelope, publishTopic)
+	span.SetError(err)
+	span.End()
 	if err != nil {
 		lc.Errorf("Unable to send message for API event. Correlation-id: %s, Profile Name: %s, "+
 			"Device Name: %s, Source Name: %s, Error: %v", correlationId, profileName, deviceName, sourceName, err)
@@ -96,6 +199,17 @@ func (a *CoreDataApp) PublishEvent(data []byte, serviceName string, profileName
 	}
 }
 
+// newMessageEnvelope creates the MessageEnvelope of a payload published by core-data, with the payload compressed as
+// configured by Compression
+func newMessageEnvelope(data []byte, ctx context.Context, dic *di.Container) msgTypes.MessageEnvelope {
+	envelope := msgTypes.NewMessageEnvelope(data, ctx)
+	compression := container.ConfigurationFrom(dic.Get).Compression
+	if err := utils.CompressPayload(&envelope, compression.Encoding, compression.MinSize); err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("Unable to compress the payload, publishing it uncompressed: %v", err)
+	}
+	return envelope
+}
+
 func (a *CoreDataApp) EventById(id string, dic *di.Container) (dtos.Event, errors.EdgeX) {
 	if id == "" {
 		return dtos.Event{}, errors.NewCommonEdgeX(errors.KindInvalidId, "id is empty", nil)
@@ -105,14 +219,14 @@ func (a *CoreDataApp) EventById(id string, dic *di.Container) (dtos.Event, error
 		return dtos.Event{}, errors.NewCommonEdgeX(errors.KindInvalidId, "fail to parse id as an UUID", err)
 	}
 
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 
 	event, err := dbClient.EventById(id)
 	if err != nil {
 		return dtos.Event{}, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	eventDTO := dtos.FromEventModelToDTO(event)
+	eventDTO := dtos.FromEventModelToDTO(BinaryStoreFrom(dic.Get).LoadEvent(event))
 	return eventDTO, nil
 }
 
@@ -130,18 +244,29 @@ func (a *CoreDataApp) DeleteEventById(id string, dic *di.Container) errors.EdgeX
 	}
 
 	dbClient := container.DBClientFrom(dic.Get)
+	binaryStore := BinaryStoreFrom(dic.Get)
+
+	// the event is queried before being deleted to find the files of its offloaded binary values
+	var event models.Event
+	if binaryStore != nil {
+		var err errors.EdgeX
+		if event, err = dbClient.EventById(id); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 
 	err := dbClient.DeleteEventById(id)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	binaryStore.Remove(event)
 
 	return nil
 }
 
 // EventTotalCount return the count of all events currently stored in the database and error if any
 func (a *CoreDataApp) EventTotalCount(dic *di.Container) (uint32, errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 
 	count, err := dbClient.EventTotalCount()
 	if err != nil {
@@ -153,7 +278,7 @@ func (a *CoreDataApp) EventTotalCount(dic *di.Container) (uint32, errors.EdgeX)
 
 // EventCountByDeviceName return the count of all events associated with given device and error if any
 func (a *CoreDataApp) EventCountByDeviceName(deviceName string, dic *di.Container) (uint32, errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 
 	count, err := dbClient.EventCountByDeviceName(deviceName)
 	if err != nil {
@@ -163,6 +288,36 @@ func (a *CoreDataApp) EventCountByDeviceName(deviceName string, dic *di.Containe
 	return count, nil
 }
 
+// EventCountsByTimeInterval returns the start of each of the consecutive intervals from start, inclusive, to end,
+// exclusive, in nanoseconds, and the number of events of each device in each interval
+func (a *CoreDataApp) EventCountsByTimeInterval(start int64, end int64, interval time.Duration, dic *di.Container) (intervals []int64, devices []dataDTOs.DeviceEventCounts, err errors.EdgeX) {
+	if end <= start {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end %d must be greater than start %d", end, start), nil)
+	}
+	if interval <= 0 {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "interval must be positive", nil)
+	}
+	intervalCount := (end - start + int64(interval) - 1) / int64(interval)
+	if intervalCount > maxEventCountIntervals {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the time range spans %d intervals, exceeding the maximum of %d", intervalCount, maxEventCountIntervals), nil)
+	}
+
+	counts, err := container.QueryDBClientFrom(dic.Get).EventCountsByTimeInterval(start, end, int64(interval))
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	intervals = make([]int64, intervalCount)
+	for i := range intervals {
+		intervals[i] = start + int64(i)*int64(interval)
+	}
+	devices = make([]dataDTOs.DeviceEventCounts, len(counts))
+	for i, c := range counts {
+		devices[i] = dataDTOs.FromDeviceEventCountsModelToDTO(c)
+	}
+	return intervals, devices, nil
+}
+
 // The DeleteEventsByDeviceName function will be invoked by controller functions
 // and then invokes DeleteEventsByDeviceName function in the infrastructure layer to remove
 // all events/readings that are associated with the given deviceName
@@ -177,14 +332,16 @@ func (a *CoreDataApp) DeleteEventsByDeviceName(deviceName string, dic *di.Contai
 		err := dbClient.DeleteEventsByDeviceName(deviceName)
 		if err != nil {
 			lc.Errorf("Delete events by device name failed: %v", err)
+			return
 		}
+		BinaryStoreFrom(dic.Get).RemoveDevice(deviceName)
 	}()
 	return nil
 }
 
 // AllEvents query events by offset and limit
 func (a *CoreDataApp) AllEvents(offset int, limit int, dic *di.Container) (events []dtos.Event, totalCount uint32, err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	eventModels, err := dbClient.AllEvents(offset, limit)
 	if err == nil {
 		totalCount, err = dbClient.EventTotalCount()
@@ -192,9 +349,28 @@ func (a *CoreDataApp) AllEvents(offset int, limit int, dic *di.Container) (event
 	if err != nil {
 		return events, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
+	binaryStore := BinaryStoreFrom(dic.Get)
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(binaryStore.LoadEvent(e))
+	}
+	return events, totalCount, nil
+}
+
+// AllEventsAfter query events after the cursor, and limit
+func (a *CoreDataApp) AllEventsAfter(after utils.Cursor, limit int, dic *di.Container) (events []dtos.Event, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	eventModels, err := dbClient.AllEventsAfter(after, limit)
+	if err == nil {
+		totalCount, err = dbClient.EventTotalCount()
+	}
+	if err != nil {
+		return events, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	binaryStore := BinaryStoreFrom(dic.Get)
 	events = make([]dtos.Event, len(eventModels))
 	for i, e := range eventModels {
-		events[i] = dtos.FromEventModelToDTO(e)
+		events[i] = dtos.FromEventModelToDTO(binaryStore.LoadEvent(e))
 	}
 	return events, totalCount, nil
 }
@@ -204,7 +380,7 @@ func (a *CoreDataApp) EventsByDeviceName(offset int, limit int, name string, dic
 	if name == "" {
 		return events, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	eventModels, err := dbClient.EventsByDeviceName(offset, limit, name)
 	if err == nil {
 		totalCount, err = dbClient.EventCountByDeviceName(name)
@@ -212,16 +388,38 @@ func (a *CoreDataApp) EventsByDeviceName(offset int, limit int, name string, dic
 	if err != nil {
 		return events, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
+	binaryStore := BinaryStoreFrom(dic.Get)
 	events = make([]dtos.Event, len(eventModels))
 	for i, e := range eventModels {
-		events[i] = dtos.FromEventModelToDTO(e)
+		events[i] = dtos.FromEventModelToDTO(binaryStore.LoadEvent(e))
+	}
+	return events, totalCount, nil
+}
+
+// EventsByDeviceNameAfter query events after the cursor, with limit and name
+func (a *CoreDataApp) EventsByDeviceNameAfter(after utils.Cursor, limit int, name string, dic *di.Container) (events []dtos.Event, totalCount uint32, err errors.EdgeX) {
+	if name == "" {
+		return events, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	eventModels, err := dbClient.EventsByDeviceNameAfter(after, limit, name)
+	if err == nil {
+		totalCount, err = dbClient.EventCountByDeviceName(name)
+	}
+	if err != nil {
+		return events, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	binaryStore := BinaryStoreFrom(dic.Get)
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(binaryStore.LoadEvent(e))
 	}
 	return events, totalCount, nil
 }
 
 // EventsByTimeRange query events with offset, limit and time range
 func (a *CoreDataApp) EventsByTimeRange(startTime int, endTime int, offset int, limit int, dic *di.Container) (events []dtos.Event, totalCount uint32, err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	eventModels, err := dbClient.EventsByTimeRange(startTime, endTime, offset, limit)
 	if err == nil {
 		totalCount, err = dbClient.EventCountByTimeRange(startTime, endTime)
@@ -229,13 +427,50 @@ func (a *CoreDataApp) EventsByTimeRange(startTime int, endTime int, offset int,
 	if err != nil {
 		return events, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
+	binaryStore := BinaryStoreFrom(dic.Get)
 	events = make([]dtos.Event, len(eventModels))
 	for i, e := range eventModels {
-		events[i] = dtos.FromEventModelToDTO(e)
+		events[i] = dtos.FromEventModelToDTO(binaryStore.LoadEvent(e))
 	}
 	return events, totalCount, nil
 }
 
+// ExportEventsByTimeRange passes the events within the time range to export, one page of at most pageSize events at a
+// time, so the events of the time range are never loaded in memory all at once. The events are read from the newest
+// one following the cursor of the previous page, so that the events added or deleted during the export neither repeat
+// nor skip the events of the time range. The export stops at the first error returned by export.
+func (a *CoreDataApp) ExportEventsByTimeRange(startTime int, endTime int, pageSize int, export func(events []dtos.Event) error, dic *di.Container) errors.EdgeX {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	binaryStore := BinaryStoreFrom(dic.Get)
+	for after := (utils.Cursor{Score: int64(endTime)}); ; {
+		eventModels, err := dbClient.AllEventsAfter(after, pageSize)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		// the events are sorted by descending origin, those before the time range end the export
+		count := 0
+		for count < len(eventModels) && eventModels[count].Origin >= int64(startTime) {
+			count++
+		}
+		if count == 0 {
+			return nil
+		}
+
+		events := make([]dtos.Event, count)
+		for i, e := range eventModels[:count] {
+			events[i] = dtos.FromEventModelToDTO(binaryStore.LoadEvent(e))
+		}
+		if err := export(events); err != nil {
+			return errors.NewCommonEdgeX(errors.KindIOError, "failed to export events", err)
+		}
+
+		if count < pageSize {
+			return nil
+		}
+		after = nextCursor(eventModels)
+	}
+}
+
 // The DeleteEventsByAge function will be invoked by controller functions
 // and then invokes DeleteEventsByAge function in the infrastructure layer to remove
 // events that are older than age.  Age is supposed in milliseconds since created timestamp.
@@ -244,10 +479,13 @@ func (a *CoreDataApp) DeleteEventsByAge(age int64, dic *di.Container) errors.Edg
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
 	go func() {
+		expireTimestamp := time.Now().UnixNano() - age
 		err := dbClient.DeleteEventsByAge(age)
 		if err != nil {
 			lc.Errorf("Delete events by age failed: %v", err)
+			return
 		}
+		BinaryStoreFrom(dic.Get).RemoveByOrigin("", "", expireTimestamp)
 	}()
 	return nil
 }
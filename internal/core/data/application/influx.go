@@ -0,0 +1,187 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+)
+
+// secretKeyToken is the key of the API token in the secrets named by InfluxExport.SecretName and
+// StoreAndForward.SecretName
+const secretKeyToken = "token"
+
+// influxForwarder writes the points of the accepted events to an InfluxDB or Telegraf endpoint in the InfluxDB line
+// protocol, in batches. All the methods of a nil influxForwarder, when InfluxExport is disabled, do nothing.
+type influxForwarder struct {
+	lc            logger.LoggingClient
+	client        *http.Client
+	url           string
+	secretName    string
+	points        chan string
+	maxBatchSize  int
+	flushInterval time.Duration
+	maxRetries    int
+	retryInterval time.Duration
+}
+
+func newInfluxForwarder(info config.InfluxExportInfo, lc logger.LoggingClient) (*influxForwarder, errors.EdgeX) {
+	if info.Url == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "Url is empty", nil)
+	}
+	if info.QueueSize <= 0 || info.MaxBatchSize <= 0 || info.MaxRetries < 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "QueueSize and MaxBatchSize must be positive, MaxRetries can't be negative", nil)
+	}
+	durations := make(map[string]time.Duration, 3)
+	for name, value := range map[string]string{"FlushInterval": info.FlushInterval, "RetryInterval": info.RetryInterval, "Timeout": info.Timeout} {
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s '%s'", name, value), err)
+		}
+		durations[name] = duration
+	}
+
+	return &influxForwarder{
+		lc:            lc,
+		client:        &http.Client{Timeout: durations["Timeout"]},
+		url:           info.Url,
+		secretName:    info.SecretName,
+		points:        make(chan string, info.QueueSize),
+		maxBatchSize:  info.MaxBatchSize,
+		flushInterval: durations["FlushInterval"],
+		maxRetries:    info.MaxRetries,
+		retryInterval: durations["RetryInterval"],
+	}, nil
+}
+
+// forward queues the points of the event to be written. The points are dropped when the queue is full, so that the
+// ingestion isn't slowed down by the endpoint.
+func (f *influxForwarder) forward(e models.Event) {
+	if f == nil {
+		return
+	}
+	for _, point := range toLineProtocol(e) {
+		select {
+		case f.points <- point:
+		default:
+			f.lc.Warnf("InfluxDB export queue is full, dropping the point of event %s", e.Id)
+			return
+		}
+	}
+}
+
+// run writes the queued points once maxBatchSize points are queued, or every flushInterval, until ctx is done
+func (f *influxForwarder) run(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	defer wg.Done()
+	ticker := time.NewTicker(f.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]string, 0, f.maxBatchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			f.write(ctx, batch, dic)
+			batch = batch[:0]
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case point := <-f.points:
+			batch = append(batch, point)
+			if len(batch) >= f.maxBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// write writes the points, retrying up to maxRetries times with an exponential backoff when the endpoint fails or
+// is unreachable. The points rejected by the endpoint are dropped.
+func (f *influxForwarder) write(ctx context.Context, points []string, dic *di.Container) {
+	token, err := tokenFromSecret(f.secretName, dic)
+	if err != nil {
+		f.lc.Errorf("Unable to write %d points to InfluxDB, dropping them: %v", len(points), err)
+		return
+	}
+	body := []byte(strings.Join(points, "\n"))
+
+	backoff := f.retryInterval
+	for attempt := 0; ; attempt++ {
+		retryable, err := f.post(ctx, body, token)
+		if err == nil {
+			f.lc.Debugf("%d points written to InfluxDB", len(points))
+			return
+		}
+		if !retryable || attempt >= f.maxRetries {
+			f.lc.Errorf("Unable to write %d points to InfluxDB after %d attempts, dropping them: %v", len(points), attempt+1, err)
+			return
+		}
+		f.lc.Warnf("Unable to write %d points to InfluxDB, retrying in %s: %v", len(points), backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post sends the points to the endpoint, and returns whether the request can be retried when it fails
+func (f *influxForwarder) post(ctx context.Context, body []byte, token string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if token != "" {
+		req.Header.Set("Authorization", "Token "+token)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	return retryable, fmt.Errorf("status code %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+}
+
+// tokenFromSecret returns the API token from the secret named secretName, empty when secretName is empty
+func tokenFromSecret(secretName string, dic *di.Container) (string, errors.EdgeX) {
+	if secretName == "" {
+		return "", nil
+	}
+	secretProvider := bootstrapContainer.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing", nil)
+	}
+	secrets, err := secretProvider.GetSecret(secretName, secretKeyToken)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to retrieve the token of secret '%s' from the secret store", secretName), err)
+	}
+	return secrets[secretKeyToken], nil
+}
@@ -0,0 +1,143 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func influxExportInfo(url string) config.InfluxExportInfo {
+	return config.InfluxExportInfo{
+		Enabled:       true,
+		Url:           url,
+		QueueSize:     10,
+		MaxBatchSize:  2,
+		FlushInterval: "1h",
+		MaxRetries:    2,
+		RetryInterval: "1ms",
+		Timeout:       "1s",
+	}
+}
+
+func influxTestEvent(value string) models.Event {
+	return models.Event{DeviceName: testDeviceName, Readings: []models.Reading{
+		simpleReading(testDeviceName, "count", common.ValueTypeInt32, value),
+	}}
+}
+
+func TestNewInfluxForwarder(t *testing.T) {
+	valid := influxExportInfo("http://localhost:8086/api/v2/write")
+	noUrl := valid
+	noUrl.Url = ""
+	noQueue := valid
+	noQueue.QueueSize = 0
+	invalidFlushInterval := valid
+	invalidFlushInterval.FlushInterval = "1"
+	invalidTimeout := valid
+	invalidTimeout.Timeout = "0s"
+
+	tests := []struct {
+		name          string
+		info          config.InfluxExportInfo
+		errorExpected bool
+	}{
+		{"valid", valid, false},
+		{"invalid, no Url", noUrl, true},
+		{"invalid, no QueueSize", noQueue, true},
+		{"invalid, FlushInterval", invalidFlushInterval, true},
+		{"invalid, Timeout", invalidTimeout, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			forwarder, err := newInfluxForwarder(testCase.info, logger.NewMockClient())
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, forwarder)
+		})
+	}
+}
+
+func TestInfluxForwarderRun(t *testing.T) {
+	var mutex sync.Mutex
+	var bodies []string
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		assert.Empty(t, r.Header.Get("Authorization"))
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	forwarder, err := newInfluxForwarder(influxExportInfo(server.URL), logger.NewMockClient())
+	require.NoError(t, err)
+	dic := mocks.NewMockDIC()
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go forwarder.run(ctx, wg, dic)
+
+	for _, value := range []string{"1", "2", "3"} {
+		forwarder.forward(influxTestEvent(value))
+	}
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(bodies) == 1
+	}, time.Second, time.Millisecond, "a full batch should be written, after a retry, without waiting for the flush interval")
+	cancel()
+	wg.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	lines := strings.Split(bodies[0], "\n")
+	assert.Equal(t, []string{"count,device=TestDevice value=1i 0", "count,device=TestDevice value=2i 0"}, lines)
+}
+
+func TestInfluxForwarderRejected(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	forwarder, err := newInfluxForwarder(influxExportInfo(server.URL), logger.NewMockClient())
+	require.NoError(t, err)
+	forwarder.write(context.Background(), toLineProtocol(influxTestEvent("1")), mocks.NewMockDIC())
+	assert.Equal(t, 1, requests, "the points rejected by the endpoint shouldn't be retried")
+}
+
+func TestInfluxForwarderNil(t *testing.T) {
+	var forwarder *influxForwarder
+	assert.NotPanics(t, func() { forwarder.forward(influxTestEvent("1")) })
+}
@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// LatenessTag is the tag of the events outside the lateness window, its value is the duration between the origin of
+// the event and its ingestion, negative when the origin is in the future
+const LatenessTag = "lateness"
+
+// latenessChecker checks the origin of the ingested events against the lateness window
+type latenessChecker struct {
+	maxLateness time.Duration
+	// maxEarliness is 0 when the origin of the events can be any time in the future
+	maxEarliness time.Duration
+	action       string
+}
+
+func newLatenessChecker(maxLateness time.Duration, maxEarliness time.Duration, action string) *latenessChecker {
+	return &latenessChecker{maxLateness: maxLateness, maxEarliness: maxEarliness, action: action}
+}
+
+// lateness returns the duration between the origin of the event and now, and whether the event is outside the window
+func (c *latenessChecker) lateness(e models.Event, now time.Time) (time.Duration, bool) {
+	lateness := now.Sub(time.Unix(0, e.Origin))
+	return lateness, lateness > c.maxLateness || (c.maxEarliness > 0 && -lateness > c.maxEarliness)
+}
+
+// checkLateness returns the event as it must be ingested when it is outside the lateness window, tagged with its
+// lateness, and whether the event is accepted. The event is rejected with an error, or routed to the late data topic
+// and not accepted, depending on the action.
+func (a *CoreDataApp) checkLateness(e models.Event, ctx context.Context, dic *di.Container) (models.Event, bool, errors.EdgeX) {
+	lateness, outside := a.latenessChecker.lateness(e, time.Now())
+	if !outside {
+		return e, true, nil
+	}
+	a.lateEventsCounter.Inc(1)
+
+	switch a.latenessChecker.action {
+	case config.LatenessActionTag:
+		tags := make(map[string]any, len(e.Tags)+1)
+		for k, v := range e.Tags {
+			tags[k] = v
+		}
+		tags[LatenessTag] = lateness.String()
+		e.Tags = tags
+		return e, true, nil
+	case config.LatenessActionRoute:
+		a.publishLateEvent(e, ctx, dic)
+		return e, false, nil
+	default:
+		return e, false, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("event origin %d is outside the lateness window, the event is %s late", e.Origin, lateness), nil)
+	}
+}
+
+// publishLateEvent publishes the event outside the lateness window to the late data topic
+func (a *CoreDataApp) publishLateEvent(e models.Event, ctx context.Context, dic *di.Container) {
+	msgClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if msgClient == nil {
+		a.lc.Errorf("Unable to route late event %s to the late data topic, MessageBus is not available", e.Id)
+		return
+	}
+	configuration := container.ConfigurationFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	data, err := json.Marshal(requests.NewAddEventRequest(dtos.FromEventModelToDTO(e)))
+	if err != nil {
+		a.lc.Errorf("Unable to encode the late event. Correlation-id: %s, Error: %v", correlationId, err)
+		return
+	}
+
+	publishTopic := common.BuildTopic(configuration.MessageBus.GetBaseTopicPrefix(), pkgCommon.CoreDataLateDataPublishTopic,
+		e.ProfileName, e.DeviceName, url.QueryEscape(e.SourceName))
+	if err = msgClient.Publish(newMessageEnvelope(data, ctx, dic), publishTopic); err != nil {
+		a.lc.Errorf("Unable to publish the late event. Topic: %s, Correlation-id: %s, Error: %v", publishTopic, correlationId, err)
+		return
+	}
+	a.lc.Debugf("Late event routed to MessageBus. Topic: %s, Correlation-id: %s", publishTopic, correlationId)
+}
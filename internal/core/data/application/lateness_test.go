@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	msgMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+)
+
+func TestLatenessCheckerLateness(t *testing.T) {
+	now := time.Now()
+	checker := newLatenessChecker(time.Hour, time.Minute, config.LatenessActionTag)
+	unlimited := newLatenessChecker(time.Hour, 0, config.LatenessActionTag)
+
+	tests := []struct {
+		name            string
+		checker         *latenessChecker
+		origin          time.Time
+		expectedOutside bool
+	}{
+		{"in window", checker, now.Add(-time.Minute), false},
+		{"late", checker, now.Add(-2 * time.Hour), true},
+		{"early", checker, now.Add(2 * time.Minute), true},
+		{"early, earliness not limited", unlimited, now.Add(24 * time.Hour), false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			lateness, outside := testCase.checker.lateness(models.Event{Origin: testCase.origin.UnixNano()}, now)
+			assert.Equal(t, now.Sub(testCase.origin), lateness)
+			assert.Equal(t, testCase.expectedOutside, outside)
+		})
+	}
+}
+
+func TestAddEventLateness(t *testing.T) {
+	lateEvent := models.Event{DeviceName: testDeviceName, ProfileName: testProfileName, SourceName: testSourceName,
+		Origin: time.Now().Add(-2 * time.Hour).UnixNano()}
+
+	tests := []struct {
+		name              string
+		action            string
+		expectedErrorKind errors.ErrKind
+		expectedPersisted bool
+		expectedPublished bool
+	}{
+		{"reject", config.LatenessActionReject, errors.KindContractInvalid, false, false},
+		{"tag", config.LatenessActionTag, "", true, false},
+		{"route", config.LatenessActionRoute, "", false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, nil)
+			msgClient := &msgMocks.MessageClient{}
+			msgClient.On("Publish", mock.Anything, "edgex/latedata/TestProfile/TestDevice/testSourceName").Return(nil)
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					configuration := &config.ConfigurationStruct{
+						Writable: config.WritableInfo{PersistData: true},
+						Lateness: config.LatenessInfo{Enabled: true, MaxLateness: "1h", Action: testCase.action},
+					}
+					configuration.MessageBus.BaseTopicPrefix = "edgex"
+					return configuration
+				},
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+					return msgClient
+				},
+			})
+			app := NewCoreDataApp(dic)
+			require.NotNil(t, app.latenessChecker)
+
+			err := app.AddEvent(lateEvent, context.Background(), dic)
+			if testCase.expectedErrorKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, int64(1), app.lateEventsCounter.Count())
+			if testCase.expectedPersisted {
+				dbClientMock.AssertCalled(t, "AddEvent", mock.MatchedBy(func(e models.Event) bool {
+					return e.Tags[LatenessTag] != nil
+				}))
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddEvent", mock.Anything)
+			}
+			if testCase.expectedPublished {
+				msgClient.AssertNumberOfCalls(t, "Publish", 1)
+			} else {
+				msgClient.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// validateParentEventIds returns an error when the ParentEventIds tag of the event isn't an event id or an array of
+// event ids other than the id of the event
+func validateParentEventIds(e models.Event) errors.EdgeX {
+	if _, exists := e.Tags[pkgCommon.ParentEventIds]; !exists {
+		return nil
+	}
+	parentIds := pkgCommon.ParentEventIdsFromTags(e.Tags)
+	if len(parentIds) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("%s tag must be an event id or an array of event ids", pkgCommon.ParentEventIds), nil)
+	}
+	for _, id := range parentIds {
+		if _, err := uuid.Parse(id); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse parent event id %s as an UUID", id), err)
+		}
+		if id == e.Id {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event %s can't be its own parent", id), nil)
+		}
+	}
+	return nil
+}
+
+// EventsByParentId query the events derived from the parent event with offset and limit
+func (a *CoreDataApp) EventsByParentId(offset int, limit int, parentId string, dic *di.Container) (events []dtos.Event, totalCount uint32, err errors.EdgeX) {
+	if _, parseErr := uuid.Parse(parentId); parseErr != nil {
+		return events, totalCount, errors.NewCommonEdgeX(errors.KindInvalidId, "fail to parse id as an UUID", parseErr)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	eventModels, err := dbClient.EventsByParentId(offset, limit, parentId)
+	if err == nil {
+		totalCount, err = dbClient.EventCountByParentId(parentId)
+	}
+	if err != nil {
+		return events, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	binaryStore := BinaryStoreFrom(dic.Get)
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(binaryStore.LoadEvent(e))
+	}
+	return events, totalCount, nil
+}
+
+// EventLineage returns the event, the events it is derived from and the events derived from it, up to maxDepth
+// generations away from it. The parent events which have been purged are skipped.
+func (a *CoreDataApp) EventLineage(id string, maxDepth int, dic *di.Container) (event dtos.Event, ancestors []dataDTOs.LineageEvent, descendants []dataDTOs.LineageEvent, err errors.EdgeX) {
+	if _, parseErr := uuid.Parse(id); parseErr != nil {
+		return event, nil, nil, errors.NewCommonEdgeX(errors.KindInvalidId, "fail to parse id as an UUID", parseErr)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	e, err := dbClient.EventById(id)
+	if err != nil {
+		return event, nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	parents := func(e models.Event) ([]models.Event, errors.EdgeX) {
+		var events []models.Event
+		for _, parentId := range pkgCommon.ParentEventIdsFromTags(e.Tags) {
+			parent, err := dbClient.EventById(parentId)
+			if err != nil {
+				if errors.Kind(err) == errors.KindEntityDoesNotExist {
+					continue
+				}
+				return nil, err
+			}
+			events = append(events, parent)
+		}
+		return events, nil
+	}
+	children := func(e models.Event) ([]models.Event, errors.EdgeX) {
+		return dbClient.EventsByParentId(0, -1, e.Id)
+	}
+
+	if ancestors, err = a.traverseLineage(e, maxDepth, parents, dic); err == nil {
+		descendants, err = a.traverseLineage(e, maxDepth, children, dic)
+	}
+	if err != nil {
+		return event, nil, nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to traverse the lineage of event %s", id), err)
+	}
+	return dtos.FromEventModelToDTO(BinaryStoreFrom(dic.Get).LoadEvent(e)), ancestors, descendants, nil
+}
+
+// traverseLineage returns the events related to the event by next, breadth first, up to maxDepth generations away
+// from it. Each event is returned once, at its lowest depth.
+func (a *CoreDataApp) traverseLineage(e models.Event, maxDepth int, next func(models.Event) ([]models.Event, errors.EdgeX), dic *di.Container) ([]dataDTOs.LineageEvent, errors.EdgeX) {
+	binaryStore := BinaryStoreFrom(dic.Get)
+	lineage := []dataDTOs.LineageEvent{}
+	visited := map[string]bool{e.Id: true}
+	generation := []models.Event{e}
+	for depth := 1; depth <= maxDepth && len(generation) > 0; depth++ {
+		var nextGeneration []models.Event
+		for _, current := range generation {
+			related, err := next(current)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			for _, r := range related {
+				if visited[r.Id] {
+					continue
+				}
+				visited[r.Id] = true
+				lineage = append(lineage, dataDTOs.LineageEvent{Depth: depth, Event: dtos.FromEventModelToDTO(binaryStore.LoadEvent(r))})
+				nextGeneration = append(nextGeneration, r)
+			}
+		}
+		generation = nextGeneration
+	}
+	return lineage, nil
+}
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func TestValidateParentEventIds(t *testing.T) {
+	id := uuid.NewString()
+	parentId := uuid.NewString()
+
+	tests := []struct {
+		name          string
+		tags          map[string]any
+		errorExpected bool
+	}{
+		{"valid, no parent", nil, false},
+		{"valid, parent id", map[string]any{pkgCommon.ParentEventIds: parentId}, false},
+		{"valid, parent ids", map[string]any{pkgCommon.ParentEventIds: []any{parentId, uuid.NewString()}}, false},
+		{"invalid, not an id", map[string]any{pkgCommon.ParentEventIds: "parent"}, true},
+		{"invalid, not a string", map[string]any{pkgCommon.ParentEventIds: 1}, true},
+		{"invalid, own id", map[string]any{pkgCommon.ParentEventIds: []any{parentId, id}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateParentEventIds(models.Event{Id: id, Tags: testCase.tags})
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEventLineage(t *testing.T) {
+	// raw <- aggregate <- report, purged <- aggregate
+	raw := models.Event{Id: uuid.NewString(), DeviceName: testDeviceName}
+	purgedId := uuid.NewString()
+	aggregate := models.Event{Id: uuid.NewString(), DeviceName: testDeviceName,
+		Tags: map[string]any{pkgCommon.ParentEventIds: []any{raw.Id, purgedId}}}
+	report := models.Event{Id: uuid.NewString(), DeviceName: testDeviceName,
+		Tags: map[string]any{pkgCommon.ParentEventIds: aggregate.Id}}
+
+	dbClientMock := &dbMock.DBClient{}
+	for _, e := range []models.Event{raw, aggregate, report} {
+		dbClientMock.On("EventById", e.Id).Return(e, nil)
+	}
+	dbClientMock.On("EventById", purgedId).Return(models.Event{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "event doesn't exist", nil))
+	dbClientMock.On("EventsByParentId", 0, -1, raw.Id).Return([]models.Event{aggregate}, nil)
+	dbClientMock.On("EventsByParentId", 0, -1, aggregate.Id).Return([]models.Event{report}, nil)
+	dbClientMock.On("EventsByParentId", 0, -1, report.Id).Return([]models.Event{}, nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	app := &CoreDataApp{}
+
+	lineageIds := func(lineage []dataDTOs.LineageEvent) map[string]int {
+		ids := make(map[string]int, len(lineage))
+		for _, e := range lineage {
+			ids[e.Event.Id] = e.Depth
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name                string
+		id                  string
+		maxDepth            int
+		expectedAncestors   map[string]int
+		expectedDescendants map[string]int
+	}{
+		{"raw", raw.Id, 10, map[string]int{}, map[string]int{aggregate.Id: 1, report.Id: 2}},
+		{"aggregate", aggregate.Id, 10, map[string]int{raw.Id: 1}, map[string]int{report.Id: 1}},
+		{"report", report.Id, 10, map[string]int{aggregate.Id: 1, raw.Id: 2}, map[string]int{}},
+		{"report, max depth", report.Id, 1, map[string]int{aggregate.Id: 1}, map[string]int{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			event, ancestors, descendants, err := app.EventLineage(testCase.id, testCase.maxDepth, dic)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.id, event.Id)
+			assert.Equal(t, testCase.expectedAncestors, lineageIds(ancestors))
+			assert.Equal(t, testCase.expectedDescendants, lineageIds(descendants))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// Tag keys of the points written in the InfluxDB line protocol
+const (
+	lineProtocolDeviceTag  = "device"
+	lineProtocolProfileTag = "profile"
+	lineProtocolSourceTag  = "source"
+	// lineProtocolValueField is the field key of the value of the reading
+	lineProtocolValueField = "value"
+)
+
+var (
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `, "\n", `\n`)
+	tagEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `, "\n", `\n`)
+	stringFieldEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+// toLineProtocol returns the points of the numeric, boolean and string readings of the event in the InfluxDB line
+// protocol, one per reading. The point of a reading belongs to the measurement named after its resource, is tagged
+// with the device, profile and source names of the event, and is timestamped with the origin of the reading in
+// nanoseconds. The other readings, i.e. arrays, binary and object readings, are skipped.
+func toLineProtocol(e models.Event) []string {
+	var tags strings.Builder
+	for _, tag := range [][2]string{
+		{lineProtocolDeviceTag, e.DeviceName},
+		{lineProtocolProfileTag, e.ProfileName},
+		{lineProtocolSourceTag, e.SourceName},
+	} {
+		// tags with an empty value are invalid
+		if tag[1] != "" {
+			tags.WriteString("," + tag[0] + "=" + tagEscaper.Replace(tag[1]))
+		}
+	}
+
+	var points []string
+	for _, r := range e.Readings {
+		reading, ok := r.(models.SimpleReading)
+		if !ok {
+			continue
+		}
+		value, ok := lineProtocolFieldValue(reading.ValueType, reading.Value)
+		if !ok {
+			continue
+		}
+		points = append(points, measurementEscaper.Replace(reading.ResourceName)+tags.String()+" "+
+			lineProtocolValueField+"="+value+" "+strconv.FormatInt(reading.Origin, 10))
+	}
+	return points
+}
+
+// lineProtocolFieldValue returns the value in the InfluxDB line protocol syntax of its value type, false when the value
+// type isn't supported or the value is invalid
+func lineProtocolFieldValue(valueType string, value string) (string, bool) {
+	switch valueType {
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", false
+		}
+		return strconv.FormatFloat(f, 'g', -1, 64), true
+	case common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return "", false
+		}
+		return value + "i", true
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64:
+		if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+			return "", false
+		}
+		return value + "u", true
+	case common.ValueTypeBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatBool(b), true
+	case common.ValueTypeString:
+		return `"` + stringFieldEscaper.Replace(value) + `"`, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func TestToLineProtocol(t *testing.T) {
+	reading := func(resourceName string, valueType string, value string) models.Reading {
+		r := simpleReading(testDeviceName, resourceName, valueType, value)
+		r.Origin = 1600000000000000000
+		return r
+	}
+	binaryReading := models.BinaryReading{BaseReading: models.BaseReading{ResourceName: "image", ValueType: common.ValueTypeBinary}}
+
+	tests := []struct {
+		name           string
+		event          models.Event
+		expectedPoints []string
+	}{
+		{"float", models.Event{DeviceName: testDeviceName, ProfileName: testProfileName, SourceName: testSourceName,
+			Readings: []models.Reading{reading("temperature", common.ValueTypeFloat64, "2.5e+01")}},
+			[]string{"temperature,device=TestDevice,profile=TestProfile,source=testSourceName value=25 1600000000000000000"}},
+		{"integers and bool", models.Event{DeviceName: testDeviceName,
+			Readings: []models.Reading{
+				reading("count", common.ValueTypeInt32, "-3"),
+				reading("total", common.ValueTypeUint64, "18446744073709551615"),
+				reading("on", common.ValueTypeBool, "TRUE"),
+			}},
+			[]string{
+				"count,device=TestDevice value=-3i 1600000000000000000",
+				"total,device=TestDevice value=18446744073709551615u 1600000000000000000",
+				"on,device=TestDevice value=true 1600000000000000000",
+			}},
+		{"escaped", models.Event{DeviceName: "my device,1",
+			Readings: []models.Reading{reading("status message", common.ValueTypeString, `say "hi" \o/`)}},
+			[]string{`status\ message,device=my\ device\,1 value="say \"hi\" \\o/" 1600000000000000000`}},
+		{"unsupported and invalid skipped", models.Event{DeviceName: testDeviceName,
+			Readings: []models.Reading{
+				binaryReading,
+				reading("array", common.ValueTypeInt32Array, "[1, 2]"),
+				reading("nan", common.ValueTypeFloat32, "NaN"),
+				reading("count", common.ValueTypeInt32, "abc"),
+			}},
+			nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedPoints, toLineProtocol(testCase.event))
+		})
+	}
+}
@@ -0,0 +1,362 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"io/fs"
+	"math"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// binaryValueURITag is the tag of an offloaded binary reading referencing the object storing its binary value
+const binaryValueURITag = "binaryValueURI"
+
+// BinaryStore offloads the binary values of the binary readings to a binaryStorage, the filesystem or an S3 compatible
+// bucket, so that only a reference to them is kept in the database. It loads them back when the readings are queried
+// and removes them when their events are deleted. The binary values are stored by tenant and device, and are named
+// after the origin of their event, so that the values of the events deleted by origin can be removed without querying
+// the events. All the methods of a nil BinaryStore, when BinaryOffload is disabled, leave the readings unchanged.
+type BinaryStore struct {
+	storage binaryStorage
+	minSize int
+	lc      logger.LoggingClient
+}
+
+// binaryStorage stores the offloaded binary values by key, <tenant>/<device>/<origin>-<uuid>
+type binaryStorage interface {
+	// put stores the value of the key and returns the URI referencing it
+	put(key string, value []byte) (string, error)
+	// key returns the key of the value referenced by uri, an error when uri doesn't reference the storage
+	key(uri string) (string, error)
+	get(key string) ([]byte, error)
+	// delete deletes the value of the key, the values which don't exist are ignored
+	delete(key string) error
+	// list returns the keys starting with prefix
+	list(prefix string) ([]string, error)
+}
+
+// NewBinaryStore creates a BinaryStore storing the binary values of at least minSize bytes in directory, which is
+// created if it doesn't exist
+func NewBinaryStore(directory string, minSize int, lc logger.LoggingClient) (*BinaryStore, errors.EdgeX) {
+	storage, err := newFileStorage(directory)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryStore{storage: storage, minSize: minSize, lc: lc}, nil
+}
+
+// NewS3BinaryStore creates a BinaryStore storing the binary values of at least minSize bytes in an S3 compatible
+// bucket, i.e. MinIO
+func NewS3BinaryStore(info config.BinaryOffloadS3Info, minSize int, dic *di.Container) (*BinaryStore, errors.EdgeX) {
+	storage, err := newS3Storage(info, dic)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryStore{storage: storage, minSize: minSize, lc: bootstrapContainer.LoggingClientFrom(dic.Get)}, nil
+}
+
+// BinaryStoreName contains the name of the BinaryStore instance in the DIC.
+var BinaryStoreName = di.TypeInstanceToName(BinaryStore{})
+
+// BinaryStoreFrom helper function queries the DIC and returns the BinaryStore instance, nil when BinaryOffload is
+// disabled.
+func BinaryStoreFrom(get di.Get) *BinaryStore {
+	store, _ := get(BinaryStoreName).(*BinaryStore)
+	return store
+}
+
+// Offload returns the event with the binary values of its binary readings stored and replaced by the
+// binaryValueURITag referencing them. The binary values failing to be stored are kept in the readings.
+func (s *BinaryStore) Offload(e models.Event) models.Event {
+	readings := make([]models.Reading, len(e.Readings))
+	for i, r := range e.Readings {
+		readings[i] = r
+		reading, ok := r.(models.BinaryReading)
+		if !ok {
+			continue
+		}
+		// the references supplied by the clients are dropped, only the values stored by core-data can be loaded
+		reading.Tags = withoutTag(reading.Tags, binaryValueURITag)
+		if s == nil || len(reading.BinaryValue) < s.minSize {
+			readings[i] = reading
+			continue
+		}
+
+		key := path.Join(pathSegment(eventTenant(e)), pathSegment(e.DeviceName), fmt.Sprintf("%d-%s", e.Origin, uuid.NewString()))
+		uri, err := s.storage.put(key, reading.BinaryValue)
+		if err != nil {
+			s.lc.Errorf("Failed to offload the binary value of the reading of resource %s of device %s, storing it in the database: %v",
+				reading.ResourceName, reading.DeviceName, err)
+			readings[i] = reading
+			continue
+		}
+		tags := make(map[string]any, len(reading.Tags)+1)
+		for k, v := range reading.Tags {
+			tags[k] = v
+		}
+		tags[binaryValueURITag] = uri
+		reading.Tags = tags
+		reading.BinaryValue = nil
+		readings[i] = reading
+	}
+	e.Readings = readings
+	return e
+}
+
+// LoadEvent returns the event with the binary values of its offloaded binary readings loaded
+func (s *BinaryStore) LoadEvent(e models.Event) models.Event {
+	e.Readings = s.LoadReadings(e.Readings)
+	return e
+}
+
+// LoadReadings returns the readings with the binary values of the offloaded binary readings loaded, and their
+// binaryValueURITag removed. The readings whose binary value fails to be loaded are returned with the tag.
+func (s *BinaryStore) LoadReadings(readings []models.Reading) []models.Reading {
+	if s == nil {
+		return readings
+	}
+	loaded := make([]models.Reading, len(readings))
+	for i, r := range readings {
+		loaded[i] = r
+		reading, ok := r.(models.BinaryReading)
+		if !ok {
+			continue
+		}
+		uri, ok := reading.Tags[binaryValueURITag].(string)
+		if !ok {
+			continue
+		}
+		value, err := s.read(uri)
+		if err != nil {
+			s.lc.Errorf("Failed to load the offloaded binary value of reading %s: %v", reading.Id, err)
+			continue
+		}
+		reading.BinaryValue = value
+		reading.Tags = withoutTag(reading.Tags, binaryValueURITag)
+		loaded[i] = reading
+	}
+	return loaded
+}
+
+// read returns the binary value referenced by uri
+func (s *BinaryStore) read(uri string) ([]byte, error) {
+	key, err := s.key(uri)
+	if err != nil {
+		return nil, err
+	}
+	return s.storage.get(key)
+}
+
+// key returns the key of the binary value referenced by uri, which must be a value offloaded by the store
+func (s *BinaryStore) key(uri string) (string, error) {
+	key, err := s.storage.key(uri)
+	if err != nil {
+		return "", err
+	}
+	if _, _, ok := parseKey(key); !ok {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "the binary value URI doesn't reference an offloaded binary value", nil)
+	}
+	return key, nil
+}
+
+// Remove removes the binary values offloaded from the readings of the events
+func (s *BinaryStore) Remove(events ...models.Event) {
+	if s == nil {
+		return
+	}
+	for _, e := range events {
+		for _, r := range e.Readings {
+			reading, ok := r.(models.BinaryReading)
+			if !ok {
+				continue
+			}
+			uri, ok := reading.Tags[binaryValueURITag].(string)
+			if !ok {
+				continue
+			}
+			key, err := s.key(uri)
+			if err != nil {
+				s.lc.Errorf("Failed to remove the offloaded binary value of reading %s: %v", reading.Id, err)
+				continue
+			}
+			s.delete(key)
+		}
+	}
+}
+
+// RemoveByOrigin removes the binary values offloaded from the events whose origin is lower than or equal to origin,
+// limited to the events of the tenant and of the device when they are not empty
+func (s *BinaryStore) RemoveByOrigin(tenant string, deviceName string, origin int64) {
+	if s == nil {
+		return
+	}
+	prefix := ""
+	if tenant != "" {
+		prefix = pathSegment(tenant) + "/"
+	}
+	keys, err := s.storage.list(prefix)
+	if err != nil {
+		s.lc.Errorf("Failed to list the offloaded binary values: %v", err)
+		return
+	}
+	for _, key := range keys {
+		keyDevice, keyOrigin, ok := parseKey(key)
+		if ok && (deviceName == "" || keyDevice == pathSegment(deviceName)) && keyOrigin <= origin {
+			s.delete(key)
+		}
+	}
+}
+
+// RemoveDevice removes the binary values offloaded from all the events of the device
+func (s *BinaryStore) RemoveDevice(deviceName string) {
+	s.RemoveByOrigin("", deviceName, math.MaxInt64)
+}
+
+func (s *BinaryStore) delete(key string) {
+	if err := s.storage.delete(key); err != nil {
+		s.lc.Errorf("Failed to remove the offloaded binary value %s: %v", key, err)
+	}
+}
+
+// pathSegment returns the key segment of a tenant or device, prefixed so that neither the empty tenant nor the names
+// "." and ".." are special
+func pathSegment(name string) string {
+	return "_" + url.PathEscape(name)
+}
+
+// parseKey returns the device segment and the origin of the event of the binary value of the key,
+// <tenant>/<device>/<origin>-<uuid>, and whether the key is the one of an offloaded binary value
+func parseKey(key string) (string, int64, bool) {
+	segments := strings.Split(key, "/")
+	if len(segments) != 3 || !strings.HasPrefix(segments[0], "_") || !strings.HasPrefix(segments[1], "_") {
+		return "", 0, false
+	}
+	originPart, id, found := strings.Cut(segments[2], "-")
+	if !found {
+		return "", 0, false
+	}
+	origin, err := strconv.ParseInt(originPart, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return "", 0, false
+	}
+	return segments[1], origin, true
+}
+
+// eventTenant returns the tenant of the event, empty when it has none
+func eventTenant(e models.Event) string {
+	tenant, _ := e.Tags[pkgCommon.Tenant].(string)
+	return tenant
+}
+
+// fileStorage is the binaryStorage of the binary values written to files in a directory, referenced by file:// URIs
+type fileStorage struct {
+	directory string
+}
+
+func newFileStorage(directory string) (*fileStorage, errors.EdgeX) {
+	if directory == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the storage directory is empty", nil)
+	}
+	directory, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid storage directory", err)
+	}
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindIOError, "failed to create the storage directory", err)
+	}
+	return &fileStorage{directory: directory}, nil
+}
+
+func (f *fileStorage) put(key string, value []byte) (string, error) {
+	name := filepath.Join(f.directory, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(name, value, 0600); err != nil {
+		return "", err
+	}
+	return (&url.URL{Scheme: "file", Path: name}).String(), nil
+}
+
+func (f *fileStorage) key(uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	relative, err := filepath.Rel(f.directory, filepath.Clean(parsed.Path))
+	if parsed.Scheme != "file" || err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "the binary value URI doesn't reference the binary offload directory", nil)
+	}
+	return filepath.ToSlash(relative), nil
+}
+
+func (f *fileStorage) get(key string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(f.directory, filepath.FromSlash(key)))
+}
+
+func (f *fileStorage) delete(key string) error {
+	if err := os.Remove(filepath.Join(f.directory, filepath.FromSlash(key))); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (f *fileStorage) list(prefix string) ([]string, error) {
+	root := filepath.Join(f.directory, filepath.FromSlash(prefix))
+	var keys []string
+	err := filepath.WalkDir(root, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && name == root {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relative, err := filepath.Rel(f.directory, name)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(relative))
+		return nil
+	})
+	return keys, err
+}
+
+// withoutTag returns a copy of the tags without the tag named name, or the tags when they don't contain it
+func withoutTag(tags map[string]any, name string) map[string]any {
+	if _, exists := tags[name]; !exists {
+		return tags
+	}
+	copied := make(map[string]any, len(tags))
+	for k, v := range tags {
+		if k != name {
+			copied[k] = v
+		}
+	}
+	return copied
+}
@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+)
+
+func binaryReading(value []byte, tags map[string]any) models.BinaryReading {
+	return models.BinaryReading{
+		BaseReading: models.BaseReading{DeviceName: testDeviceName, ResourceName: testDeviceResourceName, Tags: tags},
+		BinaryValue: value,
+		MediaType:   "image/jpeg",
+	}
+}
+
+func TestBinaryStoreOffload(t *testing.T) {
+	directory := t.TempDir()
+	store, err := NewBinaryStore(directory, 4, logger.NewMockClient())
+	require.NoError(t, err)
+
+	clientTags := map[string]any{"location": "dock", binaryValueURITag: "file:///etc/passwd"}
+	event := models.Event{DeviceName: testDeviceName, Readings: []models.Reading{
+		binaryReading([]byte("image"), clientTags),
+		binaryReading([]byte("ico"), nil),
+		simpleReading(testDeviceName, testDeviceResourceName, "Float64", "1.5e+01"),
+	}}
+
+	offloaded := store.Offload(event)
+	require.Len(t, offloaded.Readings, 3)
+	stored := offloaded.Readings[0].(models.BinaryReading)
+	assert.Nil(t, stored.BinaryValue)
+	assert.Equal(t, "dock", stored.Tags["location"])
+	assert.NotEqual(t, "file:///etc/passwd", stored.Tags[binaryValueURITag])
+	assert.Equal(t, "file:///etc/passwd", clientTags[binaryValueURITag], "the tags of the event must not be modified")
+	assert.Equal(t, event.Readings[1], offloaded.Readings[1], "binary values smaller than MinSize must not be offloaded")
+	assert.Equal(t, event.Readings[2], offloaded.Readings[2])
+	assert.Len(t, offloadedFiles(t, directory), 1)
+
+	loaded := store.LoadEvent(offloaded)
+	assert.Equal(t, binaryReading([]byte("image"), map[string]any{"location": "dock"}), loaded.Readings[0])
+	assert.Equal(t, event.Readings[1:], loaded.Readings[1:])
+
+	store.Remove(offloaded)
+	assert.Empty(t, offloadedFiles(t, directory))
+}
+
+// offloadedFiles returns the paths of the files offloaded in directory
+func offloadedFiles(t *testing.T, directory string) []string {
+	var files []string
+	err := filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			files = append(files, path)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestBinaryStoreRemoveByOrigin(t *testing.T) {
+	otherDeviceName := "OtherDevice"
+	otherTenant := "OtherTenant"
+	event := func(deviceName string, tenant string, origin int64) models.Event {
+		e := models.Event{DeviceName: deviceName, Origin: origin, Readings: []models.Reading{binaryReading([]byte("image"), nil)}}
+		if tenant != "" {
+			e = WithTenant(e, tenant)
+		}
+		return e
+	}
+
+	tests := []struct {
+		name          string
+		tenant        string
+		deviceName    string
+		origin        int64
+		expectedFiles int
+	}{
+		{"all events up to origin", "", "", 2, 2},
+		{"events of the device up to origin", "", testDeviceName, 2, 4},
+		{"events of the tenant up to origin", otherTenant, "", 2, 5},
+		{"all events of the device", "", testDeviceName, math.MaxInt64, 3},
+		{"no event up to origin", "", "", 0, 6},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			directory := t.TempDir()
+			store, err := NewBinaryStore(directory, 0, logger.NewMockClient())
+			require.NoError(t, err)
+			for _, origin := range []int64{1, 2, 3} {
+				store.Offload(event(testDeviceName, "", origin))
+			}
+			store.Offload(event(otherDeviceName, "", 1))
+			store.Offload(event(otherDeviceName, otherTenant, 1))
+			store.Offload(event(otherDeviceName, otherTenant, 3))
+
+			store.RemoveByOrigin(testCase.tenant, testCase.deviceName, testCase.origin)
+			assert.Len(t, offloadedFiles(t, directory), testCase.expectedFiles)
+		})
+	}
+}
+
+func TestBinaryStoreLoadReadings(t *testing.T) {
+	directory := t.TempDir()
+	store, err := NewBinaryStore(directory, 0, logger.NewMockClient())
+	require.NoError(t, err)
+	outside := filepath.Join(t.TempDir(), "ca93c8fa-9919-4ec5-85d3-f81b2b6a7bc1")
+	require.NoError(t, os.WriteFile(outside, []byte("secret"), 0600))
+
+	tests := []struct {
+		name string
+		uri  string
+	}{
+		{"outside the directory", "file://" + outside},
+		{"not offloaded by core-data", "file://" + filepath.Join(directory, "_", "_"+testDeviceName, "image.jpeg")},
+		{"relative path", "file://" + directory + "/../" + filepath.Base(filepath.Dir(outside)) + "/" + filepath.Base(outside)},
+		{"missing file", "file://" + filepath.Join(directory, "_", "_"+testDeviceName, "1-ca93c8fa-9919-4ec5-85d3-f81b2b6a7bc1")},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reading := binaryReading(nil, map[string]any{binaryValueURITag: testCase.uri})
+			loaded := store.LoadReadings([]models.Reading{reading})
+			assert.Equal(t, reading, loaded[0])
+		})
+	}
+}
+
+func TestBinaryStoreNotEnabled(t *testing.T) {
+	var store *BinaryStore
+	event := models.Event{Readings: []models.Reading{
+		binaryReading([]byte("image"), map[string]any{binaryValueURITag: "file:///etc/passwd"}),
+	}}
+
+	offloaded := store.Offload(event)
+	assert.Equal(t, binaryReading([]byte("image"), map[string]any{}), offloaded.Readings[0])
+	assert.Equal(t, event, store.LoadEvent(event))
+	store.Remove(event)
+	store.RemoveByOrigin("", "", 0)
+}
+
+func TestBinaryStoreRemovedWithEvents(t *testing.T) {
+	directory := t.TempDir()
+	store, err := NewBinaryStore(directory, 0, logger.NewMockClient())
+	require.NoError(t, err)
+	event := models.Event{Id: testUUIDString, DeviceName: testDeviceName, Origin: testOriginTime,
+		Readings: []models.Reading{binaryReading([]byte("image"), nil)}}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "write failed", nil))
+	dbClientMock.On("EventById", testUUIDString).Return(store.Offload(event), nil)
+	dbClientMock.On("DeleteEventById", testUUIDString).Return(nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Writable: config.WritableInfo{PersistData: true}}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		BinaryStoreName: func(get di.Get) interface{} {
+			return store
+		},
+	})
+	app := NewCoreDataApp(dic)
+	require.Len(t, offloadedFiles(t, directory), 1)
+
+	err = app.AddEvent(event, context.Background(), dic)
+	require.Error(t, err)
+	assert.Len(t, offloadedFiles(t, directory), 1, "the binary values of the event failing to be added must be removed")
+
+	err = app.DeleteEventById(testUUIDString, dic)
+	require.NoError(t, err)
+	assert.Empty(t, offloadedFiles(t, directory))
+}
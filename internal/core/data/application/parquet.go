@@ -0,0 +1,353 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/parquet"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// defaultParquetRowGroupSize is the number of readings of the row groups when ParquetExport.RowGroupSize isn't set
+const defaultParquetRowGroupSize = 10000
+
+// parquetReadingColumns are the columns of the Parquet files of the readings. The value, binaryValue and objectValue
+// columns are set according to the valueType, the objectValue and the tags being JSON encoded.
+var parquetReadingColumns = []parquet.Column{
+	{Name: "id", Type: parquet.String},
+	{Name: "origin", Type: parquet.Timestamp},
+	{Name: "deviceName", Type: parquet.String},
+	{Name: "resourceName", Type: parquet.String},
+	{Name: "profileName", Type: parquet.String},
+	{Name: "valueType", Type: parquet.String},
+	{Name: "units", Type: parquet.String, Optional: true},
+	{Name: "value", Type: parquet.String, Optional: true},
+	{Name: "binaryValue", Type: parquet.Binary, Optional: true},
+	{Name: "mediaType", Type: parquet.String, Optional: true},
+	{Name: "objectValue", Type: parquet.String, Optional: true},
+	{Name: "tags", Type: parquet.String, Optional: true},
+}
+
+// parquetExportJobTTL is how long the Parquet export jobs are kept once completed
+const parquetExportJobTTL = 24 * time.Hour
+
+// parquetPartition is the Parquet file of the readings of a device and day, built in memory
+type parquetPartition struct {
+	buf    bytes.Buffer
+	writer *parquet.Writer
+	// rows are the rows of the next row group
+	rows [][]any
+}
+
+// ParquetExportManager runs the Parquet exports in the background, one at a time, and keeps their state in memory so
+// they can be polled via the Parquet export job API. The jobs are kept for parquetExportJobTTL once completed.
+type ParquetExportManager struct {
+	dic     *di.Container
+	mutex   sync.RWMutex
+	jobs    map[string]*dataDTOs.ParquetExportJob
+	running bool
+}
+
+// NewParquetExportManager creates a new initialized ParquetExportManager
+func NewParquetExportManager(dic *di.Container) *ParquetExportManager {
+	return &ParquetExportManager{
+		dic:  dic,
+		jobs: make(map[string]*dataDTOs.ParquetExportJob),
+	}
+}
+
+// ParquetExportManagerName contains the name of data's application.ParquetExportManager instance in the DIC.
+var ParquetExportManagerName = di.TypeInstanceToName(ParquetExportManager{})
+
+// ParquetExportManagerFrom helper function queries the DIC and returns the application.ParquetExportManager instance,
+// or nil when the Parquet export isn't enabled.
+func ParquetExportManagerFrom(get di.Get) *ParquetExportManager {
+	manager, ok := get(ParquetExportManagerName).(*ParquetExportManager)
+	if !ok {
+		return nil
+	}
+	return manager
+}
+
+// Export starts the export of the readings within the time range in the background and returns the id of the job used
+// to poll its state. Only one export runs at a time.
+func (m *ParquetExportManager) Export(start int, end int) (string, errors.EdgeX) {
+	if m == nil {
+		return "", errors.NewCommonEdgeX(errors.KindNotAllowed, "Parquet export is disabled", nil)
+	}
+
+	m.mutex.Lock()
+	if m.running {
+		m.mutex.Unlock()
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "another Parquet export is running", nil)
+	}
+	m.purge(time.Now().Add(-parquetExportJobTTL).UnixMilli())
+	job := &dataDTOs.ParquetExportJob{
+		Id:      uuid.NewString(),
+		Start:   start,
+		End:     end,
+		Status:  dataDTOs.ParquetExportJobStatusRunning,
+		Created: time.Now().UnixMilli(),
+	}
+	m.jobs[job.Id] = job
+	m.running = true
+	m.mutex.Unlock()
+
+	go m.run(job.Id, start, end)
+
+	return job.Id, nil
+}
+
+// Job returns a copy of the job with the specified id.
+func (m *ParquetExportManager) Job(id string) (dataDTOs.ParquetExportJob, errors.EdgeX) {
+	if m == nil {
+		return dataDTOs.ParquetExportJob{}, errors.NewCommonEdgeX(errors.KindNotAllowed, "Parquet export is disabled", nil)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return dataDTOs.ParquetExportJob{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("Parquet export job %s does not exist or has expired", id), nil)
+	}
+	return *job, nil
+}
+
+// purge removes the jobs completed before the specified time, the caller holding the lock
+func (m *ParquetExportManager) purge(before int64) {
+	for id, job := range m.jobs {
+		if job.Status != dataDTOs.ParquetExportJobStatusRunning && job.Completed < before {
+			delete(m.jobs, id)
+		}
+	}
+}
+
+func (m *ParquetExportManager) run(id string, start int, end int) {
+	lc := bootstrapContainer.LoggingClientFrom(m.dic.Get)
+	pageSize := container.ConfigurationFrom(m.dic.Get).Service.MaxResultCount
+	count, files, err := ExportReadingsToParquet(start, end, pageSize, m.dic)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	job := m.jobs[id]
+	job.Completed = time.Now().UnixMilli()
+	job.ReadingCount = count
+	job.Files = files
+	if err != nil {
+		job.Status = dataDTOs.ParquetExportJobStatusFailed
+		job.Message = err.Error()
+		lc.Errorf("Parquet export job %s failed after exporting %d readings: %v", id, count, err)
+	} else {
+		job.Status = dataDTOs.ParquetExportJobStatusCompleted
+		lc.Debugf("Parquet export job %s exported %d readings within the time range %v ~ %v to %d Parquet files", id, count, start, end, len(files))
+	}
+	m.running = false
+}
+
+// ExportReadingsToParquet writes the readings within the time range to Parquet files, one per device and day of
+// origin, in the directory or the S3 compatible bucket of the ParquetExport configuration. The readings are read from
+// the newest one page of at most pageSize readings at a time, following the cursor of the previous page so that the
+// readings added or deleted during the export neither shift nor repeat the pages. As the readings come newest first,
+// the files of a day are complete and written as soon as a reading of an earlier day is read, so that only the files
+// of one day are held in memory. It returns the number of readings exported and the URIs of the files written, sorted
+// by key, the files written before an error included.
+func ExportReadingsToParquet(start int, end int, pageSize int, dic *di.Container) (int, []string, errors.EdgeX) {
+	info := container.ConfigurationFrom(dic.Get).ParquetExport
+	if !info.Enabled {
+		return 0, nil, errors.NewCommonEdgeX(errors.KindNotAllowed, "Parquet export is disabled", nil)
+	}
+	storage, err := newParquetStorage(info, dic)
+	if err != nil {
+		return 0, nil, err
+	}
+	rowGroupSize := info.RowGroupSize
+	if rowGroupSize <= 0 {
+		rowGroupSize = defaultParquetRowGroupSize
+	}
+
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	// partitions are the files of the day being exported
+	partitions := make(map[string]*parquetPartition)
+	day := ""
+	var uris []string
+	count := 0
+	for cursor := (utils.Cursor{Score: int64(end)}); ; {
+		readingModels, err := dbClient.AllReadingsAfter(cursor, pageSize)
+		if err != nil {
+			return count, sortedUris(uris), errors.NewCommonEdgeXWrapper(err)
+		}
+
+		readings, err := convertReadingModelsToDTOs(readingModels, dic)
+		if err != nil {
+			return count, sortedUris(uris), errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, r := range readings {
+			if r.Origin < int64(start) {
+				break
+			}
+			if readingDay := parquetPartitionDay(r); readingDay != day {
+				written, err := writeParquetPartitions(partitions, storage)
+				uris = append(uris, written...)
+				if err != nil {
+					return count, sortedUris(uris), err
+				}
+				partitions = make(map[string]*parquetPartition)
+				day = readingDay
+			}
+
+			key := parquetPartitionKey(r, start, end)
+			partition, ok := partitions[key]
+			if !ok {
+				partition = &parquetPartition{}
+				if partition.writer, err = newParquetWriter(&partition.buf); err != nil {
+					return count, sortedUris(uris), err
+				}
+				partitions[key] = partition
+			}
+			row, err := parquetReadingRow(r)
+			if err != nil {
+				return count, sortedUris(uris), err
+			}
+			partition.rows = append(partition.rows, row)
+			if len(partition.rows) >= rowGroupSize {
+				if err = partition.flush(); err != nil {
+					return count, sortedUris(uris), err
+				}
+			}
+			count++
+		}
+
+		if len(readings) == 0 || len(readings) < pageSize || readings[len(readings)-1].Origin < int64(start) {
+			break
+		}
+		last := readings[len(readings)-1]
+		cursor = utils.Cursor{Score: last.Origin, Id: last.Id}
+	}
+
+	written, err := writeParquetPartitions(partitions, storage)
+	uris = append(uris, written...)
+	return count, sortedUris(uris), err
+}
+
+// writeParquetPartitions completes the Parquet files of the partitions and writes them to the storage, it returns the
+// URIs of the files written
+func writeParquetPartitions(partitions map[string]*parquetPartition, storage binaryStorage) ([]string, errors.EdgeX) {
+	keys := make([]string, 0, len(partitions))
+	for key := range partitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	uris := make([]string, 0, len(keys))
+	for _, key := range keys {
+		partition := partitions[key]
+		if err := partition.flush(); err != nil {
+			return uris, err
+		}
+		if err := partition.writer.Close(); err != nil {
+			return uris, errors.NewCommonEdgeX(errors.KindServerError, "failed to write the Parquet file", err)
+		}
+		uri, err := storage.put(key, partition.buf.Bytes())
+		if err != nil {
+			return uris, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to write the Parquet file %s", key), err)
+		}
+		uris = append(uris, uri)
+	}
+	return uris, nil
+}
+
+func sortedUris(uris []string) []string {
+	sort.Strings(uris)
+	return uris
+}
+
+// newParquetStorage returns the storage of the Parquet files, the directory or the bucket of the ParquetExport Target
+func newParquetStorage(info config.ParquetExportInfo, dic *di.Container) (binaryStorage, errors.EdgeX) {
+	switch info.Target {
+	case config.BinaryOffloadTargetFilesystem:
+		return newFileStorage(info.Directory)
+	case config.BinaryOffloadTargetS3:
+		return newS3Storage(info.S3, dic)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("unknown ParquetExport Target '%s'", info.Target), nil)
+	}
+}
+
+func newParquetWriter(buf *bytes.Buffer) (*parquet.Writer, errors.EdgeX) {
+	writer, err := parquet.NewWriter(buf, parquetReadingColumns)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create the Parquet writer", err)
+	}
+	return writer, nil
+}
+
+// flush writes the rows of the partition as a row group
+func (p *parquetPartition) flush() errors.EdgeX {
+	if err := p.writer.WriteRowGroup(p.rows); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to write the Parquet row group", err)
+	}
+	p.rows = p.rows[:0]
+	return nil
+}
+
+// parquetPartitionKey returns the key of the Parquet file of the reading, whose device and day of origin partition
+// the files in the Hive style, device=<device-name>/date=<yyyy-mm-dd>/readings-<start>-<end>.parquet
+func parquetPartitionKey(r dtos.BaseReading, start int, end int) string {
+	return path.Join("device="+url.PathEscape(r.DeviceName), "date="+parquetPartitionDay(r), fmt.Sprintf("readings-%d-%d.parquet", start, end))
+}
+
+// parquetPartitionDay returns the day of origin of the reading, yyyy-mm-dd in UTC
+func parquetPartitionDay(r dtos.BaseReading) string {
+	return time.Unix(0, r.Origin).UTC().Format("2006-01-02")
+}
+
+// parquetReadingRow returns the values of the parquetReadingColumns of the reading
+func parquetReadingRow(r dtos.BaseReading) ([]any, errors.EdgeX) {
+	row := []any{r.Id, r.Origin, r.DeviceName, r.ResourceName, r.ProfileName, r.ValueType, nil, nil, nil, nil, nil, nil}
+	if r.Units != "" {
+		row[6] = r.Units
+	}
+	switch r.ValueType {
+	case common.ValueTypeBinary:
+		row[8] = r.BinaryValue
+		row[9] = r.MediaType
+	case common.ValueTypeObject:
+		objectValue, err := json.Marshal(r.ObjectValue)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the object value of reading %s", r.Id), err)
+		}
+		row[10] = string(objectValue)
+	default:
+		row[7] = r.Value
+	}
+	if len(r.Tags) > 0 {
+		tags, err := json.Marshal(r.Tags)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the tags of reading %s", r.Id), err)
+		}
+		row[11] = string(tags)
+	}
+	return row, nil
+}
@@ -0,0 +1,211 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+func TestExportReadingsToParquet(t *testing.T) {
+	day1 := time.Date(2023, 10, 1, 23, 0, 0, 0, time.UTC).UnixNano()
+	day2 := time.Date(2023, 10, 2, 1, 0, 0, 0, time.UTC).UnixNano()
+	start := int(day1 - 1)
+	end := int(day2 + 1)
+	reading := func(id string, deviceName string, origin int64) models.Reading {
+		r := simpleReading(deviceName, "temperature", common.ValueTypeFloat64, "20")
+		r.Id = id
+		r.Origin = origin
+		return r
+	}
+	// the readings are exported 2 at a time, newest first, across 2 devices and 2 days, the last page ending with a
+	// reading before the time range
+	page1 := []models.Reading{reading("1", testDeviceName, day2), reading("2", "camera/1", day2)}
+	page2 := []models.Reading{reading("3", testDeviceName, day1), reading("4", testDeviceName, day1)}
+	page3 := []models.Reading{reading("5", testDeviceName, day1), reading("6", testDeviceName, day1-2)}
+
+	directory := t.TempDir()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllReadingsAfter", utils.Cursor{Score: int64(end)}, 2).Return(page1, nil)
+	dbClientMock.On("AllReadingsAfter", utils.Cursor{Score: day2, Id: "2"}, 2).Return(page2, nil)
+	dbClientMock.On("AllReadingsAfter", utils.Cursor{Score: day1, Id: "4"}, 2).Return(page3, nil).Run(func(args mock.Arguments) {
+		// the files of the second day are written once the readings of the first day are read
+		_, err := os.Stat(filepath.Join(directory, "device=camera%2F1", "date=2023-10-02", fmt.Sprintf("readings-%d-%d.parquet", start, end)))
+		assert.NoError(t, err)
+	})
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	container.ConfigurationFrom(dic.Get).ParquetExport = config.ParquetExportInfo{
+		Enabled:      true,
+		Target:       config.BinaryOffloadTargetFilesystem,
+		Directory:    directory,
+		RowGroupSize: 2,
+	}
+
+	count, files, err := ExportReadingsToParquet(start, end, 2, dic)
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+	expectedKeys := []string{
+		fmt.Sprintf("device=%s/date=2023-10-01/readings-%d-%d.parquet", testDeviceName, start, end),
+		fmt.Sprintf("device=%s/date=2023-10-02/readings-%d-%d.parquet", testDeviceName, start, end),
+		fmt.Sprintf("device=camera%%2F1/date=2023-10-02/readings-%d-%d.parquet", start, end),
+	}
+	require.Len(t, files, len(expectedKeys))
+	for i, key := range expectedKeys {
+		name := filepath.Join(directory, filepath.FromSlash(key))
+		assert.Equal(t, (&url.URL{Scheme: "file", Path: name}).String(), files[i])
+		content, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(content, []byte("PAR1")))
+		assert.True(t, bytes.HasSuffix(content, []byte("PAR1")))
+		metadataLength := binary.LittleEndian.Uint32(content[len(content)-8:])
+		assert.Less(t, int(metadataLength), len(content)-12)
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AllReadingsAfter", 3)
+}
+
+func TestExportReadingsToParquetErrors(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllReadingsAfter", utils.Cursor{Score: 100}, 20).Return(nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "database error", nil))
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name         string
+		info         config.ParquetExportInfo
+		expectedKind errors.ErrKind
+	}{
+		{"disabled", config.ParquetExportInfo{}, errors.KindNotAllowed},
+		{"unknown target", config.ParquetExportInfo{Enabled: true, Target: "ftp"}, errors.KindServerError},
+		{"database error", config.ParquetExportInfo{Enabled: true, Target: config.BinaryOffloadTargetFilesystem, Directory: t.TempDir()}, errors.KindDatabaseError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container.ConfigurationFrom(dic.Get).ParquetExport = tt.info
+			_, _, err := ExportReadingsToParquet(0, 100, 20, dic)
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedKind, errors.Kind(err))
+		})
+	}
+}
+
+func TestParquetExportManager(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllReadingsAfter", utils.Cursor{Score: 100}, 20).Return([]models.Reading{}, nil).Run(func(args mock.Arguments) {
+		started <- struct{}{}
+		<-release
+	})
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	container.ConfigurationFrom(dic.Get).ParquetExport = config.ParquetExportInfo{
+		Enabled:   true,
+		Target:    config.BinaryOffloadTargetFilesystem,
+		Directory: t.TempDir(),
+	}
+	manager := NewParquetExportManager(dic)
+
+	jobId, err := manager.Export(0, 100)
+	require.NoError(t, err)
+	<-started
+	job, err := manager.Job(jobId)
+	require.NoError(t, err)
+	assert.Equal(t, dataDTOs.ParquetExportJobStatusRunning, job.Status)
+
+	// only one export runs at a time
+	_, err = manager.Export(0, 100)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+
+	close(release)
+	require.Eventually(t, func() bool {
+		job, err = manager.Job(jobId)
+		return err == nil && job.Status == dataDTOs.ParquetExportJobStatusCompleted
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 0, job.ReadingCount)
+	assert.NotZero(t, job.Completed)
+
+	// the completed jobs are purged once expired
+	manager.mutex.Lock()
+	manager.purge(job.Completed + 1)
+	manager.mutex.Unlock()
+	_, err = manager.Job(jobId)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	var disabled *ParquetExportManager
+	_, err = disabled.Export(0, 100)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindNotAllowed, errors.Kind(err))
+}
+
+func TestParquetReadingRow(t *testing.T) {
+	base := dtos.BaseReading{Id: "id", Origin: 1, DeviceName: testDeviceName, ResourceName: "resource", ProfileName: testProfileName}
+	simple := base
+	simple.ValueType = common.ValueTypeFloat64
+	simple.Units = "C"
+	simple.Value = "20"
+	simple.Tags = map[string]any{"location": "dock"}
+	binaryValue := base
+	binaryValue.ValueType = common.ValueTypeBinary
+	binaryValue.BinaryValue = []byte{1, 2}
+	binaryValue.MediaType = "image/jpeg"
+	object := base
+	object.ValueType = common.ValueTypeObject
+	object.ObjectValue = map[string]any{"x": 1}
+
+	tests := []struct {
+		name     string
+		reading  dtos.BaseReading
+		expected []any
+	}{
+		{"simple", simple, []any{"id", int64(1), testDeviceName, "resource", testProfileName, common.ValueTypeFloat64, "C", "20", nil, nil, nil, `{"location":"dock"}`}},
+		{"binary", binaryValue, []any{"id", int64(1), testDeviceName, "resource", testProfileName, common.ValueTypeBinary, nil, nil, []byte{1, 2}, "image/jpeg", nil, nil}},
+		{"object", object, []any{"id", int64(1), testDeviceName, "resource", testProfileName, common.ValueTypeObject, nil, nil, nil, nil, `{"x":1}`, nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, err := parquetReadingRow(tt.reading)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, row)
+		})
+	}
+}
@@ -13,11 +13,14 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
 // ReadingTotalCount return the count of all of readings currently stored in the database and error if any
 func ReadingTotalCount(dic *di.Container) (uint32, errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 
 	count, err := dbClient.ReadingTotalCount()
 	if err != nil {
@@ -29,10 +32,27 @@ func ReadingTotalCount(dic *di.Container) (uint32, errors.EdgeX) {
 
 // AllReadings query events by offset, and limit
 func AllReadings(offset int, limit int, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	readingModels, err := dbClient.AllReadings(offset, limit)
 	if err == nil {
-		readings, err = convertReadingModelsToDTOs(readingModels)
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
+		if err == nil {
+			totalCount, err = dbClient.ReadingTotalCount()
+		}
+	}
+
+	if err != nil {
+		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, totalCount, nil
+}
+
+// AllReadingsAfter query readings after the cursor, and limit
+func AllReadingsAfter(after utils.Cursor, limit int, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	readingModels, err := dbClient.AllReadingsAfter(after, limit)
+	if err == nil {
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
 		if err == nil {
 			totalCount, err = dbClient.ReadingTotalCount()
 		}
@@ -49,10 +69,10 @@ func ReadingsByResourceName(offset int, limit int, resourceName string, dic *di.
 	if resourceName == "" {
 		return readings, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "resourceName is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	readingModels, err := dbClient.ReadingsByResourceName(offset, limit, resourceName)
 	if err == nil {
-		readings, err = convertReadingModelsToDTOs(readingModels)
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
 		if err == nil {
 			totalCount, err = dbClient.ReadingCountByResourceName(resourceName)
 		}
@@ -69,10 +89,30 @@ func ReadingsByDeviceName(offset int, limit int, name string, dic *di.Container)
 	if name == "" {
 		return readings, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	readingModels, err := dbClient.ReadingsByDeviceName(offset, limit, name)
 	if err == nil {
-		readings, err = convertReadingModelsToDTOs(readingModels)
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
+		if err == nil {
+			totalCount, err = dbClient.ReadingCountByDeviceName(name)
+		}
+	}
+
+	if err != nil {
+		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, totalCount, nil
+}
+
+// ReadingsByDeviceNameAfter query readings after the cursor, with limit, and device name
+func ReadingsByDeviceNameAfter(after utils.Cursor, limit int, name string, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, err errors.EdgeX) {
+	if name == "" {
+		return readings, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	readingModels, err := dbClient.ReadingsByDeviceNameAfter(after, limit, name)
+	if err == nil {
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
 		if err == nil {
 			totalCount, err = dbClient.ReadingCountByDeviceName(name)
 		}
@@ -86,10 +126,10 @@ func ReadingsByDeviceName(offset int, limit int, name string, dic *di.Container)
 
 // ReadingsByTimeRange query readings with offset, limit and time range
 func ReadingsByTimeRange(start int, end int, offset int, limit int, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	readingModels, err := dbClient.ReadingsByTimeRange(start, end, offset, limit)
 	if err == nil {
-		readings, err = convertReadingModelsToDTOs(readingModels)
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
 		if err == nil {
 			totalCount, err = dbClient.ReadingCountByTimeRange(start, end)
 		}
@@ -101,7 +141,8 @@ func ReadingsByTimeRange(start int, end int, offset int, limit int, dic *di.Cont
 	return readings, totalCount, nil
 }
 
-func convertReadingModelsToDTOs(readingModels []models.Reading) (readings []dtos.BaseReading, err errors.EdgeX) {
+func convertReadingModelsToDTOs(readingModels []models.Reading, dic *di.Container) (readings []dtos.BaseReading, err errors.EdgeX) {
+	readingModels = BinaryStoreFrom(dic.Get).LoadReadings(readingModels)
 	readings = make([]dtos.BaseReading, len(readingModels))
 	for i, r := range readingModels {
 		readings[i] = dtos.FromReadingModelToDTO(r)
@@ -114,7 +155,7 @@ func ReadingCountByDeviceName(deviceName string, dic *di.Container) (uint32, err
 	if deviceName == "" {
 		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	count, err := dbClient.ReadingCountByDeviceName(deviceName)
 	if err != nil {
 		return 0, errors.NewCommonEdgeXWrapper(err)
@@ -128,10 +169,10 @@ func ReadingsByResourceNameAndTimeRange(resourceName string, start int, end int,
 	if resourceName == "" {
 		return readings, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "resourceName is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	readingModels, err := dbClient.ReadingsByResourceNameAndTimeRange(resourceName, start, end, offset, limit)
 	if err == nil {
-		readings, err = convertReadingModelsToDTOs(readingModels)
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
 		if err == nil {
 			totalCount, err = dbClient.ReadingCountByResourceNameAndTimeRange(resourceName, start, end)
 		}
@@ -152,10 +193,10 @@ func ReadingsByDeviceNameAndResourceName(deviceName string, resourceName string,
 		return readings, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
 	}
 
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	readingModels, err := dbClient.ReadingsByDeviceNameAndResourceName(deviceName, resourceName, offset, limit)
 	if err == nil {
-		readings, err = convertReadingModelsToDTOs(readingModels)
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
 		if err == nil {
 			totalCount, err = dbClient.ReadingCountByDeviceNameAndResourceName(deviceName, resourceName)
 		}
@@ -176,10 +217,10 @@ func ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resource
 		return readings, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
 	}
 
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	readingModels, err := dbClient.ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, offset, limit)
 	if err == nil {
-		readings, err = convertReadingModelsToDTOs(readingModels)
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
 		if err == nil {
 			totalCount, err = dbClient.ReadingCountByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end)
 		}
@@ -197,7 +238,7 @@ func ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourc
 		return readings, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
 	}
 
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	var readingModels []models.Reading
 	if len(resourceNames) > 0 {
 		readingModels, totalCount, err = dbClient.ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName, resourceNames, start, end, offset, limit)
@@ -209,10 +250,28 @@ func ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourc
 	}
 
 	if err == nil {
-		readings, err = convertReadingModelsToDTOs(readingModels)
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
 	}
 	if err != nil {
 		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
 	return readings, totalCount, nil
 }
+
+// ReadingStats returns the stats of all the readings and of the readings of each device, sorted by estimated size in
+// descending order
+func ReadingStats(dic *di.Container) (total dataDTOs.ReadingStats, devices []dataDTOs.DeviceReadingStats, err errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	deviceStats, err := dbClient.ReadingStats()
+	if err != nil {
+		return total, devices, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var totalStats dataModels.ReadingStats
+	devices = make([]dataDTOs.DeviceReadingStats, len(deviceStats))
+	for i, stats := range deviceStats {
+		totalStats.Add(stats.ReadingStats)
+		devices[i] = dataDTOs.FromDeviceReadingStatsModelToDTO(stats)
+	}
+	return dataDTOs.FromReadingStatsModelToDTO(totalStats), devices, nil
+}
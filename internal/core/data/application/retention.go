@@ -0,0 +1,229 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+)
+
+// StartRetention starts purging the oldest events periodically, for all the events and for each retention policy,
+// until the context is canceled. Policies with invalid settings are skipped.
+func StartRetention(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	retention := container.ConfigurationFrom(dic.Get).Retention
+
+	if retention.MaxCap > 0 {
+		if err := validateCapacity(retention.MaxCap, retention.MinCap); err != nil {
+			lc.Errorf("Retention of all events disabled: %s", err.Error())
+		} else {
+			startPurging(ctx, wg, retention.Interval, func() errors.EdgeX {
+				return capEvents(retention.MaxCap, retention.MinCap, dic)
+			}, dic)
+		}
+	}
+
+	for i, policy := range retention.Policies {
+		if err := validatePolicy(policy); err != nil {
+			lc.Errorf("Retention policy %d skipped: %s", i, err.Error())
+			continue
+		}
+		interval := policy.Interval
+		if interval == "" {
+			interval = retention.Interval
+		}
+		policy := policy
+		startPurging(ctx, wg, interval, func() errors.EdgeX {
+			return applyRetentionPolicy(policy, retention.Policies, dic)
+		}, dic)
+	}
+}
+
+func validateCapacity(maxCap uint32, minCap uint32) error {
+	if maxCap == 0 {
+		return fmt.Errorf("MaxCap must be greater than 0")
+	}
+	if minCap > maxCap {
+		return fmt.Errorf("MinCap %d is greater than MaxCap %d", minCap, maxCap)
+	}
+	return nil
+}
+
+func validatePolicy(policy config.RetentionPolicy) error {
+	if policy.Tenant != "" && (policy.DeviceName != "" || policy.ProfileName != "") {
+		return fmt.Errorf("a Tenant policy can't specify DeviceName or ProfileName")
+	}
+	if policy.DeviceName == "" && policy.ProfileName == "" && policy.Tenant == "" {
+		return fmt.Errorf("either DeviceName, ProfileName or Tenant must be specified")
+	}
+	return validateCapacity(policy.MaxCap, policy.MinCap)
+}
+
+// startPurging calls purge every interval in a goroutine tracked by the wait group
+func startPurging(ctx context.Context, wg *sync.WaitGroup, interval string, purge func() errors.EdgeX, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	duration, err := time.ParseDuration(interval)
+	if err != nil || duration <= 0 {
+		lc.Errorf("Retention skipped, invalid interval '%s'", interval)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(duration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := purge(); err != nil {
+					lc.Errorf("Failed to purge events: %s", err.Error())
+				}
+			}
+		}
+	}()
+}
+
+// capEvents deletes the oldest events, keeping the minCap most recent ones, when there are more than maxCap events
+func capEvents(maxCap uint32, minCap uint32, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	count, err := dbClient.EventTotalCount()
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if count <= maxCap {
+		return nil
+	}
+
+	// events are sorted by origin in descending order, the newest event to purge follows the events kept
+	events, err := dbClient.AllEvents(int(minCap), 1)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	lc.Debugf("Purging the %d oldest events", count-minCap)
+	if err := dbClient.DeleteEventsByOrigin(events[0].Origin); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	BinaryStoreFrom(dic.Get).RemoveByOrigin("", "", events[0].Origin)
+	return nil
+}
+
+// capDeviceEvents deletes the oldest events of the device, keeping the minCap most recent ones, when the device has
+// more than maxCap events
+func capDeviceEvents(deviceName string, maxCap uint32, minCap uint32, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	count, err := dbClient.EventCountByDeviceName(deviceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if count <= maxCap {
+		return nil
+	}
+
+	events, err := dbClient.EventsByDeviceName(int(minCap), 1, deviceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	lc.Debugf("Purging the %d oldest events of device %s", count-minCap, deviceName)
+	if err := dbClient.DeleteEventsByDeviceNameAndOrigin(deviceName, events[0].Origin); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	BinaryStoreFrom(dic.Get).RemoveByOrigin("", deviceName, events[0].Origin)
+	return nil
+}
+
+// capTenantEvents deletes the oldest events of the tenant, keeping the minCap most recent ones, when the tenant has
+// more than maxCap events
+func capTenantEvents(tenant string, maxCap uint32, minCap uint32, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	count, err := dbClient.EventCountByTenant(tenant)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if count <= maxCap {
+		return nil
+	}
+
+	events, err := dbClient.EventsByTenant(int(minCap), 1, tenant)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	lc.Debugf("Purging the %d oldest events of tenant %s", count-minCap, tenant)
+	if err := dbClient.DeleteEventsByTenantAndOrigin(tenant, events[0].Origin); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	BinaryStoreFrom(dic.Get).RemoveByOrigin(tenant, "", events[0].Origin)
+	return nil
+}
+
+// applyRetentionPolicy caps the events of the tenant of a Tenant policy, of the device of a DeviceName policy, or of
+// each device of a ProfileName policy which isn't also covered by a DeviceName policy. The profile of a device is the
+// profile of its most recent event.
+func applyRetentionPolicy(policy config.RetentionPolicy, policies []config.RetentionPolicy, dic *di.Container) errors.EdgeX {
+	if policy.Tenant != "" {
+		return capTenantEvents(policy.Tenant, policy.MaxCap, policy.MinCap, dic)
+	}
+	if policy.DeviceName != "" {
+		return capDeviceEvents(policy.DeviceName, policy.MaxCap, policy.MinCap, dic)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	deviceNames, err := dbClient.EventDeviceNames()
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, deviceName := range deviceNames {
+		if hasDevicePolicy(deviceName, policies) {
+			continue
+		}
+		events, err := dbClient.EventsByDeviceName(0, 1, deviceName)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		if len(events) == 0 || events[0].ProfileName != policy.ProfileName {
+			continue
+		}
+		if err = capDeviceEvents(deviceName, policy.MaxCap, policy.MinCap, dic); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hasDevicePolicy(deviceName string, policies []config.RetentionPolicy) bool {
+	for _, policy := range policies {
+		if policy.DeviceName == deviceName {
+			return true
+		}
+	}
+	return false
+}
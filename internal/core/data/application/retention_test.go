@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func TestCapEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		count         uint32
+		expectedPurge bool
+	}{
+		{"purge above MaxCap", 11, true},
+		{"no purge at MaxCap", 10, false},
+		{"no purge below MaxCap", 5, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("EventTotalCount").Return(testCase.count, nil)
+			dbClientMock.On("AllEvents", 8, 1).Return([]models.Event{{Origin: testOriginTime}}, nil)
+			dbClientMock.On("DeleteEventsByOrigin", int64(testOriginTime)).Return(nil)
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+
+			err := capEvents(10, 8, dic)
+			require.NoError(t, err)
+			if testCase.expectedPurge {
+				dbClientMock.AssertCalled(t, "DeleteEventsByOrigin", int64(testOriginTime))
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteEventsByOrigin", int64(testOriginTime))
+			}
+		})
+	}
+}
+
+func TestApplyRetentionPolicy(t *testing.T) {
+	otherDeviceName := "OtherDevice"
+	policies := []config.RetentionPolicy{
+		{ProfileName: testProfileName, MaxCap: 10, MinCap: 8},
+		{DeviceName: otherDeviceName, MaxCap: 100, MinCap: 80},
+		{Tenant: testTenant, MaxCap: 10, MinCap: 8},
+	}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventDeviceNames").Return([]string{testDeviceName, otherDeviceName, "AnotherProfileDevice"}, nil)
+	dbClientMock.On("EventsByDeviceName", 0, 1, testDeviceName).Return([]models.Event{{ProfileName: testProfileName}}, nil)
+	dbClientMock.On("EventsByDeviceName", 0, 1, "AnotherProfileDevice").Return([]models.Event{{ProfileName: "AnotherProfile"}}, nil)
+	dbClientMock.On("EventCountByDeviceName", testDeviceName).Return(uint32(11), nil)
+	dbClientMock.On("EventCountByDeviceName", otherDeviceName).Return(uint32(101), nil)
+	dbClientMock.On("EventsByDeviceName", 8, 1, testDeviceName).Return([]models.Event{{Origin: testOriginTime}}, nil)
+	dbClientMock.On("EventsByDeviceName", 80, 1, otherDeviceName).Return([]models.Event{{Origin: testOriginTime}}, nil)
+	dbClientMock.On("DeleteEventsByDeviceNameAndOrigin", testDeviceName, int64(testOriginTime)).Return(nil)
+	dbClientMock.On("DeleteEventsByDeviceNameAndOrigin", otherDeviceName, int64(testOriginTime)).Return(nil)
+	dbClientMock.On("EventCountByTenant", testTenant).Return(uint32(11), nil)
+	dbClientMock.On("EventsByTenant", 8, 1, testTenant).Return([]models.Event{{Origin: testOriginTime}}, nil)
+	dbClientMock.On("DeleteEventsByTenantAndOrigin", testTenant, int64(testOriginTime)).Return(nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	err := applyRetentionPolicy(policies[0], policies, dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "DeleteEventsByDeviceNameAndOrigin", testDeviceName, int64(testOriginTime))
+	dbClientMock.AssertNotCalled(t, "EventsByDeviceName", 0, 1, otherDeviceName)
+	dbClientMock.AssertNotCalled(t, "EventCountByDeviceName", "AnotherProfileDevice")
+
+	err = applyRetentionPolicy(policies[1], policies, dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "DeleteEventsByDeviceNameAndOrigin", otherDeviceName, int64(testOriginTime))
+
+	err = applyRetentionPolicy(policies[2], policies, dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "DeleteEventsByTenantAndOrigin", testTenant, int64(testOriginTime))
+}
+
+func TestValidatePolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        config.RetentionPolicy
+		expectedError bool
+	}{
+		{"valid device policy", config.RetentionPolicy{DeviceName: testDeviceName, MaxCap: 10, MinCap: 8}, false},
+		{"valid profile policy", config.RetentionPolicy{ProfileName: testProfileName, MaxCap: 10, MinCap: 10}, false},
+		{"valid tenant policy", config.RetentionPolicy{Tenant: "tenant-a", MaxCap: 10, MinCap: 8}, false},
+		{"invalid, no device or profile", config.RetentionPolicy{MaxCap: 10, MinCap: 8}, true},
+		{"invalid, tenant and device", config.RetentionPolicy{Tenant: "tenant-a", DeviceName: testDeviceName, MaxCap: 10, MinCap: 8}, true},
+		{"invalid, MaxCap 0", config.RetentionPolicy{DeviceName: testDeviceName}, true},
+		{"invalid, MinCap greater than MaxCap", config.RetentionPolicy{DeviceName: testDeviceName, MaxCap: 8, MinCap: 10}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validatePolicy(testCase.policy)
+			assert.Equal(t, testCase.expectedError, err != nil)
+		})
+	}
+}
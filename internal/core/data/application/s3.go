@@ -0,0 +1,247 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+)
+
+// The keys of the credentials in the secret named by BinaryOffload.S3.SecretName
+const (
+	secretKeyAccessKeyId     = "accessKeyId"
+	secretKeySecretAccessKey = "secretAccessKey"
+)
+
+// s3Storage is the binaryStorage of the binary values stored as the objects of an S3 compatible bucket, referenced by
+// s3://<bucket>/<key> URIs. The requests are signed with the AWS Signature Version 4.
+type s3Storage struct {
+	client   *http.Client
+	endpoint *url.URL
+	bucket   string
+	region   string
+	// credentials returns the access key id and the secret access key, read from the secret store on every request so
+	// that the rotated credentials are used
+	credentials func() (string, string, error)
+}
+
+func newS3Storage(info config.BinaryOffloadS3Info, dic *di.Container) (*s3Storage, errors.EdgeX) {
+	endpoint, err := url.Parse(info.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid S3 Endpoint '%s'", info.Endpoint), err)
+	}
+	if info.Bucket == "" || info.Region == "" || info.SecretName == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "S3 Bucket, Region and SecretName must be set", nil)
+	}
+	timeout, err := time.ParseDuration(info.Timeout)
+	if err != nil || timeout <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid S3 Timeout '%s'", info.Timeout), err)
+	}
+
+	return &s3Storage{
+		client:   &http.Client{Timeout: timeout},
+		endpoint: endpoint,
+		bucket:   info.Bucket,
+		region:   info.Region,
+		credentials: func() (string, string, error) {
+			secretProvider := bootstrapContainer.SecretProviderFrom(dic.Get)
+			if secretProvider == nil {
+				return "", "", errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing", nil)
+			}
+			secrets, err := secretProvider.GetSecret(info.SecretName, secretKeyAccessKeyId, secretKeySecretAccessKey)
+			if err != nil {
+				return "", "", errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to retrieve the S3 credentials of secret '%s' from the secret store", info.SecretName), err)
+			}
+			return secrets[secretKeyAccessKeyId], secrets[secretKeySecretAccessKey], nil
+		},
+	}, nil
+}
+
+func (s *s3Storage) put(key string, value []byte) (string, error) {
+	if _, err := s.do(http.MethodPut, key, nil, value); err != nil {
+		return "", err
+	}
+	return (&url.URL{Scheme: "s3", Host: s.bucket, Path: "/" + key}).String(), nil
+}
+
+func (s *s3Storage) key(uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Scheme != "s3" || parsed.Host != s.bucket {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "the binary value URI doesn't reference the binary offload bucket", nil)
+	}
+	return strings.TrimPrefix(parsed.Path, "/"), nil
+}
+
+func (s *s3Storage) get(key string) ([]byte, error) {
+	return s.do(http.MethodGet, key, nil, nil)
+}
+
+func (s *s3Storage) delete(key string) error {
+	_, err := s.do(http.MethodDelete, key, nil, nil)
+	return err
+}
+
+// s3ListBucketResult is the response of the ListObjectsV2 requests
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key string
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+func (s *s3Storage) list(prefix string) ([]string, error) {
+	var keys []string
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	for {
+		body, err := s.do(http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result s3ListBucketResult
+		if err := xml.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode the objects of bucket %s: %w", s.bucket, err)
+		}
+		for _, object := range result.Contents {
+			keys = append(keys, object.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+// do sends the signed request of the object of the key, or of the bucket when key is empty, and returns the body of
+// the response. The objects which don't exist are ignored by the DELETE requests.
+func (s *s3Storage) do(method string, key string, query url.Values, payload []byte) ([]byte, error) {
+	accessKeyId, secretAccessKey, err := s.credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURL := *s.endpoint
+	requestURL.Path = strings.TrimSuffix(s.endpoint.Path, "/") + "/" + s.bucket
+	if key != "" {
+		requestURL.Path += "/" + key
+	}
+	requestURL.RawPath = s3URIEncode(requestURL.Path, false)
+	requestURL.RawQuery = s3CanonicalQuery(query)
+	req, err := http.NewRequest(method, requestURL.String(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	s3Sign(req, payload, s.region, accessKeyId, secretAccessKey, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if method == http.MethodDelete && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		if len(body) > 1024 {
+			body = body[:1024]
+		}
+		return nil, fmt.Errorf("%s %s failed with status code %d: %s", method, requestURL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// s3Sign adds the AWS Signature Version 4 of the request, signed at now, to its headers
+func s3Sign(req *http.Request, payload []byte, region string, accessKeyId string, secretAccessKey string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(payload)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\nx-amz-content-sha256:" + payloadHash + "\nx-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	signingKey := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyId, scope, signedHeaders, signature))
+}
+
+// s3CanonicalQuery returns the query string of the parameters sorted by name and encoded as required by the signature
+func s3CanonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parameters := make([]string, 0, len(names))
+	for _, name := range names {
+		for _, value := range query[name] {
+			parameters = append(parameters, s3URIEncode(name, true)+"="+s3URIEncode(value, true))
+		}
+	}
+	return strings.Join(parameters, "&")
+}
+
+// s3URIEncode percent-encodes every byte of s but the unreserved characters, and the slashes unless encodeSlash
+func s3URIEncode(s string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for _, b := range []byte(s) {
+		if 'A' <= b && b <= 'Z' || 'a' <= b && b <= 'z' || '0' <= b && b <= '9' || b == '-' || b == '_' || b == '.' || b == '~' ||
+			(b == '/' && !encodeSlash) {
+			encoded.WriteByte(b)
+			continue
+		}
+		fmt.Fprintf(&encoded, "%%%02X", b)
+	}
+	return encoded.String()
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
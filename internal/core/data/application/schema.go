@@ -0,0 +1,175 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// schemaValidator validates the ingested events against their device profile, fetched from core-metadata and cached
+type schemaValidator struct {
+	cacheTTL time.Duration
+	mutex    sync.Mutex
+	profiles map[string]cachedProfile
+}
+
+// cachedProfile contains the value types of the device resources of a device profile, by resource name, nil when the
+// device profile doesn't exist
+type cachedProfile struct {
+	valueTypes map[string]string
+	fetched    time.Time
+}
+
+func newSchemaValidator(cacheTTL time.Duration) *schemaValidator {
+	return &schemaValidator{cacheTTL: cacheTTL, profiles: make(map[string]cachedProfile)}
+}
+
+// validate returns how the event doesn't conform to its device profile, nil when it conforms
+func (v *schemaValidator) validate(e models.Event, ctx context.Context, dic *di.Container) ([]dataDTOs.SchemaViolation, errors.EdgeX) {
+	valueTypes, err := v.valueTypes(e.ProfileName, ctx, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if valueTypes == nil {
+		return []dataDTOs.SchemaViolation{{Reason: dataDTOs.SchemaViolationUnknownProfile}}, nil
+	}
+
+	var violations []dataDTOs.SchemaViolation
+	for _, r := range e.Readings {
+		reading := r.GetBaseReading()
+		expected, exists := valueTypes[reading.ResourceName]
+		if !exists {
+			violations = append(violations, dataDTOs.SchemaViolation{
+				Reason:       dataDTOs.SchemaViolationUnknownResource,
+				ResourceName: reading.ResourceName,
+			})
+		} else if !strings.EqualFold(expected, reading.ValueType) {
+			violations = append(violations, dataDTOs.SchemaViolation{
+				Reason:            dataDTOs.SchemaViolationValueTypeMismatch,
+				ResourceName:      reading.ResourceName,
+				ValueType:         reading.ValueType,
+				ExpectedValueType: expected,
+			})
+		}
+	}
+	return violations, nil
+}
+
+// valueTypes returns the value types of the device resources of the device profile by resource name, nil when the
+// device profile doesn't exist
+func (v *schemaValidator) valueTypes(profileName string, ctx context.Context, dic *di.Container) (map[string]string, errors.EdgeX) {
+	v.mutex.Lock()
+	cached, exists := v.profiles[profileName]
+	v.mutex.Unlock()
+	if exists && time.Since(cached.fetched) < v.cacheTTL {
+		return cached.valueTypes, nil
+	}
+
+	client := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
+	if client == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "core-metadata DeviceProfileClient is not available", nil)
+	}
+	response, err := client.DeviceProfileByName(ctx, profileName)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query device profile %s", profileName), err)
+	}
+
+	var valueTypes map[string]string
+	if err == nil {
+		valueTypes = make(map[string]string, len(response.Profile.DeviceResources))
+		for _, resource := range response.Profile.DeviceResources {
+			valueTypes[resource.Name] = resource.Properties.ValueType
+		}
+	}
+	v.mutex.Lock()
+	v.profiles[profileName] = cachedProfile{valueTypes: valueTypes, fetched: time.Now()}
+	v.mutex.Unlock()
+	return valueTypes, nil
+}
+
+// checkSchema returns an error describing how the event doesn't conform to its device profile, and publishes the
+// SchemaViolationReport, when the event is rejected. The events are accepted when their device profile can't be
+// fetched, so that the ingestion doesn't depend on the availability of core-metadata.
+func (a *CoreDataApp) checkSchema(e models.Event, ctx context.Context, dic *di.Container) errors.EdgeX {
+	violations, err := a.schemaValidator.validate(e, ctx, dic)
+	if err != nil {
+		a.lc.Errorf("Unable to validate the event against device profile %s, accepting it: %v", e.ProfileName, err)
+		return nil
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	report := dataDTOs.SchemaViolationReport{
+		EventId:     e.Id,
+		DeviceName:  e.DeviceName,
+		ProfileName: e.ProfileName,
+		SourceName:  e.SourceName,
+		Violations:  violations,
+	}
+	a.publishSchemaViolation(report, ctx, dic)
+
+	messages := make([]string, len(violations))
+	for i, violation := range violations {
+		messages[i] = schemaViolationMessage(violation)
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("event doesn't conform to device profile %s: %s",
+		e.ProfileName, strings.Join(messages, "; ")), nil)
+}
+
+func schemaViolationMessage(violation dataDTOs.SchemaViolation) string {
+	switch violation.Reason {
+	case dataDTOs.SchemaViolationUnknownProfile:
+		return "the device profile doesn't exist"
+	case dataDTOs.SchemaViolationUnknownResource:
+		return fmt.Sprintf("resource %s isn't a device resource of the profile", violation.ResourceName)
+	case dataDTOs.SchemaViolationValueTypeMismatch:
+		return fmt.Sprintf("resource %s has value type %s instead of %s", violation.ResourceName, violation.ValueType, violation.ExpectedValueType)
+	default:
+		return violation.Reason
+	}
+}
+
+func (a *CoreDataApp) publishSchemaViolation(report dataDTOs.SchemaViolationReport, ctx context.Context, dic *di.Container) {
+	msgClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if msgClient == nil {
+		a.lc.Errorf("Unable to publish the schema violation of event %s, MessageBus is not available", report.EventId)
+		return
+	}
+	configuration := container.ConfigurationFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		a.lc.Errorf("Unable to encode the schema violation. Correlation-id: %s, Error: %v", correlationId, err)
+		return
+	}
+
+	publishTopic := common.BuildTopic(configuration.MessageBus.GetBaseTopicPrefix(), pkgCommon.CoreDataSchemaViolationPublishTopic,
+		report.ProfileName, report.DeviceName, url.QueryEscape(report.SourceName))
+	if err = msgClient.Publish(newMessageEnvelope(data, ctx, dic), publishTopic); err != nil {
+		a.lc.Errorf("Unable to publish the schema violation. Topic: %s, Correlation-id: %s, Error: %v", publishTopic, correlationId, err)
+		return
+	}
+	a.lc.Debugf("Schema violation published to MessageBus. Topic: %s, Correlation-id: %s", publishTopic, correlationId)
+}
@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	msgMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+)
+
+const unknownProfileName = "UnknownProfile"
+
+func newSchemaValidationDIC(dpcMock *clientMocks.DeviceProfileClient, msgClient *msgMocks.MessageClient) *di.Container {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			configuration := &config.ConfigurationStruct{
+				Writable:         config.WritableInfo{PersistData: true},
+				SchemaValidation: config.SchemaValidationInfo{Enabled: true, CacheTTL: "1m"},
+			}
+			configuration.MessageBus.BaseTopicPrefix = "edgex"
+			return configuration
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClient
+		},
+	})
+	return dic
+}
+
+func TestSchemaValidatorValidate(t *testing.T) {
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "temperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat64}},
+			{Name: "status", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString}},
+		},
+	}
+	dpcMock := &clientMocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, testProfileName).Return(responses.DeviceProfileResponse{Profile: profile}, nil)
+	dpcMock.On("DeviceProfileByName", mock.Anything, unknownProfileName).
+		Return(responses.DeviceProfileResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "profile doesn't exist", nil))
+	dic := newSchemaValidationDIC(dpcMock, nil)
+	validator := newSchemaValidator(time.Minute)
+
+	tests := []struct {
+		name               string
+		profileName        string
+		readings           []models.Reading
+		expectedViolations []dataDTOs.SchemaViolation
+	}{
+		{"conforming", testProfileName, []models.Reading{
+			simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "2.5e+01"),
+			simpleReading(testDeviceName, "status", common.ValueTypeString, "ok"),
+		}, nil},
+		{"unknown resource", testProfileName, []models.Reading{
+			simpleReading(testDeviceName, "humidity", common.ValueTypeFloat64, "5.0e+01"),
+		}, []dataDTOs.SchemaViolation{{Reason: dataDTOs.SchemaViolationUnknownResource, ResourceName: "humidity"}}},
+		{"value type mismatch", testProfileName, []models.Reading{
+			simpleReading(testDeviceName, "temperature", common.ValueTypeInt32, "25"),
+		}, []dataDTOs.SchemaViolation{{Reason: dataDTOs.SchemaViolationValueTypeMismatch, ResourceName: "temperature",
+			ValueType: common.ValueTypeInt32, ExpectedValueType: common.ValueTypeFloat64}}},
+		{"unknown profile", unknownProfileName, []models.Reading{
+			simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "2.5e+01"),
+		}, []dataDTOs.SchemaViolation{{Reason: dataDTOs.SchemaViolationUnknownProfile}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			event := models.Event{DeviceName: testDeviceName, ProfileName: testCase.profileName, Readings: testCase.readings}
+			violations, err := validator.validate(event, context.Background(), dic)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedViolations, violations)
+		})
+	}
+	// the profiles, including the unknown ones, are fetched once while cached
+	dpcMock.AssertNumberOfCalls(t, "DeviceProfileByName", 2)
+}
+
+func TestAddEventSchemaValidation(t *testing.T) {
+	dpcMock := &clientMocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, unknownProfileName).
+		Return(responses.DeviceProfileResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "profile doesn't exist", nil))
+	dpcMock.On("DeviceProfileByName", mock.Anything, testProfileName).
+		Return(responses.DeviceProfileResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "core-metadata is unavailable", nil))
+	msgClient := &msgMocks.MessageClient{}
+	msgClient.On("Publish", mock.Anything, "edgex/schemaviolation/UnknownProfile/TestDevice/testSourceName").Return(nil)
+	dic := newSchemaValidationDIC(dpcMock, msgClient)
+	app := NewCoreDataApp(dic)
+	require.NotNil(t, app.schemaValidator)
+
+	event := models.Event{DeviceName: testDeviceName, ProfileName: unknownProfileName, SourceName: testSourceName,
+		Readings: []models.Reading{simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "2.5e+01")}}
+	err := app.AddEvent(event, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	msgClient.AssertNumberOfCalls(t, "Publish", 1)
+
+	// the events are accepted when their profile can't be fetched
+	event.ProfileName = testProfileName
+	err = app.AddEvent(event, context.Background(), dic)
+	require.NoError(t, err)
+	container.DBClientFrom(dic.Get).(*dbMock.DBClient).AssertNumberOfCalls(t, "AddEvent", 1)
+}
@@ -0,0 +1,244 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+)
+
+// storeAndForwarder forwards the accepted events to an external endpoint in the order they were accepted. The events
+// are queued in the database first, and removed from the queue once the endpoint accepted or rejected them, so the
+// events accepted while the endpoint is unreachable are forwarded once it is reachable again. An event may be
+// forwarded twice when the database fails to remove it from the queue. All the methods of a nil storeAndForwarder,
+// when StoreAndForward is disabled, do nothing.
+type storeAndForwarder struct {
+	lc               logger.LoggingClient
+	client           *http.Client
+	url              string
+	secretName       string
+	maxQueueSize     int
+	dropOldest       bool
+	maxBatchSize     int
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
+	// queued is signaled when an event is queued, so that it is forwarded without delay
+	queued chan struct{}
+}
+
+func newStoreAndForwarder(info config.StoreAndForwardInfo, lc logger.LoggingClient) (*storeAndForwarder, errors.EdgeX) {
+	if _, err := url.ParseRequestURI(info.Url); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid Url '%s'", info.Url), err)
+	}
+	if info.MaxQueueSize <= 0 || info.MaxBatchSize <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "MaxQueueSize and MaxBatchSize must be positive", nil)
+	}
+	var dropOldest bool
+	switch info.OverflowPolicy {
+	case "", config.StoreAndForwardOverflowDropOldest:
+		dropOldest = true
+	case config.StoreAndForwardOverflowDropNewest:
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown OverflowPolicy '%s'", info.OverflowPolicy), nil)
+	}
+	durations := make(map[string]time.Duration, 3)
+	for name, value := range map[string]string{"RetryInterval": info.RetryInterval, "MaxRetryInterval": info.MaxRetryInterval, "Timeout": info.Timeout} {
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s '%s'", name, value), err)
+		}
+		durations[name] = duration
+	}
+	if durations["MaxRetryInterval"] < durations["RetryInterval"] {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "MaxRetryInterval can't be less than RetryInterval", nil)
+	}
+
+	return &storeAndForwarder{
+		lc:               lc,
+		client:           &http.Client{Timeout: durations["Timeout"]},
+		url:              info.Url,
+		secretName:       info.SecretName,
+		maxQueueSize:     info.MaxQueueSize,
+		dropOldest:       dropOldest,
+		maxBatchSize:     info.MaxBatchSize,
+		retryInterval:    durations["RetryInterval"],
+		maxRetryInterval: durations["MaxRetryInterval"],
+		queued:           make(chan struct{}, 1),
+	}, nil
+}
+
+// store queues the event to be forwarded. When the queue is full, the oldest queued events or the event are dropped
+// according to the overflow policy.
+func (f *storeAndForwarder) store(e models.Event, dic *di.Container) {
+	if f == nil {
+		return
+	}
+	payload, err := json.Marshal(requests.NewAddEventRequest(dtos.FromEventModelToDTO(e)))
+	if err != nil {
+		f.lc.Errorf("Unable to encode event %s to be forwarded: %v", e.Id, err)
+		return
+	}
+
+	dropped, edgexErr := container.DBClientFrom(dic.Get).AddForwardQueueEntry(payload, f.maxQueueSize, f.dropOldest)
+	if edgexErr != nil {
+		if errors.Kind(edgexErr) == errors.KindLimitExceeded {
+			f.lc.Warnf("Store-and-forward queue is full, dropping event %s", e.Id)
+		} else {
+			f.lc.Errorf("Unable to queue event %s to be forwarded: %v", e.Id, edgexErr)
+		}
+		return
+	}
+	if dropped > 0 {
+		f.lc.Warnf("Store-and-forward queue is full, dropped the %d oldest events", dropped)
+	}
+
+	select {
+	case f.queued <- struct{}{}:
+	default:
+	}
+}
+
+// run forwards the queued events, including the events queued before the service started, until ctx is done. While
+// the endpoint is unreachable, the forwarding is attempted again after retryInterval, doubled on every failure up to
+// maxRetryInterval.
+func (f *storeAndForwarder) run(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	defer wg.Done()
+
+	if size, err := container.DBClientFrom(dic.Get).ForwardQueueSize(); err != nil {
+		f.lc.Errorf("Unable to query the store-and-forward queue size: %v", err)
+	} else if size > 0 {
+		f.lc.Infof("%d queued events to be forwarded", size)
+	}
+
+	backoff := f.retryInterval
+	unreachable := false
+	for {
+		drained, err := f.forwardBatch(ctx, dic)
+		if err != nil {
+			if !unreachable {
+				f.lc.Warnf("Unable to forward the queued events, keeping them queued: %v", err)
+				unreachable = true
+			} else {
+				f.lc.Debugf("Unable to forward the queued events, retrying in %s: %v", backoff, err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > f.maxRetryInterval {
+				backoff = f.maxRetryInterval
+			}
+			continue
+		}
+
+		if unreachable {
+			f.lc.Info("Forwarding the queued events again")
+			unreachable = false
+		}
+		backoff = f.retryInterval
+		if drained {
+			select {
+			case <-ctx.Done():
+				return
+			case <-f.queued:
+			}
+		}
+	}
+}
+
+// forwardBatch forwards up to maxBatchSize of the oldest queued events, in order, and removes them from the queue. The
+// events rejected by the endpoint are removed as well. It stops at the first event which fails to be forwarded, and
+// returns whether the queue was drained.
+func (f *storeAndForwarder) forwardBatch(ctx context.Context, dic *di.Container) (bool, error) {
+	dbClient := container.DBClientFrom(dic.Get)
+	entries, err := dbClient.ForwardQueueEntries(f.maxBatchSize)
+	if err != nil {
+		return false, err
+	}
+	if len(entries) == 0 {
+		return true, nil
+	}
+	token, err := tokenFromSecret(f.secretName, dic)
+	if err != nil {
+		return false, err
+	}
+
+	var forwarded uint64
+	var forwardErr error
+	for _, entry := range entries {
+		retryable, err := f.post(ctx, entry.Payload, token)
+		if err != nil && retryable {
+			forwardErr = err
+			break
+		}
+		if err != nil {
+			f.lc.Errorf("Queued event %d rejected by the endpoint, dropping it: %v", entry.Sequence, err)
+		}
+		forwarded = entry.Sequence
+	}
+	if forwarded > 0 {
+		if err := dbClient.DeleteForwardQueueEntries(forwarded); err != nil {
+			return false, err
+		}
+	}
+	if forwardErr != nil {
+		return false, forwardErr
+	}
+	f.lc.Debugf("%d queued events forwarded", len(entries))
+	return len(entries) < f.maxBatchSize, nil
+}
+
+// post sends the event to the endpoint, and returns whether the request can be retried when it fails. The events
+// unauthorized by the endpoint can be retried, the token being possibly rotated meanwhile.
+func (f *storeAndForwarder) post(ctx context.Context, payload []byte, token string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.url, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set(common.ContentType, common.ContentTypeJSON)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	var retryable bool
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		retryable = true
+	default:
+		retryable = resp.StatusCode >= http.StatusInternalServerError
+	}
+	return retryable, fmt.Errorf("status code %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+}
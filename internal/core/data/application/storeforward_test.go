@@ -0,0 +1,216 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+func storeAndForwardInfo(url string) config.StoreAndForwardInfo {
+	return config.StoreAndForwardInfo{
+		Enabled:          true,
+		Url:              url,
+		MaxQueueSize:     10,
+		MaxBatchSize:     2,
+		RetryInterval:    "1ms",
+		MaxRetryInterval: "4ms",
+		Timeout:          "1s",
+	}
+}
+
+// forwardQueueDBClient returns a DBClient mock whose store-and-forward queue is kept in memory
+func forwardQueueDBClient() *dbMock.DBClient {
+	var mutex sync.Mutex
+	var queue []dataModels.ForwardQueueEntry
+	var sequence uint64
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddForwardQueueEntry", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		sequence++
+		queue = append(queue, dataModels.ForwardQueueEntry{Sequence: sequence, Payload: args.Get(0).([]byte)})
+	}).Return(uint32(0), nil)
+	dbClientMock.On("ForwardQueueEntries", mock.Anything).Return(func(limit int) []dataModels.ForwardQueueEntry {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if limit > len(queue) {
+			limit = len(queue)
+		}
+		return append([]dataModels.ForwardQueueEntry(nil), queue[:limit]...)
+	}, nil)
+	dbClientMock.On("DeleteForwardQueueEntries", mock.Anything).Run(func(args mock.Arguments) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		for len(queue) > 0 && queue[0].Sequence <= args.Get(0).(uint64) {
+			queue = queue[1:]
+		}
+	}).Return(nil)
+	dbClientMock.On("ForwardQueueSize").Return(func() uint32 {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return uint32(len(queue))
+	}, nil)
+	return dbClientMock
+}
+
+func TestNewStoreAndForwarder(t *testing.T) {
+	valid := storeAndForwardInfo("http://localhost:8080/events")
+	dropNewest := valid
+	dropNewest.OverflowPolicy = config.StoreAndForwardOverflowDropNewest
+	noUrl := valid
+	noUrl.Url = ""
+	noQueue := valid
+	noQueue.MaxQueueSize = 0
+	unknownPolicy := valid
+	unknownPolicy.OverflowPolicy = "block"
+	invalidRetryInterval := valid
+	invalidRetryInterval.RetryInterval = "1"
+	maxRetryIntervalTooShort := valid
+	maxRetryIntervalTooShort.MaxRetryInterval = "100us"
+
+	tests := []struct {
+		name               string
+		info               config.StoreAndForwardInfo
+		expectedDropOldest bool
+		errorExpected      bool
+	}{
+		{"valid, drop-oldest by default", valid, true, false},
+		{"valid, drop-newest", dropNewest, false, false},
+		{"invalid, no Url", noUrl, false, true},
+		{"invalid, no MaxQueueSize", noQueue, false, true},
+		{"invalid, OverflowPolicy", unknownPolicy, false, true},
+		{"invalid, RetryInterval", invalidRetryInterval, false, true},
+		{"invalid, MaxRetryInterval less than RetryInterval", maxRetryIntervalTooShort, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			forwarder, err := newStoreAndForwarder(testCase.info, logger.NewMockClient())
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedDropOldest, forwarder.dropOldest)
+		})
+	}
+}
+
+func TestStoreAndForwarderRun(t *testing.T) {
+	var mutex sync.Mutex
+	var received []string
+	unreachable := 3
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if unreachable > 0 {
+			unreachable--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		// the events aren't valid AddEventRequests, only their id is decoded
+		var request struct{ Event struct{ Id string } }
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, request.Event.Id)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	forwarder, err := newStoreAndForwarder(storeAndForwardInfo(server.URL), logger.NewMockClient())
+	require.NoError(t, err)
+	dbClientMock := forwardQueueDBClient()
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	// the events queued while the endpoint is unreachable, or before the forwarder runs, are forwarded in order
+	ids := []string{"event-1", "event-2", "event-3"}
+	for _, id := range ids {
+		forwarder.store(models.Event{Id: id, DeviceName: testDeviceName}, dic)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go forwarder.run(ctx, wg, dic)
+
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(received) == len(ids)
+	}, time.Second, time.Millisecond, "the queued events should be forwarded once the endpoint is reachable")
+	forwarder.store(models.Event{Id: "event-4", DeviceName: testDeviceName}, dic)
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(received) == len(ids)+1
+	}, time.Second, time.Millisecond, "the events queued afterwards should be forwarded without delay")
+	cancel()
+	wg.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, append(ids, "event-4"), received)
+	size, err := dbClientMock.ForwardQueueSize()
+	require.NoError(t, err)
+	assert.Zero(t, size, "the forwarded events should be removed from the queue")
+}
+
+func TestStoreAndForwarderRejected(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	forwarder, err := newStoreAndForwarder(storeAndForwardInfo(server.URL), logger.NewMockClient())
+	require.NoError(t, err)
+	dbClientMock := forwardQueueDBClient()
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	forwarder.store(models.Event{Id: "event-1", DeviceName: testDeviceName}, dic)
+
+	drained, forwardErr := forwarder.forwardBatch(context.Background(), dic)
+	require.NoError(t, forwardErr)
+	assert.True(t, drained)
+	assert.Equal(t, 1, requestCount, "the events rejected by the endpoint shouldn't be retried")
+	size, _ := dbClientMock.ForwardQueueSize()
+	assert.Zero(t, size, "the events rejected by the endpoint should be removed from the queue")
+}
+
+func TestStoreAndForwarderNil(t *testing.T) {
+	var forwarder *storeAndForwarder
+	assert.NotPanics(t, func() { forwarder.store(models.Event{}, mocks.NewMockDIC()) })
+}
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// eventStreams keeps the listeners of the events added, such as the gRPC clients subscribed to the events, keyed by
+// their channel with the name of the device they listen to, empty for all the devices
+type eventStreams struct {
+	mutex     sync.RWMutex
+	listeners map[chan dtos.Event]string
+}
+
+// StreamEvents returns the channel receiving the events added from now on, of the device or of all the devices when
+// deviceName is empty, and the function to call to stop listening, which closes the channel. Like the readings of the
+// reading subscriptions, at most listenerBufferSize events are buffered and further events are dropped until the
+// listener catches up.
+func (a *CoreDataApp) StreamEvents(deviceName string) (<-chan dtos.Event, func()) {
+	a.streams.mutex.Lock()
+	defer a.streams.mutex.Unlock()
+	if a.streams.listeners == nil {
+		a.streams.listeners = make(map[chan dtos.Event]string)
+	}
+	listener := make(chan dtos.Event, listenerBufferSize)
+	a.streams.listeners[listener] = deviceName
+	stop := func() {
+		a.streams.mutex.Lock()
+		defer a.streams.mutex.Unlock()
+		if _, exists := a.streams.listeners[listener]; exists {
+			delete(a.streams.listeners, listener)
+			close(listener)
+		}
+	}
+	return listener, stop
+}
+
+// streamEvent pushes the event, with its offloaded binary values loaded, to the listeners of its device
+func (a *CoreDataApp) streamEvent(e models.Event, dic *di.Container) {
+	a.streams.mutex.RLock()
+	defer a.streams.mutex.RUnlock()
+	if len(a.streams.listeners) == 0 {
+		return
+	}
+	event := dtos.FromEventModelToDTO(BinaryStoreFrom(dic.Get).LoadEvent(e))
+	for listener, deviceName := range a.streams.listeners {
+		if deviceName != "" && deviceName != e.DeviceName {
+			continue
+		}
+		select {
+		case listener <- event:
+		default:
+			a.lc.Warnf("Event %s dropped, a client of the event stream doesn't keep up", e.Id)
+		}
+	}
+}
+
+// EventStreamFilter selects the events and readings streamed to a client, by the names of their devices and resources.
+// An empty list of names selects them all.
+type EventStreamFilter struct {
+	DeviceNames   []string
+	ResourceNames []string
+}
+
+// Apply returns the event with the readings of the resources of the filter, and whether the event is selected, i.e.
+// it is an event of the devices of the filter with at least one reading selected
+func (f EventStreamFilter) Apply(event dtos.Event) (dtos.Event, bool) {
+	if len(f.DeviceNames) > 0 && !contains(f.DeviceNames, event.DeviceName) {
+		return event, false
+	}
+	if len(f.ResourceNames) == 0 {
+		return event, true
+	}
+	readings := make([]dtos.BaseReading, 0, len(event.Readings))
+	for _, reading := range event.Readings {
+		if contains(f.ResourceNames, reading.ResourceName) {
+			readings = append(readings, reading)
+		}
+	}
+	event.Readings = readings
+	return event, len(readings) > 0
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+)
+
+func TestStreamEvents(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	app := NewCoreDataApp(dic)
+
+	all, stopAll := app.StreamEvents("")
+	device, stopDevice := app.StreamEvents(testDeviceName)
+	other, stopOther := app.StreamEvents("otherDevice")
+	defer stopAll()
+	defer stopOther()
+
+	app.streamEvent(dedupTestEvent("1"), dic)
+	require.Len(t, all, 1)
+	require.Len(t, device, 1)
+	assert.Len(t, other, 0)
+	e := <-device
+	assert.Equal(t, testDeviceName, e.DeviceName)
+
+	stopDevice()
+	_, ok := <-device
+	assert.False(t, ok, "the channel should be closed once stopped")
+	stopDevice()
+
+	// the events exceeding the buffer of a listener are dropped instead of blocking
+	for i := 0; i < listenerBufferSize+1; i++ {
+		app.streamEvent(dedupTestEvent("1"), dic)
+	}
+	assert.Len(t, all, listenerBufferSize)
+}
+
+func TestEventStreamFilter(t *testing.T) {
+	event := dtos.FromEventModelToDTO(dedupTestEvent("1"))
+	event.Readings = append(event.Readings, dtos.BaseReading{ResourceName: "otherResource"})
+
+	tests := []struct {
+		name                  string
+		filter                EventStreamFilter
+		expectedSelected      bool
+		expectedReadingsCount int
+	}{
+		{"no filter", EventStreamFilter{}, true, 2},
+		{"device", EventStreamFilter{DeviceNames: []string{"otherDevice", testDeviceName}}, true, 2},
+		{"other device", EventStreamFilter{DeviceNames: []string{"otherDevice"}}, false, 0},
+		{"resource", EventStreamFilter{ResourceNames: []string{testDeviceResourceName}}, true, 1},
+		{"device and other resource", EventStreamFilter{DeviceNames: []string{testDeviceName}, ResourceNames: []string{"unknown"}}, false, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			filtered, selected := testCase.filter.Apply(event)
+			require.Equal(t, testCase.expectedSelected, selected)
+			if selected {
+				assert.Len(t, filtered.Readings, testCase.expectedReadingsCount)
+			}
+		})
+	}
+	assert.Len(t, event.Readings, 2, "the event must not be modified")
+}
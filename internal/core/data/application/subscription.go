@@ -0,0 +1,282 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// listenerBufferSize is the number of readings buffered for each WebSocket client, further readings are dropped
+// until the client catches up
+const listenerBufferSize = 100
+
+// ReadingSubscriptionManager keeps the reading subscriptions registered by the clients and dispatches the readings of
+// the ingested events to the matching subscriptions. Subscriptions are kept in memory only.
+type ReadingSubscriptionManager struct {
+	mutex            sync.RWMutex
+	lc               logger.LoggingClient
+	maxSubscriptions int
+	subscriptions    map[string]*readingSubscription
+}
+
+type readingSubscription struct {
+	dataDTOs.ReadingSubscription
+	listeners map[chan dtos.BaseReading]struct{}
+}
+
+// NewReadingSubscriptionManager creates the ReadingSubscriptionManager accepting at most maxSubscriptions
+// subscriptions, 0 means no limit
+func NewReadingSubscriptionManager(maxSubscriptions int, lc logger.LoggingClient) *ReadingSubscriptionManager {
+	return &ReadingSubscriptionManager{
+		lc:               lc,
+		maxSubscriptions: maxSubscriptions,
+		subscriptions:    make(map[string]*readingSubscription),
+	}
+}
+
+// ReadingSubscriptionManagerName contains the name of data's application.ReadingSubscriptionManager instance in the DIC.
+var ReadingSubscriptionManagerName = di.TypeInstanceToName(ReadingSubscriptionManager{})
+
+// ReadingSubscriptionManagerFrom helper function queries the DIC and returns the application.ReadingSubscriptionManager
+// instance, or nil when reading subscriptions aren't enabled.
+func ReadingSubscriptionManagerFrom(get di.Get) *ReadingSubscriptionManager {
+	manager, ok := get(ReadingSubscriptionManagerName).(*ReadingSubscriptionManager)
+	if !ok {
+		return nil
+	}
+	return manager
+}
+
+// Add registers the subscription and returns it with its id and MessageBus topic
+func (m *ReadingSubscriptionManager) Add(subscription dataDTOs.ReadingSubscription, dic *di.Container) (dataDTOs.ReadingSubscription, errors.EdgeX) {
+	if m == nil {
+		return subscription, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "reading subscriptions are not enabled", nil)
+	}
+	if subscription.Predicate != nil {
+		if err := validatePredicate(*subscription.Predicate); err != nil {
+			return subscription, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid predicate", err)
+		}
+	}
+
+	subscription.Id = uuid.NewString()
+	subscription.Topic = ""
+	if subscription.PublishToMessageBus {
+		baseTopicPrefix := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
+		subscription.Topic = common.BuildTopic(baseTopicPrefix, pkgCommon.CoreDataReadingSubscriptionPublishTopic, subscription.Id)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.maxSubscriptions > 0 && len(m.subscriptions) >= m.maxSubscriptions {
+		return subscription, errors.NewCommonEdgeX(errors.KindLimitExceeded,
+			fmt.Sprintf("the maximum number of reading subscriptions %d is reached", m.maxSubscriptions), nil)
+	}
+	m.subscriptions[subscription.Id] = &readingSubscription{
+		ReadingSubscription: subscription,
+		listeners:           make(map[chan dtos.BaseReading]struct{}),
+	}
+	return subscription, nil
+}
+
+// Subscription returns the subscription with the id
+func (m *ReadingSubscriptionManager) Subscription(id string) (dataDTOs.ReadingSubscription, errors.EdgeX) {
+	if m == nil {
+		return dataDTOs.ReadingSubscription{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "reading subscriptions are not enabled", nil)
+	}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	subscription, exists := m.subscriptions[id]
+	if !exists {
+		return dataDTOs.ReadingSubscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("reading subscription %s not found", id), nil)
+	}
+	return subscription.ReadingSubscription, nil
+}
+
+// AllSubscriptions returns the subscriptions sorted by id
+func (m *ReadingSubscriptionManager) AllSubscriptions() []dataDTOs.ReadingSubscription {
+	if m == nil {
+		return nil
+	}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	subscriptions := make([]dataDTOs.ReadingSubscription, 0, len(m.subscriptions))
+	for _, subscription := range m.subscriptions {
+		subscriptions = append(subscriptions, subscription.ReadingSubscription)
+	}
+	sort.Slice(subscriptions, func(i, j int) bool { return subscriptions[i].Id < subscriptions[j].Id })
+	return subscriptions
+}
+
+// Delete removes the subscription with the id and closes the channels of its listeners
+func (m *ReadingSubscriptionManager) Delete(id string) errors.EdgeX {
+	if m == nil {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "reading subscriptions are not enabled", nil)
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	subscription, exists := m.subscriptions[id]
+	if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("reading subscription %s not found", id), nil)
+	}
+	for listener := range subscription.listeners {
+		delete(subscription.listeners, listener)
+		close(listener)
+	}
+	delete(m.subscriptions, id)
+	return nil
+}
+
+// Listen returns the channel receiving the readings matching the subscription with the id, and the function to call
+// to stop listening. The channel is closed when the subscription is deleted or the listening stops.
+func (m *ReadingSubscriptionManager) Listen(id string) (<-chan dtos.BaseReading, func(), errors.EdgeX) {
+	if m == nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "reading subscriptions are not enabled", nil)
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	subscription, exists := m.subscriptions[id]
+	if !exists {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("reading subscription %s not found", id), nil)
+	}
+
+	listener := make(chan dtos.BaseReading, listenerBufferSize)
+	subscription.listeners[listener] = struct{}{}
+	stop := func() {
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		if _, exists := subscription.listeners[listener]; exists {
+			delete(subscription.listeners, listener)
+			close(listener)
+		}
+	}
+	return listener, stop, nil
+}
+
+// Dispatch pushes the readings of the event matching the subscriptions to their listeners, and publishes them to the
+// topics of the subscriptions published to the MessageBus
+func (m *ReadingSubscriptionManager) Dispatch(e models.Event, ctx context.Context, dic *di.Container) {
+	if m == nil {
+		return
+	}
+	msgClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	readings := make([]dtos.BaseReading, len(e.Readings))
+	for i, r := range e.Readings {
+		readings[i] = dtos.FromReadingModelToDTO(r)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	for _, subscription := range m.subscriptions {
+		for _, reading := range readings {
+			if !subscription.matches(reading) {
+				continue
+			}
+			for listener := range subscription.listeners {
+				select {
+				case listener <- reading:
+				default:
+					m.lc.Warnf("Reading dropped, a client of reading subscription %s doesn't keep up", subscription.Id)
+				}
+			}
+			if subscription.Topic != "" && msgClient != nil {
+				m.publish(msgClient, subscription.Topic, reading, ctx, dic)
+			}
+		}
+	}
+}
+
+func (m *ReadingSubscriptionManager) publish(msgClient messaging.MessageClient, topic string, reading dtos.BaseReading, ctx context.Context, dic *di.Container) {
+	data, err := json.Marshal(reading)
+	if err != nil {
+		m.lc.Errorf("Unable to encode the reading for topic %s: %v", topic, err)
+		return
+	}
+	if err = msgClient.Publish(newMessageEnvelope(data, ctx, dic), topic); err != nil {
+		m.lc.Errorf("Unable to publish the reading to topic %s: %v", topic, err)
+	}
+}
+
+func (s *readingSubscription) matches(reading dtos.BaseReading) bool {
+	if (s.DeviceName != "" && s.DeviceName != reading.DeviceName) ||
+		(s.ProfileName != "" && s.ProfileName != reading.ProfileName) ||
+		(s.ResourceName != "" && s.ResourceName != reading.ResourceName) {
+		return false
+	}
+	if s.Predicate == nil {
+		return true
+	}
+	if reading.BinaryValue != nil || reading.ObjectValue != nil {
+		return false
+	}
+	return evaluatePredicate(*s.Predicate, reading.Value)
+}
+
+func validatePredicate(predicate dataDTOs.ValuePredicate) error {
+	switch predicate.Operator {
+	case dataDTOs.PredicateEqual, dataDTOs.PredicateNotEqual:
+		return nil
+	case dataDTOs.PredicateGreater, dataDTOs.PredicateGreaterOrEqual, dataDTOs.PredicateLess, dataDTOs.PredicateLessOrEqual:
+		if _, err := strconv.ParseFloat(predicate.Value, 64); err != nil {
+			return fmt.Errorf("'%s' is not a number, required by operator %s", predicate.Value, predicate.Operator)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported operator '%s'", predicate.Operator)
+	}
+}
+
+// evaluatePredicate compares the value to the predicate's value, numerically when both are numbers
+func evaluatePredicate(predicate dataDTOs.ValuePredicate, value string) bool {
+	expected, expectedErr := strconv.ParseFloat(predicate.Value, 64)
+	actual, actualErr := strconv.ParseFloat(value, 64)
+	if expectedErr != nil || actualErr != nil {
+		switch predicate.Operator {
+		case dataDTOs.PredicateEqual:
+			return value == predicate.Value
+		case dataDTOs.PredicateNotEqual:
+			return value != predicate.Value
+		default:
+			return false
+		}
+	}
+
+	switch predicate.Operator {
+	case dataDTOs.PredicateEqual:
+		return actual == expected
+	case dataDTOs.PredicateNotEqual:
+		return actual != expected
+	case dataDTOs.PredicateGreater:
+		return actual > expected
+	case dataDTOs.PredicateGreaterOrEqual:
+		return actual >= expected
+	case dataDTOs.PredicateLess:
+		return actual < expected
+	case dataDTOs.PredicateLessOrEqual:
+		return actual <= expected
+	default:
+		return false
+	}
+}
@@ -0,0 +1,145 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	msgMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+)
+
+func TestEvaluatePredicate(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate dataDTOs.ValuePredicate
+		value     string
+		expected  bool
+	}{
+		{"numeric equal", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateEqual, Value: "10"}, "1.0e+01", true},
+		{"numeric not equal", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateNotEqual, Value: "10"}, "11", true},
+		{"greater", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateGreater, Value: "10"}, "11", true},
+		{"not greater", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateGreater, Value: "10"}, "10", false},
+		{"greater or equal", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateGreaterOrEqual, Value: "10"}, "10", true},
+		{"less", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateLess, Value: "10"}, "-5", true},
+		{"less or equal", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateLessOrEqual, Value: "10"}, "11", false},
+		{"string equal", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateEqual, Value: "on"}, "on", true},
+		{"string not equal", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateNotEqual, Value: "on"}, "off", true},
+		{"string greater", dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateGreater, Value: "10"}, "off", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, evaluatePredicate(testCase.predicate, testCase.value))
+		})
+	}
+}
+
+func TestReadingSubscriptionManagerAdd(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	manager := NewReadingSubscriptionManager(1, logger.NewMockClient())
+
+	_, err := manager.Add(dataDTOs.ReadingSubscription{Predicate: &dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateGreater, Value: "hot"}}, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	added, err := manager.Add(dataDTOs.ReadingSubscription{DeviceName: testDeviceName, PublishToMessageBus: true}, dic)
+	require.NoError(t, err)
+	assert.NotEmpty(t, added.Id)
+	assert.Contains(t, added.Topic, added.Id)
+
+	_, err = manager.Add(dataDTOs.ReadingSubscription{DeviceName: testDeviceName}, dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+
+	found, err := manager.Subscription(added.Id)
+	require.NoError(t, err)
+	assert.Equal(t, added, found)
+	assert.Equal(t, []dataDTOs.ReadingSubscription{added}, manager.AllSubscriptions())
+
+	require.NoError(t, manager.Delete(added.Id))
+	_, err = manager.Subscription(added.Id)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(manager.Delete(added.Id)))
+}
+
+func TestReadingSubscriptionManagerNotEnabled(t *testing.T) {
+	var manager *ReadingSubscriptionManager
+	_, err := manager.Add(dataDTOs.ReadingSubscription{}, mocks.NewMockDIC())
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	_, _, err = manager.Listen("id")
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Empty(t, manager.AllSubscriptions())
+	manager.Dispatch(models.Event{}, context.Background(), mocks.NewMockDIC())
+}
+
+func TestReadingSubscriptionManagerDispatch(t *testing.T) {
+	msgClient := &msgMocks.MessageClient{}
+	msgClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			configuration := &config.ConfigurationStruct{}
+			configuration.MessageBus.BaseTopicPrefix = "edgex"
+			return configuration
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClient
+		},
+	})
+	manager := NewReadingSubscriptionManager(0, logger.NewMockClient())
+
+	hot, err := manager.Add(dataDTOs.ReadingSubscription{
+		ResourceName: "temperature",
+		Predicate:    &dataDTOs.ValuePredicate{Operator: dataDTOs.PredicateGreater, Value: "30"},
+	}, dic)
+	require.NoError(t, err)
+	published, err := manager.Add(dataDTOs.ReadingSubscription{DeviceName: testDeviceName, PublishToMessageBus: true}, dic)
+	require.NoError(t, err)
+	assert.Equal(t, "edgex/core/readingsubscription/"+published.Id, published.Topic)
+
+	readings, stop, err := manager.Listen(hot.Id)
+	require.NoError(t, err)
+
+	event := models.Event{DeviceName: testDeviceName, Readings: []models.Reading{
+		simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "2.5e+01"),
+		simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "3.5e+01"),
+		simpleReading(testDeviceName, "humidity", common.ValueTypeFloat64, "5.0e+01"),
+	}}
+	manager.Dispatch(event, context.Background(), dic)
+
+	require.Len(t, readings, 1)
+	reading := <-readings
+	assert.Equal(t, "3.5e+01", reading.Value)
+	msgClient.AssertNumberOfCalls(t, "Publish", 3)
+	msgClient.AssertCalled(t, "Publish", mock.Anything, published.Topic)
+
+	stop()
+	_, open := <-readings
+	assert.False(t, open)
+	// stopping twice or after the deletion of the subscription must not panic
+	stop()
+	require.NoError(t, manager.Delete(hot.Id))
+
+	readings, _, err = manager.Listen(published.Id)
+	require.NoError(t, err)
+	require.NoError(t, manager.Delete(published.Id))
+	_, open = <-readings
+	assert.False(t, open)
+}
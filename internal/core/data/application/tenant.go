@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// WithTenant returns the event with the tenant tag of the event and its readings set to tenant. The tenant tags are
+// removed when tenant is empty, so that the events ingested without a tenant can't be stored in the tenant they tag.
+func WithTenant(e models.Event, tenant string) models.Event {
+	e.Tags = withTenantTag(e.Tags, tenant)
+	readings := make([]models.Reading, len(e.Readings))
+	for i, r := range e.Readings {
+		switch reading := r.(type) {
+		case models.SimpleReading:
+			reading.Tags = withTenantTag(reading.Tags, tenant)
+			readings[i] = reading
+		case models.BinaryReading:
+			reading.Tags = withTenantTag(reading.Tags, tenant)
+			readings[i] = reading
+		case models.ObjectReading:
+			reading.Tags = withTenantTag(reading.Tags, tenant)
+			readings[i] = reading
+		default:
+			readings[i] = r
+		}
+	}
+	e.Readings = readings
+	return e
+}
+
+// withTenantTag returns a copy of the tags with the tenant tag set to tenant, or removed when tenant is empty
+func withTenantTag(tags map[string]any, tenant string) map[string]any {
+	if _, exists := tags[pkgCommon.Tenant]; !exists && tenant == "" {
+		return tags
+	}
+	copied := make(map[string]any, len(tags)+1)
+	for k, v := range tags {
+		copied[k] = v
+	}
+	if tenant == "" {
+		delete(copied, pkgCommon.Tenant)
+	} else {
+		copied[pkgCommon.Tenant] = tenant
+	}
+	return copied
+}
+
+// CheckCrossTenantQuery returns a NotAllowed error when the tenancy is enabled, as the events and readings are then only
+// queried through the routes of each tenant rather than across the tenants
+func CheckCrossTenantQuery(dic *di.Container) errors.EdgeX {
+	if container.ConfigurationFrom(dic.Get).Tenancy.Enabled {
+		return errors.NewCommonEdgeX(errors.KindNotAllowed,
+			"the events and readings of all the tenants can't be queried when the tenancy is enabled, query the events and readings of a tenant instead", nil)
+	}
+	return nil
+}
+
+// EventsByTenant query the events of the tenant with offset and limit
+func (a *CoreDataApp) EventsByTenant(offset int, limit int, tenant string, dic *di.Container) (events []dtos.Event, totalCount uint32, err errors.EdgeX) {
+	if len(strings.TrimSpace(tenant)) == 0 {
+		return events, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "tenant is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	eventModels, err := dbClient.EventsByTenant(offset, limit, tenant)
+	if err == nil {
+		totalCount, err = dbClient.EventCountByTenant(tenant)
+	}
+	if err != nil {
+		return events, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	binaryStore := BinaryStoreFrom(dic.Get)
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(binaryStore.LoadEvent(e))
+	}
+	return events, totalCount, nil
+}
+
+// EventCountByTenant return the count of the events of the tenant
+func (a *CoreDataApp) EventCountByTenant(tenant string, dic *di.Container) (uint32, errors.EdgeX) {
+	if len(strings.TrimSpace(tenant)) == 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "tenant is empty", nil)
+	}
+	count, err := container.QueryDBClientFrom(dic.Get).EventCountByTenant(tenant)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return count, nil
+}
+
+// ReadingsByTenant query the readings of the tenant with offset and limit
+func ReadingsByTenant(offset int, limit int, tenant string, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, err errors.EdgeX) {
+	if len(strings.TrimSpace(tenant)) == 0 {
+		return readings, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "tenant is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	readingModels, err := dbClient.ReadingsByTenant(offset, limit, tenant)
+	if err == nil {
+		readings, err = convertReadingModelsToDTOs(readingModels, dic)
+		if err == nil {
+			totalCount, err = dbClient.ReadingCountByTenant(tenant)
+		}
+	}
+	if err != nil {
+		return readings, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, totalCount, nil
+}
+
+// ReadingCountByTenant return the count of the readings of the tenant
+func ReadingCountByTenant(tenant string, dic *di.Container) (uint32, errors.EdgeX) {
+	if len(strings.TrimSpace(tenant)) == 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "tenant is empty", nil)
+	}
+	count, err := container.QueryDBClientFrom(dic.Get).ReadingCountByTenant(tenant)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return count, nil
+}
@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+const testTenant = "tenant-a"
+
+func TestWithTenant(t *testing.T) {
+	spoofed := simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "2.5e+01")
+	spoofed.Tags = map[string]any{pkgCommon.Tenant: "tenant-b", "location": "lab"}
+
+	tests := []struct {
+		name     string
+		eventTag map[string]any
+		reading  models.SimpleReading
+		tenant   string
+	}{
+		{"set tenant", nil, simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "2.5e+01"), testTenant},
+		{"override tenant", map[string]any{pkgCommon.Tenant: "tenant-b"}, spoofed, testTenant},
+		{"remove tenant", map[string]any{pkgCommon.Tenant: "tenant-b"}, spoofed, ""},
+		{"no tenant", nil, simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "2.5e+01"), ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			event := models.Event{DeviceName: testDeviceName, Tags: testCase.eventTag, Readings: []models.Reading{testCase.reading}}
+
+			result := WithTenant(event, testCase.tenant)
+
+			reading := result.Readings[0].(models.SimpleReading)
+			if testCase.tenant == "" {
+				assert.NotContains(t, result.Tags, pkgCommon.Tenant)
+				assert.NotContains(t, reading.Tags, pkgCommon.Tenant)
+			} else {
+				assert.Equal(t, testCase.tenant, result.Tags[pkgCommon.Tenant])
+				assert.Equal(t, testCase.tenant, reading.Tags[pkgCommon.Tenant])
+			}
+			for k, v := range testCase.reading.Tags {
+				if k != pkgCommon.Tenant {
+					assert.Equal(t, v, reading.Tags[k])
+				}
+			}
+			// the tags of the original event are left untouched
+			assert.Equal(t, testCase.eventTag, event.Tags)
+		})
+	}
+	assert.Equal(t, "tenant-b", spoofed.Tags[pkgCommon.Tenant])
+}
+
+func TestEventsByTenant(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsByTenant", 0, 10, testTenant).Return([]models.Event{persistedEvent}, nil)
+	dbClientMock.On("EventCountByTenant", testTenant).Return(uint32(1), nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	app := NewCoreDataApp(dic)
+
+	events, totalCount, err := app.EventsByTenant(0, 10, testTenant, dic)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), totalCount)
+	require.Len(t, events, 1)
+	assert.Equal(t, persistedEvent.Id, events[0].Id)
+
+	_, _, err = app.EventsByTenant(0, 10, " ", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestCheckCrossTenantQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		enabled       bool
+		expectedError bool
+	}{
+		{"tenancy disabled", false, false},
+		{"tenancy enabled", true, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{Tenancy: config.TenancyInfo{Enabled: testCase.enabled}}
+				},
+			})
+
+			err := CheckCrossTenantQuery(dic)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindNotAllowed, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
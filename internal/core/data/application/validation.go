@@ -0,0 +1,181 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// ValidationViolationTag is the tag of the readings violating a ReadingValidation rule, its value describes the violation
+const ValidationViolationTag = "validationViolation"
+
+// readingValidator validates the readings of the ingested events against the ReadingValidation rules
+type readingValidator struct {
+	rules []config.ReadingValidationRule
+}
+
+func newReadingValidator(rules []config.ReadingValidationRule) *readingValidator {
+	return &readingValidator{rules: rules}
+}
+
+// validate returns the event with the rules applied to its readings, and the readings violating a rule tagged with
+// the violation
+func (v *readingValidator) validate(e models.Event) (models.Event, []models.Reading) {
+	var violations []models.Reading
+	readings := make([]models.Reading, 0, len(e.Readings))
+	for _, r := range e.Readings {
+		reading, ok := r.(models.SimpleReading)
+		if !ok {
+			readings = append(readings, r)
+			continue
+		}
+
+		for _, rule := range v.rules {
+			if !ruleMatches(rule, reading.BaseReading) {
+				continue
+			}
+			violation, clamped := checkReading(rule, reading)
+			if violation == "" {
+				continue
+			}
+			violations = append(violations, tagReading(reading, violation))
+
+			switch {
+			case rule.Action == config.ReadingValidationActionTag:
+				reading = tagReading(reading, violation)
+				continue
+			case rule.Action == config.ReadingValidationActionClamp && clamped != "":
+				reading.Value = clamped
+				continue
+			}
+			// rejected
+			ok = false
+			break
+		}
+		if ok {
+			readings = append(readings, reading)
+		}
+	}
+
+	e.Readings = readings
+	return e, violations
+}
+
+func ruleMatches(rule config.ReadingValidationRule, reading models.BaseReading) bool {
+	return (rule.DeviceName == "" || rule.DeviceName == reading.DeviceName) &&
+		(rule.ProfileName == "" || rule.ProfileName == reading.ProfileName) &&
+		(rule.ResourceName == "" || rule.ResourceName == reading.ResourceName)
+}
+
+// checkReading returns the violation of the rule by the reading, empty if none, and the value clamped to the range of
+// the rule when the value is out of range
+func checkReading(rule config.ReadingValidationRule, reading models.SimpleReading) (violation string, clamped string) {
+	if rule.Units != "" && reading.Units != rule.Units {
+		return fmt.Sprintf("units '%s' are not the expected units '%s'", reading.Units, rule.Units), ""
+	}
+	if !isNumericValueType(reading.ValueType) {
+		return "", ""
+	}
+
+	value, err := strconv.ParseFloat(reading.Value, 64)
+	if err != nil {
+		return fmt.Sprintf("'%s' is not a %s", reading.Value, reading.ValueType), ""
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		if rule.RejectNaN {
+			return fmt.Sprintf("%s is not a number", reading.Value), ""
+		}
+		return "", ""
+	}
+	if rule.Minimum != nil && value < *rule.Minimum {
+		return fmt.Sprintf("%s is less than the minimum %v", reading.Value, *rule.Minimum), formatValue(reading.ValueType, *rule.Minimum, math.Ceil)
+	}
+	if rule.Maximum != nil && value > *rule.Maximum {
+		return fmt.Sprintf("%s is greater than the maximum %v", reading.Value, *rule.Maximum), formatValue(reading.ValueType, *rule.Maximum, math.Floor)
+	}
+	return "", ""
+}
+
+// formatValue formats the value as the value type, integer values are rounded with round
+func formatValue(valueType string, value float64, round func(float64) float64) string {
+	switch valueType {
+	case common.ValueTypeFloat32:
+		return strconv.FormatFloat(value, 'e', -1, 32)
+	case common.ValueTypeFloat64:
+		return strconv.FormatFloat(value, 'e', -1, 64)
+	default:
+		return strconv.FormatFloat(round(value), 'f', 0, 64)
+	}
+}
+
+// tagReading returns the reading with the violation added to a copy of its tags
+func tagReading(reading models.SimpleReading, violation string) models.SimpleReading {
+	tags := make(map[string]any, len(reading.Tags)+1)
+	for k, v := range reading.Tags {
+		tags[k] = v
+	}
+	if previous, ok := tags[ValidationViolationTag].(string); ok && previous != "" && previous != violation {
+		violation = previous + "; " + violation
+	}
+	tags[ValidationViolationTag] = violation
+	reading.Tags = tags
+	return reading
+}
+
+// publishViolations publishes the event with the readings violating the ReadingValidation rules to the quarantine topic
+func (a *CoreDataApp) publishViolations(e models.Event, violations []models.Reading, ctx context.Context, dic *di.Container) {
+	msgClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if msgClient == nil {
+		a.lc.Errorf("Unable to publish %d invalid readings to the quarantine topic, MessageBus is not available", len(violations))
+		return
+	}
+	configuration := container.ConfigurationFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	e.Readings = violations
+	data, err := json.Marshal(requests.NewAddEventRequest(dtos.FromEventModelToDTO(e)))
+	if err != nil {
+		a.lc.Errorf("Unable to encode the invalid readings. Correlation-id: %s, Error: %v", correlationId, err)
+		return
+	}
+
+	publishTopic := common.BuildTopic(configuration.MessageBus.GetBaseTopicPrefix(), pkgCommon.CoreDataQuarantinePublishTopic,
+		e.ProfileName, e.DeviceName, url.QueryEscape(e.SourceName))
+	if err = msgClient.Publish(newMessageEnvelope(data, ctx, dic), publishTopic); err != nil {
+		a.lc.Errorf("Unable to publish the invalid readings. Topic: %s, Correlation-id: %s, Error: %v", publishTopic, correlationId, err)
+		return
+	}
+	a.lc.Debugf("Invalid readings published to MessageBus. Topic: %s, Correlation-id: %s, Violations: %s",
+		publishTopic, correlationId, strings.Join(violationMessages(violations), "; "))
+}
+
+func violationMessages(violations []models.Reading) []string {
+	messages := make([]string, 0, len(violations))
+	for _, r := range violations {
+		if reading, ok := r.(models.SimpleReading); ok {
+			messages = append(messages, fmt.Sprintf("%s: %v", reading.ResourceName, reading.Tags[ValidationViolationTag]))
+		}
+	}
+	return messages
+}
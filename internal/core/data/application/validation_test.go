@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	msgMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+)
+
+func TestReadingValidatorValidate(t *testing.T) {
+	minimum := float64(0)
+	maximum := float64(100)
+	rangeRule := config.ReadingValidationRule{ResourceName: "temperature", Minimum: &minimum, Maximum: &maximum, RejectNaN: true}
+
+	tests := []struct {
+		name              string
+		rule              config.ReadingValidationRule
+		reading           models.SimpleReading
+		expectedValid     bool
+		expectedValue     string
+		expectedTagged    bool
+		expectedViolation bool
+	}{
+		{"valid", rangeRule, simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "5.0e+01"), true, "5.0e+01", false, false},
+		{"rule not matching", rangeRule, simpleReading(testDeviceName, "humidity", common.ValueTypeFloat64, "1.5e+02"), true, "1.5e+02", false, false},
+		{"non numeric reading", rangeRule, simpleReading(testDeviceName, "temperature", common.ValueTypeString, "hot"), true, "hot", false, false},
+		{"reject above maximum", rangeRule, simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "1.5e+02"), false, "", false, true},
+		{"reject NaN", rangeRule, simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "NaN"), false, "", false, true},
+		{"clamp above maximum", withAction(rangeRule, config.ReadingValidationActionClamp), simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "1.5e+02"), true, "1e+02", false, true},
+		{"clamp integer below minimum", withAction(rangeRule, config.ReadingValidationActionClamp), simpleReading(testDeviceName, "temperature", common.ValueTypeInt32, "-5"), true, "0", false, true},
+		{"clamp NaN rejected", withAction(rangeRule, config.ReadingValidationActionClamp), simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "NaN"), false, "", false, true},
+		{"tag above maximum", withAction(rangeRule, config.ReadingValidationActionTag), simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "1.5e+02"), true, "1.5e+02", true, true},
+		{"reject unexpected units", config.ReadingValidationRule{Units: "degC"}, simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "5.0e+01"), false, "", false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			validator := newReadingValidator([]config.ReadingValidationRule{testCase.rule})
+			event := models.Event{DeviceName: testDeviceName, Readings: []models.Reading{testCase.reading}}
+
+			validated, violations := validator.validate(event)
+			if testCase.expectedViolation {
+				require.Len(t, violations, 1)
+				assert.Contains(t, violations[0].(models.SimpleReading).Tags, ValidationViolationTag)
+			} else {
+				assert.Empty(t, violations)
+			}
+			if !testCase.expectedValid {
+				assert.Empty(t, validated.Readings)
+				return
+			}
+			require.Len(t, validated.Readings, 1)
+			reading := validated.Readings[0].(models.SimpleReading)
+			assert.Equal(t, testCase.expectedValue, reading.Value)
+			_, tagged := reading.Tags[ValidationViolationTag]
+			assert.Equal(t, testCase.expectedTagged, tagged)
+		})
+	}
+}
+
+func withAction(rule config.ReadingValidationRule, action string) config.ReadingValidationRule {
+	rule.Action = action
+	return rule
+}
+
+func TestAddEventReadingValidation(t *testing.T) {
+	maximum := float64(100)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvent", mock.Anything).Return(models.Event{}, nil)
+	msgClient := &msgMocks.MessageClient{}
+	msgClient.On("Publish", mock.Anything, "edgex/quarantine/TestProfile/TestDevice/testSourceName").Return(nil)
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			configuration := &config.ConfigurationStruct{
+				Writable: config.WritableInfo{PersistData: true},
+				ReadingValidation: config.ReadingValidationInfo{
+					Enabled: true,
+					Rules:   []config.ReadingValidationRule{{Maximum: &maximum}},
+				},
+			}
+			configuration.MessageBus.BaseTopicPrefix = "edgex"
+			return configuration
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClient
+		},
+	})
+	app := NewCoreDataApp(dic)
+
+	valid := simpleReading(testDeviceName, "temperature", common.ValueTypeFloat64, "5.0e+01")
+	invalid := simpleReading(testDeviceName, "humidity", common.ValueTypeFloat64, "1.5e+02")
+	event := models.Event{DeviceName: testDeviceName, ProfileName: testProfileName, SourceName: testSourceName}
+
+	event.Readings = []models.Reading{valid, invalid}
+	err := app.AddEvent(event, context.Background(), dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "AddEvent", mock.MatchedBy(func(e models.Event) bool { return len(e.Readings) == 1 }))
+	msgClient.AssertNumberOfCalls(t, "Publish", 1)
+	assert.Equal(t, int64(1), app.invalidReadingsCounter.Count())
+
+	event.Readings = []models.Reading{invalid}
+	err = app.AddEvent(event, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	dbClientMock.AssertNumberOfCalls(t, "AddEvent", 1)
+}
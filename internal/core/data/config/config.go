@@ -17,15 +17,53 @@ package config
 
 import (
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 type ConfigurationStruct struct {
-	Writable     WritableInfo
-	MessageBus   bootstrapConfig.MessageBusInfo
-	Database     bootstrapConfig.Database
-	Registry     bootstrapConfig.RegistryInfo
-	Service      bootstrapConfig.ServiceInfo
-	MaxEventSize int64
+	Writable            WritableInfo
+	Clients             bootstrapConfig.ClientsCollection
+	MessageBus          bootstrapConfig.MessageBusInfo
+	Database            bootstrapConfig.Database
+	DatabasePool        db.PoolInfo
+	DatabaseReplica     db.ReplicaInfo
+	Registry            bootstrapConfig.RegistryInfo
+	Service             bootstrapConfig.ServiceInfo
+	MaxEventSize        int64
+	Retention           RetentionInfo
+	Aggregation         AggregationInfo
+	Deduplication       DeduplicationInfo
+	ReadingValidation   ReadingValidationInfo
+	ReadingSubscription ReadingSubscriptionInfo
+	Compression         CompressionInfo
+	BinaryOffload       BinaryOffloadInfo
+	WriteBatching       WriteBatchingInfo
+	SchemaValidation    SchemaValidationInfo
+	InfluxExport        InfluxExportInfo
+	ParquetExport       ParquetExportInfo
+	StoreAndForward     StoreAndForwardInfo
+	Lateness            LatenessInfo
+	EventEnrichment     EventEnrichmentInfo
+	Tenancy             TenancyInfo
+	RBAC                rbac.Info
+	// MutualTLS configures mutual TLS on the REST API and for the requests to the other services
+	MutualTLS pkgHandlers.MutualTLSInfo
+	// Grpc configures the gRPC API served alongside the REST API
+	Grpc pkgHandlers.GrpcInfo
+	// Audit configures the audit log of the security relevant operations
+	Audit audit.Info
+	// APIKey configures the authentication of the headless clients with the API keys of the secret store
+	APIKey apikey.Info
+	// Tracing configures the distributed tracing spans exported to an OpenTelemetry collector
+	Tracing tracing.Info
+	// JetStream configures the NATS JetStream durable consumers of the MessageBus subscriptions
+	JetStream pkgHandlers.JetStreamInfo
 }
 
 type WritableInfo struct {
@@ -35,6 +73,285 @@ type WritableInfo struct {
 	Telemetry       bootstrapConfig.TelemetryInfo
 }
 
+// RetentionInfo contains the settings of the purging of the oldest events when their number exceeds a capacity
+type RetentionInfo struct {
+	Enabled bool
+	// Interval is how often the number of events is checked, i.e. 30s
+	Interval string
+	// MaxCap is the number of events above which the oldest events are purged, 0 means no limit
+	MaxCap uint32
+	// MinCap is the number of most recent events kept when the oldest events are purged
+	MinCap uint32
+	// Policies specify the capacity of the events of each device matching DeviceName or ProfileName, in addition to
+	// the capacity of all the events
+	Policies []RetentionPolicy
+}
+
+// RetentionPolicy specifies the capacity of the events of the device named DeviceName or, when DeviceName is empty,
+// of each device whose most recent event belongs to the device profile named ProfileName. A DeviceName policy takes
+// precedence over a ProfileName policy. A Tenant policy specifies the capacity of all the events of the tenant, and
+// can't also specify DeviceName or ProfileName.
+type RetentionPolicy struct {
+	DeviceName  string
+	ProfileName string
+	Tenant      string
+	// Interval is how often the number of events is checked, defaults to Retention.Interval
+	Interval string
+	// MaxCap is the number of events of a device above which its oldest events are purged
+	MaxCap uint32
+	// MinCap is the number of most recent events of a device kept when its oldest events are purged
+	MinCap uint32
+}
+
+// TenancyInfo contains the settings of the isolation of the events and readings of the tenants. When Enabled, the
+// events and readings are only queried through the routes of each tenant, by the callers of the tenant, the queries of
+// the events and readings of all the tenants are rejected.
+type TenancyInfo struct {
+	Enabled bool
+	// TenantClaim is the claim of the JWT holding the tenant of the caller, nested claims are separated by dots. The
+	// events and readings of a tenant are only queried with a validated JWT whose TenantClaim is the tenant.
+	TenantClaim string
+}
+
+// AggregationInfo contains the settings of the continuous aggregation of the numeric readings into summarized
+// reading aggregates
+type AggregationInfo struct {
+	Enabled bool
+	// Window is the duration of the time windows over which the readings are aggregated, i.e. 1m
+	Window string
+	// MaxAge is how long the reading aggregates are kept, i.e. 720h. Empty keeps them indefinitely
+	MaxAge string
+}
+
+// DeduplicationInfo contains the settings of the discarding of the events received more than once, i.e. retransmitted
+// by a device service after reconnecting. An event is a duplicate of an event received within Window when they have
+// the same device name, source name, origin and readings.
+type DeduplicationInfo struct {
+	Enabled bool
+	// Window is how long a received event is remembered, i.e. 10m
+	Window string
+}
+
+// ReadingValidationInfo contains the rules the readings are validated against when events are ingested. The readings
+// violating a rule are published to the quarantine topic.
+type ReadingValidationInfo struct {
+	Enabled bool
+	Rules   []ReadingValidationRule
+}
+
+// Actions taken on the readings violating a ReadingValidationRule
+const (
+	// ReadingValidationActionReject removes the reading from the event
+	ReadingValidationActionReject = "reject"
+	// ReadingValidationActionClamp replaces the value out of range by the Minimum or Maximum, the readings violating
+	// the rule otherwise are rejected
+	ReadingValidationActionClamp = "clamp"
+	// ReadingValidationActionTag keeps the reading and adds the violation to its tags
+	ReadingValidationActionTag = "tag"
+)
+
+// ReadingValidationRule validates the numeric readings matching DeviceName, ProfileName and ResourceName, empty
+// matches any.
+type ReadingValidationRule struct {
+	DeviceName   string
+	ProfileName  string
+	ResourceName string
+	// Minimum and Maximum are the range of the valid values, the range isn't checked when they are not set
+	Minimum *float64
+	Maximum *float64
+	// RejectNaN makes NaN and infinite values invalid
+	RejectNaN bool
+	// Units are the units the readings must have, not checked when empty
+	Units string
+	// Action is "reject", "clamp" or "tag", defaults to "reject"
+	Action string
+}
+
+// ReadingSubscriptionInfo contains the settings of the subscriptions of the clients to the readings matching a filter
+type ReadingSubscriptionInfo struct {
+	Enabled bool
+	// MaxSubscriptions is the maximum number of subscriptions, 0 means no limit
+	MaxSubscriptions int
+}
+
+// CompressionInfo contains the settings of the compression of the MessageEnvelope payloads published by core-data.
+// The compressed payloads received are decompressed regardless of these settings.
+type CompressionInfo struct {
+	// Encoding is "gzip" or "deflate", empty disables the compression
+	Encoding string
+	// MinSize is the size in bytes under which the payloads are published uncompressed
+	MinSize int
+}
+
+// BinaryOffloadInfo contains the settings of the offloading of the binary values of the binary readings, i.e. images,
+// to files or to an S3 compatible bucket, so that only a reference to them is stored in the database. The offloaded
+// binary values are loaded back when the readings are queried, and are removed with their events.
+type BinaryOffloadInfo struct {
+	Enabled bool
+	// Target is where the binary values are offloaded, either filesystem or s3
+	Target string
+	// Directory is where the files of the offloaded binary values are written by the filesystem Target
+	Directory string
+	// MinSize is the size in bytes under which the binary values are stored in the database
+	MinSize int
+	// S3 contains the settings of the bucket of the s3 Target
+	S3 BinaryOffloadS3Info
+}
+
+// The BinaryOffload targets
+const (
+	BinaryOffloadTargetFilesystem = "filesystem"
+	BinaryOffloadTargetS3         = "s3"
+)
+
+// BinaryOffloadS3Info contains the settings of the S3 compatible bucket, i.e. AWS S3 or MinIO, the binary values are
+// offloaded to. The objects are addressed in the path style, <Endpoint>/<Bucket>/<key>.
+type BinaryOffloadS3Info struct {
+	// Endpoint is the URL of the S3 compatible service, i.e. https://s3.us-east-1.amazonaws.com or http://localhost:9000
+	Endpoint string
+	Bucket   string
+	// Region is the region the requests are signed for, i.e. us-east-1
+	Region string
+	// SecretName is the name of the secret whose "accessKeyId" and "secretAccessKey" sign the requests
+	SecretName string
+	// Timeout is the timeout of a request, i.e. 10s
+	Timeout string
+}
+
+// WriteBatchingInfo contains the settings of the batching of the events received from the MessageBus into grouped
+// database writes, instead of one write per event. The events received by the REST API are always written one by one.
+type WriteBatchingInfo struct {
+	Enabled bool
+	// MaxSize is the number of queued events written at once
+	MaxSize int
+	// FlushInterval is how often the queued events are written when fewer than MaxSize events are queued, i.e. 100ms
+	FlushInterval string
+}
+
+// SchemaValidationInfo contains the settings of the rejection of the ingested events whose readings don't conform to
+// the device profile of the event, i.e. reference a resource which isn't a device resource of the profile or have
+// another value type. The device profiles are fetched from core-metadata.
+type SchemaValidationInfo struct {
+	Enabled bool
+	// CacheTTL is how long a device profile fetched from core-metadata is cached, i.e. 1m
+	CacheTTL string
+}
+
+// InfluxExportInfo contains the settings of the export of the accepted events, in the InfluxDB line protocol, to an
+// InfluxDB or Telegraf endpoint. The numeric, boolean and string readings are exported as one point per reading.
+type InfluxExportInfo struct {
+	Enabled bool
+	// Url is the write endpoint, i.e. http://localhost:8086/api/v2/write?org=edgex&bucket=edgex&precision=ns
+	Url string
+	// SecretName is the name of the secret whose "token" is sent as the API token, no token is sent when empty
+	SecretName string
+	// QueueSize is the number of points queued before the new points are dropped
+	QueueSize int
+	// MaxBatchSize is the number of queued points written at once
+	MaxBatchSize int
+	// FlushInterval is how often the queued points are written when fewer than MaxBatchSize points are queued, i.e. 1s
+	FlushInterval string
+	// MaxRetries is how many times a failed write is retried before its points are dropped
+	MaxRetries int
+	// RetryInterval is the delay before the first retry, doubled on every retry, i.e. 1s
+	RetryInterval string
+	// Timeout is the timeout of a write, i.e. 10s
+	Timeout string
+}
+
+// ParquetExportInfo contains the settings of the export of the readings to Apache Parquet files, i.e. for the
+// ingestion by a data lake. The readings of a time range are exported by a background job started on request, one job
+// at a time, to one file per device and day of origin, partitioned as
+// device=<device-name>/date=<yyyy-mm-dd>/readings-<start>-<end>.parquet. The files of a day are built in memory and
+// written to a directory or to an S3 compatible bucket once all the readings of the day are read.
+type ParquetExportInfo struct {
+	Enabled bool
+	// Target is where the files are written, either filesystem or s3
+	Target string
+	// Directory is where the files are written by the filesystem Target
+	Directory string
+	// RowGroupSize is the number of readings of the row groups of the files
+	RowGroupSize int
+	// S3 contains the settings of the bucket of the s3 Target
+	S3 BinaryOffloadS3Info
+}
+
+// StoreAndForwardInfo contains the settings of the forwarding of the accepted events to an external endpoint, i.e. a
+// cloud ingestion endpoint. The events are stored in a queue persisted in the database before being forwarded in
+// order, so that the events accepted while the endpoint is unreachable are forwarded once it is reachable again, even
+// after a restart. The events are posted to the endpoint as JSON AddEventRequests.
+type StoreAndForwardInfo struct {
+	Enabled bool
+	// Url is the endpoint the events are posted to
+	Url string
+	// SecretName is the name of the secret whose "token" is sent as the bearer token, no token is sent when empty
+	SecretName string
+	// MaxQueueSize is the number of events queued before the OverflowPolicy applies
+	MaxQueueSize int
+	// OverflowPolicy is the policy applied to the events accepted when the queue is full, drop-oldest or drop-newest
+	OverflowPolicy string
+	// MaxBatchSize is the number of queued events read from the database at once
+	MaxBatchSize int
+	// RetryInterval is the delay before forwarding again once the endpoint is unreachable, doubled on every failure up
+	// to MaxRetryInterval, i.e. 1s
+	RetryInterval string
+	// MaxRetryInterval is the maximum delay between the attempts to forward while the endpoint is unreachable, i.e. 1m
+	MaxRetryInterval string
+	// Timeout is the timeout of a request to the endpoint, i.e. 10s
+	Timeout string
+}
+
+// Overflow policies of the store-and-forward queue
+const (
+	// StoreAndForwardOverflowDropOldest removes the oldest queued events to queue the accepted event
+	StoreAndForwardOverflowDropOldest = "drop-oldest"
+	// StoreAndForwardOverflowDropNewest doesn't queue the accepted event
+	StoreAndForwardOverflowDropNewest = "drop-newest"
+)
+
+// LatenessInfo contains the settings of the handling of the events whose origin is outside the lateness window, i.e.
+// older than MaxLateness or ahead of the clock of core-data by more than MaxEarliness when they are ingested.
+type LatenessInfo struct {
+	Enabled bool
+	// MaxLateness is how far in the past the origin of an event can be, i.e. 1h
+	MaxLateness string
+	// MaxEarliness is how far in the future the origin of an event can be, i.e. 1m. Empty doesn't limit it
+	MaxEarliness string
+	// Action is the action taken on the events outside the window, reject, tag or route
+	Action string
+}
+
+// Actions taken on the events outside the lateness window
+const (
+	// LatenessActionReject rejects the event
+	LatenessActionReject = "reject"
+	// LatenessActionTag keeps the event and adds how late it is to its tags
+	LatenessActionTag = "tag"
+	// LatenessActionRoute publishes the event to the late data topic instead of persisting it
+	LatenessActionRoute = "route"
+)
+
+// EventEnrichmentInfo contains the settings of the enrichment of the accepted events with the metadata of their
+// device, added as event tags at ingestion so that the consumers of the events don't need to query core-metadata. The
+// devices are fetched from core-metadata and cached until core-metadata publishes a system event about them.
+type EventEnrichmentInfo struct {
+	Enabled bool
+	// Fields are the device fields added as tags, labels, location and/or profileName
+	Fields []string
+	// CacheTTL is how long a device fetched from core-metadata is cached when no system event is received, i.e. 5m
+	CacheTTL string
+}
+
+// Device fields the accepted events can be enriched with
+const (
+	// EventEnrichmentFieldLabels adds the labels of the device to the "deviceLabels" tag
+	EventEnrichmentFieldLabels = "labels"
+	// EventEnrichmentFieldLocation adds the location of the device to the "deviceLocation" tag
+	EventEnrichmentFieldLocation = "location"
+	// EventEnrichmentFieldProfileName adds the profile name of the device to the "deviceProfileName" tag
+	EventEnrichmentFieldProfileName = "profileName"
+)
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -73,6 +390,7 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 func (c *ConfigurationStruct) GetBootstrap() bootstrapConfig.BootstrapConfiguration {
 	// temporary until we can make backwards-breaking configuration.yaml change
 	return bootstrapConfig.BootstrapConfiguration{
+		Clients:    &c.Clients,
 		Service:    &c.Service,
 		Registry:   &c.Registry,
 		MessageBus: &c.MessageBus,
@@ -95,6 +413,16 @@ func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
 	return c.Database
 }
 
+// GetDatabasePoolInfo returns the configuration of the database connection pool.
+func (c *ConfigurationStruct) GetDatabasePoolInfo() db.PoolInfo {
+	return c.DatabasePool
+}
+
+// GetDatabaseReplicaInfo returns the location of the read-only replica of the database.
+func (c *ConfigurationStruct) GetDatabaseReplicaInfo() db.ReplicaInfo {
+	return c.DatabaseReplica
+}
+
 // GetInsecureSecrets returns the service's InsecureSecrets.
 func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
 	return c.Writable.InsecureSecrets
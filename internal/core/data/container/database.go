@@ -18,3 +18,16 @@ var DBClientInterfaceName = di.TypeInstanceToName((*interfaces.DBClient)(nil))
 func DBClientFrom(get di.Get) interfaces.DBClient {
 	return get(DBClientInterfaceName).(interfaces.DBClient)
 }
+
+// QueryDBClientInterfaceName contains the name of the interfaces.DBClient implementation of the read-only replica of
+// the database in the DIC.
+var QueryDBClientInterfaceName = DBClientInterfaceName + "Query"
+
+// QueryDBClientFrom helper function queries the DIC and returns the interfaces.DBClient implementation the pure queries
+// are sent to, the client of the read-only replica when it's configured and otherwise the one of the primary database.
+func QueryDBClientFrom(get di.Get) interfaces.DBClient {
+	if client, ok := get(QueryDBClientInterfaceName).(interfaces.DBClient); ok {
+		return client
+	}
+	return DBClientFrom(get)
+}
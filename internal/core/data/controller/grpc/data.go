@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/protoconv"
+	edgexpb "github.com/edgexfoundry/edgex-go/proto/edgex/v3"
+)
+
+// CoreDataServer implements the CoreData gRPC service with the application of core-data, like the EventController
+// of the REST API
+type CoreDataServer struct {
+	edgexpb.UnimplementedCoreDataServer
+	dic *di.Container
+	app *application.CoreDataApp
+}
+
+// NewCoreDataServer creates and initializes a CoreDataServer
+func NewCoreDataServer(dic *di.Container) *CoreDataServer {
+	return &CoreDataServer{
+		dic: dic,
+		app: application.CoreDataAppFrom(dic.Get),
+	}
+}
+
+func (s *CoreDataServer) AddEvent(ctx context.Context, req *edgexpb.AddEventRequest) (*edgexpb.AddEventResponse, error) {
+	if len(strings.TrimSpace(req.GetServiceName())) == 0 {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindContractInvalid, "service name sending event can not be empty", nil))
+	}
+	if req.GetEvent() == nil {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindContractInvalid, "event can not be empty", nil))
+	}
+	addEventReq := requests.NewAddEventRequest(protoconv.ToEventDTO(req.GetEvent()))
+	if err := addEventReq.Validate(); err != nil {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddEventRequest", err))
+	}
+
+	// the event is published as the AddEventRequest received by the REST API, so the subscribers can't tell them apart
+	data, err := json.Marshal(addEventReq)
+	if err != nil {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the AddEventRequest", err))
+	}
+	event := addEventReq.Event
+	go s.app.PublishEvent(data, req.GetServiceName(), event.ProfileName, event.DeviceName, event.SourceName, "", ctx, s.dic)
+
+	if err := s.app.AddEvent(requests.AddEventReqToEventModel(addEventReq), ctx, s.dic); err != nil {
+		return nil, protoconv.Status(err)
+	}
+	return &edgexpb.AddEventResponse{Id: event.Id}, nil
+}
+
+func (s *CoreDataServer) EventById(_ context.Context, req *edgexpb.EventByIdRequest) (*edgexpb.Event, error) {
+	if edgexErr := application.CheckCrossTenantQuery(s.dic); edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	e, edgexErr := s.app.EventById(req.GetId(), s.dic)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	event, err := protoconv.FromEventDTO(e)
+	if err != nil {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the event", err))
+	}
+	return event, nil
+}
+
+func (s *CoreDataServer) EventsByDeviceName(_ context.Context, req *edgexpb.EventsByDeviceNameRequest) (*edgexpb.MultiEventsResponse, error) {
+	if edgexErr := application.CheckCrossTenantQuery(s.dic); edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	config := dataContainer.ConfigurationFrom(s.dic.Get)
+	offset, limit, edgexErr := protoconv.Page(req.GetOffset(), req.GetLimit(), config.Service.MaxResultCount)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	events, totalCount, edgexErr := s.app.EventsByDeviceName(offset, limit, req.GetDeviceName(), s.dic)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	res := &edgexpb.MultiEventsResponse{TotalCount: totalCount, Events: make([]*edgexpb.Event, len(events))}
+	for i, e := range events {
+		event, err := protoconv.FromEventDTO(e)
+		if err != nil {
+			return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the events", err))
+		}
+		res.Events[i] = event
+	}
+	return res, nil
+}
+
+func (s *CoreDataServer) SubscribeEvents(req *edgexpb.SubscribeEventsRequest, stream edgexpb.CoreData_SubscribeEventsServer) error {
+	if edgexErr := application.CheckCrossTenantQuery(s.dic); edgexErr != nil {
+		return protoconv.Status(edgexErr)
+	}
+	events, stop := s.app.StreamEvents(req.GetDeviceName())
+	defer stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+			event, err := protoconv.FromEventDTO(e)
+			if err != nil {
+				return protoconv.Status(errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the event", err))
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
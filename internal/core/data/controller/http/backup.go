@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/backup"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// backupFileName is the name of the file the backup is downloaded as
+const backupFileName = "core-data-backup.json"
+
+type BackupController struct {
+	reader io.DtoReader
+	dic    *di.Container
+	app    *application.CoreDataApp
+}
+
+// NewBackupController creates and initializes an BackupController
+func NewBackupController(dic *di.Container) *BackupController {
+	return &BackupController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+		app:    application.CoreDataAppFrom(dic.Get),
+	}
+}
+
+func (bc *BackupController) Backup(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(bc.dic.Get)
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(bc.dic.Get)
+
+	b, err := bc.app.Backup(config.Service.MaxResultCount, bc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", backupFileName))
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(b, w, lc)
+}
+
+func (bc *BackupController) Restore(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(bc.dic.Get)
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(bc.dic.Get)
+
+	var b backup.Backup
+	if err := bc.reader.Read(r.Body, &b); err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to decode the backup", err), "")
+		return
+	}
+	content, err := bc.app.Restore(b, config.Service.MaxResultCount, ctx, bc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := backup.NewRestoreResponse("", "", http.StatusCreated, content.Restored())
+	utils.WriteHttpHeader(w, ctx, http.StatusCreated)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
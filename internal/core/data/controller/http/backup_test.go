@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/backup"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// buildTestBackupEvents returns count events with distinct ids, sorted by descending origin
+func buildTestBackupEvents(count int) []models.Event {
+	events := make([]models.Event, count)
+	for i := range events {
+		events[i] = persistedEvent
+		events[i].Id = uuid.NewString()
+		events[i].SourceName = TestDeviceResourceName
+		events[i].Origin = persistedEvent.Origin - int64(i)
+	}
+	return events
+}
+
+func newTestBackupController(dbClientMock *dbMock.DBClient) *BackupController {
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	return NewBackupController(dic)
+}
+
+func TestBackup(t *testing.T) {
+	// the configured MaxResultCount is 20, the 25 events are backed up in two pages
+	events := buildTestBackupEvents(25)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllEvents", 0, 20).Return(events[:20], nil)
+	dbClientMock.On("AllEventsAfter", utils.Cursor{Score: events[19].Origin, Id: events[19].Id}, 20).Return(events[20:], nil)
+	controller := newTestBackupController(dbClientMock)
+
+	req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiBackupRoute, http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.Backup)
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Contains(t, recorder.Header().Get("Content-Disposition"), "attachment")
+	var b backup.Backup
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &b))
+	var content dataDTOs.BackupContent
+	require.NoError(t, b.Open(common.CoreDataServiceKey, &content))
+	require.Len(t, content.Events, 25)
+	assert.Equal(t, events[0].Id, content.Events[0].Id)
+	assert.Equal(t, events[24].Id, content.Events[24].Id)
+}
+
+func TestRestore(t *testing.T) {
+	events := buildTestBackupEvents(25)
+	content := dataDTOs.BackupContent{}
+	for _, e := range events {
+		content.Events = append(content.Events, dtos.FromEventModelToDTO(e))
+	}
+	valid, err := backup.New(common.CoreDataServiceKey, content)
+	require.NoError(t, err)
+	otherService, err := backup.New(common.CoreMetaDataServiceKey, content)
+	require.NoError(t, err)
+	altered := valid
+	altered.Checksum = "altered"
+	invalidEvent := content
+	invalidEvent.Events = append(invalidEvent.Events[:1:1], content.Events[1:]...)
+	invalidEvent.Events[0].SourceName = ""
+	invalid, err := backup.New(common.CoreDataServiceKey, invalidEvent)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		backup             backup.Backup
+		failingBatch       bool
+		expectedStatusCode int
+	}{
+		{"valid", valid, false, http.StatusCreated},
+		{"invalid, backup of another service", otherService, false, http.StatusBadRequest},
+		{"invalid, checksum mismatch", altered, false, http.StatusBadRequest},
+		{"invalid, invalid event", invalid, false, http.StatusBadRequest},
+		{"rolled back, event exists", valid, true, http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			// the configured MaxResultCount is 20, the 25 events are added in two batches
+			if testCase.failingBatch {
+				dbClientMock.On("AddEvents", mock.MatchedBy(func(batch []models.Event) bool { return len(batch) == 5 })).
+					Return(nil, errors.NewCommonEdgeX(errors.KindDuplicateName, "event exists", nil))
+			}
+			dbClientMock.On("AddEvents", mock.Anything).Return(nil, nil)
+			dbClientMock.On("DeleteEventById", mock.Anything).Return(nil)
+			controller := newTestBackupController(dbClientMock)
+
+			body, err := json.Marshal(testCase.backup)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiBackupRoute, bytes.NewReader(body))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.Restore)
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode)
+			switch {
+			case testCase.expectedStatusCode == http.StatusCreated:
+				var res backup.RestoreResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				assert.Equal(t, 25, res.Restored["events"])
+				dbClientMock.AssertNumberOfCalls(t, "AddEvents", 2)
+				dbClientMock.AssertNotCalled(t, "DeleteEventById", mock.Anything)
+			case testCase.failingBatch:
+				dbClientMock.AssertNumberOfCalls(t, "DeleteEventById", 20)
+			default:
+				dbClientMock.AssertNotCalled(t, "AddEvents", mock.Anything)
+			}
+		})
+	}
+}
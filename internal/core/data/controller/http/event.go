@@ -9,28 +9,40 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
 	edgexIO "github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// defaultEventCountTimeRange is the time range the events are counted over when the start is not specified
+	defaultEventCountTimeRange = time.Hour
+	defaultEventCountInterval  = "1m"
 )
 
 type EventController struct {
-	readers map[string]edgexIO.DtoReader
-	mux     sync.RWMutex
-	dic     *di.Container
-	app     *application.CoreDataApp
+	readers  map[string]edgexIO.DtoReader
+	mux      sync.RWMutex
+	upgrader websocket.Upgrader
+	dic      *di.Container
+	app      *application.CoreDataApp
 }
 
 // NewEventController creates and initializes an EventController
@@ -76,6 +88,8 @@ func (ec *EventController) AddEvent(w http.ResponseWriter, r *http.Request) {
 	profileName := vars[common.ProfileName]
 	deviceName := vars[common.DeviceName]
 	sourceName := vars[common.SourceName]
+	// the tenant is only set by the tenant route
+	tenant := vars[pkgCommon.Tenant]
 
 	var addEventReqDTO requestDTO.AddEventRequest
 	var err errors.EdgeX
@@ -92,7 +106,13 @@ func (ec *EventController) AddEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	dataBytes, readErr := io.ReadAll(r.Body)
+	// the body of unknown length is read one byte past MaxEventSize to detect the events exceeding it without reading
+	// them entirely
+	var body io.Reader = r.Body
+	if config.MaxEventSize > 0 && r.ContentLength == -1 {
+		body = io.LimitReader(r.Body, config.MaxEventSize*1024+1)
+	}
+	dataBytes, readErr := io.ReadAll(body)
 	if readErr != nil {
 		err = errors.NewCommonEdgeX(errors.KindIOError, "AddEventRequest I/O reading failed", nil)
 	} else if r.ContentLength == -1 { // only check the payload byte array size when the Content-Length of Request is unknown
@@ -102,7 +122,7 @@ func (ec *EventController) AddEvent(w http.ResponseWriter, r *http.Request) {
 	if err == nil {
 		// Per https://github.com/edgexfoundry/edgex-go/pull/3202#discussion_r587618347
 		// it is decided to asynchronously publish initially encoded payload (not re-encoding) to message bus
-		go ec.app.PublishEvent(dataBytes, serviceName, profileName, deviceName, sourceName, ctx, ec.dic)
+		go ec.app.PublishEvent(dataBytes, serviceName, profileName, deviceName, sourceName, tenant, ctx, ec.dic)
 		// unmarshal bytes to AddEventRequest
 		reader := ec.getReader(r)
 		err = reader.Read(bytes.NewReader(dataBytes), &addEventReqDTO)
@@ -112,7 +132,7 @@ func (ec *EventController) AddEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	event := requestDTO.AddEventReqToEventModel(addEventReqDTO)
+	event := application.WithTenant(requestDTO.AddEventReqToEventModel(addEventReqDTO), tenant)
 	err = ec.app.ValidateEvent(event, profileName, deviceName, sourceName, ctx, ec.dic)
 	if err == nil {
 		err = ec.app.AddEvent(event, ctx, ec.dic)
@@ -191,6 +211,41 @@ func (ec *EventController) EventTotalCount(w http.ResponseWriter, r *http.Reques
 	pkg.EncodeAndWriteResponse(response, w, lc) // encode and send out the response
 }
 
+// EventCountsByTimeInterval counts the events of each device per interval between the start and end query
+// parameters, which default to the last hour, for charting the ingestion rates
+func (ec *EventController) EventCountsByTimeInterval(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+
+	now := time.Now().UnixNano()
+	end, err := utils.ParseQueryStringToInt(r, common.End, int(now), 0, math.MaxInt)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	start, err := utils.ParseQueryStringToInt(r, common.Start, end-int(defaultEventCountTimeRange), 0, math.MaxInt)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	intervalValue := utils.ParseQueryStringToString(r, common.Interval, defaultEventCountInterval)
+	interval, parseErr := time.ParseDuration(intervalValue)
+	if parseErr != nil {
+		utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid interval '%s'", intervalValue), parseErr), "")
+		return
+	}
+
+	intervals, devices, err := ec.app.EventCountsByTimeInterval(int64(start), int64(end), interval, ec.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := dataDTOs.NewEventCountIntervalResponse("", "", http.StatusOK, interval.String(), intervals, devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (ec *EventController) EventCountByDeviceName(w http.ResponseWriter, r *http.Request) {
 	// retrieve all the service injections from bootstrap
 	lc := container.LoggingClientFrom(ec.dic.Get)
@@ -223,12 +278,25 @@ func (ec *EventController) AllEvents(w http.ResponseWriter, r *http.Request) {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
-	events, totalCount, err := ec.app.AllEvents(offset, limit, ec.dic)
+	// the cursor of the previous page replaces the offset
+	cursor, hasCursor, err := utils.ParseCursorQueryString(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	var events []dtos.Event
+	var totalCount uint32
+	if hasCursor {
+		events, totalCount, err = ec.app.AllEventsAfter(cursor, limit, ec.dic)
+	} else {
+		events, totalCount, err = ec.app.AllEvents(offset, limit, ec.dic)
+	}
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
 	response := responseDTO.NewMultiEventsResponse("", "", http.StatusOK, totalCount, events)
+	writeEventsNextCursorHeader(w, events, limit)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
@@ -247,13 +315,26 @@ func (ec *EventController) EventsByDeviceName(w http.ResponseWriter, r *http.Req
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
-	events, totalCount, err := ec.app.EventsByDeviceName(offset, limit, name, ec.dic)
+	// the cursor of the previous page replaces the offset
+	cursor, hasCursor, err := utils.ParseCursorQueryString(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	var events []dtos.Event
+	var totalCount uint32
+	if hasCursor {
+		events, totalCount, err = ec.app.EventsByDeviceNameAfter(cursor, limit, name, ec.dic)
+	} else {
+		events, totalCount, err = ec.app.EventsByDeviceName(offset, limit, name, ec.dic)
+	}
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
 
 	response := responseDTO.NewMultiEventsResponse("", "", http.StatusOK, totalCount, events)
+	writeEventsNextCursorHeader(w, events, limit)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
@@ -301,6 +382,61 @@ func (ec *EventController) EventsByTimeRange(w http.ResponseWriter, r *http.Requ
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// ExportEventsByTimeRange streams all the events within the time range, as JSON lines or, when requested with the
+// Accept header, as server-sent events. Errors occurring after the first events were sent are sent as the last line or
+// event, containing the error response.
+func (ec *EventController) ExportEventsByTimeRange(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	start, err := utils.ParsePathParamToInt(r, common.Start)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	end, err := utils.ParsePathParamToInt(r, common.End)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	if end < start {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be greater than start's value %v", end, start), nil)
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	serverSentEvents := strings.Contains(r.Header.Get("Accept"), pkgCommon.ContentTypeEventStream)
+	writer := newEventStreamWriter(w, ctx, serverSentEvents)
+	err = ec.app.ExportEventsByTimeRange(start, end, config.Service.MaxResultCount, func(events []dtos.Event) error {
+		// stop exporting when the client is gone
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		for _, event := range events {
+			if err := writer.write("", event); err != nil {
+				return err
+			}
+		}
+		writer.flush()
+		return nil
+	}, ec.dic)
+	if err != nil {
+		if !writer.started {
+			utils.WriteErrorResponse(w, ctx, lc, err, "")
+			return
+		}
+		lc.Errorf("Failed to export events by time range %v ~ %v: %s", start, end, err.Error())
+		if ctx.Err() == nil {
+			_ = writer.write("error", commonDTO.NewBaseResponse("", err.Message(), err.Code()))
+			writer.flush()
+		}
+		return
+	}
+	// the response is empty when there are no events within the time range
+	writer.flush()
+}
+
 func (ec *EventController) DeleteEventsByAge(w http.ResponseWriter, r *http.Request) {
 	// retrieve all the service injections from bootstrap
 	lc := container.LoggingClientFrom(ec.dic.Get)
@@ -325,3 +461,12 @@ func (ec *EventController) DeleteEventsByAge(w http.ResponseWriter, r *http.Requ
 	// encode and send out the response
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// writeEventsNextCursorHeader sets the cursor of the page following the events returned with the limit
+func writeEventsNextCursorHeader(w http.ResponseWriter, events []dtos.Event, limit int) {
+	if len(events) == 0 {
+		return
+	}
+	last := events[len(events)-1]
+	utils.WriteNextCursorHeader(w, len(events), limit, utils.Cursor{Score: last.Origin, Id: last.Id})
+}
@@ -12,6 +12,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/google/uuid"
@@ -31,8 +32,12 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
 var expectedEventId = uuid.New().String()
@@ -516,6 +521,61 @@ func TestEventTotalCount(t *testing.T) {
 	assert.Equal(t, expectedEventCount, actualResponse.Count, "Event count in the response body is not expected")
 }
 
+func TestEventCountsByTimeInterval(t *testing.T) {
+	minute := int64(time.Minute)
+	counts := []dataModels.DeviceEventCounts{{DeviceName: TestDeviceName, Counts: []uint32{3, 0, 5}}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventCountsByTimeInterval", int64(0), 3*minute, minute).Return(counts, nil)
+	dbClientMock.On("EventCountsByTimeInterval", int64(0), 3*minute, 2*minute).Return(nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "unexpected error", nil))
+
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	ec := NewEventController(dic)
+
+	tests := []struct {
+		name               string
+		query              string
+		expectedStatusCode int
+	}{
+		{"Valid", fmt.Sprintf("start=0&end=%d&interval=1m", 3*minute), http.StatusOK},
+		{"Invalid - end before start", fmt.Sprintf("start=%d&end=0", 3*minute), http.StatusBadRequest},
+		{"Invalid - unparsable interval", fmt.Sprintf("start=0&end=%d&interval=1x", 3*minute), http.StatusBadRequest},
+		{"Invalid - non-positive interval", fmt.Sprintf("start=0&end=%d&interval=0s", 3*minute), http.StatusBadRequest},
+		{"Invalid - too many intervals", fmt.Sprintf("start=0&end=%d&interval=1ns", 3*minute), http.StatusBadRequest},
+		{"Invalid - negative start", "start=-1", http.StatusBadRequest},
+		{"Unknown Error", fmt.Sprintf("start=0&end=%d&interval=2m", 3*minute), http.StatusInternalServerError},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiEventCountIntervalRoute+"?"+testCase.query, http.NoBody)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(ec.EventCountsByTimeInterval)
+			handler.ServeHTTP(recorder, req)
+
+			require.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var actualResponse dataDTOs.EventCountIntervalResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+			require.NoError(t, err)
+			assert.Equal(t, "1m0s", actualResponse.Interval)
+			assert.Equal(t, []int64{0, minute, 2 * minute}, actualResponse.Intervals)
+			assert.Equal(t, []dataDTOs.DeviceEventCounts{{DeviceName: TestDeviceName, Counts: []uint32{3, 0, 5}}}, actualResponse.Devices)
+		})
+	}
+}
+
 func TestEventCountByDeviceName(t *testing.T) {
 	expectedEventCount := uint32(656672)
 	deviceName := "deviceA"
@@ -598,6 +658,8 @@ func TestAllEvents(t *testing.T) {
 	dbClientMock.On("AllEvents", 0, 20).Return(events, nil)
 	dbClientMock.On("AllEvents", 1, 1).Return([]models.Event{events[1]}, nil)
 	dbClientMock.On("AllEvents", 4, 1).Return([]models.Event{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "query objects bounds out of range.", nil))
+	cursor := utils.Cursor{Score: persistedEvent.Origin, Id: persistedEvent.Id}
+	dbClientMock.On("AllEventsAfter", cursor, 1).Return([]models.Event{events[2]}, nil)
 	app := application.NewCoreDataApp(dic)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
@@ -614,14 +676,18 @@ func TestAllEvents(t *testing.T) {
 		name               string
 		offset             string
 		limit              string
+		cursor             string
 		errorExpected      bool
 		expectedCount      int
 		expectedTotalCount uint32
 		expectedStatusCode int
+		expectedNextCursor string
 	}{
-		{"Valid - get events without offset and limit", "", "", false, 3, totalCount, http.StatusOK},
-		{"Valid - get events with offset and limit", "1", "1", false, 1, totalCount, http.StatusOK},
-		{"Invalid - offset out of range", "4", "1", true, 0, 0, http.StatusNotFound},
+		{"Valid - get events without offset and limit", "", "", "", false, 3, totalCount, http.StatusOK, ""},
+		{"Valid - get events with offset and limit", "1", "1", "", false, 1, totalCount, http.StatusOK, cursor.Token()},
+		{"Valid - get events after cursor", "", "1", cursor.Token(), false, 1, totalCount, http.StatusOK, cursor.Token()},
+		{"Invalid - offset out of range", "4", "1", "", true, 0, 0, http.StatusNotFound, ""},
+		{"Invalid - invalid cursor", "", "1", "invalid", true, 0, 0, http.StatusBadRequest, ""},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -633,6 +699,9 @@ func TestAllEvents(t *testing.T) {
 			if testCase.limit != "" {
 				query.Add(common.Limit, testCase.limit)
 			}
+			if testCase.cursor != "" {
+				query.Add(pkgCommon.Cursor, testCase.cursor)
+			}
 			req.URL.RawQuery = query.Encode()
 			require.NoError(t, err)
 
@@ -659,6 +728,7 @@ func TestAllEvents(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
 				assert.Equal(t, testCase.expectedCount, len(res.Events), "Event count not as expected")
 				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+				assert.Equal(t, testCase.expectedNextCursor, recorder.Header().Get(pkgCommon.NextCursorHeader), "Next cursor not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
 			}
 		})
@@ -831,6 +901,114 @@ func TestAllEventsByTimeRange(t *testing.T) {
 	}
 }
 
+func TestExportEventsByTimeRange(t *testing.T) {
+	// events returns the count events whose origin decreases from origin, as sorted by the database
+	events := func(origin int64, count int) []models.Event {
+		result := make([]models.Event, count)
+		for i := range result {
+			result[i] = persistedEvent
+			result[i].Id = fmt.Sprintf("event-%d", origin-int64(i))
+			result[i].Origin = origin - int64(i)
+		}
+		return result
+	}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllEventsAfter", utils.Cursor{Score: 100}, 20).Return(events(100, 20), nil)
+	dbClientMock.On("AllEventsAfter", utils.Cursor{Score: 81, Id: "event-81"}, 20).Return(events(80, 1), nil)
+	dbClientMock.On("AllEventsAfter", utils.Cursor{Score: 10}, 20).Return([]models.Event{}, nil)
+	dbClientMock.On("AllEventsAfter", utils.Cursor{Score: 20}, 20).Return(nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil))
+	dbClientMock.On("AllEventsAfter", utils.Cursor{Score: 50}, 20).Return(events(50, 20), nil)
+	dbClientMock.On("AllEventsAfter", utils.Cursor{Score: 31, Id: "event-31"}, 20).Return(nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil))
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	ec := NewEventController(dic)
+	assert.NotNil(t, ec)
+
+	tests := []struct {
+		name                string
+		start               string
+		end                 string
+		accept              string
+		expectedStatusCode  int
+		expectedContentType string
+		expectedCount       int
+		expectedError       bool
+	}{
+		{"Valid - JSON lines", "0", "100", "", http.StatusOK, pkgCommon.ContentTypeNDJSON, 21, false},
+		{"Valid - server-sent events", "0", "100", pkgCommon.ContentTypeEventStream, http.StatusOK, pkgCommon.ContentTypeEventStream, 21, false},
+		{"Valid - events before start excluded", "95", "100", "", http.StatusOK, pkgCommon.ContentTypeNDJSON, 6, false},
+		{"Valid - no events", "0", "10", "", http.StatusOK, pkgCommon.ContentTypeNDJSON, 0, false},
+		{"Valid - error after first page", "0", "50", "", http.StatusOK, pkgCommon.ContentTypeNDJSON, 20, true},
+		{"Valid - server-sent error after first page", "0", "50", pkgCommon.ContentTypeEventStream, http.StatusOK, pkgCommon.ContentTypeEventStream, 20, true},
+		{"Invalid - error on first page", "0", "20", "", http.StatusInternalServerError, common.ContentTypeJSON, 0, false},
+		{"Invalid - invalid start format", "aaa", "100", "", http.StatusBadRequest, common.ContentTypeJSON, 0, false},
+		{"Invalid - end before start", "10", "0", "", http.StatusBadRequest, common.ContentTypeJSON, 0, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiEventExportByTimeRangeRoute, http.NoBody)
+			require.NoError(t, err)
+			if testCase.accept != "" {
+				req.Header.Set("Accept", testCase.accept)
+			}
+			req = mux.SetURLVars(req, map[string]string{common.Start: testCase.start, common.End: testCase.end})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(ec.ExportEventsByTimeRange)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedContentType, recorder.Header().Get(common.ContentType), "Content type not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+
+			var events []dtos.Event
+			var errorResponse *commonDTO.BaseResponse
+			for _, line := range strings.Split(recorder.Body.String(), "\n") {
+				if testCase.accept == pkgCommon.ContentTypeEventStream {
+					if !strings.HasPrefix(line, "data: ") {
+						continue
+					}
+					line = strings.TrimPrefix(line, "data: ")
+				}
+				if line == "" {
+					continue
+				}
+				var event dtos.Event
+				require.NoError(t, json.Unmarshal([]byte(line), &event))
+				if event.Id == "" {
+					errorResponse = &commonDTO.BaseResponse{}
+					require.NoError(t, json.Unmarshal([]byte(line), errorResponse))
+					continue
+				}
+				events = append(events, event)
+			}
+			assert.Equal(t, testCase.expectedCount, len(events), "Event count not as expected")
+			if testCase.expectedError {
+				require.NotNil(t, errorResponse, "Error response not streamed")
+				assert.Equal(t, http.StatusInternalServerError, errorResponse.StatusCode)
+			} else {
+				assert.Nil(t, errorResponse, "Unexpected error response")
+			}
+		})
+	}
+}
+
 func TestDeleteEventsByAge(t *testing.T) {
 	dic := mocks.NewMockDIC()
 	dbClientMock := &dbMock.DBClient{}
@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/gorilla/mux"
+
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+const (
+	// defaultLineageMaxDepth is the number of generations of the event lineage traversed when maxDepth is not specified
+	defaultLineageMaxDepth = 10
+	maxLineageMaxDepth     = 100
+)
+
+func (ec *EventController) EventsByParentId(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	events, totalCount, err := ec.app.EventsByParentId(offset, limit, id, ec.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	response := responseDTO.NewMultiEventsResponse("", "", http.StatusOK, totalCount, events)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (ec *EventController) EventLineageById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	maxDepth, err := utils.ParseQueryStringToInt(r, pkgCommon.MaxDepth, defaultLineageMaxDepth, 1, maxLineageMaxDepth)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	event, ancestors, descendants, err := ec.app.EventLineage(id, maxDepth, ec.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	response := dataDTOs.NewEventLineageResponse("", "", http.StatusOK, event, ancestors, descendants)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
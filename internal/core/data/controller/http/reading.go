@@ -12,12 +12,15 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
@@ -66,13 +69,26 @@ func (rc *ReadingController) AllReadings(w http.ResponseWriter, r *http.Request)
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
-	readings, totalCount, err := application.AllReadings(offset, limit, rc.dic)
+	// the cursor of the previous page replaces the offset
+	cursor, hasCursor, err := utils.ParseCursorQueryString(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if hasCursor {
+		readings, totalCount, err = application.AllReadingsAfter(cursor, limit, rc.dic)
+	} else {
+		readings, totalCount, err = application.AllReadings(offset, limit, rc.dic)
+	}
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
 
 	response := responseDTO.NewMultiReadingsResponse("", "", http.StatusOK, totalCount, readings)
+	writeReadingsNextCursorHeader(w, readings, limit)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
@@ -138,13 +154,26 @@ func (rc *ReadingController) ReadingsByDeviceName(w http.ResponseWriter, r *http
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
-	readings, totalCount, err := application.ReadingsByDeviceName(offset, limit, name, rc.dic)
+	// the cursor of the previous page replaces the offset
+	cursor, hasCursor, err := utils.ParseCursorQueryString(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	var readings []dtos.BaseReading
+	var totalCount uint32
+	if hasCursor {
+		readings, totalCount, err = application.ReadingsByDeviceNameAfter(cursor, limit, name, rc.dic)
+	} else {
+		readings, totalCount, err = application.ReadingsByDeviceName(offset, limit, name, rc.dic)
+	}
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
 
 	response := responseDTO.NewMultiReadingsResponse("", "", http.StatusOK, totalCount, readings)
+	writeReadingsNextCursorHeader(w, readings, limit)
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
@@ -300,3 +329,105 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceNamesAndTimeRange(w
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+func (rc *ReadingController) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(rc.dic.Get)
+
+	vars := mux.Vars(r)
+	deviceName := vars[common.Name]
+	resourceName := vars[common.ResourceName]
+
+	// parse time range (start, end), offset, and limit from incoming request
+	start, end, offset, limit, err := utils.ParseTimeRangeOffsetLimit(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	aggregates, totalCount, err := application.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, offset, limit, rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := dataDTOs.NewMultiReadingAggregatesResponse("", "", http.StatusOK, totalCount, aggregates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (rc *ReadingController) ReadingStats(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	total, devices, err := application.ReadingStats(rc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := dataDTOs.NewReadingStatsResponse("", "", http.StatusOK, total, devices)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ExportReadingsToParquet starts the export of the readings within the time range to Parquet files partitioned by
+// device and day in the background, and responds with the id of the job used to poll its state
+func (rc *ReadingController) ExportReadingsToParquet(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	start, err := utils.ParsePathParamToInt(r, common.Start)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	end, err := utils.ParsePathParamToInt(r, common.End)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	if end < start {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be greater than start's value %v", end, start), nil)
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	jobId, err := application.ParquetExportManagerFrom(rc.dic.Get).Export(start, end)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseWithIdResponse("", "", http.StatusAccepted, jobId)
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ParquetExportJobById returns the state of the Parquet export job, with the number of readings exported and the URIs
+// of the files written once completed
+func (rc *ReadingController) ParquetExportJobById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	jobId := mux.Vars(r)[pkgCommon.JobId]
+	job, err := application.ParquetExportManagerFrom(rc.dic.Get).Job(jobId)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := dataDTOs.NewParquetExportJobResponse("", "", http.StatusOK, job)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// writeReadingsNextCursorHeader sets the cursor of the page following the readings returned with the limit
+func writeReadingsNextCursorHeader(w http.ResponseWriter, readings []dtos.BaseReading, limit int) {
+	if len(readings) == 0 {
+		return
+	}
+	last := readings[len(readings)-1]
+	utils.WriteNextCursorHeader(w, len(readings), limit, utils.Cursor{Score: last.Origin, Id: last.Id})
+}
@@ -7,15 +7,22 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
@@ -682,3 +689,266 @@ func TestReadingsByDeviceNameAndResourceNamesAndTimeRange(t *testing.T) {
 		})
 	}
 }
+
+func TestReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(t *testing.T) {
+	totalCount := uint32(2)
+	aggregates := []dataModels.ReadingAggregate{
+		{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Start: 60, End: 120, Count: 4, Min: 1, Max: 4, Sum: 10},
+		{DeviceName: TestDeviceName, ResourceName: TestDeviceResourceName, Start: 0, End: 60, Count: 0},
+	}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingAggregateCountByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, 0, 100).Return(totalCount, nil)
+	dbClientMock.On("ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, 0, 100, 0, 10).Return(aggregates, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		resourceName       string
+		start              string
+		end                string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, TestDeviceResourceName, "0", "100", false, http.StatusOK},
+		{"Invalid - empty deviceName", "", TestDeviceResourceName, "0", "100", true, http.StatusBadRequest},
+		{"Invalid - empty resourceName", TestDeviceName, "", "0", "100", true, http.StatusBadRequest},
+		{"Invalid - invalid start format", TestDeviceName, TestDeviceResourceName, "aaa", "100", true, http.StatusBadRequest},
+		{"Invalid - end before start", TestDeviceName, TestDeviceResourceName, "10", "0", true, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, "0")
+			query.Add(common.Limit, "10")
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceName, common.ResourceName: testCase.resourceName, common.Start: testCase.start, common.End: testCase.end})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res dataDTOs.MultiReadingAggregatesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, totalCount, res.TotalCount, "Total count not as expected")
+				require.Len(t, res.Aggregates, 2)
+				assert.Equal(t, 2.5, res.Aggregates[0].Avg, "Average not as expected")
+				assert.Equal(t, float64(0), res.Aggregates[1].Avg, "Average of an empty window not as expected")
+			}
+		})
+	}
+}
+
+func TestReadingStats(t *testing.T) {
+	devices := []dataModels.DeviceReadingStats{
+		{
+			DeviceName:   "camera",
+			ReadingStats: dataModels.ReadingStats{Count: 10, FirstOrigin: 100, LastOrigin: 900, EstimatedSize: 5000},
+			Resources: []dataModels.ResourceReadingStats{
+				{ResourceName: "image", ReadingStats: dataModels.ReadingStats{Count: 10, FirstOrigin: 100, LastOrigin: 900, EstimatedSize: 5000}},
+			},
+		},
+		{
+			DeviceName:   TestDeviceName,
+			ReadingStats: dataModels.ReadingStats{Count: 30, FirstOrigin: 50, LastOrigin: 800, EstimatedSize: 3000},
+			Resources: []dataModels.ResourceReadingStats{
+				{ResourceName: TestDeviceResourceName, ReadingStats: dataModels.ReadingStats{Count: 20, FirstOrigin: 50, LastOrigin: 800, EstimatedSize: 2000}},
+				{ResourceName: "humidity", ReadingStats: dataModels.ReadingStats{Count: 10, FirstOrigin: 60, LastOrigin: 700, EstimatedSize: 1000}},
+			},
+		},
+	}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingStats").Return(devices, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiReadingStatsRoute, http.NoBody)
+	require.NoError(t, err)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(rc.ReadingStats)
+	handler.ServeHTTP(recorder, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	var res dataDTOs.ReadingStatsResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	assert.Equal(t, dataDTOs.ReadingStats{Count: 40, FirstOrigin: 50, LastOrigin: 900, EstimatedSize: 8000}, res.Total)
+	require.Len(t, res.Devices, 2)
+	assert.Equal(t, "camera", res.Devices[0].DeviceName)
+	assert.Equal(t, uint64(30), res.Devices[1].Count)
+	require.Len(t, res.Devices[1].Resources, 2)
+	assert.Equal(t, "humidity", res.Devices[1].Resources[1].ResourceName)
+}
+
+func TestExportReadingsToParquet(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllReadingsAfter", utils.Cursor{Score: 100}, 20).Return([]models.Reading{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	directory := t.TempDir()
+
+	tests := []struct {
+		name               string
+		start              string
+		end                string
+		enabled            bool
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - with proper start/end", "0", "100", true, false, http.StatusAccepted},
+		{"Invalid - invalid start format", "aaa", "100", true, true, http.StatusBadRequest},
+		{"Invalid - invalid end format", "0", "bbb", true, true, http.StatusBadRequest},
+		{"Invalid - end before start", "10", "0", true, true, http.StatusBadRequest},
+		{"Invalid - export disabled", "0", "100", false, true, http.StatusMethodNotAllowed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			container.ConfigurationFrom(dic.Get).ParquetExport = config.ParquetExportInfo{
+				Enabled:   testCase.enabled,
+				Target:    config.BinaryOffloadTargetFilesystem,
+				Directory: directory,
+			}
+			var manager *application.ParquetExportManager
+			if testCase.enabled {
+				manager = application.NewParquetExportManager(dic)
+			}
+			dic.Update(di.ServiceConstructorMap{
+				application.ParquetExportManagerName: func(get di.Get) interface{} {
+					return manager
+				},
+			})
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiReadingParquetExportByTimeRangeRoute, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{common.Start: testCase.start, common.End: testCase.end})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.ExportReadingsToParquet)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res commonDTO.BaseWithIdResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Id, "Response doesn't contain the id of the job")
+				require.Eventually(t, func() bool {
+					job, err := manager.Job(res.Id)
+					return err == nil && job.Status == dataDTOs.ParquetExportJobStatusCompleted
+				}, time.Second, 10*time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestParquetExportJobById(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllReadingsAfter", utils.Cursor{Score: 100}, 20).Return([]models.Reading{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	container.ConfigurationFrom(dic.Get).ParquetExport = config.ParquetExportInfo{
+		Enabled:   true,
+		Target:    config.BinaryOffloadTargetFilesystem,
+		Directory: t.TempDir(),
+	}
+	manager := application.NewParquetExportManager(dic)
+	dic.Update(di.ServiceConstructorMap{
+		application.ParquetExportManagerName: func(get di.Get) interface{} {
+			return manager
+		},
+	})
+	jobId, err := manager.Export(0, 100)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		job, err := manager.Job(jobId)
+		return err == nil && job.Status != dataDTOs.ParquetExportJobStatusRunning
+	}, time.Second, 10*time.Millisecond)
+	rc := NewReadingController(dic)
+
+	tests := []struct {
+		name               string
+		jobId              string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - completed job", jobId, false, http.StatusOK},
+		{"Invalid - unknown job", "unknown", true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiReadingParquetExportJobByIdRoute, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{pkgCommon.JobId: testCase.jobId})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.ParquetExportJobById)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res dataDTOs.ParquetExportJobResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.jobId, res.Job.Id, "Job id not as expected")
+				assert.Equal(t, dataDTOs.ParquetExportJobStatusCompleted, res.Job.Status, "Job status not as expected")
+				assert.Equal(t, 0, res.Job.ReadingCount, "Reading count not as expected")
+				assert.Empty(t, res.Job.Files, "Files should be empty when no readings are exported")
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/gorilla/websocket"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// eventStreamKeepAliveInterval is the interval of the keepalive messages sent to the idle clients of the event stream
+const eventStreamKeepAliveInterval = 30 * time.Second
+
+// eventStreamWriter writes JSON values to a streamed response, either as JSON lines or as server-sent events. The
+// response header is written with the first value, so errors occurring before can still be sent as error response.
+type eventStreamWriter struct {
+	w                http.ResponseWriter
+	ctx              context.Context
+	serverSentEvents bool
+	started          bool
+}
+
+func newEventStreamWriter(w http.ResponseWriter, ctx context.Context, serverSentEvents bool) *eventStreamWriter {
+	return &eventStreamWriter{w: w, ctx: ctx, serverSentEvents: serverSentEvents}
+}
+
+func (s *eventStreamWriter) start() {
+	contentType := pkgCommon.ContentTypeNDJSON
+	if s.serverSentEvents {
+		contentType = pkgCommon.ContentTypeEventStream
+	}
+	s.w.Header().Set(common.CorrelationHeader, correlation.FromContext(s.ctx))
+	s.w.Header().Set(common.ContentType, contentType)
+	s.w.WriteHeader(http.StatusOK)
+	s.started = true
+}
+
+// write writes the JSON encoded value, eventType is the type of the server-sent event and is ignored for JSON lines.
+// An empty eventType is the default "message" event type.
+func (s *eventStreamWriter) write(eventType string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if !s.started {
+		s.start()
+	}
+
+	if !s.serverSentEvents {
+		_, err = fmt.Fprintf(s.w, "%s\n", data)
+		return err
+	}
+	if eventType != "" {
+		if _, err = fmt.Fprintf(s.w, "event: %s\n", eventType); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(s.w, "data: %s\n\n", data)
+	return err
+}
+
+// flush sends the buffered data to the client, the response header is written if no value was written yet
+func (s *eventStreamWriter) flush() {
+	if !s.started {
+		s.start()
+	}
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// keepAlive writes a server-sent events comment, which the clients ignore, so that the idle stream isn't closed by
+// the proxies
+func (s *eventStreamWriter) keepAlive() error {
+	if !s.started {
+		s.start()
+	}
+	_, err := fmt.Fprint(s.w, ": keepalive\n\n")
+	return err
+}
+
+// StreamEvents pushes the events added from now on, filtered by the deviceName and resourceName query parameters, as
+// JSON messages when the connection is upgraded to WebSocket or else as server-sent events, until the client goes
+// away.
+func (ec *EventController) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	filter := application.EventStreamFilter{
+		DeviceNames:   utils.ParseQueryStringToStrings(r, common.DeviceName, common.CommaSeparator),
+		ResourceNames: utils.ParseQueryStringToStrings(r, common.ResourceName, common.CommaSeparator),
+	}
+	// listening to a single device spares loading the binary values of the events of the other devices
+	deviceName := ""
+	if len(filter.DeviceNames) == 1 {
+		deviceName = filter.DeviceNames[0]
+	}
+	events, stop := ec.app.StreamEvents(deviceName)
+	defer stop()
+
+	if websocket.IsWebSocketUpgrade(r) {
+		ec.streamEventsOverWebSocket(w, r, events, filter)
+		return
+	}
+	ec.streamServerSentEvents(w, r, events, filter)
+}
+
+func (ec *EventController) streamServerSentEvents(w http.ResponseWriter, r *http.Request, events <-chan dtos.Event, filter application.EventStreamFilter) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	writer := newEventStreamWriter(w, ctx, true)
+	writer.flush()
+
+	keepAlive := time.NewTicker(eventStreamKeepAliveInterval)
+	defer keepAlive.Stop()
+	lc.Debug("Streaming the events as server-sent events")
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if err := writer.keepAlive(); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			event, selected := filter.Apply(event)
+			if !selected {
+				continue
+			}
+			if err := writer.write("", event); err != nil {
+				lc.Errorf("Failed to write event to server-sent events client: %v", err)
+				return
+			}
+		}
+		writer.flush()
+	}
+}
+
+func (ec *EventController) streamEventsOverWebSocket(w http.ResponseWriter, r *http.Request, events <-chan dtos.Event, filter application.EventStreamFilter) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	conn, err := ec.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has already responded with the error
+		lc.Errorf("Failed to upgrade the connection of the event stream to WebSocket: %v", err)
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	// the messages sent by the client are discarded, reading them detects the closing of the connection
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(eventStreamKeepAliveInterval)
+	defer keepAlive.Stop()
+	lc.Debug("Streaming the events over WebSocket")
+	for {
+		select {
+		case <-closed:
+			return
+		case <-keepAlive.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(webSocketWriteTimeout)); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			event, selected := filter.Apply(event)
+			if !selected {
+				continue
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+			if err := conn.WriteJSON(event); err != nil {
+				lc.Errorf("Failed to write event to WebSocket client of the event stream: %v", err)
+				return
+			}
+		}
+	}
+}
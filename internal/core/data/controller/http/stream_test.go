@@ -0,0 +1,110 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// newStreamTestServer returns the server of the event stream and the function adding the events, which aren't
+// persisted so that they are only streamed
+func newStreamTestServer(t *testing.T) (*httptest.Server, func(models.Event)) {
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					PersistData: false,
+				},
+			}
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	ec := NewEventController(dic)
+	server := httptest.NewServer(http.HandlerFunc(ec.StreamEvents))
+	t.Cleanup(server.Close)
+
+	return server, func(e models.Event) {
+		require.NoError(t, app.AddEvent(e, context.Background(), dic))
+	}
+}
+
+func streamTestEvent(deviceName string, resourceNames ...string) models.Event {
+	event := models.Event{Id: ExampleUUID, DeviceName: deviceName, ProfileName: TestDeviceProfileName, SourceName: TestSourceName, Origin: TestOriginTime}
+	for _, resourceName := range resourceNames {
+		reading := persistedReading
+		reading.DeviceName = deviceName
+		reading.ResourceName = resourceName
+		event.Readings = append(event.Readings, reading)
+	}
+	return event
+}
+
+func TestStreamEventsServerSentEvents(t *testing.T) {
+	server, addEvent := newStreamTestServer(t)
+
+	response, err := http.Get(server.URL + pkgCommon.ApiEventStreamRoute + "?deviceName=" + TestDeviceName + "&resourceName=" + TestDeviceResourceName)
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, pkgCommon.ContentTypeEventStream, response.Header.Get("Content-Type"))
+
+	addEvent(streamTestEvent("otherDevice", TestDeviceResourceName))
+	addEvent(streamTestEvent(TestDeviceName, "otherResource"))
+	addEvent(streamTestEvent(TestDeviceName, TestDeviceResourceName, "otherResource"))
+
+	// only the last event is streamed, with the reading of the resource
+	line, err := bufio.NewReader(response.Body).ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(line, "data: "), "unexpected line %s", line)
+	var event dtos.Event
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+	assert.Equal(t, TestDeviceName, event.DeviceName)
+	require.Len(t, event.Readings, 1)
+	assert.Equal(t, TestDeviceResourceName, event.Readings[0].ResourceName)
+}
+
+func TestStreamEventsWebSocket(t *testing.T) {
+	server, addEvent := newStreamTestServer(t)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + pkgCommon.ApiEventStreamRoute + "?deviceName=otherDevice," + TestDeviceName
+	conn, response, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	assert.Equal(t, http.StatusSwitchingProtocols, response.StatusCode)
+
+	addEvent(streamTestEvent("unknownDevice", TestDeviceResourceName))
+	addEvent(streamTestEvent(TestDeviceName, TestDeviceResourceName))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var event dtos.Event
+	require.NoError(t, conn.ReadJSON(&event))
+	assert.Equal(t, TestDeviceName, event.DeviceName)
+	assert.Len(t, event.Readings, 1)
+}
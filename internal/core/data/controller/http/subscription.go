@@ -0,0 +1,161 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// webSocketWriteTimeout is the time allowed to write a reading to a WebSocket client
+const webSocketWriteTimeout = 10 * time.Second
+
+type ReadingSubscriptionController struct {
+	reader   io.DtoReader
+	upgrader websocket.Upgrader
+	dic      *di.Container
+}
+
+// NewReadingSubscriptionController creates and initializes a ReadingSubscriptionController
+func NewReadingSubscriptionController(dic *di.Container) *ReadingSubscriptionController {
+	return &ReadingSubscriptionController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+func (sc *ReadingSubscriptionController) AddReadingSubscription(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+
+	var reqDTO dataDTOs.AddReadingSubscriptionRequest
+	err := sc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	subscription, err := application.ReadingSubscriptionManagerFrom(sc.dic.Get).Add(reqDTO.Subscription, sc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+		return
+	}
+
+	response := commonDTO.NewBaseWithIdResponse(reqDTO.RequestId, "", http.StatusCreated, subscription.Id)
+	utils.WriteHttpHeader(w, ctx, http.StatusCreated)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (sc *ReadingSubscriptionController) AllReadingSubscriptions(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+
+	subscriptions := application.ReadingSubscriptionManagerFrom(sc.dic.Get).AllSubscriptions()
+
+	response := dataDTOs.NewMultiReadingSubscriptionsResponse("", "", http.StatusOK, uint32(len(subscriptions)), subscriptions)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (sc *ReadingSubscriptionController) ReadingSubscriptionById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+
+	subscription, err := application.ReadingSubscriptionManagerFrom(sc.dic.Get).Subscription(mux.Vars(r)[common.Id])
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := dataDTOs.NewReadingSubscriptionResponse("", "", http.StatusOK, subscription)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (sc *ReadingSubscriptionController) DeleteReadingSubscriptionById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+
+	err := application.ReadingSubscriptionManagerFrom(sc.dic.Get).Delete(mux.Vars(r)[common.Id])
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// StreamReadingSubscriptionById upgrades the connection to WebSocket and pushes the readings matching the
+// subscription as JSON messages, until the client closes the connection or the subscription is deleted.
+func (sc *ReadingSubscriptionController) StreamReadingSubscriptionById(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+	id := mux.Vars(r)[common.Id]
+
+	readings, stop, err := application.ReadingSubscriptionManagerFrom(sc.dic.Get).Listen(id)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	defer stop()
+
+	conn, upgradeErr := sc.upgrader.Upgrade(w, r, nil)
+	if upgradeErr != nil {
+		// the upgrader has already responded with the error
+		lc.Errorf("Failed to upgrade the connection of reading subscription %s to WebSocket: %v", id, upgradeErr)
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	// the messages sent by the client are discarded, reading them detects the closing of the connection
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	lc.Debugf("Streaming the readings of reading subscription %s over WebSocket", id)
+	for {
+		select {
+		case <-closed:
+			return
+		case reading, ok := <-readings:
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseNormalClosure, "reading subscription deleted"), time.Now().Add(webSocketWriteTimeout))
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout))
+			if err := conn.WriteJSON(reading); err != nil {
+				lc.Errorf("Failed to write reading to WebSocket client of reading subscription %s: %v", id, err)
+				return
+			}
+		}
+	}
+}
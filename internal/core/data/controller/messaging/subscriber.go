@@ -16,6 +16,10 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgEnvelope "github.com/edgexfoundry/edgex-go/internal/pkg/envelope"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
@@ -28,19 +32,22 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 )
 
-// SubscribeEvents subscribes to events from message bus
+// SubscribeEvents subscribes to events from message bus, with a JetStream durable consumer when enabled
 func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
-	messageBusInfo := dataContainer.ConfigurationFrom(dic.Get).MessageBus
+	configuration := dataContainer.ConfigurationFrom(dic.Get)
+	messageBusInfo := configuration.MessageBus
 	lc := container.LoggingClientFrom(dic.Get)
 
-	messageBus := container.MessagingClientFrom(dic.Get)
-
 	messages := make(chan types.MessageEnvelope)
 	messageErrors := make(chan error)
 
 	app := application.CoreDataAppFrom(dic.Get)
 
 	subscribeTopic := common.BuildTopic(messageBusInfo.GetBaseTopicPrefix(), common.CoreDataEventSubscribeTopic)
+	messageBus, edgexErr := pkgHandlers.SubscriptionClient(ctx, configuration.JetStream, common.CoreDataServiceKey+"-events", subscribeTopic, dic)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 
 	topics := []types.TopicChannel{
 		{
@@ -64,28 +71,10 @@ func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
 				lc.Error(e.Error())
 			case msgEnvelope := <-messages:
 				lc.Debugf("Event received from MessageBus. Topic: %s, Correlation-id: %s", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
-				event := &requests.AddEventRequest{}
-				// decoding the large payload may cause memory issues so checking before decoding
-				maxEventSize := dataContainer.ConfigurationFrom(dic.Get).MaxEventSize
-				edgeXerr := utils.CheckPayloadSize(msgEnvelope.Payload, maxEventSize*1024)
-				if edgeXerr != nil {
-					lc.Errorf("event size exceed MaxEventSize(%d KB)", maxEventSize)
-					break
-				}
-				err = unmarshalPayload(msgEnvelope, event)
-				if err != nil {
-					lc.Errorf("fail to unmarshal event, %v", err)
-					break
-				}
-				err = validateEvent(msgEnvelope.ReceivedTopic, event.Event)
-				if err != nil {
-					lc.Error(err.Error())
-					break
-				}
-				err = app.AddEvent(requests.AddEventReqToEventModel(*event), ctx, dic)
-				if err != nil {
-					lc.Errorf("fail to persist the event, %v", err)
-				}
+				msgCtx, span := tracing.TracerFrom(dic.Get).StartMessageSpan(ctx, tracing.SpanKindConsumer, messageBusInfo.Type, msgEnvelope.ReceivedTopic, msgEnvelope)
+				err = addEvent(msgCtx, msgEnvelope, app, dic)
+				span.SetError(err)
+				span.End()
 			}
 		}
 	}()
@@ -93,6 +82,54 @@ func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
 	return nil
 }
 
+// NewEventSubscriptions returns the subscription to the events from the message bus, which is renewed when the
+// MessageBus BaseTopicPrefix changes
+func NewEventSubscriptions(dic *di.Container) *pkgHandlers.MessageBusSubscriptions {
+	return pkgHandlers.NewMessageBusSubscriptions(
+		func(ctx context.Context) errors.EdgeX {
+			return SubscribeEvents(ctx, dic)
+		},
+		func(baseTopicPrefix string) []string {
+			return []string{common.BuildTopic(baseTopicPrefix, common.CoreDataEventSubscribeTopic)}
+		})
+}
+
+// addEvent adds the event of the envelope received from the message bus, the errors being logged
+func addEvent(ctx context.Context, msgEnvelope types.MessageEnvelope, app *application.CoreDataApp, dic *di.Container) error {
+	lc := container.LoggingClientFrom(dic.Get)
+	event := &requests.AddEventRequest{}
+	// decoding the large payload may cause memory issues so checking before decoding
+	maxEventSize := dataContainer.ConfigurationFrom(dic.Get).MaxEventSize
+	err := utils.CheckPayloadSize(msgEnvelope.Payload, maxEventSize*1024)
+	if err != nil {
+		lc.Errorf("event size exceed MaxEventSize(%d KB)", maxEventSize)
+		return err
+	}
+	err = utils.DecompressPayload(&msgEnvelope, maxEventSize*1024)
+	if err != nil {
+		lc.Errorf("fail to decompress event, %v", err)
+		return err
+	}
+	if _, err := pkgEnvelope.Upgrade(&msgEnvelope); err != nil {
+		lc.Errorf("fail to convert event, %v", err)
+		return err
+	}
+	if err := unmarshalPayload(msgEnvelope, event); err != nil {
+		lc.Errorf("fail to unmarshal event, %v", err)
+		return err
+	}
+	if err := validateEvent(msgEnvelope.ReceivedTopic, event.Event); err != nil {
+		lc.Error(err.Error())
+		return err
+	}
+	err = app.QueueEvent(application.WithTenant(requests.AddEventReqToEventModel(*event), msgEnvelope.QueryParams[pkgCommon.Tenant]), ctx, dic)
+	if err != nil {
+		lc.Errorf("fail to persist the event, %v", err)
+		return err
+	}
+	return nil
+}
+
 func unmarshalPayload(envelope types.MessageEnvelope, target interface{}) error {
 	var err error
 	switch envelope.ContentType {
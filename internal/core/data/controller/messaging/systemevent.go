@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+)
+
+// deviceSystemEventTopic returns the topic of the system events published by core-metadata about the devices
+func deviceSystemEventTopic(baseTopicPrefix string) string {
+	return common.BuildTopic(baseTopicPrefix, common.SystemEventPublishTopic, common.CoreMetaDataServiceKey,
+		common.DeviceSystemEventType, "#")
+}
+
+// SubscribeDeviceSystemEvents subscribes to the device system events published by core-metadata, which invalidate the
+// cached metadata the events are enriched with
+func SubscribeDeviceSystemEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := container.LoggingClientFrom(dic.Get)
+	messageBus := container.MessagingClientFrom(dic.Get)
+	app := application.CoreDataAppFrom(dic.Get)
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	subscribeTopic := deviceSystemEventTopic(dataContainer.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix())
+	topics := []types.TopicChannel{
+		{
+			Topic:    subscribeTopic,
+			Messages: messages,
+		},
+	}
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", subscribeTopic)
+				return
+			case e := <-messageErrors:
+				lc.Error(e.Error())
+			case msgEnvelope := <-messages:
+				lc.Debugf("Device system event received from MessageBus. Topic: %s, Correlation-id: %s", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
+				if err := invalidateDeviceMetadata(msgEnvelope, app); err != nil {
+					lc.Errorf("fail to handle the device system event, %v", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// NewDeviceSystemEventSubscriptions returns the subscription to the device system events from the message bus, which is
+// renewed when the MessageBus BaseTopicPrefix changes
+func NewDeviceSystemEventSubscriptions(dic *di.Container) *pkgHandlers.MessageBusSubscriptions {
+	return pkgHandlers.NewMessageBusSubscriptions(
+		func(ctx context.Context) errors.EdgeX {
+			return SubscribeDeviceSystemEvents(ctx, dic)
+		},
+		func(baseTopicPrefix string) []string {
+			return []string{deviceSystemEventTopic(baseTopicPrefix)}
+		})
+}
+
+// invalidateDeviceMetadata drops the cached metadata of the device of the system event received from the message bus
+func invalidateDeviceMetadata(msgEnvelope types.MessageEnvelope, app *application.CoreDataApp) error {
+	var systemEvent dtos.SystemEvent
+	if err := json.Unmarshal(msgEnvelope.Payload, &systemEvent); err != nil {
+		return err
+	}
+	if systemEvent.Type != common.DeviceSystemEventType {
+		return nil
+	}
+	var device dtos.Device
+	if err := systemEvent.DecodeDetails(&device); err != nil {
+		return err
+	}
+	app.InvalidateDeviceMetadata(device.Name)
+	return nil
+}
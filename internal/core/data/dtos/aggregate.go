@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// ReadingAggregate summarizes the numeric readings of a device resource whose origin is within the time window
+// [start, end), in nanoseconds.
+type ReadingAggregate struct {
+	Id           string  `json:"id"`
+	DeviceName   string  `json:"deviceName"`
+	ProfileName  string  `json:"profileName"`
+	ResourceName string  `json:"resourceName"`
+	Start        int64   `json:"start"`
+	End          int64   `json:"end"`
+	Count        uint64  `json:"count"`
+	Min          float64 `json:"min"`
+	Max          float64 `json:"max"`
+	Avg          float64 `json:"avg"`
+}
+
+// FromReadingAggregateModelToDTO transforms the ReadingAggregate Model to the ReadingAggregate DTO
+func FromReadingAggregateModelToDTO(aggregate models.ReadingAggregate) ReadingAggregate {
+	dto := ReadingAggregate{
+		Id:           aggregate.Id,
+		DeviceName:   aggregate.DeviceName,
+		ProfileName:  aggregate.ProfileName,
+		ResourceName: aggregate.ResourceName,
+		Start:        aggregate.Start,
+		End:          aggregate.End,
+		Count:        aggregate.Count,
+		Min:          aggregate.Min,
+		Max:          aggregate.Max,
+	}
+	if aggregate.Count > 0 {
+		dto.Avg = aggregate.Sum / float64(aggregate.Count)
+	}
+	return dto
+}
+
+// MultiReadingAggregatesResponse defines the Response Content for GET multiple reading aggregates
+type MultiReadingAggregatesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Aggregates                        []ReadingAggregate `json:"aggregates"`
+}
+
+func NewMultiReadingAggregatesResponse(requestId string, message string, statusCode int, totalCount uint32, aggregates []ReadingAggregate) MultiReadingAggregatesResponse {
+	return MultiReadingAggregatesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Aggregates:                 aggregates,
+	}
+}
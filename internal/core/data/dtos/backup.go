@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+)
+
+// BackupContent is the content of the backups of core-data, with the events and their readings. The reading
+// aggregates are left out, they're derived from the readings.
+type BackupContent struct {
+	Events []dtos.Event `json:"events"`
+}
+
+// Restored returns the number of each kind of entity of the backup content
+func (c BackupContent) Restored() map[string]int {
+	readings := 0
+	for _, e := range c.Events {
+		readings += len(e.Readings)
+	}
+	return map[string]int{
+		"events":   len(c.Events),
+		"readings": readings,
+	}
+}
@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// LineageEvent is an event of the lineage of another event, Depth generations away from it
+type LineageEvent struct {
+	Depth int        `json:"depth"`
+	Event dtos.Event `json:"event"`
+}
+
+// EventLineageResponse defines the Response Content for GET event lineage, with the events the event is derived from,
+// its ancestors, and the events derived from it, its descendants
+type EventLineageResponse struct {
+	common.BaseResponse `json:",inline"`
+	Event               dtos.Event     `json:"event"`
+	Ancestors           []LineageEvent `json:"ancestors"`
+	Descendants         []LineageEvent `json:"descendants"`
+}
+
+func NewEventLineageResponse(requestId string, message string, statusCode int, event dtos.Event, ancestors []LineageEvent, descendants []LineageEvent) EventLineageResponse {
+	return EventLineageResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Event:        event,
+		Ancestors:    ancestors,
+		Descendants:  descendants,
+	}
+}
@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// Status of the ParquetExportJob
+const (
+	ParquetExportJobStatusRunning   = "RUNNING"
+	ParquetExportJobStatusCompleted = "COMPLETED"
+	ParquetExportJobStatusFailed    = "FAILED"
+)
+
+// ParquetExportJob defines the state and result of an export of the readings within a time range to Parquet files,
+// with the number of readings exported and the URIs of the Parquet files written so far
+type ParquetExportJob struct {
+	Id           string   `json:"id"`
+	Start        int      `json:"start"`
+	End          int      `json:"end"`
+	Status       string   `json:"status"`
+	Created      int64    `json:"created"`
+	Completed    int64    `json:"completed,omitempty"`
+	ReadingCount int      `json:"readingCount"`
+	Files        []string `json:"files,omitempty"`
+	Message      string   `json:"message,omitempty"`
+}
+
+// ParquetExportJobResponse defines the Response Content for GET reading Parquet export job DTO
+type ParquetExportJobResponse struct {
+	common.BaseResponse `json:",inline"`
+	Job                 ParquetExportJob `json:"job"`
+}
+
+func NewParquetExportJobResponse(requestId string, message string, statusCode int, job ParquetExportJob) ParquetExportJobResponse {
+	return ParquetExportJobResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Job:          job,
+	}
+}
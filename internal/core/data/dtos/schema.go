@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// Reasons of a SchemaViolation
+const (
+	// SchemaViolationUnknownProfile is the reason of the violation of an event whose device profile doesn't exist
+	SchemaViolationUnknownProfile = "unknownProfile"
+	// SchemaViolationUnknownResource is the reason of the violation of a reading whose resource isn't a device
+	// resource of the device profile
+	SchemaViolationUnknownResource = "unknownResource"
+	// SchemaViolationValueTypeMismatch is the reason of the violation of a reading whose value type isn't the value
+	// type of its device resource
+	SchemaViolationValueTypeMismatch = "valueTypeMismatch"
+)
+
+// SchemaViolation describes how an event, or one of its readings, doesn't conform to the device profile of the event
+type SchemaViolation struct {
+	Reason            string `json:"reason"`
+	ResourceName      string `json:"resourceName,omitempty"`
+	ValueType         string `json:"valueType,omitempty"`
+	ExpectedValueType string `json:"expectedValueType,omitempty"`
+}
+
+// SchemaViolationReport is published when an event is rejected because it doesn't conform to its device profile
+type SchemaViolationReport struct {
+	EventId     string            `json:"eventId"`
+	DeviceName  string            `json:"deviceName"`
+	ProfileName string            `json:"profileName"`
+	SourceName  string            `json:"sourceName"`
+	Violations  []SchemaViolation `json:"violations"`
+}
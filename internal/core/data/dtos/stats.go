@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// ReadingStats contains the number of readings, the origin of the oldest and most recent ones, in nanoseconds, and
+// an estimate of their storage size, in bytes
+type ReadingStats struct {
+	Count         uint64 `json:"count"`
+	FirstOrigin   int64  `json:"firstOrigin"`
+	LastOrigin    int64  `json:"lastOrigin"`
+	EstimatedSize int64  `json:"estimatedSize"`
+}
+
+// DeviceReadingStats summarizes the readings stored for a device and for each of its resources
+type DeviceReadingStats struct {
+	DeviceName   string `json:"deviceName"`
+	ReadingStats `json:",inline"`
+	Resources    []ResourceReadingStats `json:"resources"`
+}
+
+// ResourceReadingStats summarizes the readings stored for a device resource
+type ResourceReadingStats struct {
+	ResourceName string `json:"resourceName"`
+	ReadingStats `json:",inline"`
+}
+
+// FromReadingStatsModelToDTO transforms the ReadingStats Model to the ReadingStats DTO
+func FromReadingStatsModelToDTO(stats models.ReadingStats) ReadingStats {
+	return ReadingStats{
+		Count:         stats.Count,
+		FirstOrigin:   stats.FirstOrigin,
+		LastOrigin:    stats.LastOrigin,
+		EstimatedSize: stats.EstimatedSize,
+	}
+}
+
+// FromDeviceReadingStatsModelToDTO transforms the DeviceReadingStats Model to the DeviceReadingStats DTO
+func FromDeviceReadingStatsModelToDTO(stats models.DeviceReadingStats) DeviceReadingStats {
+	resources := make([]ResourceReadingStats, len(stats.Resources))
+	for i, r := range stats.Resources {
+		resources[i] = ResourceReadingStats{
+			ResourceName: r.ResourceName,
+			ReadingStats: FromReadingStatsModelToDTO(r.ReadingStats),
+		}
+	}
+	return DeviceReadingStats{
+		DeviceName:   stats.DeviceName,
+		ReadingStats: FromReadingStatsModelToDTO(stats.ReadingStats),
+		Resources:    resources,
+	}
+}
+
+// ReadingStatsResponse defines the Response Content for GET reading stats, with the stats of all the readings and
+// of the readings of each device
+type ReadingStatsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Total               ReadingStats         `json:"total"`
+	Devices             []DeviceReadingStats `json:"devices"`
+}
+
+func NewReadingStatsResponse(requestId string, message string, statusCode int, total ReadingStats, devices []DeviceReadingStats) ReadingStatsResponse {
+	return ReadingStatsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Total:        total,
+		Devices:      devices,
+	}
+}
+
+// DeviceEventCounts contains the number of events of a device in each interval of an EventCountIntervalResponse
+type DeviceEventCounts struct {
+	DeviceName string   `json:"deviceName"`
+	Counts     []uint32 `json:"counts"`
+}
+
+// FromDeviceEventCountsModelToDTO transforms the DeviceEventCounts Model to the DeviceEventCounts DTO
+func FromDeviceEventCountsModelToDTO(counts models.DeviceEventCounts) DeviceEventCounts {
+	return DeviceEventCounts{
+		DeviceName: counts.DeviceName,
+		Counts:     counts.Counts,
+	}
+}
+
+// EventCountIntervalResponse defines the Response Content for GET event count by interval, with the start of each
+// interval, in nanoseconds, and the number of events of each device in each interval
+type EventCountIntervalResponse struct {
+	common.BaseResponse `json:",inline"`
+	Interval            string              `json:"interval"`
+	Intervals           []int64             `json:"intervals"`
+	Devices             []DeviceEventCounts `json:"devices"`
+}
+
+func NewEventCountIntervalResponse(requestId string, message string, statusCode int, interval string, intervals []int64, devices []DeviceEventCounts) EventCountIntervalResponse {
+	return EventCountIntervalResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Interval:     interval,
+		Intervals:    intervals,
+		Devices:      devices,
+	}
+}
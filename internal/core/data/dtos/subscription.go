@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// Operators of the ValuePredicate
+const (
+	PredicateEqual          = "eq"
+	PredicateNotEqual       = "ne"
+	PredicateGreater        = "gt"
+	PredicateGreaterOrEqual = "ge"
+	PredicateLess           = "lt"
+	PredicateLessOrEqual    = "le"
+)
+
+// ReadingSubscription defines the filter of the readings pushed to a client over WebSocket and, when
+// PublishToMessageBus is true, published to the dedicated MessageBus Topic.
+type ReadingSubscription struct {
+	Id                  string          `json:"id,omitempty"`
+	DeviceName          string          `json:"deviceName,omitempty"`
+	ProfileName         string          `json:"profileName,omitempty"`
+	ResourceName        string          `json:"resourceName,omitempty"`
+	Predicate           *ValuePredicate `json:"predicate,omitempty"`
+	PublishToMessageBus bool            `json:"publishToMessageBus,omitempty"`
+	// Topic is the MessageBus topic the matching readings are published to, set by core-data
+	Topic string `json:"topic,omitempty"`
+}
+
+// ValuePredicate compares the value of the readings to Value. The comparison is numeric when both values are numbers,
+// otherwise only eq and ne are supported.
+type ValuePredicate struct {
+	Operator string `json:"operator" validate:"required,oneof='eq' 'ne' 'gt' 'ge' 'lt' 'le'"`
+	Value    string `json:"value"`
+}
+
+// AddReadingSubscriptionRequest defines the Request Content for POST ReadingSubscription DTO.
+type AddReadingSubscriptionRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Subscription          ReadingSubscription `json:"subscription"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddReadingSubscriptionRequest) Validate() error {
+	if r.Subscription.Predicate != nil {
+		if err := common.Validate(r.Subscription.Predicate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddReadingSubscriptionRequest type
+func (r *AddReadingSubscriptionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Subscription ReadingSubscription
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddReadingSubscriptionRequest(alias)
+
+	// validate AddReadingSubscriptionRequest DTO
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReadingSubscriptionResponse defines the Response Content for GET ReadingSubscription DTO.
+type ReadingSubscriptionResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Subscription           ReadingSubscription `json:"subscription"`
+}
+
+func NewReadingSubscriptionResponse(requestId string, message string, statusCode int, subscription ReadingSubscription) ReadingSubscriptionResponse {
+	return ReadingSubscriptionResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Subscription: subscription,
+	}
+}
+
+// MultiReadingSubscriptionsResponse defines the Response Content for GET multiple ReadingSubscription DTOs.
+type MultiReadingSubscriptionsResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	Subscriptions                        []ReadingSubscription `json:"subscriptions"`
+}
+
+func NewMultiReadingSubscriptionsResponse(requestId string, message string, statusCode int, totalCount uint32, subscriptions []ReadingSubscription) MultiReadingSubscriptionsResponse {
+	return MultiReadingSubscriptionsResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Subscriptions:              subscriptions,
+	}
+}
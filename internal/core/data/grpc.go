@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"google.golang.org/grpc"
+
+	dataGrpc "github.com/edgexfoundry/edgex-go/internal/core/data/controller/grpc"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	edgexpb "github.com/edgexfoundry/edgex-go/proto/edgex/v3"
+)
+
+// grpcServices is the CoreData gRPC service, authenticated like the REST API
+var grpcServices = pkgHandlers.GrpcServices{
+	Register: func(server *grpc.Server, dic *di.Container) {
+		edgexpb.RegisterCoreDataServer(server, dataGrpc.NewCoreDataServer(dic))
+	},
+	AuthenticationHook: newAuthenticationHook,
+	HttpMethods: map[string]string{
+		"/edgex.v3.CoreData/AddEvent": http.MethodPost,
+	},
+}
@@ -8,27 +8,44 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
 type DBClient interface {
 	CloseSession()
 
 	AddEvent(e model.Event) (model.Event, errors.EdgeX)
+	AddEvents(events []model.Event) ([]model.Event, errors.EdgeX)
 	EventById(id string) (model.Event, errors.EdgeX)
 	DeleteEventById(id string) errors.EdgeX
 	EventTotalCount() (uint32, errors.EdgeX)
 	EventCountByDeviceName(deviceName string) (uint32, errors.EdgeX)
 	EventCountByTimeRange(start int, end int) (uint32, errors.EdgeX)
 	AllEvents(offset int, limit int) ([]model.Event, errors.EdgeX)
+	AllEventsAfter(after utils.Cursor, limit int) ([]model.Event, errors.EdgeX)
 	EventsByDeviceName(offset int, limit int, name string) ([]model.Event, errors.EdgeX)
+	EventsByDeviceNameAfter(after utils.Cursor, limit int, name string) ([]model.Event, errors.EdgeX)
 	DeleteEventsByDeviceName(deviceName string) errors.EdgeX
 	EventsByTimeRange(start int, end int, offset int, limit int) ([]model.Event, errors.EdgeX)
 	DeleteEventsByAge(age int64) errors.EdgeX
+	DeleteEventsByOrigin(origin int64) errors.EdgeX
+	DeleteEventsByDeviceNameAndOrigin(deviceName string, origin int64) errors.EdgeX
+	EventDeviceNames() ([]string, errors.EdgeX)
+	EventsByTenant(offset int, limit int, tenant string) ([]model.Event, errors.EdgeX)
+	EventCountByTenant(tenant string) (uint32, errors.EdgeX)
+	DeleteEventsByTenantAndOrigin(tenant string, origin int64) errors.EdgeX
+	EventsByParentId(offset int, limit int, parentId string) ([]model.Event, errors.EdgeX)
+	EventCountByParentId(parentId string) (uint32, errors.EdgeX)
+	EventCountsByTimeInterval(start int64, end int64, interval int64) ([]dataModels.DeviceEventCounts, errors.EdgeX)
 	ReadingTotalCount() (uint32, errors.EdgeX)
 	AllReadings(offset int, limit int) ([]model.Reading, errors.EdgeX)
+	AllReadingsAfter(after utils.Cursor, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByTimeRange(start int, end int, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByResourceName(offset int, limit int, resourceName string) ([]model.Reading, errors.EdgeX)
 	ReadingsByDeviceName(offset int, limit int, name string) ([]model.Reading, errors.EdgeX)
+	ReadingsByDeviceNameAfter(after utils.Cursor, limit int, name string) ([]model.Reading, errors.EdgeX)
 	ReadingsByDeviceNameAndResourceName(deviceName string, resourceName string, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX)
@@ -41,4 +58,17 @@ type DBClient interface {
 	ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourceNames []string, start, end, offset, limit int) ([]model.Reading, uint32, errors.EdgeX)
 	ReadingsByDeviceNameAndTimeRange(deviceName string, start int, end int, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingCountByDeviceNameAndTimeRange(deviceName string, start int, end int) (uint32, errors.EdgeX)
+	ReadingsByTenant(offset int, limit int, tenant string) ([]model.Reading, errors.EdgeX)
+	ReadingCountByTenant(tenant string) (uint32, errors.EdgeX)
+	ReadingStats() ([]dataModels.DeviceReadingStats, errors.EdgeX)
+
+	AddReadingAggregates(aggregates []dataModels.ReadingAggregate) errors.EdgeX
+	ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, offset int, limit int) ([]dataModels.ReadingAggregate, errors.EdgeX)
+	ReadingAggregateCountByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int) (uint32, errors.EdgeX)
+	DeleteReadingAggregatesByAge(age int64) errors.EdgeX
+
+	AddForwardQueueEntry(payload []byte, maxSize int, dropOldest bool) (uint32, errors.EdgeX)
+	ForwardQueueEntries(limit int) ([]dataModels.ForwardQueueEntry, errors.EdgeX)
+	DeleteForwardQueueEntries(sequence uint64) errors.EdgeX
+	ForwardQueueSize() (uint32, errors.EdgeX)
 }
@@ -8,6 +8,10 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	datamodels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
+	utils "github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -38,6 +42,70 @@ func (_m *DBClient) AddEvent(e models.Event) (models.Event, errors.EdgeX) {
 	return r0, r1
 }
 
+// AddEvents provides a mock function with given fields: events
+func (_m *DBClient) AddEvents(events []models.Event) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(events)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func([]models.Event) []models.Event); ok {
+		r0 = rf(events)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func([]models.Event) errors.EdgeX); ok {
+		r1 = rf(events)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddForwardQueueEntry provides a mock function with given fields: payload, maxSize, dropOldest
+func (_m *DBClient) AddForwardQueueEntry(payload []byte, maxSize int, dropOldest bool) (uint32, errors.EdgeX) {
+	ret := _m.Called(payload, maxSize, dropOldest)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func([]byte, int, bool) uint32); ok {
+		r0 = rf(payload, maxSize, dropOldest)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func([]byte, int, bool) errors.EdgeX); ok {
+		r1 = rf(payload, maxSize, dropOldest)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddReadingAggregates provides a mock function with given fields: aggregates
+func (_m *DBClient) AddReadingAggregates(aggregates []datamodels.ReadingAggregate) errors.EdgeX {
+	ret := _m.Called(aggregates)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]datamodels.ReadingAggregate) errors.EdgeX); ok {
+		r0 = rf(aggregates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // AllEvents provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllEvents(offset int, limit int) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -63,6 +131,31 @@ func (_m *DBClient) AllEvents(offset int, limit int) ([]models.Event, errors.Edg
 	return r0, r1
 }
 
+// AllEventsAfter provides a mock function with given fields: after, limit
+func (_m *DBClient) AllEventsAfter(after utils.Cursor, limit int) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(after, limit)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func(utils.Cursor, int) []models.Event); ok {
+		r0 = rf(after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(utils.Cursor, int) errors.EdgeX); ok {
+		r1 = rf(after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllReadings provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllReadings(offset int, limit int) ([]models.Reading, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -88,6 +181,31 @@ func (_m *DBClient) AllReadings(offset int, limit int) ([]models.Reading, errors
 	return r0, r1
 }
 
+// AllReadingsAfter provides a mock function with given fields: after, limit
+func (_m *DBClient) AllReadingsAfter(after utils.Cursor, limit int) ([]models.Reading, errors.EdgeX) {
+	ret := _m.Called(after, limit)
+
+	var r0 []models.Reading
+	if rf, ok := ret.Get(0).(func(utils.Cursor, int) []models.Reading); ok {
+		r0 = rf(after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reading)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(utils.Cursor, int) errors.EdgeX); ok {
+		r1 = rf(after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
@@ -141,6 +259,86 @@ func (_m *DBClient) DeleteEventsByDeviceName(deviceName string) errors.EdgeX {
 	return r0
 }
 
+// DeleteEventsByDeviceNameAndOrigin provides a mock function with given fields: deviceName, origin
+func (_m *DBClient) DeleteEventsByDeviceNameAndOrigin(deviceName string, origin int64) errors.EdgeX {
+	ret := _m.Called(deviceName, origin)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(deviceName, origin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEventsByOrigin provides a mock function with given fields: origin
+func (_m *DBClient) DeleteEventsByOrigin(origin int64) errors.EdgeX {
+	ret := _m.Called(origin)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(origin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEventsByTenantAndOrigin provides a mock function with given fields: tenant, origin
+func (_m *DBClient) DeleteEventsByTenantAndOrigin(tenant string, origin int64) errors.EdgeX {
+	ret := _m.Called(tenant, origin)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(tenant, origin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteForwardQueueEntries provides a mock function with given fields: sequence
+func (_m *DBClient) DeleteForwardQueueEntries(sequence uint64) errors.EdgeX {
+	ret := _m.Called(sequence)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(uint64) errors.EdgeX); ok {
+		r0 = rf(sequence)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteReadingAggregatesByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteReadingAggregatesByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // EventById provides a mock function with given fields: id
 func (_m *DBClient) EventById(id string) (models.Event, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -187,6 +385,52 @@ func (_m *DBClient) EventCountByDeviceName(deviceName string) (uint32, errors.Ed
 	return r0, r1
 }
 
+// EventCountByParentId provides a mock function with given fields: parentId
+func (_m *DBClient) EventCountByParentId(parentId string) (uint32, errors.EdgeX) {
+	ret := _m.Called(parentId)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(parentId)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(parentId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// EventCountByTenant provides a mock function with given fields: tenant
+func (_m *DBClient) EventCountByTenant(tenant string) (uint32, errors.EdgeX) {
+	ret := _m.Called(tenant)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventCountByTimeRange provides a mock function with given fields: start, end
 func (_m *DBClient) EventCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
 	ret := _m.Called(start, end)
@@ -210,6 +454,56 @@ func (_m *DBClient) EventCountByTimeRange(start int, end int) (uint32, errors.Ed
 	return r0, r1
 }
 
+// EventCountsByTimeInterval provides a mock function with given fields: start, end, interval
+func (_m *DBClient) EventCountsByTimeInterval(start int64, end int64, interval int64) ([]datamodels.DeviceEventCounts, errors.EdgeX) {
+	ret := _m.Called(start, end, interval)
+
+	var r0 []datamodels.DeviceEventCounts
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) []datamodels.DeviceEventCounts); ok {
+		r0 = rf(start, end, interval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.DeviceEventCounts)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int64, int64, int64) errors.EdgeX); ok {
+		r1 = rf(start, end, interval)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// EventDeviceNames provides a mock function with given fields:
+func (_m *DBClient) EventDeviceNames() ([]string, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventTotalCount provides a mock function with given fields:
 func (_m *DBClient) EventTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -258,6 +552,81 @@ func (_m *DBClient) EventsByDeviceName(offset int, limit int, name string) ([]mo
 	return r0, r1
 }
 
+// EventsByDeviceNameAfter provides a mock function with given fields: after, limit, name
+func (_m *DBClient) EventsByDeviceNameAfter(after utils.Cursor, limit int, name string) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(after, limit, name)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func(utils.Cursor, int, string) []models.Event); ok {
+		r0 = rf(after, limit, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(utils.Cursor, int, string) errors.EdgeX); ok {
+		r1 = rf(after, limit, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// EventsByParentId provides a mock function with given fields: offset, limit, parentId
+func (_m *DBClient) EventsByParentId(offset int, limit int, parentId string) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(offset, limit, parentId)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Event); ok {
+		r0 = rf(offset, limit, parentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, parentId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// EventsByTenant provides a mock function with given fields: offset, limit, tenant
+func (_m *DBClient) EventsByTenant(offset int, limit int, tenant string) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(offset, limit, tenant)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Event); ok {
+		r0 = rf(offset, limit, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventsByTimeRange provides a mock function with given fields: start, end, offset, limit
 func (_m *DBClient) EventsByTimeRange(start int, end int, offset int, limit int) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(start, end, offset, limit)
@@ -283,6 +652,102 @@ func (_m *DBClient) EventsByTimeRange(start int, end int, offset int, limit int)
 	return r0, r1
 }
 
+// ForwardQueueEntries provides a mock function with given fields: limit
+func (_m *DBClient) ForwardQueueEntries(limit int) ([]datamodels.ForwardQueueEntry, errors.EdgeX) {
+	ret := _m.Called(limit)
+
+	var r0 []datamodels.ForwardQueueEntry
+	if rf, ok := ret.Get(0).(func(int) []datamodels.ForwardQueueEntry); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.ForwardQueueEntry)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int) errors.EdgeX); ok {
+		r1 = rf(limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ForwardQueueSize provides a mock function with given fields:
+func (_m *DBClient) ForwardQueueSize() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReadingAggregateCountByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: deviceName, resourceName, start, end
+func (_m *DBClient) ReadingAggregateCountByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int) (uint32, errors.EdgeX) {
+	ret := _m.Called(deviceName, resourceName, start, end)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string, string, int, int) uint32); ok {
+		r0 = rf(deviceName, resourceName, start, end)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, int, int) errors.EdgeX); ok {
+		r1 = rf(deviceName, resourceName, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: deviceName, resourceName, start, end, offset, limit
+func (_m *DBClient) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, offset int, limit int) ([]datamodels.ReadingAggregate, errors.EdgeX) {
+	ret := _m.Called(deviceName, resourceName, start, end, offset, limit)
+
+	var r0 []datamodels.ReadingAggregate
+	if rf, ok := ret.Get(0).(func(string, string, int, int, int, int) []datamodels.ReadingAggregate); ok {
+		r0 = rf(deviceName, resourceName, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.ReadingAggregate)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, int, int, int, int) errors.EdgeX); ok {
+		r1 = rf(deviceName, resourceName, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCountByDeviceName provides a mock function with given fields: deviceName
 func (_m *DBClient) ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX) {
 	ret := _m.Called(deviceName)
@@ -421,6 +886,29 @@ func (_m *DBClient) ReadingCountByResourceNameAndTimeRange(resourceName string,
 	return r0, r1
 }
 
+// ReadingCountByTenant provides a mock function with given fields: tenant
+func (_m *DBClient) ReadingCountByTenant(tenant string) (uint32, errors.EdgeX) {
+	ret := _m.Called(tenant)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCountByTimeRange provides a mock function with given fields: start, end
 func (_m *DBClient) ReadingCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
 	ret := _m.Called(start, end)
@@ -444,6 +932,31 @@ func (_m *DBClient) ReadingCountByTimeRange(start int, end int) (uint32, errors.
 	return r0, r1
 }
 
+// ReadingStats provides a mock function with given fields:
+func (_m *DBClient) ReadingStats() ([]datamodels.DeviceReadingStats, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 []datamodels.DeviceReadingStats
+	if rf, ok := ret.Get(0).(func() []datamodels.DeviceReadingStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.DeviceReadingStats)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingTotalCount provides a mock function with given fields:
 func (_m *DBClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -492,6 +1005,31 @@ func (_m *DBClient) ReadingsByDeviceName(offset int, limit int, name string) ([]
 	return r0, r1
 }
 
+// ReadingsByDeviceNameAfter provides a mock function with given fields: after, limit, name
+func (_m *DBClient) ReadingsByDeviceNameAfter(after utils.Cursor, limit int, name string) ([]models.Reading, errors.EdgeX) {
+	ret := _m.Called(after, limit, name)
+
+	var r0 []models.Reading
+	if rf, ok := ret.Get(0).(func(utils.Cursor, int, string) []models.Reading); ok {
+		r0 = rf(after, limit, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reading)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(utils.Cursor, int, string) errors.EdgeX); ok {
+		r1 = rf(after, limit, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingsByDeviceNameAndResourceName provides a mock function with given fields: deviceName, resourceName, offset, limit
 func (_m *DBClient) ReadingsByDeviceNameAndResourceName(deviceName string, resourceName string, offset int, limit int) ([]models.Reading, errors.EdgeX) {
 	ret := _m.Called(deviceName, resourceName, offset, limit)
@@ -649,6 +1187,31 @@ func (_m *DBClient) ReadingsByResourceNameAndTimeRange(resourceName string, star
 	return r0, r1
 }
 
+// ReadingsByTenant provides a mock function with given fields: offset, limit, tenant
+func (_m *DBClient) ReadingsByTenant(offset int, limit int, tenant string) ([]models.Reading, errors.EdgeX) {
+	ret := _m.Called(offset, limit, tenant)
+
+	var r0 []models.Reading
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Reading); ok {
+		r0 = rf(offset, limit, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reading)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingsByTimeRange provides a mock function with given fields: start, end, offset, limit
 func (_m *DBClient) ReadingsByTimeRange(start int, end int, offset int, limit int) ([]models.Reading, errors.EdgeX) {
 	ret := _m.Called(start, end, offset, limit)
@@ -19,8 +19,15 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/controller/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 
@@ -31,26 +38,55 @@ import (
 type Bootstrap struct {
 	router      *mux.Router
 	serviceName string
+	flags       flags.Common
 }
 
 // NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
-func NewBootstrap(router *mux.Router, serviceName string) *Bootstrap {
+func NewBootstrap(router *mux.Router, serviceName string, f flags.Common) *Bootstrap {
 	return &Bootstrap{
 		router:      router,
 		serviceName: serviceName,
+		flags:       f,
 	}
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the data service.
 func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !audit.BootstrapAuditor(dataContainer.ConfigurationFrom(dic.Get).Audit, b.serviceName, dic) {
+		return false
+	}
+	if !apikey.BootstrapValidator(ctx, wg, dataContainer.ConfigurationFrom(dic.Get).APIKey, dic) {
+		return false
+	}
+	if !tracing.BootstrapTracer(ctx, wg, dataContainer.ConfigurationFrom(dic.Get).Tracing, b.serviceName, dic) {
+		return false
+	}
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
 	lc := container.LoggingClientFrom(dic.Get)
-	err := messaging.SubscribeEvents(ctx, dic)
+	subscriptions := messaging.NewEventSubscriptions(dic)
+	err := subscriptions.Subscribe(ctx, dic)
 	if err != nil {
 		lc.Errorf("Failed to subscribe events from message bus, %v", err)
 		return false
 	}
+	messageBusSubscriptions := []*pkgHandlers.MessageBusSubscriptions{subscriptions}
+	if application.CoreDataAppFrom(dic.Get).EventEnrichmentEnabled() {
+		deviceSystemEvents := messaging.NewDeviceSystemEventSubscriptions(dic)
+		if err := deviceSystemEvents.Subscribe(ctx, dic); err != nil {
+			lc.Errorf("Failed to subscribe device system events from message bus, %v", err)
+			return false
+		}
+		messageBusSubscriptions = append(messageBusSubscriptions, deviceSystemEvents)
+	}
+	pkgHandlers.ListenForMessageBusChanges(ctx, wg, b.flags, dic, messageBusSubscriptions...)
+
+	if dataContainer.ConfigurationFrom(dic.Get).Retention.Enabled {
+		application.StartRetention(ctx, wg, dic)
+	}
+	if dataContainer.ConfigurationFrom(dic.Get).Aggregation.Enabled {
+		application.StartAggregation(ctx, wg, dic)
+	}
 
 	return true
 }
@@ -57,7 +57,10 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		},
 	})
 
-	httpServer := handlers.NewHttpServer(router, true)
+	httpServer := pkgHandlers.NewHttpServer(router, true, &configuration.MutualTLS)
+	grpcServer := pkgHandlers.NewGrpcServer(&configuration.Grpc, httpServer, grpcServices)
+	database := pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName).
+		WithReadReplica(container.QueryDBClientInterfaceName)
 
 	bootstrap.Run(
 		ctx,
@@ -71,12 +74,15 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		true,
 		bootstrapConfig.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
-			pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName).BootstrapHandler, // add db client bootstrap handler
+			database.BootstrapHandler, // add db client bootstrap handler
+			handlers.NewClientsBootstrap(f.InDevMode()).BootstrapHandler,
 			handlers.MessagingBootstrapHandler,
 			handlers.NewServiceMetrics(common.CoreDataServiceKey).BootstrapHandler, // Must be after Messaging
+			database.MetricsBootstrapHandler,                                       // Must be after Service Metrics
 			application.BootstrapHandler,                                           // Must be after Service Metrics and before next handler
-			NewBootstrap(router, common.CoreDataServiceKey).BootstrapHandler,
+			NewBootstrap(router, common.CoreDataServiceKey, f).BootstrapHandler,
 			httpServer.BootstrapHandler,
+			grpcServer.BootstrapHandler, // Must be after the HttpServer, which loads the mutual TLS certificate
 			handlers.NewStartMessage(common.CoreDataServiceKey, edgex.Version).BootstrapHandler,
 		},
 	)
@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ReadingAggregate summarizes the numeric readings of a device resource whose origin is within the time window
+// [Start, End), in nanoseconds.
+type ReadingAggregate struct {
+	Id           string
+	DeviceName   string
+	ProfileName  string
+	ResourceName string
+	Start        int64
+	End          int64
+	Count        uint64
+	Min          float64
+	Max          float64
+	Sum          float64
+}
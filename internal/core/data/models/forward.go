@@ -0,0 +1,13 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ForwardQueueEntry is an event queued to be forwarded by the store-and-forward, Payload being the encoded request
+// sent to the endpoint. The entries are forwarded in the order of their Sequence.
+type ForwardQueueEntry struct {
+	Sequence uint64
+	Payload  []byte
+}
@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeviceReadingStats summarizes the readings stored for a device and for each of its resources
+type DeviceReadingStats struct {
+	DeviceName string
+	ReadingStats
+	Resources []ResourceReadingStats
+}
+
+// ResourceReadingStats summarizes the readings stored for a device resource
+type ResourceReadingStats struct {
+	ResourceName string
+	ReadingStats
+}
+
+// ReadingStats contains the number of readings, the origin of the oldest and most recent ones, in nanoseconds, and
+// an estimate of their storage size, in bytes
+type ReadingStats struct {
+	Count         uint64
+	FirstOrigin   int64
+	LastOrigin    int64
+	EstimatedSize int64
+}
+
+// Add includes the stats of other readings into these stats
+func (s *ReadingStats) Add(other ReadingStats) {
+	if other.Count == 0 {
+		return
+	}
+	if s.Count == 0 || other.FirstOrigin < s.FirstOrigin {
+		s.FirstOrigin = other.FirstOrigin
+	}
+	if other.LastOrigin > s.LastOrigin {
+		s.LastOrigin = other.LastOrigin
+	}
+	s.Count += other.Count
+	s.EstimatedSize += other.EstimatedSize
+}
+
+// DeviceEventCounts contains the number of events of a device in consecutive time intervals
+type DeviceEventCounts struct {
+	DeviceName string
+	Counts     []uint32
+}
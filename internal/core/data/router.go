@@ -18,17 +18,69 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	dataController "github.com/edgexfoundry/edgex-go/internal/core/data/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
+// permissionTable defines the permissions of the core-data routes which differ from the default permission of their
+// method, querying the audit records and the API keys requires the audit permission
+var permissionTable = rbac.PermissionTable{
+	rbac.RouteKey(http.MethodGet, pkgCommon.ApiAuditRoute):  rbac.PermissionAudit,
+	rbac.RouteKey(http.MethodGet, pkgCommon.ApiApiKeyRoute): rbac.PermissionAudit,
+}
+
+// newAuthenticationHook returns the hook authenticating the requests with an API key or the authentication of the
+// go-mod-bootstrap, and authorizing them with the permissionTable. It also authenticates the gRPC calls.
+func newAuthenticationHook(dic *di.Container) func(inner http.HandlerFunc) http.HandlerFunc {
+	lc := container.LoggingClientFrom(dic.Get)
+	secretProvider := container.SecretProviderExtFrom(dic.Get)
+	authenticationHook := rbac.AuthorizationHandlerFunc(dataContainer.ConfigurationFrom(dic.Get).RBAC, permissionTable,
+		handlers.AutoConfigAuthenticationFunc(secretProvider, lc), lc)
+	return apikey.AuthenticationHandlerFunc(apikey.ValidatorFrom(dic.Get), permissionTable, authenticationHook, lc)
+}
+
+// newTenancyHook returns the hook rejecting the requests querying the events and readings of all the tenants when the
+// tenancy is enabled
+func newTenancyHook(dic *di.Container) func(inner http.HandlerFunc) http.HandlerFunc {
+	lc := container.LoggingClientFrom(dic.Get)
+	return func(inner http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if err := application.CheckCrossTenantQuery(dic); err != nil {
+				utils.WriteErrorResponse(w, r.Context(), lc, err, "")
+				return
+			}
+			inner(w, r)
+		}
+	}
+}
+
+// newTenantHook returns the hook authenticating the requests querying the events and readings of a tenant and, when the
+// tenancy is enabled, rejecting those whose caller isn't of the tenant
+func newTenantHook(dic *di.Container, authenticationHook func(inner http.HandlerFunc) http.HandlerFunc) func(inner http.HandlerFunc) http.HandlerFunc {
+	tenancy := dataContainer.ConfigurationFrom(dic.Get).Tenancy
+	if !tenancy.Enabled {
+		return authenticationHook
+	}
+	return rbac.ClaimHandlerFunc(tenancy.TenantClaim, pkgCommon.Tenant, authenticationHook, container.LoggingClientFrom(dic.Get))
+}
+
 func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	// r.UseEncodedPath() tells the router to match the encoded original path to the routes
 	r.UseEncodedPath()
 
 	lc := container.LoggingClientFrom(dic.Get)
-	secretProvider := container.SecretProviderExtFrom(dic.Get)
-	authenticationHook := handlers.AutoConfigAuthenticationFunc(secretProvider, lc)
+	authenticationHook := newAuthenticationHook(dic)
+	tenancyHook := newTenancyHook(dic)
+	tenantHook := newTenantHook(dic, authenticationHook)
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
@@ -36,30 +88,77 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	// Events
 	ec := dataController.NewEventController(dic)
 	r.HandleFunc(common.ApiEventServiceNameProfileNameDeviceNameSourceNameRoute, authenticationHook(ec.AddEvent)).Methods(http.MethodPost)
-	r.HandleFunc(common.ApiEventIdRoute, authenticationHook(ec.EventById)).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiEventIdRoute, authenticationHook(tenancyHook(ec.EventById))).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiEventIdRoute, authenticationHook(ec.DeleteEventById)).Methods(http.MethodDelete)
-	r.HandleFunc(common.ApiEventCountRoute, authenticationHook(ec.EventTotalCount)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiEventCountByDeviceNameRoute, authenticationHook(ec.EventCountByDeviceName)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiAllEventRoute, authenticationHook(ec.AllEvents)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiEventByDeviceNameRoute, authenticationHook(ec.EventsByDeviceName)).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiEventCountRoute, authenticationHook(tenancyHook(ec.EventTotalCount))).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiEventCountIntervalRoute, authenticationHook(tenancyHook(ec.EventCountsByTimeInterval))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiEventCountByDeviceNameRoute, authenticationHook(tenancyHook(ec.EventCountByDeviceName))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiAllEventRoute, authenticationHook(tenancyHook(ec.AllEvents))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiEventByDeviceNameRoute, authenticationHook(tenancyHook(ec.EventsByDeviceName))).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiEventByDeviceNameRoute, authenticationHook(ec.DeleteEventsByDeviceName)).Methods(http.MethodDelete)
-	r.HandleFunc(common.ApiEventByTimeRangeRoute, authenticationHook(ec.EventsByTimeRange)).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiEventByTimeRangeRoute, authenticationHook(tenancyHook(ec.EventsByTimeRange))).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiEventExportByTimeRangeRoute, authenticationHook(tenancyHook(ec.ExportEventsByTimeRange))).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiEventByParentIdRoute, authenticationHook(tenancyHook(ec.EventsByParentId))).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiEventLineageByIdRoute, authenticationHook(tenancyHook(ec.EventLineageById))).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiEventStreamRoute, authenticationHook(tenancyHook(ec.StreamEvents))).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiEventByAgeRoute, authenticationHook(ec.DeleteEventsByAge)).Methods(http.MethodDelete) // TODO: Add authentication to support-scheduler
 
 	// Readings
 	rc := dataController.NewReadingController(dic)
-	r.HandleFunc(common.ApiReadingCountRoute, authenticationHook(rc.ReadingTotalCount)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiAllReadingRoute, authenticationHook(rc.AllReadings)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiReadingByDeviceNameRoute, authenticationHook(rc.ReadingsByDeviceName)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiReadingByTimeRangeRoute, authenticationHook(rc.ReadingsByTimeRange)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiReadingByResourceNameRoute, authenticationHook(rc.ReadingsByResourceName)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiReadingCountByDeviceNameRoute, authenticationHook(rc.ReadingCountByDeviceName)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiReadingByResourceNameAndTimeRangeRoute, authenticationHook(rc.ReadingsByResourceNameAndTimeRange)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiReadingByDeviceNameAndResourceNameRoute, authenticationHook(rc.ReadingsByDeviceNameAndResourceName)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, authenticationHook(rc.ReadingsByDeviceNameAndResourceNameAndTimeRange)).Methods(http.MethodGet)
-	r.HandleFunc(common.ApiReadingByDeviceNameAndTimeRangeRoute, authenticationHook(rc.ReadingsByDeviceNameAndResourceNamesAndTimeRange)).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingCountRoute, authenticationHook(tenancyHook(rc.ReadingTotalCount))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiAllReadingRoute, authenticationHook(tenancyHook(rc.AllReadings))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingByDeviceNameRoute, authenticationHook(tenancyHook(rc.ReadingsByDeviceName))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingByTimeRangeRoute, authenticationHook(tenancyHook(rc.ReadingsByTimeRange))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingByResourceNameRoute, authenticationHook(tenancyHook(rc.ReadingsByResourceName))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingCountByDeviceNameRoute, authenticationHook(tenancyHook(rc.ReadingCountByDeviceName))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingByResourceNameAndTimeRangeRoute, authenticationHook(tenancyHook(rc.ReadingsByResourceNameAndTimeRange))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingByDeviceNameAndResourceNameRoute, authenticationHook(tenancyHook(rc.ReadingsByDeviceNameAndResourceName))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, authenticationHook(tenancyHook(rc.ReadingsByDeviceNameAndResourceNameAndTimeRange))).Methods(http.MethodGet)
+	r.HandleFunc(common.ApiReadingByDeviceNameAndTimeRangeRoute, authenticationHook(tenancyHook(rc.ReadingsByDeviceNameAndResourceNamesAndTimeRange))).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute, authenticationHook(tenancyHook(rc.ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange))).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiReadingStatsRoute, authenticationHook(tenancyHook(rc.ReadingStats))).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiReadingParquetExportByTimeRangeRoute, authenticationHook(tenancyHook(rc.ExportReadingsToParquet))).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiReadingParquetExportJobByIdRoute, authenticationHook(rc.ParquetExportJobById)).Methods(http.MethodGet)
+
+	// Reading subscriptions
+	sc := dataController.NewReadingSubscriptionController(dic)
+	r.HandleFunc(pkgCommon.ApiReadingSubscriptionRoute, authenticationHook(sc.AddReadingSubscription)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllReadingSubscriptionsRoute, authenticationHook(sc.AllReadingSubscriptions)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiReadingSubscriptionByIdRoute, authenticationHook(sc.ReadingSubscriptionById)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiReadingSubscriptionByIdRoute, authenticationHook(sc.DeleteReadingSubscriptionById)).Methods(http.MethodDelete)
+	r.HandleFunc(pkgCommon.ApiReadingSubscriptionStreamByIdRoute, authenticationHook(tenancyHook(sc.StreamReadingSubscriptionById))).Methods(http.MethodGet)
+
+	// Tenants
+	r.HandleFunc(pkgCommon.ApiTenantEventServiceNameProfileNameDeviceNameSourceNameRoute, authenticationHook(ec.AddEvent)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiTenantAllEventRoute, tenantHook(ec.EventsByTenant)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiTenantEventCountRoute, tenantHook(ec.EventCountByTenant)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiTenantAllReadingRoute, tenantHook(rc.ReadingsByTenant)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiTenantReadingCountRoute, tenantHook(rc.ReadingCountByTenant)).Methods(http.MethodGet)
+
+	// Backup
+	bc := dataController.NewBackupController(dic)
+	r.HandleFunc(pkgCommon.ApiBackupRoute, authenticationHook(bc.Backup)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiBackupRoute, authenticationHook(bc.Restore)).Methods(http.MethodPost)
+
+	// Audit
+	auditor := audit.AuditorFrom(dic.Get)
+	if auditor != nil {
+		maxResultCount := container.ConfigurationFrom(dic.Get).GetBootstrap().Service.MaxResultCount
+		r.HandleFunc(pkgCommon.ApiAuditRoute, authenticationHook(audit.QueryHandler(auditor, lc, maxResultCount))).Methods(http.MethodGet)
+	}
+
+	// API keys
+	if validator := apikey.ValidatorFrom(dic.Get); validator != nil {
+		r.HandleFunc(pkgCommon.ApiApiKeyRoute, authenticationHook(apikey.KeysHandler(validator, lc))).Methods(http.MethodGet)
+	}
 
 	r.Use(correlation.ManageHeader)
+	r.Use(tracing.Middleware(tracing.TracerFrom(dic.Get)))
+	r.Use(audit.Middleware(auditor))
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 	r.Use(correlation.UrlDecodeMiddleware(container.LoggingClientFrom(dic.Get)))
+	r.Use(pkgHandlers.RequestLimitMiddleware(container.ConfigurationFrom(dic.Get).GetBootstrap().Service.MaxRequestSize, container.LoggingClientFrom(dic.Get)))
+	// the streams are served without the request timeout of the http server
+	r.Use(pkgHandlers.StreamMiddleware(dataContainer.ConfigurationFrom(dic.Get).Service.CORSConfiguration,
+		pkgCommon.ApiEventStreamRoute, pkgCommon.ApiEventExportByTimeRangeRoute, pkgCommon.ApiReadingSubscriptionStreamByIdRoute))
 }
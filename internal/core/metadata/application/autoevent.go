@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// validateAutoEvents returns an error when the interval of an auto event isn't a positive duration, or when two auto
+// events read the same source, the device service then reading the source at both intervals
+func validateAutoEvents(autoEvents []models.AutoEvent) errors.EdgeX {
+	sources := make(map[string]int, len(autoEvents))
+	for i, a := range autoEvents {
+		interval, err := time.ParseDuration(a.Interval)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("auto event interval '%s' of source %s is invalid", a.Interval, a.SourceName), err)
+		} else if interval <= 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("auto event interval '%s' of source %s must be greater than 0", a.Interval, a.SourceName), nil)
+		}
+		if j, ok := sources[a.SourceName]; ok {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("auto events with intervals '%s' and '%s' conflict on source %s", autoEvents[j].Interval, a.Interval, a.SourceName), nil)
+		}
+		sources[a.SourceName] = i
+	}
+	return nil
+}
+
+// DeviceServicePollingLoads returns the polling load of each device service, the most loaded first. The auto events
+// of the locked devices and of the devices of a locked device service aren't effective.
+func DeviceServicePollingLoads(dic *di.Container) ([]metadataDTOs.DeviceServicePollingLoad, errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+
+	deviceServices, err := dbClient.AllDeviceServices(0, -1, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices, err := dbClient.AllDevices(0, -1, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	loads := make([]metadataDTOs.DeviceServicePollingLoad, len(deviceServices))
+	shortestIntervals := make([]time.Duration, len(deviceServices))
+	indexes := make(map[string]int, len(deviceServices))
+	for i, ds := range deviceServices {
+		loads[i].ServiceName = ds.Name
+		if ds.AdminState == models.Unlocked {
+			indexes[ds.Name] = i
+		}
+	}
+	for _, d := range devices {
+		i, ok := indexes[d.ServiceName]
+		if !ok || d.AdminState != models.Unlocked || len(d.AutoEvents) == 0 {
+			continue
+		}
+		polling := false
+		for _, a := range d.AutoEvents {
+			interval, err := time.ParseDuration(a.Interval)
+			if err != nil || interval <= 0 {
+				// the auto events written before their intervals were validated can't be scheduled
+				continue
+			}
+			polling = true
+			loads[i].AutoEvents++
+			loads[i].ReadsPerSecond += float64(time.Second) / float64(interval)
+			if shortestIntervals[i] == 0 || interval < shortestIntervals[i] {
+				shortestIntervals[i] = interval
+				loads[i].ShortestInterval = a.Interval
+			}
+		}
+		if polling {
+			loads[i].Devices++
+		}
+	}
+
+	sort.SliceStable(loads, func(i, j int) bool {
+		return loads[i].ReadsPerSecond > loads[j].ReadsPerSecond
+	})
+	return loads, nil
+}
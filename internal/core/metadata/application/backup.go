@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/backup"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// Backup returns the backup of all the entities persisted by core-metadata. The entities are read from the primary
+// database rather than from the read replica, so that the backup includes the latest changes.
+func Backup(dic *di.Container) (backup.Backup, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	bundle, err := exportBundle(dbClient)
+	if err != nil {
+		return backup.Backup{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	content := metadataDTOs.BackupContent{Bundle: bundle}
+
+	deviceGroups, err := dbClient.AllDeviceGroups(0, -1)
+	if err != nil {
+		return backup.Backup{}, errors.NewCommonEdgeX(errors.Kind(err), "fail to back up the device groups", err)
+	}
+	content.DeviceGroups = make([]metadataDTOs.DeviceGroup, len(deviceGroups))
+	for i, dg := range deviceGroups {
+		content.DeviceGroups[i] = metadataDTOs.FromDeviceGroupModelToDTO(dg)
+	}
+
+	deviceTemplates, err := dbClient.AllDeviceTemplates(0, -1)
+	if err != nil {
+		return backup.Backup{}, errors.NewCommonEdgeX(errors.Kind(err), "fail to back up the device templates", err)
+	}
+	content.DeviceTemplates = make([]metadataDTOs.DeviceTemplate, len(deviceTemplates))
+	for i, dt := range deviceTemplates {
+		content.DeviceTemplates[i] = metadataDTOs.FromDeviceTemplateModelToDTO(dt)
+	}
+
+	return backup.New(common.CoreMetaDataServiceKey, content)
+}
+
+// Restore adds all the entities of the backup, which is meant to be restored into a fresh deployment: none of its
+// entities must exist yet. Like a bundle import, the whole backup is validated before anything is added and either
+// all of its entities or none of them are restored.
+func Restore(b backup.Backup, ctx context.Context, dic *di.Container) (content metadataDTOs.BackupContent, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if err = b.Open(common.CoreMetaDataServiceKey, &content); err != nil {
+		return content, errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = validateBundle(content.Bundle, dbClient, dic); err != nil {
+		return content, errors.NewCommonEdgeX(errors.Kind(err), "invalid backup", err)
+	}
+	if err = validateBackupContent(content, dbClient); err != nil {
+		return content, errors.NewCommonEdgeX(errors.Kind(err), "invalid backup", err)
+	}
+
+	var undo rollbacks
+	if err = addBackupContent(content, dbClient, &undo); err != nil {
+		undo.run(ctx, lc)
+		return content, err
+	}
+
+	lc.Infof(
+		"Backup created at %d restored. Device services: %d, device profiles: %d, devices: %d, provision watchers: %d, device groups: %d, device templates: %d, Correlation-ID: %s ",
+		b.Created, len(content.Bundle.DeviceServices), len(content.Bundle.DeviceProfiles), len(content.Bundle.Devices),
+		len(content.Bundle.ProvisionWatchers), len(content.DeviceGroups), len(content.DeviceTemplates), correlation.FromContext(ctx),
+	)
+	publishBundle(content.Bundle, ctx, dic)
+	for _, dg := range content.DeviceGroups {
+		recordChange(DeviceGroupChangeType, common.SystemEventActionAdd, dg, ctx, dic)
+	}
+	for _, dt := range content.DeviceTemplates {
+		recordChange(DeviceTemplateChangeType, common.SystemEventActionAdd, dt, ctx, dic)
+	}
+	return content, nil
+}
+
+// validateBackupContent returns an error when a device group or device template of the backup is invalid or already
+// exists, or when it references an entity which is neither in the backup nor in the database
+func validateBackupContent(content metadataDTOs.BackupContent, dbClient interfaces.DBClient) errors.EdgeX {
+	serviceNames := make(map[string]bool, len(content.Bundle.DeviceServices))
+	for _, ds := range content.Bundle.DeviceServices {
+		serviceNames[ds.Name] = true
+	}
+	profileNames := make(map[string]bool, len(content.Bundle.DeviceProfiles))
+	for _, dp := range content.Bundle.DeviceProfiles {
+		profileNames[dp.Name] = true
+	}
+	deviceNames := make(map[string]bool, len(content.Bundle.Devices))
+	for _, d := range content.Bundle.Devices {
+		deviceNames[d.Name] = true
+	}
+
+	groupNames := make(map[string]bool, len(content.DeviceGroups))
+	for _, dg := range content.DeviceGroups {
+		if err := common.Validate(dg); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device group %s", dg.Name), err)
+		}
+		if err := checkBundleName("device group", dg.Name, groupNames, entityNameExists(dbClient.DeviceGroupByName)); err != nil {
+			return err
+		}
+		for _, deviceName := range dg.Devices {
+			if err := checkBundleReference("device", deviceName, deviceNames, dbClient.DeviceNameExists); err != nil {
+				return err
+			}
+		}
+	}
+	templateNames := make(map[string]bool, len(content.DeviceTemplates))
+	for _, dt := range content.DeviceTemplates {
+		if err := common.Validate(dt); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device template %s", dt.Name), err)
+		}
+		if err := checkBundleName("device template", dt.Name, templateNames, entityNameExists(dbClient.DeviceTemplateByName)); err != nil {
+			return err
+		}
+		if err := checkBundleReference("device service", dt.ServiceName, serviceNames, dbClient.DeviceServiceNameExists); err != nil {
+			return err
+		}
+		if err := checkBundleReference("device profile", dt.ProfileName, profileNames, dbClient.DeviceProfileNameExists); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addBackupContent adds the entities of the validated backup to the database, appending the deletion of each entity
+// added to the rollbacks
+func addBackupContent(content metadataDTOs.BackupContent, dbClient interfaces.DBClient, undo *rollbacks) errors.EdgeX {
+	if err := addBundle(content.Bundle, dbClient, undo); err != nil {
+		return err
+	}
+	for _, dg := range content.DeviceGroups {
+		name := dg.Name
+		if _, err := dbClient.AddDeviceGroup(metadataDTOs.ToDeviceGroupModel(dg)); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to restore device group %s", name), err)
+		}
+		*undo = append(*undo, func() errors.EdgeX { return dbClient.DeleteDeviceGroupByName(name) })
+	}
+	for _, dt := range content.DeviceTemplates {
+		name := dt.Name
+		if _, err := dbClient.AddDeviceTemplate(metadataDTOs.ToDeviceTemplateModel(dt)); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to restore device template %s", name), err)
+		}
+		*undo = append(*undo, func() errors.EdgeX { return dbClient.DeleteDeviceTemplateByName(name) })
+	}
+	return nil
+}
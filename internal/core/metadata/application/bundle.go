@@ -0,0 +1,288 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// ExportBundle returns all the device services, device profiles, devices and provision watchers as a Bundle
+func ExportBundle(dic *di.Container) (bundle metadataDTOs.Bundle, err errors.EdgeX) {
+	return exportBundle(container.QueryDBClientFrom(dic.Get))
+}
+
+func exportBundle(dbClient interfaces.DBClient) (bundle metadataDTOs.Bundle, err errors.EdgeX) {
+	bundle = metadataDTOs.Bundle{
+		Versionable:   commonDTO.NewVersionable(),
+		BundleVersion: metadataDTOs.BundleVersion,
+		Created:       pkgCommon.MakeTimestamp(),
+	}
+
+	deviceServices, err := dbClient.AllDeviceServices(0, -1, nil)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeX(errors.Kind(err), "fail to export the device services", err)
+	}
+	bundle.DeviceServices = make([]dtos.DeviceService, len(deviceServices))
+	for i, ds := range deviceServices {
+		bundle.DeviceServices[i] = dtos.FromDeviceServiceModelToDTO(ds)
+	}
+
+	deviceProfiles, err := dbClient.AllDeviceProfiles(0, -1, nil)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeX(errors.Kind(err), "fail to export the device profiles", err)
+	}
+	bundle.DeviceProfiles = make([]dtos.DeviceProfile, len(deviceProfiles))
+	for i, dp := range deviceProfiles {
+		bundle.DeviceProfiles[i] = dtos.FromDeviceProfileModelToDTO(dp)
+	}
+
+	devices, err := dbClient.AllDevices(0, -1, nil)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeX(errors.Kind(err), "fail to export the devices", err)
+	}
+	bundle.Devices = make([]dtos.Device, len(devices))
+	for i, d := range devices {
+		bundle.Devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+
+	provisionWatchers, err := dbClient.AllProvisionWatchers(0, -1, nil)
+	if err != nil {
+		return bundle, errors.NewCommonEdgeX(errors.Kind(err), "fail to export the provision watchers", err)
+	}
+	bundle.ProvisionWatchers = make([]dtos.ProvisionWatcher, len(provisionWatchers))
+	for i, pw := range provisionWatchers {
+		bundle.ProvisionWatchers[i] = dtos.FromProvisionWatcherModelToDTO(pw)
+	}
+
+	return bundle, nil
+}
+
+// ImportBundle adds all the device services, device profiles, devices and provision watchers of the bundle. The
+// bundle is validated before anything is added, and the entities already added are deleted when adding one fails,
+// so that either the whole bundle or nothing is imported. The entities of the bundle must not exist yet.
+func ImportBundle(bundle metadataDTOs.Bundle, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if err := validateBundle(bundle, dbClient, dic); err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "invalid bundle", err)
+	}
+
+	var undo rollbacks
+	if err := addBundle(bundle, dbClient, &undo); err != nil {
+		undo.run(ctx, lc)
+		return err
+	}
+
+	lc.Debugf(
+		"Bundle imported on DB successfully. Device services: %d, device profiles: %d, devices: %d, provision watchers: %d, Correlation-ID: %s ",
+		len(bundle.DeviceServices), len(bundle.DeviceProfiles), len(bundle.Devices), len(bundle.ProvisionWatchers),
+		correlation.FromContext(ctx),
+	)
+	publishBundle(bundle, ctx, dic)
+	return nil
+}
+
+// rollbacks delete the entities added by an import, they're run in the reverse order when the import fails
+type rollbacks []func() errors.EdgeX
+
+func (r rollbacks) run(ctx context.Context, lc logger.LoggingClient) {
+	for i := len(r) - 1; i >= 0; i-- {
+		if err := r[i](); err != nil {
+			lc.Errorf("Unable to roll back the import, Correlation-ID: %s, Error: %v", correlation.FromContext(ctx), err)
+		}
+	}
+}
+
+// addBundle adds the entities of the validated bundle to the database, appending the deletion of each entity added
+// to the rollbacks
+func addBundle(bundle metadataDTOs.Bundle, dbClient interfaces.DBClient, undo *rollbacks) errors.EdgeX {
+	for _, ds := range bundle.DeviceServices {
+		name := ds.Name
+		if _, err := dbClient.AddDeviceService(dtos.ToDeviceServiceModel(ds)); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to import device service %s", name), err)
+		}
+		*undo = append(*undo, func() errors.EdgeX { return dbClient.DeleteDeviceServiceByName(name) })
+	}
+	for _, dp := range bundle.DeviceProfiles {
+		name := dp.Name
+		if _, err := dbClient.AddDeviceProfile(dtos.ToDeviceProfileModel(dp)); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to import device profile %s", name), err)
+		}
+		*undo = append(*undo, func() errors.EdgeX { return dbClient.DeleteDeviceProfileByName(name) })
+	}
+	for _, d := range bundle.Devices {
+		name := d.Name
+		if _, err := dbClient.AddDevice(dtos.ToDeviceModel(d)); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to import device %s", name), err)
+		}
+		*undo = append(*undo, func() errors.EdgeX { return dbClient.DeleteDeviceByName(name) })
+	}
+	for _, pw := range bundle.ProvisionWatchers {
+		name := pw.Name
+		if _, err := dbClient.AddProvisionWatcher(dtos.ToProvisionWatcherModel(pw)); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to import provision watcher %s", name), err)
+		}
+		*undo = append(*undo, func() errors.EdgeX { return dbClient.DeleteProvisionWatcherByName(name) })
+	}
+	return nil
+}
+
+// publishBundle records the changes and publishes the system events of the entities of the bundle added
+func publishBundle(bundle metadataDTOs.Bundle, ctx context.Context, dic *di.Container) {
+	for _, ds := range bundle.DeviceServices {
+		recordChange(common.DeviceServiceSystemEventType, common.SystemEventActionAdd, ds, ctx, dic)
+		go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionAdd, ds.Name, ds, ctx, dic)
+	}
+	for _, dp := range bundle.DeviceProfiles {
+		recordChange(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, dp, ctx, dic)
+		go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, dp, ctx, dic)
+	}
+	for _, d := range bundle.Devices {
+		recordChange(common.DeviceSystemEventType, common.SystemEventActionAdd, d, ctx, dic)
+		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, d.ServiceName, d, ctx, dic)
+		publishDeviceLifecycleTransition("", d, ctx, dic)
+	}
+	for _, pw := range bundle.ProvisionWatchers {
+		recordChange(common.ProvisionWatcherSystemEventType, common.SystemEventActionAdd, pw, ctx, dic)
+		go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionAdd, pw.ServiceName, pw, ctx, dic)
+	}
+}
+
+// validateBundle returns an error when an entity of the bundle is invalid or already exists, or when a device or
+// provision watcher references a device service or device profile which is neither in the bundle nor in the database
+func validateBundle(bundle metadataDTOs.Bundle, dbClient interfaces.DBClient, dic *di.Container) errors.EdgeX {
+	if bundle.BundleVersion != metadataDTOs.BundleVersion {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("bundle version %d is not supported, the supported version is %d", bundle.BundleVersion, metadataDTOs.BundleVersion), nil)
+	}
+
+	serviceNames := make(map[string]bool, len(bundle.DeviceServices))
+	for _, ds := range bundle.DeviceServices {
+		if err := common.Validate(ds); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device service %s", ds.Name), err)
+		}
+		if err := checkBundleName("device service", ds.Name, serviceNames, dbClient.DeviceServiceNameExists); err != nil {
+			return err
+		}
+	}
+	profileNames := make(map[string]bool, len(bundle.DeviceProfiles))
+	for _, dp := range bundle.DeviceProfiles {
+		if err := dp.Validate(); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device profile %s", dp.Name), err)
+		}
+		if err := deviceProfileUoMValidation(dtos.ToDeviceProfileModel(dp), dic); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("invalid device profile %s", dp.Name), err)
+		}
+		if err := checkBundleName("device profile", dp.Name, profileNames, dbClient.DeviceProfileNameExists); err != nil {
+			return err
+		}
+	}
+	deviceNames := make(map[string]bool, len(bundle.Devices))
+	for _, d := range bundle.Devices {
+		if err := common.Validate(d); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device %s", d.Name), err)
+		}
+		if err := validateDeviceAttributes(d.Properties); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device %s", d.Name), err)
+		}
+		if _, err := deviceLifecycleState(d.Properties); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device %s", d.Name), err)
+		}
+		if err := validateDeviceLocation(d.Location); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device %s", d.Name), err)
+		}
+		if err := validateAutoEvents(dtos.ToAutoEventModels(d.AutoEvents)); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device %s", d.Name), err)
+		}
+		if err := checkBundleName("device", d.Name, deviceNames, dbClient.DeviceNameExists); err != nil {
+			return err
+		}
+		if err := checkBundleReference("device service", d.ServiceName, serviceNames, dbClient.DeviceServiceNameExists); err != nil {
+			return err
+		}
+		if err := checkBundleReference("device profile", d.ProfileName, profileNames, dbClient.DeviceProfileNameExists); err != nil {
+			return err
+		}
+	}
+	watcherNames := make(map[string]bool, len(bundle.ProvisionWatchers))
+	for _, pw := range bundle.ProvisionWatchers {
+		if err := common.Validate(pw); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid provision watcher %s", pw.Name), err)
+		}
+		if err := validateAutoEvents(dtos.ToAutoEventModels(pw.DiscoveredDevice.AutoEvents)); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid provision watcher %s", pw.Name), err)
+		}
+		if err := checkBundleName("provision watcher", pw.Name, watcherNames, entityNameExists(dbClient.ProvisionWatcherByName)); err != nil {
+			return err
+		}
+		if err := checkBundleReference("device service", pw.ServiceName, serviceNames, dbClient.DeviceServiceNameExists); err != nil {
+			return err
+		}
+		if err := checkBundleReference("device profile", pw.DiscoveredDevice.ProfileName, profileNames, dbClient.DeviceProfileNameExists); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBundleName returns an error when the name of an entity of the bundle is a duplicate or already exists, and adds
+// the name to the names of the bundle otherwise
+func checkBundleName(kind string, name string, names map[string]bool, exists func(string) (bool, errors.EdgeX)) errors.EdgeX {
+	if names[name] {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s %s is duplicated in the bundle", kind, name), nil)
+	}
+	names[name] = true
+	found, err := exists(name)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("%s '%s' existence check failed", kind, name), err)
+	} else if found {
+		return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("%s %s already exists", kind, name), nil)
+	}
+	return nil
+}
+
+// checkBundleReference returns an error when the referenced entity is neither in the bundle nor in the database
+func checkBundleReference(kind string, name string, names map[string]bool, exists func(string) (bool, errors.EdgeX)) errors.EdgeX {
+	if name == "" || names[name] {
+		return nil
+	}
+	found, err := exists(name)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("%s '%s' existence check failed", kind, name), err)
+	} else if !found {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s '%s' does not exists", kind, name), nil)
+	}
+	return nil
+}
+
+// entityNameExists adapts the query of an entity by name to an existence check
+func entityNameExists[T any](byName func(string) (T, errors.EdgeX)) func(string) (bool, errors.EdgeX) {
+	return func(name string) (bool, errors.EdgeX) {
+		_, err := byName(name)
+		if err == nil {
+			return true, nil
+		} else if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			return false, nil
+		}
+		return false, err
+	}
+}
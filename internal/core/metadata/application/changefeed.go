@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// The change feed types of the entities which have no system event type, the other change feed types are the system
+// event types
+const (
+	DeviceGroupChangeType    = "devicegroup"
+	DeviceTemplateChangeType = "devicetemplate"
+)
+
+// recordChange records the mutation of the entity described by the DTO in the change feed. The mutation itself has
+// already succeeded, so a failure to record it is only logged.
+func recordChange(changeType string, action string, dto any, ctx context.Context, dic *di.Container) {
+	maxEntries := container.ConfigurationFrom(dic.Get).Writable.ChangeFeed.MaxEntries
+	if maxEntries <= 0 {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	var name string
+	switch entity := dto.(type) {
+	case dtos.Device:
+		name = entity.Name
+	case dtos.DeviceProfile:
+		name = entity.Name
+	case dtos.DeviceService:
+		name = entity.Name
+	case dtos.ProvisionWatcher:
+		name = entity.Name
+	case metadataDTOs.DeviceGroup:
+		name = entity.Name
+	case metadataDTOs.DeviceTemplate:
+		name = entity.Name
+	default:
+		lc.Errorf("unable to record the %s %s in the change feed, unrecognized details %T", changeType, action, dto)
+		return
+	}
+
+	entry := metadataModels.ChangeFeedEntry{Type: changeType, Action: action, Name: name, Details: dto}
+	entry, err := container.DBClientFrom(dic.Get).AddChangeFeedEntry(entry, maxEntries)
+	if err != nil {
+		lc.Errorf("unable to record the %s %s of %s in the change feed, Correlation-ID: %s, Error: %v", changeType, action, name, correlation.FromContext(ctx), err)
+		return
+	}
+	lc.Debugf("Change %d recorded in the change feed: %s %s of %s, Correlation-ID: %s", entry.Sequence, changeType, action, name, correlation.FromContext(ctx))
+}
+
+// ChangeFeed returns at most limit changes recorded after the cursor, in the order they were recorded, with the
+// cursor to request the next changes and the sequence of the latest change. The cursor is the sequence of the last
+// change already read, 0 to read the change feed from the start. An error is returned when changes after the cursor
+// were already removed from the change feed, the client having to read all the metadata again before following the
+// change feed from its latest sequence.
+func ChangeFeed(cursor uint64, limit int, dic *di.Container) (changes []metadataDTOs.ChangeFeedEntry, nextCursor uint64, latest uint64, err errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+
+	oldest, latest, err := dbClient.ChangeFeedSequences()
+	if err != nil {
+		return nil, cursor, latest, errors.NewCommonEdgeXWrapper(err)
+	}
+	if oldest > cursor+1 {
+		return nil, cursor, latest, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable,
+			fmt.Sprintf("the changes after cursor %d are no longer available, the oldest change is %d", cursor, oldest), nil)
+	}
+
+	entries, err := dbClient.ChangeFeedEntriesAfter(cursor, limit)
+	if err != nil {
+		return nil, cursor, latest, errors.NewCommonEdgeXWrapper(err)
+	}
+	nextCursor = cursor
+	changes = make([]metadataDTOs.ChangeFeedEntry, len(entries))
+	for i, e := range entries {
+		changes[i] = metadataDTOs.FromChangeFeedEntryModelToDTO(e)
+		nextCursor = e.Sequence
+	}
+	return changes, nextCursor, latest, nil
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
@@ -52,7 +53,24 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container) (id stri
 		return id, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device service '%s' does not exists", d.ServiceName), nil)
 	}
 
-	err := validateDeviceCallback(dtos.FromDeviceModelToDTO(d), dic)
+	err := validateDeviceAttributes(d.Properties)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	_, err = deviceLifecycleState(d.Properties)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	err = validateDeviceLocation(d.Location)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	err = validateAutoEvents(d.AutoEvents)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = validateDeviceCallback(dtos.FromDeviceModelToDTO(d), dic)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
@@ -74,7 +92,9 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container) (id stri
 	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(addedDevice)
+	recordChange(common.DeviceSystemEventType, common.SystemEventActionAdd, deviceDTO, ctx, dic)
 	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, d.ServiceName, deviceDTO, ctx, dic)
+	publishDeviceLifecycleTransition("", deviceDTO, ctx, dic)
 
 	return addedDevice.Id, nil
 }
@@ -95,6 +115,7 @@ func DeleteDeviceByName(name string, ctx context.Context, dic *di.Container) err
 	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
+	recordChange(common.DeviceSystemEventType, common.SystemEventActionDelete, deviceDTO, ctx, dic)
 	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, device.ServiceName, deviceDTO, ctx, dic)
 
 	return nil
@@ -105,7 +126,7 @@ func DevicesByServiceName(offset int, limit int, name string, ctx context.Contex
 	if name == "" {
 		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	deviceModels, err := dbClient.DevicesByServiceName(offset, limit, name)
 	if err == nil {
 		totalCount, err = dbClient.DeviceCountByServiceName(name)
@@ -120,12 +141,40 @@ func DevicesByServiceName(offset int, limit int, name string, ctx context.Contex
 	return devices, totalCount, nil
 }
 
+// DevicesByAttribute query the devices with offset, limit and attribute
+func DevicesByAttribute(offset int, limit int, key string, value string, dic *di.Container) (devices []dtos.Device, totalCount uint32, err errors.EdgeX) {
+	if key == "" {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "attribute key is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	deviceModels, err := dbClient.DevicesByAttribute(offset, limit, key, value)
+	if err == nil {
+		totalCount, err = dbClient.DeviceCountByAttribute(key, value)
+	}
+	if err != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, totalCount, nil
+}
+
+// validateDeviceAttributes returns an error when the searchable attributes in the device properties are invalid
+func validateDeviceAttributes(properties map[string]any) errors.EdgeX {
+	if _, err := pkgCommon.DeviceAttributesFromProperties(properties); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device attributes", err)
+	}
+	return nil
+}
+
 // DeviceNameExists checks the device existence by name
 func DeviceNameExists(name string, dic *di.Container) (exists bool, err errors.EdgeX) {
 	if name == "" {
 		return exists, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	exists, err = dbClient.DeviceNameExists(name)
 	if err != nil {
 		return exists, errors.NewCommonEdgeXWrapper(err)
@@ -159,8 +208,27 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container)
 		oldServiceName = device.ServiceName
 	}
 
+	previousLifecycleState, _ := pkgCommon.DeviceLifecycleStateFromProperties(device.Properties)
+
 	requests.ReplaceDeviceModelFieldsWithDTO(&device, dto)
 
+	err = validateDeviceAttributes(device.Properties)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	device.Properties, err = patchDeviceLifecycleState(previousLifecycleState, device.Properties)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = validateDeviceLocation(device.Location)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = validateAutoEvents(device.AutoEvents)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
 	err = validateDeviceCallback(deviceDTO, dic)
 	if err != nil {
@@ -186,7 +254,9 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container)
 		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, oldServiceName, deviceDTO, ctx, dic)
 	}
 
+	recordChange(common.DeviceSystemEventType, common.SystemEventActionUpdate, deviceDTO, ctx, dic)
 	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, device.ServiceName, deviceDTO, ctx, dic)
+	publishDeviceLifecycleTransition(previousLifecycleState, deviceDTO, ctx, dic)
 
 	return nil
 }
@@ -212,7 +282,7 @@ func deviceByDTO(dbClient interfaces.DBClient, dto dtos.UpdateDevice) (device mo
 
 // AllDevices query the devices with offset, limit, and labels
 func AllDevices(offset int, limit int, labels []string, dic *di.Container) (devices []dtos.Device, totalCount uint32, err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	deviceModels, err := dbClient.AllDevices(offset, limit, labels)
 	if err == nil {
 		totalCount, err = dbClient.DeviceCountByLabels(labels)
@@ -232,7 +302,7 @@ func DeviceByName(name string, dic *di.Container) (device dtos.Device, err error
 	if name == "" {
 		return device, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	d, err := dbClient.DeviceByName(name)
 	if err != nil {
 		return device, errors.NewCommonEdgeXWrapper(err)
@@ -246,7 +316,7 @@ func DevicesByProfileName(offset int, limit int, profileName string, dic *di.Con
 	if profileName == "" {
 		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "profileName is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	deviceModels, err := dbClient.DevicesByProfileName(offset, limit, profileName)
 	if err == nil {
 		totalCount, err = dbClient.DeviceCountByProfileName(profileName)
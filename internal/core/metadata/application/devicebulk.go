@@ -0,0 +1,273 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// The bulk device functions return the error of each device in the order of the request, nil for the devices which
+// succeeded, and whether the devices without error were applied.
+//
+// In best-effort mode each device is handled independently, like the array requests of the device endpoints do, so
+// the devices without error are always applied. In atomic mode all the devices are validated before any is written,
+// and the devices already written are restored when writing one fails, so that either all or none of the devices are
+// applied. The system events of the devices are published once all the devices are applied.
+
+// BulkAddDevices adds the devices, and returns the id of each added device
+func BulkAddDevices(devices []models.Device, atomic bool, ctx context.Context, dic *di.Container) (ids []string, errs []errors.EdgeX, applied bool) {
+	ids = make([]string, len(devices))
+	errs = make([]errors.EdgeX, len(devices))
+	if !atomic {
+		for i, d := range devices {
+			ids[i], errs[i] = AddDevice(d, ctx, dic)
+		}
+		return ids, errs, true
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	failed := false
+	names := make(map[string]bool, len(devices))
+	for i, d := range devices {
+		if errs[i] = validateBulkAddDevice(dbClient, d, names, dic); errs[i] != nil {
+			failed = true
+		}
+	}
+	if failed {
+		return ids, errs, false
+	}
+
+	var added []string
+	rollback := func() {
+		for j := len(added) - 1; j >= 0; j-- {
+			if err := dbClient.DeleteDeviceByName(added[j]); err != nil {
+				lc.Errorf("Unable to roll back the bulk device creation, Correlation-ID: %s, Error: %v", correlation.FromContext(ctx), err)
+			}
+		}
+	}
+	addedDevices := make([]models.Device, len(devices))
+	for i, d := range devices {
+		addedDevice, err := dbClient.AddDevice(d)
+		if err != nil {
+			rollback()
+			errs[i] = errors.NewCommonEdgeXWrapper(err)
+			return ids, errs, false
+		}
+		added = append(added, addedDevice.Name)
+		addedDevices[i] = addedDevice
+		ids[i] = addedDevice.Id
+	}
+
+	lc.Debugf("%d devices created on DB successfully. Correlation-ID: %s ", len(devices), correlation.FromContext(ctx))
+
+	for _, d := range addedDevices {
+		deviceDTO := dtos.FromDeviceModelToDTO(d)
+		recordChange(common.DeviceSystemEventType, common.SystemEventActionAdd, deviceDTO, ctx, dic)
+		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionAdd, d.ServiceName, deviceDTO, ctx, dic)
+		publishDeviceLifecycleTransition("", deviceDTO, ctx, dic)
+	}
+	return ids, errs, true
+}
+
+// BulkPatchDevices patches the devices with the DTOs
+func BulkPatchDevices(updates []dtos.UpdateDevice, atomic bool, ctx context.Context, dic *di.Container) (errs []errors.EdgeX, applied bool) {
+	errs = make([]errors.EdgeX, len(updates))
+	if !atomic {
+		for i, dto := range updates {
+			errs[i] = PatchDevice(dto, ctx, dic)
+		}
+		return errs, true
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	failed := false
+	names := make(map[string]bool, len(updates))
+	originals := make([]models.Device, len(updates))
+	patched := make([]models.Device, len(updates))
+	for i, dto := range updates {
+		if originals[i], patched[i], errs[i] = validateBulkPatchDevice(dbClient, dto, names, dic); errs[i] != nil {
+			failed = true
+		}
+	}
+	if failed {
+		return errs, false
+	}
+
+	rollback := func(count int) {
+		for j := count - 1; j >= 0; j-- {
+			if err := dbClient.UpdateDevice(originals[j]); err != nil {
+				lc.Errorf("Unable to roll back the bulk device update, Correlation-ID: %s, Error: %v", correlation.FromContext(ctx), err)
+			}
+		}
+	}
+	for i, d := range patched {
+		if err := dbClient.UpdateDevice(d); err != nil {
+			rollback(i)
+			errs[i] = errors.NewCommonEdgeXWrapper(err)
+			return errs, false
+		}
+	}
+
+	lc.Debugf("%d devices patched on DB successfully. Correlation-ID: %s ", len(updates), correlation.FromContext(ctx))
+
+	for i, d := range patched {
+		deviceDTO := dtos.FromDeviceModelToDTO(d)
+		if originals[i].ServiceName != d.ServiceName {
+			go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, originals[i].ServiceName, deviceDTO, ctx, dic)
+		}
+		recordChange(common.DeviceSystemEventType, common.SystemEventActionUpdate, deviceDTO, ctx, dic)
+		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, d.ServiceName, deviceDTO, ctx, dic)
+		previousLifecycleState, _ := pkgCommon.DeviceLifecycleStateFromProperties(originals[i].Properties)
+		publishDeviceLifecycleTransition(previousLifecycleState, deviceDTO, ctx, dic)
+	}
+	return errs, true
+}
+
+// BulkDeleteDevicesByName deletes the devices by name
+func BulkDeleteDevicesByName(names []string, atomic bool, ctx context.Context, dic *di.Container) (errs []errors.EdgeX, applied bool) {
+	errs = make([]errors.EdgeX, len(names))
+	if !atomic {
+		for i, name := range names {
+			errs[i] = DeleteDeviceByName(name, ctx, dic)
+		}
+		return errs, true
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	failed := false
+	found := make(map[string]bool, len(names))
+	devices := make([]models.Device, len(names))
+	for i, name := range names {
+		if found[name] {
+			errs[i] = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device %s is duplicated in the request", name), nil)
+			failed = true
+			continue
+		}
+		found[name] = true
+		var err errors.EdgeX
+		if devices[i], err = dbClient.DeviceByName(name); err != nil {
+			errs[i] = errors.NewCommonEdgeXWrapper(err)
+			failed = true
+		}
+	}
+	if failed {
+		return errs, false
+	}
+
+	rollback := func(count int) {
+		for j := count - 1; j >= 0; j-- {
+			if _, err := dbClient.AddDevice(devices[j]); err != nil {
+				lc.Errorf("Unable to roll back the bulk device deletion, Correlation-ID: %s, Error: %v", correlation.FromContext(ctx), err)
+			}
+		}
+	}
+	for i, name := range names {
+		if err := dbClient.DeleteDeviceByName(name); err != nil {
+			rollback(i)
+			errs[i] = errors.NewCommonEdgeXWrapper(err)
+			return errs, false
+		}
+	}
+
+	lc.Debugf("%d devices deleted on DB successfully. Correlation-ID: %s ", len(names), correlation.FromContext(ctx))
+
+	for _, d := range devices {
+		deviceDTO := dtos.FromDeviceModelToDTO(d)
+		recordChange(common.DeviceSystemEventType, common.SystemEventActionDelete, deviceDTO, ctx, dic)
+		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, d.ServiceName, deviceDTO, ctx, dic)
+	}
+	return errs, true
+}
+
+// validateBulkAddDevice returns an error when the device is duplicated in the request, already exists, references a
+// device service or device profile which doesn't exist, has an invalid lifecycle state or auto events, or is rejected by
+// its device service
+func validateBulkAddDevice(dbClient interfaces.DBClient, d models.Device, names map[string]bool, dic *di.Container) errors.EdgeX {
+	if names[d.Name] {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device %s is duplicated in the request", d.Name), nil)
+	}
+	if err := checkBundleName("device", d.Name, names, dbClient.DeviceNameExists); err != nil {
+		return err
+	}
+	if err := checkBundleReference("device service", d.ServiceName, nil, dbClient.DeviceServiceNameExists); err != nil {
+		return err
+	}
+	if err := checkBundleReference("device profile", d.ProfileName, nil, dbClient.DeviceProfileNameExists); err != nil {
+		return err
+	}
+	if err := validateDeviceAttributes(d.Properties); err != nil {
+		return err
+	}
+	if _, err := deviceLifecycleState(d.Properties); err != nil {
+		return err
+	}
+	if err := validateDeviceLocation(d.Location); err != nil {
+		return err
+	}
+	if err := validateAutoEvents(d.AutoEvents); err != nil {
+		return err
+	}
+	return validateDeviceCallback(dtos.FromDeviceModelToDTO(d), dic)
+}
+
+// validateBulkPatchDevice returns the device before and after the patch, or an error when the device is duplicated in
+// the request, doesn't exist, references a device service which doesn't exist, can't transition to its lifecycle state,
+// or is rejected by its device service
+func validateBulkPatchDevice(dbClient interfaces.DBClient, dto dtos.UpdateDevice, names map[string]bool, dic *di.Container) (original models.Device, patched models.Device, err errors.EdgeX) {
+	if dto.ServiceName != nil {
+		if err = checkBundleReference("device service", *dto.ServiceName, nil, dbClient.DeviceServiceNameExists); err != nil {
+			return original, patched, err
+		}
+	}
+	original, err = deviceByDTO(dbClient, dto)
+	if err != nil {
+		return original, patched, errors.NewCommonEdgeXWrapper(err)
+	}
+	if names[original.Name] {
+		return original, patched, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device %s is duplicated in the request", original.Name), nil)
+	}
+	names[original.Name] = true
+
+	patched = original
+	requests.ReplaceDeviceModelFieldsWithDTO(&patched, dto)
+	if err = validateDeviceAttributes(patched.Properties); err != nil {
+		return original, patched, err
+	}
+	previousLifecycleState, _ := pkgCommon.DeviceLifecycleStateFromProperties(original.Properties)
+	if patched.Properties, err = patchDeviceLifecycleState(previousLifecycleState, patched.Properties); err != nil {
+		return original, patched, err
+	}
+	if err = validateDeviceLocation(patched.Location); err != nil {
+		return original, patched, err
+	}
+	if err = validateAutoEvents(patched.AutoEvents); err != nil {
+		return original, patched, err
+	}
+	if err = validateDeviceCallback(dtos.FromDeviceModelToDTO(patched), dic); err != nil {
+		return original, patched, errors.NewCommonEdgeXWrapper(err)
+	}
+	return original, patched, nil
+}
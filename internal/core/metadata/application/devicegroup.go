@@ -0,0 +1,189 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// AddDeviceGroup adds the device group, the devices it names must exist
+func AddDeviceGroup(dg metadataModels.DeviceGroup, ctx context.Context, dic *di.Container) (id string, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if err = checkDeviceGroupMembers(dbClient, dg); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	addedDeviceGroup, err := dbClient.AddDeviceGroup(dg)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceGroup created on DB successfully. DeviceGroup ID: %s, Correlation-ID: %s ",
+		addedDeviceGroup.Id,
+		correlation.FromContext(ctx),
+	)
+	recordChange(DeviceGroupChangeType, common.SystemEventActionAdd, metadataDTOs.FromDeviceGroupModelToDTO(addedDeviceGroup), ctx, dic)
+	return addedDeviceGroup.Id, nil
+}
+
+// DeviceGroupByName query the device group by name
+func DeviceGroupByName(name string, dic *di.Container) (deviceGroup metadataDTOs.DeviceGroup, err errors.EdgeX) {
+	if name == "" {
+		return deviceGroup, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	dg, err := dbClient.DeviceGroupByName(name)
+	if err != nil {
+		return deviceGroup, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromDeviceGroupModelToDTO(dg), nil
+}
+
+// AllDeviceGroups query the device groups with offset and limit
+func AllDeviceGroups(offset int, limit int, dic *di.Container) (deviceGroups []metadataDTOs.DeviceGroup, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	dgs, err := dbClient.AllDeviceGroups(offset, limit)
+	if err == nil {
+		totalCount, err = dbClient.DeviceGroupTotalCount()
+	}
+	if err != nil {
+		return deviceGroups, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceGroups = make([]metadataDTOs.DeviceGroup, len(dgs))
+	for i, dg := range dgs {
+		deviceGroups[i] = metadataDTOs.FromDeviceGroupModelToDTO(dg)
+	}
+	return deviceGroups, totalCount, nil
+}
+
+// PatchDeviceGroup executes the PATCH operation with the device group DTO to replace the old data
+func PatchDeviceGroup(dto metadataDTOs.UpdateDeviceGroup, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	dg, err := dbClient.DeviceGroupByName(*dto.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	metadataDTOs.ReplaceDeviceGroupModelFieldsWithDTO(&dg, dto)
+	if len(dg.Devices) == 0 && len(dg.Labels) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "devices or labels must be specified", nil)
+	}
+	if err = checkDeviceGroupMembers(dbClient, dg); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = dbClient.UpdateDeviceGroup(dg)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceGroup patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	recordChange(DeviceGroupChangeType, common.SystemEventActionUpdate, metadataDTOs.FromDeviceGroupModelToDTO(dg), ctx, dic)
+	return nil
+}
+
+// DeleteDeviceGroupByName deletes the device group by name
+func DeleteDeviceGroupByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	dg, err := dbClient.DeviceGroupByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteDeviceGroupByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	recordChange(DeviceGroupChangeType, common.SystemEventActionDelete, metadataDTOs.FromDeviceGroupModelToDTO(dg), ctx, dic)
+	return nil
+}
+
+// DevicesByGroupName query the member devices of the device group with offset and limit. The devices named by the
+// group come first, followed by the devices selected by its labels. The devices named by the group which have been
+// deleted since are skipped.
+func DevicesByGroupName(offset int, limit int, name string, dic *di.Container) (devices []dtos.Device, totalCount uint32, err errors.EdgeX) {
+	if name == "" {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	dg, err := dbClient.DeviceGroupByName(name)
+	if err != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	members := make([]models.Device, 0, len(dg.Devices))
+	memberNames := make(map[string]bool, len(dg.Devices))
+	for _, deviceName := range dg.Devices {
+		device, err := dbClient.DeviceByName(deviceName)
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			continue
+		} else if err != nil {
+			return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+		}
+		if !memberNames[device.Name] {
+			memberNames[device.Name] = true
+			members = append(members, device)
+		}
+	}
+	if len(dg.Labels) > 0 {
+		labeled, err := dbClient.AllDevices(0, -1, dg.Labels)
+		if err != nil {
+			return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, device := range labeled {
+			if !memberNames[device.Name] {
+				memberNames[device.Name] = true
+				members = append(members, device)
+			}
+		}
+	}
+
+	totalCount = uint32(len(members))
+	if offset > len(members) {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable,
+			fmt.Sprintf("query objects bounds out of range. length:%v", len(members)), nil)
+	}
+	members = members[offset:]
+	if limit >= 0 && limit < len(members) {
+		members = members[:limit]
+	}
+	devices = make([]dtos.Device, len(members))
+	for i, d := range members {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, totalCount, nil
+}
+
+// checkDeviceGroupMembers checks that the devices named by the device group exist
+func checkDeviceGroupMembers(dbClient interfaces.DBClient, dg metadataModels.DeviceGroup) errors.EdgeX {
+	for _, deviceName := range dg.Devices {
+		exists, err := dbClient.DeviceNameExists(deviceName)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		} else if !exists {
+			return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device '%s' of device group '%s' does not exist", deviceName, dg.Name), nil)
+		}
+	}
+	return nil
+}
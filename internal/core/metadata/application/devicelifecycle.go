@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// deviceLifecycleTransitions are the lifecycle states each lifecycle state can transition to, a retired device can't
+// transition to any other lifecycle state
+var deviceLifecycleTransitions = map[string][]string{
+	pkgCommon.DeviceLifecycleStateProvisioned: {pkgCommon.DeviceLifecycleStateCommissioned, pkgCommon.DeviceLifecycleStateRetired},
+	pkgCommon.DeviceLifecycleStateCommissioned: {pkgCommon.DeviceLifecycleStateActive, pkgCommon.DeviceLifecycleStateMaintenance,
+		pkgCommon.DeviceLifecycleStateRetired},
+	pkgCommon.DeviceLifecycleStateActive: {pkgCommon.DeviceLifecycleStateMaintenance, pkgCommon.DeviceLifecycleStateRetired},
+	pkgCommon.DeviceLifecycleStateMaintenance: {pkgCommon.DeviceLifecycleStateActive, pkgCommon.DeviceLifecycleStateCommissioned,
+		pkgCommon.DeviceLifecycleStateRetired},
+	pkgCommon.DeviceLifecycleStateRetired: {},
+}
+
+// deviceLifecycleState returns the lifecycle state of the device properties, or an error when it is unknown
+func deviceLifecycleState(properties map[string]any) (string, errors.EdgeX) {
+	state, err := pkgCommon.DeviceLifecycleStateFromProperties(properties)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device lifecycle state", err)
+	}
+	return state, nil
+}
+
+// patchDeviceLifecycleState validates the lifecycle state of the patched device against the previous lifecycle state
+// of the device. The previous lifecycle state is kept when the patched properties don't carry one, so that replacing
+// the properties doesn't take the device out of its lifecycle. A device without lifecycle state can enter the
+// lifecycle in any state.
+func patchDeviceLifecycleState(previousState string, properties map[string]any) (map[string]any, errors.EdgeX) {
+	state, err := deviceLifecycleState(properties)
+	if err != nil {
+		return properties, err
+	}
+	if state == "" {
+		if previousState != "" {
+			if properties == nil {
+				properties = make(map[string]any)
+			}
+			properties[pkgCommon.DeviceLifecycleState] = previousState
+		}
+		return properties, nil
+	}
+	if previousState == "" || previousState == state {
+		return properties, nil
+	}
+	for _, allowed := range deviceLifecycleTransitions[previousState] {
+		if allowed == state {
+			return properties, nil
+		}
+	}
+	return properties, errors.NewCommonEdgeX(errors.KindStatusConflict,
+		fmt.Sprintf("device lifecycle state can't transition from %s to %s", previousState, state), nil)
+}
+
+// publishDeviceLifecycleTransition publishes the device lifecycle system event when the lifecycle state of the device
+// differs from its previous lifecycle state
+func publishDeviceLifecycleTransition(previousState string, device dtos.Device, ctx context.Context, dic *di.Container) {
+	state, _ := pkgCommon.DeviceLifecycleStateFromProperties(device.Properties)
+	if state == "" || state == previousState {
+		return
+	}
+	transition := metadataDTOs.DeviceLifecycleTransition{Device: device, PreviousState: previousState, State: state}
+	go publishSystemEvent(pkgCommon.DeviceLifecycleSystemEventType, state, device.ServiceName, transition, ctx, dic)
+}
+
+// TransitionDeviceLifecycleState transitions the device to the lifecycle state, the same way patching the device
+// properties with the lifecycle state does
+func TransitionDeviceLifecycleState(name string, state string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if _, ok := deviceLifecycleTransitions[state]; !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device lifecycle state '%s' is unknown", state), nil)
+	}
+	device, err := container.DBClientFrom(dic.Get).DeviceByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	properties := make(map[string]any, len(device.Properties)+1)
+	for k, v := range device.Properties {
+		properties[k] = v
+	}
+	properties[pkgCommon.DeviceLifecycleState] = state
+	return PatchDevice(dtos.UpdateDevice{Name: &name, Properties: properties}, ctx, dic)
+}
+
+// DevicesByLifecycleState query the devices with offset, limit and lifecycle state
+func DevicesByLifecycleState(offset int, limit int, state string, dic *di.Container) (devices []dtos.Device, totalCount uint32, err errors.EdgeX) {
+	if _, ok := deviceLifecycleTransitions[state]; !ok {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device lifecycle state '%s' is unknown", state), nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	deviceModels, err := dbClient.DevicesByLifecycleState(offset, limit, state)
+	if err == nil {
+		totalCount, err = dbClient.DeviceCountByLifecycleState(state)
+	}
+	if err != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, totalCount, nil
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// validateDeviceLocation returns an error when the device location is a structured location which is invalid, the
+// free-form locations being accepted as they are
+func validateDeviceLocation(location any) errors.EdgeX {
+	if _, err := pkgCommon.DeviceGeoLocationFromLocation(location); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device location", err)
+	}
+	return nil
+}
+
+// DevicesByLocationRadius query the devices with offset, limit and distance in meters from the position, sorted by
+// distance ascending. Only the devices whose structured location has a latitude and a longitude are returned.
+func DevicesByLocationRadius(offset int, limit int, latitude float64, longitude float64, radius float64, dic *di.Container) (devices []dtos.Device, totalCount uint32, err errors.EdgeX) {
+	if radius <= 0 {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("radius %v must be greater than 0", radius), nil)
+	}
+	deviceModels, totalCount, err := container.QueryDBClientFrom(dic.Get).DevicesByLocationRadius(offset, limit, latitude, longitude, radius)
+	if err != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, totalCount, nil
+}
+
+// DevicesByLocationBox query the devices with offset, limit and bounding box, sorted by distance ascending from the
+// center of the bounding box. Only the devices whose structured location has a latitude and a longitude are returned.
+func DevicesByLocationBox(offset int, limit int, minLatitude float64, minLongitude float64, maxLatitude float64, maxLongitude float64, dic *di.Container) (devices []dtos.Device, totalCount uint32, err errors.EdgeX) {
+	if minLatitude > maxLatitude {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("minLatitude %v must not be greater than maxLatitude %v", minLatitude, maxLatitude), nil)
+	}
+	if minLongitude > maxLongitude {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("minLongitude %v must not be greater than maxLongitude %v, a bounding box crossing the antimeridian must be queried as two bounding boxes", minLongitude, maxLongitude), nil)
+	}
+	deviceModels, totalCount, err := container.QueryDBClientFrom(dic.Get).DevicesByLocationBox(offset, limit, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	if err != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, totalCount, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -47,6 +48,7 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(addedDeviceProfile)
+	recordChange(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, profileDTO, ctx, dic)
 	go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionAdd, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
 
 	return addedDeviceProfile.Id, nil
@@ -79,6 +81,7 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordChange(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, profileDTO, ctx, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -89,8 +92,11 @@ func DeviceProfileByName(name string, ctx context.Context, dic *di.Container) (d
 	if name == "" {
 		return deviceProfile, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	_, span := tracing.TracerFrom(dic.Get).StartDatabaseSpan(ctx, container.ConfigurationFrom(dic.Get).Database.Type, "DeviceProfileByName")
 	dp, err := dbClient.DeviceProfileByName(name)
+	span.SetError(err)
+	span.End()
 	if err != nil {
 		return deviceProfile, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -98,8 +104,9 @@ func DeviceProfileByName(name string, ctx context.Context, dic *di.Container) (d
 	return deviceProfile, nil
 }
 
-// DeleteDeviceProfileByName delete the device profile by name
-func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+// DeleteDeviceProfileByName delete the device profile by name, after deleting the devices and provision watchers
+// using it when cascade is true
+func DeleteDeviceProfileByName(name string, cascade bool, ctx context.Context, dic *di.Container) errors.EdgeX {
 	strictProfileDeletes := container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileDeletes
 	if strictProfileDeletes {
 		return errors.NewCommonEdgeX(errors.KindServiceLocked, "profile deletion is not allowed when StrictDeviceProfileDeletes config is enabled", nil)
@@ -112,12 +119,19 @@ func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Contain
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if cascade {
+		err = cascadeDelete(dbClient.DevicesByProfileName, dbClient.ProvisionWatchersByProfileName, name, ctx, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 	err = dbClient.DeleteDeviceProfileByName(name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordChange(common.DeviceProfileSystemEventType, common.SystemEventActionDelete, profileDTO, ctx, dic)
 	go publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
 
 	return nil
@@ -125,7 +139,7 @@ func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Contain
 
 // AllDeviceProfiles query the device profiles with offset, and limit
 func AllDeviceProfiles(offset int, limit int, labels []string, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, totalCount uint32, err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	dps, err := dbClient.AllDeviceProfiles(offset, limit, labels)
 	if err == nil {
 		totalCount, err = dbClient.DeviceProfileCountByLabels(labels)
@@ -145,7 +159,7 @@ func DeviceProfilesByModel(offset int, limit int, model string, dic *di.Containe
 	if model == "" {
 		return deviceProfiles, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "model is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	dps, err := dbClient.DeviceProfilesByModel(offset, limit, model)
 	if err == nil {
 		totalCount, err = dbClient.DeviceProfileCountByModel(model)
@@ -165,7 +179,7 @@ func DeviceProfilesByManufacturer(offset int, limit int, manufacturer string, di
 	if manufacturer == "" {
 		return deviceProfiles, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "manufacturer is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	dps, err := dbClient.DeviceProfilesByManufacturer(offset, limit, manufacturer)
 	if err == nil {
 		totalCount, err = dbClient.DeviceProfileCountByManufacturer(manufacturer)
@@ -188,7 +202,7 @@ func DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer st
 	if model == "" {
 		return deviceProfiles, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "model is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	dps, totalCount, err := dbClient.DeviceProfilesByManufacturerAndModel(offset, limit, manufacturer, model)
 	if err != nil {
 		return deviceProfiles, totalCount, errors.NewCommonEdgeXWrapper(err)
@@ -221,6 +235,7 @@ func PatchDeviceProfileBasicInfo(ctx context.Context, dto dtos.UpdateDeviceProfi
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(deviceProfile)
+	recordChange(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, profileDTO, ctx, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -246,12 +261,9 @@ func deviceProfileByDTO(dbClient interfaces.DBClient, dto dtos.UpdateDeviceProfi
 }
 
 func deviceProfileUoMValidation(p models.DeviceProfile, dic *di.Container) errors.EdgeX {
-	if container.ConfigurationFrom(dic.Get).Writable.UoM.Validation {
-		uom := container.UnitsOfMeasureFrom(dic.Get)
-		for _, dr := range p.DeviceResources {
-			if ok := uom.Validate(dr.Properties.Units); !ok {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s units %s is invalid", dr.Name, dr.Properties.Units), nil)
-			}
+	for _, dr := range p.DeviceResources {
+		if issue := deviceResourceUnitsIssue(dr.Name, dr.Properties.Units, dr.Properties.ValueType, dic); issue != "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, issue, nil)
 		}
 	}
 
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"strconv"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// DeviceProfileRevisionsByName query the revisions of the device profile with offset, limit and name, the latest first
+func DeviceProfileRevisionsByName(offset int, limit int, name string, dic *di.Container) (revisions []metadataDTOs.DeviceProfileRevision, totalCount uint32, err errors.EdgeX) {
+	if name == "" {
+		return revisions, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	if _, err = dbClient.DeviceProfileByName(name); err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	revisionModels, err := dbClient.DeviceProfileRevisionsByName(offset, limit, name)
+	if err == nil {
+		totalCount, err = dbClient.DeviceProfileRevisionCountByName(name)
+	}
+	if err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	revisions = make([]metadataDTOs.DeviceProfileRevision, len(revisionModels))
+	for i, r := range revisionModels {
+		revisions[i] = metadataDTOs.FromDeviceProfileRevisionModelToDTO(r)
+	}
+	return revisions, totalCount, nil
+}
+
+// DeviceProfileRevisionByNameAndVersion query the revision of the device profile by name and version
+func DeviceProfileRevisionByNameAndVersion(name string, version uint64, dic *di.Container) (revision metadataDTOs.DeviceProfileRevision, err errors.EdgeX) {
+	if name == "" {
+		return revision, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	r, err := dbClient.DeviceProfileRevisionByNameAndVersion(name, version)
+	if err != nil {
+		return revision, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromDeviceProfileRevisionModelToDTO(r), nil
+}
+
+// RollbackDeviceProfile updates the device profile with the content of its revision of the version, the rollback is
+// stored as a new revision
+func RollbackDeviceProfile(name string, version uint64, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if container.ConfigurationFrom(dic.Get).Writable.ProfileChange.StrictDeviceProfileChanges {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, "profile change is not allowed when StrictDeviceProfileChanges config is enabled", nil)
+	}
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	profile, err := dbClient.DeviceProfileByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	revision, err := dbClient.DeviceProfileRevisionByNameAndVersion(name, version)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	revision.Profile.Id = profile.Id
+	return UpdateDeviceProfile(revision.Profile, ctx, dic)
+}
+
+// deviceProfileVersionTags returns the system event tags carrying the version of the latest revision of the device
+// profile, nil when the version can't be queried
+func deviceProfileVersionTags(name string, dic *di.Container) map[string]string {
+	revisions, err := container.DBClientFrom(dic.Get).DeviceProfileRevisionsByName(0, 1, name)
+	if err != nil || len(revisions) == 0 {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("unable to query the version of device profile %s: %v", name, err)
+		return nil
+	}
+	return map[string]string{pkgCommon.ProfileVersion: strconv.FormatUint(revisions[0].Version, 10)}
+}
@@ -0,0 +1,210 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// ValidateDeviceProfile reports all the issues of the device profile instead of the first one, as adding the profile
+// would. When existingProfileName isn't empty, the profile is also checked to be backward-compatible with the existing
+// device profile and the devices using it.
+func ValidateDeviceProfile(profile dtos.DeviceProfile, existingProfileName string, dic *di.Container) (issues []metadataDTOs.DeviceProfileIssue, compatibility *metadataDTOs.DeviceProfileCompatibility, err errors.EdgeX) {
+	issues = lintDeviceProfile(profile, dic)
+	if existingProfileName == "" {
+		return issues, nil, nil
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	existing, err := dbClient.DeviceProfileByName(existingProfileName)
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices, err := dbClient.DevicesByProfileName(0, -1, existingProfileName)
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if profile.Name != existingProfileName {
+		issues = append(issues, metadataDTOs.DeviceProfileIssue{
+			Severity: metadataDTOs.IssueSeverityWarning,
+			Path:     "name",
+			Message:  fmt.Sprintf("profile name %s differs from the existing profile name %s", profile.Name, existingProfileName),
+		})
+	}
+	return issues, deviceProfileCompatibility(dtos.ToDeviceProfileModel(profile), existing, devices), nil
+}
+
+// lintDeviceProfile returns the issues of the device profile, in the order of the profile elements
+func lintDeviceProfile(profile dtos.DeviceProfile, dic *di.Container) []metadataDTOs.DeviceProfileIssue {
+	issues := make([]metadataDTOs.DeviceProfileIssue, 0)
+	addIssue := func(severity string, path string, format string, args ...any) {
+		issues = append(issues, metadataDTOs.DeviceProfileIssue{Severity: severity, Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if err := common.Validate(profile.DeviceProfileBasicInfo); err != nil {
+		addIssue(metadataDTOs.IssueSeverityError, "", "%v", err)
+	}
+
+	resources := make(map[string]dtos.DeviceResource, len(profile.DeviceResources))
+	for i, r := range profile.DeviceResources {
+		path := fmt.Sprintf("deviceResources[%d]", i)
+		if err := common.Validate(r); err != nil {
+			addIssue(metadataDTOs.IssueSeverityError, path, "%v", err)
+		}
+		if _, ok := resources[r.Name]; ok {
+			addIssue(metadataDTOs.IssueSeverityError, path, "device resource %s is duplicated", r.Name)
+		} else {
+			resources[r.Name] = r
+		}
+		if strings.EqualFold(r.Properties.ValueType, common.ValueTypeBinary) && strings.Contains(r.Properties.ReadWrite, common.ReadWrite_W) {
+			addIssue(metadataDTOs.IssueSeverityError, path, "device resource %s of %s value type can't be writable", r.Name, common.ValueTypeBinary)
+		}
+		if r.Properties.Minimum != nil && r.Properties.Maximum != nil && *r.Properties.Minimum > *r.Properties.Maximum {
+			addIssue(metadataDTOs.IssueSeverityError, path, "device resource %s minimum %v is greater than its maximum %v", r.Name, *r.Properties.Minimum, *r.Properties.Maximum)
+		}
+		if issue := deviceResourceUnitsIssue(r.Name, r.Properties.Units, r.Properties.ValueType, dic); issue != "" {
+			addIssue(metadataDTOs.IssueSeverityError, path, "%s", issue)
+		}
+	}
+
+	referenced := make(map[string]bool, len(profile.DeviceResources))
+	commands := make(map[string]bool, len(profile.DeviceCommands))
+	for i, c := range profile.DeviceCommands {
+		path := fmt.Sprintf("deviceCommands[%d]", i)
+		if err := common.Validate(c); err != nil {
+			addIssue(metadataDTOs.IssueSeverityError, path, "%v", err)
+		}
+		if commands[c.Name] {
+			addIssue(metadataDTOs.IssueSeverityError, path, "device command %s is duplicated", c.Name)
+		}
+		commands[c.Name] = true
+		if _, ok := resources[c.Name]; ok {
+			addIssue(metadataDTOs.IssueSeverityWarning, path, "device command %s has the name of a device resource, the device command shadows the device resource", c.Name)
+		}
+		for j, ro := range c.ResourceOperations {
+			r, ok := resources[ro.DeviceResource]
+			if !ok {
+				addIssue(metadataDTOs.IssueSeverityError, fmt.Sprintf("%s.resourceOperations[%d]", path, j),
+					"device command %s is unreachable, its device resource %s doesn't exist", c.Name, ro.DeviceResource)
+				continue
+			}
+			referenced[r.Name] = true
+			if !readWriteIncludes(r.Properties.ReadWrite, c.ReadWrite) {
+				addIssue(metadataDTOs.IssueSeverityError, fmt.Sprintf("%s.resourceOperations[%d]", path, j),
+					"device command %s is unreachable, its %s permission isn't granted by the %s permission of device resource %s", c.Name, c.ReadWrite, r.Properties.ReadWrite, r.Name)
+			}
+		}
+	}
+
+	for i, r := range profile.DeviceResources {
+		if r.IsHidden && !referenced[r.Name] {
+			addIssue(metadataDTOs.IssueSeverityWarning, fmt.Sprintf("deviceResources[%d]", i),
+				"device resource %s is unreachable, it is hidden and no device command uses it", r.Name)
+		}
+	}
+	return issues
+}
+
+// deviceProfileCompatibility compares the profile with the existing device profile it replaces. The changes breaking
+// the clients of the existing profile, and the devices whose auto events read a source which the profile no longer
+// provides, make the profile incompatible.
+func deviceProfileCompatibility(profile models.DeviceProfile, existing models.DeviceProfile, devices []models.Device) *metadataDTOs.DeviceProfileCompatibility {
+	compatibility := &metadataDTOs.DeviceProfileCompatibility{
+		ExistingProfileName: existing.Name,
+		DeviceCount:         uint32(len(devices)),
+	}
+
+	resources := make(map[string]models.DeviceResource, len(profile.DeviceResources))
+	for _, r := range profile.DeviceResources {
+		resources[r.Name] = r
+	}
+	for _, old := range existing.DeviceResources {
+		r, ok := resources[old.Name]
+		if !ok {
+			compatibility.BreakingChanges = append(compatibility.BreakingChanges, fmt.Sprintf("device resource %s is removed", old.Name))
+			continue
+		}
+		if !strings.EqualFold(r.Properties.ValueType, old.Properties.ValueType) {
+			compatibility.BreakingChanges = append(compatibility.BreakingChanges,
+				fmt.Sprintf("device resource %s value type changes from %s to %s", old.Name, old.Properties.ValueType, r.Properties.ValueType))
+		}
+		if !readWriteIncludes(r.Properties.ReadWrite, old.Properties.ReadWrite) {
+			compatibility.BreakingChanges = append(compatibility.BreakingChanges,
+				fmt.Sprintf("device resource %s permission changes from %s to %s", old.Name, old.Properties.ReadWrite, r.Properties.ReadWrite))
+		}
+	}
+
+	commands := make(map[string]models.DeviceCommand, len(profile.DeviceCommands))
+	for _, c := range profile.DeviceCommands {
+		commands[c.Name] = c
+	}
+	for _, old := range existing.DeviceCommands {
+		c, ok := commands[old.Name]
+		if !ok {
+			compatibility.BreakingChanges = append(compatibility.BreakingChanges, fmt.Sprintf("device command %s is removed", old.Name))
+			continue
+		}
+		if !readWriteIncludes(c.ReadWrite, old.ReadWrite) {
+			compatibility.BreakingChanges = append(compatibility.BreakingChanges,
+				fmt.Sprintf("device command %s permission changes from %s to %s", old.Name, old.ReadWrite, c.ReadWrite))
+		}
+		if !sameResourceOperations(c.ResourceOperations, old.ResourceOperations) {
+			compatibility.BreakingChanges = append(compatibility.BreakingChanges,
+				fmt.Sprintf("device command %s reads or writes different device resources", old.Name))
+		}
+	}
+
+	for _, d := range devices {
+		affected := false
+		for _, autoEvent := range d.AutoEvents {
+			_, isResource := resources[autoEvent.SourceName]
+			_, isCommand := commands[autoEvent.SourceName]
+			if !isResource && !isCommand {
+				compatibility.BreakingChanges = append(compatibility.BreakingChanges,
+					fmt.Sprintf("device %s auto event source %s is removed", d.Name, autoEvent.SourceName))
+				affected = true
+			}
+		}
+		if affected {
+			compatibility.AffectedDevices = append(compatibility.AffectedDevices, d.Name)
+		}
+	}
+
+	compatibility.Compatible = len(compatibility.BreakingChanges) == 0
+	return compatibility
+}
+
+// readWriteIncludes returns whether the readWrite permission grants all the permissions of required
+func readWriteIncludes(readWrite string, required string) bool {
+	for _, permission := range []string{common.ReadWrite_R, common.ReadWrite_W} {
+		if strings.Contains(required, permission) && !strings.Contains(readWrite, permission) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameResourceOperations(a []models.ResourceOperation, b []models.ResourceOperation) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].DeviceResource != b[i].DeviceResource {
+			return false
+		}
+	}
+	return true
+}
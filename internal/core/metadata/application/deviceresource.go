@@ -14,6 +14,7 @@ import (
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
@@ -28,7 +29,7 @@ func DeviceResourceByProfileNameAndResourceName(profileName string, resourceName
 	if resourceName == "" {
 		return resource, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	profile, err := dbClient.DeviceProfileByName(profileName)
 	if err != nil {
 		return resource, errors.NewCommonEdgeXWrapper(err)
@@ -79,6 +80,7 @@ func AddDeviceProfileResource(profileName string, resource models.DeviceResource
 	}
 
 	lc.Debugf("DeviceProfile deviceResources added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
+	recordChange(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, profileDTO, ctx, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -114,6 +116,7 @@ func PatchDeviceProfileResource(profileName string, dto dtos.UpdateDeviceResourc
 
 	lc.Debugf("DeviceProfile deviceResources patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
+	recordChange(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, profileDTO, ctx, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 
 	return nil
@@ -169,16 +172,14 @@ func DeleteDeviceResourceByName(profileName string, resourceName string, ctx con
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	recordChange(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, profileDTO, ctx, dic)
 	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
 	return nil
 }
 
 func deviceResourceUoMValidation(r models.DeviceResource, dic *di.Container) errors.EdgeX {
-	if container.ConfigurationFrom(dic.Get).Writable.UoM.Validation {
-		uom := container.UnitsOfMeasureFrom(dic.Get)
-		if ok := uom.Validate(r.Properties.Units); !ok {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s units %s is invalid", r.Name, r.Properties.Units), nil)
-		}
+	if issue := deviceResourceUnitsIssue(r.Name, r.Properties.Units, r.Properties.ValueType, dic); issue != "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, issue, nil)
 	}
 
 	return nil
@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
@@ -39,6 +40,7 @@ func AddDeviceService(d models.DeviceService, ctx context.Context, dic *di.Conta
 		correlationId,
 	)
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(d)
+	recordChange(common.DeviceServiceSystemEventType, common.SystemEventActionAdd, DeviceServiceDTO, ctx, dic)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionAdd, d.Name, DeviceServiceDTO, ctx, dic)
 	return addedDeviceService.Id, nil
 }
@@ -48,8 +50,11 @@ func DeviceServiceByName(name string, ctx context.Context, dic *di.Container) (d
 	if name == "" {
 		return deviceService, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	_, span := tracing.TracerFrom(dic.Get).StartDatabaseSpan(ctx, container.ConfigurationFrom(dic.Get).Database.Type, "DeviceServiceByName")
 	ds, err := dbClient.DeviceServiceByName(name)
+	span.SetError(err)
+	span.End()
 	if err != nil {
 		return deviceService, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -79,6 +84,7 @@ func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *
 		correlation.FromContext(ctx),
 	)
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(deviceService)
+	recordChange(common.DeviceServiceSystemEventType, common.SystemEventActionUpdate, DeviceServiceDTO, ctx, dic)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionUpdate, deviceService.Name, DeviceServiceDTO, ctx, dic)
 	return nil
 }
@@ -102,8 +108,9 @@ func deviceServiceByDTO(dbClient interfaces.DBClient, dto dtos.UpdateDeviceServi
 	return deviceService, nil
 }
 
-// DeleteDeviceServiceByName delete the device service by name
-func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+// DeleteDeviceServiceByName delete the device service by name, after deleting the devices and provision watchers
+// of the device service when cascade is true
+func DeleteDeviceServiceByName(name string, cascade bool, ctx context.Context, dic *di.Container) errors.EdgeX {
 	if name == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
@@ -112,18 +119,25 @@ func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Contain
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if cascade {
+		err = cascadeDelete(dbClient.DevicesByServiceName, dbClient.ProvisionWatchersByServiceName, name, ctx, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 	err = dbClient.DeleteDeviceServiceByName(name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	DeviceServiceDTO := dtos.FromDeviceServiceModelToDTO(deviceService)
+	recordChange(common.DeviceServiceSystemEventType, common.SystemEventActionDelete, DeviceServiceDTO, ctx, dic)
 	go publishSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionDelete, deviceService.Name, DeviceServiceDTO, ctx, dic)
 	return nil
 }
 
 // AllDeviceServices query the device services with labels, offset, and limit
 func AllDeviceServices(offset int, limit int, labels []string, ctx context.Context, dic *di.Container) (deviceServices []dtos.DeviceService, totalCount uint32, err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	services, err := dbClient.AllDeviceServices(offset, limit, labels)
 	if err == nil {
 		totalCount, err = dbClient.DeviceServiceCountByLabels(labels)
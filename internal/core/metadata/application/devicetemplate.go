@@ -0,0 +1,264 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// deviceTemplatePlaceholder matches the ${parameter} placeholders of the device templates
+var deviceTemplatePlaceholder = regexp.MustCompile(`\$\{([^${}]*)\}`)
+
+// AddDeviceTemplate adds the device template, the device service and device profile it references must exist
+func AddDeviceTemplate(dt metadataModels.DeviceTemplate, ctx context.Context, dic *di.Container) (id string, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if err = checkDeviceTemplateReferences(dbClient, dt); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	addedDeviceTemplate, err := dbClient.AddDeviceTemplate(dt)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceTemplate created on DB successfully. DeviceTemplate ID: %s, Correlation-ID: %s ",
+		addedDeviceTemplate.Id,
+		correlation.FromContext(ctx),
+	)
+	recordChange(DeviceTemplateChangeType, common.SystemEventActionAdd, metadataDTOs.FromDeviceTemplateModelToDTO(addedDeviceTemplate), ctx, dic)
+	return addedDeviceTemplate.Id, nil
+}
+
+// DeviceTemplateByName query the device template by name
+func DeviceTemplateByName(name string, dic *di.Container) (deviceTemplate metadataDTOs.DeviceTemplate, err errors.EdgeX) {
+	if name == "" {
+		return deviceTemplate, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	dt, err := dbClient.DeviceTemplateByName(name)
+	if err != nil {
+		return deviceTemplate, errors.NewCommonEdgeXWrapper(err)
+	}
+	return metadataDTOs.FromDeviceTemplateModelToDTO(dt), nil
+}
+
+// AllDeviceTemplates query the device templates with offset and limit
+func AllDeviceTemplates(offset int, limit int, dic *di.Container) (deviceTemplates []metadataDTOs.DeviceTemplate, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+	dts, err := dbClient.AllDeviceTemplates(offset, limit)
+	if err == nil {
+		totalCount, err = dbClient.DeviceTemplateTotalCount()
+	}
+	if err != nil {
+		return deviceTemplates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceTemplates = make([]metadataDTOs.DeviceTemplate, len(dts))
+	for i, dt := range dts {
+		deviceTemplates[i] = metadataDTOs.FromDeviceTemplateModelToDTO(dt)
+	}
+	return deviceTemplates, totalCount, nil
+}
+
+// PatchDeviceTemplate executes the PATCH operation with the device template DTO to replace the old data. The devices
+// already instantiated from the device template are left unchanged.
+func PatchDeviceTemplate(dto metadataDTOs.UpdateDeviceTemplate, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	dt, err := dbClient.DeviceTemplateByName(*dto.Name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	metadataDTOs.ReplaceDeviceTemplateModelFieldsWithDTO(&dt, dto)
+	if err = checkDeviceTemplateReferences(dbClient, dt); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = dbClient.UpdateDeviceTemplate(dt)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceTemplate patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	recordChange(DeviceTemplateChangeType, common.SystemEventActionUpdate, metadataDTOs.FromDeviceTemplateModelToDTO(dt), ctx, dic)
+	return nil
+}
+
+// DeleteDeviceTemplateByName deletes the device template by name, the devices instantiated from it are kept
+func DeleteDeviceTemplateByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	dt, err := dbClient.DeviceTemplateByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteDeviceTemplateByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	recordChange(DeviceTemplateChangeType, common.SystemEventActionDelete, metadataDTOs.FromDeviceTemplateModelToDTO(dt), ctx, dic)
+	return nil
+}
+
+// InstantiateDeviceTemplate renders one device of the device template per instance parameters, and adds the devices
+// the same way the bulk device creation does. The error of each device is returned in the order of the instances, an
+// error being returned for the whole request when the device template doesn't exist.
+func InstantiateDeviceTemplate(name string, instances []map[string]string, atomic bool, ctx context.Context, dic *di.Container) (devices []models.Device, ids []string, errs []errors.EdgeX, applied bool, err errors.EdgeX) {
+	if name == "" {
+		return nil, nil, nil, false, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dt, err := container.DBClientFrom(dic.Get).DeviceTemplateByName(name)
+	if err != nil {
+		return nil, nil, nil, false, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	devices = make([]models.Device, len(instances))
+	errs = make([]errors.EdgeX, len(instances))
+	failed := false
+	for i, parameters := range instances {
+		if devices[i], errs[i] = renderDeviceTemplate(dt, parameters); errs[i] != nil {
+			failed = true
+		}
+	}
+	if !atomic {
+		ids = make([]string, len(instances))
+		for i, d := range devices {
+			if errs[i] == nil {
+				ids[i], errs[i] = AddDevice(d, ctx, dic)
+			}
+		}
+		return devices, ids, errs, true, nil
+	}
+	if failed {
+		return devices, make([]string, len(instances)), errs, false, nil
+	}
+
+	ids, errs, applied = BulkAddDevices(devices, atomic, ctx, dic)
+	return devices, ids, errs, applied, nil
+}
+
+// renderDeviceTemplate returns the device of the device template with the placeholders substituted with the parameters
+func renderDeviceTemplate(dt metadataModels.DeviceTemplate, parameters map[string]string) (models.Device, errors.EdgeX) {
+	r := deviceTemplateRenderer{parameters: parameters}
+	device := dtos.Device{
+		Name:           r.string(dt.DeviceName),
+		Description:    r.string(dt.DeviceDescription),
+		AdminState:     string(dt.AdminState),
+		OperatingState: models.Up,
+		ServiceName:    dt.ServiceName,
+		ProfileName:    dt.ProfileName,
+		Location:       r.value(dt.Location),
+	}
+	if device.AdminState == "" {
+		device.AdminState = models.Unlocked
+	}
+	if dt.Labels != nil {
+		device.Labels = make([]string, len(dt.Labels))
+		for i, label := range dt.Labels {
+			device.Labels[i] = r.string(label)
+		}
+	}
+	device.Protocols = make(map[string]dtos.ProtocolProperties, len(dt.Protocols))
+	for protocolName, protocol := range dt.Protocols {
+		properties := make(dtos.ProtocolProperties, len(protocol))
+		for k, v := range protocol {
+			properties[k] = r.value(v)
+		}
+		device.Protocols[r.string(protocolName)] = properties
+	}
+	if dt.AutoEvents != nil {
+		device.AutoEvents = make([]dtos.AutoEvent, len(dt.AutoEvents))
+		for i, a := range dt.AutoEvents {
+			device.AutoEvents[i] = dtos.AutoEvent{Interval: r.string(a.Interval), OnChange: a.OnChange, SourceName: r.string(a.SourceName)}
+		}
+	}
+	if dt.Properties != nil {
+		device.Properties, _ = r.value(dt.Properties).(map[string]any)
+	}
+
+	if r.err != nil {
+		return models.Device{}, r.err
+	}
+	if err := common.Validate(device); err != nil {
+		return models.Device{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("device rendered from device template %s is invalid", dt.Name), err)
+	}
+	return dtos.ToDeviceModel(device), nil
+}
+
+// deviceTemplateRenderer substitutes the placeholders with the parameters, keeping the first unknown placeholder
+type deviceTemplateRenderer struct {
+	parameters map[string]string
+	err        errors.EdgeX
+}
+
+func (r *deviceTemplateRenderer) string(s string) string {
+	return deviceTemplatePlaceholder.ReplaceAllStringFunc(s, func(placeholder string) string {
+		name := deviceTemplatePlaceholder.FindStringSubmatch(placeholder)[1]
+		value, ok := r.parameters[name]
+		if !ok && r.err == nil {
+			r.err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device template parameter '%s' is not specified", name), nil)
+		}
+		return value
+	})
+}
+
+// value substitutes the placeholders of the strings nested in the JSON value
+func (r *deviceTemplateRenderer) value(v any) any {
+	switch value := v.(type) {
+	case string:
+		return r.string(value)
+	case []any:
+		result := make([]any, len(value))
+		for i, element := range value {
+			result[i] = r.value(element)
+		}
+		return result
+	case map[string]any:
+		result := make(map[string]any, len(value))
+		for k, element := range value {
+			result[r.string(k)] = r.value(element)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// checkDeviceTemplateReferences checks that the device service and device profile of the device template exist
+func checkDeviceTemplateReferences(dbClient interfaces.DBClient, dt metadataModels.DeviceTemplate) errors.EdgeX {
+	exists, err := dbClient.DeviceServiceNameExists(dt.ServiceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device service '%s' of device template '%s' does not exist", dt.ServiceName, dt.Name), nil)
+	}
+	exists, err = dbClient.DeviceProfileNameExists(dt.ProfileName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' of device template '%s' does not exist", dt.ProfileName, dt.Name), nil)
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/graphql"
+)
+
+// graphQLDefaultReadingCount is the number of latest readings resolved for a device when the query doesn't limit them
+const graphQLDefaultReadingCount = 10
+
+// graphQLSchema is the schema of the GraphQL queries:
+//
+//	type Query {
+//	  device(name: String!): Device
+//	  devices(offset: Int = 0, limit: Int = 20, labels: [String]): [Device]
+//	  deviceProfile(name: String!): DeviceProfile
+//	  deviceService(name: String!): DeviceService
+//	}
+//
+// A Device has the fields of the device DTO, plus its profile, its service, and its latestReadings(resourceName: String,
+// limit: Int = 10), newest first. The leaf fields are those of the DTOs, the maps such as the protocols or the tags
+// being resolved as JSON objects.
+var graphQLSchema = newGraphQLSchema()
+
+// graphQLLoader reads the device profiles and the device services referenced by the devices of a query once per query,
+// however many devices reference them
+type graphQLLoader struct {
+	dic      *di.Container
+	profiles map[string]dtos.DeviceProfile
+	services map[string]dtos.DeviceService
+}
+
+// graphQLDevice is a device resolved by a query, with the loader of the query to resolve its profile and service
+type graphQLDevice struct {
+	dtos.Device
+	loader *graphQLLoader
+}
+
+// ExecuteGraphQL executes the GraphQL query against the devices, device profiles and device services, and the readings
+// of core-data
+func ExecuteGraphQL(ctx context.Context, request graphql.Request, dic *di.Container) graphql.Response {
+	loader := &graphQLLoader{
+		dic:      dic,
+		profiles: make(map[string]dtos.DeviceProfile),
+		services: make(map[string]dtos.DeviceService),
+	}
+	return graphQLSchema.Execute(ctx, request, loader)
+}
+
+func (l *graphQLLoader) deviceProfile(ctx context.Context, name string) (dtos.DeviceProfile, errors.EdgeX) {
+	if profile, exists := l.profiles[name]; exists {
+		return profile, nil
+	}
+	profile, err := DeviceProfileByName(name, ctx, l.dic)
+	if err != nil {
+		return profile, err
+	}
+	l.profiles[name] = profile
+	return profile, nil
+}
+
+func (l *graphQLLoader) deviceService(ctx context.Context, name string) (dtos.DeviceService, errors.EdgeX) {
+	if service, exists := l.services[name]; exists {
+		return service, nil
+	}
+	service, err := DeviceServiceByName(name, ctx, l.dic)
+	if err != nil {
+		return service, err
+	}
+	l.services[name] = service
+	return service, nil
+}
+
+// orNull returns nil instead of the value when the entity queried by name doesn't exist, or the error
+func orNull(value any, err errors.EdgeX) (any, error) {
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// checkCount returns an error if the value of the offset or limit argument is out of the range accepted by the REST API
+func checkCount(name string, value int, min int, max int) errors.EdgeX {
+	if value < min || value > max {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s %d is out of the range [%d, %d]", name, value, min, max), nil)
+	}
+	return nil
+}
+
+func graphQLLeafFields(names ...string) map[string]*graphql.FieldDefinition {
+	fields := make(map[string]*graphql.FieldDefinition, len(names))
+	for _, name := range names {
+		fields[name] = &graphql.FieldDefinition{}
+	}
+	return fields
+}
+
+func newGraphQLSchema() *graphql.Schema {
+	autoEvent := &graphql.Object{Name: "AutoEvent", Fields: graphQLLeafFields("interval", "onChange", "sourceName")}
+	reading := &graphql.Object{Name: "Reading", Fields: graphQLLeafFields("id", "origin", "deviceName", "resourceName",
+		"profileName", "valueType", "units", "tags", "value", "binaryValue", "mediaType", "objectValue")}
+	resourceProperties := &graphql.Object{Name: "ResourceProperties", Fields: graphQLLeafFields("valueType", "readWrite",
+		"units", "minimum", "maximum", "defaultValue", "mask", "shift", "scale", "offset", "base", "assertion", "mediaType",
+		"optional")}
+	resourceOperation := &graphql.Object{Name: "ResourceOperation", Fields: graphQLLeafFields("deviceResource",
+		"defaultValue", "mappings")}
+
+	deviceResource := &graphql.Object{Name: "DeviceResource", Fields: graphQLLeafFields("name", "description", "isHidden",
+		"attributes", "tags")}
+	deviceResource.Fields["properties"] = &graphql.FieldDefinition{Type: resourceProperties}
+
+	deviceCommand := &graphql.Object{Name: "DeviceCommand", Fields: graphQLLeafFields("name", "isHidden", "readWrite", "tags")}
+	deviceCommand.Fields["resourceOperations"] = &graphql.FieldDefinition{Type: resourceOperation, List: true}
+
+	deviceProfile := &graphql.Object{Name: "DeviceProfile", Fields: graphQLLeafFields("id", "name", "description",
+		"manufacturer", "model", "labels", "created", "modified")}
+	deviceProfile.Fields["deviceResources"] = &graphql.FieldDefinition{Type: deviceResource, List: true}
+	deviceProfile.Fields["deviceCommands"] = &graphql.FieldDefinition{Type: deviceCommand, List: true}
+
+	deviceService := &graphql.Object{Name: "DeviceService", Fields: graphQLLeafFields("id", "name", "description", "labels",
+		"baseAddress", "adminState", "created", "modified")}
+
+	device := &graphql.Object{Name: "Device", Fields: graphQLLeafFields("id", "name", "description", "adminState",
+		"operatingState", "labels", "location", "serviceName", "profileName", "protocols", "tags", "properties", "created",
+		"modified")}
+	device.Fields["autoEvents"] = &graphql.FieldDefinition{Type: autoEvent, List: true}
+	device.Fields["profile"] = &graphql.FieldDefinition{
+		Type: deviceProfile,
+		Resolve: func(ctx context.Context, source any, _ map[string]any) (any, error) {
+			d := source.(graphQLDevice)
+			profile, err := d.loader.deviceProfile(ctx, d.ProfileName)
+			if err != nil {
+				return nil, err
+			}
+			return profile, nil
+		},
+	}
+	device.Fields["service"] = &graphql.FieldDefinition{
+		Type: deviceService,
+		Resolve: func(ctx context.Context, source any, _ map[string]any) (any, error) {
+			d := source.(graphQLDevice)
+			service, err := d.loader.deviceService(ctx, d.ServiceName)
+			if err != nil {
+				return nil, err
+			}
+			return service, nil
+		},
+	}
+	device.Fields["latestReadings"] = &graphql.FieldDefinition{
+		Type: reading,
+		List: true,
+		Arguments: map[string]*graphql.ArgumentDefinition{
+			"resourceName": {Type: graphql.String},
+			"limit":        {Type: graphql.Int, DefaultValue: graphQLDefaultReadingCount},
+		},
+		Resolve: resolveLatestReadings,
+	}
+
+	query := &graphql.Object{Name: "Query", Fields: map[string]*graphql.FieldDefinition{
+		"device": {
+			Type:      device,
+			Arguments: map[string]*graphql.ArgumentDefinition{"name": {Type: graphql.String, Required: true}},
+			Resolve: func(_ context.Context, source any, args map[string]any) (any, error) {
+				loader := source.(*graphQLLoader)
+				d, err := DeviceByName(args["name"].(string), loader.dic)
+				return orNull(graphQLDevice{Device: d, loader: loader}, err)
+			},
+		},
+		"devices": {
+			Type: device,
+			List: true,
+			Arguments: map[string]*graphql.ArgumentDefinition{
+				"offset": {Type: graphql.Int, DefaultValue: common.DefaultOffset},
+				"limit":  {Type: graphql.Int, DefaultValue: common.DefaultLimit},
+				"labels": {Type: graphql.StringList},
+			},
+			Resolve: resolveDevices,
+		},
+		"deviceProfile": {
+			Type:      deviceProfile,
+			Arguments: map[string]*graphql.ArgumentDefinition{"name": {Type: graphql.String, Required: true}},
+			Resolve: func(ctx context.Context, source any, args map[string]any) (any, error) {
+				return orNull(source.(*graphQLLoader).deviceProfile(ctx, args["name"].(string)))
+			},
+		},
+		"deviceService": {
+			Type:      deviceService,
+			Arguments: map[string]*graphql.ArgumentDefinition{"name": {Type: graphql.String, Required: true}},
+			Resolve: func(ctx context.Context, source any, args map[string]any) (any, error) {
+				return orNull(source.(*graphQLLoader).deviceService(ctx, args["name"].(string)))
+			},
+		},
+	}}
+	return &graphql.Schema{Query: query}
+}
+
+func resolveDevices(_ context.Context, source any, args map[string]any) (any, error) {
+	loader := source.(*graphQLLoader)
+	maxResultCount := container.ConfigurationFrom(loader.dic.Get).Service.MaxResultCount
+	offset, limit := args["offset"].(int), args["limit"].(int)
+	if err := checkCount(common.Offset, offset, 0, math.MaxInt32); err != nil {
+		return nil, err
+	}
+	// like the REST API, -1 is the maximum of MaxResultCount
+	if err := checkCount(common.Limit, limit, -1, maxResultCount); err != nil {
+		return nil, err
+	}
+	if limit == -1 {
+		limit = maxResultCount
+	}
+	labels, _ := args["labels"].([]string)
+
+	devices, _, err := AllDevices(offset, limit, labels, loader.dic)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make([]graphQLDevice, len(devices))
+	for i, d := range devices {
+		resolved[i] = graphQLDevice{Device: d, loader: loader}
+	}
+	return resolved, nil
+}
+
+func resolveLatestReadings(ctx context.Context, source any, args map[string]any) (any, error) {
+	d := source.(graphQLDevice)
+	maxResultCount := container.ConfigurationFrom(d.loader.dic.Get).Service.MaxResultCount
+	limit := args["limit"].(int)
+	if err := checkCount(common.Limit, limit, 1, maxResultCount); err != nil {
+		return nil, err
+	}
+	client := bootstrapContainer.ReadingClientFrom(d.loader.dic.Get)
+	if client == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "the core-data client is not configured", nil)
+	}
+
+	if resourceName, ok := args["resourceName"].(string); ok {
+		response, err := client.ReadingsByDeviceNameAndResourceName(ctx, d.Name, resourceName, 0, limit)
+		if err != nil {
+			return nil, err
+		}
+		return response.Readings, nil
+	}
+	response, err := client.ReadingsByDeviceName(ctx, d.Name, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+	return response.Readings, nil
+}
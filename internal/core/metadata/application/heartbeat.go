@@ -0,0 +1,231 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const defaultHeartbeatMaxMissed = 3
+
+// heartbeatTracker tracks the liveness of the device services publishing heartbeats. It isn't safe for concurrent use,
+// the heartbeats and the checks being processed by a single goroutine.
+type heartbeatTracker struct {
+	// timeout is the duration without heartbeat after which a device service is considered down
+	timeout time.Duration
+	// lastHeartbeats are the times of the last heartbeat of the tracked device services
+	lastHeartbeats map[string]time.Time
+	// downDevices are the names of the devices marked DOWN by the tracker, by device service considered down. They are
+	// persisted in the database, so that the devices are marked UP again by the heartbeats received after a restart.
+	downDevices map[string][]string
+	dic         *di.Container
+}
+
+func newHeartbeatTracker(interval time.Duration, maxMissed int, dic *di.Container) *heartbeatTracker {
+	if maxMissed <= 0 {
+		maxMissed = defaultHeartbeatMaxMissed
+	}
+	t := &heartbeatTracker{
+		timeout:        interval * time.Duration(maxMissed),
+		lastHeartbeats: make(map[string]time.Time),
+		downDevices:    make(map[string][]string),
+		dic:            dic,
+	}
+	t.restoreDownDevices()
+	return t
+}
+
+// restoreDownDevices loads the devices marked DOWN by the tracker before a restart, dropping the ones of the device
+// services deleted meanwhile
+func (t *heartbeatTracker) restoreDownDevices() {
+	lc := bootstrapContainer.LoggingClientFrom(t.dic.Get)
+	dbClient := container.DBClientFrom(t.dic.Get)
+	downDevices, err := dbClient.DownDevicesOfServices()
+	if err != nil {
+		lc.Errorf("Failed to query the devices marked %s by the heartbeat tracking: %v", models.Down, err)
+		return
+	}
+	for serviceName, devices := range downDevices {
+		if _, err := dbClient.DeviceServiceByName(serviceName); errors.Kind(err) == errors.KindEntityDoesNotExist {
+			t.forgetDownDevices(serviceName)
+			continue
+		}
+		t.downDevices[serviceName] = devices
+		lc.Infof("Device service %s is still considered down, its devices %v are marked %s until its next heartbeat", serviceName, devices, models.Down)
+	}
+}
+
+// forgetDownDevices deletes the devices of the device service marked DOWN by the tracker from the database
+func (t *heartbeatTracker) forgetDownDevices(serviceName string) {
+	if err := container.DBClientFrom(t.dic.Get).DeleteDownDevicesOfService(serviceName); err != nil {
+		bootstrapContainer.LoggingClientFrom(t.dic.Get).Errorf("Failed to delete the devices of the device service %s marked %s: %v", serviceName, models.Down, err)
+	}
+}
+
+// beat records the heartbeat of the device service, which is up again if it was considered down. The heartbeats of
+// unknown device services are ignored.
+func (t *heartbeatTracker) beat(serviceName string, now time.Time, ctx context.Context) {
+	if _, ok := t.lastHeartbeats[serviceName]; !ok {
+		if _, err := container.DBClientFrom(t.dic.Get).DeviceServiceByName(serviceName); err != nil {
+			bootstrapContainer.LoggingClientFrom(t.dic.Get).Debugf("Ignoring the heartbeat of the device service %s: %v", serviceName, err)
+			return
+		}
+	}
+	t.lastHeartbeats[serviceName] = now
+
+	devices, down := t.downDevices[serviceName]
+	if !down {
+		return
+	}
+	delete(t.downDevices, serviceName)
+	bootstrapContainer.LoggingClientFrom(t.dic.Get).Infof("Device service %s is up again", serviceName)
+	restored := t.setOperatingState(devices, models.Down, models.Up, ctx)
+	t.forgetDownDevices(serviceName)
+	t.publish(serviceName, pkgCommon.DeviceServiceLivenessUp, now, restored, ctx)
+}
+
+// check marks the devices of the device services which missed too many heartbeats DOWN
+func (t *heartbeatTracker) check(now time.Time, ctx context.Context) {
+	lc := bootstrapContainer.LoggingClientFrom(t.dic.Get)
+	for serviceName, lastHeartbeat := range t.lastHeartbeats {
+		if _, down := t.downDevices[serviceName]; down || now.Sub(lastHeartbeat) <= t.timeout {
+			continue
+		}
+
+		// the device service may have been deleted meanwhile
+		if _, err := container.DBClientFrom(t.dic.Get).DeviceServiceByName(serviceName); err != nil {
+			if errors.Kind(err) == errors.KindEntityDoesNotExist {
+				delete(t.lastHeartbeats, serviceName)
+			}
+			continue
+		}
+		devices, err := container.DBClientFrom(t.dic.Get).DevicesByServiceName(0, -1, serviceName)
+		if err != nil {
+			lc.Errorf("Failed to query the devices of the device service %s: %v", serviceName, err)
+			continue
+		}
+
+		names := make([]string, 0, len(devices))
+		for _, d := range devices {
+			names = append(names, d.Name)
+		}
+		lc.Warnf("Device service %s missed its heartbeats since %s, marking its devices %s", serviceName, lastHeartbeat.Format(time.RFC3339), models.Down)
+		marked := t.setOperatingState(names, models.Up, models.Down, ctx)
+		t.downDevices[serviceName] = marked
+		if err = container.DBClientFrom(t.dic.Get).SetDownDevicesOfService(serviceName, marked); err != nil {
+			lc.Errorf("Failed to store the devices of the device service %s marked %s: %v", serviceName, models.Down, err)
+		}
+		t.publish(serviceName, pkgCommon.DeviceServiceLivenessDown, lastHeartbeat, marked, ctx)
+	}
+}
+
+// setOperatingState sets the operating state of the named devices in the from operating state to the to operating
+// state, and returns the names of the devices updated. Only the operating state of the devices is updated, so that the
+// concurrent updates of the devices aren't overwritten.
+func (t *heartbeatTracker) setOperatingState(names []string, from string, to string, ctx context.Context) []string {
+	lc := bootstrapContainer.LoggingClientFrom(t.dic.Get)
+	dbClient := container.DBClientFrom(t.dic.Get)
+	updated := make([]string, 0, len(names))
+	for _, name := range names {
+		d, ok, err := dbClient.UpdateDeviceOperatingState(name, models.OperatingState(from), models.OperatingState(to))
+		if err != nil {
+			lc.Errorf("Failed to mark the device %s %s: %v", name, to, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		deviceDTO := dtos.FromDeviceModelToDTO(d)
+		recordChange(common.DeviceSystemEventType, common.SystemEventActionUpdate, deviceDTO, ctx, t.dic)
+		go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, d.ServiceName, deviceDTO, ctx, t.dic)
+		updated = append(updated, name)
+	}
+	return updated
+}
+
+func (t *heartbeatTracker) publish(serviceName string, state string, lastHeartbeat time.Time, devices []string, ctx context.Context) {
+	liveness := metadataDTOs.DeviceServiceLiveness{
+		ServiceName:   serviceName,
+		State:         state,
+		LastHeartbeat: lastHeartbeat.UnixNano(),
+		Devices:       devices,
+	}
+	go publishSystemEvent(pkgCommon.DeviceServiceLivenessSystemEventType, state, serviceName, liveness, ctx, t.dic)
+}
+
+// StartHeartbeatTracking subscribes to the heartbeats of the device services and checks every interval whether any
+// missed too many of them, until the context is canceled
+func StartHeartbeatTracking(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	interval, err := time.ParseDuration(configuration.Heartbeat.Interval)
+	if err != nil || interval <= 0 {
+		lc.Errorf("Invalid Heartbeat.Interval '%s'", configuration.Heartbeat.Interval)
+		return false
+	}
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if messageBus == nil {
+		lc.Errorf("Heartbeat tracking requires the MessageBus: %v", noMessagingClientError)
+		return false
+	}
+
+	heartbeatTopic := common.BuildTopic(configuration.MessageBus.GetBaseTopicPrefix(), pkgCommon.DeviceServiceHeartbeatPublishTopic)
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []types.TopicChannel{
+		{
+			Topic:    common.BuildTopic(heartbeatTopic, "#"),
+			Messages: messages,
+		},
+	}
+	if err = messageBus.Subscribe(topics, messageErrors); err != nil {
+		lc.Errorf("Failed to subscribe to the device service heartbeats: %v", err)
+		return false
+	}
+	lc.Infof("Subscribing to the device service heartbeats on topic: %s", topics[0].Topic)
+
+	tracker := newHeartbeatTracker(interval, configuration.Heartbeat.MaxMissed, dic)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", topics[0].Topic)
+				return
+			case e := <-messageErrors:
+				lc.Error(e.Error())
+			case msgEnvelope := <-messages:
+				serviceName := strings.TrimPrefix(msgEnvelope.ReceivedTopic, heartbeatTopic+"/")
+				if serviceName == "" || strings.Contains(serviceName, "/") {
+					lc.Warnf("Ignoring the heartbeat received on topic '%s'", msgEnvelope.ReceivedTopic)
+					continue
+				}
+				tracker.beat(serviceName, time.Now(), context.Background())
+			case now := <-ticker.C:
+				tracker.check(now, context.Background())
+			}
+		}
+	}()
+	return true
+}
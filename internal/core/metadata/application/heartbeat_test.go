@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+)
+
+func TestHeartbeatTracker(t *testing.T) {
+	serviceName := "device-simple"
+	devices := map[string]*models.Device{
+		"up-device":   {Name: "up-device", ServiceName: serviceName, OperatingState: models.Up},
+		"down-device": {Name: "down-device", ServiceName: serviceName, OperatingState: models.Down},
+	}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceByName", serviceName).Return(models.DeviceService{Name: serviceName}, nil)
+	dbClientMock.On("DeviceServiceByName", "unknown").Return(models.DeviceService{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DevicesByServiceName", 0, -1, serviceName).Return([]models.Device{*devices["up-device"], *devices["down-device"]}, nil)
+	// only the devices in the from operating state are updated
+	var updated bool
+	dbClientMock.On("UpdateDeviceOperatingState", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(name string, from models.OperatingState, to models.OperatingState) models.Device {
+			updated = devices[name].OperatingState == from
+			if updated {
+				devices[name].OperatingState = to
+			}
+			return *devices[name]
+		},
+		func(string, models.OperatingState, models.OperatingState) bool {
+			return updated
+		},
+		nil)
+	storedDownDevices := map[string][]string{}
+	dbClientMock.On("DownDevicesOfServices").Return(func() map[string][]string { return storedDownDevices }, nil)
+	dbClientMock.On("SetDownDevicesOfService", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		storedDownDevices[args.String(0)] = args.Get(1).([]string)
+	}).Return(nil)
+	dbClientMock.On("DeleteDownDevicesOfService", mock.Anything).Run(func(args mock.Arguments) {
+		delete(storedDownDevices, args.String(0))
+	}).Return(nil)
+	messageBus := &mocks.MessageClient{}
+	messageBus.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return messageBus
+		},
+	})
+
+	tracker := newHeartbeatTracker(10*time.Second, 0, dic)
+	assert.Equal(t, 30*time.Second, tracker.timeout, "3 heartbeats should be missed by default")
+
+	start := time.Now()
+	tracker.beat("unknown", start, context.Background())
+	assert.NotContains(t, tracker.lastHeartbeats, "unknown", "the unknown device services shouldn't be tracked")
+	tracker.beat(serviceName, start, context.Background())
+
+	tracker.check(start.Add(30*time.Second), context.Background())
+	assert.Empty(t, tracker.downDevices)
+	assert.Equal(t, models.OperatingState(models.Up), devices["up-device"].OperatingState)
+
+	tracker.check(start.Add(31*time.Second), context.Background())
+	assert.Equal(t, map[string][]string{serviceName: {"up-device"}}, tracker.downDevices)
+	assert.Equal(t, storedDownDevices, tracker.downDevices, "the devices marked DOWN should be persisted")
+	assert.Equal(t, models.OperatingState(models.Down), devices["up-device"].OperatingState)
+
+	// the devices marked DOWN before a restart are marked UP again by the next heartbeat
+	tracker = newHeartbeatTracker(10*time.Second, 0, dic)
+	assert.Equal(t, map[string][]string{serviceName: {"up-device"}}, tracker.downDevices)
+
+	// only the devices marked DOWN by the tracker are marked UP again
+	devices["down-device"].OperatingState = models.Down
+	tracker.beat(serviceName, start.Add(40*time.Second), context.Background())
+	assert.Empty(t, tracker.downDevices)
+	assert.Empty(t, storedDownDevices)
+	assert.Equal(t, models.OperatingState(models.Up), devices["up-device"].OperatingState)
+	assert.Equal(t, models.OperatingState(models.Down), devices["down-device"].OperatingState)
+}
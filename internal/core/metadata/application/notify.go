@@ -20,6 +20,9 @@ import (
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 // validateDeviceCallback invoke device service's validation function for validating new or updated device
@@ -103,10 +106,28 @@ func publishSystemEvent(eventType, action, owner string, dto any, ctx context.Co
 			lc.Errorf("can not convert to device DTO")
 			return
 		}
+	case pkgCommon.DeviceLifecycleSystemEventType:
+		if transition, ok := dto.(metadataDTOs.DeviceLifecycleTransition); ok {
+			profileName = transition.Device.ProfileName
+			detailName = transition.Device.Name
+		} else {
+			lc.Errorf("can not convert to device lifecycle transition DTO")
+			return
+		}
+	case pkgCommon.DeviceServiceLivenessSystemEventType:
+		if liveness, ok := dto.(metadataDTOs.DeviceServiceLiveness); ok {
+			detailName = liveness.ServiceName
+		} else {
+			lc.Errorf("can not convert to device service liveness DTO")
+			return
+		}
 	case common.DeviceProfileSystemEventType:
 		if profile, ok := dto.(dtos.DeviceProfile); ok {
 			profileName = profile.Name
 			detailName = profile.Name
+			if action != common.SystemEventActionDelete {
+				systemEvent.Tags = deviceProfileVersionTags(profile.Name, dic)
+			}
 		} else {
 			lc.Errorf("can not convert to device profile DTO")
 			return
@@ -147,13 +168,18 @@ func publishSystemEvent(eventType, action, owner string, dto any, ctx context.Co
 	}
 
 	payload, _ := json.Marshal(systemEvent)
+	recordSystemEvent(publishTopic, payload, ctx, dic)
 	envelope := types.NewMessageEnvelope(payload, ctx)
 	// Correlation ID and Content type are set by the above factory function from the context of the request that
 	// triggered this System Event. We'll keep that Correlation ID, but need to make sure the Content Type is set appropriate
 	// for how the payload was encoded above.
 	envelope.ContentType = common.ContentTypeJSON
 
+	messageBusType := container.ConfigurationFrom(dic.Get).MessageBus.Type
+	_, span := tracing.TracerFrom(dic.Get).StartMessageSpan(ctx, tracing.SpanKindProducer, messageBusType, publishTopic, envelope)
+	defer span.End()
 	if err := messagingClient.Publish(envelope, publishTopic); err != nil {
+		span.SetError(err)
 		lc.Errorf("unable to publish '%s' System Event for %s '%s' to topic '%s': %v", action, eventType, detailName, publishTopic, err)
 		return
 	}
@@ -15,6 +15,9 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	mocks2 "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger/mocks"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
@@ -72,6 +75,8 @@ func TestPublishSystemEvent(t *testing.T) {
 
 	pubErrMsg := errors.NewCommonEdgeXWrapper(goErrors.New("publish failed"))
 	mockLogger := &mocks2.LoggingClient{}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileRevisionsByName", 0, 1, TestDeviceProfileName).Return([]metadataModels.DeviceProfileRevision{{Version: 3}}, nil)
 
 	dic := di.NewContainer(di.ServiceConstructorMap{
 		container.ConfigurationName: func(get di.Get) interface{} {
@@ -80,6 +85,9 @@ func TestPublishSystemEvent(t *testing.T) {
 		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
 			return mockLogger
 		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
 	})
 
 	for _, test := range tests {
@@ -107,6 +115,11 @@ func TestPublishSystemEvent(t *testing.T) {
 					require.NoError(t, err)
 					assert.Equal(t, expectedDeviceProfile.Name, actualDeviceProfile.Name)
 					assert.Equal(t, expectedDeviceProfile.Id, actualDeviceProfile.Id)
+					if test.Action == common.SystemEventActionDelete {
+						assert.Empty(t, systemEvent.Tags)
+					} else {
+						assert.Equal(t, "3", systemEvent.Tags[pkgCommon.ProfileVersion])
+					}
 				}
 
 				assert.Equal(t, common.ApiVersion, systemEvent.ApiVersion)
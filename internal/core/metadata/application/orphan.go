@@ -0,0 +1,206 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// DetectOrphans returns the devices and provision watchers which reference a device service, device profile or
+// auto event source which doesn't exist, and repairs them when repair is true. An orphan which fails to be repaired is
+// reported as not repaired.
+func DetectOrphans(repair bool, ctx context.Context, dic *di.Container) (orphans []metadataDTOs.Orphan, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	services, err := dbClient.AllDeviceServices(0, -1, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	profiles, err := dbClient.AllDeviceProfiles(0, -1, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices, err := dbClient.AllDevices(0, -1, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	pws, err := dbClient.AllProvisionWatchers(0, -1, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	serviceNames := make(map[string]bool, len(services))
+	for _, s := range services {
+		serviceNames[s.Name] = true
+	}
+	// the sources of each profile, i.e. the names of its device resources and device commands
+	sources := make(map[string]map[string]bool, len(profiles))
+	for _, p := range profiles {
+		sources[p.Name] = make(map[string]bool, len(p.DeviceResources)+len(p.DeviceCommands))
+		for _, r := range p.DeviceResources {
+			sources[p.Name][r.Name] = true
+		}
+		for _, c := range p.DeviceCommands {
+			sources[p.Name][c.Name] = true
+		}
+	}
+
+	orphans = make([]metadataDTOs.Orphan, 0)
+	for _, d := range devices {
+		deviceOrphans := make([]metadataDTOs.Orphan, 0)
+		if !serviceNames[d.ServiceName] {
+			deviceOrphans = append(deviceOrphans, metadataDTOs.Orphan{EntityType: common.DeviceSystemEventType, Name: d.Name, Kind: metadataDTOs.OrphanKindMissingDeviceService, Reference: d.ServiceName})
+		}
+		profileSources, ok := sources[d.ProfileName]
+		if !ok {
+			deviceOrphans = append(deviceOrphans, metadataDTOs.Orphan{EntityType: common.DeviceSystemEventType, Name: d.Name, Kind: metadataDTOs.OrphanKindMissingDeviceProfile, Reference: d.ProfileName})
+		}
+		if len(deviceOrphans) > 0 {
+			// the device is deleted, its auto events don't matter
+			if repair {
+				repaired := repairOrphan(func() errors.EdgeX { return DeleteDeviceByName(d.Name, ctx, dic) }, d.Name, ctx, dic)
+				for i := range deviceOrphans {
+					deviceOrphans[i].Repaired = repaired
+				}
+			}
+			orphans = append(orphans, deviceOrphans...)
+			continue
+		}
+
+		autoEvents := make([]models.AutoEvent, 0, len(d.AutoEvents))
+		for _, autoEvent := range d.AutoEvents {
+			if profileSources[autoEvent.SourceName] {
+				autoEvents = append(autoEvents, autoEvent)
+				continue
+			}
+			deviceOrphans = append(deviceOrphans, metadataDTOs.Orphan{EntityType: common.DeviceSystemEventType, Name: d.Name, Kind: metadataDTOs.OrphanKindMissingAutoEventSource, Reference: autoEvent.SourceName})
+		}
+		if repair && len(deviceOrphans) > 0 {
+			d := d
+			d.AutoEvents = autoEvents
+			repaired := repairOrphan(func() errors.EdgeX { return removeAutoEvents(d, ctx, dic) }, d.Name, ctx, dic)
+			for i := range deviceOrphans {
+				deviceOrphans[i].Repaired = repaired
+			}
+		}
+		orphans = append(orphans, deviceOrphans...)
+	}
+
+	for _, pw := range pws {
+		pwOrphans := make([]metadataDTOs.Orphan, 0)
+		if !serviceNames[pw.ServiceName] {
+			pwOrphans = append(pwOrphans, metadataDTOs.Orphan{EntityType: common.ProvisionWatcherSystemEventType, Name: pw.Name, Kind: metadataDTOs.OrphanKindMissingDeviceService, Reference: pw.ServiceName})
+		}
+		if _, ok := sources[pw.DiscoveredDevice.ProfileName]; !ok {
+			pwOrphans = append(pwOrphans, metadataDTOs.Orphan{EntityType: common.ProvisionWatcherSystemEventType, Name: pw.Name, Kind: metadataDTOs.OrphanKindMissingDeviceProfile, Reference: pw.DiscoveredDevice.ProfileName})
+		}
+		if repair && len(pwOrphans) > 0 {
+			name := pw.Name
+			repaired := repairOrphan(func() errors.EdgeX { return DeleteProvisionWatcherByName(ctx, name, dic) }, name, ctx, dic)
+			for i := range pwOrphans {
+				pwOrphans[i].Repaired = repaired
+			}
+		}
+		orphans = append(orphans, pwOrphans...)
+	}
+	return orphans, nil
+}
+
+// repairOrphan calls repair and returns whether it succeeded, logging its error
+func repairOrphan(repair func() errors.EdgeX, name string, ctx context.Context, dic *di.Container) bool {
+	if err := repair(); err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("Failed to repair the orphaned %s, Correlation-ID: %s, Error: %v", name, correlation.FromContext(ctx), err)
+		return false
+	}
+	return true
+}
+
+// removeAutoEvents updates the device whose auto events reading removed sources were removed. The device service
+// isn't asked to validate the device, since it only loses auto events which it can't execute.
+func removeAutoEvents(d models.Device, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if err := container.DBClientFrom(dic.Get).UpdateDevice(d); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceDTO := dtos.FromDeviceModelToDTO(d)
+	recordChange(common.DeviceSystemEventType, common.SystemEventActionUpdate, deviceDTO, ctx, dic)
+	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, d.ServiceName, deviceDTO, ctx, dic)
+	return nil
+}
+
+// cascadeDelete deletes the devices and provision watchers referencing the named device service or device profile,
+// which are queried by the given functions. The deletion stops at the first failure, the entities already deleted
+// remaining deleted.
+func cascadeDelete(
+	devicesByName func(offset int, limit int, name string) ([]models.Device, errors.EdgeX),
+	provisionWatchersByName func(offset int, limit int, name string) ([]models.ProvisionWatcher, errors.EdgeX),
+	name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	devices, err := devicesByName(0, -1, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, d := range devices {
+		if err = DeleteDeviceByName(d.Name, ctx, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	pws, err := provisionWatchersByName(0, -1, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, pw := range pws {
+		if err = DeleteProvisionWatcherByName(ctx, pw.Name, dic); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// StartOrphanDetection detects the orphans every interval until the context is canceled, logging them and repairing
+// them when configured to
+func StartOrphanDetection(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get).OrphanDetection
+	duration, err := time.ParseDuration(config.Interval)
+	if err != nil || duration <= 0 {
+		lc.Errorf("Orphan detection disabled, invalid interval '%s'", config.Interval)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(duration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				orphans, err := DetectOrphans(config.Repair, context.Background(), dic)
+				if err != nil {
+					lc.Errorf("Failed to detect the orphans: %v", err)
+					continue
+				}
+				for _, o := range orphans {
+					lc.Warnf("Orphaned %s %s, %s %s, repaired: %t", o.EntityType, o.Name, o.Kind, o.Reference, o.Repaired)
+				}
+			}
+		}
+	}()
+}
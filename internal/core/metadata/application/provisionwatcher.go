@@ -29,6 +29,9 @@ func AddProvisionWatcher(pw models.ProvisionWatcher, ctx context.Context, dic *d
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	correlationId := correlation.FromContext(ctx)
 
+	if err = validateAutoEvents(pw.DiscoveredDevice.AutoEvents); err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
 	addProvisionWatcher, err := dbClient.AddProvisionWatcher(pw)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
@@ -38,7 +41,9 @@ func AddProvisionWatcher(pw models.ProvisionWatcher, ctx context.Context, dic *d
 		addProvisionWatcher.Id,
 		correlationId,
 	)
-	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionAdd, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+	pwDTO := dtos.FromProvisionWatcherModelToDTO(pw)
+	recordChange(common.ProvisionWatcherSystemEventType, common.SystemEventActionAdd, pwDTO, ctx, dic)
+	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionAdd, pw.ServiceName, pwDTO, ctx, dic)
 	return addProvisionWatcher.Id, nil
 }
 
@@ -48,7 +53,7 @@ func ProvisionWatcherByName(name string, dic *di.Container) (provisionWatcher dt
 		return provisionWatcher, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
 
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	pw, err := dbClient.ProvisionWatcherByName(name)
 	if err != nil {
 		return provisionWatcher, errors.NewCommonEdgeXWrapper(err)
@@ -64,7 +69,7 @@ func ProvisionWatchersByServiceName(offset int, limit int, name string, dic *di.
 		return provisionWatchers, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
 
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	pwModels, err := dbClient.ProvisionWatchersByServiceName(offset, limit, name)
 	if err == nil {
 		totalCount, err = dbClient.ProvisionWatcherCountByServiceName(name)
@@ -85,7 +90,7 @@ func ProvisionWatchersByProfileName(offset int, limit int, name string, dic *di.
 		return provisionWatchers, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
 
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	pwModels, err := dbClient.ProvisionWatchersByProfileName(offset, limit, name)
 	if err == nil {
 		totalCount, err = dbClient.ProvisionWatcherCountByProfileName(name)
@@ -102,7 +107,7 @@ func ProvisionWatchersByProfileName(offset int, limit int, name string, dic *di.
 
 // AllProvisionWatchers query the provision watchers with offset, limit and labels
 func AllProvisionWatchers(offset int, limit int, labels []string, dic *di.Container) (provisionWatchers []dtos.ProvisionWatcher, totalCount uint32, err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	dbClient := container.QueryDBClientFrom(dic.Get)
 	pwModels, err := dbClient.AllProvisionWatchers(offset, limit, labels)
 	if err == nil {
 		totalCount, err = dbClient.ProvisionWatcherCountByLabels(labels)
@@ -131,7 +136,9 @@ func DeleteProvisionWatcherByName(ctx context.Context, name string, dic *di.Cont
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionDelete, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+	pwDTO := dtos.FromProvisionWatcherModelToDTO(pw)
+	recordChange(common.ProvisionWatcherSystemEventType, common.SystemEventActionDelete, pwDTO, ctx, dic)
+	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionDelete, pw.ServiceName, pwDTO, ctx, dic)
 	return nil
 }
 
@@ -152,6 +159,9 @@ func PatchProvisionWatcher(ctx context.Context, dto dtos.UpdateProvisionWatcher,
 	}
 
 	requests.ReplaceProvisionWatcherModelFieldsWithDTO(&pw, dto)
+	if err = validateAutoEvents(pw.DiscoveredDevice.AutoEvents); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	err = dbClient.UpdateProvisionWatcher(pw)
 	if err != nil {
@@ -160,10 +170,12 @@ func PatchProvisionWatcher(ctx context.Context, dto dtos.UpdateProvisionWatcher,
 
 	lc.Debugf("ProvisionWatcher patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
 
+	pwDTO := dtos.FromProvisionWatcherModelToDTO(pw)
+	recordChange(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, pwDTO, ctx, dic)
 	if oldServiceName != "" {
-		go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, oldServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+		go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, oldServiceName, pwDTO, ctx, dic)
 	}
-	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, pw.ServiceName, dtos.FromProvisionWatcherModelToDTO(pw), ctx, dic)
+	go publishSystemEvent(common.ProvisionWatcherSystemEventType, common.SystemEventActionUpdate, pw.ServiceName, pwDTO, ctx, dic)
 	return nil
 }
 
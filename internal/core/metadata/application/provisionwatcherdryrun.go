@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// DryRunProvisionWatchers matches the candidate device against the provision watchers of the device service, or all
+// the provision watchers when serviceName is empty, the same way the device services do on discovery. Nothing is
+// provisioned, the device each matching provision watcher would create is returned with the match.
+func DryRunProvisionWatchers(candidate metadataDTOs.CandidateDevice, serviceName string, dic *di.Container) (deviceExists bool, matches []metadataDTOs.ProvisionWatcherMatch, err errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+
+	var pws []models.ProvisionWatcher
+	if serviceName != "" {
+		pws, err = dbClient.ProvisionWatchersByServiceName(0, -1, serviceName)
+	} else {
+		pws, err = dbClient.AllProvisionWatchers(0, -1, nil)
+	}
+	if err != nil {
+		return false, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceExists, err = dbClient.DeviceNameExists(candidate.Name)
+	if err != nil {
+		return false, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	protocols := dtos.ToProtocolModels(candidate.Protocols)
+	matches = make([]metadataDTOs.ProvisionWatcherMatch, len(pws))
+	for i, pw := range pws {
+		matches[i] = metadataDTOs.ProvisionWatcherMatch{
+			ProvisionWatcherName: pw.Name,
+			ServiceName:          pw.ServiceName,
+		}
+		if reason := provisionWatcherMismatch(pw, protocols); reason != "" {
+			matches[i].Reason = reason
+			continue
+		}
+		device := dtos.FromDeviceModelToDTO(provisionedDevice(pw, candidate, protocols))
+		matches[i].Matched = true
+		matches[i].Device = &device
+	}
+	return deviceExists, matches, nil
+}
+
+// provisionWatcherMismatch returns why the device with the protocols doesn't match the provision watcher, empty when
+// it matches. The device matches when the values of one of its protocols match all the identifier regexes of the
+// provision watcher, and no value of its protocols is blocked by the blocking identifiers.
+func provisionWatcherMismatch(pw models.ProvisionWatcher, protocols map[string]models.ProtocolProperties) string {
+	if pw.AdminState == models.Locked {
+		return "provision watcher is locked"
+	}
+
+	regexes := make(map[string]*regexp.Regexp, len(pw.Identifiers))
+	for name, expr := range pw.Identifiers {
+		regex, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Sprintf("identifier %s regex '%s' is invalid: %v", name, expr, err)
+		}
+		regexes[name] = regex
+	}
+
+	// sort the protocol names so that the reason is stable
+	protocolNames := make([]string, 0, len(protocols))
+	for name := range protocols {
+		protocolNames = append(protocolNames, name)
+	}
+	sort.Strings(protocolNames)
+
+	identified := false
+	for _, protocolName := range protocolNames {
+		if protocolMatches(protocols[protocolName], regexes) {
+			identified = true
+			break
+		}
+	}
+	if !identified {
+		return "no protocol of the device matches all the identifiers"
+	}
+
+	for _, protocolName := range protocolNames {
+		for name, blocked := range pw.BlockingIdentifiers {
+			value, ok := protocols[protocolName][name]
+			if !ok {
+				continue
+			}
+			for _, v := range blocked {
+				if fmt.Sprintf("%v", value) == v {
+					return fmt.Sprintf("protocol %s property %s value '%s' is blocked", protocolName, name, v)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func protocolMatches(protocol models.ProtocolProperties, regexes map[string]*regexp.Regexp) bool {
+	for name, regex := range regexes {
+		value, ok := protocol[name]
+		if !ok || !regex.MatchString(fmt.Sprintf("%v", value)) {
+			return false
+		}
+	}
+	return true
+}
+
+// provisionedDevice returns the device the provision watcher creates from the candidate device
+func provisionedDevice(pw models.ProvisionWatcher, candidate metadataDTOs.CandidateDevice, protocols map[string]models.ProtocolProperties) models.Device {
+	return models.Device{
+		Name:           candidate.Name,
+		Description:    candidate.Description,
+		Labels:         candidate.Labels,
+		Protocols:      protocols,
+		ServiceName:    pw.ServiceName,
+		ProfileName:    pw.DiscoveredDevice.ProfileName,
+		AdminState:     pw.DiscoveredDevice.AdminState,
+		OperatingState: models.Up,
+		AutoEvents:     pw.DiscoveredDevice.AutoEvents,
+		Properties:     pw.DiscoveredDevice.Properties,
+	}
+}
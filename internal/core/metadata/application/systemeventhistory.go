@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// recordSystemEvent records the system event published to the topic in the system event history. The system event is
+// published whether it is recorded or not, so a failure to record it is only logged.
+func recordSystemEvent(topic string, payload []byte, ctx context.Context, dic *di.Container) {
+	maxEntries := container.ConfigurationFrom(dic.Get).Writable.SystemEventHistory.MaxEntries
+	if maxEntries <= 0 {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	entry := metadataModels.SystemEventHistoryEntry{Topic: topic, Payload: payload}
+	entry, err := container.DBClientFrom(dic.Get).AddSystemEventHistoryEntry(entry, maxEntries)
+	if err != nil {
+		lc.Errorf("unable to record the System Event published to topic '%s' in the history, Correlation-ID: %s, Error: %v", topic, correlation.FromContext(ctx), err)
+		return
+	}
+	lc.Debugf("System Event %d recorded in the history, topic: %s, Correlation-ID: %s", entry.Sequence, topic, correlation.FromContext(ctx))
+}
+
+// systemEventHistoryEntriesAfter returns at most limit entries recorded after the cursor, and the sequence of the
+// latest entry. An error is returned when entries after the cursor were already removed from the history.
+func systemEventHistoryEntriesAfter(cursor uint64, limit int, dic *di.Container) (entries []metadataModels.SystemEventHistoryEntry, latest uint64, err errors.EdgeX) {
+	dbClient := container.QueryDBClientFrom(dic.Get)
+
+	oldest, latest, err := dbClient.SystemEventHistorySequences()
+	if err != nil {
+		return nil, latest, errors.NewCommonEdgeXWrapper(err)
+	}
+	if oldest > cursor+1 {
+		return nil, latest, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable,
+			fmt.Sprintf("the system events after cursor %d are no longer available, the oldest system event is %d", cursor, oldest), nil)
+	}
+
+	entries, err = dbClient.SystemEventHistoryEntriesAfter(cursor, limit)
+	if err != nil {
+		return nil, latest, errors.NewCommonEdgeXWrapper(err)
+	}
+	return entries, latest, nil
+}
+
+// SystemEventHistory returns at most limit system events recorded after the cursor, in the order they were published,
+// with the cursor to request the next system events and the sequence of the latest system event, the same way as the
+// change feed
+func SystemEventHistory(cursor uint64, limit int, dic *di.Container) (systemEvents []metadataDTOs.SystemEventHistoryEntry, nextCursor uint64, latest uint64, err errors.EdgeX) {
+	entries, latest, err := systemEventHistoryEntriesAfter(cursor, limit, dic)
+	if err != nil {
+		return nil, cursor, latest, errors.NewCommonEdgeXWrapper(err)
+	}
+	nextCursor = cursor
+	systemEvents = make([]metadataDTOs.SystemEventHistoryEntry, len(entries))
+	for i, e := range entries {
+		systemEvent, decodeErr := metadataDTOs.FromSystemEventHistoryEntryModelToDTO(e)
+		if decodeErr != nil {
+			return nil, cursor, latest, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to decode the system event %d", e.Sequence), decodeErr)
+		}
+		systemEvents[i] = systemEvent
+		nextCursor = e.Sequence
+	}
+	return systemEvents, nextCursor, latest, nil
+}
+
+// ReplaySystemEvents publishes again at most limit system events recorded after the cursor to the topic, below the
+// MessageBus base topic, so that a consumer started after them can catch up. The levels of the original topic of each
+// system event following the system event topic are appended to the topic. It returns the number of system events
+// replayed, with the cursor to replay the next system events and the sequence of the latest system event. The replay
+// stops at the first system event which fails to be published.
+func ReplaySystemEvents(cursor uint64, limit int, topic string, ctx context.Context, dic *di.Container) (replayed int, nextCursor uint64, latest uint64, err errors.EdgeX) {
+	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if messagingClient == nil {
+		return 0, cursor, latest, errors.NewCommonEdgeX(errors.KindServerError, "unable to replay the System Events", noMessagingClientError)
+	}
+	entries, latest, err := systemEventHistoryEntriesAfter(cursor, limit, dic)
+	if err != nil {
+		return 0, cursor, latest, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	baseTopic := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
+	systemEventTopic := common.BuildTopic(baseTopic, common.SystemEventPublishTopic)
+	replayTopic := common.BuildTopic(baseTopic, topic)
+	nextCursor = cursor
+	for _, e := range entries {
+		publishTopic := replayTopic
+		if levels, ok := strings.CutPrefix(e.Topic, systemEventTopic+"/"); ok {
+			publishTopic = common.BuildTopic(replayTopic, levels)
+		}
+		envelope := types.NewMessageEnvelope(e.Payload, ctx)
+		envelope.ContentType = common.ContentTypeJSON
+		if publishErr := messagingClient.Publish(envelope, publishTopic); publishErr != nil {
+			return replayed, nextCursor, latest, errors.NewCommonEdgeX(errors.KindServerError,
+				fmt.Sprintf("failed to replay the system event %d to topic '%s'", e.Sequence, publishTopic), publishErr)
+		}
+		replayed++
+		nextCursor = e.Sequence
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("Replayed %d System Events to topic '%s', Correlation-ID: %s", replayed, replayTopic, correlation.FromContext(ctx))
+	return replayed, nextCursor, latest, nil
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+)
+
+// ValidateUnits validates each of the units against the units of measure, the same way as the units of the device
+// resources are when Writable.UoM.Validation is enabled
+func ValidateUnits(units []string, dic *di.Container) []metadataDTOs.UnitValidation {
+	strict := container.ConfigurationFrom(dic.Get).Writable.UoM.Strict
+	validations := make([]metadataDTOs.UnitValidation, len(units))
+	for i, unit := range units {
+		validations[i] = metadataDTOs.UnitValidation{Unit: unit, Valid: unitValid(unit, strict, dic)}
+	}
+	return validations
+}
+
+// unitValid returns whether the unit is valid against the units of measure. In strict mode, the unit must be one of the
+// units of measure, even when none is loaded.
+func unitValid(unit string, strict bool, dic *di.Container) bool {
+	if strict {
+		return container.UnitsOfMeasureFrom(dic.Get).Contains(unit)
+	}
+	return container.UnitsOfMeasureFrom(dic.Get).Validate(unit)
+}
+
+// deviceResourceUnitsIssue returns why the units of the device resource are invalid, empty when they are valid or
+// Writable.UoM.Validation is disabled. In strict mode, the device resources of a numeric value type must have units.
+func deviceResourceUnitsIssue(name string, units string, valueType string, dic *di.Container) string {
+	uomConfig := container.ConfigurationFrom(dic.Get).Writable.UoM
+	if !uomConfig.Validation {
+		return ""
+	}
+	if units == "" {
+		if uomConfig.Strict && isNumericValueType(valueType) {
+			return fmt.Sprintf("DeviceResource %s of %s value type has no units", name, valueType)
+		}
+		return ""
+	}
+	if !unitValid(units, uomConfig.Strict, dic) {
+		return fmt.Sprintf("DeviceResource %s units %s is invalid", name, units)
+	}
+	return ""
+}
+
+func isNumericValueType(valueType string) bool {
+	switch valueType {
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+		common.ValueTypeFloat32, common.ValueTypeFloat64:
+		return true
+	}
+	return false
+}
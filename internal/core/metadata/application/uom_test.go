@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/uom"
+)
+
+func TestDeviceResourceUnitsIssue(t *testing.T) {
+	tests := []struct {
+		name          string
+		uomConfig     config.WritableUoM
+		units         map[string]uom.Unit
+		resourceUnits string
+		valueType     string
+		issueExpected bool
+	}{
+		{"valid, validation disabled", config.WritableUoM{}, nil, "kW", common.ValueTypeFloat32, false},
+		{"valid, known units", config.WritableUoM{Validation: true}, map[string]uom.Unit{"temperature": {Values: []string{"C"}}}, "C", common.ValueTypeFloat32, false},
+		{"valid, no units of measure", config.WritableUoM{Validation: true}, nil, "kW", common.ValueTypeFloat32, false},
+		{"valid, numeric without units", config.WritableUoM{Validation: true}, nil, "", common.ValueTypeInt8, false},
+		{"valid, strict, string without units", config.WritableUoM{Validation: true, Strict: true}, nil, "", common.ValueTypeString, false},
+		{"invalid, unknown units", config.WritableUoM{Validation: true}, map[string]uom.Unit{"temperature": {Values: []string{"C"}}}, "kW", common.ValueTypeFloat32, true},
+		{"invalid, strict, no units of measure", config.WritableUoM{Validation: true, Strict: true}, nil, "kW", common.ValueTypeFloat32, true},
+		{"invalid, strict, numeric without units", config.WritableUoM{Validation: true, Strict: true}, nil, "", common.ValueTypeInt8, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{Writable: config.WritableInfo{UoM: testCase.uomConfig}}
+				},
+				container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+					return &uom.UnitsOfMeasureImpl{Units: testCase.units}
+				},
+			})
+
+			issue := deviceResourceUnitsIssue("resource", testCase.resourceUnits, testCase.valueType, dic)
+			assert.Equal(t, testCase.issueExpected, issue != "")
+		})
+	}
+}
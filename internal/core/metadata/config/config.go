@@ -17,24 +17,50 @@ package config
 
 import (
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 // Struct used to parse the JSON configuration file
 type ConfigurationStruct struct {
-	Writable   WritableInfo
-	Database   bootstrapConfig.Database
-	Registry   bootstrapConfig.RegistryInfo
-	Service    bootstrapConfig.ServiceInfo
-	MessageBus bootstrapConfig.MessageBusInfo
-	UoM        UoM
+	Writable        WritableInfo
+	Database        bootstrapConfig.Database
+	DatabasePool    db.PoolInfo
+	DatabaseReplica db.ReplicaInfo
+	Registry        bootstrapConfig.RegistryInfo
+	Service         bootstrapConfig.ServiceInfo
+	MessageBus      bootstrapConfig.MessageBusInfo
+	UoM             UoM
+	OrphanDetection OrphanDetectionInfo
+	Heartbeat       HeartbeatInfo
+	RBAC            rbac.Info
+	// MutualTLS configures mutual TLS on the REST API and for the requests to the other services
+	MutualTLS pkgHandlers.MutualTLSInfo
+	// Grpc configures the gRPC API served alongside the REST API
+	Grpc pkgHandlers.GrpcInfo
+	// Audit configures the audit log of the security relevant operations
+	Audit audit.Info
+	// APIKey configures the authentication of the headless clients with the API keys of the secret store
+	APIKey apikey.Info
+	// Tracing configures the distributed tracing spans exported to an OpenTelemetry collector
+	Tracing tracing.Info
+	// GraphQL configures the GraphQL endpoint querying the devices with their profiles and latest readings
+	GraphQL GraphQLInfo
 }
 
 type WritableInfo struct {
-	LogLevel        string
-	ProfileChange   ProfileChange
-	UoM             WritableUoM
-	InsecureSecrets bootstrapConfig.InsecureSecrets
-	Telemetry       bootstrapConfig.TelemetryInfo
+	LogLevel           string
+	ProfileChange      ProfileChange
+	UoM                WritableUoM
+	ChangeFeed         ChangeFeed
+	SystemEventHistory SystemEventHistory
+	InsecureSecrets    bootstrapConfig.InsecureSecrets
+	Telemetry          bootstrapConfig.TelemetryInfo
 }
 
 type ProfileChange struct {
@@ -42,12 +68,64 @@ type ProfileChange struct {
 	StrictDeviceProfileDeletes bool
 }
 
+// ChangeFeed configures the change feed recording the metadata mutations
+type ChangeFeed struct {
+	// MaxEntries is the number of the latest mutations kept in the change feed, 0 disables the change feed
+	MaxEntries int
+}
+
+// SystemEventHistory configures the history of the system events published by core-metadata, which the consumers
+// started after them can query or have replayed
+type SystemEventHistory struct {
+	// MaxEntries is the number of the latest system events kept in the history, 0 disables the history
+	MaxEntries int
+}
+
 type WritableUoM struct {
 	Validation bool
+	// Strict makes the validation reject the units which aren't units of measure even when no unit of measure is
+	// loaded, and the numeric device resources without units
+	Strict bool
 }
 
 type UoM struct {
+	// UoMFile is the path or the http(s) URI of the units of measure
 	UoMFile string
+	// AdditionalFiles are the paths or the http(s) URIs of units of measure merged into those of UoMFile
+	AdditionalFiles []string
+	// ReloadInterval is how often the units of measure are loaded again to apply their changes, i.e. 5m. Empty
+	// disables the reloading
+	ReloadInterval string
+}
+
+// OrphanDetectionInfo configures the background job detecting the devices and provision watchers which reference a
+// device service, device profile or device resource which no longer exists
+type OrphanDetectionInfo struct {
+	Enabled bool
+	// Interval is how often the orphans are detected, e.g. 1h
+	Interval string
+	// Repair deletes the orphaned devices and provision watchers, and the auto events reading removed sources, instead
+	// of only logging them
+	Repair bool
+}
+
+// HeartbeatInfo configures the liveness tracking of the device services publishing heartbeats to the MessageBus. A
+// device service which misses MaxMissed heartbeats in a row is considered down, its operational devices being marked
+// DOWN until it publishes a heartbeat again. The device services which never published a heartbeat aren't tracked.
+type HeartbeatInfo struct {
+	Enabled bool
+	// Interval is how often the device services publish their heartbeat, e.g. 10s
+	Interval string
+	// MaxMissed is the number of heartbeats a device service can miss in a row, defaults to 3
+	MaxMissed int
+}
+
+// GraphQLInfo configures the GraphQL endpoint, which resolves the devices with their device profiles and device
+// services, and their latest readings queried from core-data, in a single request
+type GraphQLInfo struct {
+	Enabled bool
+	// CoreData is where the latest readings of the devices are queried
+	CoreData bootstrapConfig.ClientInfo
 }
 
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
@@ -110,6 +188,16 @@ func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
 	return c.Database
 }
 
+// GetDatabasePoolInfo returns the configuration of the database connection pool.
+func (c *ConfigurationStruct) GetDatabasePoolInfo() db.PoolInfo {
+	return c.DatabasePool
+}
+
+// GetDatabaseReplicaInfo returns the location of the read-only replica of the database.
+func (c *ConfigurationStruct) GetDatabaseReplicaInfo() db.ReplicaInfo {
+	return c.DatabaseReplica
+}
+
 // GetInsecureSecrets returns the service's InsecureSecrets.
 func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
 	return c.Writable.InsecureSecrets
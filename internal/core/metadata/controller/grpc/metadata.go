@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/protoconv"
+	edgexpb "github.com/edgexfoundry/edgex-go/proto/edgex/v3"
+)
+
+// CoreMetadataServer implements the CoreMetadata gRPC service with the application of core-metadata
+type CoreMetadataServer struct {
+	edgexpb.UnimplementedCoreMetadataServer
+	dic *di.Container
+}
+
+// NewCoreMetadataServer creates and initializes a CoreMetadataServer
+func NewCoreMetadataServer(dic *di.Container) *CoreMetadataServer {
+	return &CoreMetadataServer{dic: dic}
+}
+
+func (s *CoreMetadataServer) DeviceByName(_ context.Context, req *edgexpb.NameRequest) (*edgexpb.Device, error) {
+	d, edgexErr := application.DeviceByName(req.GetName(), s.dic)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	device, err := protoconv.FromDeviceDTO(d)
+	if err != nil {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device", err))
+	}
+	return device, nil
+}
+
+func (s *CoreMetadataServer) AllDevices(_ context.Context, req *edgexpb.AllDevicesRequest) (*edgexpb.MultiDevicesResponse, error) {
+	config := container.ConfigurationFrom(s.dic.Get)
+	offset, limit, edgexErr := protoconv.Page(req.GetOffset(), req.GetLimit(), config.Service.MaxResultCount)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	devices, totalCount, edgexErr := application.AllDevices(offset, limit, req.GetLabels(), s.dic)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	res := &edgexpb.MultiDevicesResponse{TotalCount: totalCount, Devices: make([]*edgexpb.Device, len(devices))}
+	for i, d := range devices {
+		device, err := protoconv.FromDeviceDTO(d)
+		if err != nil {
+			return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the devices", err))
+		}
+		res.Devices[i] = device
+	}
+	return res, nil
+}
+
+func (s *CoreMetadataServer) DeviceProfileByName(ctx context.Context, req *edgexpb.NameRequest) (*edgexpb.DeviceProfile, error) {
+	p, edgexErr := application.DeviceProfileByName(req.GetName(), ctx, s.dic)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	profile, err := protoconv.FromDeviceProfileDTO(p)
+	if err != nil {
+		return nil, protoconv.Status(errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile", err))
+	}
+	return profile, nil
+}
+
+func (s *CoreMetadataServer) DeviceServiceByName(ctx context.Context, req *edgexpb.NameRequest) (*edgexpb.DeviceService, error) {
+	ds, edgexErr := application.DeviceServiceByName(req.GetName(), ctx, s.dic)
+	if edgexErr != nil {
+		return nil, protoconv.Status(edgexErr)
+	}
+	return protoconv.FromDeviceServiceDTO(ds), nil
+}
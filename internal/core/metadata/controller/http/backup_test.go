@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/backup"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func buildTestBackupContent() metadataDTOs.BackupContent {
+	bundle := buildTestBundle()
+	group := buildTestAddDeviceGroupRequest().Group
+	group.Devices = []string{bundle.Devices[0].Name}
+	template := buildTestAddDeviceTemplateRequest().Template
+	template.ServiceName = bundle.DeviceServices[0].Name
+	template.ProfileName = bundle.DeviceProfiles[0].Name
+	return metadataDTOs.BackupContent{
+		Bundle:          bundle,
+		DeviceGroups:    []metadataDTOs.DeviceGroup{group},
+		DeviceTemplates: []metadataDTOs.DeviceTemplate{template},
+	}
+}
+
+func TestBackup(t *testing.T) {
+	content := buildTestBackupContent()
+	bundle := content.Bundle
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllDeviceServices", 0, -1, []string(nil)).Return([]models.DeviceService{dtos.ToDeviceServiceModel(bundle.DeviceServices[0])}, nil)
+	dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return([]models.DeviceProfile{dtos.ToDeviceProfileModel(bundle.DeviceProfiles[0])}, nil)
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return([]models.Device{dtos.ToDeviceModel(bundle.Devices[0])}, nil)
+	dbClientMock.On("AllProvisionWatchers", 0, -1, []string(nil)).Return([]models.ProvisionWatcher{dtos.ToProvisionWatcherModel(bundle.ProvisionWatchers[0])}, nil)
+	dbClientMock.On("AllDeviceGroups", 0, -1).Return([]metadataModels.DeviceGroup{metadataDTOs.ToDeviceGroupModel(content.DeviceGroups[0])}, nil)
+	dbClientMock.On("AllDeviceTemplates", 0, -1).Return([]metadataModels.DeviceTemplate{metadataDTOs.ToDeviceTemplateModel(content.DeviceTemplates[0])}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewBackupController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiBackupRoute, http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.Backup)
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Contains(t, recorder.Header().Get("Content-Disposition"), "attachment")
+	var b backup.Backup
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &b))
+	assert.Equal(t, backup.FormatVersion, b.FormatVersion)
+	var backedUp metadataDTOs.BackupContent
+	require.NoError(t, b.Open(common.CoreMetaDataServiceKey, &backedUp))
+	assert.Equal(t, bundle.Devices[0].Name, backedUp.Bundle.Devices[0].Name)
+	assert.Equal(t, content.DeviceGroups[0].Name, backedUp.DeviceGroups[0].Name)
+	assert.Equal(t, content.DeviceTemplates[0].Name, backedUp.DeviceTemplates[0].Name)
+}
+
+func TestRestore(t *testing.T) {
+	newBackup := func(serviceKey string, content metadataDTOs.BackupContent) backup.Backup {
+		b, err := backup.New(serviceKey, content)
+		require.NoError(t, err)
+		return b
+	}
+	valid := newBackup(common.CoreMetaDataServiceKey, buildTestBackupContent())
+	otherService := newBackup(common.CoreDataServiceKey, buildTestBackupContent())
+	altered := valid
+	altered.Checksum = "altered"
+	unknownGroupDevice := buildTestBackupContent()
+	unknownGroupDevice.DeviceGroups[0].Devices = []string{"unknownDevice"}
+	existingTemplate := buildTestBackupContent()
+	existingTemplate.DeviceTemplates[0].Name = "existingTemplate"
+	failingTemplate := buildTestBackupContent()
+	failingTemplate.DeviceTemplates[0].Name = "failingTemplate"
+
+	tests := []struct {
+		name               string
+		backup             backup.Backup
+		expectedStatusCode int
+		expectedRollback   bool
+	}{
+		{"valid", valid, http.StatusCreated, false},
+		{"invalid, backup of another service", otherService, http.StatusBadRequest, false},
+		{"invalid, checksum mismatch", altered, http.StatusBadRequest, false},
+		{"invalid, unknown device of device group", newBackup(common.CoreMetaDataServiceKey, unknownGroupDevice), http.StatusBadRequest, false},
+		{"invalid, device template exists", newBackup(common.CoreMetaDataServiceKey, existingTemplate), http.StatusConflict, false},
+		{"rolled back, device template creation failed", newBackup(common.CoreMetaDataServiceKey, failingTemplate), http.StatusInternalServerError, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceServiceNameExists", mock.Anything).Return(false, nil)
+			dbClientMock.On("DeviceProfileNameExists", mock.Anything).Return(false, nil)
+			dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+			dbClientMock.On("ProvisionWatcherByName", mock.Anything).Return(models.ProvisionWatcher{},
+				errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "provision watcher does not exist", nil))
+			dbClientMock.On("DeviceGroupByName", mock.Anything).Return(metadataModels.DeviceGroup{},
+				errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device group does not exist", nil))
+			dbClientMock.On("DeviceTemplateByName", "existingTemplate").Return(metadataModels.DeviceTemplate{}, nil)
+			dbClientMock.On("DeviceTemplateByName", mock.Anything).Return(metadataModels.DeviceTemplate{},
+				errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device template does not exist", nil))
+			dbClientMock.On("AddDeviceService", mock.Anything).Return(models.DeviceService{}, nil)
+			dbClientMock.On("AddDeviceProfile", mock.Anything).Return(models.DeviceProfile{}, nil)
+			dbClientMock.On("AddDevice", mock.Anything).Return(models.Device{}, nil)
+			dbClientMock.On("AddProvisionWatcher", mock.Anything).Return(models.ProvisionWatcher{}, nil)
+			dbClientMock.On("AddDeviceGroup", mock.Anything).Return(metadataModels.DeviceGroup{}, nil)
+			dbClientMock.On("AddDeviceTemplate", mock.MatchedBy(func(dt metadataModels.DeviceTemplate) bool { return dt.Name == "failingTemplate" })).
+				Return(metadataModels.DeviceTemplate{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device template creation failed", nil))
+			dbClientMock.On("AddDeviceTemplate", mock.Anything).Return(metadataModels.DeviceTemplate{}, nil)
+			dbClientMock.On("DeleteDeviceServiceByName", mock.Anything).Return(nil)
+			dbClientMock.On("DeleteDeviceProfileByName", mock.Anything).Return(nil)
+			dbClientMock.On("DeleteDeviceByName", mock.Anything).Return(nil)
+			dbClientMock.On("DeleteProvisionWatcherByName", mock.Anything).Return(nil)
+			dbClientMock.On("DeleteDeviceGroupByName", mock.Anything).Return(nil)
+			dic := mockDic()
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+			controller := NewBackupController(dic)
+
+			body, err := json.Marshal(testCase.backup)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiBackupRoute, bytes.NewReader(body))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.Restore)
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode)
+			if testCase.expectedStatusCode == http.StatusCreated {
+				var res backup.RestoreResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				assert.Equal(t, 1, res.Restored["devices"])
+				assert.Equal(t, 1, res.Restored["deviceTemplates"])
+				dbClientMock.AssertNumberOfCalls(t, "AddDeviceGroup", 1)
+			} else if !testCase.expectedRollback {
+				dbClientMock.AssertNotCalled(t, "AddDeviceService", mock.Anything)
+			}
+			if testCase.expectedRollback {
+				dbClientMock.AssertCalled(t, "DeleteDeviceGroupByName", testDeviceGroupName)
+				dbClientMock.AssertCalled(t, "DeleteDeviceByName", TestDeviceName)
+				dbClientMock.AssertCalled(t, "DeleteDeviceServiceByName", testDeviceServiceName)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// bundleFileName is the name of the file the exported bundle is downloaded as
+const bundleFileName = "core-metadata-bundle.json"
+
+type BundleController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewBundleController creates and initializes an BundleController
+func NewBundleController(dic *di.Container) *BundleController {
+	return &BundleController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+func (bc *BundleController) ExportBundle(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(bc.dic.Get)
+	ctx := r.Context()
+
+	bundle, err := application.ExportBundle(bc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", bundleFileName))
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(bundle, w, lc)
+}
+
+func (bc *BundleController) ImportBundle(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(bc.dic.Get)
+	ctx := r.Context()
+
+	var bundle metadataDTOs.Bundle
+	if err := bc.reader.Read(r.Body, &bundle); err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to decode the bundle", err), "")
+		return
+	}
+	if err := application.ImportBundle(bundle, ctx, bc.dic); err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := metadataDTOs.NewBundleImportResponse("", "", http.StatusCreated, bundle)
+	utils.WriteHttpHeader(w, ctx, http.StatusCreated)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
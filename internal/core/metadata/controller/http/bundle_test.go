@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func buildTestBundle() metadataDTOs.Bundle {
+	device := buildTestDeviceRequest().Device
+	device.ServiceName = testDeviceServiceName
+	watcher := buildTestAddProvisionWatcherRequest().ProvisionWatcher
+	watcher.ServiceName = testDeviceServiceName
+	watcher.DiscoveredDevice.ProfileName = TestDeviceProfileName
+	return metadataDTOs.Bundle{
+		Versionable:       commonDTO.NewVersionable(),
+		BundleVersion:     metadataDTOs.BundleVersion,
+		DeviceServices:    []dtos.DeviceService{buildTestDeviceServiceRequest().Service},
+		DeviceProfiles:    []dtos.DeviceProfile{buildTestDeviceProfileRequest().Profile},
+		Devices:           []dtos.Device{device},
+		ProvisionWatchers: []dtos.ProvisionWatcher{watcher},
+	}
+}
+
+func TestExportBundle(t *testing.T) {
+	bundle := buildTestBundle()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllDeviceServices", 0, -1, []string(nil)).Return([]models.DeviceService{dtos.ToDeviceServiceModel(bundle.DeviceServices[0])}, nil)
+	dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return([]models.DeviceProfile{dtos.ToDeviceProfileModel(bundle.DeviceProfiles[0])}, nil)
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return([]models.Device{dtos.ToDeviceModel(bundle.Devices[0])}, nil)
+	dbClientMock.On("AllProvisionWatchers", 0, -1, []string(nil)).Return([]models.ProvisionWatcher{dtos.ToProvisionWatcherModel(bundle.ProvisionWatchers[0])}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewBundleController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiBundleRoute, http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.ExportBundle)
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Contains(t, recorder.Header().Get("Content-Disposition"), "attachment")
+	var exported metadataDTOs.Bundle
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &exported))
+	assert.Equal(t, metadataDTOs.BundleVersion, exported.BundleVersion)
+	assert.Equal(t, bundle.DeviceServices[0].Name, exported.DeviceServices[0].Name)
+	assert.Equal(t, bundle.DeviceProfiles[0].Name, exported.DeviceProfiles[0].Name)
+	assert.Equal(t, bundle.Devices[0].Name, exported.Devices[0].Name)
+	assert.Equal(t, bundle.ProvisionWatchers[0].Name, exported.ProvisionWatchers[0].Name)
+}
+
+func TestImportBundle(t *testing.T) {
+	valid := buildTestBundle()
+	unsupportedVersion := buildTestBundle()
+	unsupportedVersion.BundleVersion = metadataDTOs.BundleVersion + 1
+	existingService := buildTestBundle()
+	existingService.DeviceServices[0].Name = "existingService"
+	existingService.Devices[0].ServiceName = "existingService"
+	existingService.ProvisionWatchers[0].ServiceName = "existingService"
+	unknownProfile := buildTestBundle()
+	unknownProfile.Devices[0].ProfileName = "unknownProfile"
+	invalidDevice := buildTestBundle()
+	invalidDevice.Devices[0].AdminState = "invalidAdminState"
+	failingDevice := buildTestBundle()
+	failingDevice.Devices[0].Name = "failingDevice"
+
+	tests := []struct {
+		name               string
+		bundle             metadataDTOs.Bundle
+		expectedStatusCode int
+		expectedRollback   bool
+	}{
+		{"valid", valid, http.StatusCreated, false},
+		{"invalid, unsupported version", unsupportedVersion, http.StatusBadRequest, false},
+		{"invalid, device service exists", existingService, http.StatusConflict, false},
+		{"invalid, unknown device profile", unknownProfile, http.StatusBadRequest, false},
+		{"invalid, invalid device", invalidDevice, http.StatusBadRequest, false},
+		{"rolled back, device creation failed", failingDevice, http.StatusInternalServerError, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceServiceNameExists", "existingService").Return(true, nil)
+			dbClientMock.On("DeviceServiceNameExists", mock.Anything).Return(false, nil)
+			dbClientMock.On("DeviceProfileNameExists", mock.Anything).Return(false, nil)
+			dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+			dbClientMock.On("ProvisionWatcherByName", mock.Anything).Return(models.ProvisionWatcher{},
+				errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "provision watcher does not exist", nil))
+			dbClientMock.On("AddDeviceService", mock.Anything).Return(models.DeviceService{}, nil)
+			dbClientMock.On("AddDeviceProfile", mock.Anything).Return(models.DeviceProfile{}, nil)
+			dbClientMock.On("AddDevice", mock.MatchedBy(func(d models.Device) bool { return d.Name == "failingDevice" })).
+				Return(models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device creation failed", nil))
+			dbClientMock.On("AddDevice", mock.Anything).Return(models.Device{}, nil)
+			dbClientMock.On("AddProvisionWatcher", mock.Anything).Return(models.ProvisionWatcher{}, nil)
+			dbClientMock.On("DeleteDeviceServiceByName", testDeviceServiceName).Return(nil)
+			dbClientMock.On("DeleteDeviceProfileByName", TestDeviceProfileName).Return(nil)
+			dic := mockDic()
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+			controller := NewBundleController(dic)
+
+			body, err := json.Marshal(testCase.bundle)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiBundleRoute, bytes.NewReader(body))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.ImportBundle)
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode)
+			if testCase.expectedStatusCode == http.StatusCreated {
+				var res metadataDTOs.BundleImportResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				assert.Equal(t, 1, res.Devices)
+				dbClientMock.AssertNumberOfCalls(t, "AddProvisionWatcher", 1)
+			} else if !testCase.expectedRollback {
+				dbClientMock.AssertNotCalled(t, "AddDeviceService", mock.Anything)
+			}
+			if testCase.expectedRollback {
+				dbClientMock.AssertCalled(t, "DeleteDeviceProfileByName", TestDeviceProfileName)
+				dbClientMock.AssertCalled(t, "DeleteDeviceServiceByName", testDeviceServiceName)
+				dbClientMock.AssertNotCalled(t, "AddProvisionWatcher", mock.Anything)
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteDeviceServiceByName", mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type ChangeFeedController struct {
+	dic *di.Container
+}
+
+// NewChangeFeedController creates and initializes an ChangeFeedController
+func NewChangeFeedController(dic *di.Container) *ChangeFeedController {
+	return &ChangeFeedController{
+		dic: dic,
+	}
+}
+
+func (cc *ChangeFeedController) ChangeFeed(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(cc.dic.Get)
+
+	// parse URL query string for cursor and limit
+	cursor, err := utils.ParseQueryStringToInt(r, pkgCommon.Cursor, 0, 0, math.MaxInt)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	limit, err := utils.ParseQueryStringToInt(r, common.Limit, common.DefaultLimit, 1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	changes, nextCursor, latest, err := application.ChangeFeed(uint64(cursor), limit, cc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := metadataDTOs.NewChangeFeedResponse("", "", http.StatusOK, changes, nextCursor, latest)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func TestChangeFeed(t *testing.T) {
+	entries := []metadataModels.ChangeFeedEntry{
+		{Sequence: 5, Type: common.DeviceSystemEventType, Action: common.SystemEventActionAdd, Name: TestDeviceName},
+		{Sequence: 6, Type: common.DeviceProfileSystemEventType, Action: common.SystemEventActionUpdate, Name: TestDeviceProfileName},
+		{Sequence: 7, Type: common.DeviceSystemEventType, Action: common.SystemEventActionDelete, Name: TestDeviceName},
+	}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("ChangeFeedSequences").Return(uint64(5), uint64(7), nil)
+	dbClientMock.On("ChangeFeedEntriesAfter", uint64(4), 2).Return(entries[:2], nil)
+	dbClientMock.On("ChangeFeedEntriesAfter", uint64(6), 20).Return(entries[2:], nil)
+	dbClientMock.On("ChangeFeedEntriesAfter", uint64(7), 20).Return([]metadataModels.ChangeFeedEntry{}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewChangeFeedController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		cursor             string
+		limit              string
+		expectedSequences  []uint64
+		expectedNextCursor uint64
+		expectedStatusCode int
+	}{
+		{"Valid - first changes", "4", "2", []uint64{5, 6}, 6, http.StatusOK},
+		{"Valid - following changes with the default limit", "6", "", []uint64{7}, 7, http.StatusOK},
+		{"Valid - up to date", "7", "", []uint64{}, 7, http.StatusOK},
+		{"Invalid - changes no longer available", "3", "", nil, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - negative cursor", "-1", "", nil, 0, http.StatusBadRequest},
+		{"Invalid - non-numeric cursor", "abc", "", nil, 0, http.StatusBadRequest},
+		{"Invalid - limit greater than MaxResultCount", "4", "31", nil, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiChangeFeedRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(pkgCommon.Cursor, testCase.cursor)
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.ChangeFeed)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res metadataDTOs.ChangeFeedResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			sequences := make([]uint64, len(res.Changes))
+			for i, c := range res.Changes {
+				sequences[i] = c.Sequence
+			}
+			assert.Equal(t, testCase.expectedSequences, sequences)
+			assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+			assert.Equal(t, uint64(7), res.LatestSequence, "Latest sequence not as expected")
+		})
+	}
+}
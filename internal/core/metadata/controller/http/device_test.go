@@ -21,6 +21,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -166,6 +167,17 @@ func TestAddDevice(t *testing.T) {
 	emptyProtocols.Device.Protocols = map[string]dtos.ProtocolProperties{}
 	invalidProtocols := testDevice
 	invalidProtocols.Device.Protocols = map[string]dtos.ProtocolProperties{"others": {}}
+	invalidAttributes := testDevice
+	invalidAttributes.Device.Properties = map[string]any{pkgCommon.DeviceAttributes: map[string]any{"site": []any{"plant1"}}}
+	invalidLocation := testDevice
+	invalidLocation.Device.Location = map[string]any{"latitude": 48.85}
+	zeroInterval := testDevice
+	zeroInterval.Device.AutoEvents = []dtos.AutoEvent{{Interval: "0s", SourceName: "TestResource"}}
+	conflictingAutoEvents := testDevice
+	conflictingAutoEvents.Device.AutoEvents = []dtos.AutoEvent{
+		{Interval: "10s", SourceName: "TestResource"},
+		{Interval: "1m", SourceName: "TestResource", OnChange: true},
+	}
 
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
@@ -195,6 +207,10 @@ func TestAddDevice(t *testing.T) {
 		{"Invalid - empty protocols", []requests.AddDeviceRequest{emptyProtocols}, http.StatusBadRequest, http.StatusBadRequest, false, false},
 		{"Invalid - invalid protocols", []requests.AddDeviceRequest{invalidProtocols}, http.StatusMultiStatus, http.StatusInternalServerError, true, false},
 		{"Invalid - not found device service", []requests.AddDeviceRequest{notFoundService}, http.StatusMultiStatus, http.StatusBadRequest, false, false},
+		{"Invalid - invalid attributes", []requests.AddDeviceRequest{invalidAttributes}, http.StatusMultiStatus, http.StatusBadRequest, false, false},
+		{"Invalid - invalid location", []requests.AddDeviceRequest{invalidLocation}, http.StatusMultiStatus, http.StatusBadRequest, false, false},
+		{"Invalid - zero auto event interval", []requests.AddDeviceRequest{zeroInterval}, http.StatusMultiStatus, http.StatusBadRequest, false, false},
+		{"Invalid - conflicting auto events", []requests.AddDeviceRequest{conflictingAutoEvents}, http.StatusMultiStatus, http.StatusBadRequest, false, false},
 		{"Invalid - device service unavailable", []requests.AddDeviceRequest{valid}, http.StatusMultiStatus, http.StatusServiceUnavailable, true, false},
 	}
 	for _, testCase := range tests {
@@ -892,3 +908,70 @@ func TestDevicesByProfileName(t *testing.T) {
 		})
 	}
 }
+
+func TestDevicesByAttribute(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	device.Properties = map[string]any{pkgCommon.DeviceAttributes: map[string]any{"site": "plant1", "floor": float64(2)}}
+	devices := []models.Device{device, device}
+	expectedTotalCount := uint32(2)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceCountByAttribute", "site", "plant1").Return(expectedTotalCount, nil)
+	dbClientMock.On("DevicesByAttribute", 0, 5, "site", "plant1").Return(devices, nil)
+	dbClientMock.On("DevicesByAttribute", 1, 1, "site", "plant1").Return(devices[1:], nil)
+	dbClientMock.On("DevicesByAttribute", 4, 1, "site", "plant1").Return([]models.Device{}, edgexErr.NewCommonEdgeX(edgexErr.KindRangeNotSatisfiable, "query objects bounds out of range", nil))
+	dbClientMock.On("DeviceCountByAttribute", "site", "plant2").Return(uint32(0), nil)
+	dbClientMock.On("DevicesByAttribute", 0, 5, "site", "plant2").Return([]models.Device{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		key                string
+		value              string
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - get devices with attribute", "0", "5", "site", "plant1", 2, expectedTotalCount, http.StatusOK},
+		{"Valid - get devices with offset and limit", "1", "1", "site", "plant1", 1, expectedTotalCount, http.StatusOK},
+		{"Valid - no device with attribute", "0", "5", "site", "plant2", 0, 0, http.StatusOK},
+		{"Invalid - offset out of range", "4", "1", "site", "plant1", 0, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - empty attribute key", "0", "5", "", "plant1", 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceByAttributeRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{pkgCommon.Key: testCase.key, pkgCommon.Value: testCase.value})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DevicesByAttribute)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+			assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+		})
+	}
+}
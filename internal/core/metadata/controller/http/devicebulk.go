@@ -0,0 +1,171 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+func (dc *DeviceController) BulkAddDevices(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	mode, err := parseBulkMode(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	var reqDTOs []requestDTO.AddDeviceRequest
+	err = dc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	ids, errs, applied := application.BulkAddDevices(requestDTO.AddDeviceReqToDeviceModels(reqDTOs), mode == pkgCommon.BulkModeAtomic, ctx, dc.dic)
+	results := make([]metadataDTOs.BulkDeviceResult, len(reqDTOs))
+	for i, req := range reqDTOs {
+		results[i] = metadataDTOs.BulkDeviceResult{RequestId: req.RequestId, Name: req.Device.Name, Id: ids[i]}
+	}
+	writeBulkDevicesResponse(w, ctx, dc.dic, mode, results, errs, applied, http.StatusCreated)
+}
+
+func (dc *DeviceController) BulkPatchDevices(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	mode, err := parseBulkMode(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	var reqDTOs []requestDTO.UpdateDeviceRequest
+	err = dc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	updates := make([]dtos.UpdateDevice, len(reqDTOs))
+	results := make([]metadataDTOs.BulkDeviceResult, len(reqDTOs))
+	for i, req := range reqDTOs {
+		updates[i] = req.Device
+		results[i] = metadataDTOs.BulkDeviceResult{RequestId: req.RequestId}
+		if req.Device.Name != nil {
+			results[i].Name = *req.Device.Name
+		}
+		if req.Device.Id != nil {
+			results[i].Id = *req.Device.Id
+		}
+	}
+	errs, applied := application.BulkPatchDevices(updates, mode == pkgCommon.BulkModeAtomic, ctx, dc.dic)
+	writeBulkDevicesResponse(w, ctx, dc.dic, mode, results, errs, applied, http.StatusOK)
+}
+
+func (dc *DeviceController) BulkDeleteDevices(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	mode, err := parseBulkMode(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	var reqDTO metadataDTOs.DeleteDevicesRequest
+	err = dc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	errs, applied := application.BulkDeleteDevicesByName(reqDTO.DeviceNames, mode == pkgCommon.BulkModeAtomic, ctx, dc.dic)
+	results := make([]metadataDTOs.BulkDeviceResult, len(reqDTO.DeviceNames))
+	for i, name := range reqDTO.DeviceNames {
+		results[i] = metadataDTOs.BulkDeviceResult{RequestId: reqDTO.RequestId, Name: name}
+	}
+	writeBulkDevicesResponse(w, ctx, dc.dic, mode, results, errs, applied, http.StatusOK)
+}
+
+// writeBulkDevicesResponse fills the status of each device in the results and writes them with the status of the
+// whole operation: OK when all the devices succeeded, Multi-Status when some devices failed in best-effort mode, and
+// the status of the first failed device in atomic mode, the devices which didn't fail being reported as not applied.
+func writeBulkDevicesResponse(w http.ResponseWriter, ctx context.Context, dic *di.Container, mode string, results []metadataDTOs.BulkDeviceResult,
+	errs []errors.EdgeX, applied bool, successCode int) {
+	lc := container.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	statusCode := http.StatusOK
+	message := ""
+	failures := 0
+	for i, err := range errs {
+		switch {
+		case err != nil:
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			results[i].StatusCode = err.Code()
+			results[i].Message = err.Error()
+			if failures == 0 && !applied {
+				statusCode = err.Code()
+			}
+			failures++
+		case applied:
+			results[i].StatusCode = successCode
+		default:
+			results[i].StatusCode = http.StatusFailedDependency
+			results[i].Message = "not applied, the atomic bulk operation failed"
+		}
+	}
+	if failures > 0 {
+		if applied {
+			statusCode = http.StatusMultiStatus
+			message = fmt.Sprintf("%d of %d devices failed", failures, len(results))
+		} else {
+			message = fmt.Sprintf("%d of %d devices failed, no device is applied", failures, len(results))
+		}
+	}
+
+	response := metadataDTOs.NewBulkDevicesResponse("", message, statusCode, mode, results)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// parseBulkMode returns the mode of the bulk operation from the query string, atomic by default
+func parseBulkMode(r *http.Request) (string, errors.EdgeX) {
+	mode := utils.ParseQueryStringToString(r, pkgCommon.Mode, pkgCommon.BulkModeAtomic)
+	if mode != pkgCommon.BulkModeAtomic && mode != pkgCommon.BulkModeBestEffort {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("invalid mode '%s', the mode must be %s or %s", mode, pkgCommon.BulkModeAtomic, pkgCommon.BulkModeBestEffort), nil)
+	}
+	return mode, nil
+}
@@ -0,0 +1,221 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	edgexErr "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// mockDeviceValidationMessaging returns a MessageClient accepting the device validation requests and the system events
+func mockDeviceValidationMessaging(t *testing.T) *messagingMocks.MessageClient {
+	var responseEnvelope types.MessageEnvelope
+	mockMessaging := &messagingMocks.MessageClient{}
+	mockMessaging.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		requestEnvelope, ok := args.Get(0).(types.MessageEnvelope)
+		require.True(t, ok)
+		var err error
+		responseEnvelope, err = types.NewMessageEnvelopeForResponse(nil, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+		require.NoError(t, err)
+	}).Return(&responseEnvelope, nil)
+	mockMessaging.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	return mockMessaging
+}
+
+func TestBulkAddDevices(t *testing.T) {
+	valid1 := buildTestDeviceRequest()
+	valid1.Device.Id = "ba5c0eb4-2b1c-4e0d-9a5c-1e2d3c4b5a60"
+	valid1.Device.Name = "bulkDevice1"
+	valid2 := buildTestDeviceRequest()
+	valid2.Device.Id = "ba5c0eb4-2b1c-4e0d-9a5c-1e2d3c4b5a61"
+	valid2.Device.Name = "bulkDevice2"
+	failed := buildTestDeviceRequest()
+	failed.Device.Id = "ba5c0eb4-2b1c-4e0d-9a5c-1e2d3c4b5a62"
+	failed.Device.Name = "bulkDevice3"
+	deviceModels := requests.AddDeviceReqToDeviceModels([]requests.AddDeviceRequest{valid1, valid2, failed})
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", TestDeviceServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	for _, d := range deviceModels {
+		dbClientMock.On("DeviceNameExists", d.Name).Return(false, nil)
+		dbClientMock.On("DeleteDeviceByName", d.Name).Return(nil)
+	}
+	dbClientMock.On("AddDevice", deviceModels[0]).Return(deviceModels[0], nil)
+	dbClientMock.On("AddDevice", deviceModels[1]).Return(deviceModels[1], nil)
+	dbClientMock.On("AddDevice", deviceModels[2]).Return(deviceModels[2], edgexErr.NewCommonEdgeX(edgexErr.KindDatabaseError, "device creation failed", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return mockDeviceValidationMessaging(t)
+		},
+	})
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                string
+		mode                string
+		request             []requests.AddDeviceRequest
+		expectedStatusCode  int
+		expectedResultCodes []int
+		expectedRollback    bool
+	}{
+		{"Valid - atomic", pkgCommon.BulkModeAtomic, []requests.AddDeviceRequest{valid1, valid2}, http.StatusOK, []int{http.StatusCreated, http.StatusCreated}, false},
+		{"Valid - atomic by default", "", []requests.AddDeviceRequest{valid1}, http.StatusOK, []int{http.StatusCreated}, false},
+		{"Valid - best effort with failure", pkgCommon.BulkModeBestEffort, []requests.AddDeviceRequest{valid1, failed}, http.StatusMultiStatus, []int{http.StatusCreated, http.StatusInternalServerError}, false},
+		{"Invalid - atomic with failure", pkgCommon.BulkModeAtomic, []requests.AddDeviceRequest{valid1, failed, valid2}, http.StatusInternalServerError, []int{http.StatusFailedDependency, http.StatusInternalServerError, http.StatusFailedDependency}, true},
+		{"Invalid - atomic with duplicated device", pkgCommon.BulkModeAtomic, []requests.AddDeviceRequest{valid1, valid1}, http.StatusBadRequest, []int{http.StatusFailedDependency, http.StatusBadRequest}, false},
+		{"Invalid - unknown mode", "unknown", []requests.AddDeviceRequest{valid1}, http.StatusBadRequest, nil, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock.Calls = nil
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceBulkRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			if testCase.mode != "" {
+				query := req.URL.Query()
+				query.Add(pkgCommon.Mode, testCase.mode)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.BulkAddDevices)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedResultCodes == nil {
+				var res commonDTO.BaseResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res metadataDTOs.BulkDevicesResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			require.Len(t, res.Results, len(testCase.expectedResultCodes))
+			for i, code := range testCase.expectedResultCodes {
+				assert.Equal(t, testCase.request[i].Device.Name, res.Results[i].Name)
+				assert.Equal(t, code, res.Results[i].StatusCode, "Result status code not as expected")
+			}
+			if testCase.expectedRollback {
+				dbClientMock.AssertCalled(t, "DeleteDeviceByName", valid1.Device.Name)
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteDeviceByName", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestBulkDeleteDevices(t *testing.T) {
+	device1 := requests.AddDeviceReqToDeviceModels([]requests.AddDeviceRequest{buildTestDeviceRequest()})[0]
+	device1.Name = "bulkDevice1"
+	device2 := device1
+	device2.Name = "bulkDevice2"
+	failed := device1
+	failed.Name = "bulkDevice3"
+	notFoundName := "notFoundDevice"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	for _, d := range []models.Device{device1, device2, failed} {
+		dbClientMock.On("DeviceByName", d.Name).Return(d, nil)
+	}
+	dbClientMock.On("DeviceByName", notFoundName).Return(models.Device{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist", nil))
+	dbClientMock.On("DeleteDeviceByName", device1.Name).Return(nil)
+	dbClientMock.On("DeleteDeviceByName", device2.Name).Return(nil)
+	dbClientMock.On("DeleteDeviceByName", failed.Name).Return(edgexErr.NewCommonEdgeX(edgexErr.KindDatabaseError, "device deletion failed", nil))
+	dbClientMock.On("AddDevice", device1).Return(device1, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return mockDeviceValidationMessaging(t)
+		},
+	})
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                string
+		mode                string
+		deviceNames         []string
+		expectedStatusCode  int
+		expectedResultCodes []int
+		expectedRollback    bool
+	}{
+		{"Valid - atomic", pkgCommon.BulkModeAtomic, []string{device1.Name, device2.Name}, http.StatusOK, []int{http.StatusOK, http.StatusOK}, false},
+		{"Valid - best effort with not found device", pkgCommon.BulkModeBestEffort, []string{device1.Name, notFoundName}, http.StatusMultiStatus, []int{http.StatusOK, http.StatusNotFound}, false},
+		{"Invalid - atomic with not found device", pkgCommon.BulkModeAtomic, []string{device1.Name, notFoundName}, http.StatusNotFound, []int{http.StatusFailedDependency, http.StatusNotFound}, false},
+		{"Invalid - atomic with failure", pkgCommon.BulkModeAtomic, []string{device1.Name, failed.Name}, http.StatusInternalServerError, []int{http.StatusFailedDependency, http.StatusInternalServerError}, true},
+		{"Invalid - no device names", pkgCommon.BulkModeAtomic, []string{}, http.StatusBadRequest, nil, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock.Calls = nil
+			reqDTO := metadataDTOs.DeleteDevicesRequest{BaseRequest: commonDTO.NewBaseRequest(), DeviceNames: testCase.deviceNames}
+			jsonData, err := json.Marshal(reqDTO)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodDelete, pkgCommon.ApiDeviceBulkRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(pkgCommon.Mode, testCase.mode)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.BulkDeleteDevices)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedResultCodes == nil {
+				return
+			}
+			var res metadataDTOs.BulkDevicesResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			require.Len(t, res.Results, len(testCase.expectedResultCodes))
+			for i, code := range testCase.expectedResultCodes {
+				assert.Equal(t, testCase.deviceNames[i], res.Results[i].Name)
+				assert.Equal(t, code, res.Results[i].StatusCode, "Result status code not as expected")
+			}
+			if testCase.expectedRollback {
+				dbClientMock.AssertCalled(t, "AddDevice", device1)
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddDevice", mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const testDeviceGroupName = "TestDeviceGroup"
+
+func buildTestAddDeviceGroupRequest() metadataDTOs.AddDeviceGroupRequest {
+	return metadataDTOs.AddDeviceGroupRequest{
+		BaseRequest: commonDTO.BaseRequest{
+			RequestId:   ExampleUUID,
+			Versionable: commonDTO.NewVersionable(),
+		},
+		Group: metadataDTOs.DeviceGroup{
+			Name:    testDeviceGroupName,
+			Devices: []string{TestDeviceName},
+			Labels:  []string{"floor1"},
+		},
+	}
+}
+
+func TestAddDeviceGroup(t *testing.T) {
+	valid := buildTestAddDeviceGroupRequest()
+	noMembers := valid
+	noMembers.Group.Devices = nil
+	noMembers.Group.Labels = nil
+	noName := valid
+	noName.Group.Name = ""
+	unknownDevice := valid
+	unknownDevice.Group.Name = "unknownDevice"
+	unknownDevice.Group.Devices = []string{"unknown"}
+	duplicate := valid
+	duplicate.Group.Name = "duplicate"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceNameExists", TestDeviceName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", "unknown").Return(false, nil)
+	dbClientMock.On("AddDeviceGroup", mock.MatchedBy(func(dg metadataModels.DeviceGroup) bool { return dg.Name == testDeviceGroupName })).
+		Return(metadataModels.DeviceGroup{Id: ExampleUUID}, nil)
+	dbClientMock.On("AddDeviceGroup", mock.MatchedBy(func(dg metadataModels.DeviceGroup) bool { return dg.Name == "duplicate" })).
+		Return(metadataModels.DeviceGroup{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "device group name exists", nil))
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceGroupController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		request            []metadataDTOs.AddDeviceGroupRequest
+		expectedBadRequest bool
+		expectedStatusCode int
+	}{
+		{"Valid", []metadataDTOs.AddDeviceGroupRequest{valid}, false, http.StatusCreated},
+		{"Invalid - no device nor label", []metadataDTOs.AddDeviceGroupRequest{noMembers}, true, http.StatusBadRequest},
+		{"Invalid - no name", []metadataDTOs.AddDeviceGroupRequest{noName}, true, http.StatusBadRequest},
+		{"Invalid - unknown device", []metadataDTOs.AddDeviceGroupRequest{unknownDevice}, false, http.StatusNotFound},
+		{"Invalid - duplicate name", []metadataDTOs.AddDeviceGroupRequest{duplicate}, false, http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceGroupRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddDeviceGroup)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.expectedBadRequest {
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				return
+			}
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, int(res[0].StatusCode), "BaseResponse status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, ExampleUUID, res[0].Id)
+			}
+		})
+	}
+}
+
+func TestDevicesByGroupName(t *testing.T) {
+	device1 := models.Device{Name: "device1", Labels: []string{"floor1"}}
+	device2 := models.Device{Name: "device2", Labels: []string{"floor1"}}
+	device3 := models.Device{Name: "device3"}
+	group := metadataModels.DeviceGroup{Name: testDeviceGroupName, Devices: []string{"device3", "deleted", "device1"}, Labels: []string{"floor1"}}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceGroupByName", testDeviceGroupName).Return(group, nil)
+	dbClientMock.On("DeviceGroupByName", "unknown").Return(metadataModels.DeviceGroup{}, notFound)
+	dbClientMock.On("DeviceByName", "device1").Return(device1, nil)
+	dbClientMock.On("DeviceByName", "device3").Return(device3, nil)
+	dbClientMock.On("DeviceByName", "deleted").Return(models.Device{}, notFound)
+	dbClientMock.On("AllDevices", 0, -1, []string{"floor1"}).Return([]models.Device{device1, device2}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceGroupController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		groupName          string
+		offset             string
+		limit              string
+		expectedDevices    []string
+		expectedStatusCode int
+	}{
+		{"Valid - named devices first, then labeled devices", testDeviceGroupName, "0", "10", []string{"device3", "device1", "device2"}, http.StatusOK},
+		{"Valid - with offset and limit", testDeviceGroupName, "1", "1", []string{"device1"}, http.StatusOK},
+		{"Invalid - offset out of range", testDeviceGroupName, "4", "1", nil, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - unknown group", "unknown", "0", "10", nil, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceByGroupNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.groupName})
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DevicesByGroupName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(3), res.TotalCount, "Total count not as expected")
+			names := make([]string, len(res.Devices))
+			for i, d := range res.Devices {
+				names[i] = d.Name
+			}
+			assert.Equal(t, testCase.expectedDevices, names)
+		})
+	}
+}
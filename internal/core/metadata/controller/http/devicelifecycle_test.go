@@ -0,0 +1,213 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	edgexErr "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func TestTransitionDeviceLifecycleState(t *testing.T) {
+	withState := func(name string, state string) models.Device {
+		device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+		device.Name = name
+		device.Properties = map[string]any{"TestProperty1": "property1"}
+		if state != "" {
+			device.Properties[pkgCommon.DeviceLifecycleState] = state
+		}
+		return device
+	}
+	provisioned := withState("provisionedDevice", pkgCommon.DeviceLifecycleStateProvisioned)
+	retired := withState("retiredDevice", pkgCommon.DeviceLifecycleStateRetired)
+	untracked := withState("untrackedDevice", "")
+	notFound := "notFoundDevice"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	for _, d := range []models.Device{provisioned, retired, untracked} {
+		dbClientMock.On("DeviceByName", d.Name).Return(d, nil)
+	}
+	dbClientMock.On("DeviceByName", notFound).Return(models.Device{}, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeviceServiceNameExists", TestDeviceServiceName).Return(true, nil)
+	dbClientMock.On("UpdateDevice", mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		state              string
+		expectedStatusCode int
+		expectedTransition bool
+	}{
+		{"Valid - provisioned to commissioned", provisioned.Name, pkgCommon.DeviceLifecycleStateCommissioned, http.StatusOK, true},
+		{"Valid - provisioned to retired", provisioned.Name, pkgCommon.DeviceLifecycleStateRetired, http.StatusOK, true},
+		{"Valid - same state", provisioned.Name, pkgCommon.DeviceLifecycleStateProvisioned, http.StatusOK, false},
+		{"Valid - device without state enters the lifecycle", untracked.Name, pkgCommon.DeviceLifecycleStateActive, http.StatusOK, true},
+		{"Invalid - provisioned to active", provisioned.Name, pkgCommon.DeviceLifecycleStateActive, http.StatusConflict, false},
+		{"Invalid - retired to active", retired.Name, pkgCommon.DeviceLifecycleStateActive, http.StatusConflict, false},
+		{"Invalid - unknown state", provisioned.Name, "decommissioned", http.StatusBadRequest, false},
+		{"Invalid - device not found", notFound, pkgCommon.DeviceLifecycleStateActive, http.StatusNotFound, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var err error
+			topics := make(chan string, 2)
+			var responseEnvelope types.MessageEnvelope
+			mockMessaging := &messagingMocks.MessageClient{}
+			mockMessaging.On("Request", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				requestEnvelope, ok := args.Get(0).(types.MessageEnvelope)
+				require.True(t, ok)
+				responseEnvelope, err = types.NewMessageEnvelopeForResponse(nil, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+				require.NoError(t, err)
+			}).Return(&responseEnvelope, nil)
+			mockMessaging.On("Publish", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				topics <- args.String(1)
+			}).Return(nil)
+			dic.Update(di.ServiceConstructorMap{
+				bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+					return mockMessaging
+				},
+			})
+
+			req, err := http.NewRequest(http.MethodPut, pkgCommon.ApiDeviceLifecycleStateByNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.deviceName, pkgCommon.State: testCase.state})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.TransitionDeviceLifecycleState)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+
+			expectedCount := 1
+			if testCase.expectedTransition {
+				expectedCount = 2
+			}
+			var published []string
+			for i := 0; i < expectedCount; i++ {
+				select {
+				case topic := <-topics:
+					published = append(published, topic)
+				case <-time.After(time.Second):
+					require.Fail(t, "system event not published")
+				}
+			}
+			lifecycleTopic := common.BuildTopic(pkgCommon.DeviceLifecycleSystemEventType, testCase.state)
+			transitioned := false
+			for _, topic := range published {
+				if strings.Contains(topic, lifecycleTopic) {
+					transitioned = true
+				}
+			}
+			assert.Equal(t, testCase.expectedTransition, transitioned, "Lifecycle system event not as expected")
+		})
+	}
+}
+
+func TestDevicesByLifecycleState(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	device.Properties = map[string]any{pkgCommon.DeviceLifecycleState: pkgCommon.DeviceLifecycleStateActive}
+	devices := []models.Device{device, device}
+	expectedTotalCount := uint32(2)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceCountByLifecycleState", pkgCommon.DeviceLifecycleStateActive).Return(expectedTotalCount, nil)
+	dbClientMock.On("DevicesByLifecycleState", 0, 5, pkgCommon.DeviceLifecycleStateActive).Return(devices, nil)
+	dbClientMock.On("DevicesByLifecycleState", 1, 1, pkgCommon.DeviceLifecycleStateActive).Return(devices[1:], nil)
+	dbClientMock.On("DevicesByLifecycleState", 4, 1, pkgCommon.DeviceLifecycleStateActive).Return([]models.Device{}, edgexErr.NewCommonEdgeX(edgexErr.KindRangeNotSatisfiable, "query objects bounds out of range", nil))
+	dbClientMock.On("DeviceCountByLifecycleState", pkgCommon.DeviceLifecycleStateRetired).Return(uint32(0), nil)
+	dbClientMock.On("DevicesByLifecycleState", 0, 5, pkgCommon.DeviceLifecycleStateRetired).Return([]models.Device{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		state              string
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - get devices in lifecycle state", "0", "5", pkgCommon.DeviceLifecycleStateActive, 2, expectedTotalCount, http.StatusOK},
+		{"Valid - get devices with offset and limit", "1", "1", pkgCommon.DeviceLifecycleStateActive, 1, expectedTotalCount, http.StatusOK},
+		{"Valid - no device in lifecycle state", "0", "5", pkgCommon.DeviceLifecycleStateRetired, 0, 0, http.StatusOK},
+		{"Invalid - offset out of range", "4", "1", pkgCommon.DeviceLifecycleStateActive, 0, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - unknown lifecycle state", "0", "5", "decommissioned", 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceByLifecycleStateRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{pkgCommon.State: testCase.state})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DevicesByLifecycleState)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+			assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+		})
+	}
+}
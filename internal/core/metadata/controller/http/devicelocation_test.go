@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	edgexErr "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func TestDevicesByLocationRadius(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	device.Location = map[string]any{"latitude": 48.85, "longitude": 2.35}
+	devices := []models.Device{device, device}
+	expectedTotalCount := uint32(2)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByLocationRadius", 0, 5, 48.85, 2.35, float64(500)).Return(devices, expectedTotalCount, nil)
+	dbClientMock.On("DevicesByLocationRadius", 1, 1, 48.85, 2.35, float64(500)).Return(devices[1:], expectedTotalCount, nil)
+	dbClientMock.On("DevicesByLocationRadius", 4, 1, 48.85, 2.35, float64(500)).Return([]models.Device{}, expectedTotalCount, edgexErr.NewCommonEdgeX(edgexErr.KindRangeNotSatisfiable, "query objects bounds out of range", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		latitude           string
+		longitude          string
+		radius             string
+		expectedCount      int
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - get devices within radius", "0", "5", "48.85", "2.35", "500", 2, expectedTotalCount, http.StatusOK},
+		{"Valid - get devices with offset and limit", "1", "1", "48.85", "2.35", "500", 1, expectedTotalCount, http.StatusOK},
+		{"Invalid - offset out of range", "4", "1", "48.85", "2.35", "500", 0, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - missing latitude", "0", "5", "", "2.35", "500", 0, 0, http.StatusBadRequest},
+		{"Invalid - latitude out of range", "0", "5", "89", "2.35", "500", 0, 0, http.StatusBadRequest},
+		{"Invalid - longitude not a number", "0", "5", "48.85", "east", "500", 0, 0, http.StatusBadRequest},
+		{"Invalid - zero radius", "0", "5", "48.85", "2.35", "0", 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceByLocationRadiusRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			if testCase.latitude != "" {
+				query.Add(pkgCommon.Latitude, testCase.latitude)
+			}
+			query.Add(pkgCommon.Longitude, testCase.longitude)
+			query.Add(pkgCommon.Radius, testCase.radius)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DevicesByLocationRadius)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+			assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+		})
+	}
+}
+
+func TestDevicesByLocationBox(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	device.Location = map[string]any{"latitude": 48.85, "longitude": 2.35}
+	devices := []models.Device{device}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByLocationBox", 0, 5, 48.0, 2.0, 49.0, 3.0).Return(devices, uint32(1), nil)
+	dbClientMock.On("DevicesByLocationBox", 0, 5, -10.0, -10.0, -5.0, -5.0).Return([]models.Device{}, uint32(0), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		minLatitude        string
+		minLongitude       string
+		maxLatitude        string
+		maxLongitude       string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - get devices within bounding box", "48", "2", "49", "3", 1, http.StatusOK},
+		{"Valid - no device within bounding box", "-10", "-10", "-5", "-5", 0, http.StatusOK},
+		{"Invalid - missing maxLongitude", "48", "2", "49", "", 0, http.StatusBadRequest},
+		{"Invalid - minLatitude greater than maxLatitude", "49", "2", "48", "3", 0, http.StatusBadRequest},
+		{"Invalid - bounding box crossing the antimeridian", "48", "179", "49", "-179", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceByLocationBoxRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, "0")
+			query.Add(common.Limit, "5")
+			query.Add(pkgCommon.MinLatitude, testCase.minLatitude)
+			query.Add(pkgCommon.MinLongitude, testCase.minLongitude)
+			query.Add(pkgCommon.MaxLatitude, testCase.maxLatitude)
+			if testCase.maxLongitude != "" {
+				query.Add(pkgCommon.MaxLongitude, testCase.maxLongitude)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DevicesByLocationBox)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+			assert.Equal(t, uint32(testCase.expectedCount), res.TotalCount, "Total count not as expected")
+		})
+	}
+}
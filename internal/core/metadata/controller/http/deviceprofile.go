@@ -11,6 +11,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -245,7 +246,13 @@ func (dc *DeviceProfileController) DeleteDeviceProfileByName(w http.ResponseWrit
 	vars := mux.Vars(r)
 	name := vars[common.Name]
 
-	err := application.DeleteDeviceProfileByName(name, ctx, dc.dic)
+	cascade, err := parseCascadeParameter(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	err = application.DeleteDeviceProfileByName(name, cascade, ctx, dc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
@@ -389,3 +396,29 @@ func (dc *DeviceProfileController) PatchDeviceProfileBasicInfo(w http.ResponseWr
 	pkg.EncodeAndWriteResponse(updateResponses, w, lc)
 
 }
+
+func (dc *DeviceProfileController) ValidateDeviceProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	var reqDTO metadataDTOs.ValidateDeviceProfileRequest
+	err := dc.jsonDtoReader.Read(r.Body, &reqDTO)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	issues, compatibility, err := application.ValidateDeviceProfile(reqDTO.Profile, reqDTO.ExistingProfileName, dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := metadataDTOs.NewValidateDeviceProfileResponse(reqDTO.RequestId, "", http.StatusOK, issues, compatibility)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -33,7 +33,9 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -1467,3 +1469,102 @@ func TestDeviceProfilesByManufacturerAndModel(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeviceProfile(t *testing.T) {
+	profile := buildTestDeviceProfileRequest().Profile
+	existing := dtos.ToDeviceProfileModel(profile)
+	device := models.Device{
+		Name:        TestDeviceName,
+		ProfileName: TestDeviceProfileName,
+		AutoEvents:  []models.AutoEvent{{Interval: "10s", SourceName: TestDeviceResourceName + "-dup"}},
+	}
+	notFoundName := "notFoundProfile"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(existing, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{device}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	invalid := profile
+	invalid.DeviceResources = []dtos.DeviceResource{profile.DeviceResources[0], profile.DeviceResources[0], profile.DeviceResources[1]}
+	invalid.DeviceResources[2].Properties.ValueType = "Int128"
+	invalid.DeviceCommands = []dtos.DeviceCommand{{
+		Name:               "unreachable",
+		ReadWrite:          common.ReadWrite_R,
+		ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "notFoundResource"}},
+	}}
+	hiddenResource := profile
+	hiddenResource.DeviceResources = append([]dtos.DeviceResource{}, profile.DeviceResources...)
+	hiddenResource.DeviceResources[1].IsHidden = true
+	readOnlyResource := profile
+	readOnlyResource.DeviceResources = append([]dtos.DeviceResource{}, profile.DeviceResources...)
+	readOnlyResource.DeviceResources[0].Properties.ReadWrite = common.ReadWrite_R
+	removedResource := profile
+	removedResource.DeviceResources = profile.DeviceResources[:1]
+
+	tests := []struct {
+		name                    string
+		profile                 dtos.DeviceProfile
+		existingProfileName     string
+		expectedStatusCode      int
+		expectedValid           bool
+		expectedIssueCount      int
+		expectedCompatible      bool
+		expectedBreakingChanges int
+		expectedAffectedDevices []string
+	}{
+		{"Valid", profile, "", http.StatusOK, true, 0, false, 0, nil},
+		{"Valid - compatible with the existing profile", profile, TestDeviceProfileName, http.StatusOK, true, 0, true, 0, nil},
+		{"Valid - hidden resource without command", hiddenResource, "", http.StatusOK, true, 1, false, 0, nil},
+		{"Invalid - duplicated resource, invalid value type and unreachable command", invalid, "", http.StatusOK, false, 3, false, 0, nil},
+		{"Invalid - command permission not granted by its resource", readOnlyResource, TestDeviceProfileName, http.StatusOK, false, 1, false, 1, nil},
+		{"Valid - incompatible with the devices of the existing profile", removedResource, TestDeviceProfileName, http.StatusOK, true, 0, false, 2, []string{TestDeviceName}},
+		{"Invalid - existing profile not found", profile, notFoundName, http.StatusNotFound, false, 0, false, 0, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqDTO := metadataDTOs.ValidateDeviceProfileRequest{
+				BaseRequest:         commonDTO.NewBaseRequest(),
+				Profile:             testCase.profile,
+				ExistingProfileName: testCase.existingProfileName,
+			}
+			jsonData, err := json.Marshal(reqDTO)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceProfileValidateRoute, bytes.NewReader(jsonData))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.ValidateDeviceProfile)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res metadataDTOs.ValidateDeviceProfileResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedValid, res.Valid, "Valid not as expected")
+			assert.Len(t, res.Issues, testCase.expectedIssueCount, "Issues not as expected: %v", res.Issues)
+			if testCase.existingProfileName == "" {
+				assert.Nil(t, res.Compatibility)
+				return
+			}
+			require.NotNil(t, res.Compatibility)
+			assert.Equal(t, uint32(1), res.Compatibility.DeviceCount, "Device count not as expected")
+			assert.Equal(t, testCase.expectedCompatible, res.Compatibility.Compatible, "Compatible not as expected")
+			assert.Len(t, res.Compatibility.BreakingChanges, testCase.expectedBreakingChanges, "Breaking changes not as expected: %v", res.Compatibility.BreakingChanges)
+			assert.Equal(t, testCase.expectedAffectedDevices, res.Compatibility.AffectedDevices, "Affected devices not as expected")
+		})
+	}
+}
@@ -0,0 +1,213 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func buildTestDeviceProfileRevisions() []metadataModels.DeviceProfileRevision {
+	profile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	previous := profile
+	previous.Description = "previous"
+	return []metadataModels.DeviceProfileRevision{
+		{Version: 2, Created: 2, Profile: profile},
+		{Version: 1, Created: 1, Profile: previous},
+	}
+}
+
+func TestDeviceProfileRevisionsByName(t *testing.T) {
+	profile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	revisions := buildTestDeviceProfileRevisions()
+	notFoundName := "notFound"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(profile, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DeviceProfileRevisionCountByName", TestDeviceProfileName).Return(uint32(len(revisions)), nil)
+	dbClientMock.On("DeviceProfileRevisionsByName", 0, 10, TestDeviceProfileName).Return(revisions, nil)
+	dbClientMock.On("DeviceProfileRevisionsByName", 1, 1, TestDeviceProfileName).Return(revisions[1:], nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		profileName        string
+		errorExpected      bool
+		expectedVersions   []uint64
+		expectedStatusCode int
+	}{
+		{"Valid - get revisions by name", "0", "10", TestDeviceProfileName, false, []uint64{2, 1}, http.StatusOK},
+		{"Valid - get revisions by name with offset and limit", "1", "1", TestDeviceProfileName, false, []uint64{1}, http.StatusOK},
+		{"Invalid - profile not found", "0", "10", notFoundName, true, nil, http.StatusNotFound},
+		{"Invalid - name is empty", "0", "10", "", true, nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceProfileVersionsByNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.profileName})
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeviceProfileRevisionsByName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res metadataDTOs.MultiDeviceProfileRevisionsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(len(revisions)), res.TotalCount, "Total count not as expected")
+			require.Len(t, res.Revisions, len(testCase.expectedVersions))
+			for i, version := range testCase.expectedVersions {
+				assert.Equal(t, version, res.Revisions[i].Version)
+				assert.Equal(t, TestDeviceProfileName, res.Revisions[i].Profile.Name)
+			}
+		})
+	}
+}
+
+func TestDeviceProfileRevisionByNameAndVersion(t *testing.T) {
+	revisions := buildTestDeviceProfileRevisions()
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileRevisionByNameAndVersion", TestDeviceProfileName, uint64(1)).Return(revisions[1], nil)
+	dbClientMock.On("DeviceProfileRevisionByNameAndVersion", TestDeviceProfileName, uint64(3)).Return(metadataModels.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		version            string
+		expectedStatusCode int
+	}{
+		{"Valid - get revision by version", "1", http.StatusOK},
+		{"Invalid - version not found", "3", http.StatusNotFound},
+		{"Invalid - version is zero", "0", http.StatusBadRequest},
+		{"Invalid - version is not a number", "latest", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceProfileVersionByNameAndVersionRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: TestDeviceProfileName, pkgCommon.Version: testCase.version})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeviceProfileRevisionByNameAndVersion)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res metadataDTOs.DeviceProfileRevisionResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, uint64(1), res.Revision.Version)
+				assert.Equal(t, "previous", res.Revision.Profile.Description)
+			}
+		})
+	}
+}
+
+func TestRollbackDeviceProfile(t *testing.T) {
+	revisions := buildTestDeviceProfileRevisions()
+	current := revisions[0].Profile
+	current.Id = ExampleUUID
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(current, nil)
+	dbClientMock.On("DeviceProfileRevisionByNameAndVersion", TestDeviceProfileName, uint64(1)).Return(revisions[1], nil)
+	dbClientMock.On("DeviceProfileRevisionByNameAndVersion", TestDeviceProfileName, uint64(3)).Return(metadataModels.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{}, nil)
+	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(0), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		version            string
+		expectedStatusCode int
+	}{
+		{"Valid - rollback to version", "1", http.StatusOK},
+		{"Invalid - version not found", "3", http.StatusNotFound},
+		{"Invalid - version is not a number", "-1", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceProfileRollbackByNameAndVersionRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: TestDeviceProfileName, pkgCommon.Version: testCase.version})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.RollbackDeviceProfile)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+
+	// the rollback updates the current profile with the content of the revision
+	dbClientMock.AssertCalled(t, "UpdateDeviceProfile", mock.MatchedBy(func(dp models.DeviceProfile) bool {
+		return dp.Id == ExampleUUID && dp.Description == "previous"
+	}))
+}
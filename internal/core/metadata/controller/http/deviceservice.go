@@ -11,6 +11,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -153,7 +154,13 @@ func (dc *DeviceServiceController) DeleteDeviceServiceByName(w http.ResponseWrit
 	vars := mux.Vars(r)
 	name := vars[common.Name]
 
-	err := application.DeleteDeviceServiceByName(name, ctx, dc.dic)
+	cascade, err := parseCascadeParameter(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	err = application.DeleteDeviceServiceByName(name, cascade, ctx, dc.dic)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
@@ -186,3 +193,18 @@ func (dc *DeviceServiceController) AllDeviceServices(w http.ResponseWriter, r *h
 	// encode and send out the response
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+func (dc *DeviceServiceController) DeviceServicePollingLoads(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+
+	loads, err := application.DeviceServicePollingLoads(dc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := metadataDTOs.NewDeviceServicePollingLoadsResponse("", "", http.StatusOK, loads)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
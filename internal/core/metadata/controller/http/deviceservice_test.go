@@ -14,7 +14,9 @@ import (
 	"testing"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -539,3 +541,117 @@ func TestDeleteDeviceServiceByName(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteDeviceServiceByName_Cascade(t *testing.T) {
+	device := models.Device{Name: TestDeviceName, ServiceName: testDeviceServiceName}
+	pw := models.ProvisionWatcher{Name: "TestProvisionWatcher", ServiceName: testDeviceServiceName}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceByName", testDeviceServiceName).Return(models.DeviceService{Name: testDeviceServiceName}, nil)
+	dbClientMock.On("DevicesByServiceName", 0, -1, testDeviceServiceName).Return([]models.Device{device}, nil)
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("DeleteDeviceByName", device.Name).Return(nil)
+	dbClientMock.On("ProvisionWatchersByServiceName", 0, -1, testDeviceServiceName).Return([]models.ProvisionWatcher{pw}, nil)
+	dbClientMock.On("ProvisionWatcherByName", pw.Name).Return(pw, nil)
+	dbClientMock.On("DeleteProvisionWatcherByName", pw.Name).Return(nil)
+	dbClientMock.On("DeleteDeviceServiceByName", testDeviceServiceName).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceServiceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		cascade            string
+		expectedStatusCode int
+	}{
+		{"Valid - cascade delete", common.ValueTrue, http.StatusOK},
+		{"Invalid - invalid cascade value", "yes", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s?%s=%s", common.ApiDeviceServiceByNameRoute, testDeviceServiceName, pkgCommon.Cascade, testCase.cascade)
+			req, err := http.NewRequest(http.MethodDelete, reqPath, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testDeviceServiceName})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeleteDeviceServiceByName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				dbClientMock.AssertCalled(t, "DeleteDeviceByName", device.Name)
+				dbClientMock.AssertCalled(t, "DeleteProvisionWatcherByName", pw.Name)
+				dbClientMock.AssertCalled(t, "DeleteDeviceServiceByName", testDeviceServiceName)
+			}
+		})
+	}
+}
+
+func TestDeviceServicePollingLoads(t *testing.T) {
+	services := []models.DeviceService{
+		{Name: "idle", AdminState: models.Unlocked},
+		{Name: "busy", AdminState: models.Unlocked},
+		{Name: "locked", AdminState: models.Locked},
+	}
+	devices := []models.Device{
+		{Name: "device1", ServiceName: "busy", AdminState: models.Unlocked, AutoEvents: []models.AutoEvent{
+			{Interval: "100ms", SourceName: "resource1"},
+			{Interval: "1s", SourceName: "resource2"},
+		}},
+		{Name: "device2", ServiceName: "busy", AdminState: models.Unlocked, AutoEvents: []models.AutoEvent{
+			{Interval: "500ms", SourceName: "resource1"},
+		}},
+		{Name: "lockedDevice", ServiceName: "busy", AdminState: models.Locked, AutoEvents: []models.AutoEvent{
+			{Interval: "1ms", SourceName: "resource1"},
+		}},
+		{Name: "device3", ServiceName: "idle", AdminState: models.Unlocked},
+		{Name: "device4", ServiceName: "locked", AdminState: models.Unlocked, AutoEvents: []models.AutoEvent{
+			{Interval: "1s", SourceName: "resource1"},
+		}},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllDeviceServices", 0, -1, []string(nil)).Return(services, nil)
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return(devices, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceServiceController(dic)
+	require.NotNil(t, controller)
+
+	req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceServicePollingLoadRoute, http.NoBody)
+	require.NoError(t, err)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.DeviceServicePollingLoads)
+	handler.ServeHTTP(recorder, req)
+
+	// Assert
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	var res metadataDTOs.DeviceServicePollingLoadsResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Len(t, res.Loads, 3)
+	assert.Equal(t, "busy", res.Loads[0].ServiceName)
+	assert.Equal(t, 2, res.Loads[0].Devices)
+	assert.Equal(t, 3, res.Loads[0].AutoEvents)
+	assert.InDelta(t, 13, res.Loads[0].ReadsPerSecond, 0.001)
+	assert.Equal(t, "100ms", res.Loads[0].ShortestInterval)
+	for _, load := range res.Loads[1:] {
+		assert.Zero(t, load.Devices, "device service %s should have no polling load", load.ServiceName)
+		assert.Zero(t, load.ReadsPerSecond, "device service %s should have no polling load", load.ServiceName)
+	}
+}
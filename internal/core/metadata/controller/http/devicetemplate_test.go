@@ -0,0 +1,225 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const testDeviceTemplateName = "TestDeviceTemplate"
+
+func buildTestAddDeviceTemplateRequest() metadataDTOs.AddDeviceTemplateRequest {
+	return metadataDTOs.AddDeviceTemplateRequest{
+		BaseRequest: commonDTO.BaseRequest{
+			RequestId:   ExampleUUID,
+			Versionable: commonDTO.NewVersionable(),
+		},
+		Template: metadataDTOs.DeviceTemplate{
+			Name:        testDeviceTemplateName,
+			DeviceName:  "sensor-${site}-${index}",
+			ServiceName: TestDeviceServiceName,
+			ProfileName: TestDeviceProfileName,
+			Labels:      []string{"${site}"},
+			Protocols: map[string]dtos.ProtocolProperties{
+				"modbus-tcp": {"Address": "10.0.0.${index}", "Port": "502"},
+			},
+			AutoEvents: []dtos.AutoEvent{{Interval: "${interval}", SourceName: "Temperature"}},
+		},
+	}
+}
+
+func TestAddDeviceTemplate(t *testing.T) {
+	valid := buildTestAddDeviceTemplateRequest()
+	noName := valid
+	noName.Template.Name = ""
+	noProtocols := valid
+	noProtocols.Template.Protocols = nil
+	unknownProfile := valid
+	unknownProfile.Template.Name = "unknownProfile"
+	unknownProfile.Template.ProfileName = "unknown"
+	duplicate := valid
+	duplicate.Template.Name = "duplicate"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", TestDeviceServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", "unknown").Return(false, nil)
+	dbClientMock.On("AddDeviceTemplate", mock.MatchedBy(func(dt metadataModels.DeviceTemplate) bool { return dt.Name == testDeviceTemplateName })).
+		Return(metadataModels.DeviceTemplate{Id: ExampleUUID}, nil)
+	dbClientMock.On("AddDeviceTemplate", mock.MatchedBy(func(dt metadataModels.DeviceTemplate) bool { return dt.Name == "duplicate" })).
+		Return(metadataModels.DeviceTemplate{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "device template name exists", nil))
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		request            []metadataDTOs.AddDeviceTemplateRequest
+		expectedBadRequest bool
+		expectedStatusCode int
+	}{
+		{"Valid", []metadataDTOs.AddDeviceTemplateRequest{valid}, false, http.StatusCreated},
+		{"Invalid - no name", []metadataDTOs.AddDeviceTemplateRequest{noName}, true, http.StatusBadRequest},
+		{"Invalid - no protocols", []metadataDTOs.AddDeviceTemplateRequest{noProtocols}, true, http.StatusBadRequest},
+		{"Invalid - unknown device profile", []metadataDTOs.AddDeviceTemplateRequest{unknownProfile}, false, http.StatusNotFound},
+		{"Invalid - duplicate name", []metadataDTOs.AddDeviceTemplateRequest{duplicate}, false, http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceTemplateRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddDeviceTemplate)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.expectedBadRequest {
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				return
+			}
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, int(res[0].StatusCode), "BaseResponse status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, ExampleUUID, res[0].Id)
+			}
+		})
+	}
+}
+
+func TestInstantiateDeviceTemplate(t *testing.T) {
+	template := metadataDTOs.ToDeviceTemplateModel(buildTestAddDeviceTemplateRequest().Template)
+	baseRequest := commonDTO.BaseRequest{RequestId: ExampleUUID, Versionable: commonDTO.NewVersionable()}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceTemplateByName", testDeviceTemplateName).Return(template, nil)
+	dbClientMock.On("DeviceTemplateByName", "unknown").Return(metadataModels.DeviceTemplate{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device template doesn't exist", nil))
+	dbClientMock.On("DeviceServiceNameExists", TestDeviceServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", mock.Anything).Return(false, nil)
+	dbClientMock.On("AddDevice", mock.Anything).Return(func(d models.Device) models.Device {
+		d.Id = ExampleUUID
+		return d
+	}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return mockDeviceValidationMessaging(t)
+		},
+	})
+	controller := NewDeviceTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                string
+		templateName        string
+		mode                string
+		request             metadataDTOs.InstantiateDeviceTemplateRequest
+		expectedStatusCode  int
+		expectedDeviceNames []string
+		expectedResultCodes []int
+	}{
+		{"Valid - count", testDeviceTemplateName, "",
+			metadataDTOs.InstantiateDeviceTemplateRequest{BaseRequest: baseRequest, Count: 2, StartIndex: 1, Parameters: map[string]string{"site": "plant1", "interval": "10s"}},
+			http.StatusOK, []string{"sensor-plant1-1", "sensor-plant1-2"}, []int{http.StatusCreated, http.StatusCreated}},
+		{"Valid - instances", testDeviceTemplateName, "",
+			metadataDTOs.InstantiateDeviceTemplateRequest{BaseRequest: baseRequest, Parameters: map[string]string{"interval": "10s"},
+				Instances: []map[string]string{{"site": "plant1"}, {"site": "plant2", "interval": "1m"}}},
+			http.StatusOK, []string{"sensor-plant1-0", "sensor-plant2-1"}, []int{http.StatusCreated, http.StatusCreated}},
+		{"Valid - best effort with missing parameter", testDeviceTemplateName, pkgCommon.BulkModeBestEffort,
+			metadataDTOs.InstantiateDeviceTemplateRequest{BaseRequest: baseRequest, Parameters: map[string]string{"site": "plant1"},
+				Instances: []map[string]string{{"interval": "10s"}, {}}},
+			http.StatusMultiStatus, []string{"sensor-plant1-0", ""}, []int{http.StatusCreated, http.StatusBadRequest}},
+		{"Invalid - atomic with missing parameter", testDeviceTemplateName, pkgCommon.BulkModeAtomic,
+			metadataDTOs.InstantiateDeviceTemplateRequest{BaseRequest: baseRequest, Parameters: map[string]string{"site": "plant1"},
+				Instances: []map[string]string{{"interval": "10s"}, {}}},
+			http.StatusBadRequest, []string{"sensor-plant1-0", ""}, []int{http.StatusFailedDependency, http.StatusBadRequest}},
+		{"Invalid - rendered device invalid", testDeviceTemplateName, "",
+			metadataDTOs.InstantiateDeviceTemplateRequest{BaseRequest: baseRequest, Count: 1, Parameters: map[string]string{"site": "plant1", "interval": "often"}},
+			http.StatusBadRequest, []string{""}, []int{http.StatusBadRequest}},
+		{"Invalid - no count nor instances", testDeviceTemplateName, "",
+			metadataDTOs.InstantiateDeviceTemplateRequest{BaseRequest: baseRequest},
+			http.StatusBadRequest, nil, nil},
+		{"Invalid - too many devices", testDeviceTemplateName, "",
+			metadataDTOs.InstantiateDeviceTemplateRequest{BaseRequest: baseRequest, Count: metadataDTOs.MaxDeviceTemplateInstances + 1},
+			http.StatusBadRequest, nil, nil},
+		{"Invalid - unknown device template", "unknown", "",
+			metadataDTOs.InstantiateDeviceTemplateRequest{BaseRequest: baseRequest, Count: 1},
+			http.StatusNotFound, nil, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceTemplateInstantiateByNameRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.templateName})
+			if testCase.mode != "" {
+				query := req.URL.Query()
+				query.Add(pkgCommon.Mode, testCase.mode)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.InstantiateDeviceTemplate)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			require.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedResultCodes == nil {
+				return
+			}
+			var res metadataDTOs.BulkDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res.Results, len(testCase.expectedResultCodes))
+			for i, result := range res.Results {
+				assert.Equal(t, testCase.expectedResultCodes[i], result.StatusCode, "result status code not as expected")
+				assert.Equal(t, testCase.expectedDeviceNames[i], result.Name, "device name not as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/graphql"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type GraphQLController struct {
+	dic *di.Container
+}
+
+// NewGraphQLController creates and initializes a GraphQLController
+func NewGraphQLController(dic *di.Container) *GraphQLController {
+	return &GraphQLController{
+		dic: dic,
+	}
+}
+
+// Query executes the GraphQL query of the request body. Like the GraphQL servers, it responds 200 OK with the errors of
+// the query in the response, only a body which isn't a GraphQL request is a bad request.
+func (gc *GraphQLController) Query(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(gc.dic.Get)
+	ctx := r.Context()
+
+	var request graphql.Request
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the GraphQL request", err), "")
+		return
+	}
+	if len(strings.TrimSpace(request.Query)) == 0 {
+		utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "the GraphQL query is empty", nil), "")
+		return
+	}
+
+	response := application.ExecuteGraphQL(ctx, request, gc.dic)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
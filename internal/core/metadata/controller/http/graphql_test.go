@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func TestGraphQLQuery(t *testing.T) {
+	device1 := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	device2 := device1
+	device2.Name = "device2"
+	profile := models.DeviceProfile{Name: TestDeviceProfileName, Manufacturer: TestManufacturer}
+	notFoundName := "notFoundName"
+	reading := dtos.BaseReading{
+		DeviceName:    TestDeviceName,
+		ResourceName:  "TestResource",
+		ValueType:     common.ValueTypeInt16,
+		SimpleReading: dtos.SimpleReading{Value: "42"},
+	}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDevices", 0, 20, []string(nil)).Return([]models.Device{device1, device2}, nil)
+	dbClientMock.On("DeviceCountByLabels", []string(nil)).Return(uint32(2), nil)
+	dbClientMock.On("DeviceByName", notFoundName).Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(profile, nil)
+	readingClientMock := &clientMocks.ReadingClient{}
+	readingClientMock.On("ReadingsByDeviceName", mock.Anything, TestDeviceName, 0, 1).
+		Return(responseDTO.MultiReadingsResponse{Readings: []dtos.BaseReading{reading}}, nil)
+	readingClientMock.On("ReadingsByDeviceName", mock.Anything, "device2", 0, 1).
+		Return(responseDTO.MultiReadingsResponse{}, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "core-data is unavailable", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.ReadingClientName: func(get di.Get) interface{} {
+			return readingClientMock
+		},
+	})
+
+	controller := NewGraphQLController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		body               string
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{"Valid - devices with profile and latest readings",
+			`{"query": "{ devices { name profile { manufacturer } latestReadings(limit: 1) { resourceName value } } }"}`,
+			http.StatusOK,
+			`{"data":{"devices":[` +
+				`{"name":"TestDevice","profile":{"manufacturer":"TestManufacturer"},"latestReadings":[{"resourceName":"TestResource","value":"42"}]},` +
+				`{"name":"device2","profile":{"manufacturer":"TestManufacturer"},"latestReadings":null}]},` +
+				`"errors":[{"message":"core-data is unavailable","path":["devices",1,"latestReadings"]}]}`},
+		{"Valid - device not found",
+			`{"query": "query ($name: String!) { device(name: $name) { name } }", "variables": {"name": "notFoundName"}}`,
+			http.StatusOK,
+			`{"data":{"device":null}}`},
+		{"Valid - limit out of range",
+			`{"query": "{ devices(limit: 31) { name } }"}`,
+			http.StatusOK,
+			`{"data":{"devices":null},"errors":[{"message":"limit 31 is out of the range [-1, 30]","path":["devices"]}]}`},
+		{"Valid - invalid query",
+			`{"query": "{ devices { serialNumber } }"}`,
+			http.StatusOK,
+			`{"errors":[{"message":"cannot query field \"serialNumber\" on type \"Device\""}]}`},
+		{"Invalid - empty query", `{"query": " "}`, http.StatusBadRequest, ""},
+		{"Invalid - not a GraphQL request", `query { devices { name } }`, http.StatusBadRequest, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiGraphQLRoute, strings.NewReader(testCase.body))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.Query)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedResponse != "" {
+				assert.JSONEq(t, testCase.expectedResponse, recorder.Body.String())
+			}
+		})
+	}
+	// the profile shared by the devices is read once per query
+	dbClientMock.AssertNumberOfCalls(t, "DeviceProfileByName", 1)
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type OrphanController struct {
+	dic *di.Container
+}
+
+// NewOrphanController creates and initializes an OrphanController
+func NewOrphanController(dic *di.Container) *OrphanController {
+	return &OrphanController{
+		dic: dic,
+	}
+}
+
+func (oc *OrphanController) Orphans(w http.ResponseWriter, r *http.Request) {
+	oc.detectOrphans(w, r, false)
+}
+
+func (oc *OrphanController) RepairOrphans(w http.ResponseWriter, r *http.Request) {
+	oc.detectOrphans(w, r, true)
+}
+
+func (oc *OrphanController) detectOrphans(w http.ResponseWriter, r *http.Request, repair bool) {
+	lc := container.LoggingClientFrom(oc.dic.Get)
+	ctx := r.Context()
+
+	orphans, err := application.DetectOrphans(repair, ctx, oc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := metadataDTOs.NewOrphansResponse("", "", http.StatusOK, orphans)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func parseCascadeParameter(r *http.Request) (bool, errors.EdgeX) {
+	cascade := utils.ParseQueryStringToString(r, pkgCommon.Cascade, common.ValueFalse)
+	if cascade != common.ValueTrue && cascade != common.ValueFalse {
+		return false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid query parameter, %s has to be %s or %s", pkgCommon.Cascade, common.ValueTrue, common.ValueFalse), nil)
+	}
+	return cascade == common.ValueTrue, nil
+}
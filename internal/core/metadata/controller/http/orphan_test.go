@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func TestOrphans(t *testing.T) {
+	service := models.DeviceService{Name: testDeviceServiceName}
+	profile := models.DeviceProfile{
+		Name:            TestDeviceProfileName,
+		DeviceResources: []models.DeviceResource{{Name: TestDeviceResourceName}},
+		DeviceCommands:  []models.DeviceCommand{{Name: TestDeviceCommandName}},
+	}
+	valid := models.Device{
+		Name:        "valid",
+		ServiceName: testDeviceServiceName,
+		ProfileName: TestDeviceProfileName,
+		AutoEvents:  []models.AutoEvent{{SourceName: TestDeviceResourceName}, {SourceName: TestDeviceCommandName}},
+	}
+	missingProfile := models.Device{Name: "missingProfile", ServiceName: testDeviceServiceName, ProfileName: "deleted"}
+	missingSource := models.Device{
+		Name:        "missingSource",
+		ServiceName: testDeviceServiceName,
+		ProfileName: TestDeviceProfileName,
+		AutoEvents:  []models.AutoEvent{{SourceName: TestDeviceResourceName}, {SourceName: "removed"}},
+	}
+	missingService := models.ProvisionWatcher{Name: "missingService", ServiceName: "deleted"}
+	missingService.DiscoveredDevice.ProfileName = TestDeviceProfileName
+
+	expectedOrphans := []metadataDTOs.Orphan{
+		{EntityType: common.DeviceSystemEventType, Name: missingProfile.Name, Kind: metadataDTOs.OrphanKindMissingDeviceProfile, Reference: "deleted"},
+		{EntityType: common.DeviceSystemEventType, Name: missingSource.Name, Kind: metadataDTOs.OrphanKindMissingAutoEventSource, Reference: "removed"},
+		{EntityType: common.ProvisionWatcherSystemEventType, Name: missingService.Name, Kind: metadataDTOs.OrphanKindMissingDeviceService, Reference: "deleted"},
+	}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllDeviceServices", 0, -1, []string(nil)).Return([]models.DeviceService{service}, nil)
+	dbClientMock.On("AllDeviceProfiles", 0, -1, []string(nil)).Return([]models.DeviceProfile{profile}, nil)
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return([]models.Device{valid, missingProfile, missingSource}, nil)
+	dbClientMock.On("AllProvisionWatchers", 0, -1, []string(nil)).Return([]models.ProvisionWatcher{missingService}, nil)
+	dbClientMock.On("DeviceByName", missingProfile.Name).Return(missingProfile, nil)
+	dbClientMock.On("DeleteDeviceByName", missingProfile.Name).Return(nil)
+	dbClientMock.On("UpdateDevice", mock.Anything).Return(nil)
+	dbClientMock.On("ProvisionWatcherByName", missingService.Name).Return(missingService, nil)
+	dbClientMock.On("DeleteProvisionWatcherByName", missingService.Name).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewOrphanController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name     string
+		method   string
+		route    string
+		handler  http.HandlerFunc
+		repaired bool
+	}{
+		{"Valid - report orphans", http.MethodGet, pkgCommon.ApiOrphanRoute, controller.Orphans, false},
+		{"Valid - repair orphans", http.MethodPost, pkgCommon.ApiOrphanRepairRoute, controller.RepairOrphans, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(testCase.method, testCase.route, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			testCase.handler.ServeHTTP(recorder, req)
+
+			// Assert
+			require.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			var res metadataDTOs.OrphansResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			expected := make([]metadataDTOs.Orphan, len(expectedOrphans))
+			for i, o := range expectedOrphans {
+				o.Repaired = testCase.repaired
+				expected[i] = o
+			}
+			assert.Equal(t, expected, res.Orphans)
+			if !testCase.repaired {
+				dbClientMock.AssertNotCalled(t, "DeleteDeviceByName", mock.Anything)
+				dbClientMock.AssertNotCalled(t, "UpdateDevice", mock.Anything)
+				return
+			}
+			dbClientMock.AssertCalled(t, "DeleteDeviceByName", missingProfile.Name)
+			dbClientMock.AssertCalled(t, "DeleteProvisionWatcherByName", missingService.Name)
+			dbClientMock.AssertCalled(t, "UpdateDevice", mock.MatchedBy(func(d models.Device) bool {
+				return d.Name == missingSource.Name && len(d.AutoEvents) == 1 && d.AutoEvents[0].SourceName == TestDeviceResourceName
+			}))
+		})
+	}
+}
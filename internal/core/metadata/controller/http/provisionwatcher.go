@@ -11,6 +11,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -232,3 +233,29 @@ func (pwc *ProvisionWatcherController) PatchProvisionWatcher(w http.ResponseWrit
 	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
 	pkg.EncodeAndWriteResponse(updateResponses, w, lc)
 }
+
+func (pwc *ProvisionWatcherController) DryRunProvisionWatchers(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(pwc.dic.Get)
+	ctx := r.Context()
+
+	var reqDTO metadataDTOs.ProvisionWatcherDryRunRequest
+	err := pwc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	deviceExists, matches, err := application.DryRunProvisionWatchers(reqDTO.Device, reqDTO.ServiceName, pwc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := metadataDTOs.NewProvisionWatcherDryRunResponse(reqDTO.RequestId, "", http.StatusOK, deviceExists, matches)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
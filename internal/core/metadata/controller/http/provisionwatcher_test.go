@@ -26,7 +26,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 )
 
 var testProvisionWatcherName = "TestProvisionWatcher"
@@ -822,3 +824,93 @@ func TestProvisionWatcherController_PatchProvisionWatcher(t *testing.T) {
 		})
 	}
 }
+
+func TestProvisionWatcherController_DryRunProvisionWatchers(t *testing.T) {
+	provisionWatcher := dtos.ToProvisionWatcherModel(buildTestAddProvisionWatcherRequest().ProvisionWatcher)
+	lockedProvisionWatcher := provisionWatcher
+	lockedProvisionWatcher.Name = "LockedProvisionWatcher"
+	lockedProvisionWatcher.AdminState = models.Locked
+	invalidProvisionWatcher := provisionWatcher
+	invalidProvisionWatcher.Name = "InvalidProvisionWatcher"
+	invalidProvisionWatcher.Identifiers = map[string]string{"port": "3[0-9"}
+	provisionWatchers := []models.ProvisionWatcher{provisionWatcher, lockedProvisionWatcher, invalidProvisionWatcher}
+	existingDeviceName := "ExistingDevice"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AllProvisionWatchers", 0, -1, []string(nil)).Return(provisionWatchers, nil)
+	dbClientMock.On("ProvisionWatchersByServiceName", 0, -1, TestDeviceServiceName).Return([]models.ProvisionWatcher{provisionWatcher}, nil)
+	dbClientMock.On("DeviceNameExists", TestDeviceName).Return(false, nil)
+	dbClientMock.On("DeviceNameExists", existingDeviceName).Return(true, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewProvisionWatcherController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                 string
+		serviceName          string
+		deviceName           string
+		address              string
+		port                 string
+		expectedStatusCode   int
+		expectedDeviceExists bool
+		expectedMatched      []bool
+	}{
+		{"Valid - matched", "", TestDeviceName, "localhost", "300", http.StatusOK, false, []bool{true, false, false}},
+		{"Valid - matched by service name", TestDeviceServiceName, TestDeviceName, "localhost", "301", http.StatusOK, false, []bool{true}},
+		{"Valid - existing device", TestDeviceServiceName, existingDeviceName, "localhost", "301", http.StatusOK, true, []bool{true}},
+		{"Valid - identifier not matched", TestDeviceServiceName, TestDeviceName, "localhost", "400", http.StatusOK, false, []bool{false}},
+		{"Valid - blocked", TestDeviceServiceName, TestDeviceName, "localhost", "398", http.StatusOK, false, []bool{false}},
+		{"Invalid - empty device name", "", "", "localhost", "300", http.StatusBadRequest, false, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqDTO := metadataDTOs.ProvisionWatcherDryRunRequest{
+				BaseRequest: commonDTO.NewBaseRequest(),
+				ServiceName: testCase.serviceName,
+				Device: metadataDTOs.CandidateDevice{
+					Name: testCase.deviceName,
+					Protocols: map[string]dtos.ProtocolProperties{
+						"other": {"address": testCase.address, "port": testCase.port},
+					},
+				},
+			}
+			jsonData, err := json.Marshal(reqDTO)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiProvisionWatcherDryRunRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DryRunProvisionWatchers)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res metadataDTOs.ProvisionWatcherDryRunResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedDeviceExists, res.DeviceExists)
+			require.Len(t, res.Matches, len(testCase.expectedMatched))
+			for i, matched := range testCase.expectedMatched {
+				assert.Equal(t, matched, res.Matches[i].Matched)
+				if matched {
+					require.NotNil(t, res.Matches[i].Device)
+					assert.Equal(t, testCase.deviceName, res.Matches[i].Device.Name)
+					assert.Equal(t, TestDeviceProfileName, res.Matches[i].Device.ProfileName)
+					assert.Equal(t, TestDeviceServiceName, res.Matches[i].Device.ServiceName)
+				} else {
+					assert.Nil(t, res.Matches[i].Device)
+					assert.NotEmpty(t, res.Matches[i].Reason)
+				}
+			}
+		})
+	}
+}
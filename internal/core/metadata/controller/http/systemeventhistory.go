@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type SystemEventHistoryController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewSystemEventHistoryController creates and initializes an SystemEventHistoryController
+func NewSystemEventHistoryController(dic *di.Container) *SystemEventHistoryController {
+	return &SystemEventHistoryController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+func (sc *SystemEventHistoryController) SystemEventHistory(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(sc.dic.Get)
+
+	// parse URL query string for cursor and limit
+	cursor, err := utils.ParseQueryStringToInt(r, pkgCommon.Cursor, 0, 0, math.MaxInt)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	limit, err := utils.ParseQueryStringToInt(r, common.Limit, common.DefaultLimit, 1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	systemEvents, nextCursor, latest, err := application.SystemEventHistory(uint64(cursor), limit, sc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := metadataDTOs.NewSystemEventHistoryResponse("", "", http.StatusOK, systemEvents, nextCursor, latest)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (sc *SystemEventHistoryController) ReplaySystemEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+	config := metadataContainer.ConfigurationFrom(sc.dic.Get)
+
+	var req metadataDTOs.ReplaySystemEventsRequest
+	if err := sc.reader.Read(r.Body, &req); err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = config.Service.MaxResultCount
+	} else if limit > config.Service.MaxResultCount {
+		err := errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the limit %d is greater than the MaxResultCount %d", limit, config.Service.MaxResultCount), nil)
+		utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+		return
+	}
+
+	replayed, nextCursor, latest, err := application.ReplaySystemEvents(req.Cursor, limit, req.Topic, ctx, sc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, req.RequestId)
+		return
+	}
+
+	response := metadataDTOs.NewReplaySystemEventsResponse(req.RequestId, "", http.StatusOK, replayed, nextCursor, latest)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
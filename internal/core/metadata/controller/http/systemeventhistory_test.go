@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func mockSystemEventHistoryEntries(t *testing.T) []metadataModels.SystemEventHistoryEntry {
+	systemEventTopic := common.BuildTopic(common.DefaultBaseTopic, common.SystemEventPublishTopic, common.CoreMetaDataServiceKey)
+	entries := make([]metadataModels.SystemEventHistoryEntry, 3)
+	for i, action := range []string{common.SystemEventActionAdd, common.SystemEventActionUpdate, common.SystemEventActionDelete} {
+		device := dtos.Device{Name: TestDeviceName, ProfileName: TestDeviceProfileName, ServiceName: TestDeviceServiceName}
+		systemEvent := dtos.NewSystemEvent(common.DeviceSystemEventType, action, common.CoreMetaDataServiceKey, TestDeviceServiceName, nil, device)
+		payload, err := json.Marshal(systemEvent)
+		require.NoError(t, err)
+		entries[i] = metadataModels.SystemEventHistoryEntry{
+			Sequence: uint64(i + 5),
+			Topic:    common.BuildTopic(systemEventTopic, common.DeviceSystemEventType, action, TestDeviceServiceName, TestDeviceProfileName),
+			Payload:  payload,
+		}
+	}
+	return entries
+}
+
+func replayRequest(cursor uint64, limit int, topic string) metadataDTOs.ReplaySystemEventsRequest {
+	return metadataDTOs.ReplaySystemEventsRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Cursor:      cursor,
+		Limit:       limit,
+		Topic:       topic,
+	}
+}
+
+func TestSystemEventHistory(t *testing.T) {
+	entries := mockSystemEventHistoryEntries(t)
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("SystemEventHistorySequences").Return(uint64(5), uint64(7), nil)
+	dbClientMock.On("SystemEventHistoryEntriesAfter", uint64(4), 2).Return(entries[:2], nil)
+	dbClientMock.On("SystemEventHistoryEntriesAfter", uint64(6), 20).Return(entries[2:], nil)
+	dbClientMock.On("SystemEventHistoryEntriesAfter", uint64(7), 20).Return([]metadataModels.SystemEventHistoryEntry{}, nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewSystemEventHistoryController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		cursor             string
+		limit              string
+		expectedSequences  []uint64
+		expectedNextCursor uint64
+		expectedStatusCode int
+	}{
+		{"Valid - first system events", "4", "2", []uint64{5, 6}, 6, http.StatusOK},
+		{"Valid - following system events with the default limit", "6", "", []uint64{7}, 7, http.StatusOK},
+		{"Valid - up to date", "7", "", []uint64{}, 7, http.StatusOK},
+		{"Invalid - system events no longer available", "3", "", nil, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - negative cursor", "-1", "", nil, 0, http.StatusBadRequest},
+		{"Invalid - limit greater than MaxResultCount", "4", "31", nil, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiSystemEventHistoryRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(pkgCommon.Cursor, testCase.cursor)
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.SystemEventHistory)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res metadataDTOs.SystemEventHistoryResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			sequences := make([]uint64, len(res.SystemEvents))
+			for i, e := range res.SystemEvents {
+				sequences[i] = e.Sequence
+				assert.Equal(t, common.DeviceSystemEventType, e.SystemEvent.Type)
+			}
+			assert.Equal(t, testCase.expectedSequences, sequences)
+			assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+			assert.Equal(t, uint64(7), res.LatestSequence, "Latest sequence not as expected")
+		})
+	}
+}
+
+func TestReplaySystemEvents(t *testing.T) {
+	entries := mockSystemEventHistoryEntries(t)
+	replayTopic := common.BuildTopic(common.DefaultBaseTopic, "replay")
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("SystemEventHistorySequences").Return(uint64(5), uint64(7), nil)
+	dbClientMock.On("SystemEventHistoryEntriesAfter", uint64(4), 30).Return(entries, nil)
+	dbClientMock.On("SystemEventHistoryEntriesAfter", uint64(5), 1).Return(entries[1:2], nil)
+	messageBus := &messagingMocks.MessageClient{}
+	messageBus.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return messageBus
+		},
+	})
+	controller := NewSystemEventHistoryController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		request            metadataDTOs.ReplaySystemEventsRequest
+		expectedReplayed   int
+		expectedNextCursor uint64
+		expectedStatusCode int
+	}{
+		{"Valid - replay the whole history", replayRequest(4, 0, "replay"), 3, 7, http.StatusOK},
+		{"Valid - replay with limit", replayRequest(5, 1, "replay"), 1, 6, http.StatusOK},
+		{"Invalid - system events no longer available", replayRequest(3, 0, "replay"), 0, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - no topic", replayRequest(4, 0, ""), 0, 0, http.StatusBadRequest},
+		{"Invalid - wildcard topic", replayRequest(4, 0, "replay/#"), 0, 0, http.StatusBadRequest},
+		{"Invalid - limit greater than MaxResultCount", replayRequest(4, 31, "replay"), 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			messageBus.Calls = nil
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiSystemEventHistoryReplayRoute, bytes.NewReader(jsonData))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.ReplaySystemEvents)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				messageBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+				return
+			}
+			var res metadataDTOs.ReplaySystemEventsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedReplayed, res.Replayed, "Replayed count not as expected")
+			assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+			assert.Equal(t, uint64(7), res.LatestSequence, "Latest sequence not as expected")
+			require.Len(t, messageBus.Calls, testCase.expectedReplayed)
+			for _, call := range messageBus.Calls {
+				expectedTopic := common.BuildTopic(replayTopic, common.CoreMetaDataServiceKey, common.DeviceSystemEventType)
+				assert.Contains(t, call.Arguments.String(1), expectedTopic)
+				assert.Contains(t, call.Arguments.String(1), TestDeviceProfileName)
+			}
+		})
+	}
+}
@@ -12,9 +12,13 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
@@ -44,3 +48,20 @@ func (uc *UnitOfMeasureController) UnitsOfMeasure(w http.ResponseWriter, r *http
 		pkg.EncodeAndWriteResponse(response, w, lc)
 	}
 }
+
+// ValidateUnits validates the units of the unit query parameters against the units of measure
+func (uc *UnitOfMeasureController) ValidateUnits(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(uc.dic.Get)
+	ctx := r.Context()
+
+	units := r.URL.Query()[pkgCommon.Unit]
+	if len(units) == 0 {
+		err := errors.NewCommonEdgeX(errors.KindContractInvalid, "at least one unit query parameter is required", nil)
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := metadataDTOs.NewUnitsValidationResponse("", "", http.StatusOK, application.ValidateUnits(units, uc.dic))
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -19,7 +19,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/uom"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 )
 
 func TestUnitOfMeasureController_UnitsOfMeasure(t *testing.T) {
@@ -84,3 +86,51 @@ func TestUnitOfMeasureController_UnitsOfMeasure(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitOfMeasureController_ValidateUnits(t *testing.T) {
+	testUoM := uom.UnitsOfMeasureImpl{
+		Units: map[string]uom.Unit{
+			"temperature": {Values: []string{"C", "F"}},
+		},
+	}
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+			return &testUoM
+		},
+	})
+	controller := NewUnitOfMeasureController(dic)
+
+	tests := []struct {
+		name               string
+		query              string
+		strict             bool
+		expectedUnits      []metadataDTOs.UnitValidation
+		expectedStatusCode int
+	}{
+		{"valid - known and unknown units", "unit=C&unit=kW", false, []metadataDTOs.UnitValidation{{Unit: "C", Valid: true}, {Unit: "kW", Valid: false}}, http.StatusOK},
+		{"valid - empty unit", "unit=", false, []metadataDTOs.UnitValidation{{Unit: "", Valid: true}}, http.StatusOK},
+		{"valid - empty unit, strict", "unit=", true, []metadataDTOs.UnitValidation{{Unit: "", Valid: false}}, http.StatusOK},
+		{"invalid - no unit", "", false, nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			container.ConfigurationFrom(dic.Get).Writable.UoM.Strict = testCase.strict
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiUnitsOfMeasureValidateRoute+"?"+testCase.query, http.NoBody)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.ValidateUnits)
+			handler.ServeHTTP(recorder, req)
+
+			require.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res metadataDTOs.UnitsValidationResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedUnits, res.Units, "Units not as expected")
+		})
+	}
+}
@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// BackupContent is the content of the backups of core-metadata, with all the entities it persists but the device
+// profile revisions and the change feed, which are the history of the entities rather than their state
+type BackupContent struct {
+	Bundle          Bundle           `json:"bundle"`
+	DeviceGroups    []DeviceGroup    `json:"deviceGroups"`
+	DeviceTemplates []DeviceTemplate `json:"deviceTemplates"`
+}
+
+// Restored returns the number of each kind of entity of the backup content
+func (c BackupContent) Restored() map[string]int {
+	return map[string]int{
+		"deviceServices":    len(c.Bundle.DeviceServices),
+		"deviceProfiles":    len(c.Bundle.DeviceProfiles),
+		"devices":           len(c.Bundle.Devices),
+		"provisionWatchers": len(c.Bundle.ProvisionWatchers),
+		"deviceGroups":      len(c.DeviceGroups),
+		"deviceTemplates":   len(c.DeviceTemplates),
+	}
+}
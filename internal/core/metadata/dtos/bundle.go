@@ -0,0 +1,45 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// BundleVersion is the version of the format of the bundles exported, only the bundles of this version can be imported
+const BundleVersion = 1
+
+// Bundle contains all the device services, device profiles, devices and provision watchers of a core-metadata
+// instance, to be imported into another instance
+type Bundle struct {
+	common.Versionable `json:",inline"`
+	BundleVersion      int                     `json:"bundleVersion"`
+	Created            int64                   `json:"created"`
+	DeviceServices     []dtos.DeviceService    `json:"deviceServices"`
+	DeviceProfiles     []dtos.DeviceProfile    `json:"deviceProfiles"`
+	Devices            []dtos.Device           `json:"devices"`
+	ProvisionWatchers  []dtos.ProvisionWatcher `json:"provisionWatchers"`
+}
+
+// BundleImportResponse defines the Response Content for POST bundle, with the number of each kind of entity imported
+type BundleImportResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeviceServices      int `json:"deviceServices"`
+	DeviceProfiles      int `json:"deviceProfiles"`
+	Devices             int `json:"devices"`
+	ProvisionWatchers   int `json:"provisionWatchers"`
+}
+
+func NewBundleImportResponse(requestId string, message string, statusCode int, bundle Bundle) BundleImportResponse {
+	return BundleImportResponse{
+		BaseResponse:      common.NewBaseResponse(requestId, message, statusCode),
+		DeviceServices:    len(bundle.DeviceServices),
+		DeviceProfiles:    len(bundle.DeviceProfiles),
+		Devices:           len(bundle.Devices),
+		ProvisionWatchers: len(bundle.ProvisionWatchers),
+	}
+}
@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// ChangeFeedEntry is a mutation of the metadata recorded in the change feed
+type ChangeFeedEntry struct {
+	Sequence  uint64 `json:"sequence"`
+	Timestamp int64  `json:"timestamp"`
+	Type      string `json:"type"`
+	Action    string `json:"action"`
+	Name      string `json:"name"`
+	Details   any    `json:"details"`
+}
+
+// FromChangeFeedEntryModelToDTO transforms the ChangeFeedEntry Model to the ChangeFeedEntry DTO
+func FromChangeFeedEntryModelToDTO(e metadataModels.ChangeFeedEntry) ChangeFeedEntry {
+	return ChangeFeedEntry{
+		Sequence:  e.Sequence,
+		Timestamp: e.Timestamp,
+		Type:      e.Type,
+		Action:    e.Action,
+		Name:      e.Name,
+		Details:   e.Details,
+	}
+}
+
+// ChangeFeedResponse defines the Response Content for GET change feed
+type ChangeFeedResponse struct {
+	common.BaseResponse `json:",inline"`
+	Changes             []ChangeFeedEntry `json:"changes"`
+	// NextCursor is the cursor to request the changes following the returned ones
+	NextCursor uint64 `json:"nextCursor"`
+	// LatestSequence is the sequence of the latest recorded change, the client is up to date once NextCursor reaches it
+	LatestSequence uint64 `json:"latestSequence"`
+}
+
+func NewChangeFeedResponse(requestId string, message string, statusCode int, changes []ChangeFeedEntry, nextCursor uint64, latestSequence uint64) ChangeFeedResponse {
+	return ChangeFeedResponse{
+		BaseResponse:   common.NewBaseResponse(requestId, message, statusCode),
+		Changes:        changes,
+		NextCursor:     nextCursor,
+		LatestSequence: latestSequence,
+	}
+}
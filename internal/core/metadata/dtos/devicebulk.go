@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// BulkDeviceResult is the result of the operation on one device of a bulk request
+type BulkDeviceResult struct {
+	RequestId  string `json:"requestId,omitempty"`
+	Name       string `json:"name"`
+	Id         string `json:"id,omitempty"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message,omitempty"`
+}
+
+// DeleteDevicesRequest defines the Request Content for bulk DELETE Device
+type DeleteDevicesRequest struct {
+	common.BaseRequest `json:",inline"`
+	DeviceNames        []string `json:"deviceNames" validate:"gt=0,dive,required,edgex-dto-none-empty-string"`
+}
+
+// Validate satisfies the Validator interface
+func (r DeleteDevicesRequest) Validate() error {
+	return contractsCommon.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the DeleteDevicesRequest type
+func (r *DeleteDevicesRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		DeviceNames []string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = DeleteDevicesRequest(alias)
+	return r.Validate()
+}
+
+// BulkDevicesResponse defines the Response Content for the bulk Device operations. The status code is the status of
+// the whole operation, the result of each device is in the results, in the order of the request.
+type BulkDevicesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Mode                string             `json:"mode"`
+	Results             []BulkDeviceResult `json:"results"`
+}
+
+func NewBulkDevicesResponse(requestId string, message string, statusCode int, mode string, results []BulkDeviceResult) BulkDevicesResponse {
+	return BulkDevicesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Mode:         mode,
+		Results:      results,
+	}
+}
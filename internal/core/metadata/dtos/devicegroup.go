@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// DeviceGroup is a named set of devices, its members are the devices it names and the devices carrying all the
+// labels of its selector
+type DeviceGroup struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string   `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string   `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string   `json:"description,omitempty"`
+	Devices          []string `json:"devices,omitempty" validate:"dive,required,edgex-dto-none-empty-string"`
+	Labels           []string `json:"labels,omitempty" validate:"dive,required,edgex-dto-none-empty-string"`
+}
+
+// UpdateDeviceGroup defines the fields of the device group which can be patched, the device group is identified by
+// its name
+type UpdateDeviceGroup struct {
+	Name        *string  `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Description *string  `json:"description"`
+	Devices     []string `json:"devices" validate:"dive,required,edgex-dto-none-empty-string"`
+	Labels      []string `json:"labels" validate:"dive,required,edgex-dto-none-empty-string"`
+}
+
+// ToDeviceGroupModel transforms the DeviceGroup DTO to the DeviceGroup Model
+func ToDeviceGroupModel(dto DeviceGroup) metadataModels.DeviceGroup {
+	return metadataModels.DeviceGroup{
+		DBTimestamp: models.DBTimestamp(dto.DBTimestamp),
+		Id:          dto.Id,
+		Name:        dto.Name,
+		Description: dto.Description,
+		Devices:     dto.Devices,
+		Labels:      dto.Labels,
+	}
+}
+
+// FromDeviceGroupModelToDTO transforms the DeviceGroup Model to the DeviceGroup DTO
+func FromDeviceGroupModelToDTO(dg metadataModels.DeviceGroup) DeviceGroup {
+	return DeviceGroup{
+		DBTimestamp: dtos.DBTimestamp(dg.DBTimestamp),
+		Id:          dg.Id,
+		Name:        dg.Name,
+		Description: dg.Description,
+		Devices:     dg.Devices,
+		Labels:      dg.Labels,
+	}
+}
+
+// ReplaceDeviceGroupModelFieldsWithDTO replaces the fields of the DeviceGroup Model with the patched fields of the DTO
+func ReplaceDeviceGroupModelFieldsWithDTO(dg *metadataModels.DeviceGroup, patch UpdateDeviceGroup) {
+	if patch.Description != nil {
+		dg.Description = *patch.Description
+	}
+	if patch.Devices != nil {
+		dg.Devices = patch.Devices
+	}
+	if patch.Labels != nil {
+		dg.Labels = patch.Labels
+	}
+}
+
+// AddDeviceGroupRequest defines the Request Content for POST DeviceGroup DTO
+type AddDeviceGroupRequest struct {
+	common.BaseRequest `json:",inline"`
+	Group              DeviceGroup `json:"group"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddDeviceGroupRequest) Validate() error {
+	if err := contractsCommon.Validate(r); err != nil {
+		return err
+	}
+	if len(r.Group.Devices) == 0 && len(r.Group.Labels) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "devices or labels must be specified", nil)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDeviceGroupRequest type
+func (r *AddDeviceGroupRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Group DeviceGroup
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddDeviceGroupRequest(alias)
+	return r.Validate()
+}
+
+// UpdateDeviceGroupRequest defines the Request Content for PATCH DeviceGroup DTO
+type UpdateDeviceGroupRequest struct {
+	common.BaseRequest `json:",inline"`
+	Group              UpdateDeviceGroup `json:"group"`
+}
+
+// Validate satisfies the Validator interface
+func (r UpdateDeviceGroupRequest) Validate() error {
+	return contractsCommon.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateDeviceGroupRequest type
+func (r *UpdateDeviceGroupRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Group UpdateDeviceGroup
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = UpdateDeviceGroupRequest(alias)
+	return r.Validate()
+}
+
+// DeviceGroupResponse defines the Response Content for GET DeviceGroup DTO
+type DeviceGroupResponse struct {
+	common.BaseResponse `json:",inline"`
+	Group               DeviceGroup `json:"group"`
+}
+
+func NewDeviceGroupResponse(requestId string, message string, statusCode int, group DeviceGroup) DeviceGroupResponse {
+	return DeviceGroupResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Group:        group,
+	}
+}
+
+// MultiDeviceGroupsResponse defines the Response Content for GET multiple DeviceGroup DTOs
+type MultiDeviceGroupsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Groups                            []DeviceGroup `json:"groups"`
+}
+
+func NewMultiDeviceGroupsResponse(requestId string, message string, statusCode int, totalCount uint32, groups []DeviceGroup) MultiDeviceGroupsResponse {
+	return MultiDeviceGroupsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Groups:                     groups,
+	}
+}
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+)
+
+// DeviceLifecycleTransition is the details of the system events published when the lifecycle state of a device
+// changes
+type DeviceLifecycleTransition struct {
+	Device dtos.Device `json:"device"`
+	// PreviousState is empty when the device had no lifecycle state
+	PreviousState string `json:"previousState,omitempty"`
+	State         string `json:"state"`
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// DeviceProfileRevision is a revision of a device profile with its version
+type DeviceProfileRevision struct {
+	Version uint64             `json:"version"`
+	Created int64              `json:"created"`
+	Profile dtos.DeviceProfile `json:"profile"`
+}
+
+// FromDeviceProfileRevisionModelToDTO transforms the DeviceProfileRevision Model to the DeviceProfileRevision DTO
+func FromDeviceProfileRevisionModelToDTO(r models.DeviceProfileRevision) DeviceProfileRevision {
+	return DeviceProfileRevision{
+		Version: r.Version,
+		Created: r.Created,
+		Profile: dtos.FromDeviceProfileModelToDTO(r.Profile),
+	}
+}
+
+// DeviceProfileRevisionResponse defines the Response Content for GET DeviceProfileRevision DTO
+type DeviceProfileRevisionResponse struct {
+	common.BaseResponse `json:",inline"`
+	Revision            DeviceProfileRevision `json:"revision"`
+}
+
+func NewDeviceProfileRevisionResponse(requestId string, message string, statusCode int, revision DeviceProfileRevision) DeviceProfileRevisionResponse {
+	return DeviceProfileRevisionResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Revision:     revision,
+	}
+}
+
+// MultiDeviceProfileRevisionsResponse defines the Response Content for GET multiple DeviceProfileRevision DTOs
+type MultiDeviceProfileRevisionsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Revisions                         []DeviceProfileRevision `json:"revisions"`
+}
+
+func NewMultiDeviceProfileRevisionsResponse(requestId string, message string, statusCode int, totalCount uint32, revisions []DeviceProfileRevision) MultiDeviceProfileRevisionsResponse {
+	return MultiDeviceProfileRevisionsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Revisions:                  revisions,
+	}
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// Severities of the device profile issues
+const (
+	// IssueSeverityError is the severity of the issues which make core-metadata or the device services reject the
+	// device profile, or make some of its device commands unusable
+	IssueSeverityError = "error"
+	// IssueSeverityWarning is the severity of the issues which are likely mistakes without making the profile invalid
+	IssueSeverityWarning = "warning"
+)
+
+// DeviceProfileIssue is a problem found in a device profile, the path locates the faulty element of the profile,
+// e.g. deviceResources[2], and is empty for the issues of the profile itself
+type DeviceProfileIssue struct {
+	Severity string `json:"severity"`
+	Path     string `json:"path,omitempty"`
+	Message  string `json:"message"`
+}
+
+// DeviceProfileCompatibility reports whether a device profile can replace an existing device profile without
+// breaking the devices using it
+type DeviceProfileCompatibility struct {
+	ExistingProfileName string `json:"existingProfileName"`
+	// DeviceCount is the number of the devices using the existing device profile
+	DeviceCount     uint32   `json:"deviceCount"`
+	Compatible      bool     `json:"compatible"`
+	BreakingChanges []string `json:"breakingChanges,omitempty"`
+	// AffectedDevices are the devices whose auto events read a source which the device profile no longer provides
+	AffectedDevices []string `json:"affectedDevices,omitempty"`
+}
+
+// ValidateDeviceProfileRequest defines the Request Content for POST device profile validation. The profile isn't
+// validated when the request is read, its problems are reported in the response.
+type ValidateDeviceProfileRequest struct {
+	common.BaseRequest `json:",inline"`
+	Profile            dtos.DeviceProfile `json:"profile"`
+	// ExistingProfileName is the name of the device profile the profile is checked to be backward-compatible with, no
+	// compatibility check is performed when empty
+	ExistingProfileName string `json:"existingProfileName,omitempty"`
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the ValidateDeviceProfileRequest type
+func (r *ValidateDeviceProfileRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Profile             dtos.DeviceProfile
+		ExistingProfileName string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = ValidateDeviceProfileRequest(alias)
+	return nil
+}
+
+// ValidateDeviceProfileResponse defines the Response Content for POST device profile validation
+type ValidateDeviceProfileResponse struct {
+	common.BaseResponse `json:",inline"`
+	// Valid is true when no issue has the error severity
+	Valid         bool                        `json:"valid"`
+	Issues        []DeviceProfileIssue        `json:"issues"`
+	Compatibility *DeviceProfileCompatibility `json:"compatibility,omitempty"`
+}
+
+func NewValidateDeviceProfileResponse(requestId string, message string, statusCode int, issues []DeviceProfileIssue, compatibility *DeviceProfileCompatibility) ValidateDeviceProfileResponse {
+	valid := true
+	for _, issue := range issues {
+		if issue.Severity == IssueSeverityError {
+			valid = false
+			break
+		}
+	}
+	return ValidateDeviceProfileResponse{
+		BaseResponse:  common.NewBaseResponse(requestId, message, statusCode),
+		Valid:         valid,
+		Issues:        issues,
+		Compatibility: compatibility,
+	}
+}
@@ -0,0 +1,290 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+const (
+	// DeviceTemplateIndexParameter is the parameter of each instance of a device template carrying its index
+	DeviceTemplateIndexParameter = "index"
+	// MaxDeviceTemplateInstances is the maximum number of devices instantiated from a device template by one request
+	MaxDeviceTemplateInstances = 1000
+)
+
+// DeviceTemplate describes the devices instantiated from it. The placeholders ${parameter} of its device name, device
+// description, labels, location, protocols, auto events and properties are substituted with the parameters of each
+// instance, the device service and device profile being the same for all the instances.
+type DeviceTemplate struct {
+	dtos.DBTimestamp  `json:",inline"`
+	Id                string                             `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name              string                             `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description       string                             `json:"description,omitempty"`
+	DeviceName        string                             `json:"deviceName" validate:"required,edgex-dto-none-empty-string"`
+	DeviceDescription string                             `json:"deviceDescription,omitempty"`
+	ServiceName       string                             `json:"serviceName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	ProfileName       string                             `json:"profileName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	AdminState        string                             `json:"adminState,omitempty" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	Labels            []string                           `json:"labels,omitempty"`
+	Location          any                                `json:"location,omitempty"`
+	Protocols         map[string]dtos.ProtocolProperties `json:"protocols" validate:"required,gt=0"`
+	AutoEvents        []dtos.AutoEvent                   `json:"autoEvents,omitempty"`
+	Properties        map[string]any                     `json:"properties,omitempty"`
+}
+
+// UpdateDeviceTemplate defines the fields of the device template which can be patched, the device template is
+// identified by its name
+type UpdateDeviceTemplate struct {
+	Name              *string                            `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Description       *string                            `json:"description"`
+	DeviceName        *string                            `json:"deviceName" validate:"omitempty,edgex-dto-none-empty-string"`
+	DeviceDescription *string                            `json:"deviceDescription"`
+	ServiceName       *string                            `json:"serviceName" validate:"omitempty,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	ProfileName       *string                            `json:"profileName" validate:"omitempty,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	AdminState        *string                            `json:"adminState" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	Labels            []string                           `json:"labels"`
+	Location          any                                `json:"location"`
+	Protocols         map[string]dtos.ProtocolProperties `json:"protocols" validate:"omitempty,gt=0"`
+	AutoEvents        []dtos.AutoEvent                   `json:"autoEvents"`
+	Properties        map[string]any                     `json:"properties"`
+}
+
+// ToDeviceTemplateModel transforms the DeviceTemplate DTO to the DeviceTemplate Model
+func ToDeviceTemplateModel(dto DeviceTemplate) metadataModels.DeviceTemplate {
+	return metadataModels.DeviceTemplate{
+		DBTimestamp:       models.DBTimestamp(dto.DBTimestamp),
+		Id:                dto.Id,
+		Name:              dto.Name,
+		Description:       dto.Description,
+		DeviceName:        dto.DeviceName,
+		DeviceDescription: dto.DeviceDescription,
+		ServiceName:       dto.ServiceName,
+		ProfileName:       dto.ProfileName,
+		AdminState:        models.AdminState(dto.AdminState),
+		Labels:            dto.Labels,
+		Location:          dto.Location,
+		Protocols:         dtos.ToProtocolModels(dto.Protocols),
+		AutoEvents:        dtos.ToAutoEventModels(dto.AutoEvents),
+		Properties:        dto.Properties,
+	}
+}
+
+// FromDeviceTemplateModelToDTO transforms the DeviceTemplate Model to the DeviceTemplate DTO
+func FromDeviceTemplateModelToDTO(dt metadataModels.DeviceTemplate) DeviceTemplate {
+	return DeviceTemplate{
+		DBTimestamp:       dtos.DBTimestamp(dt.DBTimestamp),
+		Id:                dt.Id,
+		Name:              dt.Name,
+		Description:       dt.Description,
+		DeviceName:        dt.DeviceName,
+		DeviceDescription: dt.DeviceDescription,
+		ServiceName:       dt.ServiceName,
+		ProfileName:       dt.ProfileName,
+		AdminState:        string(dt.AdminState),
+		Labels:            dt.Labels,
+		Location:          dt.Location,
+		Protocols:         dtos.FromProtocolModelsToDTOs(dt.Protocols),
+		AutoEvents:        dtos.FromAutoEventModelsToDTOs(dt.AutoEvents),
+		Properties:        dt.Properties,
+	}
+}
+
+// ReplaceDeviceTemplateModelFieldsWithDTO replaces the fields of the DeviceTemplate Model with the patched fields of
+// the DTO
+func ReplaceDeviceTemplateModelFieldsWithDTO(dt *metadataModels.DeviceTemplate, patch UpdateDeviceTemplate) {
+	if patch.Description != nil {
+		dt.Description = *patch.Description
+	}
+	if patch.DeviceName != nil {
+		dt.DeviceName = *patch.DeviceName
+	}
+	if patch.DeviceDescription != nil {
+		dt.DeviceDescription = *patch.DeviceDescription
+	}
+	if patch.ServiceName != nil {
+		dt.ServiceName = *patch.ServiceName
+	}
+	if patch.ProfileName != nil {
+		dt.ProfileName = *patch.ProfileName
+	}
+	if patch.AdminState != nil {
+		dt.AdminState = models.AdminState(*patch.AdminState)
+	}
+	if patch.Labels != nil {
+		dt.Labels = patch.Labels
+	}
+	if patch.Location != nil {
+		dt.Location = patch.Location
+	}
+	if patch.Protocols != nil {
+		dt.Protocols = dtos.ToProtocolModels(patch.Protocols)
+	}
+	if patch.AutoEvents != nil {
+		dt.AutoEvents = dtos.ToAutoEventModels(patch.AutoEvents)
+	}
+	if patch.Properties != nil {
+		dt.Properties = patch.Properties
+	}
+}
+
+// AddDeviceTemplateRequest defines the Request Content for POST DeviceTemplate DTO
+type AddDeviceTemplateRequest struct {
+	common.BaseRequest `json:",inline"`
+	Template           DeviceTemplate `json:"template"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddDeviceTemplateRequest) Validate() error {
+	return contractsCommon.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDeviceTemplateRequest type
+func (r *AddDeviceTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Template DeviceTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddDeviceTemplateRequest(alias)
+	return r.Validate()
+}
+
+// UpdateDeviceTemplateRequest defines the Request Content for PATCH DeviceTemplate DTO
+type UpdateDeviceTemplateRequest struct {
+	common.BaseRequest `json:",inline"`
+	Template           UpdateDeviceTemplate `json:"template"`
+}
+
+// Validate satisfies the Validator interface
+func (r UpdateDeviceTemplateRequest) Validate() error {
+	return contractsCommon.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateDeviceTemplateRequest type
+func (r *UpdateDeviceTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Template UpdateDeviceTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = UpdateDeviceTemplateRequest(alias)
+	return r.Validate()
+}
+
+// InstantiateDeviceTemplateRequest defines the Request Content for POST device template instantiation. Either count
+// devices are instantiated with the same parameters, or one device is instantiated per instance with the parameters
+// of the instance added to the common parameters. The index parameter of each device is its position in the request
+// plus startIndex.
+type InstantiateDeviceTemplateRequest struct {
+	common.BaseRequest `json:",inline"`
+	Count              int                 `json:"count,omitempty" validate:"gte=0"`
+	StartIndex         int                 `json:"startIndex,omitempty"`
+	Parameters         map[string]string   `json:"parameters,omitempty"`
+	Instances          []map[string]string `json:"instances,omitempty"`
+}
+
+// Validate satisfies the Validator interface
+func (r InstantiateDeviceTemplateRequest) Validate() error {
+	if err := contractsCommon.Validate(r); err != nil {
+		return err
+	}
+	count := r.Count
+	if len(r.Instances) > 0 {
+		if count != 0 && count != len(r.Instances) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("count %d doesn't match the %d instances", count, len(r.Instances)), nil)
+		}
+		count = len(r.Instances)
+	}
+	if count == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "count or instances must be specified", nil)
+	}
+	if count > MaxDeviceTemplateInstances {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("at most %d devices can be instantiated at once", MaxDeviceTemplateInstances), nil)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the InstantiateDeviceTemplateRequest type
+func (r *InstantiateDeviceTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Count      int
+		StartIndex int
+		Parameters map[string]string
+		Instances  []map[string]string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = InstantiateDeviceTemplateRequest(alias)
+	return r.Validate()
+}
+
+// InstanceParameters returns the parameters of each device to instantiate
+func (r InstantiateDeviceTemplateRequest) InstanceParameters() []map[string]string {
+	count := r.Count
+	if len(r.Instances) > 0 {
+		count = len(r.Instances)
+	}
+	result := make([]map[string]string, count)
+	for i := range result {
+		parameters := map[string]string{DeviceTemplateIndexParameter: strconv.Itoa(r.StartIndex + i)}
+		for k, v := range r.Parameters {
+			parameters[k] = v
+		}
+		if i < len(r.Instances) {
+			for k, v := range r.Instances[i] {
+				parameters[k] = v
+			}
+		}
+		result[i] = parameters
+	}
+	return result
+}
+
+// DeviceTemplateResponse defines the Response Content for GET DeviceTemplate DTO
+type DeviceTemplateResponse struct {
+	common.BaseResponse `json:",inline"`
+	Template            DeviceTemplate `json:"template"`
+}
+
+func NewDeviceTemplateResponse(requestId string, message string, statusCode int, template DeviceTemplate) DeviceTemplateResponse {
+	return DeviceTemplateResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Template:     template,
+	}
+}
+
+// MultiDeviceTemplatesResponse defines the Response Content for GET multiple DeviceTemplate DTOs
+type MultiDeviceTemplatesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Templates                         []DeviceTemplate `json:"templates"`
+}
+
+func NewMultiDeviceTemplatesResponse(requestId string, message string, statusCode int, totalCount uint32, templates []DeviceTemplate) MultiDeviceTemplatesResponse {
+	return MultiDeviceTemplatesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Templates:                  templates,
+	}
+}
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// DeviceServiceLiveness is the details of the system events published when a device service publishing heartbeats is
+// considered down or up again
+type DeviceServiceLiveness struct {
+	ServiceName string `json:"serviceName"`
+	// State is either down or up
+	State string `json:"state"`
+	// LastHeartbeat is the time of the last heartbeat received from the device service, in nanoseconds
+	LastHeartbeat int64 `json:"lastHeartbeat"`
+	// Devices are the names of the devices marked DOWN when the device service went down, or UP again when it came
+	// back up
+	Devices []string `json:"devices"`
+}
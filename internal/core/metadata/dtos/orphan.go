@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// Kinds of the orphans
+const (
+	// OrphanKindMissingDeviceService is the kind of the devices and provision watchers whose device service doesn't exist
+	OrphanKindMissingDeviceService = "missingDeviceService"
+	// OrphanKindMissingDeviceProfile is the kind of the devices and provision watchers whose device profile doesn't exist
+	OrphanKindMissingDeviceProfile = "missingDeviceProfile"
+	// OrphanKindMissingAutoEventSource is the kind of the devices with an auto event whose source is neither a device
+	// resource nor a device command of their device profile
+	OrphanKindMissingAutoEventSource = "missingAutoEventSource"
+)
+
+// Orphan is a device or provision watcher referencing an entity which doesn't exist. Repairing a device or provision
+// watcher whose device service or device profile is missing deletes it, repairing a device with an auto event whose
+// source is missing removes the auto event.
+type Orphan struct {
+	// EntityType is device or provisionwatcher
+	EntityType string `json:"entityType"`
+	Name       string `json:"name"`
+	Kind       string `json:"kind"`
+	// Reference is the name of the missing entity
+	Reference string `json:"reference"`
+	Repaired  bool   `json:"repaired"`
+}
+
+// OrphansResponse defines the Response Content for GET orphans and POST orphan repair
+type OrphansResponse struct {
+	common.BaseResponse `json:",inline"`
+	Orphans             []Orphan `json:"orphans"`
+}
+
+func NewOrphansResponse(requestId string, message string, statusCode int, orphans []Orphan) OrphansResponse {
+	return OrphansResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Orphans:      orphans,
+	}
+}
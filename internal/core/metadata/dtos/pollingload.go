@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// DeviceServicePollingLoad is the load the auto events of the devices put on their device service. Only the auto
+// events of the unlocked devices of an unlocked device service are effective.
+type DeviceServicePollingLoad struct {
+	ServiceName string `json:"serviceName"`
+	// Devices is the number of the devices with effective auto events
+	Devices    int `json:"devices"`
+	AutoEvents int `json:"autoEvents"`
+	// ReadsPerSecond is the number of the reads the effective auto events trigger per second
+	ReadsPerSecond float64 `json:"readsPerSecond"`
+	// ShortestInterval is the interval of the most frequent effective auto event, empty when there is none
+	ShortestInterval string `json:"shortestInterval,omitempty"`
+}
+
+// DeviceServicePollingLoadsResponse defines the Response Content for GET device service polling loads
+type DeviceServicePollingLoadsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Loads               []DeviceServicePollingLoad `json:"loads"`
+}
+
+func NewDeviceServicePollingLoadsResponse(requestId string, message string, statusCode int, loads []DeviceServicePollingLoad) DeviceServicePollingLoadsResponse {
+	return DeviceServicePollingLoadsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Loads:        loads,
+	}
+}
@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// CandidateDevice is a device as discovered by a device service, before it is matched against the provision watchers
+type CandidateDevice struct {
+	Name        string                             `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Description string                             `json:"description,omitempty"`
+	Labels      []string                           `json:"labels,omitempty"`
+	Protocols   map[string]dtos.ProtocolProperties `json:"protocols" validate:"required,gt=0"`
+}
+
+// ProvisionWatcherMatch is the outcome of matching a candidate device against a provision watcher, with the device
+// the provision watcher would create when it matches
+type ProvisionWatcherMatch struct {
+	ProvisionWatcherName string       `json:"provisionWatcherName"`
+	ServiceName          string       `json:"serviceName"`
+	Matched              bool         `json:"matched"`
+	Reason               string       `json:"reason,omitempty"`
+	Device               *dtos.Device `json:"device,omitempty"`
+}
+
+// ProvisionWatcherDryRunRequest defines the Request Content for POST provision watcher dry-run
+type ProvisionWatcherDryRunRequest struct {
+	common.BaseRequest `json:",inline"`
+	// ServiceName limits the dry-run to the provision watchers of the device service, all the provision watchers are
+	// matched when empty
+	ServiceName string          `json:"serviceName,omitempty"`
+	Device      CandidateDevice `json:"device"`
+}
+
+// Validate satisfies the Validator interface
+func (r ProvisionWatcherDryRunRequest) Validate() error {
+	return contractsCommon.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the ProvisionWatcherDryRunRequest type
+func (r *ProvisionWatcherDryRunRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		ServiceName string
+		Device      CandidateDevice
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = ProvisionWatcherDryRunRequest(alias)
+	return r.Validate()
+}
+
+// ProvisionWatcherDryRunResponse defines the Response Content for POST provision watcher dry-run
+type ProvisionWatcherDryRunResponse struct {
+	common.BaseResponse `json:",inline"`
+	// DeviceExists is true when a device with the name of the candidate device already exists, the device services
+	// don't provision such a device whatever the matches
+	DeviceExists bool                    `json:"deviceExists"`
+	Matches      []ProvisionWatcherMatch `json:"matches"`
+}
+
+func NewProvisionWatcherDryRunResponse(requestId string, message string, statusCode int, deviceExists bool, matches []ProvisionWatcherMatch) ProvisionWatcherDryRunResponse {
+	return ProvisionWatcherDryRunResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DeviceExists: deviceExists,
+		Matches:      matches,
+	}
+}
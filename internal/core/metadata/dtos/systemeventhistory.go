@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+// SystemEventHistoryEntry is a system event published by core-metadata recorded in the system event history
+type SystemEventHistoryEntry struct {
+	Sequence    uint64           `json:"sequence"`
+	Topic       string           `json:"topic"`
+	SystemEvent dtos.SystemEvent `json:"systemEvent"`
+}
+
+// FromSystemEventHistoryEntryModelToDTO transforms the SystemEventHistoryEntry Model to the SystemEventHistoryEntry DTO
+func FromSystemEventHistoryEntryModelToDTO(e metadataModels.SystemEventHistoryEntry) (SystemEventHistoryEntry, error) {
+	entry := SystemEventHistoryEntry{
+		Sequence: e.Sequence,
+		Topic:    e.Topic,
+	}
+	err := json.Unmarshal(e.Payload, &entry.SystemEvent)
+	return entry, err
+}
+
+// SystemEventHistoryResponse defines the Response Content for GET system event history
+type SystemEventHistoryResponse struct {
+	common.BaseResponse `json:",inline"`
+	SystemEvents        []SystemEventHistoryEntry `json:"systemEvents"`
+	// NextCursor is the cursor to request the system events following the returned ones
+	NextCursor uint64 `json:"nextCursor"`
+	// LatestSequence is the sequence of the latest recorded system event, the client is up to date once NextCursor
+	// reaches it
+	LatestSequence uint64 `json:"latestSequence"`
+}
+
+func NewSystemEventHistoryResponse(requestId string, message string, statusCode int, systemEvents []SystemEventHistoryEntry, nextCursor uint64, latestSequence uint64) SystemEventHistoryResponse {
+	return SystemEventHistoryResponse{
+		BaseResponse:   common.NewBaseResponse(requestId, message, statusCode),
+		SystemEvents:   systemEvents,
+		NextCursor:     nextCursor,
+		LatestSequence: latestSequence,
+	}
+}
+
+// ReplaySystemEventsRequest defines the Request Content for POST system event history replay
+type ReplaySystemEventsRequest struct {
+	common.BaseRequest `json:",inline"`
+	// Cursor is the sequence of the last system event already received, 0 to replay the history from the start
+	Cursor uint64 `json:"cursor"`
+	// Limit is the maximum number of system events replayed, defaults to Service.MaxResultCount
+	Limit int `json:"limit,omitempty" validate:"gte=0"`
+	// Topic is the topic, below the MessageBus base topic, the system events are replayed to. The levels of their
+	// original topic following the system event topic, i.e. source, type, action, owner and profile, are kept.
+	Topic string `json:"topic" validate:"required,edgex-dto-none-empty-string,excludesall=#+*>"`
+}
+
+// Validate satisfies the Validator interface
+func (r ReplaySystemEventsRequest) Validate() error {
+	return contractsCommon.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the ReplaySystemEventsRequest type
+func (r *ReplaySystemEventsRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Cursor uint64
+		Limit  int
+		Topic  string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = ReplaySystemEventsRequest(alias)
+	return r.Validate()
+}
+
+// ReplaySystemEventsResponse defines the Response Content for POST system event history replay
+type ReplaySystemEventsResponse struct {
+	common.BaseResponse `json:",inline"`
+	// Replayed is the number of system events replayed
+	Replayed int `json:"replayed"`
+	// NextCursor is the cursor to replay the system events following the replayed ones
+	NextCursor uint64 `json:"nextCursor"`
+	// LatestSequence is the sequence of the latest recorded system event
+	LatestSequence uint64 `json:"latestSequence"`
+}
+
+func NewReplaySystemEventsResponse(requestId string, message string, statusCode int, replayed int, nextCursor uint64, latestSequence uint64) ReplaySystemEventsResponse {
+	return ReplaySystemEventsResponse{
+		BaseResponse:   common.NewBaseResponse(requestId, message, statusCode),
+		Replayed:       replayed,
+		NextCursor:     nextCursor,
+		LatestSequence: latestSequence,
+	}
+}
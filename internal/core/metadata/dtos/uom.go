@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// UnitValidation reports whether a unit is valid against the units of measure
+type UnitValidation struct {
+	Unit  string `json:"unit"`
+	Valid bool   `json:"valid"`
+}
+
+// UnitsValidationResponse defines the Response Content for GET units of measure validation
+type UnitsValidationResponse struct {
+	common.BaseResponse `json:",inline"`
+	Units               []UnitValidation `json:"units"`
+}
+
+func NewUnitsValidationResponse(requestId string, message string, statusCode int, units []UnitValidation) UnitsValidationResponse {
+	return UnitsValidationResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Units:        units,
+	}
+}
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"google.golang.org/grpc"
+
+	metadataGrpc "github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/grpc"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	edgexpb "github.com/edgexfoundry/edgex-go/proto/edgex/v3"
+)
+
+// grpcServices is the CoreMetadata gRPC service, authenticated like the REST API. It only queries the resources, so
+// all its calls require the read permission.
+var grpcServices = pkgHandlers.GrpcServices{
+	Register: func(server *grpc.Server, dic *di.Container) {
+		edgexpb.RegisterCoreMetadataServer(server, metadataGrpc.NewCoreMetadataServer(dic))
+	},
+	AuthenticationHook: newAuthenticationHook,
+}
@@ -8,6 +8,8 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 )
 
 type DBClient interface {
@@ -27,6 +29,27 @@ type DBClient interface {
 	DeviceProfileCountByLabels(labels []string) (uint32, errors.EdgeX)
 	DeviceProfileCountByManufacturer(manufacturer string) (uint32, errors.EdgeX)
 	DeviceProfileCountByModel(model string) (uint32, errors.EdgeX)
+	DeviceProfileRevisionsByName(offset int, limit int, name string) ([]metadataModels.DeviceProfileRevision, errors.EdgeX)
+	DeviceProfileRevisionCountByName(name string) (uint32, errors.EdgeX)
+	DeviceProfileRevisionByNameAndVersion(name string, version uint64) (metadataModels.DeviceProfileRevision, errors.EdgeX)
+	AddDeviceGroup(dg metadataModels.DeviceGroup) (metadataModels.DeviceGroup, errors.EdgeX)
+	DeviceGroupByName(name string) (metadataModels.DeviceGroup, errors.EdgeX)
+	AllDeviceGroups(offset int, limit int) ([]metadataModels.DeviceGroup, errors.EdgeX)
+	DeviceGroupTotalCount() (uint32, errors.EdgeX)
+	UpdateDeviceGroup(dg metadataModels.DeviceGroup) errors.EdgeX
+	DeleteDeviceGroupByName(name string) errors.EdgeX
+	AddDeviceTemplate(dt metadataModels.DeviceTemplate) (metadataModels.DeviceTemplate, errors.EdgeX)
+	DeviceTemplateByName(name string) (metadataModels.DeviceTemplate, errors.EdgeX)
+	AllDeviceTemplates(offset int, limit int) ([]metadataModels.DeviceTemplate, errors.EdgeX)
+	DeviceTemplateTotalCount() (uint32, errors.EdgeX)
+	UpdateDeviceTemplate(dt metadataModels.DeviceTemplate) errors.EdgeX
+	DeleteDeviceTemplateByName(name string) errors.EdgeX
+	AddChangeFeedEntry(entry metadataModels.ChangeFeedEntry, maxEntries int) (metadataModels.ChangeFeedEntry, errors.EdgeX)
+	ChangeFeedEntriesAfter(cursor uint64, limit int) ([]metadataModels.ChangeFeedEntry, errors.EdgeX)
+	ChangeFeedSequences() (oldest uint64, latest uint64, err errors.EdgeX)
+	AddSystemEventHistoryEntry(entry metadataModels.SystemEventHistoryEntry, maxEntries int) (metadataModels.SystemEventHistoryEntry, errors.EdgeX)
+	SystemEventHistoryEntriesAfter(cursor uint64, limit int) ([]metadataModels.SystemEventHistoryEntry, errors.EdgeX)
+	SystemEventHistorySequences() (oldest uint64, latest uint64, err errors.EdgeX)
 
 	AddDeviceService(ds model.DeviceService) (model.DeviceService, errors.EdgeX)
 	DeviceServiceById(id string) (model.DeviceService, errors.EdgeX)
@@ -49,9 +72,19 @@ type DBClient interface {
 	AllDevices(offset int, limit int, labels []string) ([]model.Device, errors.EdgeX)
 	DevicesByProfileName(offset int, limit int, profileName string) ([]model.Device, errors.EdgeX)
 	UpdateDevice(d model.Device) errors.EdgeX
+	UpdateDeviceOperatingState(name string, from model.OperatingState, to model.OperatingState) (model.Device, bool, errors.EdgeX)
+	SetDownDevicesOfService(serviceName string, deviceNames []string) errors.EdgeX
+	DownDevicesOfServices() (map[string][]string, errors.EdgeX)
+	DeleteDownDevicesOfService(serviceName string) errors.EdgeX
 	DeviceCountByLabels(labels []string) (uint32, errors.EdgeX)
 	DeviceCountByProfileName(profileName string) (uint32, errors.EdgeX)
 	DeviceCountByServiceName(serviceName string) (uint32, errors.EdgeX)
+	DevicesByAttribute(offset int, limit int, key string, value string) ([]model.Device, errors.EdgeX)
+	DeviceCountByAttribute(key string, value string) (uint32, errors.EdgeX)
+	DevicesByLifecycleState(offset int, limit int, state string) ([]model.Device, errors.EdgeX)
+	DeviceCountByLifecycleState(state string) (uint32, errors.EdgeX)
+	DevicesByLocationRadius(offset int, limit int, latitude float64, longitude float64, radius float64) ([]model.Device, uint32, errors.EdgeX)
+	DevicesByLocationBox(offset int, limit int, minLatitude float64, minLongitude float64, maxLatitude float64, maxLongitude float64) ([]model.Device, uint32, errors.EdgeX)
 
 	AddProvisionWatcher(pw model.ProvisionWatcher) (model.ProvisionWatcher, errors.EdgeX)
 	ProvisionWatcherById(id string) (model.ProvisionWatcher, errors.EdgeX)
@@ -5,6 +5,8 @@ package mocks
 import (
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
@@ -15,6 +17,29 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddChangeFeedEntry provides a mock function with given fields: entry, maxEntries
+func (_m *DBClient) AddChangeFeedEntry(entry metadataModels.ChangeFeedEntry, maxEntries int) (metadataModels.ChangeFeedEntry, errors.EdgeX) {
+	ret := _m.Called(entry, maxEntries)
+
+	var r0 metadataModels.ChangeFeedEntry
+	if rf, ok := ret.Get(0).(func(metadataModels.ChangeFeedEntry, int) metadataModels.ChangeFeedEntry); ok {
+		r0 = rf(entry, maxEntries)
+	} else {
+		r0 = ret.Get(0).(metadataModels.ChangeFeedEntry)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(metadataModels.ChangeFeedEntry, int) errors.EdgeX); ok {
+		r1 = rf(entry, maxEntries)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddDevice provides a mock function with given fields: d
 func (_m *DBClient) AddDevice(d models.Device) (models.Device, errors.EdgeX) {
 	ret := _m.Called(d)
@@ -38,6 +63,29 @@ func (_m *DBClient) AddDevice(d models.Device) (models.Device, errors.EdgeX) {
 	return r0, r1
 }
 
+// AddDeviceGroup provides a mock function with given fields: dg
+func (_m *DBClient) AddDeviceGroup(dg metadataModels.DeviceGroup) (metadataModels.DeviceGroup, errors.EdgeX) {
+	ret := _m.Called(dg)
+
+	var r0 metadataModels.DeviceGroup
+	if rf, ok := ret.Get(0).(func(metadataModels.DeviceGroup) metadataModels.DeviceGroup); ok {
+		r0 = rf(dg)
+	} else {
+		r0 = ret.Get(0).(metadataModels.DeviceGroup)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(metadataModels.DeviceGroup) errors.EdgeX); ok {
+		r1 = rf(dg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddDeviceProfile provides a mock function with given fields: e
 func (_m *DBClient) AddDeviceProfile(e models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(e)
@@ -84,6 +132,29 @@ func (_m *DBClient) AddDeviceService(ds models.DeviceService) (models.DeviceServ
 	return r0, r1
 }
 
+// AddDeviceTemplate provides a mock function with given fields: dt
+func (_m *DBClient) AddDeviceTemplate(dt metadataModels.DeviceTemplate) (metadataModels.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(dt)
+
+	var r0 metadataModels.DeviceTemplate
+	if rf, ok := ret.Get(0).(func(metadataModels.DeviceTemplate) metadataModels.DeviceTemplate); ok {
+		r0 = rf(dt)
+	} else {
+		r0 = ret.Get(0).(metadataModels.DeviceTemplate)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(metadataModels.DeviceTemplate) errors.EdgeX); ok {
+		r1 = rf(dt)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddProvisionWatcher provides a mock function with given fields: pw
 func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(pw)
@@ -107,6 +178,54 @@ func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (models.Prov
 	return r0, r1
 }
 
+// AddSystemEventHistoryEntry provides a mock function with given fields: entry, maxEntries
+func (_m *DBClient) AddSystemEventHistoryEntry(entry metadataModels.SystemEventHistoryEntry, maxEntries int) (metadataModels.SystemEventHistoryEntry, errors.EdgeX) {
+	ret := _m.Called(entry, maxEntries)
+
+	var r0 metadataModels.SystemEventHistoryEntry
+	if rf, ok := ret.Get(0).(func(metadataModels.SystemEventHistoryEntry, int) metadataModels.SystemEventHistoryEntry); ok {
+		r0 = rf(entry, maxEntries)
+	} else {
+		r0 = ret.Get(0).(metadataModels.SystemEventHistoryEntry)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(metadataModels.SystemEventHistoryEntry, int) errors.EdgeX); ok {
+		r1 = rf(entry, maxEntries)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllDeviceGroups provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceGroups(offset int, limit int) ([]metadataModels.DeviceGroup, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []metadataModels.DeviceGroup
+	if rf, ok := ret.Get(0).(func(int, int) []metadataModels.DeviceGroup); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadataModels.DeviceGroup)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDeviceProfiles provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDeviceProfiles(offset int, limit int, labels []string) ([]models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -157,6 +276,31 @@ func (_m *DBClient) AllDeviceServices(offset int, limit int, labels []string) ([
 	return r0, r1
 }
 
+// AllDeviceTemplates provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceTemplates(offset int, limit int) ([]metadataModels.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []metadataModels.DeviceTemplate
+	if rf, ok := ret.Get(0).(func(int, int) []metadataModels.DeviceTemplate); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadataModels.DeviceTemplate)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDevices provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -207,6 +351,61 @@ func (_m *DBClient) AllProvisionWatchers(offset int, limit int, labels []string)
 	return r0, r1
 }
 
+// ChangeFeedEntriesAfter provides a mock function with given fields: cursor, limit
+func (_m *DBClient) ChangeFeedEntriesAfter(cursor uint64, limit int) ([]metadataModels.ChangeFeedEntry, errors.EdgeX) {
+	ret := _m.Called(cursor, limit)
+
+	var r0 []metadataModels.ChangeFeedEntry
+	if rf, ok := ret.Get(0).(func(uint64, int) []metadataModels.ChangeFeedEntry); ok {
+		r0 = rf(cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadataModels.ChangeFeedEntry)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(uint64, int) errors.EdgeX); ok {
+		r1 = rf(cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ChangeFeedSequences provides a mock function with given fields:
+func (_m *DBClient) ChangeFeedSequences() (uint64, uint64, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func() uint64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func() errors.EdgeX); ok {
+		r2 = rf()
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
@@ -244,6 +443,22 @@ func (_m *DBClient) DeleteDeviceByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteDeviceGroupByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeviceGroupByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteDeviceProfileById provides a mock function with given fields: id
 func (_m *DBClient) DeleteDeviceProfileById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -308,6 +523,38 @@ func (_m *DBClient) DeleteDeviceServiceByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteDeviceTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeviceTemplateByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDownDevicesOfService provides a mock function with given fields: serviceName
+func (_m *DBClient) DeleteDownDevicesOfService(serviceName string) errors.EdgeX {
+	ret := _m.Called(serviceName)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(serviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteProvisionWatcherByName provides a mock function with given fields: name
 func (_m *DBClient) DeleteProvisionWatcherByName(name string) errors.EdgeX {
 	ret := _m.Called(name)
@@ -370,6 +617,29 @@ func (_m *DBClient) DeviceByName(name string) (models.Device, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceCountByAttribute provides a mock function with given fields: key, value
+func (_m *DBClient) DeviceCountByAttribute(key string, value string) (uint32, errors.EdgeX) {
+	ret := _m.Called(key, value)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string, string) uint32); ok {
+		r0 = rf(key, value)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string) errors.EdgeX); ok {
+		r1 = rf(key, value)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceCountByLabels provides a mock function with given fields: labels
 func (_m *DBClient) DeviceCountByLabels(labels []string) (uint32, errors.EdgeX) {
 	ret := _m.Called(labels)
@@ -393,6 +663,29 @@ func (_m *DBClient) DeviceCountByLabels(labels []string) (uint32, errors.EdgeX)
 	return r0, r1
 }
 
+// DeviceCountByLifecycleState provides a mock function with given fields: state
+func (_m *DBClient) DeviceCountByLifecycleState(state string) (uint32, errors.EdgeX) {
+	ret := _m.Called(state)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(state)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(state)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceCountByProfileName provides a mock function with given fields: profileName
 func (_m *DBClient) DeviceCountByProfileName(profileName string) (uint32, errors.EdgeX) {
 	ret := _m.Called(profileName)
@@ -439,6 +732,52 @@ func (_m *DBClient) DeviceCountByServiceName(serviceName string) (uint32, errors
 	return r0, r1
 }
 
+// DeviceGroupByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceGroupByName(name string) (metadataModels.DeviceGroup, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 metadataModels.DeviceGroup
+	if rf, ok := ret.Get(0).(func(string) metadataModels.DeviceGroup); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(metadataModels.DeviceGroup)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceGroupTotalCount provides a mock function with given fields:
+func (_m *DBClient) DeviceGroupTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceIdExists provides a mock function with given fields: id
 func (_m *DBClient) DeviceIdExists(id string) (bool, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -623,6 +962,77 @@ func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceProfileRevisionByNameAndVersion provides a mock function with given fields: name, version
+func (_m *DBClient) DeviceProfileRevisionByNameAndVersion(name string, version uint64) (metadataModels.DeviceProfileRevision, errors.EdgeX) {
+	ret := _m.Called(name, version)
+
+	var r0 metadataModels.DeviceProfileRevision
+	if rf, ok := ret.Get(0).(func(string, uint64) metadataModels.DeviceProfileRevision); ok {
+		r0 = rf(name, version)
+	} else {
+		r0 = ret.Get(0).(metadataModels.DeviceProfileRevision)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, uint64) errors.EdgeX); ok {
+		r1 = rf(name, version)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileRevisionCountByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceProfileRevisionCountByName(name string) (uint32, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileRevisionsByName provides a mock function with given fields: offset, limit, name
+func (_m *DBClient) DeviceProfileRevisionsByName(offset int, limit int, name string) ([]metadataModels.DeviceProfileRevision, errors.EdgeX) {
+	ret := _m.Called(offset, limit, name)
+
+	var r0 []metadataModels.DeviceProfileRevision
+	if rf, ok := ret.Get(0).(func(int, int, string) []metadataModels.DeviceProfileRevision); ok {
+		r0 = rf(offset, limit, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadataModels.DeviceProfileRevision)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
 func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, manufacturer)
@@ -797,6 +1207,166 @@ func (_m *DBClient) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceTemplateByName(name string) (metadataModels.DeviceTemplate, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 metadataModels.DeviceTemplate
+	if rf, ok := ret.Get(0).(func(string) metadataModels.DeviceTemplate); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(metadataModels.DeviceTemplate)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceTemplateTotalCount provides a mock function with given fields:
+func (_m *DBClient) DeviceTemplateTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DevicesByAttribute provides a mock function with given fields: offset, limit, key, value
+func (_m *DBClient) DevicesByAttribute(offset int, limit int, key string, value string) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(offset, limit, key, value)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []models.Device); ok {
+		r0 = rf(offset, limit, key, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, key, value)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DevicesByLifecycleState provides a mock function with given fields: offset, limit, state
+func (_m *DBClient) DevicesByLifecycleState(offset int, limit int, state string) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(offset, limit, state)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
+		r0 = rf(offset, limit, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, state)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DevicesByLocationBox provides a mock function with given fields: offset, limit, minLatitude, minLongitude, maxLatitude, maxLongitude
+func (_m *DBClient) DevicesByLocationBox(offset int, limit int, minLatitude float64, minLongitude float64, maxLatitude float64, maxLongitude float64) ([]models.Device, uint32, errors.EdgeX) {
+	ret := _m.Called(offset, limit, minLatitude, minLongitude, maxLatitude, maxLongitude)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, float64, float64, float64, float64) []models.Device); ok {
+		r0 = rf(offset, limit, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(int, int, float64, float64, float64, float64) uint32); ok {
+		r1 = rf(offset, limit, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(int, int, float64, float64, float64, float64) errors.EdgeX); ok {
+		r2 = rf(offset, limit, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// DevicesByLocationRadius provides a mock function with given fields: offset, limit, latitude, longitude, radius
+func (_m *DBClient) DevicesByLocationRadius(offset int, limit int, latitude float64, longitude float64, radius float64) ([]models.Device, uint32, errors.EdgeX) {
+	ret := _m.Called(offset, limit, latitude, longitude, radius)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, float64, float64, float64) []models.Device); ok {
+		r0 = rf(offset, limit, latitude, longitude, radius)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(int, int, float64, float64, float64) uint32); ok {
+		r1 = rf(offset, limit, latitude, longitude, radius)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(int, int, float64, float64, float64) errors.EdgeX); ok {
+		r2 = rf(offset, limit, latitude, longitude, radius)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // DevicesByProfileName provides a mock function with given fields: offset, limit, profileName
 func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName string) ([]models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, profileName)
@@ -847,6 +1417,31 @@ func (_m *DBClient) DevicesByServiceName(offset int, limit int, name string) ([]
 	return r0, r1
 }
 
+// DownDevicesOfServices provides a mock function with given fields:
+func (_m *DBClient) DownDevicesOfServices() (map[string][]string, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 map[string][]string
+	if rf, ok := ret.Get(0).(func() map[string][]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ProvisionWatcherById provides a mock function with given fields: id
 func (_m *DBClient) ProvisionWatcherById(id string) (models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -1012,6 +1607,77 @@ func (_m *DBClient) ProvisionWatchersByServiceName(offset int, limit int, name s
 	return r0, r1
 }
 
+// SetDownDevicesOfService provides a mock function with given fields: serviceName, deviceNames
+func (_m *DBClient) SetDownDevicesOfService(serviceName string, deviceNames []string) errors.EdgeX {
+	ret := _m.Called(serviceName, deviceNames)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, []string) errors.EdgeX); ok {
+		r0 = rf(serviceName, deviceNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// SystemEventHistoryEntriesAfter provides a mock function with given fields: cursor, limit
+func (_m *DBClient) SystemEventHistoryEntriesAfter(cursor uint64, limit int) ([]metadataModels.SystemEventHistoryEntry, errors.EdgeX) {
+	ret := _m.Called(cursor, limit)
+
+	var r0 []metadataModels.SystemEventHistoryEntry
+	if rf, ok := ret.Get(0).(func(uint64, int) []metadataModels.SystemEventHistoryEntry); ok {
+		r0 = rf(cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadataModels.SystemEventHistoryEntry)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(uint64, int) errors.EdgeX); ok {
+		r1 = rf(cursor, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SystemEventHistorySequences provides a mock function with given fields:
+func (_m *DBClient) SystemEventHistorySequences() (uint64, uint64, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 uint64
+	if rf, ok := ret.Get(1).(func() uint64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func() errors.EdgeX); ok {
+		r2 = rf()
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // UpdateDevice provides a mock function with given fields: d
 func (_m *DBClient) UpdateDevice(d models.Device) errors.EdgeX {
 	ret := _m.Called(d)
@@ -1028,6 +1694,52 @@ func (_m *DBClient) UpdateDevice(d models.Device) errors.EdgeX {
 	return r0
 }
 
+// UpdateDeviceGroup provides a mock function with given fields: dg
+func (_m *DBClient) UpdateDeviceGroup(dg metadataModels.DeviceGroup) errors.EdgeX {
+	ret := _m.Called(dg)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(metadataModels.DeviceGroup) errors.EdgeX); ok {
+		r0 = rf(dg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateDeviceOperatingState provides a mock function with given fields: name, from, to
+func (_m *DBClient) UpdateDeviceOperatingState(name string, from models.OperatingState, to models.OperatingState) (models.Device, bool, errors.EdgeX) {
+	ret := _m.Called(name, from, to)
+
+	var r0 models.Device
+	if rf, ok := ret.Get(0).(func(string, models.OperatingState, models.OperatingState) models.Device); ok {
+		r0 = rf(name, from, to)
+	} else {
+		r0 = ret.Get(0).(models.Device)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string, models.OperatingState, models.OperatingState) bool); ok {
+		r1 = rf(name, from, to)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(string, models.OperatingState, models.OperatingState) errors.EdgeX); ok {
+		r2 = rf(name, from, to)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // UpdateDeviceProfile provides a mock function with given fields: e
 func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
 	ret := _m.Called(e)
@@ -1060,6 +1772,22 @@ func (_m *DBClient) UpdateDeviceService(ds models.DeviceService) errors.EdgeX {
 	return r0
 }
 
+// UpdateDeviceTemplate provides a mock function with given fields: dt
+func (_m *DBClient) UpdateDeviceTemplate(dt metadataModels.DeviceTemplate) errors.EdgeX {
+	ret := _m.Called(dt)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(metadataModels.DeviceTemplate) errors.EdgeX); ok {
+		r0 = rf(dt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateProvisionWatcher provides a mock function with given fields: pw
 func (_m *DBClient) UpdateProvisionWatcher(pw models.ProvisionWatcher) errors.EdgeX {
 	ret := _m.Called(pw)
@@ -11,4 +11,7 @@ type UnitsOfMeasure interface {
 	// Validate validates DeviceResource's unit against the list of
 	// units of measure by core metadata.
 	Validate(string) bool
+	// Contains returns whether the unit is one of the units of measure, which
+	// is never the case when no unit of measure is loaded.
+	Contains(string) bool
 }
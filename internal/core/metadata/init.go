@@ -19,9 +19,18 @@ import (
 
 	"sync"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http"
 	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
 // Bootstrap contains references to dependencies required by the BootstrapHandler.
@@ -40,7 +49,37 @@ func NewBootstrap(router *mux.Router, serviceName string) *Bootstrap {
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the metadata service.
 func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !audit.BootstrapAuditor(container.ConfigurationFrom(dic.Get).Audit, b.serviceName, dic) {
+		return false
+	}
+	if !apikey.BootstrapValidator(ctx, wg, container.ConfigurationFrom(dic.Get).APIKey, dic) {
+		return false
+	}
+	if !tracing.BootstrapTracer(ctx, wg, container.ConfigurationFrom(dic.Get).Tracing, b.serviceName, dic) {
+		return false
+	}
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
+	// the ReadingClient querying the latest readings of the GraphQL queries isn't created by the NewClientsBootstrap
+	// handler, as core-metadata doesn't depend on core-data otherwise
+	if graphQL := container.ConfigurationFrom(dic.Get).GraphQL; graphQL.Enabled {
+		dic.Update(di.ServiceConstructorMap{
+			bootstrapContainer.ReadingClientName: func(get di.Get) interface{} {
+				jwtSecretProvider := secret.NewJWTSecretProvider(bootstrapContainer.SecretProviderExtFrom(get))
+				return clients.NewReadingClient(graphQL.CoreData.Url(), jwtSecretProvider)
+			},
+		})
+	}
+
+	if container.ConfigurationFrom(dic.Get).OrphanDetection.Enabled {
+		application.StartOrphanDetection(ctx, wg, dic)
+	}
+
+	if container.ConfigurationFrom(dic.Get).Heartbeat.Enabled {
+		if !application.StartHeartbeatTracking(ctx, wg, dic) {
+			return false
+		}
+	}
+
 	return true
 }
@@ -58,7 +58,10 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		},
 	})
 
-	httpServer := handlers.NewHttpServer(router, true)
+	httpServer := pkgHandlers.NewHttpServer(router, true, &configuration.MutualTLS)
+	grpcServer := pkgHandlers.NewGrpcServer(&configuration.Grpc, httpServer, grpcServices)
+	database := pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName).
+		WithReadReplica(container.QueryDBClientInterfaceName)
 
 	bootstrap.Run(
 		ctx,
@@ -73,11 +76,13 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		bootstrapConfig.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
 			uom.BootstrapHandler,
-			pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName).BootstrapHandler, // add db client bootstrap handler
+			database.BootstrapHandler, // add db client bootstrap handler
 			handlers.MessagingBootstrapHandler,
 			handlers.NewServiceMetrics(common.CoreMetaDataServiceKey).BootstrapHandler, // Must be after Messaging
+			database.MetricsBootstrapHandler,                                           // Must be after Service Metrics
 			NewBootstrap(router, common.CoreMetaDataServiceKey).BootstrapHandler,
 			httpServer.BootstrapHandler,
+			grpcServer.BootstrapHandler, // Must be after the HttpServer, which loads the mutual TLS certificate
 			handlers.NewStartMessage(common.CoreMetaDataServiceKey, edgex.Version).BootstrapHandler,
 		},
 	)
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ChangeFeedEntry records a mutation of the metadata. The sequences of the entries are assigned in the order the
+// mutations are recorded, starting at 1.
+type ChangeFeedEntry struct {
+	Sequence  uint64
+	Timestamp int64
+	// Type is the type of the mutated entity, the same as the type of the system event published for the mutation
+	Type string
+	// Action is add, update or delete
+	Action string
+	Name   string
+	// Details is the entity after the mutation, or before it for a delete
+	Details any
+}
@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// DeviceGroup is a named set of devices, its members are the devices it names and the devices carrying all the
+// labels of its selector
+type DeviceGroup struct {
+	models.DBTimestamp
+	Id          string
+	Name        string
+	Description string
+	Devices     []string
+	Labels      []string
+}
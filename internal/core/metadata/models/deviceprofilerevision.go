@@ -0,0 +1,18 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// DeviceProfileRevision is a revision of a device profile as it was stored by an add or update. The versions of the
+// revisions of a device profile start at 1 and are incremented by each update.
+type DeviceProfileRevision struct {
+	Version uint64
+	Created int64
+	Profile models.DeviceProfile
+}
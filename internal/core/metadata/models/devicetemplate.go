@@ -0,0 +1,30 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// DeviceTemplate describes the devices instantiated from it. The placeholders ${parameter} of its device name, device
+// description, labels, location, protocols, auto events and properties are substituted with the parameters of each
+// instance, the device service and device profile being the same for all the instances.
+type DeviceTemplate struct {
+	models.DBTimestamp
+	Id                string
+	Name              string
+	Description       string
+	DeviceName        string
+	DeviceDescription string
+	ServiceName       string
+	ProfileName       string
+	AdminState        models.AdminState
+	Labels            []string
+	Location          any
+	Protocols         map[string]models.ProtocolProperties
+	AutoEvents        []models.AutoEvent
+	Properties        map[string]any
+}
@@ -0,0 +1,16 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// SystemEventHistoryEntry records a system event published by core-metadata. The sequences of the entries are assigned
+// in the order the system events are recorded, starting at 1.
+type SystemEventHistoryEntry struct {
+	Sequence uint64
+	// Topic is the topic the system event was published to
+	Topic string
+	// Payload is the JSON encoded system event, as it was published
+	Payload []byte
+}
@@ -18,17 +18,44 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	metadataController "github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 )
 
+// permissionTable defines the permissions of the core-metadata routes which differ from the default permission of their
+// method, validating a device profile, dry running the provision watchers and the GraphQL queries don't change any
+// resource, querying the audit records and the API keys requires the audit permission
+var permissionTable = rbac.PermissionTable{
+	rbac.RouteKey(http.MethodPost, pkgCommon.ApiDeviceProfileValidateRoute):  rbac.PermissionRead,
+	rbac.RouteKey(http.MethodPost, pkgCommon.ApiProvisionWatcherDryRunRoute): rbac.PermissionRead,
+	rbac.RouteKey(http.MethodPost, pkgCommon.ApiGraphQLRoute):                rbac.PermissionRead,
+	rbac.RouteKey(http.MethodGet, pkgCommon.ApiAuditRoute):                   rbac.PermissionAudit,
+	rbac.RouteKey(http.MethodGet, pkgCommon.ApiApiKeyRoute):                  rbac.PermissionAudit,
+}
+
+// newAuthenticationHook returns the hook authenticating the requests with an API key or the authentication of the
+// go-mod-bootstrap, and authorizing them with the permissionTable. It also authenticates the gRPC calls.
+func newAuthenticationHook(dic *di.Container) func(inner http.HandlerFunc) http.HandlerFunc {
+	lc := container.LoggingClientFrom(dic.Get)
+	secretProvider := container.SecretProviderExtFrom(dic.Get)
+	authenticationHook := rbac.AuthorizationHandlerFunc(metadataContainer.ConfigurationFrom(dic.Get).RBAC, permissionTable,
+		handlers.AutoConfigAuthenticationFunc(secretProvider, lc), lc)
+	return apikey.AuthenticationHandlerFunc(apikey.ValidatorFrom(dic.Get), permissionTable, authenticationHook, lc)
+}
+
 func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	// r.UseEncodedPath() tells the router to match the encoded original path to the routes
 	r.UseEncodedPath()
 
 	lc := container.LoggingClientFrom(dic.Get)
-	secretProvider := container.SecretProviderExtFrom(dic.Get)
-	authenticationHook := handlers.AutoConfigAuthenticationFunc(secretProvider, lc)
+	authenticationHook := newAuthenticationHook(dic)
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
@@ -36,6 +63,7 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	// Units of Measure
 	uc := metadataController.NewUnitOfMeasureController(dic)
 	r.HandleFunc(common.ApiUnitsOfMeasureRoute, authenticationHook(uc.UnitsOfMeasure)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiUnitsOfMeasureValidateRoute, authenticationHook(uc.ValidateUnits)).Methods(http.MethodGet)
 
 	// Device Profile
 	dc := metadataController.NewDeviceProfileController(dic)
@@ -50,6 +78,10 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiDeviceProfileByManufacturerRoute, authenticationHook(dc.DeviceProfilesByManufacturer)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceProfileByManufacturerAndModelRoute, authenticationHook(dc.DeviceProfilesByManufacturerAndModel)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceProfileBasicInfoRoute, authenticationHook(dc.PatchDeviceProfileBasicInfo)).Methods(http.MethodPatch)
+	r.HandleFunc(pkgCommon.ApiDeviceProfileVersionsByNameRoute, authenticationHook(dc.DeviceProfileRevisionsByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceProfileVersionByNameAndVersionRoute, authenticationHook(dc.DeviceProfileRevisionByNameAndVersion)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceProfileRollbackByNameAndVersionRoute, authenticationHook(dc.RollbackDeviceProfile)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiDeviceProfileValidateRoute, authenticationHook(dc.ValidateDeviceProfile)).Methods(http.MethodPost)
 
 	// Device Resource
 	dr := metadataController.NewDeviceResourceController(dic)
@@ -71,6 +103,7 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiDeviceServiceByNameRoute, authenticationHook(ds.DeviceServiceByName)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceServiceByNameRoute, authenticationHook(ds.DeleteDeviceServiceByName)).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiAllDeviceServiceRoute, authenticationHook(ds.AllDeviceServices)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceServicePollingLoadRoute, authenticationHook(ds.DeviceServicePollingLoads)).Methods(http.MethodGet)
 
 	// Device
 	d := metadataController.NewDeviceController(dic)
@@ -82,6 +115,14 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiAllDeviceRoute, authenticationHook(d.AllDevices)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceByNameRoute, authenticationHook(d.DeviceByName)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiDeviceByProfileNameRoute, authenticationHook(d.DevicesByProfileName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceByAttributeRoute, authenticationHook(d.DevicesByAttribute)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceByLifecycleStateRoute, authenticationHook(d.DevicesByLifecycleState)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceLifecycleStateByNameRoute, authenticationHook(d.TransitionDeviceLifecycleState)).Methods(http.MethodPut)
+	r.HandleFunc(pkgCommon.ApiDeviceByLocationRadiusRoute, authenticationHook(d.DevicesByLocationRadius)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceByLocationBoxRoute, authenticationHook(d.DevicesByLocationBox)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceBulkRoute, authenticationHook(d.BulkAddDevices)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiDeviceBulkRoute, authenticationHook(d.BulkPatchDevices)).Methods(http.MethodPatch)
+	r.HandleFunc(pkgCommon.ApiDeviceBulkRoute, authenticationHook(d.BulkDeleteDevices)).Methods(http.MethodDelete)
 
 	// ProvisionWatcher
 	pwc := metadataController.NewProvisionWatcherController(dic)
@@ -92,8 +133,72 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiAllProvisionWatcherRoute, authenticationHook(pwc.AllProvisionWatchers)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiProvisionWatcherByNameRoute, authenticationHook(pwc.DeleteProvisionWatcherByName)).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiProvisionWatcherRoute, authenticationHook(pwc.PatchProvisionWatcher)).Methods(http.MethodPatch)
+	r.HandleFunc(pkgCommon.ApiProvisionWatcherDryRunRoute, authenticationHook(pwc.DryRunProvisionWatchers)).Methods(http.MethodPost)
+
+	// Device Group
+	dg := metadataController.NewDeviceGroupController(dic)
+	r.HandleFunc(pkgCommon.ApiDeviceGroupRoute, authenticationHook(dg.AddDeviceGroup)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiDeviceGroupRoute, authenticationHook(dg.PatchDeviceGroup)).Methods(http.MethodPatch)
+	r.HandleFunc(pkgCommon.ApiAllDeviceGroupRoute, authenticationHook(dg.AllDeviceGroups)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceGroupByNameRoute, authenticationHook(dg.DeviceGroupByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceGroupByNameRoute, authenticationHook(dg.DeleteDeviceGroupByName)).Methods(http.MethodDelete)
+	r.HandleFunc(pkgCommon.ApiDeviceByGroupNameRoute, authenticationHook(dg.DevicesByGroupName)).Methods(http.MethodGet)
+
+	// Device Template
+	dtc := metadataController.NewDeviceTemplateController(dic)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateRoute, authenticationHook(dtc.AddDeviceTemplate)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateRoute, authenticationHook(dtc.PatchDeviceTemplate)).Methods(http.MethodPatch)
+	r.HandleFunc(pkgCommon.ApiAllDeviceTemplateRoute, authenticationHook(dtc.AllDeviceTemplates)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateByNameRoute, authenticationHook(dtc.DeviceTemplateByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateByNameRoute, authenticationHook(dtc.DeleteDeviceTemplateByName)).Methods(http.MethodDelete)
+	r.HandleFunc(pkgCommon.ApiDeviceTemplateInstantiateByNameRoute, authenticationHook(dtc.InstantiateDeviceTemplate)).Methods(http.MethodPost)
+
+	// Bundle
+	bc := metadataController.NewBundleController(dic)
+	r.HandleFunc(pkgCommon.ApiBundleRoute, authenticationHook(bc.ExportBundle)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiBundleRoute, authenticationHook(bc.ImportBundle)).Methods(http.MethodPost)
+
+	// Backup
+	bkc := metadataController.NewBackupController(dic)
+	r.HandleFunc(pkgCommon.ApiBackupRoute, authenticationHook(bkc.Backup)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiBackupRoute, authenticationHook(bkc.Restore)).Methods(http.MethodPost)
+
+	// GraphQL
+	if metadataContainer.ConfigurationFrom(dic.Get).GraphQL.Enabled {
+		gqc := metadataController.NewGraphQLController(dic)
+		r.HandleFunc(pkgCommon.ApiGraphQLRoute, authenticationHook(gqc.Query)).Methods(http.MethodPost)
+	}
+
+	// Orphan
+	oc := metadataController.NewOrphanController(dic)
+	r.HandleFunc(pkgCommon.ApiOrphanRoute, authenticationHook(oc.Orphans)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiOrphanRepairRoute, authenticationHook(oc.RepairOrphans)).Methods(http.MethodPost)
+
+	// Change Feed
+	cf := metadataController.NewChangeFeedController(dic)
+	r.HandleFunc(pkgCommon.ApiChangeFeedRoute, authenticationHook(cf.ChangeFeed)).Methods(http.MethodGet)
+
+	// System Event History
+	seh := metadataController.NewSystemEventHistoryController(dic)
+	r.HandleFunc(pkgCommon.ApiSystemEventHistoryRoute, authenticationHook(seh.SystemEventHistory)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiSystemEventHistoryReplayRoute, authenticationHook(seh.ReplaySystemEvents)).Methods(http.MethodPost)
+
+	// Audit
+	auditor := audit.AuditorFrom(dic.Get)
+	if auditor != nil {
+		maxResultCount := container.ConfigurationFrom(dic.Get).GetBootstrap().Service.MaxResultCount
+		r.HandleFunc(pkgCommon.ApiAuditRoute, authenticationHook(audit.QueryHandler(auditor, lc, maxResultCount))).Methods(http.MethodGet)
+	}
+
+	// API keys
+	if validator := apikey.ValidatorFrom(dic.Get); validator != nil {
+		r.HandleFunc(pkgCommon.ApiApiKeyRoute, authenticationHook(apikey.KeysHandler(validator, lc))).Methods(http.MethodGet)
+	}
 
 	r.Use(correlation.ManageHeader)
+	r.Use(tracing.Middleware(tracing.TracerFrom(dic.Get)))
+	r.Use(audit.Middleware(auditor))
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 	r.Use(correlation.UrlDecodeMiddleware(container.LoggingClientFrom(dic.Get)))
+	r.Use(pkgHandlers.RequestLimitMiddleware(container.ConfigurationFrom(dic.Get).GetBootstrap().Service.MaxRequestSize, container.LoggingClientFrom(dic.Get)))
 }
@@ -7,29 +7,34 @@ package uom
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"strings"
 	"sync"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/file"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 )
 
-func BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
 
-	uomImpl := &UnitsOfMeasureImpl{}
 	filepath := config.UoM.UoMFile
 	// backward compatability for using older 2.x configuration
 	// TODO: Remove in EdgeX 3.0
 	if filepath == "" {
 		dic.Update(di.ServiceConstructorMap{
 			container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
-				return uomImpl
+				return &UnitsOfMeasureImpl{}
 			},
 		})
 
@@ -37,14 +42,20 @@ func BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic
 		return true
 	}
 
-	secretProvider := bootstrapContainer.SecretProviderFrom(dic.Get)
-	contents, err := file.Load(filepath, secretProvider, lc)
-	if err != nil {
-		lc.Errorf("could not load unit of measure configuration file: %s", err.Error())
-		return false
+	var reloadInterval time.Duration
+	if config.UoM.ReloadInterval != "" {
+		var err error
+		reloadInterval, err = time.ParseDuration(config.UoM.ReloadInterval)
+		if err != nil || reloadInterval <= 0 {
+			lc.Errorf("invalid UoM.ReloadInterval '%s'", config.UoM.ReloadInterval)
+			return false
+		}
 	}
 
-	if err = yaml.Unmarshal(contents, uomImpl); err != nil {
+	secretProvider := bootstrapContainer.SecretProviderFrom(dic.Get)
+	sources := append([]string{filepath}, config.UoM.AdditionalFiles...)
+	uomImpl, err := load(sources, secretProvider, lc)
+	if err != nil {
 		lc.Errorf("could not load unit of measure configuration file: %s", err.Error())
 		return false
 	}
@@ -55,7 +66,64 @@ func BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic
 		},
 	})
 
-	lc.Infof("Loaded unit of measure configuration from %s", filepath)
+	lc.Infof("Loaded unit of measure configuration from %s", strings.Join(sources, ", "))
+
+	if reloadInterval > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reload(ctx, reloadInterval, sources, uomImpl, dic)
+		}()
+	}
 
 	return true
 }
+
+// load loads the units of measure of the sources, the units of each source being merged into those of the previous
+// ones
+func load(sources []string, secretProvider interfaces.SecretProvider, lc logger.LoggingClient) (*UnitsOfMeasureImpl, error) {
+	uomImpl := &UnitsOfMeasureImpl{}
+	for _, source := range sources {
+		contents, err := file.Load(source, secretProvider, lc)
+		if err != nil {
+			return nil, err
+		}
+		var units UnitsOfMeasureImpl
+		if err = yaml.Unmarshal(contents, &units); err != nil {
+			return nil, fmt.Errorf("invalid units of measure of %s: %w", source, err)
+		}
+		uomImpl.merge(units)
+	}
+	return uomImpl, nil
+}
+
+// reload loads the units of measure of the sources every interval, until ctx is done, and replaces the units of measure
+// of the DIC when they changed. The current units of measure are kept when the sources fail to load.
+func reload(ctx context.Context, interval time.Duration, sources []string, current *UnitsOfMeasureImpl, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		uomImpl, err := load(sources, bootstrapContainer.SecretProviderFrom(dic.Get), lc)
+		if err != nil {
+			lc.Errorf("could not reload unit of measure configuration, keeping the current units of measure: %s", err.Error())
+			continue
+		}
+		if reflect.DeepEqual(uomImpl, current) {
+			continue
+		}
+		current = uomImpl
+		dic.Update(di.ServiceConstructorMap{
+			container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+				return uomImpl
+			},
+		})
+		lc.Infof("Reloaded unit of measure configuration from %s", strings.Join(sources, ", "))
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2022 IOTech Ltd
+// Copyright (C) 2022-2023 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -20,6 +20,10 @@ func (u *UnitsOfMeasureImpl) Validate(unit string) bool {
 		return true
 	}
 
+	return u.Contains(unit)
+}
+
+func (u *UnitsOfMeasureImpl) Contains(unit string) bool {
 	for _, units := range u.Units {
 		for _, v := range units.Values {
 			if unit == v {
@@ -30,3 +34,39 @@ func (u *UnitsOfMeasureImpl) Validate(unit string) bool {
 
 	return false
 }
+
+// merge adds the units of other to the units, the values of a category of units defined by both being merged. The
+// Source of the units is kept, other's is used when it is empty.
+func (u *UnitsOfMeasureImpl) merge(other UnitsOfMeasureImpl) {
+	if u.Source == "" {
+		u.Source = other.Source
+	}
+	if len(other.Units) > 0 && u.Units == nil {
+		u.Units = make(map[string]Unit, len(other.Units))
+	}
+	for category, otherUnit := range other.Units {
+		unit, ok := u.Units[category]
+		if !ok {
+			u.Units[category] = otherUnit
+			continue
+		}
+		if unit.Source == "" {
+			unit.Source = otherUnit.Source
+		}
+		for _, v := range otherUnit.Values {
+			if !containsValue(unit.Values, v) {
+				unit.Values = append(unit.Values, v)
+			}
+		}
+		u.Units[category] = unit
+	}
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package uom
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitsOfMeasureImpl_Validate(t *testing.T) {
+	uom := UnitsOfMeasureImpl{
+		Units: map[string]Unit{
+			"temperature": {Values: []string{"C", "F"}},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		uom              UnitsOfMeasureImpl
+		unit             string
+		expectedValid    bool
+		expectedContains bool
+	}{
+		{"known unit", uom, "C", true, true},
+		{"unknown unit", uom, "kW", false, false},
+		{"empty unit", uom, "", true, false},
+		{"no units of measure", UnitsOfMeasureImpl{}, "kW", true, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedValid, testCase.uom.Validate(testCase.unit))
+			assert.Equal(t, testCase.expectedContains, testCase.uom.Contains(testCase.unit))
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "uom.yaml")
+	additional := filepath.Join(dir, "additional.yaml")
+	invalid := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(base, []byte(`
+Source: base source
+Units:
+  temperature:
+    Source: temperature source
+    Values:
+      - C
+      - F
+`), 0600))
+	require.NoError(t, os.WriteFile(additional, []byte(`
+Source: additional source
+Units:
+  temperature:
+    Values:
+      - F
+      - K
+  power:
+    Values:
+      - kW
+`), 0600))
+	require.NoError(t, os.WriteFile(invalid, []byte("Units: [C, F]"), 0600))
+
+	tests := []struct {
+		name          string
+		sources       []string
+		expected      *UnitsOfMeasureImpl
+		errorExpected bool
+	}{
+		{"valid, single source", []string{base}, &UnitsOfMeasureImpl{
+			Source: "base source",
+			Units:  map[string]Unit{"temperature": {Source: "temperature source", Values: []string{"C", "F"}}},
+		}, false},
+		{"valid, merged sources", []string{base, additional}, &UnitsOfMeasureImpl{
+			Source: "base source",
+			Units: map[string]Unit{
+				"temperature": {Source: "temperature source", Values: []string{"C", "F", "K"}},
+				"power":       {Values: []string{"kW"}},
+			},
+		}, false},
+		{"invalid, missing source", []string{base, filepath.Join(dir, "missing.yaml")}, nil, true},
+		{"invalid, units of measure", []string{invalid}, nil, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			uom, err := load(testCase.sources, nil, logger.NewMockClient())
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, uom)
+		})
+	}
+}
@@ -0,0 +1,749 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package amqp is a minimal AMQP 1.0 client, which connects to a broker such as Azure Service Bus or RabbitMQ to send
+// messages to its addresses and receive the messages of its addresses. The connection has a single session, whose
+// links each send or receive the messages of an address. The connection fails as a whole when the broker detaches a
+// link or ends the session, so that the client connects again.
+package amqp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultMaxFrameSize is the size of the largest frame accepted from the broker
+	defaultMaxFrameSize = 65536
+	// defaultIdleTimeout is how long the connection may be idle before the broker is considered gone
+	defaultIdleTimeout = time.Minute
+	// sessionWindow is the incoming and outgoing window of the session, large enough for the link credit to be the
+	// only flow control
+	sessionWindow = math.MaxInt32
+	// transferOverhead is the room left in the transfer frames for the frame header and the transfer performative
+	transferOverhead = 128
+	// The settle modes of the links, the senders settling the deliveries once the receivers accept them
+	senderSettleModeMixed   uint8 = 2
+	receiverSettleModeFirst uint8 = 0
+	// saslOutcomeOk is the code of the successful SASL outcome
+	saslOutcomeOk uint8 = 0
+)
+
+// ErrClosed is the error of the connection closed by the client
+var ErrClosed = errors.New("AMQP connection closed")
+
+// Options contains the settings of the connection to the broker
+type Options struct {
+	// ContainerID identifies the client to the broker
+	ContainerID string
+	// Username and Password authenticate the client with the SASL PLAIN mechanism, the SASL ANONYMOUS mechanism being
+	// used when Username is empty
+	Username string
+	Password string
+	// TLSConfig is the TLS configuration of the amqps connections
+	TLSConfig *tls.Config
+	// IdleTimeout is how long the connection may be idle before the broker is considered gone, the broker being asked
+	// to send a frame at least every IdleTimeout. Defaults to 1 minute.
+	IdleTimeout time.Duration
+	// MaxMessageSize is the size in bytes of the largest message the receivers accept, 0 for unlimited. It's the
+	// max-message-size of the receiver links, which are detached with amqp:link:message-size-exceeded, failing the
+	// connection, when the broker sends a larger message anyway.
+	MaxMessageSize uint64
+}
+
+// Conn is a connection to an AMQP 1.0 broker
+type Conn struct {
+	netConn          net.Conn
+	reader           *bufio.Reader
+	containerID      string
+	idleTimeout      time.Duration
+	maxMessageSize   uint64
+	peerMaxFrameSize uint32
+
+	// writeMutex serializes the frames written, the transfers being written in the order of their delivery ids
+	writeMutex sync.Mutex
+
+	mutex          sync.Mutex
+	nextOutgoingID uint32
+	nextIncomingID uint32
+	nextDeliveryID uint32
+	nextHandle     uint32
+	// links are the attached links by the handle the broker assigned to them
+	links map[uint32]*link
+	// attaching are the links waiting for the broker to attach them by name
+	attaching map[string]*link
+	// pendingSends are the results of the messages sent and not yet settled by the broker, by delivery id
+	pendingSends map[uint32]chan error
+	err          error
+	done         chan struct{}
+}
+
+// link is a link of the session, which sends or receives the messages of an address
+type link struct {
+	name     string
+	handle   uint32
+	receiver bool
+	attached chan struct{}
+
+	deliveryCount uint32
+	credit        uint32
+	// creditChanged is signaled when the broker gives credit to the sender
+	creditChanged chan struct{}
+
+	// messages are the messages received and not yet consumed, at most capacity as it's the credit given to the broker
+	messages chan *Message
+	capacity uint32
+	consumed uint32
+	// maxMessageSize is the size of the largest message received, 0 for unlimited
+	maxMessageSize uint64
+	// partial is the payload of the delivery received in several transfer frames
+	partial        []byte
+	partialID      uint32
+	partialSettled bool
+	inDelivery     bool
+}
+
+// Dial connects to the broker of the amqp:// or amqps:// URL and begins the session. The context bounds the time
+// spent connecting.
+func Dial(ctx context.Context, brokerURL string, opts Options) (*Conn, error) {
+	u, err := url.Parse(brokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AMQP broker URL '%s': %w", brokerURL, err)
+	}
+	var port string
+	switch u.Scheme {
+	case "amqp":
+		port = "5672"
+	case "amqps":
+		port = "5671"
+	default:
+		return nil, fmt.Errorf("invalid AMQP broker URL '%s', the scheme must be amqp or amqps", brokerURL)
+	}
+	if u.Port() != "" {
+		port = u.Port()
+	}
+	if opts.IdleTimeout <= 0 {
+		opts.IdleTimeout = defaultIdleTimeout
+	}
+
+	var dialer net.Dialer
+	netConn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return nil, err
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(opts.IdleTimeout)
+	}
+	_ = netConn.SetDeadline(deadline)
+	if u.Scheme == "amqps" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if opts.TLSConfig != nil {
+			tlsConfig = opts.TLSConfig.Clone()
+		}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = u.Hostname()
+		}
+		tlsConn := tls.Client(netConn, tlsConfig)
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			_ = netConn.Close()
+			return nil, err
+		}
+		netConn = tlsConn
+	}
+
+	c := &Conn{
+		netConn:        netConn,
+		reader:         bufio.NewReader(netConn),
+		containerID:    opts.ContainerID,
+		idleTimeout:    opts.IdleTimeout,
+		maxMessageSize: opts.MaxMessageSize,
+		links:          make(map[uint32]*link),
+		attaching:      make(map[string]*link),
+		pendingSends:   make(map[uint32]chan error),
+		done:           make(chan struct{}),
+	}
+	peerIdleTimeout, err := c.handshake(u.Hostname(), opts)
+	if err != nil {
+		_ = netConn.Close()
+		return nil, err
+	}
+	_ = netConn.SetDeadline(time.Time{})
+
+	go c.read()
+	if peerIdleTimeout > 0 {
+		go c.keepAlive(peerIdleTimeout / 2)
+	}
+	return c, nil
+}
+
+// handshake authenticates with SASL, opens the connection and begins the session, and returns the idle timeout of the
+// broker
+func (c *Conn) handshake(hostname string, opts Options) (time.Duration, error) {
+	if err := c.exchangeProtocolHeader(protocolHeaderSASL); err != nil {
+		return 0, err
+	}
+	mechanisms, err := c.readPerformative(frameTypeSASL, codeSASLMechanisms)
+	if err != nil {
+		return 0, err
+	}
+	mechanism, initialResponse := Symbol("ANONYMOUS"), []byte(nil)
+	if opts.Username != "" {
+		mechanism, initialResponse = "PLAIN", []byte("\x00"+opts.Username+"\x00"+opts.Password)
+	}
+	if !offers(mechanisms.field(0), mechanism) {
+		return 0, fmt.Errorf("the AMQP broker doesn't offer the SASL %s mechanism, offered mechanisms: %v", mechanism, mechanisms.field(0))
+	}
+	if err = c.write(frameTypeSASL, &described{code: codeSASLInit, value: []any{mechanism, initialResponse, hostname}}, nil); err != nil {
+		return 0, err
+	}
+	outcome, err := c.readPerformative(frameTypeSASL, codeSASLOutcome)
+	if err != nil {
+		return 0, err
+	}
+	if code, _ := outcome.field(0).(uint8); code != saslOutcomeOk {
+		return 0, fmt.Errorf("AMQP SASL %s authentication failed with code %d", mechanism, code)
+	}
+
+	if err = c.exchangeProtocolHeader(protocolHeaderAMQP); err != nil {
+		return 0, err
+	}
+	open := []any{c.containerID, hostname, uint32(defaultMaxFrameSize), uint16(0), uint32(c.idleTimeout.Milliseconds())}
+	if err = c.write(frameTypeAMQP, &described{code: codeOpen, value: open}, nil); err != nil {
+		return 0, err
+	}
+	begin := []any{nil, uint32(0), uint32(sessionWindow), uint32(sessionWindow)}
+	if err = c.write(frameTypeAMQP, &described{code: codeBegin, value: begin}, nil); err != nil {
+		return 0, err
+	}
+	peerOpen, err := c.readPerformative(frameTypeAMQP, codeOpen)
+	if err != nil {
+		return 0, err
+	}
+	c.peerMaxFrameSize = math.MaxUint32
+	if maxFrameSize := toUint32(peerOpen.field(2)); maxFrameSize >= minMaxFrameSize {
+		c.peerMaxFrameSize = maxFrameSize
+	}
+	peerBegin, err := c.readPerformative(frameTypeAMQP, codeBegin)
+	if err != nil {
+		return 0, err
+	}
+	c.nextIncomingID = toUint32(peerBegin.field(1))
+	return time.Duration(toUint32(peerOpen.field(4))) * time.Millisecond, nil
+}
+
+// exchangeProtocolHeader sends the protocol header, which the broker must reply with
+func (c *Conn) exchangeProtocolHeader(header []byte) error {
+	if _, err := c.netConn.Write(header); err != nil {
+		return err
+	}
+	reply := make([]byte, len(header))
+	if _, err := io.ReadFull(c.reader, reply); err != nil {
+		return err
+	}
+	if !bytes.Equal(header, reply) {
+		return fmt.Errorf("the AMQP broker replied the protocol header %v with %v", header, reply)
+	}
+	return nil
+}
+
+// readPerformative reads the next frame, which must be the performative of the code, or the close of the connection
+func (c *Conn) readPerformative(frameType byte, code uint64) (described, error) {
+	for {
+		fr, err := readFrame(c.reader, defaultMaxFrameSize)
+		if err != nil {
+			return described{}, err
+		}
+		if fr.body == nil {
+			continue
+		}
+		if fr.frameType == frameTypeAMQP && fr.body.code == codeClose {
+			return described{}, fmt.Errorf("AMQP connection closed by the broker: %v", toError(fr.body.field(0)))
+		}
+		if fr.frameType != frameType || fr.body.code != code {
+			return described{}, fmt.Errorf("unexpected AMQP performative 0x%02x, 0x%02x expected", fr.body.code, code)
+		}
+		return *fr.body, nil
+	}
+}
+
+// offers checks whether the offered SASL mechanisms, a symbol or an array of symbols, contain the mechanism
+func offers(offered any, mechanism Symbol) bool {
+	if symbol, ok := offered.(Symbol); ok {
+		return symbol == mechanism
+	}
+	symbols, _ := offered.([]any)
+	for _, symbol := range symbols {
+		if symbol == mechanism {
+			return true
+		}
+	}
+	return false
+}
+
+// write writes the frame of the body and payload
+func (c *Conn) write(frameType byte, body *described, payload []byte) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	return c.writeLocked(frameType, body, payload)
+}
+
+// writeLocked writes the frame of the body and payload, the writeMutex being locked
+func (c *Conn) writeLocked(frameType byte, body *described, payload []byte) error {
+	fr, err := encodeFrame(frameType, 0, body, payload)
+	if err != nil {
+		return err
+	}
+	_, err = c.netConn.Write(fr)
+	return err
+}
+
+// keepAlive sends an empty frame every interval, so that the broker doesn't consider the connection idle
+func (c *Conn) keepAlive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.write(frameTypeAMQP, nil, nil); err != nil {
+				c.fail(fmt.Errorf("AMQP connection lost: %w", err))
+				return
+			}
+		}
+	}
+}
+
+// read handles the frames received until the connection fails
+func (c *Conn) read() {
+	for {
+		_ = c.netConn.SetReadDeadline(time.Now().Add(c.idleTimeout))
+		fr, err := readFrame(c.reader, defaultMaxFrameSize)
+		if err != nil {
+			c.fail(fmt.Errorf("AMQP connection lost: %w", err))
+			return
+		}
+		if fr.body == nil {
+			continue
+		}
+		if err = c.handle(fr); err != nil {
+			var detach *detachError
+			if errors.As(err, &detach) {
+				// the broker is told why the link is detached before the connection fails
+				condition := described{code: codeError, value: []any{detach.err.Condition, detach.err.Description}}
+				_ = c.write(frameTypeAMQP, &described{code: codeDetach, value: []any{detach.handle, true, condition}}, nil)
+			}
+			c.fail(err)
+			return
+		}
+	}
+}
+
+// handle handles the performative received
+func (c *Conn) handle(fr frame) error {
+	body := *fr.body
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	switch body.code {
+	case codeAttach:
+		name := toString(body.field(0))
+		l, ok := c.attaching[name]
+		if !ok {
+			return fmt.Errorf("unexpected attach of AMQP link '%s'", name)
+		}
+		delete(c.attaching, name)
+		c.links[toUint32(body.field(1))] = l
+		if l.receiver {
+			l.deliveryCount = toUint32(body.field(9))
+		}
+		close(l.attached)
+	case codeFlow:
+		if body.field(4) == nil {
+			// the flow of the session, whose windows are large enough
+			return nil
+		}
+		l, ok := c.links[toUint32(body.field(4))]
+		if !ok || l.receiver {
+			return nil
+		}
+		// the credit is relative to the delivery count of the receiver, the sender having sent more messages since
+		l.credit = toUint32(body.field(5)) + toUint32(body.field(6)) - l.deliveryCount
+		select {
+		case l.creditChanged <- struct{}{}:
+		default:
+		}
+	case codeTransfer:
+		c.nextIncomingID++
+		l, ok := c.links[toUint32(body.field(0))]
+		if !ok || !l.receiver {
+			return fmt.Errorf("AMQP transfer received on unknown link %v", body.field(0))
+		}
+		return l.transfer(body, fr.payload)
+	case codeDisposition:
+		if !toBool(body.field(0)) {
+			// the dispositions of the messages received, which are settled on acceptance
+			return nil
+		}
+		first := toUint32(body.field(1))
+		last := first
+		if body.field(2) != nil {
+			last = toUint32(body.field(2))
+		}
+		outcome := deliveryOutcome(body.field(4))
+		for id := first; ; id++ {
+			if result, ok := c.pendingSends[id]; ok {
+				delete(c.pendingSends, id)
+				result <- outcome
+			}
+			if id == last {
+				break
+			}
+		}
+	case codeDetach:
+		return fmt.Errorf("AMQP link detached by the broker: %v", toError(body.field(2)))
+	case codeEnd:
+		return fmt.Errorf("AMQP session ended by the broker: %v", toError(body.field(0)))
+	case codeClose:
+		return fmt.Errorf("AMQP connection closed by the broker: %v", toError(body.field(0)))
+	}
+	return nil
+}
+
+// detachError is the error of the link detached by the client, which fails the connection
+type detachError struct {
+	handle uint32
+	err    *Error
+}
+
+func (e *detachError) Error() string {
+	return fmt.Sprintf("AMQP link detached: %v", e.err)
+}
+
+// transfer handles the transfer frame of the receiver link, the message being buffered once its last frame is
+// received. The link is detached as soon as the frames received exceed the max message size of the link, rather than
+// once the whole message is buffered.
+func (l *link) transfer(body described, payload []byte) error {
+	if !l.inDelivery {
+		l.inDelivery = true
+		l.partial = nil
+		l.partialID = toUint32(body.field(1))
+		l.partialSettled = toBool(body.field(4))
+	}
+	if toBool(body.field(9)) {
+		// aborted
+		l.inDelivery = false
+		return nil
+	}
+	if l.maxMessageSize > 0 && uint64(len(l.partial))+uint64(len(payload)) > l.maxMessageSize {
+		l.inDelivery = false
+		l.partial = nil
+		return &detachError{handle: l.handle, err: &Error{
+			Condition:   "amqp:link:message-size-exceeded",
+			Description: fmt.Sprintf("the message of delivery %d exceeds the max message size of %d bytes of link '%s'", l.partialID, l.maxMessageSize, l.name),
+		}}
+	}
+	l.partial = append(l.partial, payload...)
+	if toBool(body.field(5)) {
+		// more frames follow
+		return nil
+	}
+	l.inDelivery = false
+	l.deliveryCount++
+	msg, err := decodeMessage(l.partial)
+	if err != nil {
+		return err
+	}
+	msg.deliveryID = l.partialID
+	msg.settled = l.partialSettled
+	select {
+	case l.messages <- msg:
+		return nil
+	default:
+		return fmt.Errorf("AMQP broker exceeded the credit %d of link '%s'", l.capacity, l.name)
+	}
+}
+
+// deliveryOutcome returns the error of the outcome of a message sent, nil when the message is accepted
+func deliveryOutcome(state any) error {
+	outcome, ok := state.(described)
+	if !ok {
+		// settled without outcome
+		return nil
+	}
+	switch outcome.code {
+	case codeAccepted:
+		return nil
+	case codeRejected:
+		if err := toError(outcome.field(0)); err != nil {
+			return fmt.Errorf("AMQP message rejected: %w", err)
+		}
+		return errors.New("AMQP message rejected")
+	case codeReleased:
+		return errors.New("AMQP message released by the broker")
+	case codeModified:
+		return errors.New("AMQP message modified by the broker")
+	}
+	return fmt.Errorf("unexpected AMQP delivery outcome 0x%02x", outcome.code)
+}
+
+// fail closes the connection with the error, unless it already failed
+func (c *Conn) fail(err error) {
+	c.mutex.Lock()
+	if c.err == nil {
+		c.err = err
+		close(c.done)
+	}
+	c.mutex.Unlock()
+	_ = c.netConn.Close()
+}
+
+// Done returns the channel closed when the connection fails or is closed
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns the error of the failed or closed connection
+func (c *Conn) Err() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.err
+}
+
+// Close closes the connection
+func (c *Conn) Close() error {
+	select {
+	case <-c.done:
+		return nil
+	default:
+	}
+	_ = c.write(frameTypeAMQP, &described{code: codeClose, value: []any{}}, nil)
+	c.fail(ErrClosed)
+	return nil
+}
+
+// attach attaches the link of the address, and waits for the broker to attach it
+func (c *Conn) attach(ctx context.Context, address string, receiver bool, capacity uint32) (*link, error) {
+	c.mutex.Lock()
+	if c.err != nil {
+		c.mutex.Unlock()
+		return nil, c.err
+	}
+	l := &link{
+		handle:        c.nextHandle,
+		receiver:      receiver,
+		attached:      make(chan struct{}),
+		creditChanged: make(chan struct{}, 1),
+		messages:      make(chan *Message, capacity),
+		capacity:      capacity,
+	}
+	if receiver {
+		l.maxMessageSize = c.maxMessageSize
+	}
+	c.nextHandle++
+	role := "sender"
+	source := described{code: codeSource, value: []any{}}
+	target := described{code: codeTarget, value: []any{address}}
+	var initialDeliveryCount any = uint32(0)
+	if receiver {
+		role = "receiver"
+		source = described{code: codeSource, value: []any{address}}
+		target = described{code: codeTarget, value: []any{}}
+		initialDeliveryCount = nil
+	}
+	l.name = fmt.Sprintf("%s-%s-%d", c.containerID, role, l.handle)
+	c.attaching[l.name] = l
+	c.mutex.Unlock()
+
+	attach := []any{l.name, l.handle, receiver, senderSettleModeMixed, receiverSettleModeFirst, source, target, nil, false, initialDeliveryCount}
+	if l.maxMessageSize > 0 {
+		attach = append(attach, l.maxMessageSize)
+	}
+	if err := c.write(frameTypeAMQP, &described{code: codeAttach, value: attach}, nil); err != nil {
+		c.fail(fmt.Errorf("AMQP connection lost: %w", err))
+		return nil, c.Err()
+	}
+	select {
+	case <-l.attached:
+		return l, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		return nil, c.Err()
+	}
+}
+
+// Sender sends messages to an address
+type Sender struct {
+	conn *Conn
+	link *link
+}
+
+// NewSender attaches a link sending messages to the address
+func (c *Conn) NewSender(ctx context.Context, address string) (*Sender, error) {
+	l, err := c.attach(ctx, address, false, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &Sender{conn: c, link: l}, nil
+}
+
+// Send sends the message, once the broker gives credit to the sender, and waits for the broker to accept it
+func (s *Sender) Send(ctx context.Context, msg *Message) error {
+	payload, err := encodeMessage(msg)
+	if err != nil {
+		return err
+	}
+	c := s.conn
+	for {
+		c.writeMutex.Lock()
+		c.mutex.Lock()
+		if c.err != nil {
+			c.mutex.Unlock()
+			c.writeMutex.Unlock()
+			return c.Err()
+		}
+		if s.link.credit > 0 {
+			break
+		}
+		c.mutex.Unlock()
+		c.writeMutex.Unlock()
+		select {
+		case <-s.link.creditChanged:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.done:
+			return c.Err()
+		}
+	}
+
+	// the mutexes are locked
+	s.link.credit--
+	s.link.deliveryCount++
+	deliveryID := c.nextDeliveryID
+	c.nextDeliveryID++
+	result := make(chan error, 1)
+	c.pendingSends[deliveryID] = result
+	// the message is split in transfer frames no larger than the broker accepts
+	maxPayload := defaultMaxFrameSize - transferOverhead
+	if c.peerMaxFrameSize < defaultMaxFrameSize {
+		maxPayload = int(c.peerMaxFrameSize) - transferOverhead
+	}
+	var chunks [][]byte
+	for len(chunks) == 0 || len(payload) > 0 {
+		chunk := payload
+		if len(chunk) > maxPayload {
+			chunk = chunk[:maxPayload]
+		}
+		payload = payload[len(chunk):]
+		chunks = append(chunks, chunk)
+	}
+	c.nextOutgoingID += uint32(len(chunks))
+	c.mutex.Unlock()
+	for i, chunk := range chunks {
+		fields := []any{s.link.handle, nil, nil, nil, false, i < len(chunks)-1}
+		if i == 0 {
+			fields[1], fields[2], fields[3] = deliveryID, binary.BigEndian.AppendUint32(nil, deliveryID), uint32(0)
+		}
+		if err = c.writeLocked(frameTypeAMQP, &described{code: codeTransfer, value: fields}, chunk); err != nil {
+			break
+		}
+	}
+	c.writeMutex.Unlock()
+	if err != nil {
+		c.fail(fmt.Errorf("AMQP connection lost: %w", err))
+		return c.Err()
+	}
+
+	select {
+	case err = <-result:
+		return err
+	case <-ctx.Done():
+		c.mutex.Lock()
+		delete(c.pendingSends, deliveryID)
+		c.mutex.Unlock()
+		return ctx.Err()
+	case <-c.done:
+		return c.Err()
+	}
+}
+
+// Receiver receives the messages of an address
+type Receiver struct {
+	conn *Conn
+	link *link
+}
+
+// NewReceiver attaches a link receiving the messages of the address, the broker being given the credit to send up to
+// capacity messages which aren't received yet
+func (c *Conn) NewReceiver(ctx context.Context, address string, capacity uint32) (*Receiver, error) {
+	if capacity == 0 {
+		return nil, errors.New("the capacity of the AMQP receiver must be positive")
+	}
+	l, err := c.attach(ctx, address, true, capacity)
+	if err != nil {
+		return nil, err
+	}
+	r := &Receiver{conn: c, link: l}
+	if err = r.grantCredit(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// grantCredit gives the broker the credit to send as many messages as the receiver can buffer
+func (r *Receiver) grantCredit() error {
+	c := r.conn
+	c.mutex.Lock()
+	r.link.consumed = 0
+	credit := r.link.capacity - uint32(len(r.link.messages))
+	flow := []any{c.nextIncomingID, uint32(sessionWindow), c.nextOutgoingID, uint32(sessionWindow), r.link.handle, r.link.deliveryCount, credit}
+	c.mutex.Unlock()
+	return c.write(frameTypeAMQP, &described{code: codeFlow, value: flow}, nil)
+}
+
+// Receive waits for the next message. The credit of the broker is renewed once half of the messages it could send are
+// received.
+func (r *Receiver) Receive(ctx context.Context) (*Message, error) {
+	var msg *Message
+	select {
+	case msg = <-r.link.messages:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-r.conn.done:
+		return nil, r.conn.Err()
+	}
+
+	r.conn.mutex.Lock()
+	r.link.consumed++
+	renew := r.link.consumed >= (r.link.capacity+1)/2
+	r.conn.mutex.Unlock()
+	if renew {
+		if err := r.grantCredit(); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// Accept settles the delivery of the message received, which the broker then removes from the address
+func (r *Receiver) Accept(msg *Message) error {
+	if msg.settled {
+		return nil
+	}
+	disposition := []any{true, msg.deliveryID, nil, true, described{code: codeAccepted, value: []any{}}}
+	return r.conn.write(frameTypeAMQP, &described{code: codeDisposition, value: disposition}, nil)
+}
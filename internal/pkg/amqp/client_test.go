@@ -0,0 +1,398 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package amqp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUsername = "edgex"
+	testPassword = "password"
+	// testBrokerMaxFrameSize is small, so that the messages are split in several transfer frames
+	testBrokerMaxFrameSize = 1024
+)
+
+// testBroker is an AMQP 1.0 broker which routes the messages sent to an address to the receivers of the address. The
+// messages whose subject is "reject" are rejected.
+type testBroker struct {
+	t        *testing.T
+	listener net.Listener
+
+	mutex     sync.Mutex
+	queues    map[string][]*Message
+	receivers map[string][]*testReceiverLink
+	accepted  chan uint32
+	// maxMessageSizes are the max message sizes of the receiver links attached, which the broker ignores
+	maxMessageSizes chan any
+	// detached are the errors of the links detached by the clients
+	detached chan *Error
+}
+
+// testReceiverLink is the link of a client receiver, to which the broker sends the messages of the address
+type testReceiverLink struct {
+	conn           *testBrokerConn
+	handle         uint32
+	deliveryCount  uint32
+	credit         uint32
+	nextDeliveryID *uint32
+}
+
+type testBrokerConn struct {
+	writeMutex sync.Mutex
+	conn       net.Conn
+}
+
+func (c *testBrokerConn) write(t *testing.T, frameType byte, body described, payload []byte) {
+	fr, err := encodeFrame(frameType, 0, &body, payload)
+	require.NoError(t, err)
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	_, _ = c.conn.Write(fr)
+}
+
+func newTestBroker(t *testing.T) *testBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	b := &testBroker{
+		t:         t,
+		listener:  listener,
+		queues:    make(map[string][]*Message),
+		receivers: make(map[string][]*testReceiverLink),
+		accepted:  make(chan uint32, 10),
+
+		maxMessageSizes: make(chan any, 10),
+		detached:        make(chan *Error, 10),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go b.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { _ = listener.Close() })
+	return b
+}
+
+func (b *testBroker) url() string {
+	return "amqp://" + b.listener.Addr().String()
+}
+
+func (b *testBroker) serve(netConn net.Conn) {
+	defer netConn.Close()
+	r := bufio.NewReader(netConn)
+	conn := &testBrokerConn{conn: netConn}
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(r, header); err != nil || !bytes.Equal(header, protocolHeaderSASL) {
+		return
+	}
+	_, _ = netConn.Write(protocolHeaderSASL)
+	conn.write(b.t, frameTypeSASL, described{code: codeSASLMechanisms, value: []any{[]Symbol{"PLAIN", "ANONYMOUS"}}}, nil)
+	fr, err := readFrame(r, defaultMaxFrameSize)
+	if err != nil {
+		return
+	}
+	outcome := saslOutcomeOk
+	if fr.body.field(0) != Symbol("PLAIN") || string(toBytes(fr.body.field(1))) != "\x00"+testUsername+"\x00"+testPassword {
+		outcome = 1
+	}
+	conn.write(b.t, frameTypeSASL, described{code: codeSASLOutcome, value: []any{outcome}}, nil)
+	if outcome != saslOutcomeOk {
+		return
+	}
+	if _, err = io.ReadFull(r, header); err != nil || !bytes.Equal(header, protocolHeaderAMQP) {
+		return
+	}
+	_, _ = netConn.Write(protocolHeaderAMQP)
+
+	var nextDeliveryID uint32
+	links := make(map[uint32]string)
+	var partial []byte
+	var partialID uint32
+	for {
+		fr, err := readFrame(r, defaultMaxFrameSize)
+		if err != nil {
+			return
+		}
+		if fr.body == nil {
+			continue
+		}
+		body := *fr.body
+		switch body.code {
+		case codeOpen:
+			conn.write(b.t, frameTypeAMQP, described{code: codeOpen, value: []any{"test-broker", nil, uint32(testBrokerMaxFrameSize), nil, uint32(0)}}, nil)
+		case codeBegin:
+			conn.write(b.t, frameTypeAMQP, described{code: codeBegin, value: []any{uint16(0), uint32(0), uint32(100), uint32(100)}}, nil)
+		case codeAttach:
+			handle := toUint32(body.field(1))
+			receiver := toBool(body.field(2))
+			source, _ := body.field(5).(described)
+			target, _ := body.field(6).(described)
+			reply := []any{body.field(0), handle, !receiver, nil, nil, source, target, nil, false, nil}
+			if receiver {
+				reply[9] = uint32(0)
+			}
+			conn.write(b.t, frameTypeAMQP, described{code: codeAttach, value: reply}, nil)
+			if receiver {
+				b.maxMessageSizes <- body.field(10)
+				address := toString(source.field(0))
+				links[handle] = address
+				b.mutex.Lock()
+				b.receivers[address] = append(b.receivers[address], &testReceiverLink{conn: conn, handle: handle, nextDeliveryID: &nextDeliveryID})
+				b.mutex.Unlock()
+				continue
+			}
+			links[handle] = toString(target.field(0))
+			conn.write(b.t, frameTypeAMQP, described{code: codeFlow, value: []any{uint32(0), uint32(100), uint32(0), uint32(100), handle, uint32(0), uint32(10)}}, nil)
+		case codeFlow:
+			address := links[toUint32(body.field(4))]
+			b.mutex.Lock()
+			for _, receiver := range b.receivers[address] {
+				if receiver.conn == conn && receiver.handle == toUint32(body.field(4)) {
+					receiver.credit = toUint32(body.field(5)) + toUint32(body.field(6)) - receiver.deliveryCount
+				}
+			}
+			b.deliver(address)
+			b.mutex.Unlock()
+		case codeTransfer:
+			if body.field(1) != nil {
+				partial, partialID = nil, toUint32(body.field(1))
+			}
+			partial = append(partial, fr.payload...)
+			if toBool(body.field(5)) {
+				continue
+			}
+			msg, err := decodeMessage(partial)
+			require.NoError(b.t, err)
+			var state any = described{code: codeAccepted, value: []any{}}
+			if msg.Subject == "reject" {
+				state = described{code: codeRejected, value: []any{described{code: codeError, value: []any{Symbol("amqp:precondition-failed"), "rejected subject"}}}}
+			} else {
+				address := links[toUint32(body.field(0))]
+				b.mutex.Lock()
+				b.queues[address] = append(b.queues[address], msg)
+				b.deliver(address)
+				b.mutex.Unlock()
+			}
+			conn.write(b.t, frameTypeAMQP, described{code: codeDisposition, value: []any{true, partialID, nil, true, state}}, nil)
+		case codeDisposition:
+			if _, ok := body.field(4).(described); ok {
+				b.accepted <- toUint32(body.field(1))
+			}
+		case codeDetach:
+			b.detached <- toError(body.field(2))
+		case codeClose:
+			conn.write(b.t, frameTypeAMQP, described{code: codeClose, value: []any{}}, nil)
+			return
+		}
+	}
+}
+
+// deliver sends the queued messages of the address to its receivers with credit, the mutex being locked
+func (b *testBroker) deliver(address string) {
+	for _, receiver := range b.receivers[address] {
+		for receiver.credit > 0 && len(b.queues[address]) > 0 {
+			msg := b.queues[address][0]
+			b.queues[address] = b.queues[address][1:]
+			receiver.credit--
+			receiver.deliveryCount++
+			deliveryID := *receiver.nextDeliveryID
+			*receiver.nextDeliveryID++
+			payload, err := encodeMessage(msg)
+			require.NoError(b.t, err)
+			for first := true; first || len(payload) > 0; first = false {
+				chunk := payload
+				if len(chunk) > testBrokerMaxFrameSize/2 {
+					chunk = chunk[:testBrokerMaxFrameSize/2]
+				}
+				payload = payload[len(chunk):]
+				fields := []any{receiver.handle, nil, nil, nil, false, len(payload) > 0}
+				if first {
+					fields[1], fields[2], fields[3] = deliveryID, []byte{byte(deliveryID)}, uint32(0)
+				}
+				receiver.conn.write(b.t, frameTypeAMQP, described{code: codeTransfer, value: fields}, chunk)
+			}
+		}
+	}
+}
+
+func TestSendReceive(t *testing.T) {
+	broker := newTestBroker(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := Dial(ctx, broker.url(), Options{ContainerID: "core-command", Username: testUsername, Password: testPassword})
+	require.NoError(t, err)
+	defer conn.Close()
+	receiver, err := conn.NewReceiver(ctx, "edgex.command.request", 2)
+	require.NoError(t, err)
+	sender, err := conn.NewSender(ctx, "edgex.command.request")
+	require.NoError(t, err)
+
+	sent := []*Message{
+		{MessageID: "1", Subject: "device/command/get", ReplyTo: "edgex.command.response", ContentType: "application/json", Data: []byte(`{"apiVersion":"v3"}`)},
+		// larger than the frames of the broker
+		{MessageID: "2", CorrelationID: "1", Subject: "device/command/set", ApplicationProperties: map[string]any{"tenant": "a"}, Data: []byte(strings.Repeat("x", 5*testBrokerMaxFrameSize))},
+		{MessageID: "3", Subject: "device/command/get"},
+		{MessageID: "4", Subject: "device/command/get"},
+	}
+	for _, msg := range sent {
+		require.NoError(t, sender.Send(ctx, msg))
+	}
+	for i, expected := range sent {
+		received, err := receiver.Receive(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expected.MessageID, received.MessageID)
+		assert.Equal(t, expected.CorrelationID, received.CorrelationID)
+		assert.Equal(t, expected.Subject, received.Subject)
+		assert.Equal(t, expected.ReplyTo, received.ReplyTo)
+		assert.Equal(t, expected.ContentType, received.ContentType)
+		assert.Equal(t, expected.ApplicationProperties, received.ApplicationProperties)
+		assert.Equal(t, len(expected.Data), len(received.Data))
+		assert.Equal(t, expected.Data, received.Data)
+		require.NoError(t, receiver.Accept(received))
+		select {
+		case deliveryID := <-broker.accepted:
+			assert.Equal(t, uint32(i), deliveryID)
+		case <-ctx.Done():
+			require.Fail(t, "the message wasn't accepted")
+		}
+	}
+
+	err = sender.Send(ctx, &Message{Subject: "reject"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rejected subject")
+
+	require.NoError(t, conn.Close())
+	<-conn.Done()
+	assert.ErrorIs(t, conn.Err(), ErrClosed)
+	_, err = receiver.Receive(ctx)
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestReceiveMaxMessageSize(t *testing.T) {
+	broker := newTestBroker(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	maxMessageSize := uint64(2 * testBrokerMaxFrameSize)
+	conn, err := Dial(ctx, broker.url(), Options{ContainerID: "core-command", Username: testUsername, Password: testPassword, MaxMessageSize: maxMessageSize})
+	require.NoError(t, err)
+	defer conn.Close()
+	receiver, err := conn.NewReceiver(ctx, "edgex.command.request", 2)
+	require.NoError(t, err)
+	assert.Equal(t, maxMessageSize, <-broker.maxMessageSizes)
+	sender, err := conn.NewSender(ctx, "edgex.command.request")
+	require.NoError(t, err)
+
+	// the broker ignores the max message size of the receiver, and delivers the message in several transfer frames, the
+	// connection possibly failing before the message sent is settled
+	_ = sender.Send(ctx, &Message{MessageID: "1", Data: []byte(strings.Repeat("x", 5*testBrokerMaxFrameSize))})
+	select {
+	case detachErr := <-broker.detached:
+		require.NotNil(t, detachErr)
+		assert.Equal(t, Symbol("amqp:link:message-size-exceeded"), detachErr.Condition)
+	case <-ctx.Done():
+		require.Fail(t, "the receiver link wasn't detached")
+	}
+	<-conn.Done()
+	assert.Contains(t, conn.Err().Error(), "amqp:link:message-size-exceeded")
+	_, err = receiver.Receive(ctx)
+	require.Error(t, err)
+}
+
+func TestDial(t *testing.T) {
+	broker := newTestBroker(t)
+	tests := []struct {
+		name          string
+		url           string
+		username      string
+		expectedError string
+	}{
+		{"authenticated", broker.url(), testUsername, ""},
+		{"authentication failure", broker.url(), "unknown", "authentication failed"},
+		{"invalid scheme", "tcp://" + broker.listener.Addr().String(), testUsername, "scheme"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			conn, err := Dial(ctx, tt.url, Options{ContainerID: "core-command", Username: tt.username, Password: testPassword})
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	values := []any{
+		nil,
+		true,
+		false,
+		uint8(7),
+		uint16(1000),
+		uint32(0),
+		uint32(200),
+		uint32(70000),
+		uint64(0),
+		uint64(200),
+		uint64(1) << 40,
+		int32(-5),
+		int64(-1) << 40,
+		1.5,
+		time.UnixMilli(1700000000123).UTC(),
+		"text",
+		strings.Repeat("long text ", 30),
+		Symbol("amqp:not-found"),
+		[]byte{1, 2, 3},
+		[]any{},
+		[]any{"a", uint32(1), []any{true}},
+		described{code: codeSource, value: []any{"address"}},
+	}
+	for _, value := range values {
+		var buf bytes.Buffer
+		require.NoError(t, encode(&buf, value))
+		decoded, err := decode(buf.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, value, decoded)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, encode(&buf, map[string]any{"tenant": "a"}))
+	decoded, err := decode(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, map[any]any{"tenant": "a"}, decoded)
+
+	buf.Reset()
+	require.NoError(t, encode(&buf, []Symbol{"PLAIN", "ANONYMOUS"}))
+	decoded, err = decode(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, []any{Symbol("PLAIN"), Symbol("ANONYMOUS")}, decoded)
+
+	_, err = decode([]byte{typeStr8, 10, 'a'})
+	require.Error(t, err)
+	_, err = decode([]byte{0x01})
+	require.Error(t, err)
+}
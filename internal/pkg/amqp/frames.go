@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package amqp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// The types of the frames
+const (
+	frameTypeAMQP = 0x00
+	frameTypeSASL = 0x01
+)
+
+// The codes of the descriptors of the performatives, the SASL frames, the delivery states, the terminus and the
+// message sections
+const (
+	codeOpen           uint64 = 0x10
+	codeBegin          uint64 = 0x11
+	codeAttach         uint64 = 0x12
+	codeFlow           uint64 = 0x13
+	codeTransfer       uint64 = 0x14
+	codeDisposition    uint64 = 0x15
+	codeDetach         uint64 = 0x16
+	codeEnd            uint64 = 0x17
+	codeClose          uint64 = 0x18
+	codeError          uint64 = 0x1d
+	codeAccepted       uint64 = 0x24
+	codeRejected       uint64 = 0x25
+	codeReleased       uint64 = 0x26
+	codeModified       uint64 = 0x27
+	codeSource         uint64 = 0x28
+	codeTarget         uint64 = 0x29
+	codeSASLMechanisms uint64 = 0x40
+	codeSASLInit       uint64 = 0x41
+	codeSASLOutcome    uint64 = 0x44
+	codeHeader         uint64 = 0x70
+	codeProperties     uint64 = 0x73
+	codeAppProperties  uint64 = 0x74
+	codeData           uint64 = 0x75
+	codeAMQPValue      uint64 = 0x77
+)
+
+// The protocol headers sent before the SASL and the AMQP frames
+var (
+	protocolHeaderSASL = []byte{'A', 'M', 'Q', 'P', 3, 1, 0, 0}
+	protocolHeaderAMQP = []byte{'A', 'M', 'Q', 'P', 0, 1, 0, 0}
+)
+
+const (
+	// frameHeaderSize is the size of the frame header without extended header
+	frameHeaderSize = 8
+	// minMaxFrameSize is the smallest max frame size a peer may require
+	minMaxFrameSize = 512
+)
+
+// frame is a frame received from the peer, whose body is nil for the empty frames sent to keep the connection alive
+type frame struct {
+	frameType byte
+	channel   uint16
+	body      *described
+	// payload is the part of the transfer frames following the performative
+	payload []byte
+}
+
+// readFrame reads the next frame, which is at most maxFrameSize long
+func readFrame(r io.Reader, maxFrameSize uint32) (frame, error) {
+	header := make([]byte, frameHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return frame{}, err
+	}
+	size := binary.BigEndian.Uint32(header)
+	dataOffset := int(header[4]) * 4
+	if size < frameHeaderSize || size > maxFrameSize || dataOffset < frameHeaderSize || uint32(dataOffset) > size {
+		return frame{}, fmt.Errorf("invalid AMQP frame of %d bytes with data offset %d", size, dataOffset)
+	}
+	data := make([]byte, size-frameHeaderSize)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return frame{}, err
+	}
+	fr := frame{frameType: header[5], channel: binary.BigEndian.Uint16(header[6:])}
+	// the extended header is ignored
+	data = data[dataOffset-frameHeaderSize:]
+	if len(data) == 0 {
+		return fr, nil
+	}
+
+	d := &decoder{buf: data}
+	value, err := d.value()
+	if err != nil {
+		return frame{}, fmt.Errorf("invalid AMQP frame body: %w", err)
+	}
+	body, ok := value.(described)
+	if !ok {
+		return frame{}, fmt.Errorf("invalid AMQP frame body of type %T", value)
+	}
+	fr.body = &body
+	fr.payload = data[d.offset:]
+	return fr, nil
+}
+
+// encodeFrame returns the frame of the body, followed by the payload of the transfer frames, or the empty frame when
+// the body is nil
+func encodeFrame(frameType byte, channel uint16, body *described, payload []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(make([]byte, frameHeaderSize))
+	if body != nil {
+		if err := encode(&buf, *body); err != nil {
+			return nil, err
+		}
+	}
+	buf.Write(payload)
+	fr := buf.Bytes()
+	binary.BigEndian.PutUint32(fr, uint32(len(fr)))
+	fr[4] = 2
+	fr[5] = frameType
+	binary.BigEndian.PutUint16(fr[6:], channel)
+	return fr, nil
+}
+
+// Error is an error condition sent by the peer when it closes the connection, ends the session, detaches a link or
+// rejects a message
+type Error struct {
+	Condition   Symbol
+	Description string
+}
+
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return string(e.Condition)
+	}
+	return fmt.Sprintf("%s: %s", e.Condition, e.Description)
+}
+
+// toError returns the error of the described error field, nil when the field is absent
+func toError(v any) *Error {
+	d, ok := v.(described)
+	if !ok || d.code != codeError {
+		return nil
+	}
+	condition, _ := d.field(0).(Symbol)
+	return &Error{Condition: condition, Description: toString(d.field(1))}
+}
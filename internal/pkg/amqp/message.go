@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package amqp
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Message is an AMQP message whose body is a single data section, along with the properties used by the
+// request/response exchanges
+type Message struct {
+	MessageID     string
+	CorrelationID string
+	// Subject is the subject of the message, i.e. the kind of request
+	Subject     string
+	ReplyTo     string
+	ContentType string
+	// ApplicationProperties are the properties of the message defined by the application
+	ApplicationProperties map[string]any
+	Data                  []byte
+
+	// deliveryID is the id of the delivery of the received message
+	deliveryID uint32
+	// settled indicates whether the delivery of the received message was settled by the broker, i.e. doesn't need to
+	// be accepted
+	settled bool
+}
+
+// encodeMessage returns the properties, the application properties and the data sections of the message
+func encodeMessage(msg *Message) ([]byte, error) {
+	var buf bytes.Buffer
+	properties := []any{nilIfEmpty(msg.MessageID), nil, nil, nilIfEmpty(msg.Subject), nilIfEmpty(msg.ReplyTo),
+		nilIfEmpty(msg.CorrelationID)}
+	if msg.ContentType != "" {
+		properties = append(properties, Symbol(msg.ContentType))
+	}
+	if err := encode(&buf, described{code: codeProperties, value: properties}); err != nil {
+		return nil, err
+	}
+	if len(msg.ApplicationProperties) > 0 {
+		if err := encode(&buf, described{code: codeAppProperties, value: msg.ApplicationProperties}); err != nil {
+			return nil, err
+		}
+	}
+	if err := encode(&buf, described{code: codeData, value: msg.Data}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeMessage decodes the sections of the message. The data sections are concatenated, and a string or binary
+// value section is used as data. The other sections are ignored.
+func decodeMessage(payload []byte) (*Message, error) {
+	msg := &Message{}
+	d := &decoder{buf: payload}
+	for d.remaining() > 0 {
+		value, err := d.value()
+		if err != nil {
+			return nil, fmt.Errorf("invalid AMQP message: %w", err)
+		}
+		section, ok := value.(described)
+		if !ok {
+			return nil, fmt.Errorf("invalid AMQP message section of type %T", value)
+		}
+		switch section.code {
+		case codeProperties:
+			msg.MessageID = toString(section.field(0))
+			msg.Subject = toString(section.field(3))
+			msg.ReplyTo = toString(section.field(4))
+			msg.CorrelationID = toString(section.field(5))
+			msg.ContentType = toString(section.field(6))
+		case codeAppProperties:
+			properties, _ := section.value.(map[any]any)
+			msg.ApplicationProperties = make(map[string]any, len(properties))
+			for k, v := range properties {
+				msg.ApplicationProperties[toString(k)] = v
+			}
+		case codeData:
+			msg.Data = append(msg.Data, toBytes(section.value)...)
+		case codeAMQPValue:
+			switch v := section.value.(type) {
+			case []byte:
+				msg.Data = v
+			case string:
+				msg.Data = []byte(v)
+			default:
+				return nil, fmt.Errorf("unsupported AMQP message value of type %T", v)
+			}
+		}
+	}
+	return msg, nil
+}
+
+func nilIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
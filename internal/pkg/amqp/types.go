@@ -0,0 +1,548 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package amqp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Symbol is an AMQP symbol, i.e. the name of a SASL mechanism or of an error condition
+type Symbol string
+
+// described is an AMQP described value, i.e. a performative or a message section, whose descriptor is the numeric code
+// of its type
+type described struct {
+	code  uint64
+	value any
+}
+
+// list returns the fields of the described list, nil when the value isn't a list
+func (d described) list() []any {
+	fields, _ := d.value.([]any)
+	return fields
+}
+
+// field returns the field at index i of the described list, nil when it's absent
+func (d described) field(i int) any {
+	fields := d.list()
+	if i >= len(fields) {
+		return nil
+	}
+	return fields[i]
+}
+
+// The constructors of the AMQP primitive types
+const (
+	typeDescribed  = 0x00
+	typeNull       = 0x40
+	typeTrue       = 0x41
+	typeFalse      = 0x42
+	typeUint0      = 0x43
+	typeUlong0     = 0x44
+	typeList0      = 0x45
+	typeUbyte      = 0x50
+	typeByte       = 0x51
+	typeSmallUint  = 0x52
+	typeSmallUlong = 0x53
+	typeSmallInt   = 0x54
+	typeSmallLong  = 0x55
+	typeBool       = 0x56
+	typeUshort     = 0x60
+	typeShort      = 0x61
+	typeUint       = 0x70
+	typeInt        = 0x71
+	typeFloat      = 0x72
+	typeChar       = 0x73
+	typeDecimal32  = 0x74
+	typeUlong      = 0x80
+	typeLong       = 0x81
+	typeDouble     = 0x82
+	typeTimestamp  = 0x83
+	typeDecimal64  = 0x84
+	typeDecimal128 = 0x94
+	typeUUID       = 0x98
+	typeVbin8      = 0xa0
+	typeStr8       = 0xa1
+	typeSym8       = 0xa3
+	typeVbin32     = 0xb0
+	typeStr32      = 0xb1
+	typeSym32      = 0xb3
+	typeList8      = 0xc0
+	typeMap8       = 0xc1
+	typeList32     = 0xd0
+	typeMap32      = 0xd1
+	typeArray8     = 0xe0
+	typeArray32    = 0xf0
+)
+
+// encode appends the AMQP encoding of the value to the buffer. The Go types are encoded as the AMQP type of the same
+// width, the maps as AMQP maps and the slices of any as AMQP lists.
+func encode(buf *bytes.Buffer, v any) error {
+	switch value := v.(type) {
+	case nil:
+		buf.WriteByte(typeNull)
+	case bool:
+		if value {
+			buf.WriteByte(typeTrue)
+		} else {
+			buf.WriteByte(typeFalse)
+		}
+	case uint8:
+		buf.Write([]byte{typeUbyte, value})
+	case uint16:
+		buf.WriteByte(typeUshort)
+		buf.Write(binary.BigEndian.AppendUint16(nil, value))
+	case uint32:
+		switch {
+		case value == 0:
+			buf.WriteByte(typeUint0)
+		case value <= math.MaxUint8:
+			buf.Write([]byte{typeSmallUint, byte(value)})
+		default:
+			buf.WriteByte(typeUint)
+			buf.Write(binary.BigEndian.AppendUint32(nil, value))
+		}
+	case uint64:
+		switch {
+		case value == 0:
+			buf.WriteByte(typeUlong0)
+		case value <= math.MaxUint8:
+			buf.Write([]byte{typeSmallUlong, byte(value)})
+		default:
+			buf.WriteByte(typeUlong)
+			buf.Write(binary.BigEndian.AppendUint64(nil, value))
+		}
+	case int32:
+		buf.WriteByte(typeInt)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(value)))
+	case int64:
+		buf.WriteByte(typeLong)
+		buf.Write(binary.BigEndian.AppendUint64(nil, uint64(value)))
+	case int:
+		buf.WriteByte(typeLong)
+		buf.Write(binary.BigEndian.AppendUint64(nil, uint64(value)))
+	case float64:
+		buf.WriteByte(typeDouble)
+		buf.Write(binary.BigEndian.AppendUint64(nil, math.Float64bits(value)))
+	case time.Time:
+		buf.WriteByte(typeTimestamp)
+		buf.Write(binary.BigEndian.AppendUint64(nil, uint64(value.UnixMilli())))
+	case string:
+		encodeVariable(buf, typeStr8, typeStr32, []byte(value))
+	case Symbol:
+		encodeVariable(buf, typeSym8, typeSym32, []byte(value))
+	case []byte:
+		encodeVariable(buf, typeVbin8, typeVbin32, value)
+	case []Symbol:
+		return encodeSymbolArray(buf, value)
+	case []any:
+		return encodeList(buf, value)
+	case map[string]any:
+		entries := make([]any, 0, 2*len(value))
+		for k, item := range value {
+			entries = append(entries, k, item)
+		}
+		return encodeMap(buf, entries)
+	case map[Symbol]any:
+		entries := make([]any, 0, 2*len(value))
+		for k, item := range value {
+			entries = append(entries, k, item)
+		}
+		return encodeMap(buf, entries)
+	case described:
+		buf.WriteByte(typeDescribed)
+		if err := encode(buf, value.code); err != nil {
+			return err
+		}
+		return encode(buf, value.value)
+	default:
+		return fmt.Errorf("unsupported AMQP value type %T", v)
+	}
+	return nil
+}
+
+// encodeVariable appends the variable width value with the 1 byte size constructor when it's short enough, with the
+// 4 bytes size constructor otherwise
+func encodeVariable(buf *bytes.Buffer, type8 byte, type32 byte, value []byte) {
+	if len(value) <= math.MaxUint8 {
+		buf.Write([]byte{type8, byte(len(value))})
+	} else {
+		buf.WriteByte(type32)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(len(value))))
+	}
+	buf.Write(value)
+}
+
+// encodeCompound appends the list or the map of the count encoded items
+func encodeCompound(buf *bytes.Buffer, type8 byte, type32 byte, count int, items []byte) {
+	if len(items)+1 <= math.MaxUint8 && count <= math.MaxUint8 {
+		buf.Write([]byte{type8, byte(len(items) + 1), byte(count)})
+	} else {
+		buf.WriteByte(type32)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(len(items)+4)))
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(count)))
+	}
+	buf.Write(items)
+}
+
+func encodeList(buf *bytes.Buffer, list []any) error {
+	if len(list) == 0 {
+		buf.WriteByte(typeList0)
+		return nil
+	}
+	var items bytes.Buffer
+	for _, item := range list {
+		if err := encode(&items, item); err != nil {
+			return err
+		}
+	}
+	encodeCompound(buf, typeList8, typeList32, len(list), items.Bytes())
+	return nil
+}
+
+func encodeMap(buf *bytes.Buffer, entries []any) error {
+	var items bytes.Buffer
+	for _, item := range entries {
+		if err := encode(&items, item); err != nil {
+			return err
+		}
+	}
+	encodeCompound(buf, typeMap8, typeMap32, len(entries), items.Bytes())
+	return nil
+}
+
+// encodeSymbolArray appends the array of symbols, which are all encoded with the 4 bytes size constructor
+func encodeSymbolArray(buf *bytes.Buffer, symbols []Symbol) error {
+	var items bytes.Buffer
+	items.WriteByte(typeSym32)
+	for _, symbol := range symbols {
+		items.Write(binary.BigEndian.AppendUint32(nil, uint32(len(symbol))))
+		items.WriteString(string(symbol))
+	}
+	buf.WriteByte(typeArray32)
+	buf.Write(binary.BigEndian.AppendUint32(nil, uint32(items.Len()+4)))
+	buf.Write(binary.BigEndian.AppendUint32(nil, uint32(len(symbols))))
+	buf.Write(items.Bytes())
+	return nil
+}
+
+// decoder decodes the AMQP values of a buffer
+type decoder struct {
+	buf    []byte
+	offset int
+}
+
+func (d *decoder) remaining() int {
+	return len(d.buf) - d.offset
+}
+
+func (d *decoder) next(n int) ([]byte, error) {
+	if n < 0 || d.remaining() < n {
+		return nil, fmt.Errorf("truncated AMQP value, %d bytes expected and %d remaining", n, d.remaining())
+	}
+	b := d.buf[d.offset : d.offset+n]
+	d.offset += n
+	return b, nil
+}
+
+func (d *decoder) byte() (byte, error) {
+	b, err := d.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *decoder) uint32() (uint32, error) {
+	b, err := d.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(b), nil
+}
+
+// value decodes the next value of the buffer
+func (d *decoder) value() (any, error) {
+	constructor, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+	if constructor == typeDescribed {
+		descriptor, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		value, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		code, ok := descriptor.(uint64)
+		if !ok {
+			return nil, fmt.Errorf("unsupported AMQP descriptor %v, only the numeric descriptors are supported", descriptor)
+		}
+		return described{code: code, value: value}, nil
+	}
+	return d.primitive(constructor)
+}
+
+// primitive decodes the value of the primitive type of the constructor
+func (d *decoder) primitive(constructor byte) (any, error) {
+	switch constructor {
+	case typeNull:
+		return nil, nil
+	case typeTrue:
+		return true, nil
+	case typeFalse:
+		return false, nil
+	case typeUint0:
+		return uint32(0), nil
+	case typeUlong0:
+		return uint64(0), nil
+	case typeList0:
+		return []any{}, nil
+	}
+
+	// the fixed width types
+	var width int
+	switch constructor & 0xf0 {
+	case 0x50:
+		width = 1
+	case 0x60:
+		width = 2
+	case 0x70:
+		width = 4
+	case 0x80:
+		width = 8
+	case 0x90:
+		width = 16
+	}
+	if width > 0 {
+		b, err := d.next(width)
+		if err != nil {
+			return nil, err
+		}
+		switch constructor {
+		case typeUbyte:
+			return b[0], nil
+		case typeByte:
+			return int8(b[0]), nil
+		case typeSmallUint:
+			return uint32(b[0]), nil
+		case typeSmallUlong:
+			return uint64(b[0]), nil
+		case typeSmallInt:
+			return int32(int8(b[0])), nil
+		case typeSmallLong:
+			return int64(int8(b[0])), nil
+		case typeBool:
+			return b[0] != 0, nil
+		case typeUshort:
+			return binary.BigEndian.Uint16(b), nil
+		case typeShort:
+			return int16(binary.BigEndian.Uint16(b)), nil
+		case typeUint:
+			return binary.BigEndian.Uint32(b), nil
+		case typeInt, typeChar:
+			return int32(binary.BigEndian.Uint32(b)), nil
+		case typeFloat:
+			return math.Float32frombits(binary.BigEndian.Uint32(b)), nil
+		case typeUlong:
+			return binary.BigEndian.Uint64(b), nil
+		case typeLong:
+			return int64(binary.BigEndian.Uint64(b)), nil
+		case typeDouble:
+			return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+		case typeTimestamp:
+			return time.UnixMilli(int64(binary.BigEndian.Uint64(b))).UTC(), nil
+		case typeUUID:
+			var uuid [16]byte
+			copy(uuid[:], b)
+			return uuid, nil
+		case typeDecimal32, typeDecimal64, typeDecimal128:
+			// the decimals are left encoded, no EdgeX value being a decimal
+			return append([]byte(nil), b...), nil
+		}
+		return nil, fmt.Errorf("unsupported AMQP type 0x%02x", constructor)
+	}
+
+	// the variable width and the compound types
+	var size int
+	switch constructor {
+	case typeVbin8, typeStr8, typeSym8, typeList8, typeMap8, typeArray8:
+		b, err := d.byte()
+		if err != nil {
+			return nil, err
+		}
+		size = int(b)
+	case typeVbin32, typeStr32, typeSym32, typeList32, typeMap32, typeArray32:
+		n, err := d.uint32()
+		if err != nil {
+			return nil, err
+		}
+		size = int(n)
+	default:
+		return nil, fmt.Errorf("unsupported AMQP type 0x%02x", constructor)
+	}
+	b, err := d.next(size)
+	if err != nil {
+		return nil, err
+	}
+	switch constructor {
+	case typeVbin8, typeVbin32:
+		return append([]byte(nil), b...), nil
+	case typeStr8, typeStr32:
+		return string(b), nil
+	case typeSym8, typeSym32:
+		return Symbol(b), nil
+	}
+
+	// the count of the items of the compound types has the width of their size
+	items := &decoder{buf: b}
+	var count int
+	if constructor == typeList8 || constructor == typeMap8 || constructor == typeArray8 {
+		c, err := items.byte()
+		if err != nil {
+			return nil, err
+		}
+		count = int(c)
+	} else {
+		c, err := items.uint32()
+		if err != nil {
+			return nil, err
+		}
+		count = int(c)
+	}
+	if count > items.remaining() && constructor != typeArray8 && constructor != typeArray32 {
+		return nil, fmt.Errorf("invalid AMQP compound of %d items in %d bytes", count, items.remaining())
+	}
+
+	switch constructor {
+	case typeList8, typeList32:
+		list := make([]any, count)
+		for i := range list {
+			if list[i], err = items.value(); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case typeMap8, typeMap32:
+		if count%2 != 0 {
+			return nil, fmt.Errorf("invalid AMQP map of %d items", count)
+		}
+		m := make(map[any]any, count/2)
+		for i := 0; i < count; i += 2 {
+			key, err := items.value()
+			if err != nil {
+				return nil, err
+			}
+			value, err := items.value()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := key.([]byte); ok {
+				return nil, fmt.Errorf("unsupported AMQP map key of type binary")
+			}
+			m[key] = value
+		}
+		return m, nil
+	default:
+		return items.array(count)
+	}
+}
+
+// array decodes the count elements of an array, which share the constructor preceding them
+func (d *decoder) array(count int) ([]any, error) {
+	constructor, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+	var descriptor any
+	if constructor == typeDescribed {
+		if descriptor, err = d.value(); err != nil {
+			return nil, err
+		}
+		if constructor, err = d.byte(); err != nil {
+			return nil, err
+		}
+	}
+	if count > d.remaining() && constructor != typeNull && constructor != typeTrue && constructor != typeFalse &&
+		constructor != typeUint0 && constructor != typeUlong0 && constructor != typeList0 {
+		return nil, fmt.Errorf("invalid AMQP array of %d items in %d bytes", count, d.remaining())
+	}
+	elements := make([]any, count)
+	for i := range elements {
+		element, err := d.primitive(constructor)
+		if err != nil {
+			return nil, err
+		}
+		if code, ok := descriptor.(uint64); ok {
+			element = described{code: code, value: element}
+		}
+		elements[i] = element
+	}
+	return elements, nil
+}
+
+// decode decodes the single AMQP value of the buffer
+func decode(buf []byte) (any, error) {
+	d := &decoder{buf: buf}
+	value, err := d.value()
+	if err != nil {
+		return nil, err
+	}
+	if d.remaining() > 0 {
+		return nil, fmt.Errorf("%d unexpected bytes following the AMQP value", d.remaining())
+	}
+	return value, nil
+}
+
+// The conversions of the decoded values to the types of the fields, which accept the narrower encodings of the
+// integers and return the zero value of the type for the absent fields
+
+func toUint32(v any) uint32 {
+	switch value := v.(type) {
+	case uint8:
+		return uint32(value)
+	case uint16:
+		return uint32(value)
+	case uint32:
+		return value
+	case uint64:
+		return uint32(value)
+	}
+	return 0
+}
+
+func toBool(v any) bool {
+	value, _ := v.(bool)
+	return value
+}
+
+func toBytes(v any) []byte {
+	value, _ := v.([]byte)
+	return value
+}
+
+// toString returns the string, symbol or binary value, or the string representation of the other message ids
+func toString(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case Symbol:
+		return string(value)
+	case []byte:
+		return string(value)
+	case [16]byte:
+		return fmt.Sprintf("%x-%x-%x-%x-%x", value[0:4], value[4:6], value[6:8], value[8:10], value[10:16])
+	}
+	return fmt.Sprint(v)
+}
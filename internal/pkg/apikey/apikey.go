@@ -0,0 +1,273 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apikey authenticates the requests of the headless clients with the API keys stored in the secret store of
+// the service, as an alternative to the JWT. Each API key grants its own scopes, i.e. the permissions of the role based
+// access control, and the time each API key was last used is tracked.
+package apikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+)
+
+const (
+	defaultSecretName = "apikeys"
+	// ScopesSuffix is appended to the name of an API key for the secret key of its comma separated scopes
+	ScopesSuffix = ".scopes"
+	// ExpiresSuffix is appended to the name of an API key for the secret key of its RFC 3339 expiration time
+	ExpiresSuffix = ".expires"
+)
+
+// Info configures the API key authentication of a service
+type Info struct {
+	Enabled bool
+	// SecretName of the secret holding the API keys, defaults to apikeys. Each API key is stored under its name, along
+	// with its scopes under <name>.scopes and its optional expiration time under <name>.expires.
+	SecretName string
+	// ReloadInterval is how often the API keys are reloaded from the secret store, i.e. 5m. They are also reloaded
+	// whenever the secret is updated through the service. Empty disables the periodic reload.
+	ReloadInterval string
+}
+
+// Key describes an API key, without its value
+type Key struct {
+	Name   string            `json:"name"`
+	Scopes []rbac.Permission `json:"scopes"`
+	// Expires is the RFC 3339 expiration time of the API key, empty when it doesn't expire
+	Expires string `json:"expires,omitempty"`
+	// LastUsed is the time in milliseconds the API key last authenticated a request, 0 when it's not been used since
+	// the service started
+	LastUsed int64 `json:"lastUsed,omitempty"`
+}
+
+type apiKey struct {
+	digest  [sha256.Size]byte
+	scopes  []rbac.Permission
+	expires time.Time
+}
+
+// Validator validates the API keys of the requests against the API keys loaded from the secret store
+type Validator struct {
+	lc       logger.LoggingClient
+	info     Info
+	mutex    sync.RWMutex
+	keys     map[string]apiKey
+	lastUsed map[string]int64
+}
+
+// ValidatorName contains the name of the Validator in the DIC.
+var ValidatorName = di.TypeInstanceToName(Validator{})
+
+// ValidatorFrom helper function queries the DIC and returns the Validator, nil when the API key authentication isn't
+// enabled.
+func ValidatorFrom(get di.Get) *Validator {
+	validator, ok := get(ValidatorName).(*Validator)
+	if !ok {
+		return nil
+	}
+	return validator
+}
+
+// NewValidator creates the Validator of the service, without any API key until they are loaded
+func NewValidator(info Info, lc logger.LoggingClient) *Validator {
+	if info.SecretName == "" {
+		info.SecretName = defaultSecretName
+	}
+	return &Validator{
+		lc:       lc,
+		info:     info,
+		keys:     make(map[string]apiKey),
+		lastUsed: make(map[string]int64),
+	}
+}
+
+// Load replaces the API keys with the ones of the secret, there are no API keys when the secret doesn't exist
+func (v *Validator) Load(secretProvider interfaces.SecretProvider) error {
+	exists, err := secretProvider.HasSecret(v.info.SecretName)
+	if err != nil {
+		return fmt.Errorf("failed to check the API key secret '%s': %w", v.info.SecretName, err)
+	}
+	secretData := map[string]string{}
+	if exists {
+		secretData, err = secretProvider.GetSecret(v.info.SecretName)
+		if err != nil {
+			return fmt.Errorf("failed to get the API key secret '%s': %w", v.info.SecretName, err)
+		}
+	}
+	keys, err := parseKeys(secretData)
+	if err != nil {
+		return fmt.Errorf("invalid API key secret '%s': %w", v.info.SecretName, err)
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.keys = keys
+	for name := range v.lastUsed {
+		if _, ok := keys[name]; !ok {
+			delete(v.lastUsed, name)
+		}
+	}
+	return nil
+}
+
+// parseKeys parses the API keys of the secret data
+func parseKeys(secretData map[string]string) (map[string]apiKey, error) {
+	keys := make(map[string]apiKey)
+	for name, value := range secretData {
+		if strings.HasSuffix(name, ScopesSuffix) || strings.HasSuffix(name, ExpiresSuffix) {
+			continue
+		}
+		if value == "" {
+			return nil, fmt.Errorf("the API key '%s' is empty", name)
+		}
+		key := apiKey{digest: sha256.Sum256([]byte(value))}
+		for _, scope := range strings.Split(secretData[name+ScopesSuffix], ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				key.scopes = append(key.scopes, rbac.Permission(scope))
+			}
+		}
+		if len(key.scopes) == 0 {
+			return nil, fmt.Errorf("the API key '%s' has no scopes", name)
+		}
+		if expires := secretData[name+ExpiresSuffix]; expires != "" {
+			var err error
+			if key.expires, err = time.Parse(time.RFC3339, expires); err != nil {
+				return nil, fmt.Errorf("invalid expiration time of the API key '%s': %w", name, err)
+			}
+		}
+		keys[name] = key
+	}
+	for name := range secretData {
+		for _, suffix := range []string{ScopesSuffix, ExpiresSuffix} {
+			if keyName, ok := strings.CutSuffix(name, suffix); ok {
+				if _, exists := keys[keyName]; !exists {
+					return nil, fmt.Errorf("'%s' doesn't belong to any API key", name)
+				}
+			}
+		}
+	}
+	return keys, nil
+}
+
+// validate returns the name and the scopes of the API key, and records that it's been used. An error is returned when
+// the API key is unknown or expired.
+func (v *Validator) validate(value string) (string, []rbac.Permission, error) {
+	digest := sha256.Sum256([]byte(value))
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	// all the API keys are compared so that the time taken doesn't depend on which one matches
+	var name string
+	for keyName, key := range v.keys {
+		if subtle.ConstantTimeCompare(digest[:], key.digest[:]) == 1 {
+			name = keyName
+		}
+	}
+	if name == "" {
+		return "", nil, errors.New("unknown API key")
+	}
+	key := v.keys[name]
+	now := time.Now()
+	if !key.expires.IsZero() && now.After(key.expires) {
+		return "", nil, fmt.Errorf("the API key '%s' expired at %s", name, key.expires.Format(time.RFC3339))
+	}
+	v.lastUsed[name] = now.UnixMilli()
+	return name, key.scopes, nil
+}
+
+// Keys returns the API keys, without their values, sorted by name
+func (v *Validator) Keys() []Key {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	keys := make([]Key, 0, len(v.keys))
+	for name, key := range v.keys {
+		k := Key{Name: name, Scopes: key.scopes, LastUsed: v.lastUsed[name]}
+		if !key.expires.IsZero() {
+			k.Expires = key.expires.Format(time.RFC3339)
+		}
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	return keys
+}
+
+// watchSecret reloads the API keys whenever the secret is updated and, if configured, every ReloadInterval
+func (v *Validator) watchSecret(ctx context.Context, wg *sync.WaitGroup, secretProvider interfaces.SecretProvider) {
+	reload := func() {
+		if err := v.Load(secretProvider); err != nil {
+			v.lc.Errorf("Failed to reload the API keys, keeping the current ones: %s", err.Error())
+			return
+		}
+		v.lc.Infof("Reloaded the API keys from secret '%s'", v.info.SecretName)
+	}
+
+	if err := secretProvider.RegisterSecretUpdatedCallback(v.info.SecretName, func(_ string) { reload() }); err != nil {
+		v.lc.Warnf("Unable to watch secret '%s' for updates of the API keys: %s", v.info.SecretName, err.Error())
+	}
+
+	if v.info.ReloadInterval == "" {
+		return
+	}
+	interval, err := time.ParseDuration(v.info.ReloadInterval)
+	if err != nil || interval <= 0 {
+		v.lc.Errorf("Invalid APIKey ReloadInterval '%s', the API keys are not reloaded periodically", v.info.ReloadInterval)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reload()
+			}
+		}
+	}()
+}
+
+// BootstrapValidator loads the API keys of the service and adds the Validator to the DIC, so that the REST routes
+// accept the API keys. Nothing is done when the API key authentication isn't enabled.
+func BootstrapValidator(ctx context.Context, wg *sync.WaitGroup, info Info, dic *di.Container) bool {
+	if !info.Enabled {
+		return true
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	secretProvider := bootstrapContainer.SecretProviderFrom(dic.Get)
+	validator := NewValidator(info, lc)
+	if err := validator.Load(secretProvider); err != nil {
+		lc.Errorf("Failed to load the API keys: %s", err.Error())
+		return false
+	}
+	validator.watchSecret(ctx, wg, secretProvider)
+	lc.Infof("API key authentication enabled with %d API keys", len(validator.Keys()))
+
+	dic.Update(di.ServiceConstructorMap{
+		ValidatorName: func(get di.Get) interface{} {
+			return validator
+		},
+	})
+	return true
+}
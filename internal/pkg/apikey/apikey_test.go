@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+)
+
+const (
+	testDeviceRoute  = "/api/v3/device/name/{name}"
+	testCommandRoute = "/api/v3/device/name/{name}/{command}"
+)
+
+var testSecretData = map[string]string{
+	"dashboard":         "dashboard-key",
+	"dashboard.scopes":  "read",
+	"pipeline":          "pipeline-key",
+	"pipeline.scopes":   "read, write, command",
+	"retired":           "retired-key",
+	"retired.scopes":    "read",
+	"retired.expires":   "2020-01-01T00:00:00Z",
+	"temporary":         "temporary-key",
+	"temporary.scopes":  "read",
+	"temporary.expires": "2100-01-01T00:00:00Z",
+}
+
+func newTestValidator(t *testing.T, secretData map[string]string) *Validator {
+	secretProvider := &mocks.SecretProvider{}
+	secretProvider.On("HasSecret", defaultSecretName).Return(true, nil)
+	secretProvider.On("GetSecret", defaultSecretName).Return(secretData, nil)
+	validator := NewValidator(Info{Enabled: true}, logger.NewMockClient())
+	require.NoError(t, validator.Load(secretProvider))
+	return validator
+}
+
+func TestAuthenticationHandlerFunc(t *testing.T) {
+	validator := newTestValidator(t, testSecretData)
+	table := rbac.PermissionTable{
+		rbac.RouteKey(http.MethodPut, testCommandRoute): rbac.PermissionCommand,
+	}
+	// the JWT authentication hook rejects every request
+	jwtHook := func(inner http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		}
+	}
+	authenticationHook := AuthenticationHandlerFunc(validator, table, jwtHook, logger.NewMockClient())
+	handler := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+	router := mux.NewRouter()
+	router.HandleFunc(testDeviceRoute, authenticationHook(handler))
+	router.HandleFunc(testCommandRoute, authenticationHook(handler))
+
+	tests := []struct {
+		name               string
+		method             string
+		path               string
+		apiKey             string
+		expectedStatusCode int
+	}{
+		{"read with read scope", http.MethodGet, "/api/v3/device/name/sensor", "dashboard-key", http.StatusOK},
+		{"delete without delete scope", http.MethodDelete, "/api/v3/device/name/sensor", "pipeline-key", http.StatusForbidden},
+		{"command with command scope", http.MethodPut, "/api/v3/device/name/sensor/switch", "pipeline-key", http.StatusOK},
+		{"command without command scope", http.MethodPut, "/api/v3/device/name/sensor/switch", "dashboard-key", http.StatusForbidden},
+		{"not yet expired", http.MethodGet, "/api/v3/device/name/sensor", "temporary-key", http.StatusOK},
+		{"expired", http.MethodGet, "/api/v3/device/name/sensor", "retired-key", http.StatusUnauthorized},
+		{"unknown", http.MethodGet, "/api/v3/device/name/sensor", "unknown-key", http.StatusUnauthorized},
+		{"left to the JWT", http.MethodGet, "/api/v3/device/name/sensor", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, http.NoBody)
+			if tt.apiKey != "" {
+				req.Header.Set(pkgCommon.ApiKeyHeader, tt.apiKey)
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestAuthenticationHandlerFuncDisabled(t *testing.T) {
+	called := false
+	jwtHook := func(inner http.HandlerFunc) http.HandlerFunc {
+		called = true
+		return inner
+	}
+	authenticationHook := AuthenticationHandlerFunc(nil, nil, jwtHook, logger.NewMockClient())
+	authenticationHook(func(w http.ResponseWriter, r *http.Request) {})
+	assert.True(t, called)
+}
+
+func TestKeysHandler(t *testing.T) {
+	validator := newTestValidator(t, testSecretData)
+	before := time.Now().UnixMilli()
+	_, _, err := validator.validate("pipeline-key")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	KeysHandler(validator, logger.NewMockClient())(recorder, httptest.NewRequest(http.MethodGet, pkgCommon.ApiApiKeyRoute, http.NoBody))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "pipeline-key")
+
+	var response MultiKeysResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, uint32(4), response.TotalCount)
+	require.Len(t, response.Keys, 4)
+	assert.Equal(t, "dashboard", response.Keys[0].Name)
+	assert.Zero(t, response.Keys[0].LastUsed)
+	assert.Equal(t, "pipeline", response.Keys[1].Name)
+	assert.Equal(t, []rbac.Permission{rbac.PermissionRead, rbac.PermissionWrite, rbac.PermissionCommand}, response.Keys[1].Scopes)
+	assert.GreaterOrEqual(t, response.Keys[1].LastUsed, before)
+	assert.Equal(t, "2020-01-01T00:00:00Z", response.Keys[2].Expires)
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name          string
+		hasSecret     bool
+		hasSecretErr  error
+		secretData    map[string]string
+		expectedKeys  int
+		expectedError bool
+	}{
+		{"valid", true, nil, testSecretData, 4, false},
+		{"no secret", false, nil, nil, 0, false},
+		{"secret store error", false, errors.New("unavailable"), nil, 0, true},
+		{"no scopes", true, nil, map[string]string{"key": "value"}, 0, true},
+		{"empty key", true, nil, map[string]string{"key": "", "key.scopes": "read"}, 0, true},
+		{"invalid expiration", true, nil, map[string]string{"key": "value", "key.scopes": "read", "key.expires": "tomorrow"}, 0, true},
+		{"orphan scopes", true, nil, map[string]string{"key": "value", "key.scopes": "read", "other.scopes": "read"}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secretProvider := &mocks.SecretProvider{}
+			secretProvider.On("HasSecret", defaultSecretName).Return(tt.hasSecret, tt.hasSecretErr)
+			secretProvider.On("GetSecret", defaultSecretName).Return(tt.secretData, nil)
+			validator := NewValidator(Info{Enabled: true}, logger.NewMockClient())
+			err := validator.Load(secretProvider)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, validator.Keys(), tt.expectedKeys)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/rbac"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// MultiKeysResponse is the response of the API key query
+type MultiKeysResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	Keys                                 []Key `json:"keys"`
+}
+
+// AuthenticationHandlerFunc wraps the authentication hook of the routes so that the requests carrying an API key in
+// the X-Api-Key header are authenticated with it instead, and only handled when one of the scopes of the API key is the
+// permission of the route in the table. The requests with an unknown or expired API key are rejected with 401 and the
+// ones out of the scopes of the API key with 403. The other requests are left to the authentication hook, which is
+// returned as is when the validator is nil.
+func AuthenticationHandlerFunc(validator *Validator, table rbac.PermissionTable, authenticationHook func(inner http.HandlerFunc) http.HandlerFunc, lc logger.LoggingClient) func(inner http.HandlerFunc) http.HandlerFunc {
+	if validator == nil {
+		return authenticationHook
+	}
+	return func(inner http.HandlerFunc) http.HandlerFunc {
+		authenticated := authenticationHook(inner)
+		return func(w http.ResponseWriter, r *http.Request) {
+			value := r.Header.Get(pkgCommon.ApiKeyHeader)
+			if value == "" {
+				authenticated(w, r)
+				return
+			}
+			name, scopes, err := validator.validate(value)
+			if err != nil {
+				lc.Warnf("Request to '%s' UNAUTHORIZED: %s", r.URL.Path, err.Error())
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+			permission := table.RequestPermission(r)
+			for _, scope := range scopes {
+				if scope == permission {
+					lc.Debugf("Request to '%s' authorized with the API key '%s'", r.URL.Path, name)
+					inner(w, r)
+					return
+				}
+			}
+			lc.Warnf("Request to '%s %s' FORBIDDEN: the scopes %v of the API key '%s' don't include the %s permission", r.Method, r.URL.Path, scopes, name, permission)
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		}
+	}
+}
+
+// KeysHandler returns the API keys of the service with their scopes and the time they were last used, but never their
+// values
+func KeysHandler(validator *Validator, lc logger.LoggingClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		keys := validator.Keys()
+		response := MultiKeysResponse{
+			BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, uint32(len(keys))),
+			Keys:                       keys,
+		}
+		utils.WriteHttpHeader(w, r.Context(), http.StatusOK)
+		pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+}
@@ -0,0 +1,231 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records the security relevant operations of the core services, i.e. the authentication and
+// authorization decisions, the secret accesses and the changes made through the REST API. The records are appended to
+// an append-only store, can be queried through the REST API and are optionally exported to syslog as JSON or CEF.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+)
+
+// Categories of the audit records
+const (
+	// CategoryAuthentication records the requests rejected because they aren't authenticated
+	CategoryAuthentication = "authentication"
+	// CategoryAuthorization records the requests rejected because none of their roles grants the route permission
+	CategoryAuthorization = "authorization"
+	// CategorySecret records the secrets stored in the secret store of the service
+	CategorySecret = "secret"
+	// CategoryChange records the requests adding, updating or deleting the resources and configurations
+	CategoryChange = "change"
+	// CategoryAccess records the other requests, only when RecordReads is enabled
+	CategoryAccess = "access"
+)
+
+// Outcomes of the audited operations
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// maxRecordSize is the size of the largest record read back from the store
+const maxRecordSize = 1024 * 1024
+
+// Info configures the audit log of a service
+type Info struct {
+	Enabled bool
+	// FilePath of the append-only store the records are appended to, as JSON lines
+	FilePath string
+	// RecordReads also records the successful requests which don't change anything, which are otherwise not recorded
+	RecordReads bool
+	// Syslog exports each record to a syslog server
+	Syslog SyslogInfo
+}
+
+// Record is an audit record of a security relevant operation
+type Record struct {
+	// Timestamp in milliseconds of the operation
+	Timestamp int64  `json:"timestamp"`
+	Service   string `json:"service"`
+	Category  string `json:"category"`
+	// Action is the method and route of the request, i.e. PUT /api/v3/device/name/{name}/{command}
+	Action string `json:"action"`
+	// Resource is the path of the request
+	Resource string `json:"resource,omitempty"`
+	// Subject is the subject of the JWT of the request, which is only verified for the authenticated requests
+	Subject       string `json:"subject,omitempty"`
+	SourceAddress string `json:"sourceAddress,omitempty"`
+	Outcome       string `json:"outcome"`
+	StatusCode    int    `json:"statusCode,omitempty"`
+	CorrelationId string `json:"correlationId,omitempty"`
+}
+
+// Filter selects the records returned by a query, the empty fields select all the records
+type Filter struct {
+	// Start and End are the range of the Timestamp in milliseconds, End 0 is the current time
+	Start    int64
+	End      int64
+	Category string
+	Subject  string
+	Outcome  string
+}
+
+func (f Filter) matches(record Record) bool {
+	return record.Timestamp >= f.Start &&
+		(f.End == 0 || record.Timestamp <= f.End) &&
+		(f.Category == "" || record.Category == f.Category) &&
+		(f.Subject == "" || record.Subject == f.Subject) &&
+		(f.Outcome == "" || record.Outcome == f.Outcome)
+}
+
+// Auditor appends the audit records of a service to the store and exports them
+type Auditor struct {
+	lc          logger.LoggingClient
+	info        Info
+	serviceName string
+	exporter    *syslogExporter
+	mutex       sync.Mutex
+}
+
+// AuditorName contains the name of the Auditor in the DIC.
+var AuditorName = di.TypeInstanceToName(Auditor{})
+
+// AuditorFrom helper function queries the DIC and returns the Auditor, nil when the audit log isn't enabled.
+func AuditorFrom(get di.Get) *Auditor {
+	auditor, ok := get(AuditorName).(*Auditor)
+	if !ok {
+		return nil
+	}
+	return auditor
+}
+
+// NewAuditor creates the Auditor of the service, nil is returned when the audit log isn't enabled
+func NewAuditor(info Info, serviceName string, lc logger.LoggingClient) (*Auditor, error) {
+	if !info.Enabled {
+		return nil, nil
+	}
+	if info.FilePath == "" {
+		return nil, errors.New("the audit log FilePath is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(info.FilePath), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create the directory of the audit log: %w", err)
+	}
+	auditor := &Auditor{
+		lc:          lc,
+		info:        info,
+		serviceName: serviceName,
+	}
+	if info.Syslog.Enabled {
+		exporter, err := newSyslogExporter(info.Syslog, serviceName)
+		if err != nil {
+			return nil, err
+		}
+		auditor.exporter = exporter
+	}
+	return auditor, nil
+}
+
+// Record appends the record to the store and exports it, the failures are logged without interrupting the operation
+func (a *Auditor) Record(record Record) {
+	record.Service = a.serviceName
+	line, err := json.Marshal(record)
+	if err != nil {
+		a.lc.Errorf("failed to encode the audit record: %s", err.Error())
+		return
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if err = appendLine(a.info.FilePath, line); err != nil {
+		a.lc.Errorf("failed to write the audit record to %s: %s", a.info.FilePath, err.Error())
+	}
+	if a.exporter != nil {
+		if err = a.exporter.export(record, line); err != nil {
+			a.lc.Errorf("failed to export the audit record to syslog: %s", err.Error())
+		}
+	}
+}
+
+// Query returns the records selected by the filter from the newest, along with the total count of the selected
+// records. A negative limit returns all the records from the offset.
+func (a *Auditor) Query(filter Filter, offset int, limit int) ([]Record, uint32, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	file, err := os.Open(a.info.FilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Record{}, 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = file.Close() }()
+
+	matching, err := readRecords(file, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read the audit records from %s: %w", a.info.FilePath, err)
+	}
+
+	total := uint32(len(matching))
+	records := make([]Record, 0)
+	for i := len(matching) - 1 - offset; i >= 0 && (limit < 0 || len(records) < limit); i-- {
+		records = append(records, matching[i])
+	}
+	return records, total, nil
+}
+
+// readRecords returns the records of the store selected by the filter, the records which can't be decoded are skipped
+func readRecords(reader io.Reader, filter Filter) ([]Record, error) {
+	var records []Record
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		if filter.matches(record) {
+			records = append(records, record)
+		}
+	}
+	return records, scanner.Err()
+}
+
+func appendLine(path string, line []byte) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(line, '\n'))
+	return errors.Join(err, file.Close())
+}
+
+// BootstrapAuditor creates the Auditor of the service and adds it to the DIC, so that the REST routes are audited
+func BootstrapAuditor(info Info, serviceName string, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	auditor, err := NewAuditor(info, serviceName, lc)
+	if err != nil {
+		lc.Errorf("failed to create the audit log: %s", err.Error())
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		AuditorName: func(get di.Get) interface{} {
+			return auditor
+		},
+	})
+	return true
+}
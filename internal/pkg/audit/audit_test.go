@@ -0,0 +1,228 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	testService     = "core-metadata"
+	testDeviceRoute = "/api/v3/device/name/{name}"
+)
+
+func testToken(t *testing.T, subject string) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef0123456789abcdef")}, nil)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{Subject: subject}).CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func newTestAuditor(t *testing.T, info Info) *Auditor {
+	info.Enabled = true
+	info.FilePath = filepath.Join(t.TempDir(), "audit", testService+".log")
+	auditor, err := NewAuditor(info, testService, logger.NewMockClient())
+	require.NoError(t, err)
+	return auditor
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name             string
+		recordReads      bool
+		method           string
+		path             string
+		statusCode       int
+		token            string
+		expectedCategory string
+		expectedOutcome  string
+		expectedSubject  string
+	}{
+		{"change", false, http.MethodDelete, "/api/v3/device/name/sensor", http.StatusOK, testToken(t, "operator"), CategoryChange, OutcomeSuccess, "operator"},
+		{"failed change", false, http.MethodPatch, "/api/v3/device/name/sensor", http.StatusNotFound, "", CategoryChange, OutcomeFailure, ""},
+		{"authentication failure", false, http.MethodGet, "/api/v3/device/name/sensor", http.StatusUnauthorized, "", CategoryAuthentication, OutcomeFailure, ""},
+		{"authorization denied", false, http.MethodDelete, "/api/v3/device/name/sensor", http.StatusForbidden, testToken(t, "reader"), CategoryAuthorization, OutcomeFailure, "reader"},
+		{"secret stored", false, http.MethodPost, common.ApiSecretRoute, http.StatusCreated, "", CategorySecret, OutcomeSuccess, ""},
+		{"read recorded", true, http.MethodGet, "/api/v3/device/name/sensor", http.StatusOK, "", CategoryAccess, OutcomeSuccess, ""},
+		{"read not recorded", false, http.MethodGet, "/api/v3/device/name/sensor", http.StatusOK, "", "", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditor := newTestAuditor(t, Info{RecordReads: tt.recordReads})
+			handler := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(tt.statusCode) }
+			router := mux.NewRouter()
+			router.HandleFunc(testDeviceRoute, handler)
+			router.HandleFunc(common.ApiSecretRoute, handler)
+			router.Use(Middleware(auditor))
+
+			req := httptest.NewRequest(tt.method, tt.path, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+			require.Equal(t, tt.statusCode, recorder.Code)
+
+			records, total, err := auditor.Query(Filter{}, 0, -1)
+			require.NoError(t, err)
+			if tt.expectedCategory == "" {
+				assert.Zero(t, total)
+				return
+			}
+			require.Len(t, records, 1)
+			record := records[0]
+			assert.Equal(t, testService, record.Service)
+			assert.Equal(t, tt.expectedCategory, record.Category)
+			assert.Equal(t, tt.expectedOutcome, record.Outcome)
+			assert.Equal(t, tt.expectedSubject, record.Subject)
+			assert.Equal(t, tt.statusCode, record.StatusCode)
+			assert.Equal(t, tt.path, record.Resource)
+			assert.True(t, strings.HasPrefix(record.Action, tt.method+" "))
+		})
+	}
+}
+
+func TestMiddlewareDisabled(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	assert.NotNil(t, Middleware(nil)(next))
+
+	auditor, err := NewAuditor(Info{Enabled: false}, testService, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Nil(t, auditor)
+}
+
+func TestQueryHandler(t *testing.T) {
+	auditor := newTestAuditor(t, Info{})
+	now := time.Now().UnixMilli()
+	for i, record := range []Record{
+		{Timestamp: now - 3000, Category: CategoryChange, Subject: "alice", Outcome: OutcomeSuccess},
+		{Timestamp: now - 2000, Category: CategoryAuthorization, Subject: "bob", Outcome: OutcomeFailure},
+		{Timestamp: now - 1000, Category: CategoryChange, Subject: "bob", Outcome: OutcomeSuccess},
+	} {
+		record.CorrelationId = string(rune('a' + i))
+		auditor.Record(record)
+	}
+
+	tests := []struct {
+		name                  string
+		query                 string
+		expectedStatusCode    int
+		expectedTotal         uint32
+		expectedCorrelationId []string
+	}{
+		{"all", "", http.StatusOK, 3, []string{"c", "b", "a"}},
+		{"by category", "?" + pkgCommon.Category + "=" + CategoryChange, http.StatusOK, 2, []string{"c", "a"}},
+		{"by subject and outcome", "?" + pkgCommon.Subject + "=bob&" + pkgCommon.Outcome + "=" + OutcomeFailure, http.StatusOK, 1, []string{"b"}},
+		{"by time range", "?start=" + strconv.FormatInt(now-2500, 10) + "&end=" + strconv.FormatInt(now-500, 10), http.StatusOK, 2, []string{"c", "b"}},
+		{"offset and limit", "?offset=1&limit=1", http.StatusOK, 3, []string{"b"}},
+		{"invalid start", "?start=yesterday", http.StatusBadRequest, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, pkgCommon.ApiAuditRoute+tt.query, http.NoBody)
+			recorder := httptest.NewRecorder()
+			QueryHandler(auditor, logger.NewMockClient(), 1000)(recorder, req)
+
+			require.Equal(t, tt.expectedStatusCode, recorder.Code)
+			if tt.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var response MultiRecordsResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedTotal, response.TotalCount)
+			correlationIds := make([]string, 0, len(response.Records))
+			for _, record := range response.Records {
+				correlationIds = append(correlationIds, record.CorrelationId)
+			}
+			assert.Equal(t, tt.expectedCorrelationId, correlationIds)
+		})
+	}
+}
+
+func TestSyslogExport(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	tests := []struct {
+		name            string
+		format          string
+		expectedContent string
+	}{
+		{"json", FormatJSON, `{"timestamp":`},
+		{"cef", FormatCEF, "CEF:0|EdgeX Foundry|core-metadata|"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditor := newTestAuditor(t, Info{Syslog: SyslogInfo{Enabled: true, Address: conn.LocalAddr().String(), Format: tt.format}})
+			auditor.Record(Record{Timestamp: time.Now().UnixMilli(), Category: CategoryAuthorization, Action: "DELETE " + testDeviceRoute, Outcome: OutcomeFailure})
+
+			buffer := make([]byte, 4096)
+			require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+			n, _, err := conn.ReadFrom(buffer)
+			require.NoError(t, err)
+			message := string(buffer[:n])
+			// log audit facility with the warning severity of the failures
+			assert.True(t, strings.HasPrefix(message, "<108>1 "), message)
+			assert.Contains(t, message, " core-metadata - authorization - "+tt.expectedContent)
+		})
+	}
+}
+
+func TestFormatCEF(t *testing.T) {
+	record := Record{
+		Timestamp:     1700000000000,
+		Service:       testService,
+		Category:      CategoryChange,
+		Action:        "PUT /api/v3/device|name",
+		Resource:      "/api/v3/device?name=a=b",
+		Subject:       `domain\user`,
+		Outcome:       OutcomeSuccess,
+		StatusCode:    http.StatusOK,
+		CorrelationId: "14a42ea6-c394-41c3-8bcd-a29b9f5e6835",
+	}
+	assert.Equal(t, `CEF:0|EdgeX Foundry|core-metadata|to be replaced by makefile|change|PUT /api/v3/device\|name|3|`+
+		`rt=1700000000000 outcome=success request=/api/v3/device?name\=a\=b suser=domain\\user `+
+		`cs1Label=statusCode cs1=200 cs2Label=correlationId cs2=14a42ea6-c394-41c3-8bcd-a29b9f5e6835`, formatCEF(record))
+}
+
+func TestNewAuditorInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		info Info
+	}{
+		{"no file path", Info{Enabled: true}},
+		{"unsupported syslog network", Info{Enabled: true, FilePath: "audit.log", Syslog: SyslogInfo{Enabled: true, Network: "unix", Address: "/dev/log"}}},
+		{"unsupported syslog format", Info{Enabled: true, FilePath: "audit.log", Syslog: SyslogInfo{Enabled: true, Address: "localhost:514", Format: "leef"}}},
+		{"no syslog address", Info{Enabled: true, FilePath: "audit.log", Syslog: SyslogInfo{Enabled: true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAuditor(tt.info, testService, logger.NewMockClient())
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// MultiRecordsResponse is the response of the audit record query
+type MultiRecordsResponse struct {
+	commonDTO.BaseWithTotalCountResponse `json:",inline"`
+	Records                              []Record `json:"records"`
+}
+
+// statusRecorder records the status code of the response
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (s *statusRecorder) WriteHeader(statusCode int) {
+	if s.statusCode == 0 {
+		s.statusCode = statusCode
+	}
+	s.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.statusCode == 0 {
+		s.statusCode = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+// Flush supports the streaming responses
+func (s *statusRecorder) Flush() {
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack supports the WebSocket connections
+func (s *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := s.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T doesn't support hijacking", s.ResponseWriter)
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err == nil && s.statusCode == 0 {
+		s.statusCode = http.StatusSwitchingProtocols
+	}
+	return conn, rw, err
+}
+
+// Unwrap returns the original ResponseWriter for http.ResponseController
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// Middleware records the requests of the routes which are security relevant: the authentication and authorization
+// failures, the secrets stored and the requests changing the resources. The requests are passed through when the
+// auditor is nil.
+func Middleware(auditor *Auditor) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if auditor == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			recorder := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(recorder, r)
+
+			route := r.URL.Path
+			if current := mux.CurrentRoute(r); current != nil {
+				if template, err := current.GetPathTemplate(); err == nil {
+					route = template
+				}
+			}
+			statusCode := recorder.statusCode
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+			category, ok := categorize(r.Method, route, statusCode, auditor.info.RecordReads)
+			if !ok {
+				return
+			}
+			record := Record{
+				Timestamp:     utils.MakeTimestamp(),
+				Category:      category,
+				Action:        r.Method + " " + route,
+				Resource:      r.URL.Path,
+				Subject:       requestSubject(r),
+				SourceAddress: sourceAddress(r),
+				Outcome:       OutcomeSuccess,
+				StatusCode:    statusCode,
+				CorrelationId: correlation.FromContext(r.Context()),
+			}
+			if statusCode >= http.StatusBadRequest {
+				record.Outcome = OutcomeFailure
+			}
+			auditor.Record(record)
+		})
+	}
+}
+
+// categorize returns the category of the request, false is returned when the request isn't recorded
+func categorize(method string, route string, statusCode int, recordReads bool) (string, bool) {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return CategoryAuthentication, true
+	case statusCode == http.StatusForbidden:
+		return CategoryAuthorization, true
+	case route == common.ApiSecretRoute:
+		return CategorySecret, true
+	case method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch || method == http.MethodDelete:
+		return CategoryChange, true
+	case recordReads:
+		return CategoryAccess, true
+	default:
+		return "", false
+	}
+}
+
+// requestSubject returns the subject of the request JWT, its signature is verified by the authentication hook
+func requestSubject(r *http.Request) string {
+	authParts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(authParts) < 2 || !strings.EqualFold(authParts[0], "Bearer") {
+		return ""
+	}
+	token, err := jwt.ParseSigned(authParts[1])
+	if err != nil {
+		return ""
+	}
+	var claims jwt.Claims
+	if err = token.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return ""
+	}
+	return claims.Subject
+}
+
+func sourceAddress(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// QueryHandler returns the records of the service selected by the start, end, category, subject and outcome query
+// parameters, from the newest
+func QueryHandler(auditor *Auditor, lc logger.LoggingClient, maxResultCount int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		start, err := utils.ParseQueryStringToInt(r, common.Start, 0, 0, math.MaxInt)
+		if err != nil {
+			utils.WriteErrorResponse(w, ctx, lc, err, "")
+			return
+		}
+		end, err := utils.ParseQueryStringToInt(r, common.End, 0, 0, math.MaxInt)
+		if err != nil {
+			utils.WriteErrorResponse(w, ctx, lc, err, "")
+			return
+		}
+		offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, maxResultCount)
+		if err != nil {
+			utils.WriteErrorResponse(w, ctx, lc, err, "")
+			return
+		}
+		filter := Filter{
+			Start:    int64(start),
+			End:      int64(end),
+			Category: utils.ParseQueryStringToString(r, pkgCommon.Category, ""),
+			Subject:  utils.ParseQueryStringToString(r, pkgCommon.Subject, ""),
+			Outcome:  utils.ParseQueryStringToString(r, pkgCommon.Outcome, ""),
+		}
+
+		records, total, queryErr := auditor.Query(filter, offset, limit)
+		if queryErr != nil {
+			utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindServerError, "failed to query the audit records", queryErr), "")
+			return
+		}
+		response := MultiRecordsResponse{
+			BaseWithTotalCountResponse: commonDTO.NewBaseWithTotalCountResponse("", "", http.StatusOK, total),
+			Records:                    records,
+		}
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+}
@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go"
+)
+
+// Formats of the records exported to syslog
+const (
+	FormatJSON = "json"
+	FormatCEF  = "cef"
+)
+
+const (
+	// syslogFacilityAudit is the log audit facility of RFC 5424
+	syslogFacilityAudit = 13
+	syslogSeverityWarn  = 4
+	syslogSeverityInfo  = 6
+	syslogDialTimeout   = 5 * time.Second
+	cefVendor           = "EdgeX Foundry"
+)
+
+// SyslogInfo configures the export of the audit records to a syslog server
+type SyslogInfo struct {
+	Enabled bool
+	// Network is udp or tcp, defaults to udp
+	Network string
+	// Address of the syslog server, i.e. localhost:514
+	Address string
+	// Format of the exported records, json or cef, defaults to json
+	Format string
+}
+
+// syslogExporter sends the records to the syslog server as RFC 5424 messages, reconnecting after a failure
+type syslogExporter struct {
+	info     SyslogInfo
+	appName  string
+	hostname string
+	conn     net.Conn
+}
+
+func newSyslogExporter(info SyslogInfo, serviceName string) (*syslogExporter, error) {
+	switch info.Network {
+	case "":
+		info.Network = "udp"
+	case "udp", "tcp":
+	default:
+		return nil, fmt.Errorf("unsupported audit Syslog Network '%s'", info.Network)
+	}
+	switch info.Format {
+	case "":
+		info.Format = FormatJSON
+	case FormatJSON, FormatCEF:
+	default:
+		return nil, fmt.Errorf("unsupported audit Syslog Format '%s'", info.Format)
+	}
+	if info.Address == "" {
+		return nil, fmt.Errorf("the audit Syslog Address is required")
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+	return &syslogExporter{info: info, appName: serviceName, hostname: hostname}, nil
+}
+
+// export sends the record, whose JSON encoding is provided, to the syslog server
+func (e *syslogExporter) export(record Record, jsonRecord []byte) error {
+	content := string(jsonRecord)
+	if e.info.Format == FormatCEF {
+		content = formatCEF(record)
+	}
+	severity := syslogSeverityInfo
+	if record.Outcome == OutcomeFailure {
+		severity = syslogSeverityWarn
+	}
+	message := fmt.Sprintf("<%d>1 %s %s %s - %s - %s", syslogFacilityAudit*8+severity,
+		time.UnixMilli(record.Timestamp).UTC().Format(time.RFC3339Nano), e.hostname, e.appName, record.Category, content)
+	if e.info.Network == "tcp" {
+		// octet counting framing of RFC 6587
+		message = fmt.Sprintf("%d %s", len(message), message)
+	}
+
+	err := e.write(message)
+	if err != nil {
+		// the connection may have been closed by the server, retry once with a new connection
+		err = e.write(message)
+	}
+	return err
+}
+
+func (e *syslogExporter) write(message string) error {
+	if e.conn == nil {
+		conn, err := net.DialTimeout(e.info.Network, e.info.Address, syslogDialTimeout)
+		if err != nil {
+			return err
+		}
+		e.conn = conn
+	}
+	if _, err := e.conn.Write([]byte(message)); err != nil {
+		_ = e.conn.Close()
+		e.conn = nil
+		return err
+	}
+	return nil
+}
+
+// formatCEF formats the record in the ArcSight Common Event Format
+func formatCEF(record Record) string {
+	severity := 3
+	if record.Outcome == OutcomeFailure {
+		severity = 7
+	}
+	extensions := []string{
+		"rt=" + cefExtension(fmt.Sprint(record.Timestamp)),
+		"outcome=" + cefExtension(record.Outcome),
+		"request=" + cefExtension(record.Resource),
+	}
+	if record.Subject != "" {
+		extensions = append(extensions, "suser="+cefExtension(record.Subject))
+	}
+	if record.SourceAddress != "" {
+		extensions = append(extensions, "src="+cefExtension(record.SourceAddress))
+	}
+	if record.StatusCode != 0 {
+		extensions = append(extensions, "cs1Label=statusCode", "cs1="+cefExtension(fmt.Sprint(record.StatusCode)))
+	}
+	if record.CorrelationId != "" {
+		extensions = append(extensions, "cs2Label=correlationId", "cs2="+cefExtension(record.CorrelationId))
+	}
+	return fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|%s", cefHeader(cefVendor), cefHeader(record.Service),
+		cefHeader(edgex.Version), cefHeader(record.Category), cefHeader(record.Action), severity,
+		strings.Join(extensions, " "))
+}
+
+var (
+	cefHeaderReplacer    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionReplacer = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+func cefHeader(value string) string {
+	return cefHeaderReplacer.Replace(value)
+}
+
+func cefExtension(value string) string {
+	return cefExtensionReplacer.Replace(value)
+}
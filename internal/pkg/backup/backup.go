@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// FormatVersion is the version of the format of the backups, only the backups of this version can be restored
+const FormatVersion = 1
+
+// Backup is the envelope of the data backed up by a service. Its checksum is the hex encoded SHA-256 of the compacted
+// JSON content, so that a backup which is truncated or altered is never restored.
+type Backup struct {
+	common.Versionable `json:",inline"`
+	FormatVersion      int             `json:"formatVersion"`
+	ServiceKey         string          `json:"serviceKey"`
+	Created            int64           `json:"created"`
+	Checksum           string          `json:"checksum"`
+	Content            json.RawMessage `json:"content"`
+}
+
+// RestoreResponse defines the Response Content for POST backup, with the number of each kind of entity restored
+type RestoreResponse struct {
+	common.BaseResponse `json:",inline"`
+	Restored            map[string]int `json:"restored"`
+}
+
+func NewRestoreResponse(requestId string, message string, statusCode int, restored map[string]int) RestoreResponse {
+	return RestoreResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Restored:     restored,
+	}
+}
+
+// New returns the backup of the content by the service
+func New(serviceKey string, content any) (Backup, errors.EdgeX) {
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		return Backup{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the backup content", err)
+	}
+	return Backup{
+		Versionable:   common.NewVersionable(),
+		FormatVersion: FormatVersion,
+		ServiceKey:    serviceKey,
+		Created:       pkgCommon.MakeTimestamp(),
+		Checksum:      checksum(encoded),
+		Content:       encoded,
+	}, nil
+}
+
+// Open verifies the format version, the service and the checksum of the backup and decodes its content
+func (b Backup) Open(serviceKey string, content any) errors.EdgeX {
+	if b.FormatVersion != FormatVersion {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("backup format version %d is not supported, the supported version is %d", b.FormatVersion, FormatVersion), nil)
+	}
+	if b.ServiceKey != serviceKey {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the backup of %s can't be restored into %s", b.ServiceKey, serviceKey), nil)
+	}
+
+	// the backup may have been reformatted since it was created, the checksum is computed over the compacted content
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, b.Content); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid backup content", err)
+	}
+	if checksum(compacted.Bytes()) != b.Checksum {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the backup checksum doesn't match its content", nil)
+	}
+	if err := json.Unmarshal(compacted.Bytes(), content); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the backup content", err)
+	}
+	return nil
+}
+
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
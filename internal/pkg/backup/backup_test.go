@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testContent struct {
+	Names []string `json:"names"`
+}
+
+func TestOpen(t *testing.T) {
+	content := testContent{Names: []string{"device1", "device2"}}
+	valid, err := New("core-metadata", content)
+	require.NoError(t, err)
+
+	unsupportedVersion := valid
+	unsupportedVersion.FormatVersion = FormatVersion + 1
+	altered := valid
+	altered.Content = json.RawMessage(`{"names":["device1"]}`)
+	noChecksum := valid
+	noChecksum.Checksum = ""
+
+	tests := []struct {
+		name          string
+		backup        Backup
+		serviceKey    string
+		expectedError bool
+	}{
+		{"valid", valid, "core-metadata", false},
+		{"invalid, unsupported version", unsupportedVersion, "core-metadata", true},
+		{"invalid, other service", valid, "core-data", true},
+		{"invalid, altered content", altered, "core-metadata", true},
+		{"invalid, no checksum", noChecksum, "core-metadata", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the backup is downloaded, reformatted then uploaded, which keeps it valid
+			encoded, err := json.MarshalIndent(tt.backup, "", "  ")
+			require.NoError(t, err)
+			var backup Backup
+			require.NoError(t, json.Unmarshal(encoded, &backup))
+
+			var opened testContent
+			edgexErr := backup.Open(tt.serviceKey, &opened)
+			if tt.expectedError {
+				require.Error(t, edgexErr)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(edgexErr))
+				return
+			}
+			require.NoError(t, edgexErr)
+			assert.Equal(t, content, opened)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/flags"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const (
+	MessageBusSection   = "MessageBus"
+	ExternalMQTTSection = "ExternalMQTT"
+)
+
+// ListenForConfigChanges watches the section of the service's configuration in the Configuration Provider and calls
+// onChange with the updated section, so that settings outside of Writable can be applied without restarting the
+// service. The settings missing from the section in the Configuration Provider keep their value in current, which
+// allows watching the private overrides of a common section. Nothing is watched when the Configuration Provider isn't
+// used.
+func ListenForConfigChanges[T any](ctx context.Context, wg *sync.WaitGroup, f flags.Common, dic *di.Container,
+	sectionName string, current T, onChange func(updated T)) {
+	lc := container.LoggingClientFrom(dic.Get)
+	processor := config.NewProcessorForCustomConfig(f, ctx, wg, dic)
+	processor.ListenForCustomConfigChanges(&current, sectionName, func(raw any) {
+		updated, ok := raw.(*T)
+		if !ok {
+			lc.Errorf("Unexpected type %T of the updated '%s' configuration, the changes are ignored", raw, sectionName)
+			return
+		}
+		onChange(*updated)
+	})
+}
+
+// MessageBusSubscriptions are subscriptions of the service to topics under the BaseTopicPrefix of the MessageBus,
+// which are renewed when the BaseTopicPrefix changes.
+type MessageBusSubscriptions struct {
+	subscribe func(ctx context.Context) errors.EdgeX
+	topics    func(baseTopicPrefix string) []string
+
+	mutex           sync.Mutex
+	ctx             context.Context
+	cancel          context.CancelFunc
+	baseTopicPrefix string
+}
+
+// NewMessageBusSubscriptions returns the subscriptions made by subscribe, which subscribes to the topics under the
+// BaseTopicPrefix of the service's configuration until its context is done. topics returns the topics subscribed to
+// for a given BaseTopicPrefix, so that they can be unsubscribed.
+func NewMessageBusSubscriptions(subscribe func(ctx context.Context) errors.EdgeX, topics func(baseTopicPrefix string) []string) *MessageBusSubscriptions {
+	return &MessageBusSubscriptions{
+		subscribe: subscribe,
+		topics:    topics,
+	}
+}
+
+// Subscribe subscribes to the topics, the subscriptions lasting until the context is done
+func (s *MessageBusSubscriptions) Subscribe(ctx context.Context, dic *di.Container) errors.EdgeX {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.ctx = ctx
+	return s.start(dic)
+}
+
+func (s *MessageBusSubscriptions) start(dic *di.Container) errors.EdgeX {
+	ctx, cancel := context.WithCancel(s.ctx)
+	if err := s.subscribe(ctx); err != nil {
+		cancel()
+		return err
+	}
+	s.cancel = cancel
+	s.baseTopicPrefix = container.ConfigurationFrom(dic.Get).GetBootstrap().MessageBus.GetBaseTopicPrefix()
+	return nil
+}
+
+// Renew unsubscribes from the topics under the previous BaseTopicPrefix and subscribes to those under the current one,
+// nothing is done when the BaseTopicPrefix is unchanged.
+func (s *MessageBusSubscriptions) Renew(dic *di.Container) errors.EdgeX {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.cancel == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "the subscriptions must be made before being renewed", nil)
+	}
+	if container.ConfigurationFrom(dic.Get).GetBootstrap().MessageBus.GetBaseTopicPrefix() == s.baseTopicPrefix {
+		return nil
+	}
+
+	s.cancel()
+	s.cancel = nil
+	if err := container.MessagingClientFrom(dic.Get).Unsubscribe(s.topics(s.baseTopicPrefix)...); err != nil {
+		container.LoggingClientFrom(dic.Get).Warnf("Failed to unsubscribe from the topics under the previous base topic prefix '%s': %v", s.baseTopicPrefix, err)
+	}
+	return s.start(dic)
+}
+
+// ListenForMessageBusChanges watches the MessageBus configuration and renews the subscriptions when the
+// BaseTopicPrefix changes. The connection to the MessageBus is kept, so the changes of its connection settings only
+// take effect once the service is restarted.
+func ListenForMessageBusChanges(ctx context.Context, wg *sync.WaitGroup, f flags.Common, dic *di.Container, subscriptions ...*MessageBusSubscriptions) {
+	lc := container.LoggingClientFrom(dic.Get)
+	messageBusInfo := container.ConfigurationFrom(dic.Get).GetBootstrap().MessageBus
+
+	ListenForConfigChanges(ctx, wg, f, dic, MessageBusSection, *messageBusInfo, func(updated bootstrapConfig.MessageBusInfo) {
+		if messageBusConnectionChanged(*messageBusInfo, updated) {
+			lc.Warn("The MessageBus connection settings changed, the service must be restarted to apply them")
+		}
+		if updated.GetBaseTopicPrefix() == messageBusInfo.GetBaseTopicPrefix() {
+			return
+		}
+
+		lc.Infof("The MessageBus BaseTopicPrefix changed from '%s' to '%s', renewing the subscriptions", messageBusInfo.GetBaseTopicPrefix(), updated.GetBaseTopicPrefix())
+		messageBusInfo.BaseTopicPrefix = updated.BaseTopicPrefix
+		for _, s := range subscriptions {
+			if err := s.Renew(dic); err != nil {
+				lc.Errorf("Failed to renew the MessageBus subscriptions: %v", err)
+			}
+		}
+	})
+}
+
+func messageBusConnectionChanged(current bootstrapConfig.MessageBusInfo, updated bootstrapConfig.MessageBusInfo) bool {
+	return current.Disabled != updated.Disabled ||
+		current.Type != updated.Type ||
+		current.Protocol != updated.Protocol ||
+		current.Host != updated.Host ||
+		current.Port != updated.Port ||
+		current.AuthMode != updated.AuthMode ||
+		current.SecretName != updated.SecretName
+}
@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	configMocks "github.com/edgexfoundry/go-mod-configuration/v3/configuration/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newConfigWatcherTestDIC(messageBusInfo *bootstrapConfig.MessageBusInfo) *di.Container {
+	configuration := &mocks.Configuration{}
+	configuration.On("GetBootstrap").Return(bootstrapConfig.BootstrapConfiguration{MessageBus: messageBusInfo})
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.ConfigurationInterfaceName: func(get di.Get) interface{} {
+			return configuration
+		},
+	})
+}
+
+func TestListenForConfigChanges(t *testing.T) {
+	messageBusInfo := &bootstrapConfig.MessageBusInfo{Host: "localhost", BaseTopicPrefix: "edgex"}
+	dic := newConfigWatcherTestDIC(messageBusInfo)
+
+	updates := make(chan chan<- interface{}, 1)
+	configClient := &configMocks.Client{}
+	configClient.On("WatchForChanges", mock.Anything, mock.Anything, mock.Anything, MessageBusSection).
+		Run(func(args mock.Arguments) {
+			// the current section is the initial value of the updates
+			target, ok := args.Get(2).(*bootstrapConfig.MessageBusInfo)
+			require.True(t, ok)
+			assert.Equal(t, *messageBusInfo, *target)
+			updates <- args.Get(0).(chan<- interface{})
+		})
+	configClient.On("StopWatching").Return()
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigClientInterfaceName: func(get di.Get) interface{} {
+			return configClient
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	changes := make(chan bootstrapConfig.MessageBusInfo, 1)
+	ListenForConfigChanges(ctx, wg, nil, dic, MessageBusSection, *messageBusInfo, func(updated bootstrapConfig.MessageBusInfo) {
+		changes <- updated
+	})
+
+	updateStream := <-updates
+	// the first update is sent as soon as the watcher is connected and is ignored
+	updateStream <- &bootstrapConfig.MessageBusInfo{Host: "localhost", BaseTopicPrefix: "edgex"}
+	updateStream <- &bootstrapConfig.MessageBusInfo{Host: "localhost", BaseTopicPrefix: "updated"}
+	select {
+	case updated := <-changes:
+		assert.Equal(t, "updated", updated.BaseTopicPrefix)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the change wasn't received")
+	}
+	assert.Empty(t, changes)
+
+	cancel()
+	wg.Wait()
+}
+
+func TestMessageBusSubscriptionsRenew(t *testing.T) {
+	messageBusInfo := &bootstrapConfig.MessageBusInfo{BaseTopicPrefix: "edgex"}
+	dic := newConfigWatcherTestDIC(messageBusInfo)
+	messageBus := &messagingMocks.MessageClient{}
+	messageBus.On("Unsubscribe", "edgex/test").Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.MessagingClientName: func(get di.Get) interface{} {
+			return messageBus
+		},
+	})
+
+	var contexts []context.Context
+	var prefixes []string
+	subscriptions := NewMessageBusSubscriptions(
+		func(ctx context.Context) errors.EdgeX {
+			contexts = append(contexts, ctx)
+			prefixes = append(prefixes, messageBusInfo.GetBaseTopicPrefix())
+			return nil
+		},
+		func(baseTopicPrefix string) []string {
+			return []string{common.BuildTopic(baseTopicPrefix, "test")}
+		})
+
+	require.Error(t, subscriptions.Renew(dic), "the subscriptions must be made first")
+	require.NoError(t, subscriptions.Subscribe(context.Background(), dic))
+
+	// unchanged BaseTopicPrefix
+	require.NoError(t, subscriptions.Renew(dic))
+	require.Len(t, contexts, 1)
+	messageBus.AssertNotCalled(t, "Unsubscribe", mock.Anything)
+
+	messageBusInfo.BaseTopicPrefix = "updated"
+	require.NoError(t, subscriptions.Renew(dic))
+	require.Len(t, contexts, 2)
+	assert.Error(t, contexts[0].Err(), "the previous subscriptions should be done")
+	assert.NoError(t, contexts[1].Err())
+	assert.Equal(t, []string{"edgex", "updated"}, prefixes)
+	messageBus.AssertCalled(t, "Unsubscribe", "edgex/test")
+}
+
+func TestMessageBusConnectionChanged(t *testing.T) {
+	current := bootstrapConfig.MessageBusInfo{Type: "mqtt", Protocol: "tcp", Host: "localhost", Port: 1883, BaseTopicPrefix: "edgex"}
+
+	tests := []struct {
+		name     string
+		update   func(info *bootstrapConfig.MessageBusInfo)
+		expected bool
+	}{
+		{"unchanged", func(info *bootstrapConfig.MessageBusInfo) {}, false},
+		{"base topic prefix", func(info *bootstrapConfig.MessageBusInfo) { info.BaseTopicPrefix = "updated" }, false},
+		{"host", func(info *bootstrapConfig.MessageBusInfo) { info.Host = "edgex-mqtt-broker" }, true},
+		{"port", func(info *bootstrapConfig.MessageBusInfo) { info.Port = 8883 }, true},
+		{"auth mode", func(info *bootstrapConfig.MessageBusInfo) { info.AuthMode = "usernamepassword" }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := current
+			tt.update(&updated)
+			assert.Equal(t, tt.expected, messageBusConnectionChanged(current, updated))
+		})
+	}
+}
@@ -7,6 +7,7 @@ package handlers
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -21,6 +22,8 @@ import (
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	gometrics "github.com/rcrowley/go-metrics"
 )
 
 // httpServer defines the contract used to determine whether or not the http httpServer is running.
@@ -33,6 +36,8 @@ type Database struct {
 	httpServer            httpServer
 	database              bootstrapInterfaces.Database
 	dBClientInterfaceName string
+	// queryDBClientInterfaceName is the name of the client of the read-only replica in the DIC
+	queryDBClientInterfaceName string
 }
 
 // NewDatabase is a factory method that returns an initialized Database receiver struct.
@@ -44,24 +49,99 @@ func NewDatabase(httpServer httpServer, database bootstrapInterfaces.Database, d
 	}
 }
 
+// WithReadReplica returns the Database which also connects to the read-only replica of the database when it's
+// configured, its client being added to the DIC as queryDBClientInterfaceName.
+func (d Database) WithReadReplica(queryDBClientInterfaceName string) Database {
+	d.queryDBClientInterfaceName = queryDBClientInterfaceName
+	return d
+}
+
+const (
+	databaseConnectionsInUseMetricName = "DatabaseConnectionsInUse"
+	databaseConnectionsIdleMetricName  = "DatabaseConnectionsIdle"
+	databaseWaitCountMetricName        = "DatabaseWaitCount"
+	databaseWaitDurationMetricName     = "DatabaseWaitDuration"
+)
+
+// databaseClientFactory creates the client of a database backend, which must implement the DBClient interfaces of the
+// services using the backend
+type databaseClientFactory func(config db.Configuration, lc logger.LoggingClient) (interfaces.DBClient, error)
+
+// poolStatsProvider is implemented by the database clients whose connection pool statistics are published as metrics
+type poolStatsProvider interface {
+	PoolStats() db.PoolStats
+}
+
+// databaseClientFactories maps the Database Types to the factories of their backend
+var databaseClientFactories = map[string]databaseClientFactory{
+	"redisdb": newRedisClient,
+}
+
+// supportedDatabaseTypes returns the sorted Database Types whose backend is registered
+func supportedDatabaseTypes() []string {
+	types := make([]string, 0, len(databaseClientFactories))
+	for databaseType := range databaseClientFactories {
+		types = append(types, databaseType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func newRedisClient(config db.Configuration, lc logger.LoggingClient) (interfaces.DBClient, error) {
+	if config.ReadOnly {
+		return redis.NewReplicaClient(config, lc)
+	}
+	return redis.NewClient(config, lc)
+}
+
 // Return the dbClient interface
 func (d Database) newDBClient(
 	lc logger.LoggingClient,
 	credentials bootstrapConfig.Credentials) (interfaces.DBClient, error) {
+	return newClient(d.configuration(credentials), lc)
+}
+
+// newReplicaDBClient returns the client of the read-only replica of the database, or nil when no replica is configured
+func (d Database) newReplicaDBClient(
+	lc logger.LoggingClient,
+	credentials bootstrapConfig.Credentials) (interfaces.DBClient, error) {
+	database, ok := d.database.(bootstrapInterfaces.DatabaseReplica)
+	if !ok || len(d.queryDBClientInterfaceName) == 0 {
+		return nil, nil
+	}
+	replicaInfo := database.GetDatabaseReplicaInfo()
+	if len(replicaInfo.Host) == 0 {
+		return nil, nil
+	}
+
+	config := d.configuration(credentials)
+	config.Host = replicaInfo.Host
+	config.Port = replicaInfo.Port
+	config.ReadOnly = true
+	return newClient(config, lc)
+}
+
+// configuration returns the configuration of the client of the database
+func (d Database) configuration(credentials bootstrapConfig.Credentials) db.Configuration {
 	databaseInfo := d.database.GetDatabaseInfo()
-	switch databaseInfo.Type {
-	case "redisdb":
-		return redis.NewClient(
-			db.Configuration{
-				Host:     databaseInfo.Host,
-				Port:     databaseInfo.Port,
-				Password: credentials.Password,
-				Timeout:  databaseInfo.Timeout,
-			},
-			lc)
-	default:
+	return db.Configuration{
+		DbType:       databaseInfo.Type,
+		Host:         databaseInfo.Host,
+		Port:         databaseInfo.Port,
+		Timeout:      databaseInfo.Timeout,
+		DatabaseName: databaseInfo.Name,
+		Username:     credentials.Username,
+		Password:     credentials.Password,
+		Pool:         d.database.GetDatabasePoolInfo(),
+	}
+}
+
+func newClient(config db.Configuration, lc logger.LoggingClient) (interfaces.DBClient, error) {
+	factory, ok := databaseClientFactories[config.DbType]
+	if !ok {
 		return nil, db.ErrUnsupportedDatabase
 	}
+	return factory(config, lc)
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and initializes the database.
@@ -79,6 +159,10 @@ func (d Database) BootstrapHandler(
 		lc.Error("Database configuration is empty or incomplete, missing common config? Use -cp or -cc flags for common config")
 		return false
 	}
+	if _, ok := databaseClientFactories[dbInfo.Type]; !ok {
+		lc.Errorf("Unsupported database type '%s', the supported types are %v", dbInfo.Type, supportedDatabaseTypes())
+		return false
+	}
 
 	var credentials bootstrapConfig.Credentials
 	dbCredsRetrieved := false
@@ -133,6 +217,20 @@ func (d Database) BootstrapHandler(
 	})
 
 	lc.Info("Database connected")
+
+	// the queries are sent to the primary database when the replica is unavailable
+	queryDBClient, err := d.newReplicaDBClient(lc, credentials)
+	if err != nil {
+		lc.Errorf("couldn't create database replica client, the queries are sent to the primary database: %v", err.Error())
+	} else if queryDBClient != nil {
+		dic.Update(di.ServiceConstructorMap{
+			d.queryDBClientInterfaceName: func(get di.Get) interface{} {
+				return queryDBClient
+			},
+		})
+		lc.Info("Database replica connected")
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -142,6 +240,9 @@ func (d Database) BootstrapHandler(
 			// wait for httpServer to stop running (e.g. handling requests) before closing the database connection.
 			if !d.httpServer.IsRunning() {
 				dbClient.CloseSession()
+				if queryDBClient != nil {
+					queryDBClient.CloseSession()
+				}
 				break
 			}
 			time.Sleep(time.Second)
@@ -151,3 +252,49 @@ func (d Database) BootstrapHandler(
 
 	return true
 }
+
+// MetricsBootstrapHandler fulfills the BootstrapHandler contract and registers the metrics of the database connection
+// pool, it must be after the database and service metrics bootstrap handlers.
+func (d Database) MetricsBootstrapHandler(
+	_ context.Context,
+	_ *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	provider, ok := dic.Get(d.dBClientInterfaceName).(poolStatsProvider)
+	if !ok {
+		lc.Debug("Database client doesn't provide connection pool statistics, database pool metrics will not be collected")
+		return true
+	}
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		lc.Error("Metric Manager not available. Database pool metrics will not be collected.")
+		return true
+	}
+
+	metrics := map[string]gometrics.Gauge{
+		databaseConnectionsInUseMetricName: gometrics.NewFunctionalGauge(func() int64 {
+			return int64(provider.PoolStats().InUse)
+		}),
+		databaseConnectionsIdleMetricName: gometrics.NewFunctionalGauge(func() int64 {
+			return int64(provider.PoolStats().Idle)
+		}),
+		databaseWaitCountMetricName: gometrics.NewFunctionalGauge(func() int64 {
+			return provider.PoolStats().WaitCount
+		}),
+		// the total wait duration is reported in milliseconds
+		databaseWaitDurationMetricName: gometrics.NewFunctionalGauge(func() int64 {
+			return provider.PoolStats().WaitDuration.Milliseconds()
+		}),
+	}
+	for name, gauge := range metrics {
+		if err := metricsManager.Register(name, gauge, nil); err != nil {
+			lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		lc.Infof("Registered metrics gauge %s", name)
+	}
+
+	return true
+}
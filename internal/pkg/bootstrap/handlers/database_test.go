@@ -0,0 +1,152 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	gometrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	bootstrapInterfaces "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/interfaces"
+)
+
+type testDatabase struct {
+	info bootstrapConfig.Database
+	pool db.PoolInfo
+}
+
+func (t testDatabase) GetDatabaseInfo() bootstrapConfig.Database {
+	return t.info
+}
+
+func (t testDatabase) GetDatabasePoolInfo() db.PoolInfo {
+	return t.pool
+}
+
+type testReplicatedDatabase struct {
+	testDatabase
+	replica db.ReplicaInfo
+}
+
+func (t testReplicatedDatabase) GetDatabaseReplicaInfo() db.ReplicaInfo {
+	return t.replica
+}
+
+type testDBClient struct {
+	config db.Configuration
+}
+
+func (c *testDBClient) CloseSession() {}
+
+func (c *testDBClient) PoolStats() db.PoolStats {
+	return db.PoolStats{InUse: 3, Idle: 2, WaitCount: 5, WaitDuration: 1500 * time.Millisecond}
+}
+
+func TestNewDBClient(t *testing.T) {
+	databaseClientFactories["testdb"] = func(config db.Configuration, _ logger.LoggingClient) (interfaces.DBClient, error) {
+		return &testDBClient{config: config}, nil
+	}
+	defer delete(databaseClientFactories, "testdb")
+	assert.Equal(t, []string{"redisdb", "testdb"}, supportedDatabaseTypes())
+	credentials := bootstrapConfig.Credentials{Username: "edgex", Password: "password"}
+
+	pool := db.PoolInfo{MaxActive: 20, MaxIdle: 5, MaxConnLifetime: "1h", Wait: true}
+
+	database := NewDatabase(nil, testDatabase{info: bootstrapConfig.Database{Type: "testdb", Host: "localhost"}, pool: pool}, "")
+	dbClient, err := database.newDBClient(logger.NewMockClient(), credentials)
+	require.NoError(t, err)
+	require.IsType(t, &testDBClient{}, dbClient)
+	config := dbClient.(*testDBClient).config
+	assert.Equal(t, "localhost", config.Host)
+	assert.Equal(t, credentials.Username, config.Username)
+	assert.Equal(t, credentials.Password, config.Password)
+	assert.Equal(t, pool, config.Pool)
+
+	database = NewDatabase(nil, testDatabase{info: bootstrapConfig.Database{Type: "unknown"}}, "")
+	_, err = database.newDBClient(logger.NewMockClient(), credentials)
+	assert.ErrorIs(t, err, db.ErrUnsupportedDatabase)
+}
+
+func TestNewReplicaDBClient(t *testing.T) {
+	databaseClientFactories["testdb"] = func(config db.Configuration, _ logger.LoggingClient) (interfaces.DBClient, error) {
+		return &testDBClient{config: config}, nil
+	}
+	defer delete(databaseClientFactories, "testdb")
+	credentials := bootstrapConfig.Credentials{Password: "password"}
+	primary := testDatabase{info: bootstrapConfig.Database{Type: "testdb", Host: "primary", Port: 6379, Timeout: "5s"}}
+
+	tests := []struct {
+		name            string
+		database        bootstrapInterfaces.Database
+		queryClientName string
+		expectedClient  bool
+	}{
+		{"replica", testReplicatedDatabase{primary, db.ReplicaInfo{Host: "replica", Port: 6380}}, "query", true},
+		{"no replica host", testReplicatedDatabase{primary, db.ReplicaInfo{}}, "query", false},
+		{"replica not supported by the service", primary, "query", false},
+		{"read replica not enabled", testReplicatedDatabase{primary, db.ReplicaInfo{Host: "replica", Port: 6380}}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := NewDatabase(nil, tt.database, "").WithReadReplica(tt.queryClientName)
+			dbClient, err := database.newReplicaDBClient(logger.NewMockClient(), credentials)
+			require.NoError(t, err)
+			if !tt.expectedClient {
+				assert.Nil(t, dbClient)
+				return
+			}
+			require.IsType(t, &testDBClient{}, dbClient)
+			config := dbClient.(*testDBClient).config
+			assert.Equal(t, "replica", config.Host)
+			assert.Equal(t, 6380, config.Port)
+			assert.Equal(t, "5s", config.Timeout)
+			assert.Equal(t, credentials.Password, config.Password)
+			assert.True(t, config.ReadOnly)
+		})
+	}
+}
+
+func TestMetricsBootstrapHandler(t *testing.T) {
+	registered := make(map[string]gometrics.Gauge)
+	metricsManager := &mocks.MetricsManager{}
+	metricsManager.On("Register", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		registered[args.String(0)] = args.Get(1).(gometrics.Gauge)
+	}).Return(nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+			return metricsManager
+		},
+		"testDBClient": func(get di.Get) interface{} {
+			return &testDBClient{}
+		},
+	})
+
+	database := NewDatabase(nil, testDatabase{}, "testDBClient")
+	require.True(t, database.MetricsBootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewTimer(1, 1), dic))
+
+	require.Len(t, registered, 4)
+	assert.Equal(t, int64(3), registered[databaseConnectionsInUseMetricName].Value())
+	assert.Equal(t, int64(2), registered[databaseConnectionsIdleMetricName].Value())
+	assert.Equal(t, int64(5), registered[databaseWaitCountMetricName].Value())
+	assert.Equal(t, int64(1500), registered[databaseWaitDurationMetricName].Value())
+}
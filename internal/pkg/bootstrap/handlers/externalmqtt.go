@@ -0,0 +1,329 @@
+//
+// Copyright (C) 2022-2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+)
+
+// FailoverHandler is called when the external MQTT client connects to a broker other than the one it was
+// previously connected to.
+type FailoverHandler func(previousBroker string, currentBroker string)
+
+// ExternalMQTT contains references to dependencies required by the external MQTT bootstrap implementation. Unlike
+// the go-mod-bootstrap implementation it accepts an ordered list of failover brokers which are tried after
+// ExternalMQTT.Url when connecting and reconnecting, additional TLS settings, and it reloads the credentials when
+// they are rotated in the secret store.
+type ExternalMQTT struct {
+	onConnectHandler mqtt.OnConnectHandler
+	failoverUrls     []string
+	onFailover       FailoverHandler
+	tlsInfo          TLSInfo
+	credentials      *brokerCredentials
+	opts             *mqtt.ClientOptions
+
+	mutex           sync.Mutex
+	attemptedBroker string
+	connectedBroker string
+}
+
+// NewExternalMQTT is a factory method that returns an initialized ExternalMQTT receiver struct.
+func NewExternalMQTT(onConnectHandler mqtt.OnConnectHandler, failoverUrls []string, onFailover FailoverHandler, tlsInfo TLSInfo) *ExternalMQTT {
+	return &ExternalMQTT{
+		onConnectHandler: onConnectHandler,
+		failoverUrls:     failoverUrls,
+		onFailover:       onFailover,
+		tlsInfo:          tlsInfo,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract and connects to the external MQTT broker.
+func (e *ExternalMQTT) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	lc := container.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	brokerConfig := configuration.GetBootstrap().ExternalMQTT
+	if len(strings.TrimSpace(brokerConfig.SubscribeTopics)) == 0 && len(brokerConfig.Topics) == 0 {
+		lc.Errorf("missing SubscribeTopics and/or Topics for external MQTT connection. Must be present in [ExternalMqtt] section")
+		return false
+	}
+
+	opts := mqtt.NewClientOptions()
+	if err := e.applyConnectionSettings(opts, *brokerConfig); err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	opts.SetOnConnectHandler(e.onConnect(lc))
+	opts.SetConnectionAttemptHandler(e.onConnectionAttempt)
+	opts.SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+		lc.Warnf("Connection to external MQTT broker '%s' lost: %v", e.currentBroker(), err)
+	})
+
+	tlsConfig, err := e.tlsInfo.newTLSConfig(brokerConfig.SkipCertVerify)
+	if err != nil {
+		lc.Errorf("Invalid external MQTT TLS configuration: %s", err.Error())
+		return false
+	}
+
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	authMode := brokerConfig.AuthMode
+	if brokerConfig.AuthMode == "" {
+		authMode = messaging.AuthModeNone
+		lc.Warn("AuthMode not set, defaulting to \"" + messaging.AuthModeNone + "\"")
+	}
+
+	//get the secrets from the secret provider and populate the struct
+	secretData, err := loadSecretData(authMode, brokerConfig.SecretName, secretProvider)
+	if err != nil {
+		lc.Errorf("Failed to retrieve secret data: %s", err.Error())
+		return false
+	}
+	if secretData != nil {
+		// configure the mqtt client with the retrieved secret values, which are reloaded when they are rotated
+		e.credentials = &brokerCredentials{}
+		if err = e.credentials.update(authMode, secretData); err != nil {
+			lc.Errorf("Invalid secret data: %s", err.Error())
+			return false
+		}
+		switch authMode {
+		case messaging.AuthModeUsernamePassword:
+			opts.SetCredentialsProvider(e.credentials.credentialsProvider)
+		case messaging.AuthModeCert:
+			tlsConfig.GetClientCertificate = e.credentials.getClientCertificate
+		}
+		e.watchSecret(ctx, wg, lc, authMode, brokerConfig.SecretName, secretProvider)
+	}
+	opts.SetTLSConfig(tlsConfig)
+
+	var mqttClient mqtt.Client
+	for startupTimer.HasNotElapsed() {
+		select {
+		case <-ctx.Done():
+			return false
+		default:
+			mqttClient, err = createMqttClient(opts)
+			if err != nil {
+				lc.Warnf("Unable to create MQTT client: %s", err.Error())
+				startupTimer.SleepForInterval()
+				continue
+			}
+
+			e.opts = opts
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-ctx.Done()
+				// the client may have been replaced by Reconnect
+				container.ExternalMQTTMessagingClientFrom(dic.Get).Disconnect(0)
+				lc.Info("Disconnected from external MQTT broker")
+			}()
+
+			dic.Update(di.ServiceConstructorMap{
+				container.ExternalMQTTMessagingClientName: func(get di.Get) interface{} {
+					return mqttClient
+				},
+			})
+
+			lc.Infof(
+				"Connected to external MQTT broker @ %s with AuthMode='%s'",
+				e.currentBroker(),
+				brokerConfig.AuthMode)
+
+			return true
+		}
+	}
+
+	lc.Error("Connecting to external MQTT broker time out")
+	return false
+}
+
+// applyConnectionSettings sets the brokers, i.e. ExternalMQTT.Url followed by the failover brokers, and the connection
+// settings of the external MQTT configuration to the client options.
+func (e *ExternalMQTT) applyConnectionSettings(opts *mqtt.ClientOptions, brokerConfig bootstrapConfig.ExternalMQTTInfo) error {
+	opts.Servers = nil
+	for _, brokerUrl := range append([]string{brokerConfig.Url}, e.failoverUrls...) {
+		if _, err := url.Parse(brokerUrl); err != nil {
+			return fmt.Errorf("invalid MQTT Broker Url '%s': %s", brokerUrl, err.Error())
+		}
+		opts.AddBroker(brokerUrl)
+	}
+	opts.SetClientID(brokerConfig.ClientId)
+	opts.SetAutoReconnect(brokerConfig.AutoReconnect)
+	opts.KeepAlive = brokerConfig.KeepAlive
+	if len(brokerConfig.ConnectTimeout) > 0 {
+		duration, err := time.ParseDuration(brokerConfig.ConnectTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid MQTT ConnectTimeout '%s': %s", brokerConfig.ConnectTimeout, err.Error())
+		}
+		opts.SetConnectTimeout(duration)
+	}
+	return nil
+}
+
+// Reconnect replaces the client of the DIC with a client connected with the connection settings of brokerConfig,
+// i.e. Url, ClientId, ConnectTimeout, AutoReconnect and KeepAlive. The authentication and TLS settings the service
+// started with are kept. The current client is disconnected first, so that the broker doesn't drop either connection
+// for using the same ClientId, and it is connected again when the new client fails to connect.
+func (e *ExternalMQTT) Reconnect(brokerConfig bootstrapConfig.ExternalMQTTInfo, dic *di.Container) error {
+	if e.opts == nil {
+		return errors.New("the external MQTT client must be connected before reconnecting")
+	}
+	lc := container.LoggingClientFrom(dic.Get)
+
+	opts := *e.opts
+	if err := e.applyConnectionSettings(&opts, brokerConfig); err != nil {
+		return err
+	}
+
+	previousClient := container.ExternalMQTTMessagingClientFrom(dic.Get)
+	previousClient.Disconnect(0)
+	// connecting to the updated brokers isn't a failover
+	e.mutex.Lock()
+	e.connectedBroker = ""
+	e.mutex.Unlock()
+
+	mqttClient, err := createMqttClient(&opts)
+	if err != nil {
+		if token := previousClient.Connect(); token.Wait() && token.Error() != nil {
+			lc.Errorf("Failed to connect the previous external MQTT client again: %s", token.Error().Error())
+		}
+		return err
+	}
+
+	e.opts = &opts
+	dic.Update(di.ServiceConstructorMap{
+		container.ExternalMQTTMessagingClientName: func(get di.Get) interface{} {
+			return mqttClient
+		},
+	})
+	lc.Infof("Reconnected to external MQTT broker @ %s", e.currentBroker())
+	return nil
+}
+
+// onConnectionAttempt records the broker the client is attempting to connect to, so that the broker in use is known
+// once connected.
+// The CA loaded from the secret store at the time of the attempt is applied to the TLS configuration.
+func (e *ExternalMQTT) onConnectionAttempt(broker *url.URL, tlsCfg *tls.Config) *tls.Config {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.attemptedBroker = broker.String()
+	if tlsCfg != nil && e.credentials != nil {
+		return e.credentials.apply(tlsCfg)
+	}
+	return tlsCfg
+}
+
+// watchSecret reloads the credentials whenever the secret is updated and, if configured, every TLS.ReloadInterval
+func (e *ExternalMQTT) watchSecret(ctx context.Context, wg *sync.WaitGroup, lc logger.LoggingClient, authMode string,
+	secretName string, secretProvider interfaces.SecretProvider) {
+	reload := func() {
+		secretData, err := loadSecretData(authMode, secretName, secretProvider)
+		if err == nil {
+			err = e.credentials.update(authMode, secretData)
+		}
+		if err != nil {
+			lc.Errorf("Failed to reload external MQTT credentials from secret '%s', keeping the current ones: %s", secretName, err.Error())
+			return
+		}
+		lc.Infof("Reloaded external MQTT credentials from secret '%s'", secretName)
+	}
+
+	if err := secretProvider.RegisterSecretUpdatedCallback(secretName, func(_ string) { reload() }); err != nil {
+		lc.Warnf("Unable to watch secret '%s' for updates of the external MQTT credentials: %s", secretName, err.Error())
+	}
+
+	if e.tlsInfo.ReloadInterval == "" {
+		return
+	}
+	interval, err := time.ParseDuration(e.tlsInfo.ReloadInterval)
+	if err != nil || interval <= 0 {
+		lc.Errorf("Invalid TLS ReloadInterval '%s', external MQTT credentials are not reloaded periodically", e.tlsInfo.ReloadInterval)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reload()
+			}
+		}
+	}()
+}
+
+// loadSecretData retrieves and validates the secret data required by the AuthMode, nil is returned for AuthMode none
+func loadSecretData(authMode string, secretName string, secretProvider messaging.SecretDataProvider) (*messaging.SecretData, error) {
+	secretData, err := messaging.GetSecretData(authMode, secretName, secretProvider)
+	if err != nil || secretData == nil {
+		return nil, err
+	}
+	//ensure that the AuthMode selected has the required secret values
+	if err = messaging.ValidateSecretData(authMode, secretName, secretData); err != nil {
+		return nil, err
+	}
+	return secretData, nil
+}
+
+// onConnect detects whether the client failed over to a different broker before calling the service's
+// OnConnectHandler.
+func (e *ExternalMQTT) onConnect(lc logger.LoggingClient) mqtt.OnConnectHandler {
+	return func(client mqtt.Client) {
+		e.mutex.Lock()
+		previousBroker := e.connectedBroker
+		e.connectedBroker = e.attemptedBroker
+		currentBroker := e.connectedBroker
+		e.mutex.Unlock()
+
+		if len(previousBroker) > 0 && previousBroker != currentBroker {
+			lc.Warnf("External MQTT connection failed over from broker '%s' to broker '%s'", previousBroker, currentBroker)
+			if e.onFailover != nil {
+				e.onFailover(previousBroker, currentBroker)
+			}
+		}
+
+		if e.onConnectHandler != nil {
+			e.onConnectHandler(client)
+		}
+	}
+}
+
+func (e *ExternalMQTT) currentBroker() string {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.connectedBroker
+}
+
+func createMqttClient(opts *mqtt.ClientOptions) (mqtt.Client, error) {
+	mqttClient := mqtt.NewClient(opts)
+	if token := mqttClient.Connect(); token.Wait() && token.Error() != nil {
+		return nil, fmt.Errorf("could not connect to broker: %s", token.Error().Error())
+	}
+
+	return mqttClient, nil
+}
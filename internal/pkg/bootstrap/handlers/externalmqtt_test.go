@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/url"
+	"testing"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalMQTTFailover(t *testing.T) {
+	primary, err := url.Parse("tcp://primary:1883")
+	require.NoError(t, err)
+	backup, err := url.Parse("tcp://backup:1883")
+	require.NoError(t, err)
+
+	var failovers [][2]string
+	connects := 0
+	e := NewExternalMQTT(
+		func(client mqtt.Client) { connects++ },
+		[]string{backup.String()},
+		func(previousBroker string, currentBroker string) {
+			failovers = append(failovers, [2]string{previousBroker, currentBroker})
+		},
+		TLSInfo{})
+	onConnect := e.onConnect(logger.NewMockClient())
+
+	// initial connection is not a failover
+	e.onConnectionAttempt(primary, nil)
+	onConnect(nil)
+	assert.Empty(t, failovers)
+	assert.Equal(t, primary.String(), e.currentBroker())
+
+	// reconnecting to the same broker is not a failover
+	e.onConnectionAttempt(primary, nil)
+	onConnect(nil)
+	assert.Empty(t, failovers)
+
+	// reconnecting to the backup broker is a failover
+	e.onConnectionAttempt(primary, nil)
+	e.onConnectionAttempt(backup, nil)
+	onConnect(nil)
+	require.Len(t, failovers, 1)
+	assert.Equal(t, [2]string{primary.String(), backup.String()}, failovers[0])
+	assert.Equal(t, backup.String(), e.currentBroker())
+	assert.Equal(t, 3, connects)
+}
@@ -0,0 +1,229 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcInfo configures the gRPC API of the service, served alongside the REST API
+type GrpcInfo struct {
+	Enabled bool
+	// Port the gRPC API is served on, at the ServerBindAddr of the service
+	Port int
+	// MaxMessageSize is the maximum size in bytes of the messages received, 0 for the gRPC default of 4MB
+	MaxMessageSize int
+}
+
+// GrpcServices are the gRPC services of a service, authenticated and authorized like its REST API
+type GrpcServices struct {
+	// Register registers the services on the server
+	Register func(server *grpc.Server, dic *di.Container)
+	// AuthenticationHook returns the authentication hook of the REST API. Each call is authenticated by the hook as a
+	// request to the full method of the call, with the metadata of the call as headers.
+	AuthenticationHook func(dic *di.Container) func(inner http.HandlerFunc) http.HandlerFunc
+	// HttpMethods maps the full methods of the calls adding, updating or deleting resources to the HTTP method of
+	// their REST equivalent, the other calls are authorized as GET requests
+	HttpMethods map[string]string
+}
+
+// GrpcServer serves the gRPC API of the service, over mutual TLS with the certificate of the HttpServer when it's
+// enabled for the REST API.
+type GrpcServer struct {
+	info       *GrpcInfo
+	httpServer *HttpServer
+	services   GrpcServices
+}
+
+// NewGrpcServer is a factory method that returns an initialized GrpcServer receiver struct. The GrpcInfo is read by
+// the BootstrapHandler, once the configuration is loaded.
+func NewGrpcServer(info *GrpcInfo, httpServer *HttpServer, services GrpcServices) *GrpcServer {
+	return &GrpcServer{
+		info:       info,
+		httpServer: httpServer,
+		services:   services,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract and serves the gRPC API when it's enabled. It must run after
+// the BootstrapHandler of the HttpServer, which loads the mutual TLS certificate.
+func (b *GrpcServer) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	if b.info.Port == 0 {
+		lc.Error("Grpc.Port is missing from service's configuration or should not be 0 when the gRPC API is enabled")
+		return false
+	}
+	bootstrapConfig := container.ConfigurationFrom(dic.Get).GetBootstrap()
+	addr := bootstrapConfig.Service.ServerBindAddr + ":" + strconv.Itoa(b.info.Port)
+	if bootstrapConfig.Service.ServerBindAddr == "" {
+		addr = bootstrapConfig.Service.Host + ":" + strconv.Itoa(b.info.Port)
+	}
+
+	authentication := grpcAuthentication{hook: b.services.AuthenticationHook(dic), httpMethods: b.services.HttpMethods}
+	options := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authentication.unaryInterceptor),
+		grpc.ChainStreamInterceptor(authentication.streamInterceptor),
+	}
+	if b.info.MaxMessageSize > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(b.info.MaxMessageSize))
+	}
+	if b.httpServer.info.Enabled {
+		tlsConfig, err := b.httpServer.info.newServerTLSConfig(&b.httpServer.credentials)
+		if err != nil {
+			lc.Errorf("Invalid MutualTLS configuration: %s", err.Error())
+			return false
+		}
+		// the configuration returned for each client is cloned from this one, which must then include the ALPN of
+		// HTTP/2 added by the gRPC credentials to their own copy
+		tlsConfig.NextProtos = []string{"h2"}
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	server := grpc.NewServer(options...)
+	b.services.Register(server, dic)
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		lc.Errorf("Unable to listen on %s for the gRPC API: %v", addr, err)
+		return false
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+		// the event streams only end when their clients cancel them, so the server isn't stopped gracefully
+		server.Stop()
+		lc.Info("gRPC server shut down")
+	}()
+
+	lc.Infof("gRPC server starting (%s)", addr)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if err := server.Serve(listener); err != nil {
+			lc.Errorf("gRPC server failed: %v", err)
+
+			cancel := container.CancelFuncFrom(dic.Get)
+			cancel()
+
+			wg.Done() // Must do this to account for this go func's wg.Add above otherwise wait will block indefinitely
+			wg.Wait()
+			os.Exit(1)
+		}
+		lc.Info("gRPC server stopped")
+	}()
+
+	return true
+}
+
+// grpcAuthentication authenticates and authorizes the gRPC calls with the authentication hook of the REST API
+type grpcAuthentication struct {
+	hook        func(inner http.HandlerFunc) http.HandlerFunc
+	httpMethods map[string]string
+}
+
+func (a grpcAuthentication) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authenticate(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a grpcAuthentication) streamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authenticate(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// authenticate runs the authentication hook on the request equivalent to the call, and returns the status error of
+// the response the hook writes when it rejects the request
+func (a grpcAuthentication) authenticate(ctx context.Context, fullMethod string) error {
+	method, ok := a.httpMethods[fullMethod]
+	if !ok {
+		method = http.MethodGet
+	}
+	r, err := http.NewRequestWithContext(ctx, method, fullMethod, http.NoBody)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		// the pseudo-headers of HTTP/2 such as :authority aren't headers of the request
+		if strings.HasPrefix(key, ":") {
+			continue
+		}
+		for _, value := range values {
+			r.Header.Add(key, value)
+		}
+	}
+
+	authenticated := false
+	response := &authenticationResponse{header: make(http.Header)}
+	a.hook(func(http.ResponseWriter, *http.Request) { authenticated = true })(response, r)
+	if authenticated {
+		return nil
+	}
+	switch response.status {
+	case http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, http.StatusText(response.status))
+	case http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, http.StatusText(response.status))
+	default:
+		return status.Error(codes.Internal, http.StatusText(response.status))
+	}
+}
+
+// authenticationResponse records the status of the response the authentication hook writes when it rejects a request
+type authenticationResponse struct {
+	header http.Header
+	status int
+}
+
+func (r *authenticationResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *authenticationResponse) Write(data []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return len(data), nil
+}
+
+func (r *authenticationResponse) WriteHeader(statusCode int) {
+	if r.status == 0 {
+		r.status = statusCode
+	}
+}
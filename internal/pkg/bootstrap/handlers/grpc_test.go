@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testGetMethod = "/edgex.v3.CoreCommand/IssueGetCommand"
+	testSetMethod = "/edgex.v3.CoreCommand/IssueSetCommand"
+)
+
+// testAuthenticationHook rejects the requests without token, and the PUT requests of the tokens other than admin
+func testAuthenticationHook(inner http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+		switch {
+		case token == "":
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		case r.Method == http.MethodPut && token != "admin":
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		default:
+			inner(w, r)
+		}
+	}
+}
+
+func TestGrpcAuthenticate(t *testing.T) {
+	authentication := grpcAuthentication{
+		hook:        testAuthenticationHook,
+		httpMethods: map[string]string{testSetMethod: http.MethodPut},
+	}
+
+	tests := []struct {
+		name         string
+		fullMethod   string
+		token        string
+		expectedCode codes.Code
+	}{
+		{"valid, get", testGetMethod, "reader", codes.OK},
+		{"valid, set", testSetMethod, "admin", codes.OK},
+		{"unauthenticated, no token", testGetMethod, "", codes.Unauthenticated},
+		{"permission denied, set requires admin", testSetMethod, "reader", codes.PermissionDenied},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", testCase.token, ":authority", "localhost"))
+			}
+
+			err := authentication.authenticate(ctx, testCase.fullMethod)
+			if testCase.expectedCode == codes.OK {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestGrpcUnaryInterceptor(t *testing.T) {
+	authentication := grpcAuthentication{hook: testAuthenticationHook}
+	called := false
+	handler := func(context.Context, any) (any, error) {
+		called = true
+		return "response", nil
+	}
+
+	_, err := authentication.unaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testGetMethod}, handler)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.False(t, called)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "reader"))
+	res, err := authentication.unaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testGetMethod}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "response", res)
+	assert.True(t, called)
+}
@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapMessaging "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+)
+
+// The MessageBus Optional settings of the NATS JetStream client
+const (
+	optionalClientId      = "ClientId"
+	optionalDurable       = "Durable"
+	optionalSubject       = "Subject"
+	optionalAutoProvision = "AutoProvision"
+	optionalDeliver       = "Deliver"
+	optionalExactlyOnce   = "ExactlyOnce"
+)
+
+// JetStreamInfo configures the NATS JetStream durable consumers of the MessageBus subscriptions of the service
+type JetStreamInfo struct {
+	// Enabled makes each subscription use a durable consumer, whose stream keeps the messages published while the
+	// service is down so that they are delivered once it recovers. The messages are acknowledged explicitly. It requires
+	// the NATS MessageBus and the service to be built with the include_nats_messaging tag.
+	Enabled bool
+	// Deliver is the delivery policy of a durable consumer the first time it is created: all, last, lastpersubject or
+	// new, defaults to new
+	Deliver string
+	// ExactlyOnce acknowledges the messages synchronously
+	ExactlyOnce bool
+}
+
+// SubscriptionClient returns the client subscribing to the topic of the MessageBus. It is the MessageBus client of the
+// DIC unless the JetStream durable consumers are enabled, in which case a JetStream client bound to the durable
+// consumer durableName is connected and disconnected once the context is done. The stream of the consumer, named
+// after it as well, is created if needed. The MessageBus client of the DIC is still the one to publish with.
+func SubscriptionClient(ctx context.Context, info JetStreamInfo, durableName string, topic string, dic *di.Container) (messaging.MessageClient, errors.EdgeX) {
+	if !info.Enabled {
+		return container.MessagingClientFrom(dic.Get), nil
+	}
+	lc := container.LoggingClientFrom(dic.Get)
+
+	messageBusInfo := *container.ConfigurationFrom(dic.Get).GetBootstrap().MessageBus
+	if !strings.HasPrefix(messageBusInfo.Type, "nats") {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError,
+			fmt.Sprintf("the JetStream durable consumers require the NATS MessageBus, not '%s'", messageBusInfo.Type), nil)
+	}
+	// the auth data mustn't be added to the Optional settings of the service's configuration
+	optional := make(map[string]string, len(messageBusInfo.Optional))
+	for key, value := range messageBusInfo.Optional {
+		optional[key] = value
+	}
+	messageBusInfo.Optional = optional
+	if len(messageBusInfo.AuthMode) > 0 && !strings.EqualFold(strings.TrimSpace(messageBusInfo.AuthMode), bootstrapMessaging.AuthModeNone) {
+		if err := bootstrapMessaging.SetOptionsAuthData(&messageBusInfo, lc, dic); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to set the MessageBus auth options", err)
+		}
+	}
+
+	client, err := messaging.NewMessageClient(jetStreamConfig(messageBusInfo, info, durableName, topic))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create the JetStream client", err)
+	}
+	if err = client.Connect(); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to connect the JetStream client", err)
+	}
+	go func() {
+		<-ctx.Done()
+		if err := client.Disconnect(); err != nil {
+			lc.Warnf("Failed to disconnect the JetStream client of the durable consumer '%s': %v", durableName, err)
+		}
+	}()
+
+	lc.Infof("Subscribing to topic '%s' with the JetStream durable consumer '%s'", topic, durableName)
+	return client, nil
+}
+
+// jetStreamConfig returns the configuration of the JetStream client of the durable consumer subscribing to the topic
+func jetStreamConfig(messageBusInfo bootstrapConfig.MessageBusInfo, info JetStreamInfo, durableName string, topic string) types.MessageBusConfig {
+	optional := make(map[string]string, len(messageBusInfo.Optional)+6)
+	for key, value := range messageBusInfo.Optional {
+		optional[key] = value
+	}
+	if clientId := optional[optionalClientId]; clientId != "" {
+		optional[optionalClientId] = clientId + "-" + durableName
+	}
+	optional[optionalDurable] = durableName
+	optional[optionalSubject] = topic
+	optional[optionalAutoProvision] = strconv.FormatBool(true)
+	optional[optionalExactlyOnce] = strconv.FormatBool(info.ExactlyOnce)
+	if info.Deliver != "" {
+		optional[optionalDeliver] = info.Deliver
+	}
+
+	return types.MessageBusConfig{
+		Broker: types.HostInfo{
+			Host:     messageBusInfo.Host,
+			Port:     messageBusInfo.Port,
+			Protocol: messageBusInfo.Protocol,
+		},
+		Type:     messaging.NatsJetStream,
+		Optional: optional,
+	}
+}
@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJetStreamConfig(t *testing.T) {
+	messageBusInfo := bootstrapConfig.MessageBusInfo{
+		Type:     messaging.NatsCore,
+		Protocol: "nats",
+		Host:     "localhost",
+		Port:     4222,
+		Optional: map[string]string{"ClientId": "core-data", "Format": "nats"},
+	}
+
+	config := jetStreamConfig(messageBusInfo, JetStreamInfo{Enabled: true, Deliver: "all", ExactlyOnce: true}, "core-data-events", "edgex/events/#")
+	assert.Equal(t, messaging.NatsJetStream, config.Type)
+	assert.Equal(t, "nats://localhost:4222", config.Broker.GetHostURL())
+	assert.Equal(t, map[string]string{
+		"ClientId":      "core-data-core-data-events",
+		"Format":        "nats",
+		"Durable":       "core-data-events",
+		"Subject":       "edgex/events/#",
+		"AutoProvision": "true",
+		"Deliver":       "all",
+		"ExactlyOnce":   "true",
+	}, config.Optional)
+	assert.Equal(t, "core-data", messageBusInfo.Optional["ClientId"], "the Optional settings of the configuration should be kept")
+
+	config = jetStreamConfig(messageBusInfo, JetStreamInfo{Enabled: true}, "core-data-events", "edgex/events/#")
+	assert.NotContains(t, config.Optional, "Deliver", "the default delivery policy should be used")
+	assert.Equal(t, "false", config.Optional["ExactlyOnce"])
+}
+
+func TestSubscriptionClient(t *testing.T) {
+	messageBus := &messagingMocks.MessageClient{}
+
+	tests := []struct {
+		name          string
+		info          JetStreamInfo
+		messageBus    string
+		expectedError bool
+	}{
+		{"disabled", JetStreamInfo{}, "mqtt", false},
+		{"enabled with MQTT", JetStreamInfo{Enabled: true}, "mqtt", true},
+		{"enabled with Redis", JetStreamInfo{Enabled: true}, "redis", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dic := newConfigWatcherTestDIC(&bootstrapConfig.MessageBusInfo{Type: tt.messageBus, Protocol: "tcp", Host: "localhost", Port: 1883})
+			dic.Update(di.ServiceConstructorMap{
+				container.MessagingClientName: func(get di.Get) interface{} {
+					return messageBus
+				},
+			})
+
+			client, err := SubscriptionClient(context.Background(), tt.info, "core-data-events", "edgex/events/#", dic)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, messageBus, client)
+		})
+	}
+}
@@ -0,0 +1,356 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapHandlers "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/handlers"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+)
+
+const (
+	// ClientAuthRequire rejects the clients without a certificate issued by the service CA
+	ClientAuthRequire = "require"
+	// ClientAuthVerifyIfGiven also accepts the clients without certificate, such as the API gateway and the registry
+	// health checks, which are then left to the other authentication of the service
+	ClientAuthVerifyIfGiven = "verify-if-given"
+
+	defaultMutualTLSSecretName = "tls"
+)
+
+// MutualTLSInfo configures mutual TLS on the REST API of the service and for its requests to the other services, with
+// the certificate issued to the service by security-secretstore-setup
+type MutualTLSInfo struct {
+	Enabled bool
+	// SecretName of the secret holding the certificate, its key and the CA certificate, defaults to tls
+	SecretName string
+	// ClientAuth is require or verify-if-given, defaults to require
+	ClientAuth string
+	// ReloadInterval is how often the certificate and CA are reloaded from the secret store, i.e. 1h. They are also
+	// reloaded whenever the secret is updated. Empty disables the periodic reload.
+	ReloadInterval string
+}
+
+// HttpServer is the go-mod-bootstrap http server serving the REST API over mutual TLS when it's enabled.
+type HttpServer struct {
+	*bootstrapHandlers.HttpServer
+	router           *mux.Router
+	doListenAndServe bool
+	info             *MutualTLSInfo
+	credentials      serviceCredentials
+	isRunning        bool
+}
+
+// NewHttpServer is a factory method that returns an initialized HttpServer receiver struct. The MutualTLSInfo is
+// read by the BootstrapHandler, once the configuration is loaded.
+func NewHttpServer(router *mux.Router, doListenAndServe bool, info *MutualTLSInfo) *HttpServer {
+	return &HttpServer{
+		HttpServer:       bootstrapHandlers.NewHttpServer(router, doListenAndServe),
+		router:           router,
+		doListenAndServe: doListenAndServe,
+		info:             info,
+	}
+}
+
+// IsRunning returns whether or not the http server is running.
+func (b *HttpServer) IsRunning() bool {
+	if !b.info.Enabled || !b.doListenAndServe {
+		return b.HttpServer.IsRunning()
+	}
+	return b.isRunning
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. Without mutual TLS it runs the go-mod-bootstrap http server,
+// otherwise it loads the certificate of the service, configures the requests to the other services to present it and
+// serves the REST API over TLS, requiring the clients to present a certificate issued by the same CA.
+func (b *HttpServer) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container) bool {
+
+	if !b.info.Enabled {
+		return b.HttpServer.BootstrapHandler(ctx, wg, startupTimer, dic)
+	}
+
+	if b.info.SecretName == "" {
+		b.info.SecretName = defaultMutualTLSSecretName
+	}
+	lc := container.LoggingClientFrom(dic.Get)
+	serverConfig, err := b.info.newServerTLSConfig(&b.credentials)
+	if err != nil {
+		lc.Errorf("Invalid MutualTLS configuration: %s", err.Error())
+		return false
+	}
+
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	secretData, err := loadSecretData(messaging.AuthModeCert, b.info.SecretName, secretProvider)
+	if err == nil {
+		err = b.credentials.update(secretData)
+	}
+	if err != nil {
+		lc.Errorf("Failed to load the mutual TLS certificate from secret '%s': %s", b.info.SecretName, err.Error())
+		return false
+	}
+	b.watchSecret(ctx, wg, lc, secretProvider)
+
+	// the service clients of go-mod-core-contracts send their requests with the default transport
+	http.DefaultTransport = withClientTLSConfig(http.DefaultTransport, newClientTLSConfig(&b.credentials))
+
+	if !b.doListenAndServe {
+		return b.HttpServer.BootstrapHandler(ctx, wg, startupTimer, dic)
+	}
+
+	bootstrapConfig := container.ConfigurationFrom(dic.Get).GetBootstrap()
+	if bootstrapConfig.Service.Port == 0 {
+		lc.Error("Service.Port is missing from service's configuration or should not be 0 in local private config")
+		return false
+	}
+	port := strconv.Itoa(bootstrapConfig.Service.Port)
+	addr := bootstrapConfig.Service.ServerBindAddr + ":" + port
+	if bootstrapConfig.Service.ServerBindAddr == "" {
+		addr = bootstrapConfig.Service.Host + ":" + port
+	}
+	timeout, err := time.ParseDuration(bootstrapConfig.Service.RequestTimeout)
+	if err != nil {
+		lc.Errorf("unable to parse RequestTimeout value of %s to a duration: %v", bootstrapConfig.Service.RequestTimeout, err)
+		return false
+	}
+
+	b.router.Use(func(next http.Handler) http.Handler {
+		return http.TimeoutHandler(next, timeout, "HTTP request timeout")
+	})
+	b.router.Use(bootstrapHandlers.RequestLimitMiddleware(bootstrapConfig.Service.MaxRequestSize, lc))
+	b.router.Use(bootstrapHandlers.ProcessCORS(bootstrapConfig.Service.CORSConfiguration))
+	b.router.Methods(http.MethodOptions).MatcherFunc(func(r *http.Request, rm *mux.RouteMatch) bool {
+		return r.Header.Get(bootstrapHandlers.AccessControlRequestMethod) != ""
+	}).HandlerFunc(bootstrapHandlers.HandlePreflight(bootstrapConfig.Service.CORSConfiguration))
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           b.router,
+		TLSConfig:         serverConfig,
+		ReadHeaderTimeout: 5 * time.Second, // G112: A configured ReadHeaderTimeout in the http.Server averts a potential Slowloris Attack
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+		lc.Info("Web server shut down")
+	}()
+
+	lc.Infof("Web server starting with mutual TLS (%s)", addr)
+
+	wg.Add(1)
+	go func() {
+		defer func() {
+			wg.Done()
+			b.isRunning = false
+		}()
+
+		b.isRunning = true
+		// the certificate is provided by the TLS configuration
+		err := server.ListenAndServeTLS("", "")
+		if err != nil && err != http.ErrServerClosed {
+			lc.Errorf("Web server failed: %v", err)
+
+			cancel := container.CancelFuncFrom(dic.Get)
+			cancel()
+
+			wg.Done() // Must do this to account for this go func's wg.Add above otherwise wait will block indefinitely
+			wg.Wait()
+			os.Exit(1)
+		} else {
+			lc.Info("Web server stopped")
+		}
+	}()
+
+	return true
+}
+
+// watchSecret reloads the certificate whenever the secret is updated and, if configured, every ReloadInterval
+func (b *HttpServer) watchSecret(ctx context.Context, wg *sync.WaitGroup, lc logger.LoggingClient,
+	secretProvider interfaces.SecretProvider) {
+	reload := func() {
+		secretData, err := loadSecretData(messaging.AuthModeCert, b.info.SecretName, secretProvider)
+		if err == nil {
+			err = b.credentials.update(secretData)
+		}
+		if err != nil {
+			lc.Errorf("Failed to reload the mutual TLS certificate from secret '%s', keeping the current one: %s", b.info.SecretName, err.Error())
+			return
+		}
+		lc.Infof("Reloaded the mutual TLS certificate from secret '%s'", b.info.SecretName)
+	}
+
+	if err := secretProvider.RegisterSecretUpdatedCallback(b.info.SecretName, func(_ string) { reload() }); err != nil {
+		lc.Warnf("Unable to watch secret '%s' for updates of the mutual TLS certificate: %s", b.info.SecretName, err.Error())
+	}
+
+	if b.info.ReloadInterval == "" {
+		return
+	}
+	interval, err := time.ParseDuration(b.info.ReloadInterval)
+	if err != nil || interval <= 0 {
+		lc.Errorf("Invalid MutualTLS ReloadInterval '%s', the certificate is not reloaded periodically", b.info.ReloadInterval)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reload()
+			}
+		}
+	}()
+}
+
+// newServerTLSConfig returns the TLS configuration of the server, which presents the current certificate and verifies
+// the client certificates with the current CA
+func (info MutualTLSInfo) newServerTLSConfig(credentials *serviceCredentials) (*tls.Config, error) {
+	var clientAuth tls.ClientAuthType
+	switch info.ClientAuth {
+	case "", ClientAuthRequire:
+		clientAuth = tls.RequireAndVerifyClientCert
+	case ClientAuthVerifyIfGiven:
+		clientAuth = tls.VerifyClientCertIfGiven
+	default:
+		return nil, fmt.Errorf("unsupported ClientAuth '%s'", info.ClientAuth)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: clientAuth,
+	}
+	tlsConfig.GetConfigForClient = func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+		credentials.mutex.RLock()
+		defer credentials.mutex.RUnlock()
+		applied := tlsConfig.Clone()
+		applied.GetConfigForClient = nil
+		applied.Certificates = []tls.Certificate{*credentials.certificate}
+		applied.ClientCAs = credentials.caPool
+		return applied, nil
+	}
+	return tlsConfig, nil
+}
+
+// newClientTLSConfig returns the TLS configuration of the requests, which present the current certificate and verify
+// the servers with the current CA, or the system CAs for the servers outside of EdgeX
+// withClientTLSConfig returns a clone of the transport with the client TLS config, the transport wrapped by the tracing
+// Transport being cloned as well. The other RoundTrippers are returned as is.
+func withClientTLSConfig(roundTripper http.RoundTripper, config *tls.Config) http.RoundTripper {
+	switch transport := roundTripper.(type) {
+	case *http.Transport:
+		transport = transport.Clone()
+		transport.TLSClientConfig = config
+		return transport
+	case *tracing.Transport:
+		traced := *transport
+		traced.Base = withClientTLSConfig(transport.Base, config)
+		return &traced
+	}
+	return roundTripper
+}
+
+func newClientTLSConfig(credentials *serviceCredentials) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the server certificate is verified by VerifyConnection, with the CA reloaded from the secret store
+		// nolint: gosec
+		InsecureSkipVerify:   true,
+		GetClientCertificate: credentials.getClientCertificate,
+		VerifyConnection:     credentials.verifyServer,
+	}
+}
+
+// serviceCredentials holds the certificate and CA loaded from the secret store, so they can be replaced when they are
+// issued again without restarting the service.
+type serviceCredentials struct {
+	mutex       sync.RWMutex
+	certificate *tls.Certificate
+	caPool      *x509.CertPool
+}
+
+// update replaces the certificate and CA with those of the secret data
+func (c *serviceCredentials) update(secretData *messaging.SecretData) error {
+	certificate, err := tls.X509KeyPair(secretData.CertPemBlock, secretData.KeyPemBlock)
+	if err != nil {
+		return fmt.Errorf("failed to parse public/private key pair: %s", err.Error())
+	}
+	caPool := x509.NewCertPool()
+	if ok := caPool.AppendCertsFromPEM(secretData.CaPemBlock); !ok {
+		return errors.New("error parsing CA PEM block")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.certificate = &certificate
+	c.caPool = caPool
+	return nil
+}
+
+// getClientCertificate returns the current certificate
+func (c *serviceCredentials) getClientCertificate(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.certificate, nil
+}
+
+// verifyServer verifies the server certificate with the current CA, falling back to the system CAs
+func (c *serviceCredentials) verifyServer(state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("no server certificate")
+	}
+	c.mutex.RLock()
+	caPool := c.caPool
+	c.mutex.RUnlock()
+
+	opts := x509.VerifyOptions{
+		DNSName:       state.ServerName,
+		Roots:         caPool,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range state.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(opts)
+	if err != nil {
+		opts.Roots = nil
+		if _, systemErr := state.PeerCertificates[0].Verify(opts); systemErr == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,200 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+)
+
+// newTestSecretData returns the secret data of a certificate for localhost issued by a new CA
+func newTestSecretData(t *testing.T) *messaging.SecretData {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "core-data"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return &messaging.SecretData{
+		CertPemBlock: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		KeyPemBlock:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		CaPemBlock:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	secretData := newTestSecretData(t)
+	otherSecretData := newTestSecretData(t)
+
+	tests := []struct {
+		name          string
+		clientAuth    string
+		clientSecret  *messaging.SecretData
+		expectedError bool
+	}{
+		{"valid - client certificate required", ClientAuthRequire, secretData, false},
+		{"valid - client without certificate", ClientAuthVerifyIfGiven, nil, false},
+		{"invalid - client without certificate", "", nil, true},
+		{"invalid - client certificate of another CA", ClientAuthVerifyIfGiven, otherSecretData, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var serverCredentials serviceCredentials
+			require.NoError(t, serverCredentials.update(secretData))
+			serverConfig, err := MutualTLSInfo{ClientAuth: tt.clientAuth}.newServerTLSConfig(&serverCredentials)
+			require.NoError(t, err)
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			server.TLS = serverConfig
+			server.StartTLS()
+			defer server.Close()
+
+			clientConfig := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: x509.NewCertPool()}
+			clientConfig.RootCAs.AppendCertsFromPEM(secretData.CaPemBlock)
+			if tt.clientSecret != nil {
+				var clientCredentials serviceCredentials
+				require.NoError(t, clientCredentials.update(tt.clientSecret))
+				clientConfig = newClientTLSConfig(&clientCredentials)
+				// the server certificate is issued by the CA of the client credentials in the valid cases
+				clientConfig.VerifyConnection = nil
+			}
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientConfig}}
+
+			resp, err := client.Get(server.URL)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
+
+func TestMutualTLSVerifyServer(t *testing.T) {
+	secretData := newTestSecretData(t)
+	var serverCredentials serviceCredentials
+	require.NoError(t, serverCredentials.update(secretData))
+	serverConfig, err := MutualTLSInfo{}.newServerTLSConfig(&serverCredentials)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = serverConfig
+	server.StartTLS()
+	defer server.Close()
+
+	var clientCredentials serviceCredentials
+	require.NoError(t, clientCredentials.update(secretData))
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: newClientTLSConfig(&clientCredentials)}}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	// the server is no longer trusted once the credentials are replaced by those of another CA
+	require.NoError(t, clientCredentials.update(newTestSecretData(t)))
+	client.CloseIdleConnections()
+	_, err = client.Get(server.URL)
+	require.Error(t, err)
+}
+
+func TestMutualTLSInfoNewServerTLSConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		clientAuth         string
+		expectedClientAuth tls.ClientAuthType
+		expectedError      bool
+	}{
+		{"valid - default", "", tls.RequireAndVerifyClientCert, false},
+		{"valid - require", ClientAuthRequire, tls.RequireAndVerifyClientCert, false},
+		{"valid - verify if given", ClientAuthVerifyIfGiven, tls.VerifyClientCertIfGiven, false},
+		{"invalid - unsupported", "none", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlsConfig, err := MutualTLSInfo{ClientAuth: tt.clientAuth}.newServerTLSConfig(&serviceCredentials{})
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedClientAuth, tlsConfig.ClientAuth)
+		})
+	}
+}
+
+func TestServiceCredentialsUpdateInvalid(t *testing.T) {
+	secretData := newTestSecretData(t)
+	noCA := *secretData
+	noCA.CaPemBlock = nil
+	invalidKey := *secretData
+	invalidKey.KeyPemBlock = newTestSecretData(t).KeyPemBlock
+
+	var credentials serviceCredentials
+	assert.Error(t, credentials.update(&noCA))
+	assert.Error(t, credentials.update(&invalidKey))
+	assert.Nil(t, credentials.certificate)
+}
+
+func TestWithClientTLSConfig(t *testing.T) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	transport := &http.Transport{}
+
+	configured, ok := withClientTLSConfig(transport, config).(*http.Transport)
+	require.True(t, ok)
+	assert.Same(t, config, configured.TLSClientConfig)
+	assert.NotSame(t, transport, configured, "the transport must be cloned")
+
+	tracer, err := tracing.NewTracer(tracing.Info{Enabled: true, Endpoint: "http://localhost:4318/v1/traces"}, "core-data", logger.NewMockClient())
+	require.NoError(t, err)
+	traced := tracer.Transport(transport)
+	configuredTraced, ok := withClientTLSConfig(traced, config).(*tracing.Transport)
+	require.True(t, ok)
+	assert.NotSame(t, traced, configuredTraced, "the tracing transport must be cloned")
+	assert.Same(t, config, configuredTraced.Base.(*http.Transport).TLSClientConfig)
+	assert.Same(t, transport, traced.Base)
+}
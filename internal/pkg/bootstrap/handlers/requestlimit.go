@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// RequestLimitMiddleware limits the size of the request bodies of unknown length, i.e. chunked, to sizeLimit kilobytes,
+// 0 for unlimited, like Service.MaxRequestSize. The http server of go-mod-bootstrap only rejects the requests whose
+// Content-Length exceeds Service.MaxRequestSize, so the bodies of unknown length are read up to the limit before the
+// request is served, and the requests exceeding it are rejected with 413 Request Entity Too Large.
+func RequestLimitMiddleware(sizeLimit int64, lc logger.LoggingClient) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		if sizeLimit <= 0 {
+			return next
+		}
+		limit := sizeLimit * 1024
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength >= 0 || r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, limit+1))
+			if err != nil {
+				edgexErr := errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the request body", err)
+				utils.WriteErrorResponse(w, r.Context(), lc, edgexErr, "")
+				return
+			}
+			if int64(len(body)) > limit {
+				edgexErr := errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("request size exceed Service.MaxRequestSize(%d KB)", sizeLimit), nil)
+				utils.WriteErrorResponse(w, r.Context(), lc, edgexErr, "")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
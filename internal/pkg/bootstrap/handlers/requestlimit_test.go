@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLimitMiddleware(t *testing.T) {
+	smallBody := bytes.Repeat([]byte("a"), 1024)
+	largeBody := bytes.Repeat([]byte("a"), 1025)
+
+	tests := []struct {
+		name               string
+		sizeLimit          int64
+		body               []byte
+		chunked            bool
+		expectedStatusCode int
+	}{
+		{"valid - chunked body within the limit", 1, smallBody, true, http.StatusOK},
+		{"valid - chunked body, unlimited", 0, largeBody, true, http.StatusOK},
+		{"valid - body of known length, limited by the http server", 1, largeBody, false, http.StatusOK},
+		{"invalid - chunked body exceeding the limit", 1, largeBody, true, http.StatusRequestEntityTooLarge},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var received []byte
+			handler := RequestLimitMiddleware(testCase.sizeLimit, logger.NewMockClient())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var err error
+				received, err = io.ReadAll(r.Body)
+				require.NoError(t, err)
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req, err := http.NewRequest(http.MethodPost, "/api/v3/event", bytes.NewReader(testCase.body))
+			require.NoError(t, err)
+			if testCase.chunked {
+				req.ContentLength = -1
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Code)
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testCase.body, received, "the body should be passed on")
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+
+	bootstrapHandlers "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/handlers"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+
+	"github.com/gorilla/mux"
+)
+
+// StreamMiddleware serves the stream routes, i.e. the WebSocket and server-sent events streams, without the middlewares
+// the http server adds to the router once the routes are loaded: the http.TimeoutHandler of the request timeout would
+// cut the streams, and its response doesn't implement http.Flusher nor http.Hijacker. The CORS headers of the stream
+// routes are still processed. It must be the last middleware the service uses, so that it runs right before those of
+// the http server.
+func StreamMiddleware(corsInfo bootstrapConfig.CORSConfigurationInfo, routes ...string) mux.MiddlewareFunc {
+	streamRoutes := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		streamRoutes[route] = struct{}{}
+	}
+	cors := bootstrapHandlers.ProcessCORS(corsInfo)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if current := mux.CurrentRoute(r); current != nil {
+				if template, err := current.GetPathTemplate(); err == nil {
+					if _, isStream := streamRoutes[template]; isStream {
+						cors(current.GetHandler()).ServeHTTP(w, r)
+						return
+					}
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamMiddleware(t *testing.T) {
+	corsInfo := bootstrapConfig.CORSConfigurationInfo{EnableCORS: true, CORSAllowedOrigin: "https://localhost"}
+
+	flushable := func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := w.(http.Flusher); !ok {
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v3/event/stream", flushable)
+	router.HandleFunc("/api/v3/reading/subscription/id/{id}/stream", flushable)
+	router.HandleFunc("/api/v3/event/all", flushable)
+	router.Use(StreamMiddleware(corsInfo, "/api/v3/event/stream", "/api/v3/reading/subscription/id/{id}/stream"))
+	// like the http server, whose response writer doesn't implement http.Flusher
+	router.Use(func(next http.Handler) http.Handler {
+		return http.TimeoutHandler(next, time.Second, "HTTP request timeout")
+	})
+
+	tests := []struct {
+		name               string
+		path               string
+		expectedStatusCode int
+	}{
+		{"stream", "/api/v3/event/stream", http.StatusOK},
+		{"stream with path variable", "/api/v3/reading/subscription/id/1/stream", http.StatusOK},
+		{"not a stream", "/api/v3/event/all", http.StatusNotImplemented},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, testCase.path, http.NoBody)
+			req.Header.Set("Origin", "https://localhost")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Code)
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, corsInfo.CORSAllowedOrigin, recorder.Header().Get("Access-Control-Allow-Origin"))
+			}
+		})
+	}
+}
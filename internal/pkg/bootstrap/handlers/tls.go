@@ -0,0 +1,221 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+)
+
+// TLSInfo contains the TLS settings of the external MQTT connection which complement ExternalMQTT.AuthMode.
+type TLSInfo struct {
+	// MinVersion is the minimum TLS version, "1.2" or "1.3", defaults to "1.2"
+	MinVersion string
+	// MaxVersion is the maximum TLS version, "1.2" or "1.3", defaults to the highest version supported
+	MaxVersion string
+	// CipherSuites lists the names of the cipher suites enabled for TLS 1.2, i.e. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+	// Defaults to the cipher suites of the crypto/tls package. TLS 1.3 cipher suites are not configurable.
+	CipherSuites []string
+	// PinnedCAFingerprints lists the hex encoded SHA-256 fingerprints of the CA certificates of which at least one
+	// must be part of the broker's certificate chain. When SkipCertVerify is set, the broker must send the pinned CA
+	// certificate issuing its certificate.
+	PinnedCAFingerprints []string
+	// ReloadInterval is how often the client certificate and CA are reloaded from the secret store, i.e. 1h.
+	// The certificates are also reloaded whenever the secret is updated. Empty disables the periodic reload.
+	ReloadInterval string
+}
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// newTLSConfig returns the TLS configuration with the versions, cipher suites and CA pinning of TLSInfo applied
+func (info TLSInfo) newTLSConfig(skipCertVerify bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		// nolint: gosec
+		InsecureSkipVerify: skipCertVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if info.MinVersion != "" {
+		version, ok := tlsVersions[info.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TLS MinVersion '%s'", info.MinVersion)
+		}
+		tlsConfig.MinVersion = version
+	}
+	if info.MaxVersion != "" {
+		version, ok := tlsVersions[info.MaxVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TLS MaxVersion '%s'", info.MaxVersion)
+		}
+		if version < tlsConfig.MinVersion {
+			return nil, fmt.Errorf("TLS MaxVersion '%s' is lower than MinVersion", info.MaxVersion)
+		}
+		tlsConfig.MaxVersion = version
+	}
+
+	if len(info.CipherSuites) > 0 {
+		supported := make(map[string]uint16)
+		for _, suite := range tls.CipherSuites() {
+			supported[suite.Name] = suite.ID
+		}
+		for _, name := range info.CipherSuites {
+			id, ok := supported[name]
+			if !ok {
+				return nil, fmt.Errorf("unsupported or insecure TLS cipher suite '%s'", name)
+			}
+			tlsConfig.CipherSuites = append(tlsConfig.CipherSuites, id)
+		}
+	}
+
+	if len(info.PinnedCAFingerprints) > 0 {
+		pinned := make(map[string]bool)
+		for _, fingerprint := range info.PinnedCAFingerprints {
+			pinned[normalizeFingerprint(fingerprint)] = true
+		}
+		tlsConfig.VerifyPeerCertificate = verifyPinnedCA(pinned)
+	}
+
+	return tlsConfig, nil
+}
+
+// verifyPinnedCA returns the function verifying that the broker's certificate chain contains one of the pinned CA
+// certificates. The verified chains are checked, unless certificate verification is skipped, in which case the
+// broker's certificate must be issued by one of the pinned CA certificates it sends, the other certificates it sends
+// being the intermediates.
+func verifyPinnedCA(pinned map[string]bool) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		if len(verifiedChains) == 0 {
+			if verifyChainToPinnedCA(rawCerts, pinned) {
+				return nil
+			}
+		}
+		for _, chain := range verifiedChains {
+			for i := 1; i < len(chain); i++ {
+				if pinned[fingerprint(chain[i].Raw)] {
+					return nil
+				}
+			}
+		}
+		return errors.New("certificate of the MQTT broker is not issued by a pinned CA")
+	}
+}
+
+// verifyChainToPinnedCA verifies the leaf certificate of the unverified chain sent by the broker against the pinned CA
+// certificates of the chain only, as the mere presence of a pinned CA certificate, which is public, proves nothing
+func verifyChainToPinnedCA(rawCerts [][]byte, pinned map[string]bool) bool {
+	if len(rawCerts) < 2 {
+		return false
+	}
+	leaf, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return false
+	}
+	roots := x509.NewCertPool()
+	intermediates := x509.NewCertPool()
+	hasRoot := false
+	for _, raw := range rawCerts[1:] {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return false
+		}
+		if pinned[fingerprint(raw)] {
+			roots.AddCert(cert)
+			hasRoot = true
+		} else {
+			intermediates.AddCert(cert)
+		}
+	}
+	if !hasRoot {
+		return false
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates})
+	return err == nil
+}
+
+func fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeFingerprint accepts fingerprints in upper or lower case, optionally separated by colons
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+}
+
+// brokerCredentials holds the credentials loaded from the secret store, so they can be replaced when they are rotated
+// without recreating the MQTT client.
+type brokerCredentials struct {
+	mutex       sync.RWMutex
+	username    string
+	password    string
+	certificate *tls.Certificate
+	rootCAs     *x509.CertPool
+}
+
+// update replaces the credentials with those of the secret data
+func (c *brokerCredentials) update(authMode string, secretData *messaging.SecretData) error {
+	var certificate *tls.Certificate
+	if authMode == messaging.AuthModeCert {
+		cert, err := tls.X509KeyPair(secretData.CertPemBlock, secretData.KeyPemBlock)
+		if err != nil {
+			return fmt.Errorf("failed to parse public/private key pair: %s", err.Error())
+		}
+		certificate = &cert
+	}
+
+	var rootCAs *x509.CertPool
+	if len(secretData.CaPemBlock) > 0 {
+		rootCAs = x509.NewCertPool()
+		if ok := rootCAs.AppendCertsFromPEM(secretData.CaPemBlock); !ok {
+			return errors.New("error parsing CA PEM block")
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.username = secretData.Username
+	c.password = secretData.Password
+	c.certificate = certificate
+	c.rootCAs = rootCAs
+	return nil
+}
+
+// credentialsProvider returns the current username and password
+func (c *brokerCredentials) credentialsProvider() (string, string) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.username, c.password
+}
+
+// getClientCertificate returns the current client certificate, an empty certificate is sent when there is none
+func (c *brokerCredentials) getClientCertificate(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.certificate == nil {
+		return &tls.Certificate{}, nil
+	}
+	return c.certificate, nil
+}
+
+// apply returns a copy of the TLS configuration using the current CA
+func (c *brokerCredentials) apply(tlsConfig *tls.Config) *tls.Config {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	applied := tlsConfig.Clone()
+	applied.RootCAs = c.rootCAs
+	return applied
+}
@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSInfoNewTLSConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		info               TLSInfo
+		expectedMinVersion uint16
+		expectedMaxVersion uint16
+		expectedCiphers    []uint16
+		expectedError      bool
+	}{
+		{"valid - defaults", TLSInfo{}, tls.VersionTLS12, 0, nil, false},
+		{"valid - TLS 1.3 only", TLSInfo{MinVersion: "1.3", MaxVersion: "1.3"}, tls.VersionTLS13, tls.VersionTLS13, nil, false},
+		{"valid - cipher suites", TLSInfo{CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}},
+			tls.VersionTLS12, 0, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}, false},
+		{"invalid - unsupported version", TLSInfo{MinVersion: "1.0"}, 0, 0, nil, true},
+		{"invalid - max version lower than min version", TLSInfo{MinVersion: "1.3", MaxVersion: "1.2"}, 0, 0, nil, true},
+		{"invalid - insecure cipher suite", TLSInfo{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}}, 0, 0, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlsConfig, err := tt.info.newTLSConfig(false)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMinVersion, tlsConfig.MinVersion)
+			assert.Equal(t, tt.expectedMaxVersion, tlsConfig.MaxVersion)
+			assert.Equal(t, tt.expectedCiphers, tlsConfig.CipherSuites)
+			assert.Nil(t, tlsConfig.VerifyPeerCertificate)
+		})
+	}
+}
+
+// testCertificates returns the DER encoded leaf and CA certificates of the secret data
+func testCertificates(t *testing.T, secretData *messaging.SecretData) ([]byte, []byte) {
+	leaf, _ := pem.Decode(secretData.CertPemBlock)
+	require.NotNil(t, leaf)
+	ca, _ := pem.Decode(secretData.CaPemBlock)
+	require.NotNil(t, ca)
+	return leaf.Bytes, ca.Bytes
+}
+
+func TestVerifyPinnedCA(t *testing.T) {
+	leaf, pinnedCA := testCertificates(t, newTestSecretData(t))
+	otherLeaf, otherCA := testCertificates(t, newTestSecretData(t))
+
+	tlsConfig, err := TLSInfo{PinnedCAFingerprints: []string{strings.ToUpper(fingerprint(pinnedCA))}}.newTLSConfig(true)
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig.VerifyPeerCertificate)
+
+	tests := []struct {
+		name          string
+		rawCerts      [][]byte
+		expectedError bool
+	}{
+		{"valid - issued by the pinned CA", [][]byte{leaf, pinnedCA}, false},
+		{"valid - other certificates sent", [][]byte{leaf, otherCA, pinnedCA}, false},
+		{"invalid - pinned CA not sent", [][]byte{leaf, otherCA}, true},
+		{"invalid - pinned CA appended to a chain of another CA", [][]byte{otherLeaf, otherCA, pinnedCA}, true},
+		{"invalid - pinned CA appended to another leaf", [][]byte{otherLeaf, pinnedCA}, true},
+		// the leaf certificate itself is not a CA
+		{"invalid - pinned CA as leaf", [][]byte{pinnedCA}, true},
+		{"invalid - malformed certificate", [][]byte{[]byte("leaf certificate"), pinnedCA}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tlsConfig.VerifyPeerCertificate(tt.rawCerts, nil)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestBrokerCredentialsUpdate(t *testing.T) {
+	credentials := &brokerCredentials{}
+
+	err := credentials.update(messaging.AuthModeUsernamePassword, &messaging.SecretData{Username: "user", Password: "secret"})
+	require.NoError(t, err)
+	username, password := credentials.credentialsProvider()
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "secret", password)
+
+	// invalid credentials don't replace the current ones
+	err = credentials.update(messaging.AuthModeCert, &messaging.SecretData{CertPemBlock: []byte("invalid"), KeyPemBlock: []byte("invalid")})
+	require.Error(t, err)
+	err = credentials.update(messaging.AuthModeCA, &messaging.SecretData{CaPemBlock: []byte("invalid")})
+	require.Error(t, err)
+	username, _ = credentials.credentialsProvider()
+	assert.Equal(t, "user", username)
+
+	certificate, err := credentials.getClientCertificate(nil)
+	require.NoError(t, err)
+	assert.Empty(t, certificate.Certificate)
+}
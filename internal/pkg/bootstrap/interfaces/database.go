@@ -14,10 +14,23 @@
 
 package interfaces
 
-import "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+)
 
 // Database interface provides an abstraction for obtaining the database configuration information.
 type Database interface {
 	// GetDatabaseInfo returns a database information.
 	GetDatabaseInfo() config.Database
+	// GetDatabasePoolInfo returns the configuration of the database connection pool.
+	GetDatabasePoolInfo() db.PoolInfo
+}
+
+// DatabaseReplica interface is implemented by the configurations of the services sending their queries to a read-only
+// replica of the database.
+type DatabaseReplica interface {
+	// GetDatabaseReplicaInfo returns the location of the read-only replica.
+	GetDatabaseReplicaInfo() db.ReplicaInfo
 }
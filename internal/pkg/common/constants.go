@@ -0,0 +1,349 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+)
+
+// Routes which are not yet provided by go-mod-core-contracts
+const (
+	ApiDeviceCommandsRoute = common.ApiDeviceRoute + "/commands"
+
+	ApiCommandResultRoute        = common.ApiBase + "/commandresult"
+	ApiCommandResultByJobIdRoute = ApiCommandResultRoute + "/{" + JobId + "}"
+
+	ApiEventCountIntervalRoute     = common.ApiEventCountRoute + "/" + common.Interval
+	ApiEventExportByTimeRangeRoute = common.ApiEventRoute + "/" + Export + "/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+	ApiEventByParentIdRoute        = common.ApiEventRoute + "/" + Parent + "/" + common.Id + "/{" + common.Id + "}"
+	ApiEventLineageByIdRoute       = common.ApiEventRoute + "/" + Lineage + "/" + common.Id + "/{" + common.Id + "}"
+	ApiEventStreamRoute            = common.ApiEventRoute + "/" + Stream
+
+	ApiReadingAggregateRoute                                        = common.ApiReadingRoute + "/" + Aggregate
+	ApiReadingStatsRoute                                            = common.ApiReadingRoute + "/" + Stats
+	ApiReadingParquetExportByTimeRangeRoute                         = common.ApiReadingRoute + "/" + Export + "/" + Parquet + "/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+	ApiReadingParquetExportJobByIdRoute                             = common.ApiReadingRoute + "/" + Export + "/" + Parquet + "/" + Job + "/{" + JobId + "}"
+	ApiReadingSubscriptionRoute                                     = common.ApiReadingRoute + "/" + Subscription
+	ApiAllReadingSubscriptionsRoute                                 = ApiReadingSubscriptionRoute + "/" + common.All
+	ApiReadingSubscriptionByIdRoute                                 = ApiReadingSubscriptionRoute + "/" + common.Id + "/{" + common.Id + "}"
+	ApiReadingSubscriptionStreamByIdRoute                           = ApiReadingSubscriptionByIdRoute + "/" + Stream
+	ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute = ApiReadingAggregateRoute + "/" + common.Device + "/" + common.Name + "/{" + common.Name + "}/" + common.ResourceName + "/{" + common.ResourceName + "}/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+
+	ApiBundleRoute = common.ApiBase + "/" + Bundle
+	ApiBackupRoute = common.ApiBase + "/" + Backup
+
+	ApiGraphQLRoute = common.ApiBase + "/" + GraphQL
+
+	ApiDeviceProfileVersionsByNameRoute           = common.ApiDeviceProfileByNameRoute + "/" + Versions
+	ApiDeviceProfileVersionByNameAndVersionRoute  = common.ApiDeviceProfileByNameRoute + "/" + Version + "/{" + Version + "}"
+	ApiDeviceProfileRollbackByNameAndVersionRoute = ApiDeviceProfileVersionByNameAndVersionRoute + "/" + Rollback
+	ApiDeviceProfileValidateRoute                 = common.ApiDeviceProfileRoute + "/" + Validate
+
+	ApiUnitsOfMeasureValidateRoute = common.ApiUnitsOfMeasureRoute + "/" + Validate
+
+	ApiDeviceGroupRoute                = common.ApiBase + "/" + DeviceGroup
+	ApiAllDeviceGroupRoute             = ApiDeviceGroupRoute + "/" + common.All
+	ApiDeviceGroupByNameRoute          = ApiDeviceGroupRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiDeviceByGroupNameRoute          = common.ApiDeviceRoute + "/" + Group + "/" + common.Name + "/{" + common.Name + "}"
+	ApiDeviceGroupNameCommandNameRoute = ApiDeviceByGroupNameRoute + "/{" + common.Command + "}"
+
+	ApiDeviceTemplateRoute                  = common.ApiBase + "/" + DeviceTemplate
+	ApiAllDeviceTemplateRoute               = ApiDeviceTemplateRoute + "/" + common.All
+	ApiDeviceTemplateByNameRoute            = ApiDeviceTemplateRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiDeviceTemplateInstantiateByNameRoute = ApiDeviceTemplateByNameRoute + "/" + Instantiate
+
+	ApiProvisionWatcherDryRunRoute = common.ApiProvisionWatcherRoute + "/" + DryRun
+
+	ApiDeviceBulkRoute = common.ApiDeviceRoute + "/" + Bulk
+
+	ApiDeviceServicePollingLoadRoute = common.ApiDeviceServiceRoute + "/" + PollingLoad
+
+	ApiChangeFeedRoute = common.ApiBase + "/" + ChangeFeed
+
+	ApiSystemEventHistoryRoute       = common.ApiBase + "/" + SystemEventHistory
+	ApiSystemEventHistoryReplayRoute = ApiSystemEventHistoryRoute + "/" + Replay
+
+	ApiDeviceByAttributeRoute = common.ApiDeviceRoute + "/" + Attribute + "/{" + Key + "}/{" + Value + "}"
+
+	ApiDeviceByLifecycleStateRoute     = common.ApiDeviceRoute + "/" + Lifecycle + "/{" + State + "}"
+	ApiDeviceLifecycleStateByNameRoute = common.ApiDeviceByNameRoute + "/" + Lifecycle + "/{" + State + "}"
+
+	ApiDeviceByLocationRadiusRoute = common.ApiDeviceRoute + "/" + Location + "/" + Radius
+	ApiDeviceByLocationBoxRoute    = common.ApiDeviceRoute + "/" + Location + "/" + Box
+
+	ApiOrphanRoute       = common.ApiBase + "/" + Orphan
+	ApiOrphanRepairRoute = ApiOrphanRoute + "/" + Repair
+
+	ApiEscalationPolicyRoute       = common.ApiBase + "/" + EscalationPolicy
+	ApiAllEscalationPolicyRoute    = ApiEscalationPolicyRoute + "/" + common.All
+	ApiEscalationPolicyByNameRoute = ApiEscalationPolicyRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiNotificationAcknowledgementByIdRoute = common.ApiNotificationByIdRoute + "/" + Acknowledgement
+	ApiUnacknowledgedNotificationRoute      = common.ApiNotificationRoute + "/" + Unacknowledged
+
+	ApiNotificationTemplateRoute       = common.ApiBase + "/" + NotificationTemplate
+	ApiAllNotificationTemplateRoute    = ApiNotificationTemplateRoute + "/" + common.All
+	ApiNotificationTemplateByNameRoute = ApiNotificationTemplateRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiDigestRoute       = common.ApiBase + "/" + Digest
+	ApiAllDigestRoute    = ApiDigestRoute + "/" + common.All
+	ApiDigestByNameRoute = ApiDigestRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiConnectorRoute       = common.ApiBase + "/" + Connector
+	ApiAllConnectorRoute    = ApiConnectorRoute + "/" + common.All
+	ApiConnectorByNameRoute = ApiConnectorRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiSmsChannelRoute       = common.ApiBase + "/" + SmsChannel
+	ApiAllSmsChannelRoute    = ApiSmsChannelRoute + "/" + common.All
+	ApiSmsChannelByNameRoute = ApiSmsChannelRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiSmsChannelStatusRoute = ApiSmsChannelRoute + "/" + common.Status
+
+	ApiDeliveryPolicyRoute       = common.ApiBase + "/" + DeliveryPolicy
+	ApiAllDeliveryPolicyRoute    = ApiDeliveryPolicyRoute + "/" + common.All
+	ApiDeliveryPolicyByNameRoute = ApiDeliveryPolicyRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiTransmissionResendByIdRoute = common.ApiTransmissionByIdRoute + "/" + Resend
+
+	ApiDeviceCommandActionRoute       = common.ApiBase + "/" + DeviceCommandAction
+	ApiAllDeviceCommandActionRoute    = ApiDeviceCommandActionRoute + "/" + common.All
+	ApiDeviceCommandActionByNameRoute = ApiDeviceCommandActionRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiMessageBusActionRoute       = common.ApiBase + "/" + MessageBusAction
+	ApiAllMessageBusActionRoute    = ApiMessageBusActionRoute + "/" + common.All
+	ApiMessageBusActionByNameRoute = ApiMessageBusActionRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiJobRunRoute               = common.ApiBase + "/" + JobRun
+	ApiAllJobRunRoute            = ApiJobRunRoute + "/" + common.All
+	ApiJobRunByJobNameRoute      = ApiJobRunRoute + "/" + Job + "/" + common.Name + "/{" + common.Name + "}"
+	ApiJobRunByIntervalNameRoute = ApiJobRunRoute + "/" + common.Interval + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiJobTriggerByNameRoute = common.ApiBase + "/" + Job + "/" + common.Name + "/{" + common.Name + "}/" + Trigger
+
+	ApiCalendarRoute       = common.ApiBase + "/" + Calendar
+	ApiAllCalendarRoute    = ApiCalendarRoute + "/" + common.All
+	ApiCalendarByNameRoute = ApiCalendarRoute + "/" + common.Name + "/{" + common.Name + "}"
+
+	ApiTenantRoute                                                = common.ApiBase + "/" + Tenant + "/{" + Tenant + "}"
+	ApiTenantEventRoute                                           = ApiTenantRoute + "/event"
+	ApiTenantEventServiceNameProfileNameDeviceNameSourceNameRoute = ApiTenantEventRoute + "/{" + common.ServiceName + "}" + "/{" + common.ProfileName + "}" + "/{" + common.DeviceName + "}" + "/{" + common.SourceName + "}"
+	ApiTenantAllEventRoute                                        = ApiTenantEventRoute + "/" + common.All
+	ApiTenantEventCountRoute                                      = ApiTenantEventRoute + "/" + common.Count
+	ApiTenantReadingRoute                                         = ApiTenantRoute + "/reading"
+	ApiTenantAllReadingRoute                                      = ApiTenantReadingRoute + "/" + common.All
+	ApiTenantReadingCountRoute                                    = ApiTenantReadingRoute + "/" + common.Count
+
+	ApiAuditRoute  = common.ApiBase + "/" + Audit
+	ApiApiKeyRoute = common.ApiBase + "/" + ApiKey
+
+	ApiHealthMetricsRoute = common.ApiHealthRoute + "/" + Metrics
+)
+
+// Headers which are not yet provided by go-mod-core-contracts
+const (
+	// ApiKeyHeader is the header carrying the API key of the requests authenticated with an API key instead of a JWT
+	ApiKeyHeader = "X-Api-Key"
+	// NextCursorHeader is the header of the event and reading query responses carrying the opaque token of the cursor
+	// query parameter requesting the next page, it's absent from the last page
+	NextCursorHeader = "X-Next-Cursor"
+)
+
+// Content types which are not yet provided by go-mod-core-contracts
+const (
+	ContentTypeNDJSON      = "application/x-ndjson"
+	ContentTypeEventStream = "text/event-stream"
+	ContentTypeForm        = "application/x-www-form-urlencoded"
+	// ContentTypePrometheusText is the content type of the Prometheus text exposition format
+	ContentTypePrometheusText = "text/plain; version=0.0.4"
+)
+
+// Content encodings of the MessageEnvelope payloads, which are not yet provided by go-mod-messaging
+const (
+	// ContentEncoding is the MessageEnvelope query parameter carrying the encoding the payload is compressed with,
+	// the payload isn't compressed when absent
+	ContentEncoding        = "contentEncoding"
+	ContentEncodingGzip    = "gzip"
+	ContentEncodingDeflate = "deflate"
+)
+
+// MessageBus topics which are not yet provided by go-mod-core-contracts
+const (
+	CoreCommandBatchRequestSubscribeTopic = "core/commandbatch/request"
+	CoreCommandResultPublishTopic         = "core/commandresult"
+	// CoreDataQuarantinePublishTopic is the topic core-data publishes the readings violating the validation rules to
+	CoreDataQuarantinePublishTopic = "quarantine"
+	// CoreDataSchemaViolationPublishTopic is the topic core-data publishes the reports of the events rejected because
+	// they don't conform to their device profile to
+	CoreDataSchemaViolationPublishTopic = "schemaviolation"
+	// CoreDataLateDataPublishTopic is the topic core-data routes the events outside the lateness window to
+	CoreDataLateDataPublishTopic = "latedata"
+	// CoreDataReadingSubscriptionPublishTopic is the topic core-data publishes the readings matching a reading
+	// subscription to, followed by the id of the subscription
+	CoreDataReadingSubscriptionPublishTopic = "core/readingsubscription"
+	// DeviceServiceHeartbeatPublishTopic is the topic the device services publish their heartbeat to, followed by the
+	// name of the device service
+	DeviceServiceHeartbeatPublishTopic = "heartbeat"
+)
+
+// Query parameters which are not yet provided by go-mod-core-contracts
+const (
+	// CommandTimeout is the query parameter used to request the timeout of a device command, e.g. cmd-timeout=30s
+	CommandTimeout = "cmd-timeout"
+	// CommandAsync is the query parameter used to issue a device command asynchronously, e.g. cmd-async=true
+	CommandAsync = "cmd-async"
+	// MaxDepth is the query parameter used to limit the depth of the event lineage traversed, e.g. maxDepth=3
+	MaxDepth = "maxDepth"
+	// CommandContinuation is the query parameter of the command query response carrying the token used to request the
+	// next part of the commands, e.g. cmd-continuation=eyJvZmZzZXQiOjIwLCJsaW1pdCI6LTF9
+	CommandContinuation = "cmd-continuation"
+	// Mode is the query parameter of the bulk device operations selecting all-or-nothing or best-effort semantics,
+	// e.g. mode=bestEffort
+	Mode = "mode"
+	// Cursor is the query parameter of the change feed carrying the sequence of the last change already read,
+	// e.g. cursor=42. It is also the query parameter of the event and reading queries carrying the opaque token of
+	// the NextCursorHeader of the previous page.
+	Cursor = "cursor"
+	// Cascade is the query parameter of the device service and device profile deletions which also deletes the devices
+	// and provision watchers using them, e.g. cascade=true
+	Cascade = "cascade"
+	// Latitude, Longitude and Radius are the query parameters of the device query by distance from a position, the
+	// radius being in meters, e.g. latitude=48.85&longitude=2.35&radius=500
+	Latitude  = "latitude"
+	Longitude = "longitude"
+	Radius    = "radius"
+	// MinLatitude, MinLongitude, MaxLatitude and MaxLongitude are the query parameters of the device query by
+	// bounding box
+	MinLatitude  = "minLatitude"
+	MinLongitude = "minLongitude"
+	MaxLatitude  = "maxLatitude"
+	MaxLongitude = "maxLongitude"
+	// Category, Subject and Outcome are the query parameters of the audit record query, e.g. category=authorization
+	Category = "category"
+	Subject  = "subject"
+	Outcome  = "outcome"
+	// Unit is the query parameter of the units of measure validation, repeated for each unit validated,
+	// e.g. unit=C&unit=kW
+	Unit = "unit"
+)
+
+// Modes of the bulk device operations
+const (
+	// BulkModeAtomic applies either all the devices of the request or none of them
+	BulkModeAtomic = "atomic"
+	// BulkModeBestEffort applies each device of the request independently
+	BulkModeBestEffort = "bestEffort"
+)
+
+// URL parameter names which are not yet provided by go-mod-core-contracts
+const (
+	JobId = "jobId"
+	// Tenant is the name of the tenant of the events and readings. It is also the MessageEnvelope query parameter
+	// carrying the tenant of the event published, and the tag of the events and readings of a tenant.
+	Tenant = "tenant"
+	// Version is the version of a device profile revision
+	Version = "version"
+	// Key and Value are the key and the value of a device attribute
+	Key   = "key"
+	Value = "value"
+	// State is the lifecycle state of a device
+	State = "state"
+)
+
+// Tags of the events which are not yet provided by go-mod-core-contracts
+const (
+	// ParentEventIds is the tag of the events derived from other events, i.e. aggregation or transformation results,
+	// its value is the id of the parent event or an array of the ids of the parent events
+	ParentEventIds = "parentEventIds"
+	// ProfileVersion is the tag of the device profile system events, and of the events produced against a versioned
+	// device profile, its value is the version of the device profile revision
+	ProfileVersion = "profileVersion"
+)
+
+// Route path segments which are not yet provided by go-mod-core-contracts
+const (
+	Export               = "export"
+	Parquet              = "parquet"
+	Aggregate            = "aggregate"
+	Subscription         = "subscription"
+	Stream               = "stream"
+	Stats                = "stats"
+	Parent               = "parent"
+	Lineage              = "lineage"
+	Bundle               = "bundle"
+	Backup               = "backup"
+	Versions             = "versions"
+	Rollback             = "rollback"
+	DeviceGroup          = "devicegroup"
+	Group                = "group"
+	DryRun               = "dryrun"
+	Bulk                 = "bulk"
+	ChangeFeed           = "changefeed"
+	SystemEventHistory   = "systemeventhistory"
+	Replay               = "replay"
+	Attribute            = "attribute"
+	Validate             = "validate"
+	Orphan               = "orphan"
+	Repair               = "repair"
+	Lifecycle            = "lifecycle"
+	Location             = "location"
+	Box                  = "box"
+	DeviceTemplate       = "devicetemplate"
+	Instantiate          = "instantiate"
+	PollingLoad          = "pollingload"
+	EscalationPolicy     = "escalationpolicy"
+	Acknowledgement      = "acknowledgement"
+	Unacknowledged       = "unacknowledged"
+	NotificationTemplate = "notificationtemplate"
+	Digest               = "digest"
+	Connector            = "connector"
+	SmsChannel           = "smschannel"
+	DeliveryPolicy       = "deliverypolicy"
+	Resend               = "resend"
+	DeviceCommandAction  = "devicecommandaction"
+	MessageBusAction     = "messagebusaction"
+	JobRun               = "jobrun"
+	Job                  = "job"
+	Trigger              = "trigger"
+	Calendar             = "calendar"
+	Audit                = "audit"
+	ApiKey               = "apikey"
+	GraphQL              = "graphql"
+	Metrics              = "metrics"
+)
+
+// Device properties which are not yet provided by go-mod-core-contracts
+const (
+	// DeviceAttributes is the device property carrying the searchable attributes of the device, an object whose values
+	// are strings, numbers or booleans, e.g. {"site": "plant1", "floor": 2}. The devices are indexed by attribute.
+	DeviceAttributes = "attributes"
+	// DeviceLifecycleState is the device property carrying the lifecycle state of the device, one of the
+	// DeviceLifecycleStateXXX values. The devices without it aren't managed through the lifecycle.
+	DeviceLifecycleState = "lifecycleState"
+)
+
+// Device lifecycle states, the allowed transitions between them are enforced by core-metadata
+const (
+	DeviceLifecycleStateProvisioned  = "provisioned"
+	DeviceLifecycleStateCommissioned = "commissioned"
+	DeviceLifecycleStateActive       = "active"
+	DeviceLifecycleStateMaintenance  = "maintenance"
+	DeviceLifecycleStateRetired      = "retired"
+)
+
+// System event types which are not yet provided by go-mod-core-contracts
+const (
+	// DeviceLifecycleSystemEventType is the type of the system events published when the lifecycle state of a device
+	// changes, the action of these system events being the new lifecycle state
+	DeviceLifecycleSystemEventType = "devicelifecycle"
+	// DeviceServiceLivenessSystemEventType is the type of the system events published when a device service publishing
+	// heartbeats is considered down or up again, the action of these system events being the liveness state
+	DeviceServiceLivenessSystemEventType = "deviceserviceliveness"
+)
+
+// Liveness states of the device services publishing heartbeats
+const (
+	DeviceServiceLivenessDown = "down"
+	DeviceServiceLivenessUp   = "up"
+)
@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+const (
+	// EarthRadius is the radius of the Earth in meters, the same the geospatial indexes of the database use
+	EarthRadius = 6372797.560856
+	// MaxGeoLatitude is the greatest absolute latitude the geospatial indexes of the database support
+	MaxGeoLatitude = 85.05112878
+)
+
+// DeviceGeoLocation is the structured location of a device, e.g. {"latitude": 48.85, "longitude": 2.35,
+// "altitude": 35, "site": ["paris", "plant1", "hall2"]}. The site is a hierarchy of named places, from the broadest to
+// the narrowest. The devices whose location has a latitude and a longitude are indexed by position.
+type DeviceGeoLocation struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Altitude  *float64 `json:"altitude,omitempty"`
+	Site      []string `json:"site,omitempty"`
+}
+
+// HasPosition returns whether the location has a latitude and a longitude
+func (l DeviceGeoLocation) HasPosition() bool {
+	return l.Latitude != nil && l.Longitude != nil
+}
+
+// DeviceGeoLocationFromLocation returns the structured location of a device, nil when the location of the device is
+// free-form, i.e. not an object or an object without latitude, longitude, altitude nor site. An error is returned when
+// the structured location is invalid.
+func DeviceGeoLocationFromLocation(location any) (*DeviceGeoLocation, error) {
+	object, ok := location.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	_, hasLatitude := object["latitude"]
+	_, hasLongitude := object["longitude"]
+	_, hasAltitude := object["altitude"]
+	_, hasSite := object["site"]
+	if !hasLatitude && !hasLongitude && !hasAltitude && !hasSite {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to JSON marshal device location: %w", err)
+	}
+	var result DeviceGeoLocation
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("latitude, longitude and altitude of the device location must be numbers, and site an array of strings: %w", err)
+	}
+	if (result.Latitude == nil) != (result.Longitude == nil) {
+		return nil, fmt.Errorf("latitude and longitude of the device location must be set together")
+	}
+	if result.Latitude != nil && math.Abs(*result.Latitude) > MaxGeoLatitude {
+		return nil, fmt.Errorf("latitude %v of the device location must be between -%v and %v", *result.Latitude, MaxGeoLatitude, MaxGeoLatitude)
+	}
+	if result.Longitude != nil && math.Abs(*result.Longitude) > 180 {
+		return nil, fmt.Errorf("longitude %v of the device location must be between -180 and 180", *result.Longitude)
+	}
+	for _, name := range result.Site {
+		if name == "" {
+			return nil, fmt.Errorf("site of the device location must not contain an empty name")
+		}
+	}
+	return &result, nil
+}
+
+// GeoDistance returns the great-circle distance in meters between the two positions, computed with the haversine
+// formula as the geospatial indexes of the database do
+func GeoDistance(latitude1, longitude1, latitude2, longitude2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	lat1, lat2 := toRadians(latitude1), toRadians(latitude2)
+	u := math.Sin((lat2 - lat1) / 2)
+	v := math.Sin(toRadians(longitude2-longitude1) / 2)
+	return 2 * EarthRadius * math.Asin(math.Sqrt(u*u+math.Cos(lat1)*math.Cos(lat2)*v*v))
+}
@@ -6,6 +6,9 @@
 package common
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -47,3 +50,82 @@ func ConvertStringsToInterfaces(stringArray []string) []interface{} {
 	}
 	return result
 }
+
+// ParentEventIdsFromTags returns the ids of the parent events in the ParentEventIds tag of an event, nil when the event
+// isn't derived from other events. The tag value is either an id or an array of ids, the values which aren't strings
+// are ignored.
+func ParentEventIdsFromTags(tags map[string]any) []string {
+	switch value := tags[ParentEventIds].(type) {
+	case string:
+		if value != "" {
+			return []string{value}
+		}
+	case []string:
+		return value
+	case []any:
+		ids := make([]string, 0, len(value))
+		for _, v := range value {
+			if id, ok := v.(string); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+	return nil
+}
+
+// DeviceAttributesFromProperties returns the searchable attributes carried by the DeviceAttributes property of a device,
+// with the values formatted the way they are looked up, e.g. {"site": "plant1", "floor": "2", "indoor": "true"}. An
+// error is returned when the attributes aren't an object, a key is empty or contains a colon, or a value isn't a
+// string, number or boolean.
+func DeviceAttributesFromProperties(properties map[string]any) (map[string]string, error) {
+	value, ok := properties[DeviceAttributes]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	attributes, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("device property %s must be an object, not %T", DeviceAttributes, value)
+	}
+
+	result := make(map[string]string, len(attributes))
+	for key, v := range attributes {
+		if key == "" || strings.Contains(key, ":") {
+			return nil, fmt.Errorf("device attribute key '%s' must be non-empty and must not contain a colon", key)
+		}
+		switch v := v.(type) {
+		case string:
+			result[key] = v
+		case bool:
+			result[key] = strconv.FormatBool(v)
+		case float64:
+			result[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		case int, int32, int64, uint, uint32, uint64:
+			result[key] = fmt.Sprintf("%d", v)
+		default:
+			return nil, fmt.Errorf("device attribute %s must be a string, number or boolean, not %T", key, v)
+		}
+	}
+	return result, nil
+}
+
+// DeviceLifecycleStateFromProperties returns the lifecycle state carried by the DeviceLifecycleState property of a
+// device, empty when the device has no lifecycle state. An error is returned when the lifecycle state isn't one of the
+// DeviceLifecycleStateXXX values.
+func DeviceLifecycleStateFromProperties(properties map[string]any) (string, error) {
+	value, ok := properties[DeviceLifecycleState]
+	if !ok || value == nil {
+		return "", nil
+	}
+	state, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("device property %s must be a string, not %T", DeviceLifecycleState, value)
+	}
+	switch state {
+	case DeviceLifecycleStateProvisioned, DeviceLifecycleStateCommissioned, DeviceLifecycleStateActive,
+		DeviceLifecycleStateMaintenance, DeviceLifecycleStateRetired:
+		return state, nil
+	default:
+		return "", fmt.Errorf("device lifecycle state '%s' is unknown", state)
+	}
+}
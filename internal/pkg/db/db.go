@@ -16,6 +16,7 @@ package db
 
 import (
 	"errors"
+	"time"
 )
 
 var (
@@ -37,4 +38,41 @@ type Configuration struct {
 	Username     string
 	Password     string
 	BatchSize    int
+	Pool         PoolInfo
+	// ReadOnly is set for the clients of a read-only replica of the database
+	ReadOnly bool
+}
+
+// ReplicaInfo locates a read-only replica of the database the queries are sent to, keeping the primary database free
+// for the writes
+type ReplicaInfo struct {
+	// Host of the replica, the queries are sent to the primary database when empty
+	Host string
+	Port int
+}
+
+// PoolInfo configures the connection pool of the database client
+type PoolInfo struct {
+	// MaxActive is the maximum number of connections open at the same time, 0 for no limit
+	MaxActive int
+	// MaxIdle is the maximum number of idle connections kept open, defaults to 10
+	MaxIdle int
+	// IdleTimeout closes the connections idle for longer, defaults to the database Timeout
+	IdleTimeout string
+	// MaxConnLifetime closes the connections open for longer, empty for no limit
+	MaxConnLifetime string
+	// Wait makes the requests wait for a connection when MaxActive connections are in use instead of failing
+	Wait bool
+}
+
+// PoolStats holds the statistics of the connection pool of a database client
+type PoolStats struct {
+	// InUse is the number of connections in use
+	InUse int
+	// Idle is the number of idle connections
+	Idle int
+	// WaitCount is the total number of requests which waited for a connection
+	WaitCount int64
+	// WaitDuration is the total time the requests waited for a connection
+	WaitDuration time.Duration
 }
@@ -44,61 +44,106 @@ type CoreDataClient struct {
 func NewClient(config db.Configuration, lc logger.LoggingClient) (*Client, error) {
 	var retErr error
 	once.Do(func() {
-		connectionString := fmt.Sprintf("%s:%d", config.Host, config.Port)
-		connectTimeout, err := time.ParseDuration(config.Timeout)
-		if err != nil {
-			retErr = fmt.Errorf("configured database timeout failed to parse: %v", err)
-			return
-		}
-		opts := []redis.DialOption{
-			redis.DialConnectTimeout(connectTimeout),
-		}
-		if os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" {
-			opts = append(opts, redis.DialPassword(config.Password))
-		}
-
-		dialFunc := func() (redis.Conn, error) {
-			conn, err := redis.Dial(
-				"tcp", connectionString, opts...,
-			)
-			if err == nil {
-				_, err = conn.Do("PING")
-				if err == nil {
-					return conn, nil
-				}
-			}
-
-			return nil, fmt.Errorf("could not dial Redis: %s", err)
-		}
-		// Default the batch size to 1,000 if not set
-		batchSize := 1000
-		if config.BatchSize != 0 {
-			batchSize = config.BatchSize
-		}
-		currClient = &Client{
-			Pool: &redis.Pool{
-				IdleTimeout: connectTimeout,
-				/* The current implementation processes nested structs using concurrent connections.
-				 * With the deepest nesting level being 3, three shall be the number of maximum open
-				 * idle connections in the pool, to allow reuse.
-				 * TODO: Once we have a concurrent benchmark, this should be revisited.
-				 * TODO: Longer term, once the objects are clean of external dependencies, the use
-				 * of another serializer should make this moot.
-				 */
-				MaxIdle: 10,
-				Dial:    dialFunc,
-			},
-			BatchSize:     batchSize,
-			loggingClient: lc,
-		}
+		currClient, retErr = newClient(config, lc)
 	})
 
+	if retErr != nil {
+		return nil, retErr
+	}
+
 	// Test connectivity now so don't have failures later when doing lazy connect.
 	if _, err := currClient.Pool.Dial(); err != nil {
 		return nil, err
 	}
 
-	return currClient, retErr
+	return currClient, nil
+}
+
+// NewReplicaClient returns a client of a read-only replica of the database, unlike the client returned by NewClient
+// it isn't shared
+func NewReplicaClient(config db.Configuration, lc logger.LoggingClient) (*Client, error) {
+	client, err := newClient(config, lc)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := client.Pool.Dial()
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.Close()
+
+	return client, nil
+}
+
+func newClient(config db.Configuration, lc logger.LoggingClient) (*Client, error) {
+	connectionString := fmt.Sprintf("%s:%d", config.Host, config.Port)
+	connectTimeout, err := time.ParseDuration(config.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("configured database timeout failed to parse: %v", err)
+	}
+	opts := []redis.DialOption{
+		redis.DialConnectTimeout(connectTimeout),
+	}
+	if os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" {
+		opts = append(opts, redis.DialPassword(config.Password))
+	}
+
+	dialFunc := func() (redis.Conn, error) {
+		conn, err := redis.Dial(
+			"tcp", connectionString, opts...,
+		)
+		if err == nil {
+			_, err = conn.Do("PING")
+			if err == nil {
+				return conn, nil
+			}
+		}
+
+		return nil, fmt.Errorf("could not dial Redis: %s", err)
+	}
+	// Default the batch size to 1,000 if not set
+	batchSize := 1000
+	if config.BatchSize != 0 {
+		batchSize = config.BatchSize
+	}
+	idleTimeout := connectTimeout
+	if config.Pool.IdleTimeout != "" {
+		idleTimeout, err = time.ParseDuration(config.Pool.IdleTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("configured database pool idle timeout failed to parse: %v", err)
+		}
+	}
+	var maxConnLifetime time.Duration
+	if config.Pool.MaxConnLifetime != "" {
+		maxConnLifetime, err = time.ParseDuration(config.Pool.MaxConnLifetime)
+		if err != nil {
+			return nil, fmt.Errorf("configured database pool max connection lifetime failed to parse: %v", err)
+		}
+	}
+	maxIdle := 10
+	if config.Pool.MaxIdle != 0 {
+		maxIdle = config.Pool.MaxIdle
+	}
+	return &Client{
+		Pool: &redis.Pool{
+			IdleTimeout:     idleTimeout,
+			MaxConnLifetime: maxConnLifetime,
+			MaxActive:       config.Pool.MaxActive,
+			Wait:            config.Pool.Wait,
+			/* The current implementation processes nested structs using concurrent connections.
+			 * With the deepest nesting level being 3, three shall be the number of maximum open
+			 * idle connections in the pool, to allow reuse.
+			 * TODO: Once we have a concurrent benchmark, this should be revisited.
+			 * TODO: Longer term, once the objects are clean of external dependencies, the use
+			 * of another serializer should make this moot.
+			 */
+			MaxIdle: maxIdle,
+			Dial:    dialFunc,
+		},
+		BatchSize:     batchSize,
+		loggingClient: lc,
+	}, nil
 }
 
 // Connect connects to Redis
@@ -106,9 +151,23 @@ func (c *Client) Connect() error {
 	return nil
 }
 
+// PoolStats returns the statistics of the connection pool
+func (c *Client) PoolStats() db.PoolStats {
+	stats := c.Pool.Stats()
+	return db.PoolStats{
+		InUse:        stats.ActiveCount - stats.IdleCount,
+		Idle:         stats.IdleCount,
+		WaitCount:    stats.WaitCount,
+		WaitDuration: stats.WaitDuration,
+	}
+}
+
 // CloseSession closes the connections to Redis
 func (c *Client) CloseSession() {
 	_ = c.Pool.Close()
+	if c != currClient {
+		return
+	}
 	currClient = nil
 	once = sync.Once{}
 }
@@ -0,0 +1,229 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package envelope negotiates the ApiVersion of the MessageEnvelope based APIs, i.e. the command requests and
+// responses and the events received from the MessageBus. The envelopes received with another ApiVersion than the
+// current one are converted to it by the Transformer of their ApiVersion, and the responses are converted back to the
+// ApiVersion of the requester, so that the clients of older or newer versions keep working.
+package envelope
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/fxamacker/cbor/v2"
+)
+
+// ApiVersionV2 is the ApiVersion of the EdgeX 2 envelopes
+const ApiVersionV2 = "v2"
+
+// apiVersionField is the JSON and CBOR name of the ApiVersion of the DTOs
+const apiVersionField = "apiVersion"
+
+// mapType decodes the CBOR maps of the payloads like the JSON objects
+var mapType = reflect.TypeOf(map[string]any(nil))
+
+// Transformer converts the envelopes of an ApiVersion other than the current one
+type Transformer interface {
+	// Upgrade converts the envelope received with the ApiVersion, and its payload, to the current ApiVersion
+	Upgrade(envelope *types.MessageEnvelope) error
+	// Downgrade converts the envelope of the current ApiVersion, and its payload, to the ApiVersion
+	Downgrade(envelope *types.MessageEnvelope) error
+}
+
+var (
+	mutex        sync.RWMutex
+	transformers = map[string]Transformer{
+		ApiVersionV2: NewApiVersionTransformer(ApiVersionV2),
+	}
+)
+
+// RegisterTransformer registers the Transformer of the envelopes of the ApiVersion, replacing the registered one
+func RegisterTransformer(apiVersion string, transformer Transformer) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	transformers[apiVersion] = transformer
+}
+
+// SupportedApiVersions returns the current ApiVersion followed by those a Transformer is registered for
+func SupportedApiVersions() []string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return supportedApiVersions()
+}
+
+func supportedApiVersions() []string {
+	apiVersions := make([]string, 0, len(transformers))
+	for apiVersion := range transformers {
+		apiVersions = append(apiVersions, apiVersion)
+	}
+	sort.Strings(apiVersions)
+	return append([]string{common.ApiVersion}, apiVersions...)
+}
+
+func transformerOf(apiVersion string) (Transformer, error) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	transformer, ok := transformers[apiVersion]
+	if !ok {
+		return nil, fmt.Errorf("api version '%s' isn't supported, the supported versions are %v", apiVersion, supportedApiVersions())
+	}
+	return transformer, nil
+}
+
+// Upgrade converts the received envelope to the current ApiVersion and returns the ApiVersion it was received with,
+// which is the ApiVersion to respond with. The envelopes without ApiVersion are considered of the current one.
+func Upgrade(envelope *types.MessageEnvelope) (string, error) {
+	apiVersion := envelope.ApiVersion
+	if apiVersion == "" {
+		envelope.ApiVersion = common.ApiVersion
+		return common.ApiVersion, nil
+	}
+	if apiVersion == common.ApiVersion {
+		return apiVersion, nil
+	}
+	transformer, err := transformerOf(apiVersion)
+	if err != nil {
+		return "", err
+	}
+	if err = transformer.Upgrade(envelope); err != nil {
+		return "", fmt.Errorf("failed to convert the envelope of api version '%s' to '%s': %w", apiVersion, common.ApiVersion, err)
+	}
+	return apiVersion, nil
+}
+
+// Downgrade converts the envelope of the current ApiVersion to the ApiVersion
+func Downgrade(envelope *types.MessageEnvelope, apiVersion string) error {
+	if apiVersion == "" || apiVersion == common.ApiVersion {
+		return nil
+	}
+	transformer, err := transformerOf(apiVersion)
+	if err != nil {
+		return err
+	}
+	if err = transformer.Downgrade(envelope); err != nil {
+		return fmt.Errorf("failed to convert the envelope of api version '%s' to '%s': %w", common.ApiVersion, apiVersion, err)
+	}
+	return nil
+}
+
+// publisher downgrades the envelopes it publishes to the ApiVersion of the requester
+type publisher struct {
+	messaging.MessageClient
+	apiVersion string
+}
+
+// NewPublisher returns the MessageClient publishing the envelopes converted to the ApiVersion, i.e. the responses to a
+// request of the ApiVersion. The other operations are those of the MessageClient.
+func NewPublisher(client messaging.MessageClient, apiVersion string) messaging.MessageClient {
+	if apiVersion == "" || apiVersion == common.ApiVersion {
+		return client
+	}
+	return &publisher{MessageClient: client, apiVersion: apiVersion}
+}
+
+func (p *publisher) Publish(message types.MessageEnvelope, topic string) error {
+	if err := Downgrade(&message, p.apiVersion); err != nil {
+		return err
+	}
+	return p.MessageClient.Publish(message, topic)
+}
+
+// apiVersionTransformer converts the envelopes whose schema only differs by the ApiVersion of the envelope and of the
+// DTOs of the payload
+type apiVersionTransformer struct {
+	apiVersion string
+}
+
+// NewApiVersionTransformer returns the Transformer of the envelopes of the ApiVersion whose schema is the current one,
+// the ApiVersion of the envelope and of the DTOs of its JSON or CBOR payload being replaced.
+func NewApiVersionTransformer(apiVersion string) Transformer {
+	return apiVersionTransformer{apiVersion: apiVersion}
+}
+
+func (t apiVersionTransformer) Upgrade(envelope *types.MessageEnvelope) error {
+	return replaceApiVersion(envelope, t.apiVersion, common.ApiVersion)
+}
+
+func (t apiVersionTransformer) Downgrade(envelope *types.MessageEnvelope) error {
+	return replaceApiVersion(envelope, common.ApiVersion, t.apiVersion)
+}
+
+// replaceApiVersion replaces the from ApiVersion of the envelope and of the DTOs of its payload by the to ApiVersion.
+// The payloads which aren't JSON or CBOR, e.g. binary readings, are kept as is.
+func replaceApiVersion(envelope *types.MessageEnvelope, from string, to string) error {
+	envelope.ApiVersion = to
+	if len(envelope.Payload) == 0 {
+		return nil
+	}
+
+	switch envelope.ContentType {
+	case common.ContentTypeJSON:
+		decoder := json.NewDecoder(bytes.NewReader(envelope.Payload))
+		// keep the precision of the int64 values, e.g. the origin timestamps in nanoseconds
+		decoder.UseNumber()
+		var payload any
+		if err := decoder.Decode(&payload); err != nil {
+			return nil
+		}
+		if !replaceApiVersionField(payload, from, to) {
+			return nil
+		}
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		envelope.Payload = encoded
+	case common.ContentTypeCBOR:
+		decMode, err := cbor.DecOptions{DefaultMapType: mapType}.DecMode()
+		if err != nil {
+			return err
+		}
+		var payload any
+		if err := decMode.Unmarshal(envelope.Payload, &payload); err != nil {
+			return nil
+		}
+		if !replaceApiVersionField(payload, from, to) {
+			return nil
+		}
+		encoded, err := cbor.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		envelope.Payload = encoded
+	}
+	return nil
+}
+
+// replaceApiVersionField replaces the apiVersion fields of the value and of its nested values, returns whether any
+// was replaced
+func replaceApiVersionField(value any, from string, to string) bool {
+	replaced := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if key == apiVersionField {
+				if field == from {
+					v[key] = to
+					replaced = true
+				}
+				continue
+			}
+			replaced = replaceApiVersionField(field, from, to) || replaced
+		}
+	case []any:
+		for _, item := range v {
+			replaced = replaceApiVersionField(item, from, to) || replaced
+		}
+	}
+	return replaced
+}
@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package envelope
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testOrigin = 1690000000123456789
+
+type testEvent struct {
+	ApiVersion string `json:"apiVersion"`
+	Id         string `json:"id"`
+	Origin     int64  `json:"origin"`
+	Readings   []struct {
+		ApiVersion string `json:"apiVersion,omitempty"`
+		Value      string `json:"value"`
+	} `json:"readings"`
+}
+
+func testPayload(t *testing.T, contentType string, apiVersion string) []byte {
+	payload := map[string]any{
+		"apiVersion": apiVersion,
+		"id":         "event-id",
+		"origin":     int64(testOrigin),
+		"readings":   []any{map[string]any{"value": "1"}},
+	}
+	var data []byte
+	var err error
+	if contentType == common.ContentTypeCBOR {
+		data, err = cbor.Marshal(payload)
+	} else {
+		data, err = json.Marshal(payload)
+	}
+	require.NoError(t, err)
+	return data
+}
+
+func decodeTestPayload(t *testing.T, contentType string, data []byte) testEvent {
+	var event testEvent
+	if contentType == common.ContentTypeCBOR {
+		require.NoError(t, cbor.Unmarshal(data, &event))
+	} else {
+		require.NoError(t, json.Unmarshal(data, &event))
+	}
+	return event
+}
+
+func TestUpgradeDowngrade(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+	}{
+		{"JSON payload", common.ContentTypeJSON},
+		{"CBOR payload", common.ContentTypeCBOR},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope := types.MessageEnvelope{
+				ContentType: tt.contentType,
+				Payload:     testPayload(t, tt.contentType, ApiVersionV2),
+			}
+			envelope.ApiVersion = ApiVersionV2
+
+			apiVersion, err := Upgrade(&envelope)
+			require.NoError(t, err)
+			assert.Equal(t, ApiVersionV2, apiVersion)
+			assert.Equal(t, common.ApiVersion, envelope.ApiVersion)
+			event := decodeTestPayload(t, tt.contentType, envelope.Payload)
+			assert.Equal(t, common.ApiVersion, event.ApiVersion)
+			assert.Equal(t, "event-id", event.Id)
+			assert.Equal(t, int64(testOrigin), event.Origin, "the precision of the int64 values should be kept")
+			require.Len(t, event.Readings, 1)
+			assert.Empty(t, event.Readings[0].ApiVersion)
+
+			require.NoError(t, Downgrade(&envelope, apiVersion))
+			assert.Equal(t, ApiVersionV2, envelope.ApiVersion)
+			event = decodeTestPayload(t, tt.contentType, envelope.Payload)
+			assert.Equal(t, ApiVersionV2, event.ApiVersion)
+			assert.Equal(t, int64(testOrigin), event.Origin)
+		})
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	binaryPayload := []byte{0x01, 0x02, 0x03}
+
+	tests := []struct {
+		name               string
+		apiVersion         string
+		payload            []byte
+		expectedApiVersion string
+		expectedError      bool
+	}{
+		{"current api version", common.ApiVersion, testPayload(t, common.ContentTypeJSON, common.ApiVersion), common.ApiVersion, false},
+		{"without api version", "", testPayload(t, common.ContentTypeJSON, common.ApiVersion), common.ApiVersion, false},
+		{"v2 with binary payload", ApiVersionV2, binaryPayload, ApiVersionV2, false},
+		{"unsupported api version", "v1", testPayload(t, common.ContentTypeJSON, "v1"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope := types.MessageEnvelope{ContentType: common.ContentTypeJSON, Payload: tt.payload}
+			envelope.ApiVersion = tt.apiVersion
+
+			apiVersion, err := Upgrade(&envelope)
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), ApiVersionV2, "the supported versions should be listed")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedApiVersion, apiVersion)
+			assert.Equal(t, common.ApiVersion, envelope.ApiVersion)
+			assert.Equal(t, tt.payload, envelope.Payload)
+		})
+	}
+}
+
+func TestSupportedApiVersions(t *testing.T) {
+	assert.Equal(t, []string{common.ApiVersion, ApiVersionV2}, SupportedApiVersions())
+}
+
+func TestNewPublisher(t *testing.T) {
+	client := &mocks.MessageClient{}
+	client.On("Publish", mock.Anything, "response").Return(nil)
+
+	assert.Equal(t, client, NewPublisher(client, common.ApiVersion), "the client should be kept for the current api version")
+
+	envelope := types.MessageEnvelope{
+		ContentType: common.ContentTypeJSON,
+		Payload:     testPayload(t, common.ContentTypeJSON, common.ApiVersion),
+	}
+	envelope.ApiVersion = common.ApiVersion
+	require.NoError(t, NewPublisher(client, ApiVersionV2).Publish(envelope, "response"))
+
+	client.AssertNumberOfCalls(t, "Publish", 1)
+	published, ok := client.Calls[0].Arguments.Get(0).(types.MessageEnvelope)
+	require.True(t, ok)
+	assert.Equal(t, ApiVersionV2, published.ApiVersion)
+	assert.Equal(t, ApiVersionV2, decodeTestPayload(t, common.ContentTypeJSON, published.Payload).ApiVersion)
+}
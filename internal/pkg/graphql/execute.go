@@ -0,0 +1,520 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package graphql executes the GraphQL queries against a schema of object types whose fields are resolved by Go
+// functions. Only the query operations are supported, with variables, fragments and the @skip and @include
+// directives, the schema introspection isn't supported.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+const typeNameField = "__typename"
+
+// ArgumentType is the type of an argument, the arguments are coerced to the Go type of their type
+type ArgumentType string
+
+const (
+	// String arguments are coerced to string, from a string or an enum value
+	String ArgumentType = "String"
+	// Int arguments are coerced to int
+	Int ArgumentType = "Int"
+	// Boolean arguments are coerced to bool
+	Boolean ArgumentType = "Boolean"
+	// StringList arguments are coerced to []string, a single string being coerced to a list of one string
+	StringList ArgumentType = "[String]"
+)
+
+// Schema is the schema queried by the requests, which only supports the query operations
+type Schema struct {
+	Query *Object
+}
+
+// Object is an object type of the schema
+type Object struct {
+	Name   string
+	Fields map[string]*FieldDefinition
+}
+
+// FieldDefinition defines a field of an object type
+type FieldDefinition struct {
+	// Type is the object type of the values of the field, nil for the leaf fields, which are encoded as JSON
+	Type *Object
+	// List is true when the field resolves to a slice of values of its Type
+	List bool
+	// Arguments are the arguments accepted by the field
+	Arguments map[string]*ArgumentDefinition
+	// Resolve returns the value of the field from the value of its object, the arguments being coerced to their type
+	// and defaulted. When nil, the value is the entry of the map or the field of the struct named like the field,
+	// with its first letter upper-cased.
+	Resolve func(ctx context.Context, source any, args map[string]any) (any, error)
+}
+
+// ArgumentDefinition defines an argument of a field
+type ArgumentDefinition struct {
+	Type         ArgumentType
+	DefaultValue any
+	Required     bool
+}
+
+// Request is a GraphQL request, as POSTed in the JSON body of the HTTP requests
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Response is the response of a request, without data when the request fails to be parsed or validated
+type Response struct {
+	Data   any      `json:"data,omitempty"`
+	Errors []*Error `json:"errors,omitempty"`
+}
+
+// Error is an error of a response, with the path of the field which failed to be resolved
+type Error struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+// result is the result of a selection set, encoded as a JSON object keeping the order of its fields
+type result []resultField
+
+type resultField struct {
+	key   string
+	value any
+}
+
+func (r result) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for i, field := range r {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		key, _ := json.Marshal(field.key)
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// Execute executes the query operation of the request against the root value, the resolvers of the fields failing
+// resolve them to null and add their error to the response
+func (s *Schema) Execute(ctx context.Context, request Request, root any) Response {
+	document, err := Parse(request.Query)
+	if err != nil {
+		return Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+	operation, err := selectOperation(document, request.OperationName)
+	if err != nil {
+		return Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+	variables, err := coerceVariables(operation, request.Variables)
+	if err != nil {
+		return Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+	if errs := s.validate(document, operation); len(errs) > 0 {
+		return Response{Errors: errs}
+	}
+
+	e := &executor{document: document, variables: variables}
+	data := e.executeSelectionSet(ctx, s.Query, operation.SelectionSet, root, nil)
+	return Response{Data: data, Errors: e.errors}
+}
+
+func selectOperation(document *Document, operationName string) (*Operation, error) {
+	var operation *Operation
+	if operationName == "" {
+		if len(document.Operations) > 1 {
+			return nil, fmt.Errorf("the operation name is required when the document contains several operations")
+		}
+		operation = document.Operations[0]
+	} else {
+		for _, o := range document.Operations {
+			if o.Name == operationName {
+				operation = o
+				break
+			}
+		}
+		if operation == nil {
+			return nil, fmt.Errorf("unknown operation named %q", operationName)
+		}
+	}
+	if operation.Type != "query" {
+		return nil, fmt.Errorf("%s operations are not supported, only queries are", operation.Type)
+	}
+	return operation, nil
+}
+
+func coerceVariables(operation *Operation, values map[string]any) (map[string]any, error) {
+	variables := make(map[string]any)
+	for _, definition := range operation.Variables {
+		value, exists := values[definition.Name]
+		if !exists {
+			value, exists = definition.DefaultValue, definition.DefaultValue != nil
+		}
+		if !exists || value == nil {
+			if strings.HasSuffix(definition.Type, "!") {
+				return nil, fmt.Errorf("variable $%s of required type %s was not provided", definition.Name, definition.Type)
+			}
+			if !exists {
+				continue
+			}
+		}
+		variables[definition.Name] = value
+	}
+	return variables, nil
+}
+
+// validate checks the fields and the arguments of the selection sets of the operation against the schema
+func (s *Schema) validate(document *Document, operation *Operation) []*Error {
+	v := &validator{document: document, spreading: make(map[string]bool)}
+	v.validateSelectionSet(s.Query, operation.SelectionSet)
+	return v.errors
+}
+
+type validator struct {
+	document  *Document
+	spreading map[string]bool
+	errors    []*Error
+}
+
+func (v *validator) errorf(format string, args ...any) {
+	v.errors = append(v.errors, &Error{Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) validateSelectionSet(object *Object, selectionSet []Selection) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *Field:
+			v.validateField(object, selection)
+		case *FragmentSpread:
+			fragment, exists := v.document.Fragments[selection.Name]
+			if !exists {
+				v.errorf("unknown fragment %q", selection.Name)
+				continue
+			}
+			if v.spreading[selection.Name] {
+				v.errorf("cannot spread fragment %q within itself", selection.Name)
+				continue
+			}
+			if fragment.TypeCondition != object.Name {
+				v.errorf("fragment %q cannot be spread here as objects of type %q can never be of type %q", selection.Name, object.Name, fragment.TypeCondition)
+				continue
+			}
+			v.spreading[selection.Name] = true
+			v.validateSelectionSet(object, fragment.SelectionSet)
+			delete(v.spreading, selection.Name)
+		case *InlineFragment:
+			if selection.TypeCondition != "" && selection.TypeCondition != object.Name {
+				v.errorf("fragment cannot be spread here as objects of type %q can never be of type %q", object.Name, selection.TypeCondition)
+				continue
+			}
+			v.validateSelectionSet(object, selection.SelectionSet)
+		}
+	}
+}
+
+func (v *validator) validateField(object *Object, field *Field) {
+	if field.Name == typeNameField {
+		if len(field.SelectionSet) > 0 {
+			v.errorf("field %q must not have a selection since type \"String\" has no subfields", field.Name)
+		}
+		return
+	}
+	definition, exists := object.Fields[field.Name]
+	if !exists {
+		v.errorf("cannot query field %q on type %q", field.Name, object.Name)
+		return
+	}
+	provided := make(map[string]bool)
+	for _, argument := range field.Arguments {
+		if _, exists := definition.Arguments[argument.Name]; !exists {
+			v.errorf("unknown argument %q on field \"%s.%s\"", argument.Name, object.Name, field.Name)
+		}
+		provided[argument.Name] = true
+	}
+	for name, argument := range definition.Arguments {
+		if argument.Required && !provided[name] {
+			v.errorf("field \"%s.%s\" argument %q of type %s! is required, but it was not provided", object.Name, field.Name, name, argument.Type)
+		}
+	}
+	switch {
+	case definition.Type == nil && len(field.SelectionSet) > 0:
+		v.errorf("field %q must not have a selection since it is a leaf field", field.Name)
+	case definition.Type != nil && len(field.SelectionSet) == 0:
+		v.errorf("field %q of type %q must have a selection of subfields", field.Name, definition.Type.Name)
+	case definition.Type != nil:
+		v.validateSelectionSet(definition.Type, field.SelectionSet)
+	}
+}
+
+type executor struct {
+	document  *Document
+	variables map[string]any
+	errors    []*Error
+}
+
+func (e *executor) fieldError(path []any, err error) {
+	e.errors = append(e.errors, &Error{Message: err.Error(), Path: append([]any{}, path...)})
+}
+
+// executeSelectionSet resolves the fields selected on the source value of the object type, the fields of the same
+// response key being merged
+func (e *executor) executeSelectionSet(ctx context.Context, object *Object, selectionSet []Selection, source any, path []any) result {
+	var keys []string
+	fields := make(map[string][]*Field)
+	e.collectFields(selectionSet, func(field *Field) {
+		key := field.ResponseKey()
+		if _, exists := fields[key]; !exists {
+			keys = append(keys, key)
+		}
+		fields[key] = append(fields[key], field)
+	})
+
+	r := make(result, 0, len(keys))
+	for _, key := range keys {
+		field := fields[key][0]
+		if field.Name == typeNameField {
+			r = append(r, resultField{key: key, value: object.Name})
+			continue
+		}
+		var subSelectionSet []Selection
+		for _, f := range fields[key] {
+			subSelectionSet = append(subSelectionSet, f.SelectionSet...)
+		}
+		fieldPath := append(path[:len(path):len(path)], key)
+		value := e.executeField(ctx, object.Fields[field.Name], field, subSelectionSet, source, fieldPath)
+		r = append(r, resultField{key: key, value: value})
+	}
+	return r
+}
+
+// collectFields calls collect with the fields of the selection set and of its fragments which aren't skipped
+func (e *executor) collectFields(selectionSet []Selection, collect func(*Field)) {
+	for _, selection := range selectionSet {
+		if e.skipped(selection) {
+			continue
+		}
+		switch selection := selection.(type) {
+		case *Field:
+			collect(selection)
+		case *FragmentSpread:
+			e.collectFields(e.document.Fragments[selection.Name].SelectionSet, collect)
+		case *InlineFragment:
+			e.collectFields(selection.SelectionSet, collect)
+		}
+	}
+}
+
+// skipped returns true if the selection is skipped by the @skip or @include directive
+func (e *executor) skipped(selection Selection) bool {
+	for _, directive := range selection.directives() {
+		if directive.Name != "skip" && directive.Name != "include" {
+			continue
+		}
+		for _, argument := range directive.Arguments {
+			if argument.Name != "if" {
+				continue
+			}
+			value, _ := e.resolveValue(argument.Value).(bool)
+			if value == (directive.Name == "skip") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e *executor) executeField(ctx context.Context, definition *FieldDefinition, field *Field, selectionSet []Selection, source any, path []any) any {
+	args, err := e.coerceArguments(definition, field)
+	if err != nil {
+		e.fieldError(path, err)
+		return nil
+	}
+	var value any
+	if definition.Resolve != nil {
+		value, err = definition.Resolve(ctx, source, args)
+	} else {
+		value, err = defaultResolve(source, field.Name)
+	}
+	if err != nil {
+		e.fieldError(path, err)
+		return nil
+	}
+	if isNil(value) || definition.Type == nil {
+		return value
+	}
+	if !definition.List {
+		return e.executeSelectionSet(ctx, definition.Type, selectionSet, value, path)
+	}
+
+	list := reflect.ValueOf(value)
+	if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+		e.fieldError(path, fmt.Errorf("field %q resolved to a %T instead of a list", field.Name, value))
+		return nil
+	}
+	items := make([]any, list.Len())
+	for i := range items {
+		item := list.Index(i).Interface()
+		if isNil(item) {
+			continue
+		}
+		items[i] = e.executeSelectionSet(ctx, definition.Type, selectionSet, item, append(path[:len(path):len(path)], i))
+	}
+	return items
+}
+
+func (e *executor) coerceArguments(definition *FieldDefinition, field *Field) (map[string]any, error) {
+	args := make(map[string]any)
+	for name, argument := range definition.Arguments {
+		if argument.DefaultValue != nil {
+			args[name] = argument.DefaultValue
+		}
+	}
+	for _, argument := range field.Arguments {
+		if variable, ok := argument.Value.(Variable); ok {
+			if _, exists := e.variables[string(variable)]; !exists {
+				continue
+			}
+		}
+		argumentType := definition.Arguments[argument.Name].Type
+		value, err := coerce(e.resolveValue(argument.Value), argumentType)
+		if err != nil {
+			return nil, fmt.Errorf("argument %q of type %s: %w", argument.Name, argumentType, err)
+		}
+		if value == nil {
+			delete(args, argument.Name)
+			continue
+		}
+		args[argument.Name] = value
+	}
+	for name, argument := range definition.Arguments {
+		if _, exists := args[name]; argument.Required && !exists {
+			return nil, fmt.Errorf("argument %q of type %s! can not be null", name, argument.Type)
+		}
+	}
+	return args, nil
+}
+
+// resolveValue returns the value with the variables it references replaced by their value
+func (e *executor) resolveValue(value any) any {
+	switch value := value.(type) {
+	case Variable:
+		return e.variables[string(value)]
+	case []any:
+		list := make([]any, len(value))
+		for i, item := range value {
+			list[i] = e.resolveValue(item)
+		}
+		return list
+	case map[string]any:
+		object := make(map[string]any, len(value))
+		for key, item := range value {
+			object[key] = e.resolveValue(item)
+		}
+		return object
+	}
+	return value
+}
+
+// coerce converts the value, literal or decoded from the JSON variables, to the Go type of the argument type
+func coerce(value any, argumentType ArgumentType) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch argumentType {
+	case String:
+		switch value := value.(type) {
+		case string:
+			return value, nil
+		case EnumValue:
+			return string(value), nil
+		}
+	case Int:
+		switch value := value.(type) {
+		case int:
+			return value, nil
+		case float64:
+			if value == math.Trunc(value) && math.Abs(value) <= math.MaxInt32 {
+				return int(value), nil
+			}
+		}
+	case Boolean:
+		if value, ok := value.(bool); ok {
+			return value, nil
+		}
+	case StringList:
+		items, ok := value.([]any)
+		if !ok {
+			items = []any{value}
+		}
+		list := make([]string, len(items))
+		for i, item := range items {
+			s, err := coerce(item, String)
+			if err != nil || s == nil {
+				return nil, fmt.Errorf("invalid item %v", item)
+			}
+			list[i] = s.(string)
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("invalid value %v", value)
+}
+
+// defaultResolve returns the entry of the map or the field of the struct source named like the field
+func defaultResolve(source any, name string) (any, error) {
+	v := reflect.ValueOf(source)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		entry := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if !entry.IsValid() {
+			return nil, nil
+		}
+		return entry.Interface(), nil
+	case reflect.Struct:
+		fieldName := strings.ToUpper(name[:1]) + name[1:]
+		field := v.FieldByName(fieldName)
+		if !field.IsValid() || !field.CanInterface() {
+			break
+		}
+		return field.Interface(), nil
+	}
+	return nil, fmt.Errorf("no value for field %q in %T", name, source)
+}
+
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
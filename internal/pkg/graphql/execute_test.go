@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testProfile struct {
+	Name         string
+	Manufacturer string
+}
+
+type testDevice struct {
+	Name        string
+	Labels      []string
+	ProfileName string
+}
+
+var testProfiles = map[string]*testProfile{
+	"sensor": {Name: "sensor", Manufacturer: "IOTech"},
+}
+
+var testDevices = []testDevice{
+	{Name: "device1", Labels: []string{"floor1"}, ProfileName: "sensor"},
+	{Name: "device2", Labels: []string{"floor2"}, ProfileName: "sensor"},
+	{Name: "device3", Labels: []string{"floor1"}, ProfileName: "missing"},
+}
+
+func testSchema() *Schema {
+	profile := &Object{
+		Name: "DeviceProfile",
+		Fields: map[string]*FieldDefinition{
+			"name":         {},
+			"manufacturer": {},
+		},
+	}
+	device := &Object{
+		Name: "Device",
+		Fields: map[string]*FieldDefinition{
+			"name":   {},
+			"labels": {},
+			"profile": {
+				Type: profile,
+				Resolve: func(_ context.Context, source any, _ map[string]any) (any, error) {
+					p, exists := testProfiles[source.(testDevice).ProfileName]
+					if !exists {
+						return nil, errors.New("profile not found")
+					}
+					return p, nil
+				},
+			},
+		},
+	}
+	return &Schema{Query: &Object{
+		Name: "Query",
+		Fields: map[string]*FieldDefinition{
+			"device": {
+				Type:      device,
+				Arguments: map[string]*ArgumentDefinition{"name": {Type: String, Required: true}},
+				Resolve: func(_ context.Context, _ any, args map[string]any) (any, error) {
+					for _, d := range testDevices {
+						if d.Name == args["name"] {
+							return d, nil
+						}
+					}
+					return nil, nil
+				},
+			},
+			"devices": {
+				Type: device,
+				List: true,
+				Arguments: map[string]*ArgumentDefinition{
+					"labels": {Type: StringList},
+					"limit":  {Type: Int, DefaultValue: 10},
+				},
+				Resolve: func(_ context.Context, _ any, args map[string]any) (any, error) {
+					labels, _ := args["labels"].([]string)
+					var devices []testDevice
+					for _, d := range testDevices {
+						if len(devices) < args["limit"].(int) && (len(labels) == 0 || d.Labels[0] == labels[0]) {
+							devices = append(devices, d)
+						}
+					}
+					return devices, nil
+				},
+			},
+		},
+	}}
+}
+
+func executeJSON(t *testing.T, request Request) string {
+	response := testSchema().Execute(context.Background(), request, nil)
+	data, err := json.Marshal(response)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  Request
+		expected string
+	}{
+		{"field order and alias",
+			Request{Query: `{ d: device(name: "device1") { profile { manufacturer name } name __typename } }`},
+			`{"data":{"d":{"profile":{"manufacturer":"IOTech","name":"sensor"},"name":"device1","__typename":"Device"}}}`},
+		{"list with variables",
+			Request{Query: `query ($labels: [String], $limit: Int) { devices(labels: $labels, limit: $limit) { name } }`, Variables: map[string]any{"labels": []any{"floor1"}, "limit": float64(1)}},
+			`{"data":{"devices":[{"name":"device1"}]}}`},
+		{"default argument for missing variable",
+			Request{Query: `query ($limit: Int) { devices(labels: "floor1", limit: $limit) { name } }`},
+			`{"data":{"devices":[{"name":"device1"},{"name":"device3"}]}}`},
+		{"fragments merged",
+			Request{Query: `{ device(name: "device2") { ...names ... on Device { profile { manufacturer } } profile { name } } } fragment names on Device { name }`},
+			`{"data":{"device":{"name":"device2","profile":{"manufacturer":"IOTech","name":"sensor"}}}}`},
+		{"skip and include",
+			Request{Query: `query ($skip: Boolean!) { device(name: "device1") { name @skip(if: $skip) labels @include(if: false) profile { name } } }`, Variables: map[string]any{"skip": true}},
+			`{"data":{"device":{"profile":{"name":"sensor"}}}}`},
+		{"operation name",
+			Request{Query: `query A { device(name: "device1") { name } } query B { device(name: "device2") { name } }`, OperationName: "B"},
+			`{"data":{"device":{"name":"device2"}}}`},
+		{"null object",
+			Request{Query: `{ device(name: "unknown") { name } }`},
+			`{"data":{"device":null}}`},
+		{"resolver error",
+			Request{Query: `{ devices { name profile { name } } }`},
+			`{"data":{"devices":[{"name":"device1","profile":{"name":"sensor"}},{"name":"device2","profile":{"name":"sensor"}},{"name":"device3","profile":null}]},"errors":[{"message":"profile not found","path":["devices",2,"profile"]}]}`},
+		{"invalid argument",
+			Request{Query: `{ devices(limit: "ten") { name } }`},
+			`{"data":{"devices":null},"errors":[{"message":"argument \"limit\" of type Int: invalid value ten","path":["devices"]}]}`},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.JSONEq(t, testCase.expected, executeJSON(t, testCase.request))
+			// JSONEq ignores the order of the fields, which must be the order of the selection
+			assert.Equal(t, testCase.expected, executeJSON(t, testCase.request))
+		})
+	}
+}
+
+func TestExecuteRequestErrors(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         Request
+		expectedMessage string
+	}{
+		{"syntax error", Request{Query: `{ device(name: "device1") { name }`}, "syntax error"},
+		{"mutation", Request{Query: `mutation { device(name: "device1") { name } }`}, "only queries"},
+		{"ambiguous operation", Request{Query: `query A { devices { name } } query B { devices { name } }`}, "operation name is required"},
+		{"unknown operation", Request{Query: `query A { devices { name } }`, OperationName: "B"}, "unknown operation"},
+		{"unknown field", Request{Query: `{ devices { name serialNumber } }`}, `cannot query field "serialNumber" on type "Device"`},
+		{"unknown argument", Request{Query: `{ devices(first: 1) { name } }`}, `unknown argument "first"`},
+		{"missing required argument", Request{Query: `{ device { name } }`}, `argument "name" of type String! is required`},
+		{"missing selection", Request{Query: `{ devices }`}, "must have a selection"},
+		{"selection on leaf", Request{Query: `{ devices { name { first } } }`}, "must not have a selection"},
+		{"unknown fragment", Request{Query: `{ devices { ...unknown } }`}, `unknown fragment "unknown"`},
+		{"fragment cycle", Request{Query: `{ devices { ...a } } fragment a on Device { ...b } fragment b on Device { ...a }`}, "within itself"},
+		{"fragment type", Request{Query: `{ devices { ...p } } fragment p on DeviceProfile { name }`}, "can never be of type"},
+		{"missing required variable", Request{Query: `query ($name: String!) { device(name: $name) { name } }`}, "$name of required type String! was not provided"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := testSchema().Execute(context.Background(), testCase.request, nil)
+			assert.Nil(t, response.Data)
+			require.NotEmpty(t, response.Errors)
+			assert.Contains(t, response.Errors[0].Message, testCase.expectedMessage, fmt.Sprint(response.Errors))
+		})
+	}
+}
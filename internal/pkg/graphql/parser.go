@@ -0,0 +1,615 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Document is a parsed GraphQL request document
+type Document struct {
+	Operations []*Operation
+	Fragments  map[string]*Fragment
+}
+
+// Operation is an operation of a document, only the query operations are executed
+type Operation struct {
+	Type         string
+	Name         string
+	Variables    []*VariableDefinition
+	SelectionSet []Selection
+}
+
+// VariableDefinition is a variable of an operation, its Type is kept as written, e.g. [String!]!
+type VariableDefinition struct {
+	Name         string
+	Type         string
+	DefaultValue any
+}
+
+// Fragment is a named fragment of a document
+type Fragment struct {
+	Name          string
+	TypeCondition string
+	SelectionSet  []Selection
+}
+
+// Selection is a Field, a FragmentSpread or an InlineFragment of a selection set
+type Selection interface {
+	directives() []*Directive
+}
+
+type Field struct {
+	Alias        string
+	Name         string
+	Arguments    []*Argument
+	Directives   []*Directive
+	SelectionSet []Selection
+}
+
+// ResponseKey returns the key of the field in the response, its alias when it has one
+func (f *Field) ResponseKey() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+type FragmentSpread struct {
+	Name       string
+	Directives []*Directive
+}
+
+type InlineFragment struct {
+	TypeCondition string
+	Directives    []*Directive
+	SelectionSet  []Selection
+}
+
+type Directive struct {
+	Name      string
+	Arguments []*Argument
+}
+
+// Argument is an argument of a field or a directive, its Value is a string, an int, a float64, a bool, nil, an
+// EnumValue, a Variable, a []any or a map[string]any
+type Argument struct {
+	Name  string
+	Value any
+}
+
+// Variable is a reference to a variable of the operation in a value
+type Variable string
+
+// EnumValue is an enum value literal, coerced to a string
+type EnumValue string
+
+func (f *Field) directives() []*Directive          { return f.Directives }
+func (f *FragmentSpread) directives() []*Directive { return f.Directives }
+func (f *InlineFragment) directives() []*Directive { return f.Directives }
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "<EOF>"
+	}
+	return strconv.Quote(t.value)
+}
+
+// lex splits the source into its tokens, ignoring the whitespaces, commas and comments
+func lex(source string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(source) && source[i] != '\n' && source[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(source[i:], "\uFEFF"):
+			i += len("\uFEFF")
+		case strings.HasPrefix(source[i:], "..."):
+			tokens = append(tokens, token{kind: tokenPunctuator, value: "...", pos: i})
+			i += 3
+		case strings.IndexByte("!$():=@[]{}", c) >= 0:
+			tokens = append(tokens, token{kind: tokenPunctuator, value: string(c), pos: i})
+			i++
+		case c == '_' || isLetter(c):
+			start := i
+			for i < len(source) && (source[i] == '_' || isLetter(source[i]) || isDigit(source[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenName, value: source[start:i], pos: start})
+		case c == '-' || isDigit(c):
+			t, err := lexNumber(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+			i += len(t.value)
+		case c == '"':
+			t, length, err := lexString(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+			i += length
+		default:
+			r, _ := utf8.DecodeRuneInString(source[i:])
+			return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(source)}), nil
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func lexNumber(source string, start int) (token, error) {
+	i := start
+	if source[i] == '-' {
+		i++
+	}
+	digits := func() int {
+		from := i
+		for i < len(source) && isDigit(source[i]) {
+			i++
+		}
+		return i - from
+	}
+	kind := tokenInt
+	if digits() == 0 {
+		return token{}, fmt.Errorf("invalid number at position %d", start)
+	}
+	if i < len(source) && source[i] == '.' {
+		kind = tokenFloat
+		i++
+		if digits() == 0 {
+			return token{}, fmt.Errorf("invalid number at position %d", start)
+		}
+	}
+	if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+		kind = tokenFloat
+		i++
+		if i < len(source) && (source[i] == '+' || source[i] == '-') {
+			i++
+		}
+		if digits() == 0 {
+			return token{}, fmt.Errorf("invalid number at position %d", start)
+		}
+	}
+	return token{kind: kind, value: source[start:i], pos: start}, nil
+}
+
+// lexString returns the token of the string starting at start with its escape sequences decoded, and the length of
+// the string in the source
+func lexString(source string, start int) (token, int, error) {
+	var value strings.Builder
+	for i := start + 1; i < len(source); {
+		c := source[i]
+		switch {
+		case c == '"':
+			return token{kind: tokenString, value: value.String(), pos: start}, i + 1 - start, nil
+		case c == '\n' || c == '\r':
+			return token{}, 0, fmt.Errorf("unterminated string at position %d", start)
+		case c == '\\':
+			if i+1 >= len(source) {
+				return token{}, 0, fmt.Errorf("unterminated string at position %d", start)
+			}
+			escaped := map[byte]byte{'"': '"', '\\': '\\', '/': '/', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t'}
+			if e, ok := escaped[source[i+1]]; ok {
+				value.WriteByte(e)
+				i += 2
+				continue
+			}
+			if source[i+1] != 'u' || i+6 > len(source) {
+				return token{}, 0, fmt.Errorf("invalid escape sequence at position %d", i)
+			}
+			code, err := strconv.ParseUint(source[i+2:i+6], 16, 32)
+			if err != nil {
+				return token{}, 0, fmt.Errorf("invalid escape sequence at position %d", i)
+			}
+			value.WriteRune(rune(code))
+			i += 6
+		default:
+			value.WriteByte(c)
+			i++
+		}
+	}
+	return token{}, 0, fmt.Errorf("unterminated string at position %d", start)
+}
+
+type parser struct {
+	tokens []token
+	index  int
+}
+
+// Parse parses the GraphQL request document, the type system definitions aren't supported
+func Parse(source string) (*Document, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	document := &Document{Fragments: make(map[string]*Fragment)}
+	for p.peek().kind != tokenEOF {
+		t := p.peek()
+		switch {
+		case t.kind == tokenPunctuator && t.value == "{":
+			selectionSet, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			document.Operations = append(document.Operations, &Operation{Type: "query", SelectionSet: selectionSet})
+		case t.kind == tokenName && (t.value == "query" || t.value == "mutation" || t.value == "subscription"):
+			operation, err := p.parseOperation()
+			if err != nil {
+				return nil, err
+			}
+			document.Operations = append(document.Operations, operation)
+		case t.kind == tokenName && t.value == "fragment":
+			fragment, err := p.parseFragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := document.Fragments[fragment.Name]; exists {
+				return nil, fmt.Errorf("there can be only one fragment named %q", fragment.Name)
+			}
+			document.Fragments[fragment.Name] = fragment
+		default:
+			return nil, p.unexpected(t)
+		}
+	}
+	if len(document.Operations) == 0 {
+		return nil, fmt.Errorf("the document doesn't contain any operation")
+	}
+	return document, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.index]
+	if t.kind != tokenEOF {
+		p.index++
+	}
+	return t
+}
+
+func (p *parser) unexpected(t token) error {
+	return fmt.Errorf("syntax error: unexpected %s at position %d", t, t.pos)
+}
+
+// skip consumes the punctuator and returns true if it is the next token
+func (p *parser) skip(punctuator string) bool {
+	if t := p.peek(); t.kind == tokenPunctuator && t.value == punctuator {
+		p.index++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(punctuator string) error {
+	if !p.skip(punctuator) {
+		return p.unexpected(p.peek())
+	}
+	return nil
+}
+
+func (p *parser) expectName() (string, error) {
+	t := p.next()
+	if t.kind != tokenName {
+		return "", p.unexpected(t)
+	}
+	return t.value, nil
+}
+
+func (p *parser) parseOperation() (*Operation, error) {
+	operation := &Operation{Type: p.next().value}
+	if p.peek().kind == tokenName {
+		operation.Name = p.next().value
+	}
+	if p.skip("(") {
+		for !p.skip(")") {
+			variable, err := p.parseVariableDefinition()
+			if err != nil {
+				return nil, err
+			}
+			operation.Variables = append(operation.Variables, variable)
+		}
+	}
+	// the directives of the operations have no effect
+	if _, err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	operation.SelectionSet = selectionSet
+	return operation, nil
+}
+
+func (p *parser) parseVariableDefinition() (*VariableDefinition, error) {
+	if err := p.expect("$"); err != nil {
+		return nil, err
+	}
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	variableType, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+	variable := &VariableDefinition{Name: name, Type: variableType}
+	if p.skip("=") {
+		if variable.DefaultValue, err = p.parseValue(true); err != nil {
+			return nil, err
+		}
+	}
+	return variable, nil
+}
+
+func (p *parser) parseType() (string, error) {
+	var variableType string
+	if p.skip("[") {
+		itemType, err := p.parseType()
+		if err != nil {
+			return "", err
+		}
+		if err := p.expect("]"); err != nil {
+			return "", err
+		}
+		variableType = "[" + itemType + "]"
+	} else {
+		name, err := p.expectName()
+		if err != nil {
+			return "", err
+		}
+		variableType = name
+	}
+	if p.skip("!") {
+		variableType += "!"
+	}
+	return variableType, nil
+}
+
+func (p *parser) parseFragment() (*Fragment, error) {
+	p.next()
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if name == "on" {
+		return nil, p.unexpected(p.tokens[p.index-1])
+	}
+	if on, err := p.expectName(); err != nil || on != "on" {
+		return nil, p.unexpected(p.tokens[p.index-1])
+	}
+	typeCondition, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	return &Fragment{Name: name, TypeCondition: typeCondition, SelectionSet: selectionSet}, nil
+}
+
+func (p *parser) parseSelectionSet() ([]Selection, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var selectionSet []Selection
+	for !p.skip("}") {
+		selection, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		selectionSet = append(selectionSet, selection)
+	}
+	if len(selectionSet) == 0 {
+		return nil, p.unexpected(p.tokens[p.index-1])
+	}
+	return selectionSet, nil
+}
+
+func (p *parser) parseSelection() (Selection, error) {
+	if p.skip("...") {
+		if t := p.peek(); t.kind == tokenName && t.value != "on" {
+			p.next()
+			directives, err := p.parseDirectives()
+			if err != nil {
+				return nil, err
+			}
+			return &FragmentSpread{Name: t.value, Directives: directives}, nil
+		}
+		fragment := &InlineFragment{}
+		if t := p.peek(); t.kind == tokenName {
+			p.next()
+			typeCondition, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			fragment.TypeCondition = typeCondition
+		}
+		var err error
+		if fragment.Directives, err = p.parseDirectives(); err != nil {
+			return nil, err
+		}
+		if fragment.SelectionSet, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+		return fragment, nil
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	field := &Field{Name: name}
+	if p.skip(":") {
+		field.Alias = name
+		if field.Name, err = p.expectName(); err != nil {
+			return nil, err
+		}
+	}
+	if field.Arguments, err = p.parseArguments(); err != nil {
+		return nil, err
+	}
+	if field.Directives, err = p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind == tokenPunctuator && t.value == "{" {
+		if field.SelectionSet, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return field, nil
+}
+
+func (p *parser) parseArguments() ([]*Argument, error) {
+	if !p.skip("(") {
+		return nil, nil
+	}
+	var arguments []*Argument
+	for !p.skip(")") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue(false)
+		if err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, &Argument{Name: name, Value: value})
+	}
+	if len(arguments) == 0 {
+		return nil, p.unexpected(p.tokens[p.index-1])
+	}
+	return arguments, nil
+}
+
+func (p *parser) parseDirectives() ([]*Directive, error) {
+	var directives []*Directive
+	for p.skip("@") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		arguments, err := p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+		directives = append(directives, &Directive{Name: name, Arguments: arguments})
+	}
+	return directives, nil
+}
+
+// parseValue parses a value, constant values such as the default values of the variables can't reference variables
+func (p *parser) parseValue(constant bool) (any, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenInt:
+		value, err := strconv.Atoi(t.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Int %s at position %d", t.value, t.pos)
+		}
+		return value, nil
+	case tokenFloat:
+		value, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Float %s at position %d", t.value, t.pos)
+		}
+		return value, nil
+	case tokenString:
+		return t.value, nil
+	case tokenName:
+		switch t.value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		return EnumValue(t.value), nil
+	case tokenPunctuator:
+		switch {
+		case t.value == "$" && !constant:
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			return Variable(name), nil
+		case t.value == "[":
+			list := []any{}
+			for !p.skip("]") {
+				value, err := p.parseValue(constant)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, value)
+			}
+			return list, nil
+		case t.value == "{":
+			object := map[string]any{}
+			for !p.skip("}") {
+				name, err := p.expectName()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expect(":"); err != nil {
+					return nil, err
+				}
+				if object[name], err = p.parseValue(constant); err != nil {
+					return nil, err
+				}
+			}
+			return object, nil
+		}
+	}
+	return nil, p.unexpected(t)
+}
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	document, err := Parse(`
+		# devices with their profile
+		query Devices($labels: [String!], $limit: Int = 10) {
+			all: devices(labels: $labels, limit: $limit, offset: 0) {
+				name
+				...profile @include(if: true)
+				... on Device { adminState }
+			}
+		}
+		fragment profile on Device { profile { name, manufacturer } }`)
+	require.NoError(t, err)
+
+	require.Len(t, document.Operations, 1)
+	operation := document.Operations[0]
+	assert.Equal(t, "query", operation.Type)
+	assert.Equal(t, "Devices", operation.Name)
+	assert.Equal(t, []*VariableDefinition{
+		{Name: "labels", Type: "[String!]"},
+		{Name: "limit", Type: "Int", DefaultValue: 10},
+	}, operation.Variables)
+
+	require.Len(t, operation.SelectionSet, 1)
+	devices := operation.SelectionSet[0].(*Field)
+	assert.Equal(t, "all", devices.ResponseKey())
+	assert.Equal(t, "devices", devices.Name)
+	assert.Equal(t, []*Argument{
+		{Name: "labels", Value: Variable("labels")},
+		{Name: "limit", Value: Variable("limit")},
+		{Name: "offset", Value: 0},
+	}, devices.Arguments)
+	require.Len(t, devices.SelectionSet, 3)
+	assert.Equal(t, &FragmentSpread{Name: "profile", Directives: []*Directive{{Name: "include", Arguments: []*Argument{{Name: "if", Value: true}}}}}, devices.SelectionSet[1])
+	assert.Equal(t, "Device", devices.SelectionSet[2].(*InlineFragment).TypeCondition)
+
+	require.Contains(t, document.Fragments, "profile")
+	assert.Equal(t, "Device", document.Fragments["profile"].TypeCondition)
+}
+
+func TestParseValues(t *testing.T) {
+	document, err := Parse(`{ field(s: "a\"bé", i: -12, f: 1.5e2, b: false, n: null, e: ENUM, l: [1, "x"], o: {k: true}) }`)
+	require.NoError(t, err)
+
+	values := make(map[string]any)
+	for _, argument := range document.Operations[0].SelectionSet[0].(*Field).Arguments {
+		values[argument.Name] = argument.Value
+	}
+	assert.Equal(t, map[string]any{
+		"s": "a\"bé",
+		"i": -12,
+		"f": 150.0,
+		"b": false,
+		"n": nil,
+		"e": EnumValue("ENUM"),
+		"l": []any{1, "x"},
+		"o": map[string]any{"k": true},
+	}, values)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{"empty document", ""},
+		{"empty selection set", "{ }"},
+		{"unterminated selection set", "{ name"},
+		{"unterminated string", `{ device(name: "a) { name } }`},
+		{"invalid character", "{ name; }"},
+		{"invalid number", "{ devices(limit: 1.) { name } }"},
+		{"missing argument value", "{ device(name:) { name } }"},
+		{"variable in default value", "query ($a: Int = $b) { name }"},
+		{"duplicate fragment", "{ name } fragment f on Device { name } fragment f on Device { name }"},
+		{"type system definition", "type Device { name: String }"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse(testCase.source)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,293 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package health aggregates the health of the EdgeX services. The /ping and /config endpoints of the services
+// registered in the registry, or else of the clients of the service, are polled periodically and the last results are
+// served as a single JSON report and as Prometheus metrics.
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http"
+	clientInterfaces "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-registry/v3/registry"
+)
+
+const (
+	defaultInterval = 30 * time.Second
+	defaultTimeout  = 5 * time.Second
+)
+
+// Info configures the aggregation of the health of the EdgeX services
+type Info struct {
+	Enabled bool
+	// Interval is how often the services are checked, defaults to 30s
+	Interval string
+	// Timeout of the /ping and /config requests of a service, defaults to 5s
+	Timeout string
+	// ExcludedServices lists the registered services which aren't EdgeX services, i.e. the registry itself
+	ExcludedServices []string
+}
+
+// ServiceHealth is the result of the last check of a service
+type ServiceHealth struct {
+	ServiceName string `json:"serviceName"`
+	Url         string `json:"url"`
+	// Healthy is true when both the /ping and /config requests succeeded
+	Healthy    bool   `json:"healthy"`
+	PingOK     bool   `json:"pingOk"`
+	ConfigOK   bool   `json:"configOk"`
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// ResponseTime of the /ping request in milliseconds
+	ResponseTime int64  `json:"responseTime"`
+	Error        string `json:"error,omitempty"`
+	// Checked is the time in milliseconds the service was last checked
+	Checked int64 `json:"checked"`
+}
+
+// endpoint is the base URL of the REST API of a service
+type endpoint struct {
+	serviceName string
+	url         string
+}
+
+// Aggregator checks the health of the services every interval and keeps the results of the last check
+type Aggregator struct {
+	lc           logger.LoggingClient
+	info         Info
+	interval     time.Duration
+	timeout      time.Duration
+	endpoints    func() ([]endpoint, error)
+	authInjector clientInterfaces.AuthenticationInjector
+	mutex        sync.RWMutex
+	services     []ServiceHealth
+}
+
+// AggregatorName contains the name of the Aggregator in the DIC.
+var AggregatorName = di.TypeInstanceToName(Aggregator{})
+
+// AggregatorFrom helper function queries the DIC and returns the Aggregator, nil when the health aggregation isn't
+// enabled.
+func AggregatorFrom(get di.Get) *Aggregator {
+	aggregator, ok := get(AggregatorName).(*Aggregator)
+	if !ok {
+		return nil
+	}
+	return aggregator
+}
+
+// newAggregator creates the Aggregator checking the services whose endpoints are returned by the endpoints function
+func newAggregator(info Info, endpoints func() ([]endpoint, error), authInjector clientInterfaces.AuthenticationInjector, lc logger.LoggingClient) (*Aggregator, error) {
+	interval, err := parseDuration(info.Interval, defaultInterval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Health Interval: %w", err)
+	}
+	timeout, err := parseDuration(info.Timeout, defaultTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Health Timeout: %w", err)
+	}
+	return &Aggregator{
+		lc:           lc,
+		info:         info,
+		interval:     interval,
+		timeout:      timeout,
+		endpoints:    endpoints,
+		authInjector: authInjector,
+	}, nil
+}
+
+func parseDuration(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("'%s' is not a positive duration", value)
+	}
+	return duration, nil
+}
+
+// Services returns the results of the last check of the services, sorted by service name. All the services are
+// returned when serviceNames is empty.
+func (a *Aggregator) Services(serviceNames []string) []ServiceHealth {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	services := make([]ServiceHealth, 0, len(a.services))
+	for _, service := range a.services {
+		if len(serviceNames) > 0 && !contains(serviceNames, service.ServiceName) {
+			continue
+		}
+		services = append(services, service)
+	}
+	return services
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// check checks all the services concurrently and replaces the results of the previous check
+func (a *Aggregator) check(ctx context.Context) {
+	endpoints, err := a.endpoints()
+	if err != nil {
+		a.lc.Errorf("Failed to get the endpoints of the services to check the health of: %v", err)
+		return
+	}
+
+	services := make([]ServiceHealth, 0, len(endpoints))
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for _, e := range endpoints {
+		if contains(a.info.ExcludedServices, e.serviceName) {
+			continue
+		}
+		wg.Add(1)
+		go func(e endpoint) {
+			defer wg.Done()
+			service := a.checkService(ctx, e)
+			if !service.Healthy {
+				a.lc.Warnf("Service %s at %s is unhealthy: %s", service.ServiceName, service.Url, service.Error)
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			services = append(services, service)
+		}(e)
+	}
+	wg.Wait()
+	sort.Slice(services, func(i, j int) bool { return services[i].ServiceName < services[j].ServiceName })
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.services = services
+}
+
+// checkService requests the /ping and /config endpoints of the service
+func (a *Aggregator) checkService(ctx context.Context, e endpoint) ServiceHealth {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+	client := clients.NewCommonClient(e.url, a.authInjector)
+	service := ServiceHealth{ServiceName: e.serviceName, Url: e.url}
+
+	start := time.Now()
+	ping, err := client.Ping(ctx)
+	service.ResponseTime = time.Since(start).Milliseconds()
+	service.Checked = time.Now().UnixMilli()
+	if err != nil {
+		service.Error = fmt.Sprintf("ping failed: %s", err.Error())
+		return service
+	}
+	service.PingOK = true
+	service.ApiVersion = ping.ApiVersion
+
+	if _, err = client.Configuration(ctx); err != nil {
+		service.Error = fmt.Sprintf("config failed: %s", err.Error())
+		return service
+	}
+	service.ConfigOK = true
+	service.Healthy = true
+	return service
+}
+
+// run checks the services right away and then every interval, until the context is done
+func (a *Aggregator) run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+		for {
+			a.check(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// registryEndpoints returns the function getting the endpoints of the services registered in the registry
+func registryEndpoints(registryClient registry.Client) func() ([]endpoint, error) {
+	return func() ([]endpoint, error) {
+		serviceEndpoints, err := registryClient.GetAllServiceEndpoints()
+		if err != nil {
+			return nil, err
+		}
+		endpoints := make([]endpoint, 0, len(serviceEndpoints))
+		for _, se := range serviceEndpoints {
+			u := url.URL{Scheme: "http", Host: se.Host + ":" + strconv.Itoa(se.Port)}
+			endpoints = append(endpoints, endpoint{serviceName: se.ServiceId, url: u.String()})
+		}
+		return endpoints, nil
+	}
+}
+
+// clientEndpoints returns the function getting the endpoints of the clients of the service
+func clientEndpoints(clientsCollection *bootstrapConfig.ClientsCollection) func() ([]endpoint, error) {
+	return func() ([]endpoint, error) {
+		if clientsCollection == nil {
+			return nil, nil
+		}
+		endpoints := make([]endpoint, 0, len(*clientsCollection))
+		for serviceName, client := range *clientsCollection {
+			endpoints = append(endpoints, endpoint{serviceName: serviceName, url: client.Url()})
+		}
+		return endpoints, nil
+	}
+}
+
+// BootstrapAggregator starts checking the health of the services and adds the Aggregator to the DIC, so that the
+// health report is served. The services registered in the registry are checked, or the clients of the service when
+// the registry isn't used. Nothing is done when the health aggregation isn't enabled.
+func BootstrapAggregator(ctx context.Context, wg *sync.WaitGroup, info Info, dic *di.Container) bool {
+	if !info.Enabled {
+		return true
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	var endpoints func() ([]endpoint, error)
+	if registryClient := bootstrapContainer.RegistryFrom(dic.Get); registryClient != nil {
+		endpoints = registryEndpoints(registryClient)
+	} else {
+		lc.Info("The registry isn't used, the health of the clients of the service is aggregated")
+		endpoints = clientEndpoints(bootstrapContainer.ConfigurationFrom(dic.Get).GetBootstrap().Clients)
+	}
+	authInjector := secret.NewJWTSecretProvider(bootstrapContainer.SecretProviderExtFrom(dic.Get))
+	aggregator, err := newAggregator(info, endpoints, authInjector, lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	aggregator.run(ctx, wg)
+	lc.Infof("Health aggregation enabled, the services are checked every %s", aggregator.interval)
+
+	dic.Update(di.ServiceConstructorMap{
+		AggregatorName: func(get di.Get) interface{} {
+			return aggregator
+		},
+	})
+	return true
+}
@@ -0,0 +1,167 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// newTestService returns the URL of a service whose /config endpoint responds with configStatusCode
+func newTestService(t *testing.T, serviceName string, configStatusCode int) string {
+	mux := http.NewServeMux()
+	mux.HandleFunc(common.ApiPingRoute, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(common.ContentType, common.ContentTypeJSON)
+		_ = json.NewEncoder(w).Encode(commonDTO.NewPingResponse(serviceName))
+	})
+	mux.HandleFunc(common.ApiConfigRoute, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(common.ContentType, common.ContentTypeJSON)
+		w.WriteHeader(configStatusCode)
+		if configStatusCode == http.StatusOK {
+			_ = json.NewEncoder(w).Encode(commonDTO.NewConfigResponse(map[string]string{}, serviceName))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(commonDTO.NewBaseResponse("", "unauthorized", configStatusCode))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func newTestAggregator(t *testing.T) *Aggregator {
+	stopped := httptest.NewServer(http.NotFoundHandler())
+	stopped.Close()
+	endpoints := []endpoint{
+		{serviceName: "core-data", url: newTestService(t, "core-data", http.StatusOK)},
+		{serviceName: "core-metadata", url: newTestService(t, "core-metadata", http.StatusUnauthorized)},
+		{serviceName: "core-command", url: stopped.URL},
+		{serviceName: "consul", url: stopped.URL},
+	}
+	info := Info{Enabled: true, Timeout: "1s", ExcludedServices: []string{"consul"}}
+	aggregator, err := newAggregator(info, func() ([]endpoint, error) { return endpoints, nil }, nil, logger.NewMockClient())
+	require.NoError(t, err)
+	aggregator.check(context.Background())
+	return aggregator
+}
+
+func TestCheck(t *testing.T) {
+	aggregator := newTestAggregator(t)
+
+	services := aggregator.Services(nil)
+	require.Len(t, services, 3)
+	command, data, metadata := services[0], services[1], services[2]
+
+	assert.Equal(t, "core-command", command.ServiceName)
+	assert.False(t, command.Healthy)
+	assert.False(t, command.PingOK)
+	assert.Contains(t, command.Error, "ping failed")
+
+	assert.Equal(t, "core-data", data.ServiceName)
+	assert.True(t, data.Healthy)
+	assert.True(t, data.PingOK)
+	assert.True(t, data.ConfigOK)
+	assert.Equal(t, common.ApiVersion, data.ApiVersion)
+	assert.Empty(t, data.Error)
+	assert.NotZero(t, data.Checked)
+
+	assert.Equal(t, "core-metadata", metadata.ServiceName)
+	assert.False(t, metadata.Healthy)
+	assert.True(t, metadata.PingOK)
+	assert.False(t, metadata.ConfigOK)
+	assert.Contains(t, metadata.Error, "config failed")
+
+	assert.Len(t, aggregator.Services([]string{"core-data", "unknown"}), 1)
+}
+
+func TestCheckEndpointsError(t *testing.T) {
+	aggregator := newTestAggregator(t)
+	aggregator.endpoints = func() ([]endpoint, error) { return nil, errors.New("registry unavailable") }
+
+	aggregator.check(context.Background())
+	assert.Len(t, aggregator.Services(nil), 3, "the results of the previous check should be kept")
+}
+
+func TestNewAggregatorInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		info Info
+	}{
+		{"invalid interval", Info{Enabled: true, Interval: "30"}},
+		{"negative interval", Info{Enabled: true, Interval: "-30s"}},
+		{"invalid timeout", Info{Enabled: true, Timeout: "five seconds"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newAggregator(tt.info, nil, nil, logger.NewMockClient())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReportHandler(t *testing.T) {
+	aggregator := newTestAggregator(t)
+	handler := ReportHandler(aggregator, logger.NewMockClient())
+
+	tests := []struct {
+		name               string
+		services           string
+		expectedStatusCode int
+		expectedCount      int
+	}{
+		{"all services", "", http.StatusServiceUnavailable, 3},
+		{"healthy service", "core-data", http.StatusOK, 1},
+		{"unhealthy services", "core-data,core-metadata", http.StatusServiceUnavailable, 2},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			target := common.ApiHealthRoute
+			if testCase.services != "" {
+				target += "?" + common.Services + "=" + testCase.services
+			}
+			recorder := httptest.NewRecorder()
+			handler(recorder, httptest.NewRequest(http.MethodGet, target, http.NoBody))
+
+			var response ReportResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Code)
+			assert.Equal(t, testCase.expectedStatusCode, response.StatusCode)
+			assert.Equal(t, testCase.expectedStatusCode == http.StatusOK, response.Healthy)
+			assert.Len(t, response.Services, testCase.expectedCount)
+		})
+	}
+}
+
+func TestMetricsHandler(t *testing.T) {
+	aggregator := newTestAggregator(t)
+	recorder := httptest.NewRecorder()
+	MetricsHandler(aggregator, logger.NewMockClient())(recorder, httptest.NewRequest(http.MethodGet, pkgCommon.ApiHealthMetricsRoute, http.NoBody))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, pkgCommon.ContentTypePrometheusText, recorder.Header().Get(common.ContentType))
+	lines := strings.Split(recorder.Body.String(), "\n")
+	assert.Contains(t, lines, "# TYPE edgex_service_up gauge")
+	assert.Contains(t, lines, `edgex_service_up{service="core-data"} 1`)
+	assert.Contains(t, lines, `edgex_service_up{service="core-metadata"} 0`)
+	assert.Contains(t, lines, `edgex_service_up{service="core-command"} 0`)
+	assert.Contains(t, lines, "# TYPE edgex_service_ping_duration_seconds gauge")
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	assert.Equal(t, `a\\b\"c\nd`, escapeLabelValue("a\\b\"c\nd"))
+}
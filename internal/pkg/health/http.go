@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// ReportResponse is the aggregated health report of the services
+type ReportResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	// Healthy is true when all the services of the report are healthy
+	Healthy  bool            `json:"healthy"`
+	Services []ServiceHealth `json:"services"`
+}
+
+// ReportHandler returns the results of the last check of the services, or of the comma separated services of the
+// services query parameter. The status code is 503 when any of them is unhealthy, so that the report can be used as
+// the health check of the whole deployment.
+func ReportHandler(aggregator *Aggregator, lc logger.LoggingClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		services := aggregator.Services(utils.ParseQueryStringToStrings(r, common.Services, common.CommaSeparator))
+		healthy := true
+		for _, service := range services {
+			healthy = healthy && service.Healthy
+		}
+		statusCode := http.StatusOK
+		if !healthy {
+			statusCode = http.StatusServiceUnavailable
+		}
+		response := ReportResponse{
+			BaseResponse: commonDTO.NewBaseResponse("", "", statusCode),
+			Healthy:      healthy,
+			Services:     services,
+		}
+		utils.WriteHttpHeader(w, r.Context(), statusCode)
+		pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+}
+
+// MetricsHandler returns the results of the last check of the services in the Prometheus text exposition format
+func MetricsHandler(aggregator *Aggregator, lc logger.LoggingClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		services := aggregator.Services(nil)
+		var b strings.Builder
+		b.WriteString("# HELP edgex_service_up Whether the last check of the /ping and /config endpoints of the EdgeX service succeeded.\n")
+		b.WriteString("# TYPE edgex_service_up gauge\n")
+		for _, service := range services {
+			up := 0
+			if service.Healthy {
+				up = 1
+			}
+			fmt.Fprintf(&b, "edgex_service_up{service=\"%s\"} %d\n", escapeLabelValue(service.ServiceName), up)
+		}
+		b.WriteString("# HELP edgex_service_ping_duration_seconds Duration of the last /ping request of the EdgeX service.\n")
+		b.WriteString("# TYPE edgex_service_ping_duration_seconds gauge\n")
+		for _, service := range services {
+			fmt.Fprintf(&b, "edgex_service_ping_duration_seconds{service=\"%s\"} %g\n", escapeLabelValue(service.ServiceName), float64(service.ResponseTime)/1000)
+		}
+
+		w.Header().Set(common.CorrelationHeader, correlation.FromContext(r.Context()))
+		w.Header().Set(common.ContentType, pkgCommon.ContentTypePrometheusText)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(b.String())); err != nil {
+			lc.Errorf("Failed to write the health metrics: %v", err)
+		}
+	}
+}
+
+// escapeLabelValue escapes the backslashes, double quotes and line feeds of a Prometheus label value
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
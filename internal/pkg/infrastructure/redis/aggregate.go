@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+const (
+	ReadingAggregatesCollection = "cd|agg"
+	// ReadingAggregatesCollectionEnd is the sorted set of all the aggregates scored by the end of their window
+	ReadingAggregatesCollectionEnd = ReadingAggregatesCollection + DBKeySeparator + common.End
+	// ReadingAggregatesCollectionDeviceNameResourceName is the sorted set of the aggregates of a device resource
+	// scored by the start of their window
+	ReadingAggregatesCollectionDeviceNameResourceName = ReadingAggregatesCollection + DBKeySeparator + common.DeviceName + DBKeySeparator + common.ResourceName
+)
+
+// readingAggregateStoredKey return the reading aggregate's stored key which combines the collection name and object id
+func readingAggregateStoredKey(id string) string {
+	return CreateKey(ReadingAggregatesCollection, id)
+}
+
+// AddReadingAggregates stores the reading aggregates, generating their Id
+func (c *Client) AddReadingAggregates(aggregates []dataModels.ReadingAggregate) errors.EdgeX {
+	if len(aggregates) == 0 {
+		return nil
+	}
+
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	blobs := make([][]byte, len(aggregates))
+	for i := range aggregates {
+		aggregates[i].Id = uuid.NewString()
+		m, err := json.Marshal(aggregates[i])
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "reading aggregate parsing failed", err)
+		}
+		blobs[i] = m
+	}
+
+	_ = conn.Send(MULTI)
+	for i, aggregate := range aggregates {
+		storedKey := readingAggregateStoredKey(aggregate.Id)
+		_ = conn.Send(SET, storedKey, blobs[i])
+		_ = conn.Send(ZADD, ReadingAggregatesCollectionEnd, aggregate.End, storedKey)
+		_ = conn.Send(ZADD, CreateKey(ReadingAggregatesCollectionDeviceNameResourceName, aggregate.DeviceName, aggregate.ResourceName), aggregate.Start, storedKey)
+	}
+	if _, err := conn.Do(EXEC); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "reading aggregates creation failed", err)
+	}
+	return nil
+}
+
+// ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange query the aggregates of the device resource whose window
+// starts within the time range, sorted by start in descending order
+func (c *Client) ReadingAggregatesByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, offset int, limit int) (aggregates []dataModels.ReadingAggregate, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, edgeXerr := getObjectsByScoreRange(conn, CreateKey(ReadingAggregatesCollectionDeviceNameResourceName, deviceName, resourceName), start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query reading aggregates by deviceName %s, resourceName %s and time range %v ~ %v", deviceName, resourceName, start, end), edgeXerr)
+	}
+
+	aggregates = make([]dataModels.ReadingAggregate, len(objects))
+	for i, object := range objects {
+		if err := json.Unmarshal(object, &aggregates[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "reading aggregate format parsing failed from the database", err)
+		}
+	}
+	return aggregates, nil
+}
+
+// ReadingAggregateCountByDeviceNameAndResourceNameAndTimeRange returns the count of the aggregates of the device
+// resource whose window starts within the time range
+func (c *Client) ReadingAggregateCountByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberCountByScoreRange(conn, CreateKey(ReadingAggregatesCollectionDeviceNameResourceName, deviceName, resourceName), start, end)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteReadingAggregatesByAge deletes the aggregates whose window ended more than age nanoseconds ago
+func (c *Client) DeleteReadingAggregatesByAge(age int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	expireTimestamp := time.Now().UnixNano() - age
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, ReadingAggregatesCollectionEnd, 0, expireTimestamp))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query reading aggregates by age failed", err)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(storedKeys))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var aggregate dataModels.ReadingAggregate
+	_ = conn.Send(MULTI)
+	for _, object := range objects {
+		if err = json.Unmarshal(object, &aggregate); err != nil {
+			c.loggingClient.Errorf("unable to unmarshal reading aggregate.  Err: %s", err.Error())
+			continue
+		}
+		storedKey := readingAggregateStoredKey(aggregate.Id)
+		_ = conn.Send(UNLINK, storedKey)
+		_ = conn.Send(ZREM, CreateKey(ReadingAggregatesCollectionDeviceNameResourceName, aggregate.DeviceName, aggregate.ResourceName), storedKey)
+	}
+	// remove the keys of the aggregates which no longer exist as well
+	for _, storedKey := range storedKeys {
+		_ = conn.Send(ZREM, ReadingAggregatesCollectionEnd, storedKey)
+	}
+	if _, err = conn.Do(EXEC); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "reading aggregates deletion failed", err)
+	}
+	return nil
+}
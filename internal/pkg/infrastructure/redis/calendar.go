@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+const (
+	CalendarCollection     = "ss|cal"
+	CalendarCollectionName = CalendarCollection + DBKeySeparator + common.Name
+)
+
+// calendarStoredKey return the calendar's stored key which combines the collection name and object id
+func calendarStoredKey(id string) string {
+	return CreateKey(CalendarCollection, id)
+}
+
+// sendAddCalendarCmd send redis command for adding calendar
+func sendAddCalendarCmd(conn redis.Conn, storedKey string, c schedulerModels.Calendar) errors.EdgeX {
+	m, err := json.Marshal(c)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal calendar for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CalendarCollection, c.Modified, storedKey)
+	_ = conn.Send(HSET, CalendarCollectionName, c.Name, storedKey)
+	return nil
+}
+
+// addCalendar adds a new calendar into DB
+func addCalendar(conn redis.Conn, c schedulerModels.Calendar) (schedulerModels.Calendar, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, calendarStoredKey(c.Id))
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return c, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("calendar id %s already exists", c.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, CalendarCollectionName, c.Name)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return c, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("calendar name %s already exists", c.Name), edgeXerr)
+	}
+
+	c.Created = pkgCommon.MakeTimestamp()
+	c.Modified = c.Created
+
+	storedKey := calendarStoredKey(c.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddCalendarCmd(conn, storedKey, c)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "calendar creation failed", err)
+	}
+
+	return c, edgeXerr
+}
+
+// calendarByName query calendar by name from DB
+func calendarByName(conn redis.Conn, name string) (calendar schedulerModels.Calendar, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, CalendarCollectionName, name, &calendar)
+	if edgeXerr != nil {
+		return calendar, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query calendar by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allCalendars query calendars with offset and limit, the most recently modified first
+func allCalendars(conn redis.Conn, offset int, limit int) (calendars []schedulerModels.Calendar, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CalendarCollection, offset, limit)
+	if edgeXerr != nil {
+		return calendars, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	calendars = make([]schedulerModels.Calendar, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &calendars[i])
+		if err != nil {
+			return []schedulerModels.Calendar{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "calendar format parsing failed from the database", err)
+		}
+	}
+	return calendars, nil
+}
+
+// sendDeleteCalendarCmd send redis command for deleting calendar
+func sendDeleteCalendarCmd(conn redis.Conn, storedKey string, c schedulerModels.Calendar) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, CalendarCollection, storedKey)
+	_ = conn.Send(HDEL, CalendarCollectionName, c.Name)
+}
+
+// deleteCalendarByName deletes the calendar by name, unless actions are attached to it
+func deleteCalendarByName(conn redis.Conn, name string) errors.EdgeX {
+	calendar, edgeXerr := calendarByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCommandActionCollectionCalendarName, name))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if count > 0 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the calendar when associated device command action exists", nil)
+	}
+	count, edgeXerr = getMemberNumber(conn, ZCARD, CreateKey(MessageBusActionCollectionCalendarName, name))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if count > 0 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the calendar when associated message bus action exists", nil)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteCalendarCmd(conn, calendarStoredKey(calendar.Id), calendar)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "calendar deletion failed", err)
+	}
+	return nil
+}
+
+// updateCalendar updates a calendar in DB
+func updateCalendar(conn redis.Conn, c schedulerModels.Calendar) (schedulerModels.Calendar, errors.EdgeX) {
+	oldCalendar, edgeXerr := calendarByName(conn, c.Name)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	c.Id = oldCalendar.Id
+	c.Created = oldCalendar.Created
+	c.Modified = pkgCommon.MakeTimestamp()
+
+	storedKey := calendarStoredKey(c.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteCalendarCmd(conn, storedKey, oldCalendar)
+	edgeXerr = sendAddCalendarCmd(conn, storedKey, c)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return c, errors.NewCommonEdgeX(errors.KindDatabaseError, "calendar update failed", err)
+	}
+
+	return c, nil
+}
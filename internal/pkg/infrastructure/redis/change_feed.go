@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	// ChangeFeedCollection is the sorted set of the change feed entries, the members are the JSON entries and the
+	// scores are their sequences
+	ChangeFeedCollection = "md|cf"
+	// ChangeFeedCollectionSequence is the counter of the change feed sequences
+	ChangeFeedCollectionSequence = ChangeFeedCollection + DBKeySeparator + "sequence"
+)
+
+// addChangeFeedEntry assigns the next sequence to the entry and adds it to the change feed, removing the oldest
+// entries beyond maxEntries
+func addChangeFeedEntry(conn redis.Conn, entry metadataModels.ChangeFeedEntry, maxEntries int) (metadataModels.ChangeFeedEntry, errors.EdgeX) {
+	sequence, err := redis.Uint64(conn.Do(INCR, ChangeFeedCollectionSequence))
+	if err != nil {
+		return entry, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to assign the change feed sequence", err)
+	}
+	entry.Sequence = sequence
+	entry.Timestamp = pkgCommon.MakeTimestamp()
+
+	m, err := json.Marshal(entry)
+	if err != nil {
+		return entry, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal change feed entry for Redis persistence", err)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZADD, ChangeFeedCollection, sequence, m)
+	if maxEntries > 0 {
+		_ = conn.Send(ZREMRANGEBYRANK, ChangeFeedCollection, 0, -maxEntries-1)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return entry, errors.NewCommonEdgeX(errors.KindDatabaseError, "change feed entry creation failed", err)
+	}
+	return entry, nil
+}
+
+// changeFeedEntriesAfter queries at most limit change feed entries with a sequence greater than cursor, in the order
+// of their sequences. A negative limit means no limit.
+func changeFeedEntriesAfter(conn redis.Conn, cursor uint64, limit int) ([]metadataModels.ChangeFeedEntry, errors.EdgeX) {
+	objects, err := redis.ByteSlices(conn.Do(ZRANGEBYSCORE, ChangeFeedCollection, fmt.Sprintf("(%d", cursor), InfiniteMax, LIMIT, 0, limit))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the change feed entries after %d", cursor), err)
+	}
+	entries := make([]metadataModels.ChangeFeedEntry, len(objects))
+	for i, in := range objects {
+		if err = json.Unmarshal(in, &entries[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "change feed entry format parsing failed from the database", err)
+		}
+	}
+	return entries, nil
+}
+
+// changeFeedSequences returns the sequence of the oldest change feed entry, 0 when the change feed is empty, and the
+// sequence of the latest entry ever added
+func changeFeedSequences(conn redis.Conn) (oldest uint64, latest uint64, edgeXerr errors.EdgeX) {
+	values, err := redis.Values(conn.Do(ZRANGE, ChangeFeedCollection, 0, 0, WITHSCORES))
+	if err != nil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the oldest change feed entry", err)
+	}
+	if len(values) >= 2 {
+		if oldest, err = redis.Uint64(values[1], nil); err != nil {
+			return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to parse the oldest change feed sequence", err)
+		}
+	}
+	latest, err = redis.Uint64(conn.Do(GET, ChangeFeedCollectionSequence))
+	if err != nil && err != redis.ErrNil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the latest change feed sequence", err)
+	}
+	return oldest, latest, nil
+}
@@ -7,13 +7,18 @@ package redis
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
 	"github.com/google/uuid"
 )
@@ -35,6 +40,19 @@ func NewClient(config db.Configuration, logger logger.LoggingClient) (*Client, e
 	return dc, nil
 }
 
+// NewReplicaClient returns a client of a read-only replica of the database, which must only be used for the queries
+func NewReplicaClient(config db.Configuration, logger logger.LoggingClient) (*Client, errors.EdgeX) {
+	var err error
+	dc := &Client{}
+	dc.Client, err = redisClient.NewReplicaClient(config, logger)
+	dc.loggingClient = logger
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "redis replica client creation failed", err)
+	}
+
+	return dc, nil
+}
+
 // AddEvent adds a new event
 func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -50,6 +68,21 @@ func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	return addEvent(conn, e)
 }
 
+// AddEvents adds the events, and their readings, in a single transaction. None of the events is added when one of
+// them fails to be added.
+func (c *Client) AddEvents(events []model.Event) ([]model.Event, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	for _, e := range events {
+		if _, err := uuid.Parse(e.Id); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+		}
+	}
+
+	return addEvents(conn, events)
+}
+
 // EventById gets an event by id
 func (c *Client) EventById(id string) (event model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -390,6 +423,58 @@ func (c *Client) DevicesByServiceName(offset int, limit int, name string) (devic
 	return devices, nil
 }
 
+// DevicesByAttribute query devices by offset, limit and attribute
+func (c *Client) DevicesByAttribute(offset int, limit int, key string, value string) (devices []model.Device, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr = devicesByAttribute(conn, offset, limit, key, value)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices by offset %d, limit %d and attribute %s=%s", offset, limit, key, value), edgeXerr)
+	}
+	return devices, nil
+}
+
+// DevicesByLifecycleState query devices by offset, limit and lifecycle state
+func (c *Client) DevicesByLifecycleState(offset int, limit int, state string) (devices []model.Device, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr = devicesByLifecycleState(conn, offset, limit, state)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices by offset %d, limit %d and lifecycle state %s", offset, limit, state), edgeXerr)
+	}
+	return devices, nil
+}
+
+// DevicesByLocationRadius query devices by offset, limit and distance in meters from the position
+func (c *Client) DevicesByLocationRadius(offset int, limit int, latitude float64, longitude float64, radius float64) (devices []model.Device, totalCount uint32, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, totalCount, edgeXerr = devicesByLocationRadius(conn, offset, limit, latitude, longitude, radius)
+	if edgeXerr != nil {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices by offset %d, limit %d and radius %vm around %v,%v", offset, limit, radius, latitude, longitude), edgeXerr)
+	}
+	return devices, totalCount, nil
+}
+
+// DevicesByLocationBox query devices by offset, limit and bounding box
+func (c *Client) DevicesByLocationBox(offset int, limit int, minLatitude float64, minLongitude float64, maxLatitude float64, maxLongitude float64) (devices []model.Device, totalCount uint32, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, totalCount, edgeXerr = devicesByLocationBox(conn, offset, limit, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	if edgeXerr != nil {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices by offset %d, limit %d and bounding box %v,%v to %v,%v", offset, limit, minLatitude, minLongitude, maxLatitude, maxLongitude), edgeXerr)
+	}
+	return devices, totalCount, nil
+}
+
 // DeviceIdExists checks the device existence by id
 func (c *Client) DeviceIdExists(id string) (bool, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -497,6 +582,32 @@ func (c *Client) EventsByDeviceName(offset int, limit int, name string) (events
 	return events, nil
 }
 
+// AllEventsAfter query the events after the cursor
+func (c *Client) AllEventsAfter(after utils.Cursor, limit int) ([]model.Event, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, edgeXerr := eventsAfter(conn, EventsCollection, after, limit)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events after the cursor %v and limit %d", after, limit), edgeXerr)
+	}
+	return events, nil
+}
+
+// EventsByDeviceNameAfter query the events of the device after the cursor
+func (c *Client) EventsByDeviceNameAfter(after utils.Cursor, limit int, name string) ([]model.Event, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, edgeXerr := eventsAfter(conn, CreateKey(EventsCollectionDeviceName, name), after, limit)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events after the cursor %v, limit %d and name %s", after, limit, name), edgeXerr)
+	}
+	return events, nil
+}
+
 // EventsByTimeRange query events by time range, offset, and limit
 func (c *Client) EventsByTimeRange(startTime int, endTime int, offset int, limit int) (events []model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -536,6 +647,32 @@ func (c *Client) AllReadings(offset int, limit int) ([]model.Reading, errors.Edg
 	return readings, nil
 }
 
+// AllReadingsAfter query the readings after the cursor
+func (c *Client) AllReadingsAfter(after utils.Cursor, limit int) ([]model.Reading, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, edgeXerr := readingsAfter(conn, ReadingsCollectionOrigin, after, limit)
+	if edgeXerr != nil {
+		return readings, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings after the cursor %v and limit %d", after, limit), edgeXerr)
+	}
+	return readings, nil
+}
+
+// ReadingsByDeviceNameAfter query the readings of the device after the cursor
+func (c *Client) ReadingsByDeviceNameAfter(after utils.Cursor, limit int, name string) ([]model.Reading, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, edgeXerr := readingsAfter(conn, CreateKey(ReadingsCollectionDeviceName, name), after, limit)
+	if edgeXerr != nil {
+		return readings, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings after the cursor %v, limit %d and name %s", after, limit, name), edgeXerr)
+	}
+	return readings, nil
+}
+
 // ReadingsByTimeRange query readings by time range, offset, and limit
 func (c *Client) ReadingsByTimeRange(start int, end int, offset int, limit int) (readings []model.Reading, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -869,25 +1006,25 @@ func (c *Client) DeviceProfileCountByModel(model string) (uint32, errors.EdgeX)
 	return count, nil
 }
 
-// DeviceServiceCountByLabels returns the total count of Device Services with labels specified.  If no label is specified, the total count of all device services will be returned.
-func (c *Client) DeviceServiceCountByLabels(labels []string) (uint32, errors.EdgeX) {
+// DeviceProfileRevisionsByName query the revisions of the device profile with offset, limit and name, the latest first
+func (c *Client) DeviceProfileRevisionsByName(offset int, limit int, name string) ([]metadataModels.DeviceProfileRevision, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberCountByLabels(conn, ZREVRANGE, DeviceServiceCollection, labels)
+	revisions, edgeXerr := deviceProfileRevisionsByName(conn, offset, limit, name)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return revisions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query revisions by offset %d, limit %d and name %s", offset, limit, name), edgeXerr)
 	}
-
-	return count, nil
+	return revisions, nil
 }
 
-// DeviceCountByLabels returns the total count of Devices with labels specified.  If no label is specified, the total count of all devices will be returned.
-func (c *Client) DeviceCountByLabels(labels []string) (uint32, errors.EdgeX) {
+// DeviceProfileRevisionCountByName returns the count of the revisions of the device profile
+func (c *Client) DeviceProfileRevisionCountByName(name string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberCountByLabels(conn, ZREVRANGE, DeviceCollection, labels)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceProfileCollectionRevisions, name))
 	if edgeXerr != nil {
 		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -895,51 +1032,60 @@ func (c *Client) DeviceCountByLabels(labels []string) (uint32, errors.EdgeX) {
 	return count, nil
 }
 
-// DeviceCountByProfileName returns the count of Devices associated with specified profile
-func (c *Client) DeviceCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+// DeviceProfileRevisionByNameAndVersion gets the revision of the device profile by name and version
+func (c *Client) DeviceProfileRevisionByNameAndVersion(name string, version uint64) (metadataModels.DeviceProfileRevision, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCollectionProfileName, profileName))
+	revision, edgeXerr := deviceProfileRevisionByNameAndVersion(conn, name, version)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return revision, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-
-	return count, nil
+	return revision, nil
 }
 
-// DeviceCountByServiceName returns the count of Devices associated with specified service
-func (c *Client) DeviceCountByServiceName(serviceName string) (uint32, errors.EdgeX) {
+// AddDeviceGroup adds a new device group
+func (c *Client) AddDeviceGroup(dg metadataModels.DeviceGroup) (metadataModels.DeviceGroup, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCollectionServiceName, serviceName))
-	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	if len(dg.Id) == 0 {
+		dg.Id = uuid.New().String()
 	}
 
-	return count, nil
+	return addDeviceGroup(conn, dg)
 }
 
-// ProvisionWatcherCountByLabels returns the total count of Provision Watchers with labels specified.  If no label is specified, the total count of all provision watchers will be returned.
-func (c *Client) ProvisionWatcherCountByLabels(labels []string) (uint32, errors.EdgeX) {
+// DeviceGroupByName gets a device group by name
+func (c *Client) DeviceGroupByName(name string) (metadataModels.DeviceGroup, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberCountByLabels(conn, ZREVRANGE, ProvisionWatcherCollection, labels)
+	deviceGroup, edgeXerr := deviceGroupByName(conn, name)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return deviceGroup, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	return deviceGroup, nil
+}
 
-	return count, nil
+// AllDeviceGroups query device groups with offset and limit
+func (c *Client) AllDeviceGroups(offset int, limit int) ([]metadataModels.DeviceGroup, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceGroups, edgeXerr := allDeviceGroups(conn, offset, limit)
+	if edgeXerr != nil {
+		return deviceGroups, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return deviceGroups, nil
 }
 
-// ProvisionWatcherCountByServiceName returns the count of Provision Watcher associated with specified service
-func (c *Client) ProvisionWatcherCountByServiceName(name string) (uint32, errors.EdgeX) {
+// DeviceGroupTotalCount returns the total count of Device Groups
+func (c *Client) DeviceGroupTotalCount() (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(ProvisionWatcherCollectionServiceName, name))
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeviceGroupCollection)
 	if edgeXerr != nil {
 		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -947,412 +1093,1628 @@ func (c *Client) ProvisionWatcherCountByServiceName(name string) (uint32, errors
 	return count, nil
 }
 
-// ProvisionWatcherCountByProfileName returns the count of Provision Watcher associated with specified profile
-func (c *Client) ProvisionWatcherCountByProfileName(name string) (uint32, errors.EdgeX) {
+// UpdateDeviceGroup updates a device group
+func (c *Client) UpdateDeviceGroup(dg metadataModels.DeviceGroup) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
+	return updateDeviceGroup(conn, dg)
+}
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(ProvisionWatcherCollectionProfileName, name))
+// DeleteDeviceGroupByName deletes a device group by name
+func (c *Client) DeleteDeviceGroupByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceGroupByName(conn, name)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device group with name %s", name), edgeXerr)
 	}
 
-	return count, nil
+	return nil
 }
 
-// AddInterval adds a new interval
-func (c *Client) AddInterval(interval model.Interval) (model.Interval, errors.EdgeX) {
+// AddDeviceTemplate adds a new device template
+func (c *Client) AddDeviceTemplate(dt metadataModels.DeviceTemplate) (metadataModels.DeviceTemplate, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	if len(interval.Id) == 0 {
-		interval.Id = uuid.New().String()
+	if len(dt.Id) == 0 {
+		dt.Id = uuid.New().String()
 	}
 
-	return addInterval(conn, interval)
+	return addDeviceTemplate(conn, dt)
 }
 
-// IntervalByName gets a interval by name
-func (c *Client) IntervalByName(name string) (interval model.Interval, edgeXerr errors.EdgeX) {
+// DeviceTemplateByName gets a device template by name
+func (c *Client) DeviceTemplateByName(name string) (metadataModels.DeviceTemplate, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	interval, edgeXerr = intervalByName(conn, name)
+	deviceTemplate, edgeXerr := deviceTemplateByName(conn, name)
 	if edgeXerr != nil {
-		return interval, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return deviceTemplate, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return
+	return deviceTemplate, nil
 }
 
-// IntervalById gets a interval by id
-func (c *Client) IntervalById(id string) (interval model.Interval, edgeXerr errors.EdgeX) {
+// AllDeviceTemplates query device templates with offset and limit
+func (c *Client) AllDeviceTemplates(offset int, limit int) ([]metadataModels.DeviceTemplate, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	interval, edgeXerr = intervalById(conn, id)
+	deviceTemplates, edgeXerr := allDeviceTemplates(conn, offset, limit)
 	if edgeXerr != nil {
-		return interval, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return deviceTemplates, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return
+	return deviceTemplates, nil
 }
 
-// AllIntervals query intervals with offset and limit
-func (c *Client) AllIntervals(offset int, limit int) (intervals []model.Interval, edgeXerr errors.EdgeX) {
+// DeviceTemplateTotalCount returns the total count of Device Templates
+func (c *Client) DeviceTemplateTotalCount() (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	intervals, edgeXerr = allIntervals(conn, offset, limit)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeviceTemplateCollection)
 	if edgeXerr != nil {
-		return intervals, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return intervals, nil
+
+	return count, nil
 }
 
-// UpdateInterval updates a interval
-func (c *Client) UpdateInterval(interval model.Interval) errors.EdgeX {
+// UpdateDeviceTemplate updates a device template
+func (c *Client) UpdateDeviceTemplate(dt metadataModels.DeviceTemplate) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateInterval(conn, interval)
+	return updateDeviceTemplate(conn, dt)
 }
 
-// DeleteIntervalByName deletes the interval by name
-func (c *Client) DeleteIntervalByName(name string) errors.EdgeX {
+// DeleteDeviceTemplateByName deletes a device template by name
+func (c *Client) DeleteDeviceTemplateByName(name string) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteIntervalByName(conn, name)
+	edgeXerr := deleteDeviceTemplateByName(conn, name)
 	if edgeXerr != nil {
-		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the interval with name %s", name), edgeXerr)
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device template with name %s", name), edgeXerr)
 	}
 
 	return nil
 }
 
-// IntervalTotalCount returns the total count of Interval from the database
-func (c *Client) IntervalTotalCount() (uint32, errors.EdgeX) {
+// AddChangeFeedEntry adds the entry to the metadata change feed with the next sequence, keeping at most maxEntries
+// entries when maxEntries is positive
+func (c *Client) AddChangeFeedEntry(entry metadataModels.ChangeFeedEntry, maxEntries int) (metadataModels.ChangeFeedEntry, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, IntervalCollection)
-	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
-	}
-
-	return count, nil
+	return addChangeFeedEntry(conn, entry, maxEntries)
 }
 
-// IntervalActionTotalCount returns the total count of IntervalAction from the database
-func (c *Client) IntervalActionTotalCount() (uint32, errors.EdgeX) {
+// ChangeFeedEntriesAfter queries at most limit metadata change feed entries with a sequence greater than cursor
+func (c *Client) ChangeFeedEntriesAfter(cursor uint64, limit int) ([]metadataModels.ChangeFeedEntry, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, IntervalActionCollection)
+	entries, edgeXerr := changeFeedEntriesAfter(conn, cursor, limit)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-
-	return count, nil
+	return entries, nil
 }
 
-// AddIntervalAction adds a new intervalAction
-func (c *Client) AddIntervalAction(action model.IntervalAction) (model.IntervalAction, errors.EdgeX) {
+// ChangeFeedSequences returns the sequences of the oldest metadata change feed entry and of the latest one
+func (c *Client) ChangeFeedSequences() (uint64, uint64, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	if len(action.Id) == 0 {
-		action.Id = uuid.New().String()
+	oldest, latest, edgeXerr := changeFeedSequences(conn)
+	if edgeXerr != nil {
+		return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return addIntervalAction(conn, action)
+	return oldest, latest, nil
 }
 
-// AllIntervalActions query intervalActions with offset and limit
-func (c *Client) AllIntervalActions(offset int, limit int) (intervalActions []model.IntervalAction, edgeXerr errors.EdgeX) {
+// AddSystemEventHistoryEntry adds the entry to the system event history with the next sequence, keeping at most
+// maxEntries entries when maxEntries is positive
+func (c *Client) AddSystemEventHistoryEntry(entry metadataModels.SystemEventHistoryEntry, maxEntries int) (metadataModels.SystemEventHistoryEntry, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	intervalActions, edgeXerr = allIntervalActions(conn, offset, limit)
-	if edgeXerr != nil {
-		return intervalActions, errors.NewCommonEdgeXWrapper(edgeXerr)
-	}
-	return intervalActions, nil
+	return addSystemEventHistoryEntry(conn, entry, maxEntries)
 }
 
-// IntervalActionByName gets a intervalAction by name
-func (c *Client) IntervalActionByName(name string) (action model.IntervalAction, edgeXerr errors.EdgeX) {
+// SystemEventHistoryEntriesAfter queries at most limit system event history entries with a sequence greater than cursor
+func (c *Client) SystemEventHistoryEntriesAfter(cursor uint64, limit int) ([]metadataModels.SystemEventHistoryEntry, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	action, edgeXerr = intervalActionByName(conn, name)
+	entries, edgeXerr := systemEventHistoryEntriesAfter(conn, cursor, limit)
 	if edgeXerr != nil {
-		return action, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return
+	return entries, nil
 }
 
-// IntervalActionsByIntervalName query intervalActions by offset, limit and intervalName
-func (c *Client) IntervalActionsByIntervalName(offset int, limit int, intervalName string) (actions []model.IntervalAction, edgeXerr errors.EdgeX) {
+// SystemEventHistorySequences returns the sequences of the oldest system event history entry and of the latest one
+func (c *Client) SystemEventHistorySequences() (uint64, uint64, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	actions, edgeXerr = intervalActionsByIntervalName(conn, offset, limit, intervalName)
+	oldest, latest, edgeXerr := systemEventHistorySequences(conn)
 	if edgeXerr != nil {
-		return actions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query actions by offset %d, limit %d and intervalName %s", offset, limit, intervalName), edgeXerr)
+		return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return actions, nil
+	return oldest, latest, nil
 }
 
-// DeleteIntervalActionByName deletes the intervalAction by name
-func (c *Client) DeleteIntervalActionByName(name string) errors.EdgeX {
+// UpdateDeviceOperatingState sets the operating state of the device to the to operating state when it is in the from
+// operating state, and returns the device and whether it was updated. Only the operating state of the device is updated.
+func (c *Client) UpdateDeviceOperatingState(name string, from model.OperatingState, to model.OperatingState) (model.Device, bool, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteIntervalActionByName(conn, name)
+	device, updated, edgeXerr := updateDeviceOperatingState(conn, name, from, to)
 	if edgeXerr != nil {
-		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the intervalAction with name %s", name), edgeXerr)
+		return device, false, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update the operating state of device %s", name), edgeXerr)
 	}
-
-	return nil
+	return device, updated, nil
 }
 
-// IntervalActionById gets a intervalAction by id
-func (c *Client) IntervalActionById(id string) (action model.IntervalAction, edgeXerr errors.EdgeX) {
+// SetDownDevicesOfService stores the names of the devices of the device service marked DOWN by the heartbeat tracking
+func (c *Client) SetDownDevicesOfService(serviceName string, deviceNames []string) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	action, edgeXerr = intervalActionById(conn, id)
-	if edgeXerr != nil {
-		return action, errors.NewCommonEdgeXWrapper(edgeXerr)
-	}
-	return
+	return setDownDevicesOfService(conn, serviceName, deviceNames)
 }
 
-// UpdateIntervalAction updates a intervalAction
-func (c *Client) UpdateIntervalAction(action model.IntervalAction) errors.EdgeX {
+// DownDevicesOfServices queries the names of the devices marked DOWN by the heartbeat tracking, by device service
+func (c *Client) DownDevicesOfServices() (map[string][]string, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateIntervalAction(conn, action)
+
+	return downDevicesOfServices(conn)
 }
 
-// AddSubscription adds a new subscription
-func (c *Client) AddSubscription(subscription model.Subscription) (model.Subscription, errors.EdgeX) {
+// DeleteDownDevicesOfService deletes the names of the devices of the device service marked DOWN by the heartbeat
+// tracking
+func (c *Client) DeleteDownDevicesOfService(serviceName string) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	if len(subscription.Id) == 0 {
-		subscription.Id = uuid.New().String()
-	}
-
-	return addSubscription(conn, subscription)
+	return deleteDownDevicesOfService(conn, serviceName)
 }
 
-// AllSubscriptions returns multiple subscriptions per query criteria, including
-// offset: The number of items to skip before starting to collect the result set.
-// limit: The maximum number of items to return.
-func (c *Client) AllSubscriptions(offset int, limit int) ([]model.Subscription, errors.EdgeX) {
+// DeviceServiceCountByLabels returns the total count of Device Services with labels specified.  If no label is specified, the total count of all device services will be returned.
+func (c *Client) DeviceServiceCountByLabels(labels []string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	subscriptions, edgeXerr := allSubscriptions(conn, offset, limit)
+	count, edgeXerr := getMemberCountByLabels(conn, ZREVRANGE, DeviceServiceCollection, labels)
 	if edgeXerr != nil {
-		return subscriptions, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return subscriptions, nil
+
+	return count, nil
 }
 
-// SubscriptionsByCategory queries subscriptions by offset, limit and category
-func (c *Client) SubscriptionsByCategory(offset int, limit int, category string) (subscriptions []model.Subscription, edgeXerr errors.EdgeX) {
+// DeviceCountByLabels returns the total count of Devices with labels specified.  If no label is specified, the total count of all devices will be returned.
+func (c *Client) DeviceCountByLabels(labels []string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	subscriptions, edgeXerr = subscriptionsByCategory(conn, offset, limit, category)
+	count, edgeXerr := getMemberCountByLabels(conn, ZREVRANGE, DeviceCollection, labels)
 	if edgeXerr != nil {
-		return subscriptions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query subscriptions by offset %d, limit %d and category %s", offset, limit, category), edgeXerr)
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return subscriptions, nil
+
+	return count, nil
 }
 
-// SubscriptionsByLabel queries subscriptions by offset, limit and label
-func (c *Client) SubscriptionsByLabel(offset int, limit int, label string) (subscriptions []model.Subscription, edgeXerr errors.EdgeX) {
+// DeviceCountByProfileName returns the count of Devices associated with specified profile
+func (c *Client) DeviceCountByProfileName(profileName string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	subscriptions, edgeXerr = subscriptionsByLabel(conn, offset, limit, label)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCollectionProfileName, profileName))
 	if edgeXerr != nil {
-		return subscriptions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query subscriptions by offset %d, limit %d and label %s", offset, limit, label), edgeXerr)
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeviceCountByServiceName returns the count of Devices associated with specified service
+func (c *Client) DeviceCountByServiceName(serviceName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCollectionServiceName, serviceName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeviceCountByAttribute returns the count of Devices with the specified attribute
+func (c *Client) DeviceCountByAttribute(key string, value string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCollectionAttribute, key, value))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeviceCountByLifecycleState returns the count of Devices in the specified lifecycle state
+func (c *Client) DeviceCountByLifecycleState(state string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceCollectionLifecycle, state))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// ProvisionWatcherCountByLabels returns the total count of Provision Watchers with labels specified.  If no label is specified, the total count of all provision watchers will be returned.
+func (c *Client) ProvisionWatcherCountByLabels(labels []string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberCountByLabels(conn, ZREVRANGE, ProvisionWatcherCollection, labels)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// ProvisionWatcherCountByServiceName returns the count of Provision Watcher associated with specified service
+func (c *Client) ProvisionWatcherCountByServiceName(name string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(ProvisionWatcherCollectionServiceName, name))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// ProvisionWatcherCountByProfileName returns the count of Provision Watcher associated with specified profile
+func (c *Client) ProvisionWatcherCountByProfileName(name string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(ProvisionWatcherCollectionProfileName, name))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// AddInterval adds a new interval
+func (c *Client) AddInterval(interval model.Interval) (model.Interval, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(interval.Id) == 0 {
+		interval.Id = uuid.New().String()
+	}
+
+	return addInterval(conn, interval)
+}
+
+// IntervalByName gets a interval by name
+func (c *Client) IntervalByName(name string) (interval model.Interval, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	interval, edgeXerr = intervalByName(conn, name)
+	if edgeXerr != nil {
+		return interval, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// IntervalById gets a interval by id
+func (c *Client) IntervalById(id string) (interval model.Interval, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	interval, edgeXerr = intervalById(conn, id)
+	if edgeXerr != nil {
+		return interval, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// AllIntervals query intervals with offset and limit
+func (c *Client) AllIntervals(offset int, limit int) (intervals []model.Interval, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	intervals, edgeXerr = allIntervals(conn, offset, limit)
+	if edgeXerr != nil {
+		return intervals, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return intervals, nil
+}
+
+// UpdateInterval updates a interval
+func (c *Client) UpdateInterval(interval model.Interval) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateInterval(conn, interval)
+}
+
+// DeleteIntervalByName deletes the interval by name
+func (c *Client) DeleteIntervalByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteIntervalByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the interval with name %s", name), edgeXerr)
+	}
+
+	return nil
+}
+
+// IntervalTotalCount returns the total count of Interval from the database
+func (c *Client) IntervalTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, IntervalCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// IntervalActionTotalCount returns the total count of IntervalAction from the database
+func (c *Client) IntervalActionTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, IntervalActionCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// AddIntervalAction adds a new intervalAction
+func (c *Client) AddIntervalAction(action model.IntervalAction) (model.IntervalAction, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(action.Id) == 0 {
+		action.Id = uuid.New().String()
+	}
+	return addIntervalAction(conn, action)
+}
+
+// AllIntervalActions query intervalActions with offset and limit
+func (c *Client) AllIntervalActions(offset int, limit int) (intervalActions []model.IntervalAction, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	intervalActions, edgeXerr = allIntervalActions(conn, offset, limit)
+	if edgeXerr != nil {
+		return intervalActions, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return intervalActions, nil
+}
+
+// IntervalActionByName gets a intervalAction by name
+func (c *Client) IntervalActionByName(name string) (action model.IntervalAction, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	action, edgeXerr = intervalActionByName(conn, name)
+	if edgeXerr != nil {
+		return action, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// IntervalActionsByIntervalName query intervalActions by offset, limit and intervalName
+func (c *Client) IntervalActionsByIntervalName(offset int, limit int, intervalName string) (actions []model.IntervalAction, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	actions, edgeXerr = intervalActionsByIntervalName(conn, offset, limit, intervalName)
+	if edgeXerr != nil {
+		return actions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query actions by offset %d, limit %d and intervalName %s", offset, limit, intervalName), edgeXerr)
+	}
+	return actions, nil
+}
+
+// DeleteIntervalActionByName deletes the intervalAction by name
+func (c *Client) DeleteIntervalActionByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteIntervalActionByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the intervalAction with name %s", name), edgeXerr)
+	}
+
+	return nil
+}
+
+// IntervalActionById gets a intervalAction by id
+func (c *Client) IntervalActionById(id string) (action model.IntervalAction, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	action, edgeXerr = intervalActionById(conn, id)
+	if edgeXerr != nil {
+		return action, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// UpdateIntervalAction updates a intervalAction
+func (c *Client) UpdateIntervalAction(action model.IntervalAction) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateIntervalAction(conn, action)
+}
+
+// AddSubscription adds a new subscription
+func (c *Client) AddSubscription(subscription model.Subscription) (model.Subscription, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(subscription.Id) == 0 {
+		subscription.Id = uuid.New().String()
+	}
+
+	return addSubscription(conn, subscription)
+}
+
+// AllSubscriptions returns multiple subscriptions per query criteria, including
+// offset: The number of items to skip before starting to collect the result set.
+// limit: The maximum number of items to return.
+func (c *Client) AllSubscriptions(offset int, limit int) ([]model.Subscription, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	subscriptions, edgeXerr := allSubscriptions(conn, offset, limit)
+	if edgeXerr != nil {
+		return subscriptions, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return subscriptions, nil
+}
+
+// SubscriptionsByCategory queries subscriptions by offset, limit and category
+func (c *Client) SubscriptionsByCategory(offset int, limit int, category string) (subscriptions []model.Subscription, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	subscriptions, edgeXerr = subscriptionsByCategory(conn, offset, limit, category)
+	if edgeXerr != nil {
+		return subscriptions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query subscriptions by offset %d, limit %d and category %s", offset, limit, category), edgeXerr)
+	}
+	return subscriptions, nil
+}
+
+// SubscriptionsByLabel queries subscriptions by offset, limit and label
+func (c *Client) SubscriptionsByLabel(offset int, limit int, label string) (subscriptions []model.Subscription, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	subscriptions, edgeXerr = subscriptionsByLabel(conn, offset, limit, label)
+	if edgeXerr != nil {
+		return subscriptions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query subscriptions by offset %d, limit %d and label %s", offset, limit, label), edgeXerr)
+	}
+	return subscriptions, nil
+}
+
+// SubscriptionsByReceiver queries subscriptions by offset, limit and receiver
+func (c *Client) SubscriptionsByReceiver(offset int, limit int, receiver string) (subscriptions []model.Subscription, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	subscriptions, edgeXerr = subscriptionsByReceiver(conn, offset, limit, receiver)
+	if edgeXerr != nil {
+		return subscriptions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query subscriptions by offset %d, limit %d and receiver %s", offset, limit, receiver), edgeXerr)
+	}
+	return subscriptions, nil
+}
+
+// SubscriptionById gets a subscription by id
+func (c *Client) SubscriptionById(id string) (subscription model.Subscription, edgexErr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	subscription, edgexErr = subscriptionById(conn, id)
+	if edgexErr != nil {
+		return subscription, errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("failed to query subscription by id %s", id), edgexErr)
+	}
+
+	return
+}
+
+// SubscriptionByName queries subscription by name
+func (c *Client) SubscriptionByName(name string) (subscription model.Subscription, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	subscription, edgeXerr = subscriptionByName(conn, name)
+	if edgeXerr != nil {
+		return subscription, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query subscription by name %s", name), edgeXerr)
+	}
+	return subscription, nil
+}
+
+// UpdateSubscription updates a new subscription
+func (c *Client) UpdateSubscription(subscription model.Subscription) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateSubscription(conn, subscription)
+}
+
+// DeleteSubscriptionByName deletes a subscription by name
+func (c *Client) DeleteSubscriptionByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteSubscriptionByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the subscription with name %s", name), edgeXerr)
+	}
+
+	return nil
+}
+
+// SubscriptionsByCategoriesAndLabels queries subscriptions by offset, limit, categories and labels
+func (c *Client) SubscriptionsByCategoriesAndLabels(offset int, limit int, categories []string, labels []string) (subscriptions []model.Subscription, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	subscriptions, edgeXerr = subscriptionsByCategoriesAndLabels(conn, offset, limit, categories, labels)
+	if edgeXerr != nil {
+		return subscriptions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query subscriptions by offset %d, limit %d, categories %v and labels %v", offset, limit, categories, labels), edgeXerr)
 	}
 	return subscriptions, nil
 }
 
-// SubscriptionsByReceiver queries subscriptions by offset, limit and receiver
-func (c *Client) SubscriptionsByReceiver(offset int, limit int, receiver string) (subscriptions []model.Subscription, edgeXerr errors.EdgeX) {
+// AddNotification adds a new notification
+func (c *Client) AddNotification(notification model.Notification) (model.Notification, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(notification.Id) == 0 {
+		notification.Id = uuid.New().String()
+	}
+
+	return addNotification(conn, notification)
+}
+
+// NotificationsByCategory queries notifications by offset, limit and category
+func (c *Client) NotificationsByCategory(offset int, limit int, category string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notifications, edgeXerr = notificationsByCategory(conn, offset, limit, category)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notifications by offset %d, limit %d and category %s", offset, limit, category), edgeXerr)
+	}
+	return notifications, nil
+}
+
+// NotificationsByLabel queries notifications by offset, limit and label
+func (c *Client) NotificationsByLabel(offset int, limit int, label string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notifications, edgeXerr = notificationsByLabel(conn, offset, limit, label)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notifications by offset %d, limit %d and label %s", offset, limit, label), edgeXerr)
+	}
+	return notifications, nil
+}
+
+// NotificationById gets a notification by id
+func (c *Client) NotificationById(id string) (notification model.Notification, edgexErr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notification, edgexErr = notificationById(conn, id)
+	if edgexErr != nil {
+		return notification, errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("failed to query notification by id %s", id), edgexErr)
+	}
+	return
+}
+
+// NotificationsByStatus queries notifications by offset, limit and status
+func (c *Client) NotificationsByStatus(offset int, limit int, status string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notifications, edgeXerr = notificationsByStatus(conn, offset, limit, status)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notifications by offset %d, limit %d and status %s", offset, limit, status), edgeXerr)
+	}
+	return notifications, nil
+}
+
+// NotificationsByTimeRange query notifications by time range, offset, and limit
+func (c *Client) NotificationsByTimeRange(start int, end int, offset int, limit int) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notifications, edgeXerr = notificationsByTimeRange(conn, start, end, offset, limit)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notifications by time range %v ~ %v, offset %d, and limit %d", start, end, offset, limit), edgeXerr)
+	}
+	return notifications, nil
+}
+
+// NotificationsByCategoriesAndLabels queries notifications by offset, limit, categories and labels
+func (c *Client) NotificationsByCategoriesAndLabels(offset int, limit int, categories []string, labels []string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notifications, edgeXerr = notificationsByCategoriesAndLabels(conn, offset, limit, categories, labels)
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notifications by offset %d, limit %d, categories %v and labels %v", offset, limit, categories, labels), edgeXerr)
+	}
+	return notifications, nil
+}
+
+// NotificationCountByCategory returns the count of Notification associated with specified category from the database
+func (c *Client) NotificationCountByCategory(category string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(NotificationCollectionCategory, category))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// NotificationCountByLabel returns the count of Notification associated with specified label from the database
+func (c *Client) NotificationCountByLabel(label string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(NotificationCollectionLabel, label))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// NotificationCountByStatus returns the count of Notification associated with specified status from the database
+func (c *Client) NotificationCountByStatus(status string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(NotificationCollectionStatus, status))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// NotificationCountByTimeRange returns the count of Notification from the database within specified time range
+func (c *Client) NotificationCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberCountByScoreRange(conn, NotificationCollectionCreated, start, end)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// NotificationCountByCategoriesAndLabels returns the count of Notification associated with specified categories and labels from the database
+func (c *Client) NotificationCountByCategoriesAndLabels(categories []string, labels []string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notifications, edgeXerr := notificationsByCategoriesAndLabels(conn, 0, -1, categories, labels)
+	if edgeXerr != nil {
+		return uint32(0), errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query notifications by categories %v and labels %v", categories, labels), edgeXerr)
+	}
+	return uint32(len(notifications)), nil
+}
+
+// SubscriptionTotalCount returns the total count of Subscription from the database
+func (c *Client) SubscriptionTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, SubscriptionCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// SubscriptionCountByCategory returns the count of Subscription associated with specified category from the database
+func (c *Client) SubscriptionCountByCategory(category string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(SubscriptionCollectionCategory, category))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// SubscriptionCountByLabel returns the count of Subscription associated with specified label from the database
+func (c *Client) SubscriptionCountByLabel(label string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(SubscriptionCollectionLabel, label))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// SubscriptionCountByReceiver returns the count of Subscription associated with specified receiver from the database
+func (c *Client) SubscriptionCountByReceiver(receiver string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(SubscriptionCollectionReceiver, receiver))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// TransmissionTotalCount returns the total count of Transmission from the database
+func (c *Client) TransmissionTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, TransmissionCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// TransmissionCountBySubscriptionName returns the count of Transmission associated with specified subscription name from the database
+func (c *Client) TransmissionCountBySubscriptionName(subscriptionName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(TransmissionCollectionSubscriptionName, subscriptionName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// TransmissionCountByStatus returns the count of Transmission associated with specified status name from the database
+func (c *Client) TransmissionCountByStatus(status string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(TransmissionCollectionStatus, status))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// TransmissionCountByTimeRange returns the count of Transmission from the database within specified time range
+func (c *Client) TransmissionCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberCountByScoreRange(conn, TransmissionCollectionCreated, start, end)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeleteNotificationById deletes a notification by id
+func (c *Client) DeleteNotificationById(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteNotificationById(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the notification with id %s", id), edgeXerr)
+	}
+
+	return nil
+}
+
+// UpdateNotification updates a notification
+func (c *Client) UpdateNotification(n model.Notification) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateNotification(conn, n)
+}
+
+// AddTransmission adds a new transmission
+func (c *Client) AddTransmission(t model.Transmission) (model.Transmission, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+
+	return addTransmission(conn, t)
+}
+
+// UpdateTransmission updates a transmission
+func (c *Client) UpdateTransmission(trans model.Transmission) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateTransmission(conn, trans)
+}
+
+// TransmissionById gets a transmission by id
+func (c *Client) TransmissionById(id string) (trans model.Transmission, edgexErr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	trans, edgexErr = transmissionById(conn, id)
+	if edgexErr != nil {
+		return trans, errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("failed to query transmission by id %s", id), edgexErr)
+	}
+	return
+}
+
+// TransmissionsByTimeRange query transmissions by time range, offset, and limit
+func (c *Client) TransmissionsByTimeRange(start int, end int, offset int, limit int) (transmissions []model.Transmission, err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	transmissions, err = transmissionsByTimeRange(conn, start, end, offset, limit)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query transmissions by time range %v ~ %v, offset %d, and limit %d", start, end, offset, limit), err)
+	}
+	return transmissions, nil
+}
+
+// AllTransmissions returns multiple transmissions per query criteria, including
+// offset: The number of items to skip before starting to collect the result set.
+// limit: The maximum number of items to return.
+func (c *Client) AllTransmissions(offset int, limit int) ([]model.Transmission, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	transmission, err := allTransmissions(conn, offset, limit)
+	if err != nil {
+		return transmission, errors.NewCommonEdgeXWrapper(err)
+	}
+	return transmission, nil
+}
+
+// TransmissionsByStatus queries transmissions by offset, limit and status
+func (c *Client) TransmissionsByStatus(offset int, limit int, status string) (transmissions []model.Transmission, err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	transmissions, err = transmissionsByStatus(conn, offset, limit, status)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query transmissions by offset %d, limit %d and status %s", offset, limit, status), err)
+	}
+	return transmissions, nil
+}
+
+// TransmissionsBySubscriptionName queries transmissions by offset, limit and subscription name
+func (c *Client) TransmissionsBySubscriptionName(offset int, limit int, subscriptionName string) (transmissions []model.Transmission, err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	transmissions, err = transmissionsBySubscriptionName(conn, offset, limit, subscriptionName)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query transmissions by offset %d, limit %d and subscription name %s", offset, limit, subscriptionName), err)
+	}
+	return transmissions, nil
+}
+
+// TransmissionsByNotificationId queries transmissions by offset, limit and notification id
+func (c *Client) TransmissionsByNotificationId(offset int, limit int, id string) (transmissions []model.Transmission, err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	transmissions, err = transmissionsByNotificationId(conn, offset, limit, id)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query transmissions by offset %d, limit %d and notification id %s", offset, limit, id), err)
+	}
+	return transmissions, nil
+}
+
+// TransmissionCountByNotificationId returns the count of Transmission associated with specified notification id from the database
+func (c *Client) TransmissionCountByNotificationId(id string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(TransmissionCollectionNotificationId, id))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// AddEscalationPolicy adds a new escalation policy
+func (c *Client) AddEscalationPolicy(p notificationModels.EscalationPolicy) (notificationModels.EscalationPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(p.Id) == 0 {
+		p.Id = uuid.New().String()
+	}
+
+	return addEscalationPolicy(conn, p)
+}
+
+// EscalationPolicyByName gets an escalation policy by name
+func (c *Client) EscalationPolicyByName(name string) (notificationModels.EscalationPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	policy, edgeXerr := escalationPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return policy, nil
+}
+
+// AllEscalationPolicies query escalation policies with offset and limit
+func (c *Client) AllEscalationPolicies(offset int, limit int) ([]notificationModels.EscalationPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	policies, edgeXerr := allEscalationPolicies(conn, offset, limit)
+	if edgeXerr != nil {
+		return policies, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return policies, nil
+}
+
+// EscalationPoliciesBySubscriptionName query escalation policies of the subscription with offset and limit
+func (c *Client) EscalationPoliciesBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.EscalationPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	policies, edgeXerr := escalationPoliciesBySubscriptionName(conn, offset, limit, subscriptionName)
+	if edgeXerr != nil {
+		return policies, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query escalation policies by offset %d, limit %d and subscription name %s", offset, limit, subscriptionName), edgeXerr)
+	}
+	return policies, nil
+}
+
+// EscalationPolicyTotalCount returns the total count of escalation policies
+func (c *Client) EscalationPolicyTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, EscalationPolicyCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeleteEscalationPolicyByName deletes an escalation policy by name
+func (c *Client) DeleteEscalationPolicyByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteEscalationPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the escalation policy with name %s", name), edgeXerr)
+	}
+
+	return nil
+}
+
+// AddNotificationAcknowledgement records the acknowledgement of a notification
+func (c *Client) AddNotificationAcknowledgement(ack notificationModels.NotificationAcknowledgement) (notificationModels.NotificationAcknowledgement, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return addNotificationAcknowledgement(conn, ack)
+}
+
+// NotificationAcknowledgement gets the acknowledgement of a notification
+func (c *Client) NotificationAcknowledgement(notificationId string) (notificationModels.NotificationAcknowledgement, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	subscriptions, edgeXerr = subscriptionsByReceiver(conn, offset, limit, receiver)
+	return notificationAcknowledgement(conn, notificationId)
+}
+
+// UnacknowledgedNotifications queries the notifications not acknowledged yet with offset and limit, filtered by
+// category and by severity when they aren't empty
+func (c *Client) UnacknowledgedNotifications(offset int, limit int, category string, severity string) ([]model.Notification, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notifications, edgeXerr := unacknowledgedNotifications(conn, offset, limit, category, severity)
 	if edgeXerr != nil {
-		return subscriptions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query subscriptions by offset %d, limit %d and receiver %s", offset, limit, receiver), edgeXerr)
+		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query unacknowledged notifications by category '%s' and severity '%s'", category, severity), edgeXerr)
 	}
-	return subscriptions, nil
+	return notifications, nil
 }
 
-// SubscriptionById gets a subscription by id
-func (c *Client) SubscriptionById(id string) (subscription model.Subscription, edgexErr errors.EdgeX) {
+// UnacknowledgedNotificationCount returns the count of the notifications not acknowledged yet, filtered by category
+// and by severity when they aren't empty
+func (c *Client) UnacknowledgedNotificationCount(category string, severity string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if category == "" && severity == "" {
+		count, edgeXerr := getMemberNumber(conn, ZCARD, NotificationCollectionUnacknowledged)
+		if edgeXerr != nil {
+			return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		return count, nil
+	}
+	notifications, edgeXerr := unacknowledgedNotifications(conn, 0, -1, category, severity)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to count unacknowledged notifications by category '%s' and severity '%s'", category, severity), edgeXerr)
+	}
+	return uint32(len(notifications)), nil
+}
+
+// AddNotificationTemplate adds a new notification template
+func (c *Client) AddNotificationTemplate(t notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+
+	return addNotificationTemplate(conn, t)
+}
+
+// NotificationTemplateByName gets a notification template by name
+func (c *Client) NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	template, edgeXerr := notificationTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return template, nil
+}
+
+// NotificationTemplateBySubscriptionNameAndChannelType gets the notification template of a subscription for a channel type
+func (c *Client) NotificationTemplateBySubscriptionNameAndChannelType(subscriptionName string, channelType string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	template, edgeXerr := notificationTemplateBySubscriptionNameAndChannelType(conn, subscriptionName, channelType)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return template, nil
+}
+
+// AllNotificationTemplates query notification templates with offset and limit
+func (c *Client) AllNotificationTemplates(offset int, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	templates, edgeXerr := allNotificationTemplates(conn, offset, limit)
+	if edgeXerr != nil {
+		return templates, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return templates, nil
+}
+
+// NotificationTemplateTotalCount returns the total count of notification templates
+func (c *Client) NotificationTemplateTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, NotificationTemplateCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeleteNotificationTemplateByName deletes a notification template by name
+func (c *Client) DeleteNotificationTemplateByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteNotificationTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the notification template with name %s", name), edgeXerr)
+	}
+
+	return nil
+}
+
+// AddDigest adds a new digest
+func (c *Client) AddDigest(d notificationModels.Digest) (notificationModels.Digest, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(d.Id) == 0 {
+		d.Id = uuid.New().String()
+	}
+
+	return addDigest(conn, d)
+}
+
+// DigestByName gets a digest by name
+func (c *Client) DigestByName(name string) (notificationModels.Digest, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	digest, edgeXerr := digestByName(conn, name)
+	if edgeXerr != nil {
+		return digest, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return digest, nil
+}
+
+// DigestBySubscriptionName gets the digest of a subscription
+func (c *Client) DigestBySubscriptionName(subscriptionName string) (notificationModels.Digest, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	digest, edgeXerr := digestBySubscriptionName(conn, subscriptionName)
+	if edgeXerr != nil {
+		return digest, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return digest, nil
+}
+
+// AllDigests query digests with offset and limit
+func (c *Client) AllDigests(offset int, limit int) ([]notificationModels.Digest, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	digests, edgeXerr := allDigests(conn, offset, limit)
+	if edgeXerr != nil {
+		return digests, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return digests, nil
+}
+
+// DigestTotalCount returns the total count of digests
+func (c *Client) DigestTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DigestCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeleteDigestByName deletes a digest by name
+func (c *Client) DeleteDigestByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDigestByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the digest with name %s", name), edgeXerr)
+	}
+
+	return nil
+}
+
+// AddDigestedNotification adds a notification to the pending notifications of a subscription digest
+func (c *Client) AddDigestedNotification(subscriptionName string, n model.Notification) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return addDigestedNotification(conn, subscriptionName, n)
+}
+
+// DigestedNotificationCount returns the count of the pending notifications of a subscription digest
+func (c *Client) DigestedNotificationCount(subscriptionName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DigestCollectionPending, subscriptionName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// PopDigestedNotificationIds removes and returns the ids of the pending notifications of a subscription digest
+func (c *Client) PopDigestedNotificationIds(subscriptionName string) ([]string, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return popDigestedNotificationIds(conn, subscriptionName)
+}
+
+// AddNotificationOccurrence records the occurrence of a notification identified by its deduplication key, and returns
+// whether the notification is suppressed as a duplicate
+func (c *Client) AddNotificationOccurrence(deduplicationKey string, notificationId string, window time.Duration) (bool, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return addNotificationOccurrence(conn, deduplicationKey, notificationId, window)
+}
+
+// PopSuppressedNotificationCount removes and returns the count of the suppressed duplicates of a notification
+func (c *Client) PopSuppressedNotificationCount(deduplicationKey string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return popSuppressedNotificationCount(conn, deduplicationKey)
+}
+
+// AddConnector adds a new connector
+func (c *Client) AddConnector(connector notificationModels.Connector) (notificationModels.Connector, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(connector.Id) == 0 {
+		connector.Id = uuid.New().String()
+	}
+
+	return addConnector(conn, connector)
+}
+
+// ConnectorByName gets a connector by name
+func (c *Client) ConnectorByName(name string) (notificationModels.Connector, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	connector, edgeXerr := connectorByName(conn, name)
+	if edgeXerr != nil {
+		return connector, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return connector, nil
+}
+
+// AllConnectors query connectors with offset and limit
+func (c *Client) AllConnectors(offset int, limit int) ([]notificationModels.Connector, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	connectors, edgeXerr := allConnectors(conn, offset, limit)
+	if edgeXerr != nil {
+		return connectors, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return connectors, nil
+}
+
+// ConnectorsBySubscriptionName query connectors of the subscription with offset and limit
+func (c *Client) ConnectorsBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.Connector, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	connectors, edgeXerr := connectorsBySubscriptionName(conn, offset, limit, subscriptionName)
+	if edgeXerr != nil {
+		return connectors, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query connectors by offset %d, limit %d and subscription name %s", offset, limit, subscriptionName), edgeXerr)
+	}
+	return connectors, nil
+}
+
+// ConnectorTotalCount returns the total count of connectors
+func (c *Client) ConnectorTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, ConnectorCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeleteConnectorByName deletes a connector by name
+func (c *Client) DeleteConnectorByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteConnectorByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the connector with name %s", name), edgeXerr)
+	}
+
+	return nil
+}
+
+// AddSmsChannel adds a new SMS channel
+func (c *Client) AddSmsChannel(smsChannel notificationModels.SmsChannel) (notificationModels.SmsChannel, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(smsChannel.Id) == 0 {
+		smsChannel.Id = uuid.New().String()
+	}
+
+	return addSmsChannel(conn, smsChannel)
+}
+
+// SmsChannelByName gets an SMS channel by name
+func (c *Client) SmsChannelByName(name string) (notificationModels.SmsChannel, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	smsChannel, edgeXerr := smsChannelByName(conn, name)
+	if edgeXerr != nil {
+		return smsChannel, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return smsChannel, nil
+}
+
+// AllSmsChannels query SMS channels with offset and limit
+func (c *Client) AllSmsChannels(offset int, limit int) ([]notificationModels.SmsChannel, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	smsChannels, edgeXerr := allSmsChannels(conn, offset, limit)
+	if edgeXerr != nil {
+		return smsChannels, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return smsChannels, nil
+}
+
+// SmsChannelsBySubscriptionName query SMS channels of the subscription with offset and limit
+func (c *Client) SmsChannelsBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.SmsChannel, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	smsChannels, edgeXerr := smsChannelsBySubscriptionName(conn, offset, limit, subscriptionName)
+	if edgeXerr != nil {
+		return smsChannels, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query SMS channels by offset %d, limit %d and subscription name %s", offset, limit, subscriptionName), edgeXerr)
+	}
+	return smsChannels, nil
+}
+
+// SmsChannelTotalCount returns the total count of SMS channels
+func (c *Client) SmsChannelTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, SmsChannelCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeleteSmsChannelByName deletes an SMS channel by name
+func (c *Client) DeleteSmsChannelByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteSmsChannelByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the SMS channel with name %s", name), edgeXerr)
+	}
+
+	return nil
+}
+
+// AddSmsMessage records the transmission of an SMS message identified by the message id of the SMS gateway
+func (c *Client) AddSmsMessage(messageId string, transmissionId string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return addSmsMessage(conn, messageId, transmissionId)
+}
+
+// TransmissionIdBySmsMessageId gets the id of the transmission of an SMS message
+func (c *Client) TransmissionIdBySmsMessageId(messageId string) (string, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return transmissionIdBySmsMessageId(conn, messageId)
+}
+
+// AddDeliveryPolicy adds a new delivery policy
+func (c *Client) AddDeliveryPolicy(d notificationModels.DeliveryPolicy) (notificationModels.DeliveryPolicy, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	subscription, edgexErr = subscriptionById(conn, id)
-	if edgexErr != nil {
-		return subscription, errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("failed to query subscription by id %s", id), edgexErr)
+	if len(d.Id) == 0 {
+		d.Id = uuid.New().String()
 	}
 
-	return
+	return addDeliveryPolicy(conn, d)
 }
 
-// SubscriptionByName queries subscription by name
-func (c *Client) SubscriptionByName(name string) (subscription model.Subscription, edgeXerr errors.EdgeX) {
+// DeliveryPolicyByName gets a delivery policy by name
+func (c *Client) DeliveryPolicyByName(name string) (notificationModels.DeliveryPolicy, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	subscription, edgeXerr = subscriptionByName(conn, name)
+	policy, edgeXerr := deliveryPolicyByName(conn, name)
 	if edgeXerr != nil {
-		return subscription, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query subscription by name %s", name), edgeXerr)
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return subscription, nil
+	return policy, nil
 }
 
-// UpdateSubscription updates a new subscription
-func (c *Client) UpdateSubscription(subscription model.Subscription) errors.EdgeX {
+// DeliveryPolicyBySubscriptionName gets the delivery policy of a subscription
+func (c *Client) DeliveryPolicyBySubscriptionName(subscriptionName string) (notificationModels.DeliveryPolicy, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateSubscription(conn, subscription)
+
+	policy, edgeXerr := deliveryPolicyBySubscriptionName(conn, subscriptionName)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return policy, nil
 }
 
-// DeleteSubscriptionByName deletes a subscription by name
-func (c *Client) DeleteSubscriptionByName(name string) errors.EdgeX {
+// AllDeliveryPolicies query delivery policies with offset and limit
+func (c *Client) AllDeliveryPolicies(offset int, limit int) ([]notificationModels.DeliveryPolicy, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteSubscriptionByName(conn, name)
+	policies, edgeXerr := allDeliveryPolicies(conn, offset, limit)
 	if edgeXerr != nil {
-		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the subscription with name %s", name), edgeXerr)
+		return policies, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-
-	return nil
+	return policies, nil
 }
 
-// SubscriptionsByCategoriesAndLabels queries subscriptions by offset, limit, categories and labels
-func (c *Client) SubscriptionsByCategoriesAndLabels(offset int, limit int, categories []string, labels []string) (subscriptions []model.Subscription, edgeXerr errors.EdgeX) {
+// DeliveryPolicyTotalCount returns the total count of delivery policies
+func (c *Client) DeliveryPolicyTotalCount() (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	subscriptions, edgeXerr = subscriptionsByCategoriesAndLabels(conn, offset, limit, categories, labels)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeliveryPolicyCollection)
 	if edgeXerr != nil {
-		return subscriptions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query subscriptions by offset %d, limit %d, categories %v and labels %v", offset, limit, categories, labels), edgeXerr)
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return subscriptions, nil
+
+	return count, nil
 }
 
-// AddNotification adds a new notification
-func (c *Client) AddNotification(notification model.Notification) (model.Notification, errors.EdgeX) {
+// DeleteDeliveryPolicyByName deletes a delivery policy by name
+func (c *Client) DeleteDeliveryPolicyByName(name string) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	if len(notification.Id) == 0 {
-		notification.Id = uuid.New().String()
+	edgeXerr := deleteDeliveryPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the delivery policy with name %s", name), edgeXerr)
 	}
 
-	return addNotification(conn, notification)
+	return nil
 }
 
-// NotificationsByCategory queries notifications by offset, limit and category
-func (c *Client) NotificationsByCategory(offset int, limit int, category string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+// AddQueuedNotification adds a notification to the notifications queued during the quiet hours of a subscription
+func (c *Client) AddQueuedNotification(subscriptionName string, n model.Notification) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	notifications, edgeXerr = notificationsByCategory(conn, offset, limit, category)
-	if edgeXerr != nil {
-		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query notifications by offset %d, limit %d and category %s", offset, limit, category), edgeXerr)
-	}
-	return notifications, nil
+	return addQueuedNotification(conn, subscriptionName, n)
 }
 
-// NotificationsByLabel queries notifications by offset, limit and label
-func (c *Client) NotificationsByLabel(offset int, limit int, label string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+// QueuedNotificationCount returns the count of the notifications queued during the quiet hours of a subscription
+func (c *Client) QueuedNotificationCount(subscriptionName string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	notifications, edgeXerr = notificationsByLabel(conn, offset, limit, label)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeliveryPolicyCollectionQueued, subscriptionName))
 	if edgeXerr != nil {
-		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query notifications by offset %d, limit %d and label %s", offset, limit, label), edgeXerr)
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return notifications, nil
+
+	return count, nil
 }
 
-// NotificationById gets a notification by id
-func (c *Client) NotificationById(id string) (notification model.Notification, edgexErr errors.EdgeX) {
+// PopQueuedNotificationIds removes and returns the ids of the notifications queued during the quiet hours of a subscription
+func (c *Client) PopQueuedNotificationIds(subscriptionName string) ([]string, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	notification, edgexErr = notificationById(conn, id)
-	if edgexErr != nil {
-		return notification, errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("failed to query notification by id %s", id), edgexErr)
-	}
-	return
+	return popQueuedNotificationIds(conn, subscriptionName)
 }
 
-// NotificationsByStatus queries notifications by offset, limit and status
-func (c *Client) NotificationsByStatus(offset int, limit int, status string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+// IncrNotificationRate counts a notification sent to a subscription and returns the count of the notifications sent
+// to the subscription within the rate limit interval
+func (c *Client) IncrNotificationRate(subscriptionName string, interval time.Duration) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	notifications, edgeXerr = notificationsByStatus(conn, offset, limit, status)
-	if edgeXerr != nil {
-		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query notifications by offset %d, limit %d and status %s", offset, limit, status), edgeXerr)
-	}
-	return notifications, nil
+	return incrNotificationRate(conn, subscriptionName, interval)
 }
 
-// NotificationsByTimeRange query notifications by time range, offset, and limit
-func (c *Client) NotificationsByTimeRange(start int, end int, offset int, limit int) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+// AddTransmissionResend adds a new transmission resent from the original transmission
+func (c *Client) AddTransmissionResend(originalId string, resend model.Transmission) (model.Transmission, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	notifications, edgeXerr = notificationsByTimeRange(conn, start, end, offset, limit)
-	if edgeXerr != nil {
-		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query notifications by time range %v ~ %v, offset %d, and limit %d", start, end, offset, limit), edgeXerr)
+	if len(resend.Id) == 0 {
+		resend.Id = uuid.New().String()
 	}
-	return notifications, nil
+
+	return addTransmissionResend(conn, originalId, resend)
 }
 
-// NotificationsByCategoriesAndLabels queries notifications by offset, limit, categories and labels
-func (c *Client) NotificationsByCategoriesAndLabels(offset int, limit int, categories []string, labels []string) (notifications []model.Notification, edgeXerr errors.EdgeX) {
+// TransmissionResends queries the transmissions resent from the original transmission by offset and limit
+func (c *Client) TransmissionResends(offset int, limit int, originalId string) (transmissions []model.Transmission, err errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	notifications, edgeXerr = notificationsByCategoriesAndLabels(conn, offset, limit, categories, labels)
-	if edgeXerr != nil {
-		return notifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
-			fmt.Sprintf("fail to query notifications by offset %d, limit %d, categories %v and labels %v", offset, limit, categories, labels), edgeXerr)
+	transmissions, err = transmissionResends(conn, offset, limit, originalId)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
+			fmt.Sprintf("fail to query the resends of transmission %s by offset %d and limit %d", originalId, offset, limit), err)
 	}
-	return notifications, nil
+	return transmissions, nil
 }
 
-// NotificationCountByCategory returns the count of Notification associated with specified category from the database
-func (c *Client) NotificationCountByCategory(category string) (uint32, errors.EdgeX) {
+// TransmissionResendCount returns the count of the transmissions resent from the original transmission
+func (c *Client) TransmissionResendCount(originalId string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(NotificationCollectionCategory, category))
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(TransmissionCollectionResend, originalId))
 	if edgeXerr != nil {
 		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -1360,38 +2722,48 @@ func (c *Client) NotificationCountByCategory(category string) (uint32, errors.Ed
 	return count, nil
 }
 
-// NotificationCountByLabel returns the count of Notification associated with specified label from the database
-func (c *Client) NotificationCountByLabel(label string) (uint32, errors.EdgeX) {
+// AddDeviceCommandAction adds a new device command action
+func (c *Client) AddDeviceCommandAction(action schedulerModels.DeviceCommandAction) (schedulerModels.DeviceCommandAction, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(NotificationCollectionLabel, label))
-	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	if len(action.Id) == 0 {
+		action.Id = uuid.New().String()
 	}
 
-	return count, nil
+	return addDeviceCommandAction(conn, action)
 }
 
-// NotificationCountByStatus returns the count of Notification associated with specified status from the database
-func (c *Client) NotificationCountByStatus(status string) (uint32, errors.EdgeX) {
+// DeviceCommandActionByName gets a device command action by name
+func (c *Client) DeviceCommandActionByName(name string) (schedulerModels.DeviceCommandAction, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(NotificationCollectionStatus, status))
+	action, edgeXerr := deviceCommandActionByName(conn, name)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return action, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	return action, nil
+}
 
-	return count, nil
+// AllDeviceCommandActions query device command actions with offset and limit
+func (c *Client) AllDeviceCommandActions(offset int, limit int) ([]schedulerModels.DeviceCommandAction, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	actions, edgeXerr := allDeviceCommandActions(conn, offset, limit)
+	if edgeXerr != nil {
+		return actions, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return actions, nil
 }
 
-// NotificationCountByTimeRange returns the count of Notification from the database within specified time range
-func (c *Client) NotificationCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
+// DeviceCommandActionTotalCount returns the total count of device command actions
+func (c *Client) DeviceCommandActionTotalCount() (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberCountByScoreRange(conn, NotificationCollectionCreated, start, end)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeviceCommandActionCollection)
 	if edgeXerr != nil {
 		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -1399,63 +2771,61 @@ func (c *Client) NotificationCountByTimeRange(start int, end int) (uint32, error
 	return count, nil
 }
 
-// NotificationCountByCategoriesAndLabels returns the count of Notification associated with specified categories and labels from the database
-func (c *Client) NotificationCountByCategoriesAndLabels(categories []string, labels []string) (uint32, errors.EdgeX) {
+// DeleteDeviceCommandActionByName deletes a device command action by name
+func (c *Client) DeleteDeviceCommandActionByName(name string) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	notifications, edgeXerr := notificationsByCategoriesAndLabels(conn, 0, -1, categories, labels)
+	edgeXerr := deleteDeviceCommandActionByName(conn, name)
 	if edgeXerr != nil {
-		return uint32(0), errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query notifications by categories %v and labels %v", categories, labels), edgeXerr)
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device command action with name %s", name), edgeXerr)
 	}
-	return uint32(len(notifications)), nil
+
+	return nil
 }
 
-// SubscriptionTotalCount returns the total count of Subscription from the database
-func (c *Client) SubscriptionTotalCount() (uint32, errors.EdgeX) {
+// AddMessageBusAction adds a new message bus action
+func (c *Client) AddMessageBusAction(action schedulerModels.MessageBusAction) (schedulerModels.MessageBusAction, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, SubscriptionCollection)
-	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	if len(action.Id) == 0 {
+		action.Id = uuid.New().String()
 	}
 
-	return count, nil
+	return addMessageBusAction(conn, action)
 }
 
-// SubscriptionCountByCategory returns the count of Subscription associated with specified category from the database
-func (c *Client) SubscriptionCountByCategory(category string) (uint32, errors.EdgeX) {
+// MessageBusActionByName gets a message bus action by name
+func (c *Client) MessageBusActionByName(name string) (schedulerModels.MessageBusAction, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(SubscriptionCollectionCategory, category))
+	action, edgeXerr := messageBusActionByName(conn, name)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return action, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-
-	return count, nil
+	return action, nil
 }
 
-// SubscriptionCountByLabel returns the count of Subscription associated with specified label from the database
-func (c *Client) SubscriptionCountByLabel(label string) (uint32, errors.EdgeX) {
+// AllMessageBusActions query message bus actions with offset and limit
+func (c *Client) AllMessageBusActions(offset int, limit int) ([]schedulerModels.MessageBusAction, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(SubscriptionCollectionLabel, label))
+	actions, edgeXerr := allMessageBusActions(conn, offset, limit)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return actions, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-
-	return count, nil
+	return actions, nil
 }
 
-// SubscriptionCountByReceiver returns the count of Subscription associated with specified receiver from the database
-func (c *Client) SubscriptionCountByReceiver(receiver string) (uint32, errors.EdgeX) {
+// MessageBusActionTotalCount returns the total count of message bus actions
+func (c *Client) MessageBusActionTotalCount() (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(SubscriptionCollectionReceiver, receiver))
+	count, edgeXerr := getMemberNumber(conn, ZCARD, MessageBusActionCollection)
 	if edgeXerr != nil {
 		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -1463,51 +2833,49 @@ func (c *Client) SubscriptionCountByReceiver(receiver string) (uint32, errors.Ed
 	return count, nil
 }
 
-// TransmissionTotalCount returns the total count of Transmission from the database
-func (c *Client) TransmissionTotalCount() (uint32, errors.EdgeX) {
+// DeleteMessageBusActionByName deletes a message bus action by name
+func (c *Client) DeleteMessageBusActionByName(name string) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, TransmissionCollection)
+	edgeXerr := deleteMessageBusActionByName(conn, name)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the message bus action with name %s", name), edgeXerr)
 	}
 
-	return count, nil
+	return nil
 }
 
-// TransmissionCountBySubscriptionName returns the count of Transmission associated with specified subscription name from the database
-func (c *Client) TransmissionCountBySubscriptionName(subscriptionName string) (uint32, errors.EdgeX) {
+// AddJobRun adds a new job run and keeps the latest runs of the job up to the history limit, 0 keeps all the runs
+func (c *Client) AddJobRun(run schedulerModels.JobRun, historyLimit int) (schedulerModels.JobRun, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(TransmissionCollectionSubscriptionName, subscriptionName))
-	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	if len(run.Id) == 0 {
+		run.Id = uuid.New().String()
 	}
 
-	return count, nil
+	return addJobRun(conn, run, historyLimit)
 }
 
-// TransmissionCountByStatus returns the count of Transmission associated with specified status name from the database
-func (c *Client) TransmissionCountByStatus(status string) (uint32, errors.EdgeX) {
+// JobRuns query the job runs matching the query with offset and limit
+func (c *Client) JobRuns(offset int, limit int, query schedulerModels.JobRunQuery) ([]schedulerModels.JobRun, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(TransmissionCollectionStatus, status))
+	runs, edgeXerr := jobRuns(conn, offset, limit, query)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return runs, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query job runs", edgeXerr)
 	}
-
-	return count, nil
+	return runs, nil
 }
 
-// TransmissionCountByTimeRange returns the count of Transmission from the database within specified time range
-func (c *Client) TransmissionCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
+// JobRunCount returns the count of the job runs matching the query
+func (c *Client) JobRunCount(query schedulerModels.JobRunQuery) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberCountByScoreRange(conn, TransmissionCollectionCreated, start, end)
+	count, edgeXerr := jobRunCount(conn, query)
 	if edgeXerr != nil {
 		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -1515,132 +2883,109 @@ func (c *Client) TransmissionCountByTimeRange(start int, end int) (uint32, error
 	return count, nil
 }
 
-// DeleteNotificationById deletes a notification by id
-func (c *Client) DeleteNotificationById(id string) errors.EdgeX {
+// DeleteJobRunsByAge deletes the job runs started more than age milliseconds ago
+func (c *Client) DeleteJobRunsByAge(age int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	edgeXerr := deleteNotificationById(conn, id)
+	edgeXerr := deleteJobRunsByAge(conn, age)
 	if edgeXerr != nil {
-		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the notification with id %s", id), edgeXerr)
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the job runs older than %d milliseconds", age), edgeXerr)
 	}
 
 	return nil
 }
 
-// UpdateNotification updates a notification
-func (c *Client) UpdateNotification(n model.Notification) errors.EdgeX {
-	conn := c.Pool.Get()
-	defer conn.Close()
-	return updateNotification(conn, n)
-}
-
-// AddTransmission adds a new transmission
-func (c *Client) AddTransmission(t model.Transmission) (model.Transmission, errors.EdgeX) {
+// JobRunsByIntervalName query runs of the jobs of the interval with offset and limit
+func (c *Client) JobRunsByIntervalName(offset int, limit int, intervalName string) ([]schedulerModels.JobRun, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	if len(t.Id) == 0 {
-		t.Id = uuid.New().String()
+	runs, edgeXerr := jobRunsByIntervalName(conn, offset, limit, intervalName)
+	if edgeXerr != nil {
+		return runs, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query job runs by interval name %s", intervalName), edgeXerr)
 	}
-
-	return addTransmission(conn, t)
-}
-
-// UpdateTransmission updates a transmission
-func (c *Client) UpdateTransmission(trans model.Transmission) errors.EdgeX {
-	conn := c.Pool.Get()
-	defer conn.Close()
-	return updateTransmission(conn, trans)
+	return runs, nil
 }
 
-// TransmissionById gets a transmission by id
-func (c *Client) TransmissionById(id string) (trans model.Transmission, edgexErr errors.EdgeX) {
+// JobRunCountByIntervalName returns the count of runs of the jobs of the interval
+func (c *Client) JobRunCountByIntervalName(intervalName string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	trans, edgexErr = transmissionById(conn, id)
-	if edgexErr != nil {
-		return trans, errors.NewCommonEdgeX(errors.Kind(edgexErr), fmt.Sprintf("failed to query transmission by id %s", id), edgexErr)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(JobRunCollectionIntervalName, intervalName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return
+
+	return count, nil
 }
 
-// TransmissionsByTimeRange query transmissions by time range, offset, and limit
-func (c *Client) TransmissionsByTimeRange(start int, end int, offset int, limit int) (transmissions []model.Transmission, err errors.EdgeX) {
+// AddCalendar adds a new calendar
+func (c *Client) AddCalendar(calendar schedulerModels.Calendar) (schedulerModels.Calendar, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	transmissions, err = transmissionsByTimeRange(conn, start, end, offset, limit)
-	if err != nil {
-		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
-			fmt.Sprintf("fail to query transmissions by time range %v ~ %v, offset %d, and limit %d", start, end, offset, limit), err)
+	if len(calendar.Id) == 0 {
+		calendar.Id = uuid.New().String()
 	}
-	return transmissions, nil
+
+	return addCalendar(conn, calendar)
 }
 
-// AllTransmissions returns multiple transmissions per query criteria, including
-// offset: The number of items to skip before starting to collect the result set.
-// limit: The maximum number of items to return.
-func (c *Client) AllTransmissions(offset int, limit int) ([]model.Transmission, errors.EdgeX) {
+// CalendarByName gets a calendar by name
+func (c *Client) CalendarByName(name string) (schedulerModels.Calendar, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	transmission, err := allTransmissions(conn, offset, limit)
-	if err != nil {
-		return transmission, errors.NewCommonEdgeXWrapper(err)
+	calendar, edgeXerr := calendarByName(conn, name)
+	if edgeXerr != nil {
+		return calendar, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return transmission, nil
+	return calendar, nil
 }
 
-// TransmissionsByStatus queries transmissions by offset, limit and status
-func (c *Client) TransmissionsByStatus(offset int, limit int, status string) (transmissions []model.Transmission, err errors.EdgeX) {
+// AllCalendars query calendars with offset and limit
+func (c *Client) AllCalendars(offset int, limit int) ([]schedulerModels.Calendar, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	transmissions, err = transmissionsByStatus(conn, offset, limit, status)
-	if err != nil {
-		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
-			fmt.Sprintf("fail to query transmissions by offset %d, limit %d and status %s", offset, limit, status), err)
+	calendars, edgeXerr := allCalendars(conn, offset, limit)
+	if edgeXerr != nil {
+		return calendars, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return transmissions, nil
+	return calendars, nil
 }
 
-// TransmissionsBySubscriptionName queries transmissions by offset, limit and subscription name
-func (c *Client) TransmissionsBySubscriptionName(offset int, limit int, subscriptionName string) (transmissions []model.Transmission, err errors.EdgeX) {
+// CalendarTotalCount returns the total count of Calendars
+func (c *Client) CalendarTotalCount() (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	transmissions, err = transmissionsBySubscriptionName(conn, offset, limit, subscriptionName)
-	if err != nil {
-		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
-			fmt.Sprintf("fail to query transmissions by offset %d, limit %d and subscription name %s", offset, limit, subscriptionName), err)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CalendarCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return transmissions, nil
+
+	return count, nil
 }
 
-// TransmissionsByNotificationId queries transmissions by offset, limit and notification id
-func (c *Client) TransmissionsByNotificationId(offset int, limit int, id string) (transmissions []model.Transmission, err errors.EdgeX) {
+// UpdateCalendar updates a calendar and returns it as stored
+func (c *Client) UpdateCalendar(calendar schedulerModels.Calendar) (schedulerModels.Calendar, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
-
-	transmissions, err = transmissionsByNotificationId(conn, offset, limit, id)
-	if err != nil {
-		return transmissions, errors.NewCommonEdgeX(errors.Kind(err),
-			fmt.Sprintf("fail to query transmissions by offset %d, limit %d and notification id %s", offset, limit, id), err)
-	}
-	return transmissions, nil
+	return updateCalendar(conn, calendar)
 }
 
-// TransmissionCountByNotificationId returns the count of Transmission associated with specified notification id from the database
-func (c *Client) TransmissionCountByNotificationId(id string) (uint32, errors.EdgeX) {
+// DeleteCalendarByName deletes a calendar by name
+func (c *Client) DeleteCalendarByName(name string) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(TransmissionCollectionNotificationId, id))
+	edgeXerr := deleteCalendarByName(conn, name)
 	if edgeXerr != nil {
-		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the calendar with name %s", name), edgeXerr)
 	}
 
-	return count, nil
+	return nil
 }
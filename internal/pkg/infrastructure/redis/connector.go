@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	ConnectorCollection             = "sn|conn"
+	ConnectorCollectionName         = ConnectorCollection + DBKeySeparator + common.Name
+	ConnectorCollectionSubscription = ConnectorCollection + DBKeySeparator + common.Subscription
+)
+
+// connectorStoredKey return the connector's stored key which combines the collection name and object id
+func connectorStoredKey(id string) string {
+	return CreateKey(ConnectorCollection, id)
+}
+
+// sendAddConnectorCmd send redis command for adding connector
+func sendAddConnectorCmd(conn redis.Conn, storedKey string, c notificationModels.Connector) errors.EdgeX {
+	m, err := json.Marshal(c)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal connector for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, ConnectorCollection, c.Modified, storedKey)
+	_ = conn.Send(HSET, ConnectorCollectionName, c.Name, storedKey)
+	_ = conn.Send(ZADD, CreateKey(ConnectorCollectionSubscription, c.SubscriptionName), c.Modified, storedKey)
+	return nil
+}
+
+// addConnector adds a new connector into DB
+func addConnector(conn redis.Conn, c notificationModels.Connector) (notificationModels.Connector, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, connectorStoredKey(c.Id))
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return c, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("connector id %s already exists", c.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, ConnectorCollectionName, c.Name)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return c, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("connector name %s already exists", c.Name), edgeXerr)
+	}
+
+	c.Created = pkgCommon.MakeTimestamp()
+	c.Modified = c.Created
+
+	storedKey := connectorStoredKey(c.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddConnectorCmd(conn, storedKey, c)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "connector creation failed", err)
+	}
+
+	return c, edgeXerr
+}
+
+// connectorByName query connector by name from DB
+func connectorByName(conn redis.Conn, name string) (connector notificationModels.Connector, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, ConnectorCollectionName, name, &connector)
+	if edgeXerr != nil {
+		return connector, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query connector by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allConnectors query connectors with offset and limit, the most recently modified first
+func allConnectors(conn redis.Conn, offset int, limit int) ([]notificationModels.Connector, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, ConnectorCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToConnectors(objects)
+}
+
+// connectorsBySubscriptionName query connectors of the subscription with offset and limit
+func connectorsBySubscriptionName(conn redis.Conn, offset int, limit int, subscriptionName string) ([]notificationModels.Connector, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(ConnectorCollectionSubscription, subscriptionName), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToConnectors(objects)
+}
+
+func convertObjectsToConnectors(objects [][]byte) ([]notificationModels.Connector, errors.EdgeX) {
+	connectors := make([]notificationModels.Connector, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &connectors[i])
+		if err != nil {
+			return []notificationModels.Connector{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "connector format parsing failed from the database", err)
+		}
+	}
+	return connectors, nil
+}
+
+// sendDeleteConnectorCmd send redis command for deleting connector
+func sendDeleteConnectorCmd(conn redis.Conn, storedKey string, c notificationModels.Connector) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, ConnectorCollection, storedKey)
+	_ = conn.Send(HDEL, ConnectorCollectionName, c.Name)
+	_ = conn.Send(ZREM, CreateKey(ConnectorCollectionSubscription, c.SubscriptionName), storedKey)
+}
+
+// deleteConnectorByName deletes the connector by name
+func deleteConnectorByName(conn redis.Conn, name string) errors.EdgeX {
+	connector, edgeXerr := connectorByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteConnectorCmd(conn, connectorStoredKey(connector.Id), connector)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "connector deletion failed", err)
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ const (
 	DEL              = "DEL"
 	HSET             = "HSET"
 	HGET             = "HGET"
+	HSETNX           = "HSETNX"
 	HEXISTS          = "HEXISTS"
 	HDEL             = "HDEL"
 	SADD             = "SADD"
@@ -24,6 +25,7 @@ const (
 	EXEC             = "EXEC"
 	ZRANGE           = "ZRANGE"
 	ZREVRANGE        = "ZREVRANGE"
+	ZREVRANK         = "ZREVRANK"
 	MGET             = "MGET"
 	ZCARD            = "ZCARD"
 	ZCOUNT           = "ZCOUNT"
@@ -33,6 +35,27 @@ const (
 	LIMIT            = "LIMIT"
 	ZUNIONSTORE      = "ZUNIONSTORE"
 	ZINTERSTORE      = "ZINTERSTORE"
+	SCAN             = "SCAN"
+	MATCH            = "MATCH"
+	COUNT            = "COUNT"
+	WITHSCORES       = "WITHSCORES"
+	STRLEN           = "STRLEN"
+	INCR             = "INCR"
+	ZREMRANGEBYRANK  = "ZREMRANGEBYRANK"
+	GEOADD           = "GEOADD"
+	GEOSEARCH        = "GEOSEARCH"
+	FROMLONLAT       = "FROMLONLAT"
+	BYRADIUS         = "BYRADIUS"
+	WITHCOORD        = "WITHCOORD"
+	ASC              = "ASC"
+	NX               = "NX"
+	PX               = "PX"
+	PEXPIRE          = "PEXPIRE"
+	ZREMRANGEBYSCORE = "ZREMRANGEBYSCORE"
+	HGETALL          = "HGETALL"
+	WATCH            = "WATCH"
+	UNWATCH          = "UNWATCH"
+	DISCARD          = "DISCARD"
 )
 
 const (
@@ -40,4 +63,5 @@ const (
 	InfiniteMax     = "+inf"
 	GreaterThanZero = "(0"
 	DBKeySeparator  = ":"
+	GeoUnitMeter    = "m"
 )
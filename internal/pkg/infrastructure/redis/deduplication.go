@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// NotificationDeduplicationCollection is the prefix of the keys of the notifications distributed within the
+	// suppression window, which expire with the window and hold the id of the distributed notification
+	NotificationDeduplicationCollection = NotificationCollection + DBKeySeparator + "dedup"
+	// NotificationDeduplicationCollectionSuppressed is the prefix of the counters of the notifications suppressed
+	// within the suppression window
+	NotificationDeduplicationCollectionSuppressed = NotificationDeduplicationCollection + DBKeySeparator + "suppressed"
+)
+
+// addNotificationOccurrence records the occurrence of the notification identified by the deduplication key, and
+// returns true when the notification is suppressed as a duplicate of a notification distributed within the window
+func addNotificationOccurrence(conn redis.Conn, deduplicationKey string, notificationId string, window time.Duration) (bool, errors.EdgeX) {
+	reply, err := conn.Do(SET, CreateKey(NotificationDeduplicationCollection, deduplicationKey), notificationId, NX, PX, window.Milliseconds())
+	if err != nil {
+		return false, errors.NewCommonEdgeX(errors.KindDatabaseError, "notification occurrence creation failed", err)
+	}
+	if reply != nil {
+		// the first occurrence within the window
+		return false, nil
+	}
+
+	suppressedKey := CreateKey(NotificationDeduplicationCollectionSuppressed, deduplicationKey)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(INCR, suppressedKey)
+	// the counter outlives the window, so that the suppressed occurrences are reported after the window
+	_ = conn.Send(PEXPIRE, suppressedKey, (2 * window).Milliseconds())
+	if _, err = conn.Do(EXEC); err != nil {
+		return false, errors.NewCommonEdgeX(errors.KindDatabaseError, "suppressed notification count update failed", err)
+	}
+	return true, nil
+}
+
+// popSuppressedNotificationCount removes and returns the count of the notifications suppressed within the window
+func popSuppressedNotificationCount(conn redis.Conn, deduplicationKey string) (uint32, errors.EdgeX) {
+	suppressedKey := CreateKey(NotificationDeduplicationCollectionSuppressed, deduplicationKey)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(GET, suppressedKey)
+	_ = conn.Send(DEL, suppressedKey)
+	values, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "suppressed notification count retrieval failed", err)
+	}
+	if values[0] == nil {
+		return 0, nil
+	}
+	count, err := redis.Int(values[0], nil)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "suppressed notification count parsing failed", err)
+	}
+	return uint32(count), nil
+}
@@ -0,0 +1,204 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	DeliveryPolicyCollection             = "sn|dlvy"
+	DeliveryPolicyCollectionName         = DeliveryPolicyCollection + DBKeySeparator + common.Name
+	DeliveryPolicyCollectionSubscription = DeliveryPolicyCollection + DBKeySeparator + common.Subscription
+	// DeliveryPolicyCollectionQueued is the prefix of the sorted sets of the ids of the notifications queued during the
+	// quiet hours of a subscription, scored by the notification created timestamp
+	DeliveryPolicyCollectionQueued = DeliveryPolicyCollection + DBKeySeparator + "queued"
+	// DeliveryPolicyCollectionRate is the prefix of the counters of the notifications sent to a subscription within the
+	// current rate limit interval, which expire with the interval
+	DeliveryPolicyCollectionRate = DeliveryPolicyCollection + DBKeySeparator + "rate"
+)
+
+// deliveryPolicyStoredKey return the delivery policy's stored key which combines the collection name and object id
+func deliveryPolicyStoredKey(id string) string {
+	return CreateKey(DeliveryPolicyCollection, id)
+}
+
+// sendAddDeliveryPolicyCmd send redis command for adding delivery policy
+func sendAddDeliveryPolicyCmd(conn redis.Conn, storedKey string, d notificationModels.DeliveryPolicy) errors.EdgeX {
+	m, err := json.Marshal(d)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal delivery policy for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeliveryPolicyCollection, d.Modified, storedKey)
+	_ = conn.Send(HSET, DeliveryPolicyCollectionName, d.Name, storedKey)
+	_ = conn.Send(HSET, DeliveryPolicyCollectionSubscription, d.SubscriptionName, storedKey)
+	return nil
+}
+
+// addDeliveryPolicy adds a new delivery policy into DB
+func addDeliveryPolicy(conn redis.Conn, d notificationModels.DeliveryPolicy) (notificationModels.DeliveryPolicy, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deliveryPolicyStoredKey(d.Id))
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("delivery policy id %s already exists", d.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, DeliveryPolicyCollectionName, d.Name)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("delivery policy name %s already exists", d.Name), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, DeliveryPolicyCollectionSubscription, d.SubscriptionName)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("subscription %s already has a delivery policy", d.SubscriptionName), edgeXerr)
+	}
+
+	d.Created = pkgCommon.MakeTimestamp()
+	d.Modified = d.Created
+
+	storedKey := deliveryPolicyStoredKey(d.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeliveryPolicyCmd(conn, storedKey, d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "delivery policy creation failed", err)
+	}
+
+	return d, edgeXerr
+}
+
+// deliveryPolicyByName query delivery policy by name from DB
+func deliveryPolicyByName(conn redis.Conn, name string) (policy notificationModels.DeliveryPolicy, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeliveryPolicyCollectionName, name, &policy)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query delivery policy by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// deliveryPolicyBySubscriptionName query the delivery policy of the subscription from DB
+func deliveryPolicyBySubscriptionName(conn redis.Conn, subscriptionName string) (policy notificationModels.DeliveryPolicy, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeliveryPolicyCollectionSubscription, subscriptionName, &policy)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query delivery policy by subscription name %s", subscriptionName), edgeXerr)
+	}
+	return
+}
+
+// allDeliveryPolicies query delivery policies with offset and limit, the most recently modified first
+func allDeliveryPolicies(conn redis.Conn, offset int, limit int) ([]notificationModels.DeliveryPolicy, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeliveryPolicyCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	policies := make([]notificationModels.DeliveryPolicy, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &policies[i])
+		if err != nil {
+			return []notificationModels.DeliveryPolicy{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "delivery policy format parsing failed from the database", err)
+		}
+	}
+	return policies, nil
+}
+
+// sendDeleteDeliveryPolicyCmd send redis command for deleting delivery policy, the notifications queued by the delivery policy are kept
+func sendDeleteDeliveryPolicyCmd(conn redis.Conn, storedKey string, d notificationModels.DeliveryPolicy) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeliveryPolicyCollection, storedKey)
+	_ = conn.Send(HDEL, DeliveryPolicyCollectionName, d.Name)
+	_ = conn.Send(HDEL, DeliveryPolicyCollectionSubscription, d.SubscriptionName)
+}
+
+// deleteDeliveryPolicyByName deletes the delivery policy by name
+func deleteDeliveryPolicyByName(conn redis.Conn, name string) errors.EdgeX {
+	policy, edgeXerr := deliveryPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDeliveryPolicyCmd(conn, deliveryPolicyStoredKey(policy.Id), policy)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "delivery policy deletion failed", err)
+	}
+	return nil
+}
+
+// addQueuedNotification adds the notification to the notifications queued during the quiet hours of the subscription
+// and returns the number of the queued notifications
+func addQueuedNotification(conn redis.Conn, subscriptionName string, n models.Notification) (uint32, errors.EdgeX) {
+	queuedKey := CreateKey(DeliveryPolicyCollectionQueued, subscriptionName)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZADD, queuedKey, n.Created, n.Id)
+	_ = conn.Send(ZCARD, queuedKey)
+	values, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "queued notification creation failed", err)
+	}
+	count, err := redis.Int(values[1], nil)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "queued notification count parsing failed", err)
+	}
+	return uint32(count), nil
+}
+
+// popQueuedNotificationIds removes and returns the ids of the notifications queued during the quiet hours of the
+// subscription, the oldest first
+func popQueuedNotificationIds(conn redis.Conn, subscriptionName string) ([]string, errors.EdgeX) {
+	queuedKey := CreateKey(DeliveryPolicyCollectionQueued, subscriptionName)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZRANGE, queuedKey, 0, -1)
+	_ = conn.Send(DEL, queuedKey)
+	values, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "queued notifications retrieval failed", err)
+	}
+	ids, err := redis.Strings(values[0], nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "queued notification ids parsing failed", err)
+	}
+	return ids, nil
+}
+
+// incrNotificationRate counts the notification sent to the subscription within the rate limit interval and returns the
+// number of the notifications sent within the interval, the interval starts with its first notification
+func incrNotificationRate(conn redis.Conn, subscriptionName string, interval time.Duration) (uint32, errors.EdgeX) {
+	rateKey := CreateKey(DeliveryPolicyCollectionRate, subscriptionName)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, rateKey, 0, NX, PX, interval.Milliseconds())
+	_ = conn.Send(INCR, rateKey)
+	values, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "notification rate update failed", err)
+	}
+	count, err := redis.Int(values[1], nil)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "notification rate parsing failed", err)
+	}
+	return uint32(count), nil
+}
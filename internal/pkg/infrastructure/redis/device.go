@@ -8,6 +8,7 @@ package redis
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
@@ -24,8 +25,44 @@ const (
 	DeviceCollectionLabel       = DeviceCollection + DBKeySeparator + common.Label
 	DeviceCollectionServiceName = DeviceCollection + DBKeySeparator + common.Service + DBKeySeparator + common.Name
 	DeviceCollectionProfileName = DeviceCollection + DBKeySeparator + common.Profile + DBKeySeparator + common.Name
+	DeviceCollectionAttribute   = DeviceCollection + DBKeySeparator + pkgCommon.Attribute
+	DeviceCollectionLifecycle   = DeviceCollection + DBKeySeparator + pkgCommon.Lifecycle
+	DeviceCollectionLocation    = DeviceCollection + DBKeySeparator + pkgCommon.Location
 )
 
+// deviceAttributeKeys returns the keys of the attribute indexes of the device, the attributes which are invalid aren't
+// indexed
+func deviceAttributeKeys(d models.Device) []string {
+	attributes, err := pkgCommon.DeviceAttributesFromProperties(d.Properties)
+	if err != nil {
+		return nil
+	}
+	keys := make([]string, 0, len(attributes))
+	for key, value := range attributes {
+		keys = append(keys, CreateKey(DeviceCollectionAttribute, key, value))
+	}
+	return keys
+}
+
+// deviceLifecycleKey returns the key of the lifecycle state index of the device, empty when the device has no valid
+// lifecycle state
+func deviceLifecycleKey(d models.Device) string {
+	state, err := pkgCommon.DeviceLifecycleStateFromProperties(d.Properties)
+	if err != nil || state == "" {
+		return ""
+	}
+	return CreateKey(DeviceCollectionLifecycle, state)
+}
+
+// devicePosition returns the latitude and longitude of the device, ok is false when the device has no valid position
+func devicePosition(d models.Device) (latitude float64, longitude float64, ok bool) {
+	location, err := pkgCommon.DeviceGeoLocationFromLocation(d.Location)
+	if err != nil || location == nil || !location.HasPosition() {
+		return 0, 0, false
+	}
+	return *location.Latitude, *location.Longitude, true
+}
+
 // deviceStoredKey return the device's stored key which combines the collection name and object id
 func deviceStoredKey(id string) string {
 	return CreateKey(DeviceCollection, id)
@@ -63,6 +100,15 @@ func sendAddDeviceCmd(conn redis.Conn, storedKey string, d models.Device) errors
 	for _, label := range d.Labels {
 		_ = conn.Send(ZADD, CreateKey(DeviceCollectionLabel, label), d.Modified, storedKey)
 	}
+	for _, key := range deviceAttributeKeys(d) {
+		_ = conn.Send(ZADD, key, d.Modified, storedKey)
+	}
+	if key := deviceLifecycleKey(d); key != "" {
+		_ = conn.Send(ZADD, key, d.Modified, storedKey)
+	}
+	if latitude, longitude, ok := devicePosition(d); ok {
+		_ = conn.Send(GEOADD, DeviceCollectionLocation, longitude, latitude, storedKey)
+	}
 	return nil
 }
 
@@ -163,6 +209,15 @@ func sendDeleteDeviceCmd(conn redis.Conn, storedKey string, device models.Device
 	for _, label := range device.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceCollectionLabel, label), storedKey)
 	}
+	for _, key := range deviceAttributeKeys(device) {
+		_ = conn.Send(ZREM, key, storedKey)
+	}
+	if key := deviceLifecycleKey(device); key != "" {
+		_ = conn.Send(ZREM, key, storedKey)
+	}
+	if _, _, ok := devicePosition(device); ok {
+		_ = conn.Send(ZREM, DeviceCollectionLocation, storedKey)
+	}
 }
 
 // deleteDevice deletes a device
@@ -234,6 +289,148 @@ func devicesByProfileName(conn redis.Conn, offset int, limit int, profileName st
 	return devices, nil
 }
 
+// devicesByAttribute query devices by offset, limit and attribute
+func devicesByAttribute(conn redis.Conn, offset int, limit int, key string, value string) (devices []models.Device, edgeXerr errors.EdgeX) {
+	objects, err := getObjectsByRevRange(conn, CreateKey(DeviceCollectionAttribute, key, value), offset, limit)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		s := models.Device{}
+		err := json.Unmarshal(in, &s)
+		if err != nil {
+			return []models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = s
+	}
+	return devices, nil
+}
+
+// devicesByLifecycleState query devices by offset, limit and lifecycle state
+func devicesByLifecycleState(conn redis.Conn, offset int, limit int, state string) (devices []models.Device, edgeXerr errors.EdgeX) {
+	objects, err := getObjectsByRevRange(conn, CreateKey(DeviceCollectionLifecycle, state), offset, limit)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		s := models.Device{}
+		err := json.Unmarshal(in, &s)
+		if err != nil {
+			return []models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = s
+	}
+	return devices, nil
+}
+
+// devicesByLocationRadius query devices by offset, limit and distance in meters from the position, sorted by distance
+// ascending, and returns the total count of the devices within the distance
+func devicesByLocationRadius(conn redis.Conn, offset int, limit int, latitude float64, longitude float64, radius float64) (devices []models.Device, totalCount uint32, edgeXerr errors.EdgeX) {
+	positions, edgeXerr := devicePositionsByRadius(conn, latitude, longitude, radius)
+	if edgeXerr != nil {
+		return devices, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKeys := make([]string, len(positions))
+	for i, p := range positions {
+		storedKeys[i] = p.storedKey
+	}
+	devices, edgeXerr = devicesByStoredKeys(conn, storedKeys, offset, limit)
+	return devices, uint32(len(storedKeys)), edgeXerr
+}
+
+// devicesByLocationBox query devices by offset, limit and bounding box, sorted by distance ascending from the center of
+// the bounding box, and returns the total count of the devices within the bounding box. The bounding box doesn't cross
+// the antimeridian, i.e. minLongitude isn't greater than maxLongitude.
+func devicesByLocationBox(conn redis.Conn, offset int, limit int, minLatitude float64, minLongitude float64, maxLatitude float64, maxLongitude float64) (devices []models.Device, totalCount uint32, edgeXerr errors.EdgeX) {
+	// search the circle circumscribing the bounding box, then keep the positions within the bounding box. The farthest
+	// points from the center are the corners as long as the bounding box spans at most 180 degrees of longitude,
+	// otherwise the whole Earth is searched.
+	centerLatitude := (minLatitude + maxLatitude) / 2
+	centerLongitude := (minLongitude + maxLongitude) / 2
+	radius := math.Pi * pkgCommon.EarthRadius
+	if maxLongitude-minLongitude <= 180 {
+		radius = math.Max(pkgCommon.GeoDistance(centerLatitude, centerLongitude, minLatitude, minLongitude),
+			pkgCommon.GeoDistance(centerLatitude, centerLongitude, maxLatitude, minLongitude))
+		// margin for the precision of the geospatial index
+		radius = radius*1.01 + 1
+	}
+
+	positions, edgeXerr := devicePositionsByRadius(conn, centerLatitude, centerLongitude, radius)
+	if edgeXerr != nil {
+		return devices, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	var storedKeys []string
+	for _, p := range positions {
+		if p.latitude >= minLatitude && p.latitude <= maxLatitude && p.longitude >= minLongitude && p.longitude <= maxLongitude {
+			storedKeys = append(storedKeys, p.storedKey)
+		}
+	}
+	devices, edgeXerr = devicesByStoredKeys(conn, storedKeys, offset, limit)
+	return devices, uint32(len(storedKeys)), edgeXerr
+}
+
+type devicePositionMember struct {
+	storedKey string
+	latitude  float64
+	longitude float64
+}
+
+// devicePositionsByRadius returns the stored keys and the positions of the devices within the distance in meters
+// from the position, sorted by distance ascending
+func devicePositionsByRadius(conn redis.Conn, latitude float64, longitude float64, radius float64) ([]devicePositionMember, errors.EdgeX) {
+	values, err := redis.Values(conn.Do(GEOSEARCH, DeviceCollectionLocation, FROMLONLAT, longitude, latitude, BYRADIUS, radius, GeoUnitMeter, ASC, WITHCOORD))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the device positions", err)
+	}
+	positions := make([]devicePositionMember, len(values))
+	for i, value := range values {
+		member, err := redis.Values(value, nil)
+		if err != nil || len(member) != 2 {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device position format parsing failed from the database", err)
+		}
+		positions[i].storedKey, err = redis.String(member[0], nil)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device position format parsing failed from the database", err)
+		}
+		coordinates, err := redis.Float64s(member[1], nil)
+		if err != nil || len(coordinates) != 2 {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device position format parsing failed from the database", err)
+		}
+		positions[i].longitude, positions[i].latitude = coordinates[0], coordinates[1]
+	}
+	return positions, nil
+}
+
+// devicesByStoredKeys query the devices by offset and limit among the stored keys, in the order of the stored keys
+func devicesByStoredKeys(conn redis.Conn, storedKeys []string, offset int, limit int) (devices []models.Device, edgeXerr errors.EdgeX) {
+	if offset > len(storedKeys) {
+		return devices, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v", len(storedKeys)), nil)
+	}
+	storedKeys = storedKeys[offset:]
+	if limit >= 0 && limit < len(storedKeys) {
+		storedKeys = storedKeys[:limit]
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(storedKeys))
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		s := models.Device{}
+		err := json.Unmarshal(in, &s)
+		if err != nil {
+			return []models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = s
+	}
+	return devices, nil
+}
+
 func updateDevice(conn redis.Conn, d models.Device) errors.EdgeX {
 	exists, edgeXerr := deviceProfileNameExists(conn, d.ProfileName)
 	if edgeXerr != nil {
@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	DeviceGroupCollection     = "md|dg"
+	DeviceGroupCollectionName = DeviceGroupCollection + DBKeySeparator + common.Name
+)
+
+// deviceGroupStoredKey return the device group's stored key which combines the collection name and object id
+func deviceGroupStoredKey(id string) string {
+	return CreateKey(DeviceGroupCollection, id)
+}
+
+// sendAddDeviceGroupCmd send redis command for adding device group
+func sendAddDeviceGroupCmd(conn redis.Conn, storedKey string, dg metadataModels.DeviceGroup) errors.EdgeX {
+	m, err := json.Marshal(dg)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device group for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceGroupCollection, dg.Modified, storedKey)
+	_ = conn.Send(HSET, DeviceGroupCollectionName, dg.Name, storedKey)
+	return nil
+}
+
+// addDeviceGroup adds a new device group into DB
+func addDeviceGroup(conn redis.Conn, dg metadataModels.DeviceGroup) (metadataModels.DeviceGroup, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deviceGroupStoredKey(dg.Id))
+	if edgeXerr != nil {
+		return dg, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return dg, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device group id %s already exists", dg.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, DeviceGroupCollectionName, dg.Name)
+	if edgeXerr != nil {
+		return dg, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return dg, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device group name %s already exists", dg.Name), edgeXerr)
+	}
+
+	dg.Created = pkgCommon.MakeTimestamp()
+	dg.Modified = dg.Created
+
+	storedKey := deviceGroupStoredKey(dg.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceGroupCmd(conn, storedKey, dg)
+	if edgeXerr != nil {
+		return dg, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device group creation failed", err)
+	}
+
+	return dg, edgeXerr
+}
+
+// deviceGroupByName query device group by name from DB
+func deviceGroupByName(conn redis.Conn, name string) (deviceGroup metadataModels.DeviceGroup, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeviceGroupCollectionName, name, &deviceGroup)
+	if edgeXerr != nil {
+		return deviceGroup, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device group by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allDeviceGroups query device groups with offset and limit, the most recently modified first
+func allDeviceGroups(conn redis.Conn, offset int, limit int) (deviceGroups []metadataModels.DeviceGroup, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeviceGroupCollection, offset, limit)
+	if edgeXerr != nil {
+		return deviceGroups, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceGroups = make([]metadataModels.DeviceGroup, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &deviceGroups[i])
+		if err != nil {
+			return []metadataModels.DeviceGroup{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device group format parsing failed from the database", err)
+		}
+	}
+	return deviceGroups, nil
+}
+
+// sendDeleteDeviceGroupCmd send redis command for deleting device group
+func sendDeleteDeviceGroupCmd(conn redis.Conn, storedKey string, dg metadataModels.DeviceGroup) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceGroupCollection, storedKey)
+	_ = conn.Send(HDEL, DeviceGroupCollectionName, dg.Name)
+}
+
+// deleteDeviceGroupByName deletes the device group by name
+func deleteDeviceGroupByName(conn redis.Conn, name string) errors.EdgeX {
+	deviceGroup, edgeXerr := deviceGroupByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceGroupCmd(conn, deviceGroupStoredKey(deviceGroup.Id), deviceGroup)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device group deletion failed", err)
+	}
+	return nil
+}
+
+// updateDeviceGroup updates a device group in DB
+func updateDeviceGroup(conn redis.Conn, dg metadataModels.DeviceGroup) errors.EdgeX {
+	oldDeviceGroup, edgeXerr := deviceGroupByName(conn, dg.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	dg.Id = oldDeviceGroup.Id
+	dg.Created = oldDeviceGroup.Created
+	dg.Modified = pkgCommon.MakeTimestamp()
+
+	storedKey := deviceGroupStoredKey(dg.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceGroupCmd(conn, storedKey, oldDeviceGroup)
+	edgeXerr = sendAddDeviceGroupCmd(conn, storedKey, dg)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device group update failed", err)
+	}
+
+	return nil
+}
@@ -91,12 +91,21 @@ func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (models.DevicePr
 	}
 	dp.Modified = ts
 
+	version, edgeXerr := latestDeviceProfileVersion(conn, dp.Name)
+	if edgeXerr != nil {
+		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
 	edgeXerr = sendAddDeviceProfileCmd(conn, storedKey, dp)
 	if edgeXerr != nil {
 		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	edgeXerr = sendAddDeviceProfileRevisionCmd(conn, dp, version+1)
+	if edgeXerr != nil {
+		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile creation failed", err)
@@ -136,9 +145,15 @@ func sendDeleteDeviceProfileCmd(conn redis.Conn, storedKey string, dp models.Dev
 }
 
 func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX {
+	revisionStoredKeys, edgeXerr := deviceProfileRevisionStoredKeys(conn, dp.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceProfileCmd(conn, storedKey, dp)
+	sendDeleteDeviceProfileRevisionsCmd(conn, dp.Name, revisionStoredKeys)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile deletion failed", err)
@@ -165,6 +180,11 @@ func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr err
 	dp.Created = oldDeviceProfile.Created
 	dp.Modified = pkgCommon.MakeTimestamp()
 
+	version, edgeXerr := latestDeviceProfileVersion(conn, dp.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteDeviceProfileCmd(conn, storedKey, oldDeviceProfile)
@@ -172,6 +192,18 @@ func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr err
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	// the profiles stored before the versioning have no revision, their current content becomes the first one
+	if version == 0 {
+		version++
+		edgeXerr = sendAddDeviceProfileRevisionCmd(conn, oldDeviceProfile, version)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	edgeXerr = sendAddDeviceProfileRevisionCmd(conn, dp, version+1)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile update failed", err)
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/gomodule/redigo/redis"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+const (
+	// DeviceProfileCollectionRevision prefixes the stored keys of the device profile revisions
+	DeviceProfileCollectionRevision = DeviceProfileCollection + DBKeySeparator + "revision"
+	// DeviceProfileCollectionRevisions prefixes the sorted sets of the revision stored keys of each device profile,
+	// scored by version
+	DeviceProfileCollectionRevisions = DeviceProfileCollection + DBKeySeparator + "revisions"
+)
+
+// deviceProfileRevisionStoredKey return the stored key of the revision of the device profile
+func deviceProfileRevisionStoredKey(name string, version uint64) string {
+	return CreateKey(DeviceProfileCollectionRevision, name, strconv.FormatUint(version, 10))
+}
+
+// latestDeviceProfileVersion returns the version of the latest revision of the device profile, 0 when the device
+// profile has no revision
+func latestDeviceProfileVersion(conn redis.Conn, name string) (uint64, errors.EdgeX) {
+	values, err := redis.Values(conn.Do(ZREVRANGE, CreateKey(DeviceProfileCollectionRevisions, name), 0, 0, WITHSCORES))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the latest version of device profile %s", name), err)
+	}
+	if len(values) < 2 {
+		return 0, nil
+	}
+	version, err := redis.Uint64(values[1], nil)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to parse the latest version of device profile %s", name), err)
+	}
+	return version, nil
+}
+
+// sendAddDeviceProfileRevisionCmd send redis command for adding the revision of the device profile
+func sendAddDeviceProfileRevisionCmd(conn redis.Conn, dp models.DeviceProfile, version uint64) errors.EdgeX {
+	revision := metadataModels.DeviceProfileRevision{Version: version, Created: dp.Modified, Profile: dp}
+	m, err := json.Marshal(revision)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile revision for Redis persistence", err)
+	}
+	storedKey := deviceProfileRevisionStoredKey(dp.Name, version)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CreateKey(DeviceProfileCollectionRevisions, dp.Name), version, storedKey)
+	return nil
+}
+
+// deviceProfileRevisionStoredKeys returns the stored keys of all the revisions of the device profile
+func deviceProfileRevisionStoredKeys(conn redis.Conn, name string) ([]any, errors.EdgeX) {
+	storedKeys, err := redis.Values(conn.Do(ZRANGE, CreateKey(DeviceProfileCollectionRevisions, name), 0, -1))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the revisions of device profile %s", name), err)
+	}
+	return storedKeys, nil
+}
+
+// sendDeleteDeviceProfileRevisionsCmd send redis command for deleting the revisions of the device profile
+func sendDeleteDeviceProfileRevisionsCmd(conn redis.Conn, name string, storedKeys []any) {
+	if len(storedKeys) > 0 {
+		_ = conn.Send(UNLINK, storedKeys...)
+	}
+	_ = conn.Send(UNLINK, CreateKey(DeviceProfileCollectionRevisions, name))
+}
+
+// deviceProfileRevisionsByName query the revisions of the device profile by offset, limit and name, the latest first
+func deviceProfileRevisionsByName(conn redis.Conn, offset int, limit int, name string) (revisions []metadataModels.DeviceProfileRevision, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(DeviceProfileCollectionRevisions, name), offset, limit)
+	if edgeXerr != nil {
+		return revisions, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	revisions = make([]metadataModels.DeviceProfileRevision, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &revisions[i])
+		if err != nil {
+			return []metadataModels.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile revision format parsing failed from the database", err)
+		}
+	}
+	return revisions, nil
+}
+
+// deviceProfileRevisionByNameAndVersion query the revision of the device profile by name and version
+func deviceProfileRevisionByNameAndVersion(conn redis.Conn, name string, version uint64) (revision metadataModels.DeviceProfileRevision, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deviceProfileRevisionStoredKey(name, version), &revision)
+	if edgeXerr != nil {
+		return revision, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query version %d of device profile %s", version, name), edgeXerr)
+	}
+	return
+}
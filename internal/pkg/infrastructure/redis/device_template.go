@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	DeviceTemplateCollection     = "md|dtpl"
+	DeviceTemplateCollectionName = DeviceTemplateCollection + DBKeySeparator + common.Name
+)
+
+// deviceTemplateStoredKey return the device template's stored key which combines the collection name and object id
+func deviceTemplateStoredKey(id string) string {
+	return CreateKey(DeviceTemplateCollection, id)
+}
+
+// sendAddDeviceTemplateCmd send redis command for adding device template
+func sendAddDeviceTemplateCmd(conn redis.Conn, storedKey string, dt metadataModels.DeviceTemplate) errors.EdgeX {
+	m, err := json.Marshal(dt)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device template for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceTemplateCollection, dt.Modified, storedKey)
+	_ = conn.Send(HSET, DeviceTemplateCollectionName, dt.Name, storedKey)
+	return nil
+}
+
+// addDeviceTemplate adds a new device template into DB
+func addDeviceTemplate(conn redis.Conn, dt metadataModels.DeviceTemplate) (metadataModels.DeviceTemplate, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deviceTemplateStoredKey(dt.Id))
+	if edgeXerr != nil {
+		return dt, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return dt, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device template id %s already exists", dt.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, DeviceTemplateCollectionName, dt.Name)
+	if edgeXerr != nil {
+		return dt, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return dt, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device template name %s already exists", dt.Name), edgeXerr)
+	}
+
+	dt.Created = pkgCommon.MakeTimestamp()
+	dt.Modified = dt.Created
+
+	storedKey := deviceTemplateStoredKey(dt.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceTemplateCmd(conn, storedKey, dt)
+	if edgeXerr != nil {
+		return dt, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device template creation failed", err)
+	}
+
+	return dt, edgeXerr
+}
+
+// deviceTemplateByName query device template by name from DB
+func deviceTemplateByName(conn redis.Conn, name string) (deviceTemplate metadataModels.DeviceTemplate, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeviceTemplateCollectionName, name, &deviceTemplate)
+	if edgeXerr != nil {
+		return deviceTemplate, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device template by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allDeviceTemplates query device templates with offset and limit, the most recently modified first
+func allDeviceTemplates(conn redis.Conn, offset int, limit int) (deviceTemplates []metadataModels.DeviceTemplate, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeviceTemplateCollection, offset, limit)
+	if edgeXerr != nil {
+		return deviceTemplates, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceTemplates = make([]metadataModels.DeviceTemplate, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &deviceTemplates[i])
+		if err != nil {
+			return []metadataModels.DeviceTemplate{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device template format parsing failed from the database", err)
+		}
+	}
+	return deviceTemplates, nil
+}
+
+// sendDeleteDeviceTemplateCmd send redis command for deleting device template
+func sendDeleteDeviceTemplateCmd(conn redis.Conn, storedKey string, dt metadataModels.DeviceTemplate) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceTemplateCollection, storedKey)
+	_ = conn.Send(HDEL, DeviceTemplateCollectionName, dt.Name)
+}
+
+// deleteDeviceTemplateByName deletes the device template by name
+func deleteDeviceTemplateByName(conn redis.Conn, name string) errors.EdgeX {
+	deviceTemplate, edgeXerr := deviceTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceTemplateCmd(conn, deviceTemplateStoredKey(deviceTemplate.Id), deviceTemplate)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device template deletion failed", err)
+	}
+	return nil
+}
+
+// updateDeviceTemplate updates a device template in DB
+func updateDeviceTemplate(conn redis.Conn, dt metadataModels.DeviceTemplate) errors.EdgeX {
+	oldDeviceTemplate, edgeXerr := deviceTemplateByName(conn, dt.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	dt.Id = oldDeviceTemplate.Id
+	dt.Created = oldDeviceTemplate.Created
+	dt.Modified = pkgCommon.MakeTimestamp()
+
+	storedKey := deviceTemplateStoredKey(dt.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceTemplateCmd(conn, storedKey, oldDeviceTemplate)
+	edgeXerr = sendAddDeviceTemplateCmd(conn, storedKey, dt)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device template update failed", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+const (
+	DeviceCommandActionCollection             = "ss|dca"
+	DeviceCommandActionCollectionName         = DeviceCommandActionCollection + DBKeySeparator + common.Name
+	DeviceCommandActionCollectionIntervalName = DeviceCommandActionCollection + DBKeySeparator + common.Interval + DBKeySeparator + common.Name
+	DeviceCommandActionCollectionCalendarName = DeviceCommandActionCollection + DBKeySeparator + pkgCommon.Calendar + DBKeySeparator + common.Name
+)
+
+// deviceCommandActionStoredKey return the device command action's stored key which combines the collection name and object id
+func deviceCommandActionStoredKey(id string) string {
+	return CreateKey(DeviceCommandActionCollection, id)
+}
+
+// sendAddDeviceCommandActionCmd send redis command for adding device command action
+func sendAddDeviceCommandActionCmd(conn redis.Conn, storedKey string, a schedulerModels.DeviceCommandAction) errors.EdgeX {
+	m, err := json.Marshal(a)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device command action for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceCommandActionCollection, a.Modified, storedKey)
+	_ = conn.Send(HSET, DeviceCommandActionCollectionName, a.Name, storedKey)
+	_ = conn.Send(ZADD, CreateKey(DeviceCommandActionCollectionIntervalName, a.IntervalName), a.Modified, storedKey)
+	for _, calendar := range a.Calendars {
+		_ = conn.Send(ZADD, CreateKey(DeviceCommandActionCollectionCalendarName, calendar), a.Modified, storedKey)
+	}
+	return nil
+}
+
+// addDeviceCommandAction adds a new device command action into DB
+func addDeviceCommandAction(conn redis.Conn, a schedulerModels.DeviceCommandAction) (schedulerModels.DeviceCommandAction, errors.EdgeX) {
+	exists, edgeXerr := intervalNameExists(conn, a.IntervalName)
+	if edgeXerr != nil {
+		return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return a, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("interval '%s' does not exists", a.IntervalName), nil)
+	}
+	for _, calendar := range a.Calendars {
+		exists, edgeXerr = objectNameExists(conn, CalendarCollectionName, calendar)
+		if edgeXerr != nil {
+			return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if !exists {
+			return a, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("calendar '%s' does not exists", calendar), nil)
+		}
+	}
+
+	exists, edgeXerr = objectIdExists(conn, deviceCommandActionStoredKey(a.Id))
+	if edgeXerr != nil {
+		return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return a, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device command action id %s already exists", a.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, DeviceCommandActionCollectionName, a.Name)
+	if edgeXerr != nil {
+		return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return a, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device command action name %s already exists", a.Name), edgeXerr)
+	}
+
+	a.Created = pkgCommon.MakeTimestamp()
+	a.Modified = a.Created
+
+	storedKey := deviceCommandActionStoredKey(a.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceCommandActionCmd(conn, storedKey, a)
+	if edgeXerr != nil {
+		return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device command action creation failed", err)
+	}
+
+	return a, edgeXerr
+}
+
+// deviceCommandActionByName query device command action by name from DB
+func deviceCommandActionByName(conn redis.Conn, name string) (action schedulerModels.DeviceCommandAction, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeviceCommandActionCollectionName, name, &action)
+	if edgeXerr != nil {
+		return action, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device command action by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allDeviceCommandActions query device command actions with offset and limit, the most recently modified first
+func allDeviceCommandActions(conn redis.Conn, offset int, limit int) ([]schedulerModels.DeviceCommandAction, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeviceCommandActionCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToDeviceCommandActions(objects)
+}
+
+// deviceCommandActionsByIntervalName query device command actions of the interval with offset and limit
+func deviceCommandActionsByIntervalName(conn redis.Conn, offset int, limit int, intervalName string) ([]schedulerModels.DeviceCommandAction, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(DeviceCommandActionCollectionIntervalName, intervalName), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToDeviceCommandActions(objects)
+}
+
+func convertObjectsToDeviceCommandActions(objects [][]byte) ([]schedulerModels.DeviceCommandAction, errors.EdgeX) {
+	actions := make([]schedulerModels.DeviceCommandAction, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &actions[i])
+		if err != nil {
+			return []schedulerModels.DeviceCommandAction{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device command action format parsing failed from the database", err)
+		}
+	}
+	return actions, nil
+}
+
+// sendDeleteDeviceCommandActionCmd send redis command for deleting device command action
+func sendDeleteDeviceCommandActionCmd(conn redis.Conn, storedKey string, a schedulerModels.DeviceCommandAction) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceCommandActionCollection, storedKey)
+	_ = conn.Send(HDEL, DeviceCommandActionCollectionName, a.Name)
+	_ = conn.Send(ZREM, CreateKey(DeviceCommandActionCollectionIntervalName, a.IntervalName), storedKey)
+	for _, calendar := range a.Calendars {
+		_ = conn.Send(ZREM, CreateKey(DeviceCommandActionCollectionCalendarName, calendar), storedKey)
+	}
+}
+
+// deleteDeviceCommandActionByName deletes the device command action by name
+func deleteDeviceCommandActionByName(conn redis.Conn, name string) errors.EdgeX {
+	action, edgeXerr := deviceCommandActionByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceCommandActionCmd(conn, deviceCommandActionStoredKey(action.Id), action)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device command action deletion failed", err)
+	}
+	return nil
+}
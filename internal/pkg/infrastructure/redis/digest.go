@@ -0,0 +1,182 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	DigestCollection             = "sn|dgst"
+	DigestCollectionName         = DigestCollection + DBKeySeparator + common.Name
+	DigestCollectionSubscription = DigestCollection + DBKeySeparator + common.Subscription
+	// DigestCollectionPending is the prefix of the sorted sets of the ids of the notifications aggregated by the digest
+	// of a subscription and not sent yet, scored by the notification created timestamp
+	DigestCollectionPending = DigestCollection + DBKeySeparator + "pending"
+)
+
+// digestStoredKey return the digest's stored key which combines the collection name and object id
+func digestStoredKey(id string) string {
+	return CreateKey(DigestCollection, id)
+}
+
+// sendAddDigestCmd send redis command for adding digest
+func sendAddDigestCmd(conn redis.Conn, storedKey string, d notificationModels.Digest) errors.EdgeX {
+	m, err := json.Marshal(d)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal digest for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DigestCollection, d.Modified, storedKey)
+	_ = conn.Send(HSET, DigestCollectionName, d.Name, storedKey)
+	_ = conn.Send(HSET, DigestCollectionSubscription, d.SubscriptionName, storedKey)
+	return nil
+}
+
+// addDigest adds a new digest into DB
+func addDigest(conn redis.Conn, d notificationModels.Digest) (notificationModels.Digest, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, digestStoredKey(d.Id))
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("digest id %s already exists", d.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, DigestCollectionName, d.Name)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("digest name %s already exists", d.Name), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, DigestCollectionSubscription, d.SubscriptionName)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("subscription %s already has a digest", d.SubscriptionName), edgeXerr)
+	}
+
+	d.Created = pkgCommon.MakeTimestamp()
+	d.Modified = d.Created
+
+	storedKey := digestStoredKey(d.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDigestCmd(conn, storedKey, d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "digest creation failed", err)
+	}
+
+	return d, edgeXerr
+}
+
+// digestByName query digest by name from DB
+func digestByName(conn redis.Conn, name string) (digest notificationModels.Digest, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DigestCollectionName, name, &digest)
+	if edgeXerr != nil {
+		return digest, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query digest by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// digestBySubscriptionName query the digest of the subscription from DB
+func digestBySubscriptionName(conn redis.Conn, subscriptionName string) (digest notificationModels.Digest, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DigestCollectionSubscription, subscriptionName, &digest)
+	if edgeXerr != nil {
+		return digest, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query digest by subscription name %s", subscriptionName), edgeXerr)
+	}
+	return
+}
+
+// allDigests query digests with offset and limit, the most recently modified first
+func allDigests(conn redis.Conn, offset int, limit int) ([]notificationModels.Digest, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DigestCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	digests := make([]notificationModels.Digest, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &digests[i])
+		if err != nil {
+			return []notificationModels.Digest{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "digest format parsing failed from the database", err)
+		}
+	}
+	return digests, nil
+}
+
+// sendDeleteDigestCmd send redis command for deleting digest, the notifications pending in the digest are kept
+func sendDeleteDigestCmd(conn redis.Conn, storedKey string, d notificationModels.Digest) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DigestCollection, storedKey)
+	_ = conn.Send(HDEL, DigestCollectionName, d.Name)
+	_ = conn.Send(HDEL, DigestCollectionSubscription, d.SubscriptionName)
+}
+
+// deleteDigestByName deletes the digest by name
+func deleteDigestByName(conn redis.Conn, name string) errors.EdgeX {
+	digest, edgeXerr := digestByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDigestCmd(conn, digestStoredKey(digest.Id), digest)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "digest deletion failed", err)
+	}
+	return nil
+}
+
+// addDigestedNotification adds the notification to the pending notifications of the subscription digest and returns
+// the number of the pending notifications
+func addDigestedNotification(conn redis.Conn, subscriptionName string, n models.Notification) (uint32, errors.EdgeX) {
+	pendingKey := CreateKey(DigestCollectionPending, subscriptionName)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZADD, pendingKey, n.Created, n.Id)
+	_ = conn.Send(ZCARD, pendingKey)
+	values, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "digested notification creation failed", err)
+	}
+	count, err := redis.Int(values[1], nil)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "digested notification count parsing failed", err)
+	}
+	return uint32(count), nil
+}
+
+// popDigestedNotificationIds removes and returns the ids of the pending notifications of the subscription digest, the
+// oldest first
+func popDigestedNotificationIds(conn redis.Conn, subscriptionName string) ([]string, errors.EdgeX) {
+	pendingKey := CreateKey(DigestCollectionPending, subscriptionName)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZRANGE, pendingKey, 0, -1)
+	_ = conn.Send(DEL, pendingKey)
+	values, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "digested notifications retrieval failed", err)
+	}
+	ids, err := redis.Strings(values[0], nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "digested notification ids parsing failed", err)
+	}
+	return ids, nil
+}
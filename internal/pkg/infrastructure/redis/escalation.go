@@ -0,0 +1,184 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	EscalationPolicyCollection             = "sn|esc"
+	EscalationPolicyCollectionName         = EscalationPolicyCollection + DBKeySeparator + common.Name
+	EscalationPolicyCollectionSubscription = EscalationPolicyCollection + DBKeySeparator + common.Subscription
+	// NotificationAcknowledgementCollection is the hash of the notification acknowledgements by notification id
+	NotificationAcknowledgementCollection = NotificationCollection + DBKeySeparator + "ack"
+	// NotificationAcknowledgementCollectionAcknowledged is the sorted set of the acknowledged notifications, scored by
+	// the acknowledgement timestamp
+	NotificationAcknowledgementCollectionAcknowledged = NotificationAcknowledgementCollection + DBKeySeparator + "time"
+)
+
+// escalationPolicyStoredKey return the escalation policy's stored key which combines the collection name and object id
+func escalationPolicyStoredKey(id string) string {
+	return CreateKey(EscalationPolicyCollection, id)
+}
+
+// sendAddEscalationPolicyCmd send redis command for adding escalation policy
+func sendAddEscalationPolicyCmd(conn redis.Conn, storedKey string, p notificationModels.EscalationPolicy) errors.EdgeX {
+	m, err := json.Marshal(p)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal escalation policy for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, EscalationPolicyCollection, p.Modified, storedKey)
+	_ = conn.Send(HSET, EscalationPolicyCollectionName, p.Name, storedKey)
+	_ = conn.Send(ZADD, CreateKey(EscalationPolicyCollectionSubscription, p.SubscriptionName), p.Modified, storedKey)
+	return nil
+}
+
+// addEscalationPolicy adds a new escalation policy into DB
+func addEscalationPolicy(conn redis.Conn, p notificationModels.EscalationPolicy) (notificationModels.EscalationPolicy, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, escalationPolicyStoredKey(p.Id))
+	if edgeXerr != nil {
+		return p, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return p, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("escalation policy id %s already exists", p.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, EscalationPolicyCollectionName, p.Name)
+	if edgeXerr != nil {
+		return p, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return p, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("escalation policy name %s already exists", p.Name), edgeXerr)
+	}
+
+	p.Created = pkgCommon.MakeTimestamp()
+	p.Modified = p.Created
+
+	storedKey := escalationPolicyStoredKey(p.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddEscalationPolicyCmd(conn, storedKey, p)
+	if edgeXerr != nil {
+		return p, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation policy creation failed", err)
+	}
+
+	return p, edgeXerr
+}
+
+// escalationPolicyByName query escalation policy by name from DB
+func escalationPolicyByName(conn redis.Conn, name string) (policy notificationModels.EscalationPolicy, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, EscalationPolicyCollectionName, name, &policy)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query escalation policy by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allEscalationPolicies query escalation policies with offset and limit, the most recently modified first
+func allEscalationPolicies(conn redis.Conn, offset int, limit int) ([]notificationModels.EscalationPolicy, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, EscalationPolicyCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToEscalationPolicies(objects)
+}
+
+// escalationPoliciesBySubscriptionName query escalation policies of the subscription with offset and limit
+func escalationPoliciesBySubscriptionName(conn redis.Conn, offset int, limit int, subscriptionName string) ([]notificationModels.EscalationPolicy, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(EscalationPolicyCollectionSubscription, subscriptionName), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToEscalationPolicies(objects)
+}
+
+func convertObjectsToEscalationPolicies(objects [][]byte) ([]notificationModels.EscalationPolicy, errors.EdgeX) {
+	policies := make([]notificationModels.EscalationPolicy, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &policies[i])
+		if err != nil {
+			return []notificationModels.EscalationPolicy{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation policy format parsing failed from the database", err)
+		}
+	}
+	return policies, nil
+}
+
+// sendDeleteEscalationPolicyCmd send redis command for deleting escalation policy
+func sendDeleteEscalationPolicyCmd(conn redis.Conn, storedKey string, p notificationModels.EscalationPolicy) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, EscalationPolicyCollection, storedKey)
+	_ = conn.Send(HDEL, EscalationPolicyCollectionName, p.Name)
+	_ = conn.Send(ZREM, CreateKey(EscalationPolicyCollectionSubscription, p.SubscriptionName), storedKey)
+}
+
+// deleteEscalationPolicyByName deletes the escalation policy by name
+func deleteEscalationPolicyByName(conn redis.Conn, name string) errors.EdgeX {
+	policy, edgeXerr := escalationPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteEscalationPolicyCmd(conn, escalationPolicyStoredKey(policy.Id), policy)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation policy deletion failed", err)
+	}
+	return nil
+}
+
+// addNotificationAcknowledgement records the acknowledgement of the notification, the first acknowledgement being
+// kept when the notification is acknowledged again, and removes the notification from the unacknowledged ones
+func addNotificationAcknowledgement(conn redis.Conn, ack notificationModels.NotificationAcknowledgement) (notificationModels.NotificationAcknowledgement, errors.EdgeX) {
+	ack.Acknowledged = pkgCommon.MakeTimestamp()
+	m, err := json.Marshal(ack)
+	if err != nil {
+		return ack, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal notification acknowledgement for Redis persistence", err)
+	}
+	storedKey := notificationStoredKey(ack.NotificationId)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(HSETNX, NotificationAcknowledgementCollection, ack.NotificationId, m)
+	_ = conn.Send(ZADD, NotificationAcknowledgementCollectionAcknowledged, NX, ack.Acknowledged, storedKey)
+	_ = conn.Send(ZREM, NotificationCollectionUnacknowledged, storedKey)
+	values, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return ack, errors.NewCommonEdgeX(errors.KindDatabaseError, "notification acknowledgement creation failed", err)
+	}
+	added, err := redis.Bool(values[0], nil)
+	if err != nil {
+		return ack, errors.NewCommonEdgeX(errors.KindDatabaseError, "notification acknowledgement creation parsing failed", err)
+	}
+	if !added {
+		return notificationAcknowledgement(conn, ack.NotificationId)
+	}
+	return ack, nil
+}
+
+// notificationAcknowledgement query the acknowledgement of the notification
+func notificationAcknowledgement(conn redis.Conn, notificationId string) (ack notificationModels.NotificationAcknowledgement, edgeXerr errors.EdgeX) {
+	in, err := redis.Bytes(conn.Do(HGET, NotificationAcknowledgementCollection, notificationId))
+	if err == redis.ErrNil {
+		return ack, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("notification %s is not acknowledged", notificationId), err)
+	} else if err != nil {
+		return ack, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the acknowledgement of notification %s", notificationId), err)
+	}
+	if err = json.Unmarshal(in, &ack); err != nil {
+		return ack, errors.NewCommonEdgeX(errors.KindDatabaseError, "notification acknowledgement format parsing failed from the database", err)
+	}
+	return ack, nil
+}
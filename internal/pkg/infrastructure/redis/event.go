@@ -9,9 +9,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
@@ -44,6 +46,7 @@ func (c *Client) asyncDeleteEventsByIds(eventIds []string) {
 	e := models.Event{}
 	_ = conn.Send(MULTI)
 	for i, event := range events {
+		e = models.Event{}
 		err := json.Unmarshal(event, &e)
 		if err != nil {
 			c.loggingClient.Errorf("unable to marshal event.  Err: %s", err.Error())
@@ -55,6 +58,10 @@ func (c *Client) asyncDeleteEventsByIds(eventIds []string) {
 		_ = conn.Send(ZREM, EventsCollection, storedKey)
 		_ = conn.Send(ZREM, EventsCollectionOrigin, storedKey)
 		_ = conn.Send(ZREM, CreateKey(EventsCollectionDeviceName, e.DeviceName), storedKey)
+		if tenant := tenantFromTags(e.Tags); tenant != "" {
+			_ = conn.Send(ZREM, CreateKey(EventsCollectionTenant, tenant), storedKey)
+		}
+		sendDeleteEventLineage(conn, e, storedKey)
 		queriesInQueue++
 
 		if queriesInQueue >= c.BatchSize {
@@ -118,6 +125,60 @@ func (c *Client) DeleteEventsByAge(age int64) (edgeXerr errors.EdgeX) {
 	return nil
 }
 
+// DeleteEventsByOrigin deletes the events, and their corresponding readings, whose origin is lower than or equal to
+// origin.  This function is implemented to starts up two goroutines to delete readings and events in the background to
+// achieve better performance.
+func (c *Client) DeleteEventsByOrigin(origin int64) (edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	eventIds, readingIds, err := getEventReadingIdsByKeyScoreRange(conn, EventsCollectionOrigin, "0", strconv.FormatInt(origin, 10))
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	c.loggingClient.Debugf("Prepare to delete %v readings", len(readingIds))
+	go c.asyncDeleteReadingsByIds(readingIds)
+	c.loggingClient.Debugf("Prepare to delete %v events", len(eventIds))
+	go c.asyncDeleteEventsByIds(eventIds)
+
+	return nil
+}
+
+// DeleteEventsByDeviceNameAndOrigin deletes the events of the device, and their corresponding readings, whose origin
+// is lower than or equal to origin.  This function is implemented to starts up two goroutines to delete readings and
+// events in the background to achieve better performance.
+func (c *Client) DeleteEventsByDeviceNameAndOrigin(deviceName string, origin int64) (edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	eventIds, readingIds, err := getEventReadingIdsByKeyScoreRange(conn, CreateKey(EventsCollectionDeviceName, deviceName), "0", strconv.FormatInt(origin, 10))
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	c.loggingClient.Debugf("Prepare to delete %v readings", len(readingIds))
+	go c.asyncDeleteReadingsByIds(readingIds)
+	c.loggingClient.Debugf("Prepare to delete %v events", len(eventIds))
+	go c.asyncDeleteEventsByIds(eventIds)
+
+	return nil
+}
+
+// EventDeviceNames returns the names of the devices which have events
+func (c *Client) EventDeviceNames() (deviceNames []string, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	prefix := CreateKey(EventsCollectionDeviceName, "")
+	keys, edgeXerr := scanKeys(conn, prefix+"*")
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "scan event device names failed", edgeXerr)
+	}
+	for _, key := range keys {
+		deviceNames = append(deviceNames, strings.TrimPrefix(key, prefix))
+	}
+	return deviceNames, nil
+}
+
 // ************************** DB HELPER FUNCTIONS ***************************
 // eventStoredKey return the event's stored key which combines the collection name and object id
 func eventStoredKey(id string) string {
@@ -130,8 +191,62 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 	if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
 		return addedEvent, errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil)
 	}
-	edgeXerr = nil
 
+	_ = conn.Send(MULTI)
+	addedEvent, edgeXerr = sendAddEvent(conn, e)
+	if edgeXerr != nil {
+		return models.Event{}, edgeXerr
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return addedEvent, errors.NewCommonEdgeX(errors.KindDatabaseError, "event creation failed", err)
+	}
+
+	return addedEvent, nil
+}
+
+// addEvents adds the events, and their readings, in a single transaction
+func addEvents(conn redis.Conn, events []models.Event) (addedEvents []models.Event, edgeXerr errors.EdgeX) {
+	// query the existence of the Events by Id first to avoid the Id conflicts
+	ids := make(map[string]struct{}, len(events))
+	for _, e := range events {
+		if _, duplicated := ids[e.Id]; duplicated {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("Event Id %s is duplicated", e.Id), nil)
+		}
+		ids[e.Id] = struct{}{}
+		_ = conn.Send(EXISTS, eventStoredKey(e.Id))
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "event existence query failed", err)
+	}
+	for _, e := range events {
+		exists, err := redis.Bool(conn.Receive())
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "event existence query failed", err)
+		}
+		if exists {
+			return nil, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("Event Id %s exists", e.Id), nil)
+		}
+	}
+
+	addedEvents = make([]models.Event, len(events))
+	_ = conn.Send(MULTI)
+	for i, e := range events {
+		addedEvents[i], edgeXerr = sendAddEvent(conn, e)
+		if edgeXerr != nil {
+			return nil, edgeXerr
+		}
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "events creation failed", err)
+	}
+
+	return addedEvents, nil
+}
+
+// sendAddEvent sends the commands adding the event and its readings, within a transaction started by the caller
+func sendAddEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXerr errors.EdgeX) {
 	event := models.Event{
 		Id:          e.Id,
 		DeviceName:  e.DeviceName,
@@ -147,12 +262,15 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 	}
 
 	storedKey := eventStoredKey(e.Id)
-	_ = conn.Send(MULTI)
 	// use the SET command to save event as blob
 	_ = conn.Send(SET, storedKey, m)
 	_ = conn.Send(ZADD, EventsCollection, e.Origin, storedKey)
 	_ = conn.Send(ZADD, EventsCollectionOrigin, e.Origin, storedKey)
 	_ = conn.Send(ZADD, CreateKey(EventsCollectionDeviceName, e.DeviceName), e.Origin, storedKey)
+	if tenant := tenantFromTags(e.Tags); tenant != "" {
+		_ = conn.Send(ZADD, CreateKey(EventsCollectionTenant, tenant), e.Origin, storedKey)
+	}
+	sendAddEventLineage(conn, e, storedKey)
 
 	// add reading ids as sorted set under each event id
 	// sort by the order provided by device service
@@ -175,12 +293,7 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 		_ = conn.Send(ZADD, rids...)
 	}
 
-	_, err = conn.Do(EXEC)
-	if err != nil {
-		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "event creation failed", err)
-	}
-
-	return e, edgeXerr
+	return e, nil
 }
 
 func deleteEventById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
@@ -205,6 +318,10 @@ func deleteEventById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
 	_ = conn.Send(ZREM, EventsCollection, storedKey)
 	_ = conn.Send(ZREM, EventsCollectionOrigin, storedKey)
 	_ = conn.Send(ZREM, CreateKey(EventsCollectionDeviceName, e.DeviceName), storedKey)
+	if tenant := tenantFromTags(e.Tags); tenant != "" {
+		_ = conn.Send(ZREM, CreateKey(EventsCollectionTenant, tenant), storedKey)
+	}
+	sendDeleteEventLineage(conn, e, storedKey)
 
 	res, err := redis.Values(conn.Do(EXEC))
 	if err != nil {
@@ -256,6 +373,15 @@ func (c *Client) allEvents(conn redis.Conn, offset int, limit int) (events []mod
 	return convertObjectsToEvents(conn, objects)
 }
 
+// eventsAfter query the events of the sorted set key after the cursor
+func eventsAfter(conn redis.Conn, key string, after utils.Cursor, limit int) (events []models.Event, edgeXerr errors.EdgeX) {
+	objects, err := getObjectsByRevRangeAfter(conn, key, eventStoredKey(after.Id), after.Score, limit)
+	if err != nil {
+		return events, errors.NewCommonEdgeXWrapper(err)
+	}
+	return convertObjectsToEvents(conn, objects)
+}
+
 // eventsByDeviceName query events by offset, limit and device name
 func eventsByDeviceName(conn redis.Conn, offset int, limit int, name string) (events []models.Event, edgeXerr errors.EdgeX) {
 	objects, err := getObjectsByRevRange(conn, CreateKey(EventsCollectionDeviceName, name), offset, limit)
@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+const (
+	// ForwardQueueCollection is the sorted set of the store-and-forward queue entries, the members are the JSON entries
+	// and the scores are their sequences
+	ForwardQueueCollection = "cd|sfq"
+	// ForwardQueueCollectionSequence is the counter of the store-and-forward queue sequences
+	ForwardQueueCollectionSequence = ForwardQueueCollection + DBKeySeparator + "sequence"
+)
+
+// AddForwardQueueEntry appends the payload to the store-and-forward queue. When the queue already holds maxSize
+// entries, the oldest entries are removed if dropOldest is true, otherwise the payload isn't queued and a
+// LimitExceeded error is returned. It returns the number of the oldest entries removed.
+func (c *Client) AddForwardQueueEntry(payload []byte, maxSize int, dropOldest bool) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if !dropOldest {
+		size, err := redis.Int(conn.Do(ZCARD, ForwardQueueCollection))
+		if err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the store-and-forward queue size", err)
+		}
+		if size >= maxSize {
+			return 0, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("the store-and-forward queue is full with %d entries", size), nil)
+		}
+	}
+
+	sequence, err := redis.Uint64(conn.Do(INCR, ForwardQueueCollectionSequence))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to assign the store-and-forward queue sequence", err)
+	}
+	m, err := json.Marshal(dataModels.ForwardQueueEntry{Sequence: sequence, Payload: payload})
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal store-and-forward queue entry for Redis persistence", err)
+	}
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZADD, ForwardQueueCollection, sequence, m)
+	_ = conn.Send(ZREMRANGEBYRANK, ForwardQueueCollection, 0, -maxSize-1)
+	results, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "store-and-forward queue entry creation failed", err)
+	}
+	dropped, err := redis.Int(results[1], nil)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to parse the number of the store-and-forward queue entries removed", err)
+	}
+	return uint32(dropped), nil
+}
+
+// ForwardQueueEntries queries at most limit of the oldest store-and-forward queue entries, in the order of their
+// sequences
+func (c *Client) ForwardQueueEntries(limit int) ([]dataModels.ForwardQueueEntry, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.ByteSlices(conn.Do(ZRANGE, ForwardQueueCollection, 0, limit-1))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the store-and-forward queue entries", err)
+	}
+	entries := make([]dataModels.ForwardQueueEntry, len(objects))
+	for i, in := range objects {
+		if err = json.Unmarshal(in, &entries[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "store-and-forward queue entry format parsing failed from the database", err)
+		}
+	}
+	return entries, nil
+}
+
+// DeleteForwardQueueEntries removes the store-and-forward queue entries whose sequence isn't greater than sequence
+func (c *Client) DeleteForwardQueueEntries(sequence uint64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do(ZREMRANGEBYSCORE, ForwardQueueCollection, InfiniteMin, sequence); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to delete the store-and-forward queue entries up to %d", sequence), err)
+	}
+	return nil
+}
+
+// ForwardQueueSize returns the number of the store-and-forward queue entries
+func (c *Client) ForwardQueueSize() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	size, err := redis.Uint64(conn.Do(ZCARD, ForwardQueueCollection))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the store-and-forward queue size", err)
+	}
+	return uint32(size), nil
+}
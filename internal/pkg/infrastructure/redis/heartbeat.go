@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// HeartbeatCollectionDownDevices is the hash of the names of the devices marked DOWN by the heartbeat tracking, the
+	// fields are the names of the device services considered down and the values the JSON arrays of device names
+	HeartbeatCollectionDownDevices = "md|hb" + DBKeySeparator + "down"
+	// maxOperatingStateUpdateAttempts is how many times the update of the operating state of a device is attempted
+	// when the device is concurrently modified
+	maxOperatingStateUpdateAttempts = 5
+)
+
+// updateDeviceOperatingState sets the operating state of the device to the to operating state when it is in the from
+// operating state, and returns the device and whether it was updated. The device is watched while its operating state is
+// updated, so that the concurrent updates of the device are neither overwritten nor overwrite the operating state.
+func updateDeviceOperatingState(conn redis.Conn, name string, from models.OperatingState, to models.OperatingState) (models.Device, bool, errors.EdgeX) {
+	for attempt := 0; attempt < maxOperatingStateUpdateAttempts; attempt++ {
+		device, edgeXerr := deviceByName(conn, name)
+		if edgeXerr != nil {
+			return device, false, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		storedKey := deviceStoredKey(device.Id)
+		if _, err := conn.Do(WATCH, storedKey); err != nil {
+			return device, false, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to watch the device", err)
+		}
+		// the device is read again once watched, as it may have been modified meanwhile
+		if edgeXerr = getObjectById(conn, storedKey, &device); edgeXerr != nil {
+			_, _ = conn.Do(UNWATCH)
+			return device, false, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if device.OperatingState != from {
+			_, _ = conn.Do(UNWATCH)
+			return device, false, nil
+		}
+
+		device.OperatingState = to
+		_ = conn.Send(MULTI)
+		if edgeXerr = sendAddDeviceCmd(conn, storedKey, device); edgeXerr != nil {
+			_, _ = conn.Do(DISCARD)
+			return device, false, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		reply, err := conn.Do(EXEC)
+		if err != nil {
+			return device, false, errors.NewCommonEdgeX(errors.KindDatabaseError, "device operating state update failed", err)
+		}
+		// the transaction is aborted when the device was modified after being watched
+		if reply != nil {
+			return device, true, nil
+		}
+	}
+	return models.Device{}, false, errors.NewCommonEdgeX(errors.KindDatabaseError,
+		fmt.Sprintf("device %s operating state update failed, the device is concurrently modified", name), nil)
+}
+
+// setDownDevicesOfService stores the names of the devices of the device service marked DOWN by the heartbeat tracking
+func setDownDevicesOfService(conn redis.Conn, serviceName string, deviceNames []string) errors.EdgeX {
+	m, err := json.Marshal(deviceNames)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal the down devices for Redis persistence", err)
+	}
+	if _, err = conn.Do(HSET, HeartbeatCollectionDownDevices, serviceName, m); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to store the down devices of device service %s", serviceName), err)
+	}
+	return nil
+}
+
+// downDevicesOfServices queries the names of the devices marked DOWN by the heartbeat tracking, by device service
+func downDevicesOfServices(conn redis.Conn) (map[string][]string, errors.EdgeX) {
+	values, err := redis.StringMap(conn.Do(HGETALL, HeartbeatCollectionDownDevices))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the down devices", err)
+	}
+	downDevices := make(map[string][]string, len(values))
+	for serviceName, value := range values {
+		var deviceNames []string
+		if err = json.Unmarshal([]byte(value), &deviceNames); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "down devices format parsing failed from the database", err)
+		}
+		downDevices[serviceName] = deviceNames
+	}
+	return downDevices, nil
+}
+
+// deleteDownDevicesOfService deletes the names of the devices of the device service marked DOWN by the heartbeat
+// tracking
+func deleteDownDevicesOfService(conn redis.Conn, serviceName string) errors.EdgeX {
+	if _, err := conn.Do(HDEL, HeartbeatCollectionDownDevices, serviceName); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to delete the down devices of device service %s", serviceName), err)
+	}
+	return nil
+}
@@ -133,6 +133,20 @@ func deleteIntervalByName(conn redis.Conn, name string) errors.EdgeX {
 	if len(actions) > 0 {
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the interval when associated intervalAction exists", nil)
 	}
+	commandActions, edgeXerr := deviceCommandActionsByIntervalName(conn, 0, 1, interval.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(commandActions) > 0 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the interval when associated device command action exists", nil)
+	}
+	busActions, edgeXerr := messageBusActionsByIntervalName(conn, 0, 1, interval.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(busActions) > 0 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to delete the interval when associated message bus action exists", nil)
+	}
 	storedKey := intervalStoredKey(interval.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteIntervalCmd(conn, storedKey, interval)
@@ -156,6 +170,20 @@ func updateInterval(conn redis.Conn, interval models.Interval) errors.EdgeX {
 	if len(actions) > 0 {
 		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to patch the interval when associated intervalAction exists", nil)
 	}
+	commandActions, edgeXerr := deviceCommandActionsByIntervalName(conn, 0, 1, interval.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(commandActions) > 0 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to patch the interval when associated device command action exists", nil)
+	}
+	busActions, edgeXerr := messageBusActionsByIntervalName(conn, 0, 1, interval.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(busActions) > 0 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, "fail to patch the interval when associated message bus action exists", nil)
+	}
 
 	interval.Modified = pkgCommon.MakeTimestamp()
 	storedKey := intervalStoredKey(interval.Id)
@@ -0,0 +1,181 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+const (
+	JobRunCollection             = "ss|jr"
+	JobRunCollectionJobName      = JobRunCollection + DBKeySeparator + "job" + DBKeySeparator + common.Name
+	JobRunCollectionIntervalName = JobRunCollection + DBKeySeparator + common.Interval + DBKeySeparator + common.Name
+	JobRunCollectionStatus       = JobRunCollection + DBKeySeparator + common.Status
+)
+
+// jobRunStoredKey return the job run's stored key which combines the collection name and object id
+func jobRunStoredKey(id string) string {
+	return CreateKey(JobRunCollection, id)
+}
+
+// sendAddJobRunCmd send redis command for adding job run
+func sendAddJobRunCmd(conn redis.Conn, storedKey string, run schedulerModels.JobRun) errors.EdgeX {
+	m, err := json.Marshal(run)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal job run for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, JobRunCollection, run.Started, storedKey)
+	_ = conn.Send(ZADD, CreateKey(JobRunCollectionJobName, run.JobName), run.Started, storedKey)
+	_ = conn.Send(ZADD, CreateKey(JobRunCollectionIntervalName, run.IntervalName), run.Started, storedKey)
+	_ = conn.Send(ZADD, CreateKey(JobRunCollectionStatus, run.Status), run.Started, storedKey)
+	_ = conn.Send(ZADD, CreateKey(JobRunCollectionJobName, run.JobName, common.Status, run.Status), run.Started, storedKey)
+	return nil
+}
+
+// sendDeleteJobRunCmd send redis command for deleting job run
+func sendDeleteJobRunCmd(conn redis.Conn, storedKey string, run schedulerModels.JobRun) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, JobRunCollection, storedKey)
+	_ = conn.Send(ZREM, CreateKey(JobRunCollectionJobName, run.JobName), storedKey)
+	_ = conn.Send(ZREM, CreateKey(JobRunCollectionIntervalName, run.IntervalName), storedKey)
+	_ = conn.Send(ZREM, CreateKey(JobRunCollectionStatus, run.Status), storedKey)
+	_ = conn.Send(ZREM, CreateKey(JobRunCollectionJobName, run.JobName, common.Status, run.Status), storedKey)
+}
+
+// addJobRun adds a new job run into DB and deletes the oldest runs of the job beyond the history limit, a history
+// limit of 0 keeps all the runs
+func addJobRun(conn redis.Conn, run schedulerModels.JobRun, historyLimit int) (schedulerModels.JobRun, errors.EdgeX) {
+	_ = conn.Send(MULTI)
+	edgeXerr := sendAddJobRunCmd(conn, jobRunStoredKey(run.Id), run)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return run, errors.NewCommonEdgeX(errors.KindDatabaseError, "job run creation failed", err)
+	}
+	if historyLimit <= 0 {
+		return run, nil
+	}
+
+	jobKey := CreateKey(JobRunCollectionJobName, run.JobName)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, jobKey)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if int(count) <= historyLimit {
+		return run, nil
+	}
+	objects, edgeXerr := getObjectsByRange(conn, jobKey, 0, int(count)-historyLimit)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = deleteJobRunObjects(conn, objects)
+	if edgeXerr != nil {
+		return run, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return run, nil
+}
+
+// deleteJobRunsByAge deletes the job runs started more than age milliseconds ago
+func deleteJobRunsByAge(conn redis.Conn, age int64) errors.EdgeX {
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	objects, edgeXerr := getObjectsByScoreRange(conn, JobRunCollection, 0, int(expireTimestamp), 0, -1)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return deleteJobRunObjects(conn, objects)
+}
+
+// deleteJobRunObjects deletes the job runs of the objects
+func deleteJobRunObjects(conn redis.Conn, objects [][]byte) errors.EdgeX {
+	runs, edgeXerr := convertObjectsToJobRuns(objects)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+	_ = conn.Send(MULTI)
+	for _, r := range runs {
+		sendDeleteJobRunCmd(conn, jobRunStoredKey(r.Id), r)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "job runs deletion failed", err)
+	}
+	return nil
+}
+
+// jobRunQueryKey returns the key of the sorted set of the job runs matching the job name and status of the query, and
+// the score range of their start time
+func jobRunQueryKey(query schedulerModels.JobRunQuery) (key string, start int, end int) {
+	switch {
+	case query.JobName != "" && query.Status != "":
+		key = CreateKey(JobRunCollectionJobName, query.JobName, common.Status, query.Status)
+	case query.JobName != "":
+		key = CreateKey(JobRunCollectionJobName, query.JobName)
+	case query.Status != "":
+		key = CreateKey(JobRunCollectionStatus, query.Status)
+	default:
+		key = JobRunCollection
+	}
+	end = int(query.End)
+	if query.End == 0 {
+		end = math.MaxInt64
+	}
+	return key, int(query.Start), end
+}
+
+// jobRuns query the job runs matching the query with offset and limit, the most recent first
+func jobRuns(conn redis.Conn, offset int, limit int, query schedulerModels.JobRunQuery) ([]schedulerModels.JobRun, errors.EdgeX) {
+	key, start, end := jobRunQueryKey(query)
+	objects, edgeXerr := getObjectsByScoreRange(conn, key, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToJobRuns(objects)
+}
+
+// jobRunCount returns the count of the job runs matching the query
+func jobRunCount(conn redis.Conn, query schedulerModels.JobRunQuery) (uint32, errors.EdgeX) {
+	key, start, end := jobRunQueryKey(query)
+	count, err := redis.Int(conn.Do(ZCOUNT, key, start, end))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "count job runs from the database failed", err)
+	}
+	return uint32(count), nil
+}
+
+// jobRunsByIntervalName query runs of the jobs of the interval with offset and limit, the most recent first
+func jobRunsByIntervalName(conn redis.Conn, offset int, limit int, intervalName string) ([]schedulerModels.JobRun, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(JobRunCollectionIntervalName, intervalName), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToJobRuns(objects)
+}
+
+func convertObjectsToJobRuns(objects [][]byte) ([]schedulerModels.JobRun, errors.EdgeX) {
+	runs := make([]schedulerModels.JobRun, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &runs[i])
+		if err != nil {
+			return []schedulerModels.JobRun{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "job run format parsing failed from the database", err)
+		}
+	}
+	return runs, nil
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// EventsCollectionChildren is the prefix of the sorted sets of the events derived from a parent event, by origin
+const EventsCollectionChildren = EventsCollection + DBKeySeparator + "children"
+
+// sendAddEventLineage sends the commands adding the event to the children of its parent events, if any
+func sendAddEventLineage(conn redis.Conn, e models.Event, storedKey string) {
+	for _, parentId := range pkgCommon.ParentEventIdsFromTags(e.Tags) {
+		_ = conn.Send(ZADD, CreateKey(EventsCollectionChildren, parentId), e.Origin, storedKey)
+	}
+}
+
+// sendDeleteEventLineage sends the commands removing the event from the children of its parent events, and the
+// children of the event. The events derived from the event are kept.
+func sendDeleteEventLineage(conn redis.Conn, e models.Event, storedKey string) {
+	for _, parentId := range pkgCommon.ParentEventIdsFromTags(e.Tags) {
+		_ = conn.Send(ZREM, CreateKey(EventsCollectionChildren, parentId), storedKey)
+	}
+	_ = conn.Send(UNLINK, CreateKey(EventsCollectionChildren, e.Id))
+}
+
+// EventsByParentId query the events derived from the parent event by offset and limit
+func (c *Client) EventsByParentId(offset int, limit int, parentId string) (events []models.Event, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(EventsCollectionChildren, parentId), offset, limit)
+	if edgeXerr == nil {
+		events, edgeXerr = convertObjectsToEvents(conn, objects)
+	}
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events by offset %d, limit %d and parent event id %s", offset, limit, parentId), edgeXerr)
+	}
+	return events, nil
+}
+
+// EventCountByParentId returns the count of the events derived from the parent event
+func (c *Client) EventCountByParentId(parentId string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(EventsCollectionChildren, parentId))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
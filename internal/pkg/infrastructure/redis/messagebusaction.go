@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+const (
+	MessageBusActionCollection             = "ss|mba"
+	MessageBusActionCollectionName         = MessageBusActionCollection + DBKeySeparator + common.Name
+	MessageBusActionCollectionIntervalName = MessageBusActionCollection + DBKeySeparator + common.Interval + DBKeySeparator + common.Name
+	MessageBusActionCollectionCalendarName = MessageBusActionCollection + DBKeySeparator + pkgCommon.Calendar + DBKeySeparator + common.Name
+)
+
+// messageBusActionStoredKey return the message bus action's stored key which combines the collection name and object id
+func messageBusActionStoredKey(id string) string {
+	return CreateKey(MessageBusActionCollection, id)
+}
+
+// sendAddMessageBusActionCmd send redis command for adding message bus action
+func sendAddMessageBusActionCmd(conn redis.Conn, storedKey string, a schedulerModels.MessageBusAction) errors.EdgeX {
+	m, err := json.Marshal(a)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal message bus action for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, MessageBusActionCollection, a.Modified, storedKey)
+	_ = conn.Send(HSET, MessageBusActionCollectionName, a.Name, storedKey)
+	_ = conn.Send(ZADD, CreateKey(MessageBusActionCollectionIntervalName, a.IntervalName), a.Modified, storedKey)
+	for _, calendar := range a.Calendars {
+		_ = conn.Send(ZADD, CreateKey(MessageBusActionCollectionCalendarName, calendar), a.Modified, storedKey)
+	}
+	return nil
+}
+
+// addMessageBusAction adds a new message bus action into DB
+func addMessageBusAction(conn redis.Conn, a schedulerModels.MessageBusAction) (schedulerModels.MessageBusAction, errors.EdgeX) {
+	exists, edgeXerr := intervalNameExists(conn, a.IntervalName)
+	if edgeXerr != nil {
+		return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return a, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("interval '%s' does not exists", a.IntervalName), nil)
+	}
+	for _, calendar := range a.Calendars {
+		exists, edgeXerr = objectNameExists(conn, CalendarCollectionName, calendar)
+		if edgeXerr != nil {
+			return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if !exists {
+			return a, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("calendar '%s' does not exists", calendar), nil)
+		}
+	}
+
+	exists, edgeXerr = objectIdExists(conn, messageBusActionStoredKey(a.Id))
+	if edgeXerr != nil {
+		return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return a, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("message bus action id %s already exists", a.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, MessageBusActionCollectionName, a.Name)
+	if edgeXerr != nil {
+		return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return a, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("message bus action name %s already exists", a.Name), edgeXerr)
+	}
+
+	a.Created = pkgCommon.MakeTimestamp()
+	a.Modified = a.Created
+
+	storedKey := messageBusActionStoredKey(a.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddMessageBusActionCmd(conn, storedKey, a)
+	if edgeXerr != nil {
+		return a, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "message bus action creation failed", err)
+	}
+
+	return a, edgeXerr
+}
+
+// messageBusActionByName query message bus action by name from DB
+func messageBusActionByName(conn redis.Conn, name string) (action schedulerModels.MessageBusAction, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, MessageBusActionCollectionName, name, &action)
+	if edgeXerr != nil {
+		return action, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query message bus action by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allMessageBusActions query message bus actions with offset and limit, the most recently modified first
+func allMessageBusActions(conn redis.Conn, offset int, limit int) ([]schedulerModels.MessageBusAction, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, MessageBusActionCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToMessageBusActions(objects)
+}
+
+// messageBusActionsByIntervalName query message bus actions of the interval with offset and limit
+func messageBusActionsByIntervalName(conn redis.Conn, offset int, limit int, intervalName string) ([]schedulerModels.MessageBusAction, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(MessageBusActionCollectionIntervalName, intervalName), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToMessageBusActions(objects)
+}
+
+func convertObjectsToMessageBusActions(objects [][]byte) ([]schedulerModels.MessageBusAction, errors.EdgeX) {
+	actions := make([]schedulerModels.MessageBusAction, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &actions[i])
+		if err != nil {
+			return []schedulerModels.MessageBusAction{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "message bus action format parsing failed from the database", err)
+		}
+	}
+	return actions, nil
+}
+
+// sendDeleteMessageBusActionCmd send redis command for deleting message bus action
+func sendDeleteMessageBusActionCmd(conn redis.Conn, storedKey string, a schedulerModels.MessageBusAction) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, MessageBusActionCollection, storedKey)
+	_ = conn.Send(HDEL, MessageBusActionCollectionName, a.Name)
+	_ = conn.Send(ZREM, CreateKey(MessageBusActionCollectionIntervalName, a.IntervalName), storedKey)
+	for _, calendar := range a.Calendars {
+		_ = conn.Send(ZREM, CreateKey(MessageBusActionCollectionCalendarName, calendar), storedKey)
+	}
+}
+
+// deleteMessageBusActionByName deletes the message bus action by name
+func deleteMessageBusActionByName(conn redis.Conn, name string) errors.EdgeX {
+	action, edgeXerr := messageBusActionByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteMessageBusActionCmd(conn, messageBusActionStoredKey(action.Id), action)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "message bus action deletion failed", err)
+	}
+	return nil
+}
@@ -26,6 +26,9 @@ const (
 	NotificationCollectionSeverity = NotificationCollection + DBKeySeparator + common.Severity
 	NotificationCollectionStatus   = NotificationCollection + DBKeySeparator + common.Status
 	NotificationCollectionCreated  = NotificationCollection + DBKeySeparator + common.Created
+	// NotificationCollectionUnacknowledged is the sorted set of the notifications not acknowledged yet, scored by the
+	// notification created timestamp
+	NotificationCollectionUnacknowledged = NotificationCollection + DBKeySeparator + "unack"
 )
 
 // notificationStoredKey return the notification's stored key which combines the collection name and object id
@@ -75,6 +78,7 @@ func addNotification(conn redis.Conn, notification models.Notification) (models.
 	if edgeXerr != nil {
 		return notification, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	_ = conn.Send(ZADD, NotificationCollectionUnacknowledged, notification.Created, storedKey)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "notification creation failed", err)
@@ -160,6 +164,13 @@ func sendDeleteNotificationCmd(conn redis.Conn, storedKey string, n models.Notif
 	_ = conn.Send(ZREM, CreateKey(NotificationCollectionStatus, string(n.Status)), storedKey)
 }
 
+// sendDeleteNotificationAcknowledgementCmd sends redis command to delete the acknowledgement of a deleted notification
+func sendDeleteNotificationAcknowledgementCmd(conn redis.Conn, storedKey string, n models.Notification) {
+	_ = conn.Send(HDEL, NotificationAcknowledgementCollection, n.Id)
+	_ = conn.Send(ZREM, NotificationAcknowledgementCollectionAcknowledged, storedKey)
+	_ = conn.Send(ZREM, NotificationCollectionUnacknowledged, storedKey)
+}
+
 // deleteNotificationById deletes the notification by id and all of its associated transmissions
 func deleteNotificationById(conn redis.Conn, id string) errors.EdgeX {
 	notification, edgexErr := notificationById(conn, id)
@@ -169,6 +180,7 @@ func deleteNotificationById(conn redis.Conn, id string) errors.EdgeX {
 	storedKey := notificationStoredKey(notification.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteNotificationCmd(conn, storedKey, notification)
+	sendDeleteNotificationAcknowledgementCmd(conn, storedKey, notification)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "notification deletion failed", err)
@@ -226,6 +238,29 @@ func notificationsByCategoriesAndLabels(conn redis.Conn, offset int, limit int,
 	return convertObjectsToNotifications(objects)
 }
 
+// unacknowledgedNotifications queries the notifications not acknowledged yet with offset and limit, the most recently
+// created first, only the notifications of the category and of the severity when they aren't empty
+func unacknowledgedNotifications(conn redis.Conn, offset int, limit int, category string, severity string) (notifications []models.Notification, edgeXerr errors.EdgeX) {
+	redisKeys := []string{NotificationCollectionUnacknowledged}
+	if category != "" {
+		redisKeys = append(redisKeys, CreateKey(NotificationCollectionCategory, category))
+	}
+	if severity != "" {
+		redisKeys = append(redisKeys, CreateKey(NotificationCollectionSeverity, severity))
+	}
+
+	var objects [][]byte
+	if len(redisKeys) == 1 {
+		objects, edgeXerr = getObjectsByRevRange(conn, NotificationCollectionUnacknowledged, offset, limit)
+	} else {
+		objects, edgeXerr = intersectionObjectsByKeys(conn, offset, limit, redisKeys...)
+	}
+	if edgeXerr != nil {
+		return notifications, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToNotifications(objects)
+}
+
 // notificationAndTransmissionStoreKeys return the store keys of the notification and transmission that are older than age.
 func notificationAndTransmissionStoreKeys(conn redis.Conn, collectionKey string, age int64) ([]string, []string, errors.EdgeX) {
 	expireTimestamp := pkgCommon.MakeTimestamp() - age
@@ -273,6 +308,7 @@ func (c *Client) asyncDeleteNotificationByStoreKeys(storeKeys []string) {
 			continue
 		}
 		sendDeleteNotificationCmd(conn, notificationStoredKey(nc.Id), nc)
+		sendDeleteNotificationAcknowledgementCmd(conn, notificationStoredKey(nc.Id), nc)
 		cmdSize++
 
 		if cmdSize >= c.BatchSize {
@@ -325,6 +361,7 @@ func (c *Client) asyncDeleteTransmissionByStoreKeys(storeKeys []string) {
 			continue
 		}
 		sendDeleteTransmissionCmd(conn, transmissionStoredKey(trans.Id), trans)
+		sendDeleteTransmissionResendsCmd(conn, trans)
 		cmdSize++
 
 		if cmdSize >= c.BatchSize {
@@ -365,6 +402,20 @@ func (c *Client) CleanupNotificationsByAge(age int64) (err errors.EdgeX) {
 	return nil
 }
 
+// DeleteAcknowledgedNotificationsByAge deletes the notifications acknowledged for longer than age and their
+// corresponding transmissions. The notifications and transmissions are deleted by two goroutines in the background.
+func (c *Client) DeleteAcknowledgedNotificationsByAge(age int64) (err errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	ncStoreKeys, transStoreKeys, err := notificationAndTransmissionStoreKeys(conn, NotificationAcknowledgementCollectionAcknowledged, age)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	go c.asyncDeleteNotificationByStoreKeys(ncStoreKeys)
+	go c.asyncDeleteTransmissionByStoreKeys(transStoreKeys)
+	return nil
+}
+
 // DeleteProcessedNotificationsByAge deletes processed notifications and their corresponding transmissions that are older than age.
 // This function is implemented to starts up two goroutines to delete transmissions and notifications in the background to achieve better performance.
 func (c *Client) DeleteProcessedNotificationsByAge(age int64) (err errors.EdgeX) {
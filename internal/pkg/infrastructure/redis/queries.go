@@ -87,6 +87,23 @@ func getObjectsBySomeRange(conn redis.Conn, command string, key string, offset i
 	return getObjectsByIds(conn, ids)
 }
 
+// getObjectsByRevRangeAfter retrieves the entries for keys enumerated in a sorted set after the cursor, in the reverse
+// sorted set order. The member of the cursor is the stored key of the last entry of the previous page, when it no
+// longer exists, e.g. deleted by the retention, the entries are retrieved after the ones scored higher than it.
+func getObjectsByRevRangeAfter(conn redis.Conn, key string, member string, score int64, limit int) ([][]byte, errors.EdgeX) {
+	start, err := redis.Int(conn.Do(ZREVRANK, key, member))
+	if err == redis.ErrNil {
+		start, err = redis.Int(conn.Do(ZCOUNT, key, fmt.Sprintf("(%d", score), InfiniteMax))
+	} else if err == nil {
+		start++
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query the rank of the cursor from database failed", err)
+	}
+
+	return getObjectsBySomeRange(conn, ZREVRANGE, key, start, limit)
+}
+
 // getObjectsByScoreRange query objects by specified key's score range, offset, and limit.  Note that the specified key must be a sorted set.
 func getObjectsByScoreRange(conn redis.Conn, key string, start int, end int, offset int, limit int) (objects [][]byte, edgeXerr errors.EdgeX) {
 	if limit == 0 {
@@ -325,3 +342,23 @@ func idFromStoredKey(storeKey string) string {
 	substrings := strings.Split(storeKey, DBKeySeparator)
 	return substrings[len(substrings)-1]
 }
+
+// scanKeys returns the keys matching the glob-style pattern, iterating over the keyspace with SCAN so that the
+// database isn't blocked
+func scanKeys(conn redis.Conn, pattern string) (keys []string, edgeXerr errors.EdgeX) {
+	cursor := 0
+	for {
+		values, err := redis.Values(conn.Do(SCAN, cursor, MATCH, pattern, COUNT, 1000))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("scan keys matching %s failed", pattern), err)
+		}
+		var batch []string
+		if _, err = redis.Scan(values, &cursor, &batch); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("scan keys matching %s failed", pattern), err)
+		}
+		keys = append(keys, batch...)
+		if cursor == 0 {
+			return keys, nil
+		}
+	}
+}
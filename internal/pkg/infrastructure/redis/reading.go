@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/gomodule/redigo/redis"
 	"github.com/google/uuid"
@@ -49,6 +50,7 @@ func (c *Client) asyncDeleteReadingsByIds(readingIds []string) {
 	r := models.BaseReading{}
 	_ = conn.Send(MULTI)
 	for i, reading := range readings {
+		r = models.BaseReading{}
 		err := json.Unmarshal(reading, &r)
 		if err != nil {
 			c.loggingClient.Error(fmt.Sprintf("unable to marshal reading.  Err: %s", err.Error()))
@@ -61,6 +63,9 @@ func (c *Client) asyncDeleteReadingsByIds(readingIds []string) {
 		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceName, r.DeviceName), storedKey)
 		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionResourceName, r.ResourceName), storedKey)
 		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceNameResourceName, r.DeviceName, r.ResourceName), storedKey)
+		if tenant := tenantFromTags(r.Tags); tenant != "" {
+			_ = conn.Send(ZREM, CreateKey(ReadingsCollectionTenant, tenant), storedKey)
+		}
 		queriesInQueue++
 
 		if queriesInQueue >= c.BatchSize {
@@ -136,6 +141,9 @@ func addReading(conn redis.Conn, r models.Reading) (reading models.Reading, edge
 	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionDeviceName, baseReading.DeviceName), baseReading.Origin, storedKey)
 	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionResourceName, baseReading.ResourceName), baseReading.Origin, storedKey)
 	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionDeviceNameResourceName, baseReading.DeviceName, baseReading.ResourceName), baseReading.Origin, storedKey)
+	if tenant := tenantFromTags(baseReading.Tags); tenant != "" {
+		_ = conn.Send(ZADD, CreateKey(ReadingsCollectionTenant, tenant), baseReading.Origin, storedKey)
+	}
 
 	return reading, nil
 }
@@ -156,6 +164,9 @@ func deleteReadingById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
 	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceName, r.DeviceName), storedKey)
 	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionResourceName, r.ResourceName), storedKey)
 	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceNameResourceName, r.DeviceName, r.ResourceName), storedKey)
+	if tenant := tenantFromTags(r.Tags); tenant != "" {
+		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionTenant, tenant), storedKey)
+	}
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("reading[id:%s] delete failed", id), err)
@@ -197,6 +208,16 @@ func allReadings(conn redis.Conn, offset int, limit int) (readings []models.Read
 	return convertObjectsToReadings(objects)
 }
 
+// readingsAfter query the readings of the sorted set key after the cursor
+func readingsAfter(conn redis.Conn, key string, after utils.Cursor, limit int) (readings []models.Reading, edgeXerr errors.EdgeX) {
+	objects, err := getObjectsByRevRangeAfter(conn, key, readingStoredKey(after.Id), after.Score, limit)
+	if err != nil {
+		return readings, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return convertObjectsToReadings(objects)
+}
+
 // readingsByResourceName query readings by offset, limit, and resource name
 func readingsByResourceName(conn redis.Conn, offset int, limit int, resourceName string) (readings []models.Reading, edgeXerr errors.EdgeX) {
 	objects, err := getObjectsByRevRange(conn, CreateKey(ReadingsCollectionResourceName, resourceName), offset, limit)
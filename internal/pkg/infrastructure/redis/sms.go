@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	SmsChannelCollection             = "sn|sms"
+	SmsChannelCollectionName         = SmsChannelCollection + DBKeySeparator + common.Name
+	SmsChannelCollectionSubscription = SmsChannelCollection + DBKeySeparator + common.Subscription
+	// SmsMessageCollection is the prefix of the keys of the transmission ids of the SMS messages by message id
+	SmsMessageCollection = SmsChannelCollection + DBKeySeparator + "msg"
+)
+
+// smsChannelStoredKey return the SMS channel's stored key which combines the collection name and object id
+func smsChannelStoredKey(id string) string {
+	return CreateKey(SmsChannelCollection, id)
+}
+
+// sendAddSmsChannelCmd send redis command for adding SMS channel
+func sendAddSmsChannelCmd(conn redis.Conn, storedKey string, c notificationModels.SmsChannel) errors.EdgeX {
+	m, err := json.Marshal(c)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal SMS channel for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, SmsChannelCollection, c.Modified, storedKey)
+	_ = conn.Send(HSET, SmsChannelCollectionName, c.Name, storedKey)
+	_ = conn.Send(ZADD, CreateKey(SmsChannelCollectionSubscription, c.SubscriptionName), c.Modified, storedKey)
+	return nil
+}
+
+// addSmsChannel adds a new SMS channel into DB
+func addSmsChannel(conn redis.Conn, c notificationModels.SmsChannel) (notificationModels.SmsChannel, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, smsChannelStoredKey(c.Id))
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return c, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("SMS channel id %s already exists", c.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, SmsChannelCollectionName, c.Name)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return c, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("SMS channel name %s already exists", c.Name), edgeXerr)
+	}
+
+	c.Created = pkgCommon.MakeTimestamp()
+	c.Modified = c.Created
+
+	storedKey := smsChannelStoredKey(c.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddSmsChannelCmd(conn, storedKey, c)
+	if edgeXerr != nil {
+		return c, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "SMS channel creation failed", err)
+	}
+
+	return c, edgeXerr
+}
+
+// smsChannelByName query SMS channel by name from DB
+func smsChannelByName(conn redis.Conn, name string) (smsChannel notificationModels.SmsChannel, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, SmsChannelCollectionName, name, &smsChannel)
+	if edgeXerr != nil {
+		return smsChannel, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query SMS channel by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allSmsChannels query SMS channels with offset and limit, the most recently modified first
+func allSmsChannels(conn redis.Conn, offset int, limit int) ([]notificationModels.SmsChannel, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, SmsChannelCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToSmsChannels(objects)
+}
+
+// smsChannelsBySubscriptionName query SMS channels of the subscription with offset and limit
+func smsChannelsBySubscriptionName(conn redis.Conn, offset int, limit int, subscriptionName string) ([]notificationModels.SmsChannel, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(SmsChannelCollectionSubscription, subscriptionName), offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToSmsChannels(objects)
+}
+
+func convertObjectsToSmsChannels(objects [][]byte) ([]notificationModels.SmsChannel, errors.EdgeX) {
+	smsChannels := make([]notificationModels.SmsChannel, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &smsChannels[i])
+		if err != nil {
+			return []notificationModels.SmsChannel{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "SMS channel format parsing failed from the database", err)
+		}
+	}
+	return smsChannels, nil
+}
+
+// sendDeleteSmsChannelCmd send redis command for deleting SMS channel
+func sendDeleteSmsChannelCmd(conn redis.Conn, storedKey string, c notificationModels.SmsChannel) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, SmsChannelCollection, storedKey)
+	_ = conn.Send(HDEL, SmsChannelCollectionName, c.Name)
+	_ = conn.Send(ZREM, CreateKey(SmsChannelCollectionSubscription, c.SubscriptionName), storedKey)
+}
+
+// deleteSmsChannelByName deletes the SMS channel by name
+func deleteSmsChannelByName(conn redis.Conn, name string) errors.EdgeX {
+	smsChannel, edgeXerr := smsChannelByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteSmsChannelCmd(conn, smsChannelStoredKey(smsChannel.Id), smsChannel)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "SMS channel deletion failed", err)
+	}
+	return nil
+}
+
+// smsMessageExpiry is how long the transmission of an SMS message is kept for the delivery status callbacks of the
+// gateway, which usually arrive within minutes
+const smsMessageExpiry = 7 * 24 * time.Hour
+
+// addSmsMessage records the transmission of the SMS message identified by the message id of the gateway
+func addSmsMessage(conn redis.Conn, messageId string, transmissionId string) errors.EdgeX {
+	_, err := conn.Do(SET, CreateKey(SmsMessageCollection, messageId), transmissionId, PX, smsMessageExpiry.Milliseconds())
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "SMS message creation failed", err)
+	}
+	return nil
+}
+
+// transmissionIdBySmsMessageId query the id of the transmission of the SMS message
+func transmissionIdBySmsMessageId(conn redis.Conn, messageId string) (string, errors.EdgeX) {
+	transmissionId, err := redis.String(conn.Do(GET, CreateKey(SmsMessageCollection, messageId)))
+	if err == redis.ErrNil {
+		return "", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("SMS message %s doesn't exist in the database", messageId), err)
+	} else if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query SMS message %s", messageId), err)
+	}
+	return transmissionId, nil
+}
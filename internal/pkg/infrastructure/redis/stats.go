@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/gomodule/redigo/redis"
+
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+)
+
+// ReadingStats returns the stats of the readings of each device and device resource, sorted by estimated size in
+// descending order. The size of the readings of a device resource is estimated from the size of its most recent
+// reading.
+func (c *Client) ReadingStats() ([]dataModels.DeviceReadingStats, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devicePrefix := CreateKey(ReadingsCollectionDeviceName, "")
+	resourcePrefix := CreateKey(ReadingsCollectionDeviceNameResourceName, "")
+	keys, edgeXerr := scanKeys(conn, devicePrefix+"*")
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "scan reading device names failed", edgeXerr)
+	}
+
+	var deviceNames, resourceKeys []string
+	for _, key := range keys {
+		if strings.HasPrefix(key, resourcePrefix) {
+			resourceKeys = append(resourceKeys, key)
+		} else {
+			deviceNames = append(deviceNames, strings.TrimPrefix(key, devicePrefix))
+		}
+	}
+	// the resource keys are matched to the longest device name they start with, as device names can contain the
+	// key separator
+	sort.Slice(deviceNames, func(i, j int) bool { return len(deviceNames[i]) > len(deviceNames[j]) })
+
+	devices := make(map[string]*dataModels.DeviceReadingStats, len(deviceNames))
+	for _, key := range resourceKeys {
+		deviceName, resourceName, found := splitDeviceNameResourceName(strings.TrimPrefix(key, resourcePrefix), deviceNames)
+		if !found {
+			continue
+		}
+		stats, edgeXerr := readingStatsByKey(conn, key)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if stats.Count == 0 {
+			continue
+		}
+		device, exists := devices[deviceName]
+		if !exists {
+			device = &dataModels.DeviceReadingStats{DeviceName: deviceName}
+			devices[deviceName] = device
+		}
+		device.Add(stats)
+		device.Resources = append(device.Resources, dataModels.ResourceReadingStats{ResourceName: resourceName, ReadingStats: stats})
+	}
+
+	result := make([]dataModels.DeviceReadingStats, 0, len(devices))
+	for _, device := range devices {
+		sort.Slice(device.Resources, func(i, j int) bool {
+			return lessReadingStats(device.Resources[i].ReadingStats, device.Resources[j].ReadingStats, device.Resources[i].ResourceName, device.Resources[j].ResourceName)
+		})
+		result = append(result, *device)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return lessReadingStats(result[i].ReadingStats, result[j].ReadingStats, result[i].DeviceName, result[j].DeviceName)
+	})
+	return result, nil
+}
+
+// splitDeviceNameResourceName splits the device name, one of deviceNames, and the resource name joined by the key
+// separator
+func splitDeviceNameResourceName(joined string, deviceNames []string) (deviceName string, resourceName string, found bool) {
+	for _, deviceName = range deviceNames {
+		if strings.HasPrefix(joined, deviceName+DBKeySeparator) {
+			return deviceName, strings.TrimPrefix(joined, deviceName+DBKeySeparator), true
+		}
+	}
+	return "", "", false
+}
+
+// lessReadingStats orders the stats by estimated size in descending order, then by name
+func lessReadingStats(a dataModels.ReadingStats, b dataModels.ReadingStats, aName string, bName string) bool {
+	if a.EstimatedSize != b.EstimatedSize {
+		return a.EstimatedSize > b.EstimatedSize
+	}
+	return aName < bName
+}
+
+// readingStatsByKey returns the stats of the readings of the sorted set scored by origin
+func readingStatsByKey(conn redis.Conn, key string) (stats dataModels.ReadingStats, edgeXerr errors.EdgeX) {
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZCARD, key)
+	_ = conn.Send(ZRANGE, key, 0, 0, WITHSCORES)
+	_ = conn.Send(ZRANGE, key, -1, -1, WITHSCORES)
+	values, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return stats, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query reading stats of %s failed", key), err)
+	}
+
+	count, err := redis.Uint64(values[0], nil)
+	if err != nil || count == 0 {
+		return stats, nil
+	}
+	first, _ := redis.Values(values[1], nil)
+	last, _ := redis.Values(values[2], nil)
+	var firstKey, lastKey string
+	var firstOrigin, lastOrigin float64
+	if _, err = redis.Scan(first, &firstKey, &firstOrigin); err != nil {
+		return stats, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query reading stats of %s failed", key), err)
+	}
+	if _, err = redis.Scan(last, &lastKey, &lastOrigin); err != nil {
+		return stats, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query reading stats of %s failed", key), err)
+	}
+	size, err := redis.Int64(conn.Do(STRLEN, lastKey))
+	if err != nil {
+		return stats, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the size of reading %s failed", lastKey), err)
+	}
+
+	stats.Count = count
+	stats.FirstOrigin = int64(firstOrigin)
+	stats.LastOrigin = int64(lastOrigin)
+	stats.EstimatedSize = size * int64(count)
+	return stats, nil
+}
+
+// EventCountsByTimeInterval returns the number of events of each device in each of the consecutive intervals of
+// interval nanoseconds from start, inclusive, to end, exclusive, sorted by device name. The devices without events in
+// the time range are omitted.
+func (c *Client) EventCountsByTimeInterval(start int64, end int64, interval int64) ([]dataModels.DeviceEventCounts, errors.EdgeX) {
+	deviceNames, edgeXerr := c.EventDeviceNames()
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	sort.Strings(deviceNames)
+
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var result []dataModels.DeviceEventCounts
+	for _, deviceName := range deviceNames {
+		key := CreateKey(EventsCollectionDeviceName, deviceName)
+		_ = conn.Send(MULTI)
+		for bucketStart := start; bucketStart < end; bucketStart += interval {
+			bucketEnd := bucketStart + interval
+			if bucketEnd > end {
+				bucketEnd = end
+			}
+			_ = conn.Send(ZCOUNT, key, bucketStart, fmt.Sprintf("(%d", bucketEnd))
+		}
+		counts, err := redis.Ints(conn.Do(EXEC))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("count events of device %s by time interval failed", deviceName), err)
+		}
+
+		device := dataModels.DeviceEventCounts{DeviceName: deviceName, Counts: make([]uint32, len(counts))}
+		var total int
+		for i, count := range counts {
+			device.Counts[i] = uint32(count)
+			total += count
+		}
+		if total > 0 {
+			result = append(result, device)
+		}
+	}
+	return result, nil
+}
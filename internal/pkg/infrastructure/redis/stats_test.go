@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitDeviceNameResourceName(t *testing.T) {
+	// sorted by length in descending order, as done by ReadingStats
+	deviceNames := []string{"camera:front", "camera"}
+
+	tests := []struct {
+		name                 string
+		joined               string
+		expectedDeviceName   string
+		expectedResourceName string
+		expectedFound        bool
+	}{
+		{"device", "camera:image", "camera", "image", true},
+		{"device name with separator", "camera:front:image", "camera:front", "image", true},
+		{"resource name with separator", "camera:image:raw", "camera", "image:raw", true},
+		{"unknown device", "sensor:temperature", "", "", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			deviceName, resourceName, found := splitDeviceNameResourceName(testCase.joined, deviceNames)
+			assert.Equal(t, testCase.expectedFound, found)
+			assert.Equal(t, testCase.expectedDeviceName, deviceName)
+			assert.Equal(t, testCase.expectedResourceName, resourceName)
+		})
+	}
+}
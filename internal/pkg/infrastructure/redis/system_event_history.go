@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+)
+
+const (
+	// SystemEventHistoryCollection is the sorted set of the system event history entries, the members are the JSON
+	// entries and the scores are their sequences
+	SystemEventHistoryCollection = "md|seh"
+	// SystemEventHistoryCollectionSequence is the counter of the system event history sequences
+	SystemEventHistoryCollectionSequence = SystemEventHistoryCollection + DBKeySeparator + "sequence"
+)
+
+// addSystemEventHistoryEntry assigns the next sequence to the entry and adds it to the system event history, removing
+// the oldest entries beyond maxEntries
+func addSystemEventHistoryEntry(conn redis.Conn, entry metadataModels.SystemEventHistoryEntry, maxEntries int) (metadataModels.SystemEventHistoryEntry, errors.EdgeX) {
+	sequence, err := redis.Uint64(conn.Do(INCR, SystemEventHistoryCollectionSequence))
+	if err != nil {
+		return entry, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to assign the system event history sequence", err)
+	}
+	entry.Sequence = sequence
+
+	m, err := json.Marshal(entry)
+	if err != nil {
+		return entry, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal system event history entry for Redis persistence", err)
+	}
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZADD, SystemEventHistoryCollection, sequence, m)
+	if maxEntries > 0 {
+		_ = conn.Send(ZREMRANGEBYRANK, SystemEventHistoryCollection, 0, -maxEntries-1)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return entry, errors.NewCommonEdgeX(errors.KindDatabaseError, "system event history entry creation failed", err)
+	}
+	return entry, nil
+}
+
+// systemEventHistoryEntriesAfter queries at most limit system event history entries with a sequence greater than
+// cursor, in the order of their sequences. A negative limit means no limit.
+func systemEventHistoryEntriesAfter(conn redis.Conn, cursor uint64, limit int) ([]metadataModels.SystemEventHistoryEntry, errors.EdgeX) {
+	objects, err := redis.ByteSlices(conn.Do(ZRANGEBYSCORE, SystemEventHistoryCollection, fmt.Sprintf("(%d", cursor), InfiniteMax, LIMIT, 0, limit))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to query the system event history entries after %d", cursor), err)
+	}
+	entries := make([]metadataModels.SystemEventHistoryEntry, len(objects))
+	for i, in := range objects {
+		if err = json.Unmarshal(in, &entries[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "system event history entry format parsing failed from the database", err)
+		}
+	}
+	return entries, nil
+}
+
+// systemEventHistorySequences returns the sequence of the oldest system event history entry, 0 when the history is
+// empty, and the sequence of the latest entry ever added
+func systemEventHistorySequences(conn redis.Conn) (oldest uint64, latest uint64, edgeXerr errors.EdgeX) {
+	values, err := redis.Values(conn.Do(ZRANGE, SystemEventHistoryCollection, 0, 0, WITHSCORES))
+	if err != nil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the oldest system event history entry", err)
+	}
+	if len(values) >= 2 {
+		if oldest, err = redis.Uint64(values[1], nil); err != nil {
+			return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to parse the oldest system event history sequence", err)
+		}
+	}
+	latest, err = redis.Uint64(conn.Do(GET, SystemEventHistoryCollectionSequence))
+	if err != nil && err != redis.ErrNil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to query the latest system event history sequence", err)
+	}
+	return oldest, latest, nil
+}
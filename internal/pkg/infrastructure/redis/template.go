@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	NotificationTemplateCollection     = "sn|tpl"
+	NotificationTemplateCollectionName = NotificationTemplateCollection + DBKeySeparator + common.Name
+	// NotificationTemplateCollectionChannel is the hash of the notification templates by subscription name and
+	// channel type, a subscription having at most one template per channel type
+	NotificationTemplateCollectionChannel = NotificationTemplateCollection + DBKeySeparator + "channel"
+)
+
+// notificationTemplateStoredKey return the notification template's stored key which combines the collection name and object id
+func notificationTemplateStoredKey(id string) string {
+	return CreateKey(NotificationTemplateCollection, id)
+}
+
+// notificationTemplateChannelField return the field of the notification template in the NotificationTemplateCollectionChannel hash
+func notificationTemplateChannelField(subscriptionName string, channelType string) string {
+	return CreateKey(subscriptionName, channelType)
+}
+
+// sendAddNotificationTemplateCmd send redis command for adding notification template
+func sendAddNotificationTemplateCmd(conn redis.Conn, storedKey string, t notificationModels.NotificationTemplate) errors.EdgeX {
+	m, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal notification template for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, NotificationTemplateCollection, t.Modified, storedKey)
+	_ = conn.Send(HSET, NotificationTemplateCollectionName, t.Name, storedKey)
+	_ = conn.Send(HSET, NotificationTemplateCollectionChannel, notificationTemplateChannelField(t.SubscriptionName, t.ChannelType), storedKey)
+	return nil
+}
+
+// addNotificationTemplate adds a new notification template into DB
+func addNotificationTemplate(conn redis.Conn, t notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, notificationTemplateStoredKey(t.Id))
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("notification template id %s already exists", t.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, NotificationTemplateCollectionName, t.Name)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("notification template name %s already exists", t.Name), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, NotificationTemplateCollectionChannel, notificationTemplateChannelField(t.SubscriptionName, t.ChannelType))
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName,
+			fmt.Sprintf("subscription %s already has a notification template for channel type '%s'", t.SubscriptionName, t.ChannelType), edgeXerr)
+	}
+
+	t.Created = pkgCommon.MakeTimestamp()
+	t.Modified = t.Created
+
+	storedKey := notificationTemplateStoredKey(t.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddNotificationTemplateCmd(conn, storedKey, t)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "notification template creation failed", err)
+	}
+
+	return t, edgeXerr
+}
+
+// notificationTemplateByName query notification template by name from DB
+func notificationTemplateByName(conn redis.Conn, name string) (template notificationModels.NotificationTemplate, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, NotificationTemplateCollectionName, name, &template)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query notification template by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// notificationTemplateBySubscriptionNameAndChannelType query the notification template of the subscription for the channel type from DB
+func notificationTemplateBySubscriptionNameAndChannelType(conn redis.Conn, subscriptionName string, channelType string) (template notificationModels.NotificationTemplate, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, NotificationTemplateCollectionChannel, notificationTemplateChannelField(subscriptionName, channelType), &template)
+	if edgeXerr != nil {
+		return template, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query notification template by subscription name %s and channel type '%s'", subscriptionName, channelType), edgeXerr)
+	}
+	return
+}
+
+// allNotificationTemplates query notification templates with offset and limit, the most recently modified first
+func allNotificationTemplates(conn redis.Conn, offset int, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, NotificationTemplateCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	templates := make([]notificationModels.NotificationTemplate, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &templates[i])
+		if err != nil {
+			return []notificationModels.NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "notification template format parsing failed from the database", err)
+		}
+	}
+	return templates, nil
+}
+
+// sendDeleteNotificationTemplateCmd send redis command for deleting notification template
+func sendDeleteNotificationTemplateCmd(conn redis.Conn, storedKey string, t notificationModels.NotificationTemplate) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, NotificationTemplateCollection, storedKey)
+	_ = conn.Send(HDEL, NotificationTemplateCollectionName, t.Name)
+	_ = conn.Send(HDEL, NotificationTemplateCollectionChannel, notificationTemplateChannelField(t.SubscriptionName, t.ChannelType))
+}
+
+// deleteNotificationTemplateByName deletes the notification template by name
+func deleteNotificationTemplateByName(conn redis.Conn, name string) errors.EdgeX {
+	template, edgeXerr := notificationTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteNotificationTemplateCmd(conn, notificationTemplateStoredKey(template.Id), template)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "notification template deletion failed", err)
+	}
+	return nil
+}
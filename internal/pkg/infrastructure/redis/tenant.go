@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	EventsCollectionTenant   = EventsCollection + DBKeySeparator + pkgCommon.Tenant
+	ReadingsCollectionTenant = ReadingsCollection + DBKeySeparator + pkgCommon.Tenant
+)
+
+// tenantFromTags returns the tenant tag of an event or reading, empty when it doesn't belong to a tenant
+func tenantFromTags(tags map[string]any) string {
+	tenant, _ := tags[pkgCommon.Tenant].(string)
+	return tenant
+}
+
+// EventsByTenant query events of the tenant by offset and limit
+func (c *Client) EventsByTenant(offset int, limit int, tenant string) (events []models.Event, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(EventsCollectionTenant, tenant), offset, limit)
+	if edgeXerr == nil {
+		events, edgeXerr = convertObjectsToEvents(conn, objects)
+	}
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events by offset %d, limit %d and tenant %s", offset, limit, tenant), edgeXerr)
+	}
+	return events, nil
+}
+
+// EventCountByTenant returns the count of the events of the tenant
+func (c *Client) EventCountByTenant(tenant string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(EventsCollectionTenant, tenant))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeleteEventsByTenantAndOrigin deletes the events of the tenant, and their corresponding readings, whose origin is
+// lower than or equal to origin.  This function is implemented to starts up two goroutines to delete readings and
+// events in the background to achieve better performance.
+func (c *Client) DeleteEventsByTenantAndOrigin(tenant string, origin int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	eventIds, readingIds, err := getEventReadingIdsByKeyScoreRange(conn, CreateKey(EventsCollectionTenant, tenant), "0", strconv.FormatInt(origin, 10))
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	c.loggingClient.Debugf("Prepare to delete %v readings", len(readingIds))
+	go c.asyncDeleteReadingsByIds(readingIds)
+	c.loggingClient.Debugf("Prepare to delete %v events", len(eventIds))
+	go c.asyncDeleteEventsByIds(eventIds)
+
+	return nil
+}
+
+// ReadingsByTenant query readings of the tenant by offset and limit
+func (c *Client) ReadingsByTenant(offset int, limit int, tenant string) (readings []models.Reading, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(ReadingsCollectionTenant, tenant), offset, limit)
+	if edgeXerr == nil {
+		readings, edgeXerr = convertObjectsToReadings(objects)
+	}
+	if edgeXerr != nil {
+		return readings, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings by offset %d, limit %d and tenant %s", offset, limit, tenant), edgeXerr)
+	}
+	return readings, nil
+}
+
+// ReadingCountByTenant returns the count of the readings of the tenant
+func (c *Client) ReadingCountByTenant(tenant string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(ReadingsCollectionTenant, tenant))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
@@ -24,6 +24,7 @@ const (
 	TransmissionCollectionSubscriptionName = TransmissionCollection + DBKeySeparator + common.Subscription + DBKeySeparator + common.Name
 	TransmissionCollectionNotificationId   = TransmissionCollection + DBKeySeparator + common.Notification + DBKeySeparator + common.Id
 	TransmissionCollectionCreated          = TransmissionCollection + DBKeySeparator + common.Created
+	TransmissionCollectionResend           = TransmissionCollection + DBKeySeparator + "resend"
 )
 
 // notificationStoredKey return the transmission's stored key which combines the collection name and object id
@@ -93,6 +94,11 @@ func sendDeleteTransmissionCmd(conn redis.Conn, storedKey string, trans models.T
 	_ = conn.Send(ZREM, CreateKey(TransmissionCollectionNotificationId, trans.NotificationId), storedKey)
 }
 
+// sendDeleteTransmissionResendsCmd sends redis command to delete the links from a deleted transmission to its resends
+func sendDeleteTransmissionResendsCmd(conn redis.Conn, trans models.Transmission) {
+	_ = conn.Send(DEL, CreateKey(TransmissionCollectionResend, trans.Id))
+}
+
 // updateTransmission updates a transmission
 func updateTransmission(conn redis.Conn, trans models.Transmission) errors.EdgeX {
 	oldTransmission, edgeXerr := transmissionById(conn, trans.Id)
@@ -123,6 +129,7 @@ func deleteTransmissionById(conn redis.Conn, id string) errors.EdgeX {
 	storedKey := transmissionStoredKey(transmission.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteTransmissionCmd(conn, storedKey, transmission)
+	sendDeleteTransmissionResendsCmd(conn, transmission)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission deletion failed", err)
@@ -180,6 +187,44 @@ func transmissionsByNotificationId(conn redis.Conn, offset int, limit int, id st
 	return objectsToTransmissions(objects)
 }
 
+// addTransmissionResend adds the resend transmission into DB and links it to the original transmission
+func addTransmissionResend(conn redis.Conn, originalId string, resend models.Transmission) (models.Transmission, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, transmissionStoredKey(resend.Id))
+	if edgeXerr != nil {
+		return resend, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return resend, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("transmission id %s already exists", resend.Id), edgeXerr)
+	}
+
+	if resend.Created == 0 {
+		resend.Created = pkgCommon.MakeTimestamp()
+	}
+
+	storedKey := transmissionStoredKey(resend.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddTransmissionCmd(conn, storedKey, resend)
+	if edgeXerr != nil {
+		return resend, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_ = conn.Send(ZADD, CreateKey(TransmissionCollectionResend, originalId), resend.Created, storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "transmission resend creation failed", err)
+	}
+
+	return resend, edgeXerr
+}
+
+// transmissionResends queries the transmissions resent from the original transmission by offset and limit
+func transmissionResends(conn redis.Conn, offset int, limit int, originalId string) (transmissions []models.Transmission, err errors.EdgeX) {
+	objects, err := getObjectsByRevRange(conn, CreateKey(TransmissionCollectionResend, originalId), offset, limit)
+	if err != nil {
+		return transmissions, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return objectsToTransmissions(objects)
+}
+
 func objectsToTransmissions(objects [][]byte) (transmissions []models.Transmission, edgeXerr errors.EdgeX) {
 	transmissions = make([]models.Transmission, len(objects))
 	for i, o := range objects {
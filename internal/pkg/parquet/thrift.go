@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// The types of the fields of the Thrift compact protocol
+const (
+	thriftBooleanTrue  = 1
+	thriftBooleanFalse = 2
+	thriftI32          = 5
+	thriftI64          = 6
+	thriftBinary       = 8
+	thriftList         = 9
+	thriftStruct       = 12
+)
+
+// thriftWriter encodes the Parquet page headers and file metadata with the Thrift compact protocol. The fields of a
+// struct must be written in increasing id order.
+type thriftWriter struct {
+	buf bytes.Buffer
+	// lastIDs is the stack of the ids of the last fields written in the structs being written, the field ids being
+	// encoded as deltas
+	lastIDs []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{lastIDs: []int16{0}}
+}
+
+func (w *thriftWriter) bytes() []byte {
+	return w.buf.Bytes()
+}
+
+func (w *thriftWriter) fieldHeader(id int16, fieldType byte) {
+	last := &w.lastIDs[len(w.lastIDs)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | fieldType)
+	} else {
+		w.buf.WriteByte(fieldType)
+		w.varint(int64(id))
+	}
+	*last = id
+}
+
+func (w *thriftWriter) varint(v int64) {
+	w.uvarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) uvarint(v uint64) {
+	w.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.varint(v)
+}
+
+func (w *thriftWriter) bool(id int16, v bool) {
+	if v {
+		w.fieldHeader(id, thriftBooleanTrue)
+	} else {
+		w.fieldHeader(id, thriftBooleanFalse)
+	}
+}
+
+func (w *thriftWriter) string(id int16, v string) {
+	w.fieldHeader(id, thriftBinary)
+	w.uvarint(uint64(len(v)))
+	w.buf.WriteString(v)
+}
+
+// stop ends the top level struct
+func (w *thriftWriter) stop() {
+	w.buf.WriteByte(0)
+}
+
+// beginStruct starts the struct field, whose fields are written until endStruct
+func (w *thriftWriter) beginStruct(id int16) {
+	w.fieldHeader(id, thriftStruct)
+	w.lastIDs = append(w.lastIDs, 0)
+}
+
+// endStruct ends the struct field, or the struct element of a list
+func (w *thriftWriter) endStruct() {
+	w.buf.WriteByte(0)
+	w.lastIDs = w.lastIDs[:len(w.lastIDs)-1]
+}
+
+// beginListStruct starts a struct element of a list, whose fields are written until endStruct
+func (w *thriftWriter) beginListStruct() {
+	w.lastIDs = append(w.lastIDs, 0)
+}
+
+func (w *thriftWriter) listHeader(id int16, elementType byte, size int) {
+	w.fieldHeader(id, thriftList)
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elementType)
+		return
+	}
+	w.buf.WriteByte(0xf0 | elementType)
+	w.uvarint(uint64(size))
+}
+
+func (w *thriftWriter) i32List(id int16, values []int32) {
+	w.listHeader(id, thriftI32, len(values))
+	for _, v := range values {
+		w.varint(int64(v))
+	}
+}
+
+func (w *thriftWriter) stringList(id int16, values []string) {
+	w.listHeader(id, thriftBinary, len(values))
+	for _, v := range values {
+		w.uvarint(uint64(len(v)))
+		w.buf.WriteString(v)
+	}
+}
@@ -0,0 +1,326 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package parquet is a minimal writer of Apache Parquet files with a flat schema, i.e. the rows of a table. The values
+// are PLAIN encoded in uncompressed data pages, each column chunk of a row group being a single page, so that the
+// files are read by any Parquet reader without support for the compression codecs.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ColumnType is the type of the values of a column
+type ColumnType int
+
+const (
+	// Int64 columns hold int64 values
+	Int64 ColumnType = iota
+	// Timestamp columns hold int64 values, the nanoseconds since the epoch in UTC
+	Timestamp
+	// String columns hold string values
+	String
+	// Binary columns hold []byte values
+	Binary
+)
+
+// Column is a column of the schema of the file
+type Column struct {
+	Name string
+	Type ColumnType
+	// Optional columns hold nil values, the null values
+	Optional bool
+}
+
+// The physical types, repetition types, encodings and converted types of the Parquet format
+const (
+	typeInt64     = 2
+	typeByteArray = 6
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	convertedTypeUTF8 = 0
+
+	pageTypeData = 0
+	codecNone    = 0
+)
+
+// magic starts and ends the Parquet files
+var magic = []byte("PAR1")
+
+// createdBy identifies the writer of the files
+const createdBy = "edgex-go"
+
+// Writer writes the rows of a Parquet file one row group at a time. The file is complete once the Writer is closed.
+type Writer struct {
+	w         io.Writer
+	columns   []Column
+	offset    int64
+	numRows   int64
+	rowGroups []rowGroup
+	closed    bool
+}
+
+type rowGroup struct {
+	numRows int64
+	size    int64
+	chunks  []columnChunk
+}
+
+type columnChunk struct {
+	offset int64
+	size   int64
+}
+
+// NewWriter returns a Writer writing the Parquet file of the columns to w
+func NewWriter(w io.Writer, columns []Column) (*Writer, error) {
+	if len(columns) == 0 {
+		return nil, errors.New("the Parquet schema has no columns")
+	}
+	names := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		if column.Name == "" || names[column.Name] {
+			return nil, fmt.Errorf("the Parquet column name '%s' is empty or duplicated", column.Name)
+		}
+		if column.Type < Int64 || column.Type > Binary {
+			return nil, fmt.Errorf("the Parquet column '%s' has an unknown type %d", column.Name, column.Type)
+		}
+		names[column.Name] = true
+	}
+
+	writer := &Writer{w: w, columns: columns}
+	if err := writer.write(magic); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (w *Writer) write(b []byte) error {
+	n, err := w.w.Write(b)
+	w.offset += int64(n)
+	return err
+}
+
+// WriteRowGroup writes the rows as a row group. A row holds the value of each column, in the order of the columns: an
+// int64 for the Int64 and Timestamp columns, a string for the String columns, a []byte for the Binary columns, or nil
+// for the optional columns.
+func (w *Writer) WriteRowGroup(rows [][]any) error {
+	if w.closed {
+		return errors.New("the Parquet writer is closed")
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	for i, row := range rows {
+		if len(row) != len(w.columns) {
+			return fmt.Errorf("row %d has %d values instead of %d", i, len(row), len(w.columns))
+		}
+	}
+
+	group := rowGroup{numRows: int64(len(rows)), chunks: make([]columnChunk, len(w.columns))}
+	for i, column := range w.columns {
+		page, err := encodePage(column, i, rows)
+		if err != nil {
+			return err
+		}
+		header := encodePageHeader(len(page), len(rows))
+		group.chunks[i] = columnChunk{offset: w.offset, size: int64(len(header) + len(page))}
+		group.size += group.chunks[i].size
+		if err = w.write(header); err != nil {
+			return err
+		}
+		if err = w.write(page); err != nil {
+			return err
+		}
+	}
+	w.rowGroups = append(w.rowGroups, group)
+	w.numRows += group.numRows
+	return nil
+}
+
+// Close writes the metadata of the file, which is complete once closed
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	metadata := w.encodeFileMetadata()
+	footer := binary.LittleEndian.AppendUint32(metadata, uint32(len(metadata)))
+	return w.write(append(footer, magic...))
+}
+
+// encodePage returns the data page of the values of the column of the rows, the definition levels of the optional
+// columns followed by the PLAIN encoded values which aren't null
+func encodePage(column Column, index int, rows [][]any) ([]byte, error) {
+	var values bytes.Buffer
+	var levels []byte
+	if column.Optional {
+		levels = make([]byte, len(rows))
+	}
+	for i, row := range rows {
+		value := row[index]
+		if value == nil {
+			if !column.Optional {
+				return nil, fmt.Errorf("row %d has no value for the required column '%s'", i, column.Name)
+			}
+			continue
+		}
+		if column.Optional {
+			levels[i] = 1
+		}
+		switch column.Type {
+		case Int64, Timestamp:
+			v, ok := value.(int64)
+			if !ok {
+				return nil, fmt.Errorf("row %d has a %T value instead of int64 for the column '%s'", i, value, column.Name)
+			}
+			values.Write(binary.LittleEndian.AppendUint64(nil, uint64(v)))
+		case String:
+			v, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("row %d has a %T value instead of string for the column '%s'", i, value, column.Name)
+			}
+			values.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(v))))
+			values.WriteString(v)
+		case Binary:
+			v, ok := value.([]byte)
+			if !ok {
+				return nil, fmt.Errorf("row %d has a %T value instead of []byte for the column '%s'", i, value, column.Name)
+			}
+			values.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(v))))
+			values.Write(v)
+		}
+	}
+
+	if !column.Optional {
+		return values.Bytes(), nil
+	}
+	encodedLevels := encodeLevels(levels)
+	page := binary.LittleEndian.AppendUint32(nil, uint32(len(encodedLevels)))
+	page = append(page, encodedLevels...)
+	return append(page, values.Bytes()...), nil
+}
+
+// encodeLevels returns the definition levels, 0 or 1, encoded as the RLE runs of the RLE/bit-packing hybrid encoding
+// with a bit width of 1
+func encodeLevels(levels []byte) []byte {
+	var encoded []byte
+	for start := 0; start < len(levels); {
+		end := start + 1
+		for end < len(levels) && levels[end] == levels[start] {
+			end++
+		}
+		encoded = binary.AppendUvarint(encoded, uint64(end-start)<<1)
+		encoded = append(encoded, levels[start])
+		start = end
+	}
+	return encoded
+}
+
+func encodePageHeader(pageSize int, numValues int) []byte {
+	w := newThriftWriter()
+	w.i32(1, pageTypeData)
+	w.i32(2, int32(pageSize))
+	w.i32(3, int32(pageSize))
+	w.beginStruct(5)
+	w.i32(1, int32(numValues))
+	w.i32(2, encodingPlain)
+	w.i32(3, encodingRLE)
+	w.i32(4, encodingRLE)
+	w.endStruct()
+	w.stop()
+	return w.bytes()
+}
+
+// encodeFileMetadata returns the FileMetaData of the file, with its schema and the locations of its column chunks
+func (w *Writer) encodeFileMetadata() []byte {
+	t := newThriftWriter()
+	t.i32(1, 1)
+
+	t.listHeader(2, thriftStruct, len(w.columns)+1)
+	t.beginListStruct()
+	t.string(4, "schema")
+	t.i32(5, int32(len(w.columns)))
+	t.endStruct()
+	for _, column := range w.columns {
+		t.beginListStruct()
+		t.i32(1, physicalType(column.Type))
+		if column.Optional {
+			t.i32(3, repetitionOptional)
+		} else {
+			t.i32(3, repetitionRequired)
+		}
+		t.string(4, column.Name)
+		if column.Type == String {
+			t.i32(6, convertedTypeUTF8)
+		}
+		switch column.Type {
+		case String:
+			// LogicalType STRING
+			t.beginStruct(10)
+			t.beginStruct(1)
+			t.endStruct()
+			t.endStruct()
+		case Timestamp:
+			// LogicalType TIMESTAMP adjusted to UTC, in NANOS
+			t.beginStruct(10)
+			t.beginStruct(8)
+			t.bool(1, true)
+			t.beginStruct(2)
+			t.beginStruct(3)
+			t.endStruct()
+			t.endStruct()
+			t.endStruct()
+			t.endStruct()
+		}
+		t.endStruct()
+	}
+
+	t.i64(3, w.numRows)
+
+	t.listHeader(4, thriftStruct, len(w.rowGroups))
+	for _, group := range w.rowGroups {
+		t.beginListStruct()
+		t.listHeader(1, thriftStruct, len(group.chunks))
+		for i, chunk := range group.chunks {
+			column := w.columns[i]
+			t.beginListStruct()
+			t.i64(2, chunk.offset)
+			t.beginStruct(3)
+			t.i32(1, physicalType(column.Type))
+			t.i32List(2, []int32{encodingPlain, encodingRLE})
+			t.stringList(3, []string{column.Name})
+			t.i32(4, codecNone)
+			t.i64(5, group.numRows)
+			t.i64(6, chunk.size)
+			t.i64(7, chunk.size)
+			t.i64(9, chunk.offset)
+			t.endStruct()
+			t.endStruct()
+		}
+		t.i64(2, group.size)
+		t.i64(3, group.numRows)
+		t.endStruct()
+	}
+
+	t.string(6, createdBy)
+	t.stop()
+	return t.bytes()
+}
+
+func physicalType(columnType ColumnType) int32 {
+	if columnType == Int64 || columnType == Timestamp {
+		return typeInt64
+	}
+	return typeByteArray
+}
@@ -0,0 +1,227 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// thriftReader decodes the Thrift compact protocol structs as maps of the values by field id: int64 for the integers,
+// bool, []byte for the binaries, []any for the lists and map[int16]any for the structs
+type thriftReader struct {
+	buf    []byte
+	offset int
+}
+
+func (r *thriftReader) byte() byte {
+	b := r.buf[r.offset]
+	r.offset++
+	return b
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.offset:])
+	r.offset += n
+	return v
+}
+
+func (r *thriftReader) varint() int64 {
+	v := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *thriftReader) value(fieldType byte) any {
+	switch fieldType {
+	case thriftBooleanTrue:
+		return true
+	case thriftBooleanFalse:
+		return false
+	case thriftI32, thriftI64:
+		return r.varint()
+	case thriftBinary:
+		n := int(r.uvarint())
+		r.offset += n
+		return r.buf[r.offset-n : r.offset]
+	case thriftList:
+		header := r.byte()
+		size, elementType := int(header>>4), header&0x0f
+		if size == 15 {
+			size = int(r.uvarint())
+		}
+		list := make([]any, size)
+		for i := range list {
+			list[i] = r.value(elementType)
+		}
+		return list
+	case thriftStruct:
+		fields := make(map[int16]any)
+		var id int16
+		for {
+			header := r.byte()
+			if header == 0 {
+				return fields
+			}
+			if delta := header >> 4; delta != 0 {
+				id += int16(delta)
+			} else {
+				id = int16(r.varint())
+			}
+			fields[id] = r.value(header & 0x0f)
+		}
+	}
+	panic(fmt.Sprintf("unknown Thrift type %d", fieldType))
+}
+
+// readColumn returns the values of the column chunk, decoding its data page
+func readColumn(t *testing.T, file []byte, chunk map[int16]any, optional bool) []any {
+	metadata := chunk[3].(map[int16]any)
+	r := &thriftReader{buf: file, offset: int(metadata[9].(int64))}
+	header := r.value(thriftStruct).(map[int16]any)
+	require.Equal(t, int64(pageTypeData), header[1])
+	page := file[r.offset : r.offset+int(header[3].(int64))]
+	numValues := int(header[5].(map[int16]any)[1].(int64))
+
+	defined := make([]bool, 0, numValues)
+	if optional {
+		length := int(binary.LittleEndian.Uint32(page))
+		levels := &thriftReader{buf: page[4 : 4+length]}
+		for levels.offset < length {
+			run := int(levels.uvarint() >> 1)
+			level := levels.byte()
+			for i := 0; i < run; i++ {
+				defined = append(defined, level == 1)
+			}
+		}
+		page = page[4+length:]
+	} else {
+		for i := 0; i < numValues; i++ {
+			defined = append(defined, true)
+		}
+	}
+	require.Len(t, defined, numValues)
+
+	values := make([]any, numValues)
+	for i := range values {
+		if !defined[i] {
+			continue
+		}
+		if metadata[1].(int64) == typeInt64 {
+			values[i] = int64(binary.LittleEndian.Uint64(page))
+			page = page[8:]
+			continue
+		}
+		length := int(binary.LittleEndian.Uint32(page))
+		values[i] = page[4 : 4+length]
+		page = page[4+length:]
+	}
+	assert.Empty(t, page)
+	return values
+}
+
+func TestWriter(t *testing.T) {
+	columns := []Column{
+		{Name: "origin", Type: Timestamp},
+		{Name: "count", Type: Int64, Optional: true},
+		{Name: "deviceName", Type: String},
+		{Name: "value", Type: String, Optional: true},
+		{Name: "binaryValue", Type: Binary, Optional: true},
+	}
+	rowGroups := [][][]any{
+		{
+			{int64(1700000000000000000), int64(1), "device1", "20.5", nil},
+			{int64(1700000000000000001), nil, "device1", nil, []byte{1, 2, 3}},
+		},
+		{
+			{int64(1700000000000000002), int64(-3), "device2", "", []byte{}},
+		},
+	}
+
+	var buf bytes.Buffer
+	writer, err := NewWriter(&buf, columns)
+	require.NoError(t, err)
+	for _, rows := range rowGroups {
+		require.NoError(t, writer.WriteRowGroup(rows))
+	}
+	require.NoError(t, writer.WriteRowGroup(nil))
+	require.NoError(t, writer.Close())
+	require.Error(t, writer.WriteRowGroup(rowGroups[0]))
+
+	file := buf.Bytes()
+	require.Equal(t, magic, file[:4])
+	require.Equal(t, magic, file[len(file)-4:])
+	metadataLength := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	r := &thriftReader{buf: file[len(file)-8-metadataLength : len(file)-8]}
+	metadata := r.value(thriftStruct).(map[int16]any)
+	assert.Equal(t, metadataLength, r.offset)
+
+	assert.Equal(t, int64(1), metadata[1])
+	assert.Equal(t, int64(3), metadata[3])
+	assert.Equal(t, []byte(createdBy), metadata[6])
+	schema := metadata[2].([]any)
+	require.Len(t, schema, len(columns)+1)
+	assert.Equal(t, int64(len(columns)), schema[0].(map[int16]any)[5])
+	for i, column := range columns {
+		element := schema[i+1].(map[int16]any)
+		assert.Equal(t, []byte(column.Name), element[4])
+		assert.Equal(t, int64(physicalType(column.Type)), element[1])
+		assert.Equal(t, column.Optional, element[3] == int64(repetitionOptional))
+	}
+	timestamp := schema[1].(map[int16]any)[10].(map[int16]any)[8].(map[int16]any)
+	assert.Equal(t, true, timestamp[1])
+	assert.Contains(t, timestamp[2].(map[int16]any), int16(3))
+	assert.Contains(t, schema[3].(map[int16]any)[10].(map[int16]any), int16(1))
+
+	groups := metadata[4].([]any)
+	require.Len(t, groups, len(rowGroups))
+	for g, rows := range rowGroups {
+		group := groups[g].(map[int16]any)
+		assert.Equal(t, int64(len(rows)), group[3])
+		chunks := group[1].([]any)
+		require.Len(t, chunks, len(columns))
+		for c, column := range columns {
+			values := readColumn(t, file, chunks[c].(map[int16]any), column.Optional)
+			for i, row := range rows {
+				expected := row[c]
+				if s, ok := expected.(string); ok {
+					expected = []byte(s)
+				}
+				assert.Equal(t, expected, values[i], "row %d of column %s", i, column.Name)
+			}
+		}
+	}
+}
+
+func TestWriterErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []Column
+		row     []any
+	}{
+		{"no columns", nil, nil},
+		{"duplicated column", []Column{{Name: "a"}, {Name: "a"}}, nil},
+		{"unknown column type", []Column{{Name: "a", Type: Binary + 1}}, nil},
+		{"missing value", []Column{{Name: "a"}, {Name: "b"}}, []any{int64(1)}},
+		{"null required value", []Column{{Name: "a"}}, []any{nil}},
+		{"invalid value type", []Column{{Name: "a", Type: String}}, []any{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer, err := NewWriter(&bytes.Buffer{}, tt.columns)
+			if tt.row == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Error(t, writer.WriteRowGroup([][]any{tt.row}))
+		})
+	}
+}
@@ -0,0 +1,292 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package protoconv converts the DTOs of the core contracts to and from the protobuf messages of the gRPC API, and the
+// EdgeX errors to gRPC statuses.
+package protoconv
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	edgexpb "github.com/edgexfoundry/edgex-go/proto/edgex/v3"
+)
+
+var statusCodes = map[errors.ErrKind]codes.Code{
+	errors.KindEntityDoesNotExist:  codes.NotFound,
+	errors.KindContractInvalid:     codes.InvalidArgument,
+	errors.KindInvalidId:           codes.InvalidArgument,
+	errors.KindDuplicateName:       codes.AlreadyExists,
+	errors.KindStatusConflict:      codes.FailedPrecondition,
+	errors.KindServiceLocked:       codes.FailedPrecondition,
+	errors.KindNotAllowed:          codes.FailedPrecondition,
+	errors.KindLimitExceeded:       codes.ResourceExhausted,
+	errors.KindRangeNotSatisfiable: codes.OutOfRange,
+	errors.KindServiceUnavailable:  codes.Unavailable,
+	errors.KindCommunicationError:  codes.Unavailable,
+	errors.KindNotImplemented:      codes.Unimplemented,
+}
+
+// Status returns the gRPC status error of the EdgeX error, with the code matching its kind, or nil without error
+func Status(err errors.EdgeX) error {
+	if err == nil {
+		return nil
+	}
+	code, ok := statusCodes[errors.Kind(err)]
+	if !ok {
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
+
+// Page returns the offset and limit of the page requested, a limit of 0 requests a page of maxResultCount items like
+// the limit -1 of the REST API
+func Page(offset int32, limit int32, maxResultCount int) (int, int, errors.EdgeX) {
+	if offset < 0 {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("offset %d is negative", offset), nil)
+	}
+	if limit < 0 || int(limit) > maxResultCount {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("limit %d is out of 0 ~ %d range", limit, maxResultCount), nil)
+	}
+	if limit == 0 {
+		return int(offset), maxResultCount, nil
+	}
+	return int(offset), int(limit), nil
+}
+
+// toStruct converts the map, such as the tags, to a Struct through its JSON encoding, so the map values have the
+// types of the REST API. A nil or empty map converts to nil.
+func toStruct(m any) (*structpb.Struct, error) {
+	if m == nil || reflect.ValueOf(m).Len() == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	s := &structpb.Struct{}
+	return s, protojson.Unmarshal(data, s)
+}
+
+func toValue(v any) (*structpb.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	value := &structpb.Value{}
+	return value, protojson.Unmarshal(data, value)
+}
+
+func toDouble(v *float64) *wrapperspb.DoubleValue {
+	if v == nil {
+		return nil
+	}
+	return wrapperspb.Double(*v)
+}
+
+// FromStruct returns the map of the Struct, nil for a nil Struct
+func FromStruct(s *structpb.Struct) map[string]any {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
+
+// FromEventDTO converts the event to its protobuf message
+func FromEventDTO(e dtos.Event) (*edgexpb.Event, error) {
+	event := &edgexpb.Event{
+		Id:          e.Id,
+		DeviceName:  e.DeviceName,
+		ProfileName: e.ProfileName,
+		SourceName:  e.SourceName,
+		Origin:      e.Origin,
+		Readings:    make([]*edgexpb.Reading, len(e.Readings)),
+	}
+	var err error
+	if event.Tags, err = toStruct(e.Tags); err != nil {
+		return nil, err
+	}
+	for i, r := range e.Readings {
+		if event.Readings[i], err = FromReadingDTO(r); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// FromReadingDTO converts the reading to its protobuf message
+func FromReadingDTO(r dtos.BaseReading) (*edgexpb.Reading, error) {
+	reading := &edgexpb.Reading{
+		Id:           r.Id,
+		Origin:       r.Origin,
+		DeviceName:   r.DeviceName,
+		ResourceName: r.ResourceName,
+		ProfileName:  r.ProfileName,
+		ValueType:    r.ValueType,
+		Units:        r.Units,
+		Value:        r.Value,
+		BinaryValue:  r.BinaryValue,
+		MediaType:    r.MediaType,
+	}
+	var err error
+	if reading.Tags, err = toStruct(r.Tags); err != nil {
+		return nil, err
+	}
+	if reading.ObjectValue, err = toValue(r.ObjectValue); err != nil {
+		return nil, err
+	}
+	return reading, nil
+}
+
+// ToEventDTO converts the protobuf message to the event, versioned as the events of the REST API
+func ToEventDTO(e *edgexpb.Event) dtos.Event {
+	event := dtos.Event{
+		Versionable: dtoCommon.NewVersionable(),
+		Id:          e.GetId(),
+		DeviceName:  e.GetDeviceName(),
+		ProfileName: e.GetProfileName(),
+		SourceName:  e.GetSourceName(),
+		Origin:      e.GetOrigin(),
+		Readings:    make([]dtos.BaseReading, len(e.GetReadings())),
+		Tags:        FromStruct(e.GetTags()),
+	}
+	for i, r := range e.GetReadings() {
+		event.Readings[i] = ToReadingDTO(r)
+	}
+	return event
+}
+
+// ToReadingDTO converts the protobuf message to the reading
+func ToReadingDTO(r *edgexpb.Reading) dtos.BaseReading {
+	reading := dtos.BaseReading{
+		Id:            r.GetId(),
+		Origin:        r.GetOrigin(),
+		DeviceName:    r.GetDeviceName(),
+		ResourceName:  r.GetResourceName(),
+		ProfileName:   r.GetProfileName(),
+		ValueType:     r.GetValueType(),
+		Units:         r.GetUnits(),
+		Tags:          FromStruct(r.GetTags()),
+		SimpleReading: dtos.SimpleReading{Value: r.GetValue()},
+		BinaryReading: dtos.BinaryReading{BinaryValue: r.GetBinaryValue(), MediaType: r.GetMediaType()},
+	}
+	if r.GetObjectValue() != nil {
+		reading.ObjectValue = r.GetObjectValue().AsInterface()
+	}
+	return reading
+}
+
+// FromDeviceDTO converts the device to its protobuf message
+func FromDeviceDTO(d dtos.Device) (*edgexpb.Device, error) {
+	device := &edgexpb.Device{
+		Id:             d.Id,
+		Name:           d.Name,
+		Description:    d.Description,
+		AdminState:     d.AdminState,
+		OperatingState: d.OperatingState,
+		Labels:         d.Labels,
+		ServiceName:    d.ServiceName,
+		ProfileName:    d.ProfileName,
+		Created:        d.Created,
+		Modified:       d.Modified,
+	}
+	for _, ae := range d.AutoEvents {
+		device.AutoEvents = append(device.AutoEvents, &edgexpb.AutoEvent{Interval: ae.Interval, OnChange: ae.OnChange, SourceName: ae.SourceName})
+	}
+	var err error
+	if device.Location, err = toValue(d.Location); err != nil {
+		return nil, err
+	}
+	if device.Protocols, err = toStruct(d.Protocols); err != nil {
+		return nil, err
+	}
+	if device.Tags, err = toStruct(d.Tags); err != nil {
+		return nil, err
+	}
+	if device.Properties, err = toStruct(d.Properties); err != nil {
+		return nil, err
+	}
+	return device, nil
+}
+
+// FromDeviceProfileDTO converts the device profile to its protobuf message
+func FromDeviceProfileDTO(p dtos.DeviceProfile) (*edgexpb.DeviceProfile, error) {
+	profile := &edgexpb.DeviceProfile{
+		Id:           p.Id,
+		Name:         p.Name,
+		Description:  p.Description,
+		Manufacturer: p.Manufacturer,
+		Model:        p.Model,
+		Labels:       p.Labels,
+		Created:      p.Created,
+		Modified:     p.Modified,
+	}
+	var err error
+	for _, r := range p.DeviceResources {
+		resource := &edgexpb.DeviceResource{
+			Name:        r.Name,
+			Description: r.Description,
+			IsHidden:    r.IsHidden,
+			Properties: &edgexpb.ResourceProperties{
+				ValueType:    r.Properties.ValueType,
+				ReadWrite:    r.Properties.ReadWrite,
+				Units:        r.Properties.Units,
+				Minimum:      toDouble(r.Properties.Minimum),
+				Maximum:      toDouble(r.Properties.Maximum),
+				DefaultValue: r.Properties.DefaultValue,
+				MediaType:    r.Properties.MediaType,
+			},
+		}
+		if resource.Properties.Optional, err = toStruct(r.Properties.Optional); err != nil {
+			return nil, err
+		}
+		if resource.Attributes, err = toStruct(r.Attributes); err != nil {
+			return nil, err
+		}
+		if resource.Tags, err = toStruct(r.Tags); err != nil {
+			return nil, err
+		}
+		profile.DeviceResources = append(profile.DeviceResources, resource)
+	}
+	for _, c := range p.DeviceCommands {
+		command := &edgexpb.DeviceCommand{Name: c.Name, IsHidden: c.IsHidden, ReadWrite: c.ReadWrite}
+		for _, ro := range c.ResourceOperations {
+			command.ResourceOperations = append(command.ResourceOperations,
+				&edgexpb.ResourceOperation{DeviceResource: ro.DeviceResource, DefaultValue: ro.DefaultValue})
+		}
+		if command.Tags, err = toStruct(c.Tags); err != nil {
+			return nil, err
+		}
+		profile.DeviceCommands = append(profile.DeviceCommands, command)
+	}
+	return profile, nil
+}
+
+// FromDeviceServiceDTO converts the device service to its protobuf message
+func FromDeviceServiceDTO(s dtos.DeviceService) *edgexpb.DeviceService {
+	return &edgexpb.DeviceService{
+		Id:          s.Id,
+		Name:        s.Name,
+		Description: s.Description,
+		Labels:      s.Labels,
+		BaseAddress: s.BaseAddress,
+		AdminState:  s.AdminState,
+		Created:     s.Created,
+		Modified:    s.Modified,
+	}
+}
@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package protoconv
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEventRoundTrip(t *testing.T) {
+	event := dtos.NewEvent("testProfile", "testDevice", "testSource")
+	require.NoError(t, event.AddSimpleReading("temperature", common.ValueTypeInt32, int32(21)))
+	event.AddBinaryReading("image", []byte{0xff, 0xd8}, "image/jpeg")
+	event.AddObjectReading("status", map[string]any{"battery": 98.5, "modes": []any{"eco", "boost"}})
+	event.Tags = dtos.Tags{"site": "factory", "floor": float64(2)}
+	event.Readings[0].Tags = dtos.Tags{"calibrated": true}
+
+	converted, err := FromEventDTO(event)
+	require.NoError(t, err)
+	assert.Equal(t, "21", converted.Readings[0].Value)
+	assert.Equal(t, "image/jpeg", converted.Readings[1].MediaType)
+
+	assert.Equal(t, event, ToEventDTO(converted))
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		name           string
+		offset         int32
+		limit          int32
+		expectedOffset int
+		expectedLimit  int
+		expectedError  bool
+	}{
+		{"valid", 10, 5, 10, 5, false},
+		{"valid, default limit", 0, 0, 0, 20, false},
+		{"invalid, negative offset", -1, 5, 0, 0, true},
+		{"invalid, negative limit", 0, -1, 0, 0, true},
+		{"invalid, limit exceeds max", 0, 21, 0, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			offset, limit, err := Page(testCase.offset, testCase.limit, 20)
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedOffset, offset)
+			assert.Equal(t, testCase.expectedLimit, limit)
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         errors.ErrKind
+		expectedCode codes.Code
+	}{
+		{"not found", errors.KindEntityDoesNotExist, codes.NotFound},
+		{"invalid", errors.KindContractInvalid, codes.InvalidArgument},
+		{"duplicate", errors.KindDuplicateName, codes.AlreadyExists},
+		{"locked", errors.KindServiceLocked, codes.FailedPrecondition},
+		{"unavailable", errors.KindServiceUnavailable, codes.Unavailable},
+		{"database error", errors.KindDatabaseError, codes.Internal},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := Status(errors.NewCommonEdgeX(testCase.kind, "failure", nil))
+			assert.Equal(t, testCase.expectedCode, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), "failure")
+		})
+	}
+	assert.NoError(t, Status(nil))
+}
@@ -0,0 +1,210 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rbac enforces the role based access control of the REST routes of the core services. The roles of a request
+// are read from a claim of its JWT, and grant the permissions the route requires.
+package rbac
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/gorilla/mux"
+)
+
+// Permission is a permission required by the routes and granted by the roles
+type Permission string
+
+const (
+	// PermissionRead allows querying the resources
+	PermissionRead Permission = "read"
+	// PermissionCommand allows issuing set commands to the devices
+	PermissionCommand Permission = "command"
+	// PermissionWrite allows adding and updating the resources
+	PermissionWrite Permission = "write"
+	// PermissionDelete allows deleting the resources
+	PermissionDelete Permission = "delete"
+	// PermissionAudit allows querying the audit records and the usage of the API keys
+	PermissionAudit Permission = "audit"
+)
+
+// Info contains the role based access control configuration of a service
+type Info struct {
+	Enabled bool
+	// RoleClaim is the claim of the JWT holding the roles of the request, nested claims are separated by dots
+	RoleClaim string
+	// DefaultRoles are the roles of the requests without JWT or whose JWT doesn't contain the role claim, such as the
+	// requests of the other EdgeX services
+	DefaultRoles []string
+	// Roles maps each role to the permissions it grants
+	Roles map[string][]Permission
+}
+
+// envDisableJWTValidation disables the validation of the JWTs by the authentication hook of go-mod-bootstrap
+const envDisableJWTValidation = "EDGEX_DISABLE_JWT_VALIDATION"
+
+// PermissionTable maps the routes, keyed by RouteKey, to the permission they require when it differs from the
+// default permission of their method
+type PermissionTable map[string]Permission
+
+// RouteKey returns the key of the route with the method in a PermissionTable
+func RouteKey(method string, route string) string {
+	return method + " " + route
+}
+
+// Permission returns the permission the route with the method requires, GET and HEAD requests require the read
+// permission, DELETE requests the delete permission and the others the write permission, unless specified otherwise
+// by the table
+func (t PermissionTable) Permission(method string, route string) Permission {
+	if p, ok := t[RouteKey(method, route)]; ok {
+		return p
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return PermissionRead
+	case http.MethodDelete:
+		return PermissionDelete
+	default:
+		return PermissionWrite
+	}
+}
+
+// RequestPermission returns the permission the request requires, according to the template of its route
+func (t PermissionTable) RequestPermission(r *http.Request) Permission {
+	route := r.URL.Path
+	if current := mux.CurrentRoute(r); current != nil {
+		if template, err := current.GetPathTemplate(); err == nil {
+			route = template
+		}
+	}
+	return t.Permission(r.Method, route)
+}
+
+// AuthorizationHandlerFunc wraps the authentication hook of the routes so that, once authenticated, the requests are
+// only handled when one of their roles grants the permission of the route, and are rejected with 403 otherwise.
+// The authentication hook is returned as is when the role based access control isn't enabled, or when the JWTs aren't
+// validated so that their role claims can't be trusted.
+func AuthorizationHandlerFunc(info Info, table PermissionTable, authenticationHook func(inner http.HandlerFunc) http.HandlerFunc, lc logger.LoggingClient) func(inner http.HandlerFunc) http.HandlerFunc {
+	if !info.Enabled {
+		return authenticationHook
+	}
+	if !jwtValidated() {
+		lc.Warnf("Role based access control disabled: the JWTs aren't validated when the security is disabled or %s is set, so that the roles of their claims can't be trusted", envDisableJWTValidation)
+		return authenticationHook
+	}
+	return func(inner http.HandlerFunc) http.HandlerFunc {
+		return authenticationHook(func(w http.ResponseWriter, r *http.Request) {
+			permission := table.RequestPermission(r)
+			roles := info.requestRoles(r)
+			if !info.grants(roles, permission) {
+				lc.Warnf("Request to '%s %s' FORBIDDEN: none of the roles %v grants the %s permission", r.Method, r.URL.Path, roles, permission)
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+			inner(w, r)
+		})
+	}
+}
+
+// jwtValidated checks whether the JWTs are validated against the secret store by the authentication hook, which
+// handlers.AutoConfigAuthenticationFunc of go-mod-bootstrap only does in secure mode unless the validation is disabled
+func jwtValidated() bool {
+	// an invalid value doesn't disable the validation, as in go-mod-bootstrap
+	disabled, _ := strconv.ParseBool(os.Getenv(envDisableJWTValidation))
+	return secret.IsSecurityEnabled() && !disabled
+}
+
+// grants checks whether one of the roles grants the permission
+func (info Info) grants(roles []string, permission Permission) bool {
+	for _, role := range roles {
+		for _, p := range info.Roles[role] {
+			if p == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requestRoles returns the roles of the role claim of the request JWT, or the default roles
+func (info Info) requestRoles(r *http.Request) []string {
+	value, ok := requestClaim(r, info.RoleClaim)
+	if !ok {
+		return info.DefaultRoles
+	}
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		roles := make([]string, 0, len(v))
+		for _, role := range v {
+			if s, ok := role.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+		return roles
+	default:
+		return info.DefaultRoles
+	}
+}
+
+// ClaimHandlerFunc wraps the authentication hook of the routes so that, once authenticated, the requests are only
+// handled when the claim of their JWT equals the value of the path variable of the route, i.e. the tenant of the
+// caller equals the tenant of the route, and are rejected with 403 otherwise. As the claims of unvalidated JWTs can't
+// be trusted, all the requests are rejected when the JWTs aren't validated.
+func ClaimHandlerFunc(claim string, variable string, authenticationHook func(inner http.HandlerFunc) http.HandlerFunc, lc logger.LoggingClient) func(inner http.HandlerFunc) http.HandlerFunc {
+	validated := jwtValidated()
+	if !validated {
+		lc.Warnf("The requests restricted to the JWT claim %s are all rejected: the JWTs aren't validated when the security is disabled or %s is set", claim, envDisableJWTValidation)
+	}
+	return func(inner http.HandlerFunc) http.HandlerFunc {
+		return authenticationHook(func(w http.ResponseWriter, r *http.Request) {
+			expected := mux.Vars(r)[variable]
+			value, ok := requestClaim(r, claim)
+			if !validated || !ok || value != expected {
+				lc.Warnf("Request to '%s %s' FORBIDDEN: the JWT claim %s doesn't match the %s '%s'", r.Method, r.URL.Path, claim, variable, expected)
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+			inner(w, r)
+		})
+	}
+}
+
+// requestClaim returns the value of the claim of the request JWT, nested claims being separated by dots. The
+// signature of the JWT has been verified by the secret store through the authentication hook, the callers checking
+// that the JWTs are validated, so that its claims are read without verifying it again.
+func requestClaim(r *http.Request, claim string) (any, bool) {
+	authParts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(authParts) < 2 || !strings.EqualFold(authParts[0], "Bearer") || claim == "" {
+		return nil, false
+	}
+	token, err := jwt.ParseSigned(authParts[1])
+	if err != nil {
+		return nil, false
+	}
+	var claims map[string]any
+	if err = token.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return nil, false
+	}
+
+	var value any = claims
+	for _, name := range strings.Split(claim, ".") {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = nested[name]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
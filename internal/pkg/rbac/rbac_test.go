@@ -0,0 +1,216 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeviceRoute  = "/api/v3/device/name/{name}"
+	testCommandRoute = "/api/v3/device/name/{name}/{command}"
+)
+
+func testToken(t *testing.T, claims map[string]any) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef0123456789abcdef")}, nil)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthorizationHandlerFunc(t *testing.T) {
+	t.Setenv(secret.EnvSecretStore, "true")
+	t.Setenv(envDisableJWTValidation, "")
+	info := Info{
+		Enabled:      true,
+		RoleClaim:    "realm_access.roles",
+		DefaultRoles: []string{"admin"},
+		Roles: map[string][]Permission{
+			"reader":   {PermissionRead},
+			"operator": {PermissionRead, PermissionCommand},
+			"admin":    {PermissionRead, PermissionCommand, PermissionWrite, PermissionDelete},
+		},
+	}
+	table := PermissionTable{RouteKey(http.MethodPut, testCommandRoute): PermissionCommand}
+
+	authenticated := false
+	authenticationHook := func(inner http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			authenticated = true
+			inner(w, r)
+		}
+	}
+	hook := AuthorizationHandlerFunc(info, table, authenticationHook, logger.NewMockClient())
+	handler := func(w http.ResponseWriter, r *http.Request) {}
+	router := mux.NewRouter()
+	router.HandleFunc(testDeviceRoute, hook(handler)).Methods(http.MethodGet, http.MethodPatch, http.MethodDelete)
+	router.HandleFunc(testCommandRoute, hook(handler)).Methods(http.MethodGet, http.MethodPut)
+
+	roles := func(roles ...any) string {
+		return testToken(t, map[string]any{"realm_access": map[string]any{"roles": append([]any{}, roles...)}})
+	}
+	tests := []struct {
+		name               string
+		method             string
+		path               string
+		token              string
+		expectedStatusCode int
+	}{
+		{"Valid - reader reads", http.MethodGet, "/api/v3/device/name/sensor", roles("reader"), http.StatusOK},
+		{"Valid - operator issues a get command", http.MethodGet, "/api/v3/device/name/sensor/temperature", roles("operator"), http.StatusOK},
+		{"Valid - operator issues a set command", http.MethodPut, "/api/v3/device/name/sensor/temperature", roles("operator"), http.StatusOK},
+		{"Valid - admin deletes", http.MethodDelete, "/api/v3/device/name/sensor", roles("admin"), http.StatusOK},
+		{"Valid - one of the roles grants", http.MethodPatch, "/api/v3/device/name/sensor", roles("reader", "admin"), http.StatusOK},
+		{"Valid - default roles without token", http.MethodDelete, "/api/v3/device/name/sensor", "", http.StatusOK},
+		{"Valid - default roles without role claim", http.MethodDelete, "/api/v3/device/name/sensor", testToken(t, map[string]any{"sub": "core-command"}), http.StatusOK},
+		{"Invalid - reader issues a set command", http.MethodPut, "/api/v3/device/name/sensor/temperature", roles("reader"), http.StatusForbidden},
+		{"Invalid - operator updates", http.MethodPatch, "/api/v3/device/name/sensor", roles("operator"), http.StatusForbidden},
+		{"Invalid - operator deletes", http.MethodDelete, "/api/v3/device/name/sensor", roles("operator"), http.StatusForbidden},
+		{"Invalid - unknown role", http.MethodGet, "/api/v3/device/name/sensor", roles("guest"), http.StatusForbidden},
+		{"Invalid - no role", http.MethodGet, "/api/v3/device/name/sensor", roles(), http.StatusForbidden},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			authenticated = false
+			req, err := http.NewRequest(testCase.method, testCase.path, http.NoBody)
+			require.NoError(t, err)
+			if testCase.token != "" {
+				req.Header.Set("Authorization", "Bearer "+testCase.token)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.True(t, authenticated, "Request not authenticated before being authorized")
+		})
+	}
+}
+
+func TestAuthorizationHandlerFuncDisabled(t *testing.T) {
+	called := false
+	authenticationHook := func(inner http.HandlerFunc) http.HandlerFunc {
+		called = true
+		return inner
+	}
+	hook := AuthorizationHandlerFunc(Info{Enabled: false}, nil, authenticationHook, logger.NewMockClient())
+	hook(func(w http.ResponseWriter, r *http.Request) {})
+	assert.True(t, called, "Authentication hook not used as is")
+}
+
+func TestAuthorizationHandlerFuncJWTNotValidated(t *testing.T) {
+	tests := []struct {
+		name                 string
+		secretStore          string
+		disableJWTValidation string
+	}{
+		{"security disabled", "false", ""},
+		{"JWT validation disabled", "true", "true"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv(secret.EnvSecretStore, testCase.secretStore)
+			t.Setenv(envDisableJWTValidation, testCase.disableJWTValidation)
+			called := false
+			authenticationHook := func(inner http.HandlerFunc) http.HandlerFunc {
+				called = true
+				return inner
+			}
+			hook := AuthorizationHandlerFunc(Info{Enabled: true, RoleClaim: "roles"}, nil, authenticationHook, logger.NewMockClient())
+			hook(func(w http.ResponseWriter, r *http.Request) {})
+			assert.True(t, called, "Authentication hook not used as is")
+		})
+	}
+}
+
+func TestClaimHandlerFunc(t *testing.T) {
+	tenant := func(tenant any) string {
+		return testToken(t, map[string]any{"tenant": tenant})
+	}
+	tests := []struct {
+		name                 string
+		secretStore          string
+		disableJWTValidation string
+		token                string
+		expectedStatusCode   int
+	}{
+		{"Valid - caller of the tenant", "true", "", tenant("tenant1"), http.StatusOK},
+		{"Invalid - caller of a foreign tenant", "true", "", tenant("tenant2"), http.StatusForbidden},
+		{"Invalid - caller with a tenant prefix", "true", "", tenant("tenant"), http.StatusForbidden},
+		{"Invalid - tenant claim not a string", "true", "", tenant([]any{"tenant1"}), http.StatusForbidden},
+		{"Invalid - no tenant claim", "true", "", testToken(t, map[string]any{"sub": "core-command"}), http.StatusForbidden},
+		{"Invalid - no token", "true", "", "", http.StatusForbidden},
+		{"Invalid - security disabled", "false", "", tenant("tenant1"), http.StatusForbidden},
+		{"Invalid - JWT validation disabled", "true", "true", tenant("tenant1"), http.StatusForbidden},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv(secret.EnvSecretStore, testCase.secretStore)
+			t.Setenv(envDisableJWTValidation, testCase.disableJWTValidation)
+			authenticated := false
+			authenticationHook := func(inner http.HandlerFunc) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					authenticated = true
+					inner(w, r)
+				}
+			}
+			hook := ClaimHandlerFunc("tenant", "tenant", authenticationHook, logger.NewMockClient())
+			router := mux.NewRouter()
+			router.HandleFunc("/api/v3/tenant/{tenant}/event/all", hook(func(w http.ResponseWriter, r *http.Request) {})).Methods(http.MethodGet)
+
+			req, err := http.NewRequest(http.MethodGet, "/api/v3/tenant/tenant1/event/all", http.NoBody)
+			require.NoError(t, err)
+			if testCase.token != "" {
+				req.Header.Set("Authorization", "Bearer "+testCase.token)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.True(t, authenticated, "Request not authenticated before being authorized")
+		})
+	}
+}
+
+func TestPermission(t *testing.T) {
+	table := PermissionTable{RouteKey(http.MethodPost, "/api/v3/deviceprofile/validate"): PermissionRead}
+
+	tests := []struct {
+		method   string
+		route    string
+		expected Permission
+	}{
+		{http.MethodGet, "/api/v3/device/all", PermissionRead},
+		{http.MethodHead, "/api/v3/device/all", PermissionRead},
+		{http.MethodPost, "/api/v3/device", PermissionWrite},
+		{http.MethodPut, "/api/v3/device", PermissionWrite},
+		{http.MethodPatch, "/api/v3/device", PermissionWrite},
+		{http.MethodDelete, "/api/v3/device/name/{name}", PermissionDelete},
+		{http.MethodPost, "/api/v3/deviceprofile/validate", PermissionRead},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.method+" "+testCase.route, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, table.Permission(testCase.method, testCase.route))
+		})
+	}
+}
@@ -0,0 +1,244 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+)
+
+const (
+	// queuedBatches is the number of batches of spans queued to the exporter, the spans ended once the queue is full
+	// are dropped
+	queuedBatches = 4
+	exportTimeout = 10 * time.Second
+	// scopeName is the instrumentation scope of the spans
+	scopeName = "github.com/edgexfoundry/edgex-go"
+)
+
+// OTLP status codes of the spans
+const (
+	statusCodeUnset = 0
+	statusCodeError = 2
+)
+
+// exporter exports the ended spans to the collector in batches
+type exporter struct {
+	endpoint    string
+	serviceName string
+	batchSize   int
+	interval    time.Duration
+	client      *http.Client
+	lc          logger.LoggingClient
+	spans       chan *Span
+	dropped     atomic.Int64
+}
+
+func newExporter(endpoint string, serviceName string, batchSize int, interval time.Duration, lc logger.LoggingClient) *exporter {
+	// the requests to the collector aren't traced, as the default transport may be wrapped by the tracing Transport
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+	return &exporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		batchSize:   batchSize,
+		interval:    interval,
+		client:      &http.Client{Transport: transport, Timeout: exportTimeout},
+		lc:          lc,
+		spans:       make(chan *Span, queuedBatches*batchSize),
+	}
+}
+
+// enqueue queues the span to be exported, without blocking the instrumented operation
+func (e *exporter) enqueue(span *Span) {
+	select {
+	case e.spans <- span:
+	default:
+		e.dropped.Add(1)
+	}
+}
+
+// run exports the queued spans once a batch is full, or every interval, until ctx is done. The spans still queued
+// when ctx is done are exported before returning.
+func (e *exporter) run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		batch := make([]*Span, 0, e.batchSize)
+		flush := func() {
+			if dropped := e.dropped.Swap(0); dropped > 0 {
+				e.lc.Warnf("%d tracing spans were dropped, the export queue being full", dropped)
+			}
+			if len(batch) == 0 {
+				return
+			}
+			if err := e.export(batch); err != nil {
+				e.lc.Errorf("failed to export %d tracing spans to %s: %s", len(batch), e.endpoint, err.Error())
+			}
+			batch = batch[:0]
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				for {
+					select {
+					case span := <-e.spans:
+						batch = append(batch, span)
+						if len(batch) >= e.batchSize {
+							flush()
+						}
+					default:
+						flush()
+						return
+					}
+				}
+			case span := <-e.spans:
+				batch = append(batch, span)
+				if len(batch) >= e.batchSize {
+					flush()
+				}
+			case <-ticker.C:
+				flush()
+			}
+		}
+	}()
+}
+
+// export posts the spans to the collector as an OTLP ExportTraceServiceRequest in the JSON encoding
+func (e *exporter) export(spans []*Span) error {
+	body, err := json.Marshal(newExportRequest(e.serviceName, spans))
+	if err != nil {
+		return err
+	}
+	response, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("the collector responded %s", response.Status)
+	}
+	return nil
+}
+
+// The types below are the subset of the OTLP JSON encoding of the traces used by the exporter
+
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope      `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              SpanKind   `json:"kind"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	Status            status     `json:"status"`
+}
+
+type status struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+func newKeyValue(key string, value any) keyValue {
+	kv := keyValue{Key: key}
+	switch v := value.(type) {
+	case int:
+		i := strconv.Itoa(v)
+		kv.Value.IntValue = &i
+	case bool:
+		kv.Value.BoolValue = &v
+	default:
+		s := fmt.Sprint(v)
+		kv.Value.StringValue = &s
+	}
+	return kv
+}
+
+func newExportRequest(serviceName string, spans []*Span) exportRequest {
+	encoded := make([]otlpSpan, len(spans))
+	for i, span := range spans {
+		encoded[i] = span.encode()
+	}
+	return exportRequest{ResourceSpans: []resourceSpans{{
+		Resource:   resource{Attributes: []keyValue{newKeyValue("service.name", serviceName)}},
+		ScopeSpans: []scopeSpans{{Scope: scope{Name: scopeName}, Spans: encoded}},
+	}}}
+}
+
+func (s *Span) encode() otlpSpan {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	encoded := otlpSpan{
+		TraceID:           s.context.TraceID.String(),
+		SpanID:            s.context.SpanID.String(),
+		Name:              s.name,
+		Kind:              s.kind,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+		Status:            status{Code: statusCodeUnset},
+	}
+	if s.parentID != (SpanID{}) {
+		encoded.ParentSpanID = s.parentID.String()
+	}
+	for _, a := range s.attributes {
+		encoded.Attributes = append(encoded.Attributes, newKeyValue(a.key, a.value))
+	}
+	if s.errorMessage != "" {
+		encoded.Status = status{Code: statusCodeError, Message: s.errorMessage}
+	}
+	return encoded
+}
@@ -0,0 +1,143 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// statusRecorder records the status code of the response
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (s *statusRecorder) WriteHeader(statusCode int) {
+	if s.statusCode == 0 {
+		s.statusCode = statusCode
+	}
+	s.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.statusCode == 0 {
+		s.statusCode = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+// Flush supports the streaming responses
+func (s *statusRecorder) Flush() {
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack supports the WebSocket connections
+func (s *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := s.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T doesn't support hijacking", s.ResponseWriter)
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err == nil && s.statusCode == 0 {
+		s.statusCode = http.StatusSwitchingProtocols
+	}
+	return conn, rw, err
+}
+
+// Unwrap returns the original ResponseWriter for http.ResponseController
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// Middleware records the server span of the requests, child of the span of the traceparent header when it is in the
+// trace of the correlation ID, so it must be used after correlation.ManageHeader. The requests are passed through when
+// the tracer is nil.
+func Middleware(tracer *Tracer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if tracer == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := r.URL.Path
+			if current := mux.CurrentRoute(r); current != nil {
+				if template, err := current.GetPathTemplate(); err == nil {
+					route = template
+				}
+			}
+
+			var remote *SpanContext
+			if sc, ok := ParseTraceParent(r.Header.Get(TraceParentHeader)); ok {
+				remote = &sc
+			}
+			ctx, span := tracer.start(r.Context(), r.Method+" "+route, SpanKindServer, correlation.FromContext(r.Context()), remote)
+			defer span.End()
+			span.SetAttribute("http.method", r.Method)
+			span.SetAttribute("http.route", route)
+			span.SetAttribute("http.target", r.URL.Path)
+
+			recorder := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(recorder, r.WithContext(ctx))
+
+			statusCode := recorder.statusCode
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+			span.SetAttribute("http.status_code", statusCode)
+			span.SetError(errorStatus(statusCode, http.StatusInternalServerError))
+		})
+	}
+}
+
+// Transport records the client span of the requests sent with the Base RoundTripper and propagates it with the
+// traceparent header. The service clients of go-mod-core-contracts don't pass the context of the request, so the
+// span of a request without context is the child of the active span of the trace of its correlation ID header.
+type Transport struct {
+	Base   http.RoundTripper
+	tracer *Tracer
+}
+
+// Transport returns the Transport of the tracer sending the requests with the base RoundTripper
+func (t *Tracer) Transport(base http.RoundTripper) *Transport {
+	return &Transport{Base: base, tracer: t}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+	correlationID := r.Header.Get(common.CorrelationHeader)
+	if SpanFromContext(ctx) == nil {
+		if traceID, ok := traceIDFromCorrelationID(correlationID); ok {
+			ctx = ContextWithSpan(ctx, t.tracer.activeSpan(traceID))
+		}
+	}
+	ctx, span := t.tracer.start(ctx, r.Method, SpanKindClient, correlationID, nil)
+	defer span.End()
+	span.SetAttribute("http.method", r.Method)
+	span.SetAttribute("http.url", r.URL.Redacted())
+	span.SetAttribute("net.peer.name", r.URL.Hostname())
+
+	// the RoundTripper must not modify the request
+	traced := r.Clone(ctx)
+	traced.Header.Set(TraceParentHeader, span.Context().TraceParent())
+	response, err := t.Base.RoundTrip(traced)
+	if err != nil {
+		span.SetError(err)
+		return response, err
+	}
+	span.SetAttribute("http.status_code", response.StatusCode)
+	span.SetError(errorStatus(response.StatusCode, http.StatusBadRequest))
+	return response, nil
+}
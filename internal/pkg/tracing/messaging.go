@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+// StartMessageSpan starts the producer or consumer span of a message sent or received on the topic of a message
+// broker, i.e. mqtt. The span is the child of the span of the context or else in the trace of the correlation ID of the
+// envelope, which the returned context carries so that the spans started from it are in the same trace. The consumer
+// span is the child of the span of the traceparent query parameter of the envelope when it is in that trace.
+func (t *Tracer) StartMessageSpan(ctx context.Context, kind SpanKind, system string, topic string, envelope types.MessageEnvelope) (context.Context, *Span) {
+	if correlation.FromContext(ctx) == "" && envelope.CorrelationID != "" {
+		// lint:ignore SA1029 legacy
+		// nolint:staticcheck // See golangci-lint #741
+		ctx = context.WithValue(ctx, common.CorrelationHeader, envelope.CorrelationID)
+	}
+	if t == nil {
+		return ctx, nil
+	}
+
+	operation := "publish"
+	if kind == SpanKindConsumer {
+		operation = "receive"
+	}
+	var remote *SpanContext
+	if kind == SpanKindConsumer {
+		if sc, ok := ParseTraceParent(envelope.QueryParams[TraceParentHeader]); ok {
+			remote = &sc
+		}
+	}
+	ctx, span := t.start(ctx, topic+" "+operation, kind, correlation.FromContext(ctx), remote)
+	span.SetAttribute("messaging.system", system)
+	span.SetAttribute("messaging.operation", operation)
+	span.SetAttribute("messaging.destination.name", topic)
+	if envelope.RequestID != "" {
+		span.SetAttribute("messaging.message.id", envelope.RequestID)
+	}
+	if envelope.CorrelationID != "" {
+		span.SetAttribute("messaging.message.conversation_id", envelope.CorrelationID)
+	}
+	return ctx, span
+}
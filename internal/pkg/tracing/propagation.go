@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// TraceParentHeader is the header of the W3C trace context propagated with the REST requests, and the query parameter
+// of the MessageEnvelopes propagating it with the messages
+const TraceParentHeader = "traceparent"
+
+// TraceID identifies a trace
+type TraceID [16]byte
+
+// String returns the lowercase hex encoding of the trace ID
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// SpanID identifies a span of a trace
+type SpanID [8]byte
+
+// String returns the lowercase hex encoding of the span ID
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// SpanContext is the context of a span propagated to its children, including those of the other services
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// TraceParent returns the value of the traceparent header propagating the span context, i.e.
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceParent parses the value of a traceparent header, the future versions being parsed as version 00
+func ParseTraceParent(value string) (SpanContext, bool) {
+	var sc SpanContext
+	fields := strings.Split(strings.TrimSpace(value), "-")
+	if len(fields) < 4 || len(fields[0]) != 2 || fields[0] == "ff" || (fields[0] == "00" && len(fields) != 4) {
+		return sc, false
+	}
+	var version, flags [1]byte
+	if !decodeHex(version[:], fields[0]) || !decodeHex(sc.TraceID[:], fields[1]) ||
+		!decodeHex(sc.SpanID[:], fields[2]) || !decodeHex(flags[:], fields[3]) {
+		return sc, false
+	}
+	if sc.TraceID == (TraceID{}) || sc.SpanID == (SpanID{}) {
+		return sc, false
+	}
+	sc.Sampled = flags[0]&1 == 1
+	return sc, true
+}
+
+// decodeHex decodes the lowercase hex value, which must fill the destination
+func decodeHex(dst []byte, value string) bool {
+	if len(value) != 2*len(dst) || strings.ToLower(value) != value {
+		return false
+	}
+	_, err := hex.Decode(dst, []byte(value))
+	return err == nil
+}
+
+// traceIDFromCorrelationID returns the trace ID of the correlation ID, which is the UUID itself or else derived from
+// the hash of the correlation ID. ok is false when the correlation ID is empty.
+func traceIDFromCorrelationID(correlationID string) (traceID TraceID, ok bool) {
+	if correlationID == "" {
+		return traceID, false
+	}
+	if id, err := uuid.Parse(correlationID); err == nil && id != uuid.Nil {
+		return TraceID(id), true
+	}
+	hash := sha256.Sum256([]byte(correlationID))
+	copy(traceID[:], hash[:])
+	return traceID, true
+}
+
+func randomTraceID() (id TraceID) {
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func randomSpanID() (id SpanID) {
+	for id == (SpanID{}) {
+		_, _ = rand.Read(id[:])
+	}
+	return id
+}
@@ -0,0 +1,334 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing records the distributed tracing spans of the core services, i.e. the REST requests served and sent,
+// the messages published and received on the message buses and the database operations, and exports them to an
+// OpenTelemetry collector with OTLP/HTTP in the JSON encoding.
+//
+// The trace of a span is the trace of the correlation ID of the request, a UUID correlation ID being the trace ID
+// itself, so that the spans of a command are in the same trace across the REST API, which propagates the W3C trace
+// context, and the message buses, whose envelopes only propagate the correlation ID.
+package tracing
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+const (
+	defaultBatchSize      = 512
+	defaultExportInterval = 5 * time.Second
+)
+
+// Info configures the tracing of a service
+type Info struct {
+	Enabled bool
+	// Endpoint is the OTLP/HTTP traces endpoint of the collector, i.e. http://localhost:4318/v1/traces
+	Endpoint string
+	// SampleRatio is the fraction of the traces recorded, from 0 to 1. The traces are sampled from their trace ID, so
+	// that all the services record the same traces.
+	SampleRatio float64
+	// BatchSize is the maximum number of spans exported in a request to the collector
+	BatchSize int
+	// ExportInterval is the maximum duration the spans are buffered before being exported, i.e. 5s
+	ExportInterval string
+}
+
+// SpanKind is the role of a span in its trace, as defined by OpenTelemetry
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = iota + 1
+	SpanKindServer
+	SpanKindClient
+	SpanKindProducer
+	SpanKindConsumer
+)
+
+// Tracer starts the spans of a service and exports them once ended
+type Tracer struct {
+	lc logger.LoggingClient
+	// sampleBound is the upper bound of the trace IDs sampled, see sampled
+	sampleBound uint64
+	exporter    *exporter
+	// active is the innermost span in progress of each trace, which parents the spans started without the context of
+	// their parent, i.e. the requests of the service clients
+	active map[TraceID]*Span
+	mutex  sync.Mutex
+}
+
+// TracerName contains the name of the Tracer in the DIC.
+var TracerName = di.TypeInstanceToName(Tracer{})
+
+// TracerFrom helper function queries the DIC and returns the Tracer, nil when the tracing isn't enabled.
+func TracerFrom(get di.Get) *Tracer {
+	tracer, ok := get(TracerName).(*Tracer)
+	if !ok {
+		return nil
+	}
+	return tracer
+}
+
+// NewTracer creates the Tracer of the service, nil is returned when the tracing isn't enabled. The spans are only
+// exported once the exporter is started by BootstrapTracer.
+func NewTracer(info Info, serviceName string, lc logger.LoggingClient) (*Tracer, error) {
+	if !info.Enabled {
+		return nil, nil
+	}
+	endpoint, err := url.Parse(info.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, fmt.Errorf("the tracing Endpoint '%s' isn't a http or https URL", info.Endpoint)
+	}
+	if info.SampleRatio < 0 || info.SampleRatio > 1 {
+		return nil, fmt.Errorf("the tracing SampleRatio %v is out of the range [0, 1]", info.SampleRatio)
+	}
+	batchSize := info.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	interval := defaultExportInterval
+	if info.ExportInterval != "" {
+		if interval, err = time.ParseDuration(info.ExportInterval); err != nil || interval <= 0 {
+			return nil, fmt.Errorf("the tracing ExportInterval '%s' isn't a positive duration", info.ExportInterval)
+		}
+	}
+
+	return &Tracer{
+		lc:          lc,
+		sampleBound: uint64(info.SampleRatio * math.MaxInt64),
+		exporter:    newExporter(info.Endpoint, serviceName, batchSize, interval, lc),
+		active:      make(map[TraceID]*Span),
+	}, nil
+}
+
+// StartSpan starts a span, child of the span of the context or else in the trace of the correlation ID of the
+// context. The span must be ended, and the returned context carries the span to parent the spans started from it.
+func (t *Tracer) StartSpan(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+	return t.start(ctx, name, kind, correlation.FromContext(ctx), nil)
+}
+
+// StartDatabaseSpan starts the client span of a database operation, i.e. the AddEvent operation of redisdb
+func (t *Tracer) StartDatabaseSpan(ctx context.Context, system string, operation string) (context.Context, *Span) {
+	ctx, span := t.StartSpan(ctx, system+" "+operation, SpanKindClient)
+	span.SetAttribute("db.system", system)
+	span.SetAttribute("db.operation", operation)
+	return ctx, span
+}
+
+// start starts a span, child of the span of the context or else of the remote parent, which is only in the same trace
+// when it is in the trace of the correlation ID.
+func (t *Tracer) start(ctx context.Context, name string, kind SpanKind, correlationID string, remote *SpanContext) (context.Context, *Span) {
+	span := &Span{
+		tracer: t,
+		name:   name,
+		kind:   kind,
+		start:  time.Now(),
+	}
+
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.context.TraceID = parent.context.TraceID
+		span.context.Sampled = parent.context.Sampled
+		span.parentID = parent.context.SpanID
+		span.parent = parent
+	} else {
+		traceID, ok := traceIDFromCorrelationID(correlationID)
+		switch {
+		case remote != nil && (!ok || remote.TraceID == traceID):
+			span.context.TraceID = remote.TraceID
+			span.context.Sampled = remote.Sampled
+			span.parentID = remote.SpanID
+		case ok:
+			span.context.TraceID = traceID
+			span.context.Sampled = t.sampled(traceID)
+		default:
+			span.context.TraceID = randomTraceID()
+			span.context.Sampled = t.sampled(span.context.TraceID)
+		}
+	}
+	span.context.SpanID = randomSpanID()
+
+	if span.context.Sampled {
+		t.mutex.Lock()
+		t.active[span.context.TraceID] = span
+		t.mutex.Unlock()
+	}
+	return ContextWithSpan(ctx, span), span
+}
+
+// sampled returns whether the trace is recorded, deciding from the trace ID like the TraceIDRatioBased sampler of
+// OpenTelemetry so that the services sample the same traces without propagating the decision
+func (t *Tracer) sampled(traceID TraceID) bool {
+	return binary.BigEndian.Uint64(traceID[8:])>>1 < t.sampleBound
+}
+
+// activeSpan returns the innermost span in progress of the trace, nil if none
+func (t *Tracer) activeSpan(traceID TraceID) *Span {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.active[traceID]
+}
+
+// ended exports the span and replaces it by its parent as the active span of the trace
+func (t *Tracer) ended(span *Span) {
+	t.mutex.Lock()
+	if t.active[span.context.TraceID] == span {
+		if span.parent != nil && !span.parent.isEnded() {
+			t.active[span.context.TraceID] = span.parent
+		} else {
+			delete(t.active, span.context.TraceID)
+		}
+	}
+	t.mutex.Unlock()
+	t.exporter.enqueue(span)
+}
+
+// attribute is an attribute of a span, the value being a string, an int or a bool
+type attribute struct {
+	key   string
+	value any
+}
+
+// Span is an operation of a trace. The methods of a nil Span do nothing, so that the operations are instrumented
+// the same whether the tracing is enabled or not.
+type Span struct {
+	tracer   *Tracer
+	context  SpanContext
+	parentID SpanID
+	// parent is the parent span of the service, nil when the parent is remote
+	parent *Span
+	name   string
+	kind   SpanKind
+	start  time.Time
+
+	mutex        sync.Mutex
+	end          time.Time
+	attributes   []attribute
+	errorMessage string
+}
+
+// Context returns the SpanContext propagated to the children of the span
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.context
+}
+
+// SetAttribute sets an attribute of the span, the value being a string, an int or a bool
+func (s *Span) SetAttribute(key string, value any) {
+	if s == nil || !s.context.Sampled {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i := range s.attributes {
+		if s.attributes[i].key == key {
+			s.attributes[i].value = value
+			return
+		}
+	}
+	s.attributes = append(s.attributes, attribute{key: key, value: value})
+}
+
+// SetError marks the span as failed with the error, a nil error is ignored
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.errorMessage = err.Error()
+}
+
+// End ends the span, which is exported when sampled. The span is only ended once.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	if !s.end.IsZero() {
+		s.mutex.Unlock()
+		return
+	}
+	s.end = time.Now()
+	s.mutex.Unlock()
+
+	if s.context.Sampled {
+		s.tracer.ended(s)
+	}
+}
+
+func (s *Span) isEnded() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return !s.end.IsZero()
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpan returns a copy of the context carrying the span
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext returns the span carried by the context, nil if none
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanContextKey{}).(*Span)
+	return span
+}
+
+// BootstrapTracer creates the Tracer of the service, adds it to the DIC and starts exporting the spans until ctx is
+// done. The default transport of the service clients is wrapped by the Transport of the Tracer so that their
+// requests are traced.
+func BootstrapTracer(ctx context.Context, wg *sync.WaitGroup, info Info, serviceName string, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	tracer, err := NewTracer(info, serviceName, lc)
+	if err != nil {
+		lc.Errorf("failed to create the tracer: %s", err.Error())
+		return false
+	}
+	if tracer == nil {
+		return true
+	}
+	tracer.exporter.run(ctx, wg)
+	if _, traced := http.DefaultTransport.(*Transport); !traced {
+		http.DefaultTransport = tracer.Transport(http.DefaultTransport)
+	}
+	lc.Infof("Tracing enabled, exporting the spans to %s", info.Endpoint)
+
+	dic.Update(di.ServiceConstructorMap{
+		TracerName: func(get di.Get) interface{} {
+			return tracer
+		},
+	})
+	return true
+}
+
+// errorStatus returns the error of a response status code which is a failure
+func errorStatus(statusCode int, minFailure int) error {
+	if statusCode < minFailure {
+		return nil
+	}
+	return errors.New(http.StatusText(statusCode))
+}
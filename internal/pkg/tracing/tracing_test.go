@@ -0,0 +1,236 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+)
+
+const (
+	testService       = "core-command"
+	testCorrelationID = "4bf92f35-77b3-4da6-a3ce-929d0e0e4736"
+	testTraceID       = "4bf92f3577b34da6a3ce929d0e0e4736"
+)
+
+// newTestTracer returns a Tracer exporting the spans to a test collector, and the function stopping the exporter and
+// returning the spans exported
+func newTestTracer(t *testing.T, sampleRatio float64) (*Tracer, func() []otlpSpan) {
+	var mutex sync.Mutex
+	var spans []otlpSpan
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request exportRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		mutex.Lock()
+		defer mutex.Unlock()
+		for _, rs := range request.ResourceSpans {
+			assert.Equal(t, testService, *rs.Resource.Attributes[0].Value.StringValue)
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}))
+	t.Cleanup(collector.Close)
+
+	info := Info{Enabled: true, Endpoint: collector.URL, SampleRatio: sampleRatio, BatchSize: 2}
+	tracer, err := NewTracer(info, testService, logger.NewMockClient())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	tracer.exporter.run(ctx, wg)
+
+	return tracer, func() []otlpSpan {
+		cancel()
+		wg.Wait()
+		mutex.Lock()
+		defer mutex.Unlock()
+		return spans
+	}
+}
+
+func spanNamed(t *testing.T, spans []otlpSpan, name string) otlpSpan {
+	for _, span := range spans {
+		if span.Name == name {
+			return span
+		}
+	}
+	require.Failf(t, "span not exported", "no span named %s in %v", name, spans)
+	return otlpSpan{}
+}
+
+func TestStartSpan(t *testing.T) {
+	tracer, exported := newTestTracer(t, 1)
+
+	// lint:ignore SA1029 legacy
+	// nolint:staticcheck // See golangci-lint #741
+	ctx := context.WithValue(context.Background(), common.CorrelationHeader, testCorrelationID)
+	ctx, parent := tracer.StartSpan(ctx, "parent", SpanKindInternal)
+	_, child := tracer.StartDatabaseSpan(ctx, "redisdb", "AddEvent")
+	child.SetError(errors.New("connection refused"))
+	child.End()
+	parent.End()
+	parent.End()
+
+	spans := exported()
+	require.Len(t, spans, 2)
+	parentSpan := spanNamed(t, spans, "parent")
+	childSpan := spanNamed(t, spans, "redisdb AddEvent")
+	assert.Equal(t, testTraceID, parentSpan.TraceID)
+	assert.Empty(t, parentSpan.ParentSpanID)
+	assert.Equal(t, testTraceID, childSpan.TraceID)
+	assert.Equal(t, parentSpan.SpanID, childSpan.ParentSpanID)
+	assert.Equal(t, SpanKindClient, childSpan.Kind)
+	assert.Equal(t, status{Code: statusCodeError, Message: "connection refused"}, childSpan.Status)
+	assert.Equal(t, "db.system", childSpan.Attributes[0].Key)
+	assert.Equal(t, "redisdb", *childSpan.Attributes[0].Value.StringValue)
+	assert.Empty(t, tracer.active)
+}
+
+func TestStartSpanNotSampled(t *testing.T) {
+	tracer, exported := newTestTracer(t, 0)
+
+	ctx, span := tracer.StartSpan(context.Background(), "not sampled", SpanKindInternal)
+	_, child := tracer.StartSpan(ctx, "child", SpanKindInternal)
+	assert.False(t, span.Context().Sampled)
+	assert.Equal(t, span.Context().TraceID, child.Context().TraceID)
+	child.End()
+	span.End()
+
+	assert.Empty(t, exported())
+}
+
+func TestDisabled(t *testing.T) {
+	tracer, err := NewTracer(Info{Enabled: false}, testService, logger.NewMockClient())
+	require.NoError(t, err)
+	require.Nil(t, tracer)
+
+	ctx, span := tracer.StartSpan(context.Background(), "disabled", SpanKindInternal)
+	assert.Nil(t, span)
+	assert.Nil(t, SpanFromContext(ctx))
+	span.SetAttribute("key", "value")
+	span.SetError(errors.New("failure"))
+	span.End()
+
+	envelope := types.MessageEnvelope{CorrelationID: testCorrelationID}
+	ctx, span = tracer.StartMessageSpan(context.Background(), SpanKindConsumer, "mqtt", "edgex/command/request", envelope)
+	assert.Nil(t, span)
+	assert.Equal(t, testCorrelationID, correlation.FromContext(ctx))
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	assert.NotNil(t, Middleware(nil)(next))
+}
+
+func TestNewTracerInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		info Info
+	}{
+		{"invalid endpoint", Info{Enabled: true, Endpoint: "localhost:4318"}},
+		{"invalid sample ratio", Info{Enabled: true, Endpoint: "http://localhost:4318/v1/traces", SampleRatio: 1.5}},
+		{"invalid export interval", Info{Enabled: true, Endpoint: "http://localhost:4318/v1/traces", ExportInterval: "5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTracer(tt.info, testService, logger.NewMockClient())
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestTrace traces a command received from the message bus which reads the device with a service client, whose
+// request is served by another service
+func TestTrace(t *testing.T) {
+	tracer, exported := newTestTracer(t, 1)
+
+	var traceParent string
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v3/device/name/{name}", func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get(TraceParentHeader)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	router.Use(correlation.ManageHeader)
+	router.Use(Middleware(tracer))
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	envelope := types.MessageEnvelope{CorrelationID: testCorrelationID, RequestID: "request-1"}
+	_, consumer := tracer.StartMessageSpan(context.Background(), SpanKindConsumer, "mqtt", "edgex/command/request", envelope)
+
+	// like the service clients, the request has the correlation ID header but not the context of the consumer span
+	request, err := http.NewRequest(http.MethodGet, server.URL+"/api/v3/device/name/sensor", http.NoBody)
+	require.NoError(t, err)
+	request.Header.Set(common.CorrelationHeader, testCorrelationID)
+	client := &http.Client{Transport: tracer.Transport(http.DefaultTransport)}
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	assert.Empty(t, request.Header.Get(TraceParentHeader), "the request must not be modified")
+	consumer.End()
+
+	spans := exported()
+	require.Len(t, spans, 3)
+	consumerSpan := spanNamed(t, spans, "edgex/command/request receive")
+	clientSpan := spanNamed(t, spans, http.MethodGet)
+	serverSpan := spanNamed(t, spans, "GET /api/v3/device/name/{name}")
+	for _, span := range spans {
+		assert.Equal(t, testTraceID, span.TraceID)
+	}
+	assert.Equal(t, SpanKindConsumer, consumerSpan.Kind)
+	assert.Equal(t, consumerSpan.SpanID, clientSpan.ParentSpanID)
+	assert.Equal(t, SpanKindClient, clientSpan.Kind)
+	assert.Equal(t, statusCodeError, clientSpan.Status.Code)
+	assert.Equal(t, "00-"+testTraceID+"-"+clientSpan.SpanID+"-01", traceParent)
+	assert.Equal(t, clientSpan.SpanID, serverSpan.ParentSpanID)
+	assert.Equal(t, SpanKindServer, serverSpan.Kind)
+	assert.Equal(t, statusCodeUnset, serverSpan.Status.Code)
+}
+
+func TestStartMessageSpanTraceParent(t *testing.T) {
+	remoteSpanID := "00f067aa0ba902b7"
+	tests := []struct {
+		name                 string
+		traceParent          string
+		expectedParentSpanID string
+	}{
+		{"traceparent of the trace of the correlation ID", "00-" + testTraceID + "-" + remoteSpanID + "-01", remoteSpanID},
+		{"traceparent of another trace", "00-0af7651916cd43dd8448eb211c80319c-" + remoteSpanID + "-01", ""},
+		{"invalid traceparent", "invalid", ""},
+		{"no traceparent", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracer, exported := newTestTracer(t, 1)
+			envelope := types.MessageEnvelope{
+				CorrelationID: testCorrelationID,
+				RequestID:     "request-1",
+				QueryParams:   map[string]string{TraceParentHeader: tt.traceParent},
+			}
+
+			_, consumer := tracer.StartMessageSpan(context.Background(), SpanKindConsumer, "mqtt", "edgex/command/request", envelope)
+			consumer.End()
+
+			spans := exported()
+			require.Len(t, spans, 1)
+			assert.Equal(t, testTraceID, spans[0].TraceID)
+			assert.Equal(t, tt.expectedParentSpanID, spans[0].ParentSpanID)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// CompressPayload compresses the payload of the envelope with the encoding, and sets the ContentEncoding query
+// parameter of the envelope so that the receiver can decompress it. Payloads smaller than minSize bytes, or which
+// don't shrink, are left uncompressed.
+func CompressPayload(envelope *types.MessageEnvelope, encoding string, minSize int) errors.EdgeX {
+	if encoding == "" || len(envelope.Payload) < minSize {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch encoding {
+	case common.ContentEncodingGzip:
+		writer = gzip.NewWriter(&buf)
+	case common.ContentEncodingDeflate:
+		// the error is only returned for an invalid level
+		writer, _ = flate.NewWriter(&buf, flate.DefaultCompression)
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported content encoding '%s'", encoding), nil)
+	}
+	if _, err := writer.Write(envelope.Payload); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to compress the payload", err)
+	}
+	if err := writer.Close(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to compress the payload", err)
+	}
+	if buf.Len() >= len(envelope.Payload) {
+		return nil
+	}
+
+	envelope.Payload = buf.Bytes()
+	if envelope.QueryParams == nil {
+		envelope.QueryParams = make(map[string]string)
+	}
+	envelope.QueryParams[common.ContentEncoding] = encoding
+	return nil
+}
+
+// DecompressPayload decompresses the payload of the envelope according to its ContentEncoding query parameter, and
+// removes the parameter. The decompressed payload can't exceed sizeLimit bytes, 0 means no limit.
+func DecompressPayload(envelope *types.MessageEnvelope, sizeLimit int64) errors.EdgeX {
+	encoding := envelope.QueryParams[common.ContentEncoding]
+	if encoding == "" {
+		return nil
+	}
+
+	var reader io.ReadCloser
+	switch encoding {
+	case common.ContentEncodingGzip:
+		gzipReader, err := gzip.NewReader(bytes.NewReader(envelope.Payload))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decompress the gzip payload", err)
+		}
+		reader = gzipReader
+	case common.ContentEncodingDeflate:
+		reader = flate.NewReader(bytes.NewReader(envelope.Payload))
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported content encoding '%s'", encoding), nil)
+	}
+	defer func() { _ = reader.Close() }()
+
+	// read one byte past the limit to detect the payloads exceeding it without decompressing them entirely
+	var limited io.Reader = reader
+	if sizeLimit > 0 {
+		limited = io.LimitReader(reader, sizeLimit+1)
+	}
+	payload, err := io.ReadAll(limited)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decompress the %s payload", encoding), err)
+	}
+	if edgexErr := CheckPayloadSize(payload, sizeLimit); edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	envelope.Payload = payload
+	delete(envelope.QueryParams, common.ContentEncoding)
+	return nil
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func TestCompressPayload(t *testing.T) {
+	payload := bytes.Repeat([]byte(`{"deviceName":"TestDevice","value":"1.5e+01"}`), 100)
+	tests := []struct {
+		name             string
+		encoding         string
+		minSize          int
+		expectCompressed bool
+		errorExpected    bool
+	}{
+		{"gzip", common.ContentEncodingGzip, 0, true, false},
+		{"deflate", common.ContentEncodingDeflate, 0, true, false},
+		{"disabled", "", 0, false, false},
+		{"smaller than min size", common.ContentEncodingGzip, len(payload) + 1, false, false},
+		{"unsupported encoding", "br", 0, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			envelope := types.NewMessageEnvelope(payload, context.Background())
+
+			err := CompressPayload(&envelope, testCase.encoding, testCase.minSize)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, payload, envelope.Payload)
+				return
+			}
+			require.NoError(t, err)
+			if !testCase.expectCompressed {
+				assert.Equal(t, payload, envelope.Payload)
+				assert.NotContains(t, envelope.QueryParams, common.ContentEncoding)
+				return
+			}
+			assert.Less(t, len(envelope.Payload), len(payload))
+			assert.Equal(t, testCase.encoding, envelope.QueryParams[common.ContentEncoding])
+
+			err = DecompressPayload(&envelope, int64(len(payload)))
+			require.NoError(t, err)
+			assert.Equal(t, payload, envelope.Payload)
+			assert.NotContains(t, envelope.QueryParams, common.ContentEncoding)
+		})
+	}
+}
+
+func TestDecompressPayload(t *testing.T) {
+	payload := bytes.Repeat([]byte("a"), 1024)
+	compressed := types.NewMessageEnvelope(payload, context.Background())
+	require.NoError(t, CompressPayload(&compressed, common.ContentEncodingGzip, 0))
+
+	tests := []struct {
+		name            string
+		encoding        string
+		payload         []byte
+		sizeLimit       int64
+		expectedErrKind errors.ErrKind
+	}{
+		{"uncompressed", "", payload, 0, ""},
+		{"no limit", common.ContentEncodingGzip, compressed.Payload, 0, ""},
+		{"exceeding limit", common.ContentEncodingGzip, compressed.Payload, int64(len(payload) - 1), errors.KindLimitExceeded},
+		{"corrupted payload", common.ContentEncodingGzip, payload, 0, errors.KindContractInvalid},
+		{"unsupported encoding", "br", compressed.Payload, 0, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			envelope := types.NewMessageEnvelope(testCase.payload, context.Background())
+			if testCase.encoding != "" {
+				envelope.QueryParams[common.ContentEncoding] = testCase.encoding
+			}
+
+			err := DecompressPayload(&envelope, testCase.sizeLimit)
+			if testCase.expectedErrKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, payload, envelope.Payload)
+		})
+	}
+}
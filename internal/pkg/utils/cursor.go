@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// Cursor is the position of the last object of a page of a collection sorted by descending score, such as the events
+// sorted by origin, the next page starting right after it. Unlike the offset, the cursor neither skips over the
+// objects of the previous pages nor shifts when objects are added to the collection.
+type Cursor struct {
+	Score int64  `json:"score"`
+	Id    string `json:"id"`
+}
+
+// Token returns the opaque token of the cursor exchanged with the clients
+func (c Cursor) Token() string {
+	encoded, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// ParseCursorQueryString parses the cursor query parameter, ok is false when the request doesn't have a cursor
+func ParseCursorQueryString(r *http.Request) (cursor Cursor, ok bool, edgexErr errors.EdgeX) {
+	token := r.URL.Query().Get(pkgCommon.Cursor)
+	if len(token) == 0 {
+		return cursor, false, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(decoded, &cursor)
+	}
+	if err == nil && len(cursor.Id) == 0 {
+		err = fmt.Errorf("the id is empty")
+	}
+	if err != nil {
+		return cursor, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid '%s' query parameter", pkgCommon.Cursor), err)
+	}
+	return cursor, true, nil
+}
+
+// WriteNextCursorHeader sets the cursor of the next page when the page returned with the limit is full, it must be
+// called before the http header is written
+func WriteNextCursorHeader(w http.ResponseWriter, count int, limit int, last Cursor) {
+	if count == 0 || limit < 0 || count < limit {
+		return
+	}
+	w.Header().Set(pkgCommon.NextCursorHeader, last.Token())
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func TestParseCursorQueryString(t *testing.T) {
+	cursor := Cursor{Score: 1616728256236000000, Id: "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"}
+
+	tests := []struct {
+		name           string
+		token          string
+		expectedCursor Cursor
+		expectedOk     bool
+		errorExpected  bool
+	}{
+		{"valid", cursor.Token(), cursor, true, false},
+		{"no cursor", "", Cursor{}, false, false},
+		{"invalid encoding", "not a token", Cursor{}, false, true},
+		{"invalid content", "bm90IGpzb24", Cursor{}, false, true},
+		{"empty id", Cursor{Score: 1}.Token(), Cursor{}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/?"+url.Values{pkgCommon.Cursor: {tt.token}}.Encode(), http.NoBody)
+			cursor, ok, err := ParseCursorQueryString(req)
+			if tt.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedCursor, cursor)
+		})
+	}
+}
+
+func TestWriteNextCursorHeader(t *testing.T) {
+	last := Cursor{Score: 1, Id: "id"}
+
+	tests := []struct {
+		name           string
+		count          int
+		limit          int
+		expectedHeader string
+	}{
+		{"full page", 20, 20, last.Token()},
+		{"last page", 5, 20, ""},
+		{"empty page", 0, 20, ""},
+		{"all remaining", 5, -1, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			WriteNextCursorHeader(recorder, tt.count, tt.limit, last)
+			assert.Equal(t, tt.expectedHeader, recorder.Header().Get(pkgCommon.NextCursorHeader))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -101,6 +102,24 @@ func ParseQueryStringToInt(r *http.Request, queryStringKey string, defaultValue
 	return result, nil
 }
 
+// ParseRequiredQueryStringToFloat parses the specified query string key to a float.  If specified query string key is
+// found more than once in the http request, only the first specified query string will be parsed.  EdgeX error will be
+// returned if the query string key is missing, the value can't be parsed or is out of the min ~ max range.
+func ParseRequiredQueryStringToFloat(r *http.Request, queryStringKey string, min float64, max float64) (float64, errors.EdgeX) {
+	values, ok := r.URL.Query()[queryStringKey]
+	if !ok || len(values) == 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is required", queryStringKey), nil)
+	}
+	result, parsingErr := strconv.ParseFloat(strings.TrimSpace(values[0]), 64)
+	if parsingErr != nil || math.IsNaN(result) {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s's value %s into float", queryStringKey, values[0]), parsingErr)
+	}
+	if result < min || result > max {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s's value %v is out of min %v ~ max %v range.", queryStringKey, result, min, max), nil)
+	}
+	return result, nil
+}
+
 // Parse the specified query string key to an array of string.  If specified query string key is found more than once in
 // the http request, only the first specified query string will be parsed and converted to an array of string.  The
 // value of query string will be split into an array of string by the passing separator.  If separator is passed in as
@@ -69,3 +69,43 @@ func TestParseGetAllObjectsRequestQueryString(t *testing.T) {
 	}
 
 }
+
+func TestParseRequiredQueryStringToFloat(t *testing.T) {
+	key := "latitude"
+	tests := []struct {
+		name              string
+		value             *string
+		expectedValue     float64
+		expectedErrorKind errors.ErrKind
+	}{
+		{"valid", stringPtr("48.85"), 48.85, ""},
+		{"valid - integer", stringPtr("-90"), -90, ""},
+		{"invalid - missing", nil, 0, errors.KindContractInvalid},
+		{"invalid - not a number", stringPtr("north"), 0, errors.KindContractInvalid},
+		{"invalid - NaN", stringPtr("NaN"), 0, errors.KindContractInvalid},
+		{"invalid - exceeds the maximum", stringPtr("90.5"), 0, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, common.ApiAllDeviceRoute, http.NoBody)
+			require.NoError(t, err)
+			if testCase.value != nil {
+				query := req.URL.Query()
+				query.Add(key, *testCase.value)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			value, err := ParseRequiredQueryStringToFloat(req, key, -90, 90)
+			if testCase.expectedErrorKind != "" {
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedValue, value)
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
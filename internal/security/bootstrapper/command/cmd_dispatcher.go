@@ -26,6 +26,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/command/listen"
 	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/command/setupacl"
 	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/command/waitfor"
+	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/command/waitforready"
 	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/config"
 	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/interfaces"
 
@@ -44,8 +45,9 @@ func NewCommand(
 	var err error
 
 	if len(args) < 1 {
-		return nil, fmt.Errorf("subcommand required (%s, %s, %s, %s, %s, %s)", gate.CommandName, listen.CommandName,
-			gethttpstatus.CommandName, genpassword.CommandName, waitfor.CommandName, setupacl.CommandName)
+		return nil, fmt.Errorf("subcommand required (%s, %s, %s, %s, %s, %s, %s)", gate.CommandName, listen.CommandName,
+			gethttpstatus.CommandName, genpassword.CommandName, waitfor.CommandName, waitforready.CommandName,
+			setupacl.CommandName)
 	}
 
 	commandName := args[0]
@@ -61,6 +63,8 @@ func NewCommand(
 		command, err = genpassword.NewCommand(ctx, wg, lc, configuration, args[1:])
 	case waitfor.CommandName:
 		command, err = waitfor.NewCommand(ctx, wg, lc, configuration, args[1:])
+	case waitforready.CommandName:
+		command, err = waitforready.NewCommand(ctx, wg, lc, configuration, args[1:])
 	case setupacl.CommandName:
 		command, err = setupacl.NewCommand(ctx, wg, lc, configuration, args[1:])
 	default:
@@ -38,6 +38,9 @@ func TestNewCommand(t *testing.T) {
 				Timeout:       "2s",
 				RetryInterval: "1s",
 			},
+			Readiness: map[string]config.ServiceReadinessInfo{
+				"core-data": {Conditions: []config.ReadinessConditionInfo{{Type: "tcp", Target: "localhost:55555"}}},
+			},
 		},
 	}
 
@@ -52,12 +55,14 @@ func TestNewCommand(t *testing.T) {
 		{"Good: genPassword command", []string{"genPassword"}, "genPassword", false},
 		{"Good: getHttpStatus command", []string{"getHttpStatus", "--url=http://localhost:55555"}, "getHttpStatus", false},
 		{"Good: waitFor command", []string{"waitFor", "--uri=http://localhost:55555"}, "waitFor", false},
+		{"Good: waitForReady command", []string{"waitForReady", "--service=core-data"}, "waitForReady", false},
 		{"Good: setupRegistryACL command", []string{"setupRegistryACL"}, "setupRegistryACL", false},
 		{"Bad: unknown command", []string{"unknown"}, "", true},
 		{"Bad: empty command", []string{}, "", true},
 		{"Bad: listenTcp command missing required --port", []string{"listenTcp"}, "", true},
 		{"Bad: getHttpStatus command missing required --url", []string{"getHttpStatus"}, "", true},
 		{"Bad: waitFor command missing required --uri", []string{"waitFor"}, "", true},
+		{"Bad: waitForReady command missing required --service", []string{"waitForReady"}, "", true},
 	}
 
 	for _, tt := range tests {
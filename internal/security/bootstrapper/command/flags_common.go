@@ -114,6 +114,8 @@ func HelpCallback() {
 			"    listenTcp         Start up a TCP listener\n"+
 			"    setupRegistryACL  Set up registry's ACL and configure the access\n"+
 			"    waitFor           Wait for the other services with specified URI(s) to connect:\n"+
-			"                      the URI(s) can be communication protocols like tcp/tcp4/tcp6/http/https or files\n",
+			"                      the URI(s) can be communication protocols like tcp/tcp4/tcp6/http/https or files\n"+
+			"    waitForReady      Wait for the readiness conditions of the specified service to be met:\n"+
+			"                      the conditions are configured in StageGate.Readiness\n",
 		os.Args[0])
 }
@@ -0,0 +1,250 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package waitforready
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/config"
+	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+)
+
+const (
+	CommandName string = "waitForReady"
+
+	conditionTypeTCP  = "tcp"
+	conditionTypeHTTP = "http"
+	conditionTypeFile = "file"
+
+	defaultConditionTimeout = 5 * time.Second
+)
+
+// condition is a readiness condition checked by the command
+type condition struct {
+	config.ReadinessConditionInfo
+	timeout time.Duration
+	check   func(ctx context.Context) error
+}
+
+type cmd struct {
+	loggingClient logger.LoggingClient
+	configuration *config.ConfigurationStruct
+
+	// options
+	service string
+
+	// internal states
+	timeout       time.Duration
+	retryInterval time.Duration
+	maxRetries    int
+	conditions    []condition
+}
+
+// NewCommand creates a new cmd and parses through options if any
+func NewCommand(
+	_ context.Context,
+	_ *sync.WaitGroup,
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct,
+	args []string) (interfaces.Command, error) {
+
+	cmd := cmd{
+		loggingClient: lc,
+		configuration: configuration,
+	}
+	var dummy string
+
+	flagSet := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	flagSet.StringVar(&dummy, "configDir", "", "") // handled by bootstrap; duplicated here to prevent arg parsing errors
+	flagSet.StringVar(&cmd.service, "service", "", "Service key whose StageGate.Readiness conditions are waited for")
+
+	err := flagSet.Parse(args)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse command: %s: %w", strings.Join(args, " "), err)
+	}
+
+	if len(cmd.service) == 0 {
+		return nil, fmt.Errorf("%s %s: argument --service is required", os.Args[0], CommandName)
+	}
+
+	readiness, ok := configuration.StageGate.Readiness[cmd.service]
+	if !ok {
+		return nil, fmt.Errorf("no StageGate.Readiness conditions configured for service %s", cmd.service)
+	}
+	if err = cmd.configure(readiness); err != nil {
+		return nil, fmt.Errorf("invalid StageGate.Readiness conditions of service %s: %w", cmd.service, err)
+	}
+
+	return &cmd, nil
+}
+
+// configure validates the readiness configuration of the service, using the WaitFor configuration by default
+func (c *cmd) configure(readiness config.ServiceReadinessInfo) error {
+	var err error
+	if c.timeout, err = parsePositiveDuration("Timeout", readiness.Timeout,
+		c.configuration.StageGate.WaitFor.Timeout); err != nil {
+		return err
+	}
+	if c.retryInterval, err = parsePositiveDuration("RetryInterval", readiness.RetryInterval,
+		c.configuration.StageGate.WaitFor.RetryInterval); err != nil {
+		return err
+	}
+	if readiness.MaxRetries < 0 {
+		return fmt.Errorf("expect non-negative MaxRetries: %d", readiness.MaxRetries)
+	}
+	c.maxRetries = readiness.MaxRetries
+
+	if len(readiness.Conditions) == 0 {
+		return errors.New("at least one condition is required")
+	}
+	for _, info := range readiness.Conditions {
+		cond := condition{ReadinessConditionInfo: info, timeout: defaultConditionTimeout}
+		if info.Timeout != "" {
+			if cond.timeout, err = parsePositiveDuration("condition Timeout", info.Timeout, ""); err != nil {
+				return err
+			}
+		}
+		if info.Target == "" {
+			return fmt.Errorf("the %s condition requires a Target", info.Type)
+		}
+
+		switch info.Type {
+		case conditionTypeTCP:
+			cond.check = cond.checkTCP
+		case conditionTypeHTTP:
+			if _, err = url.ParseRequestURI(info.Target); err != nil {
+				return fmt.Errorf("invalid http condition Target %s: %w", info.Target, err)
+			}
+			cond.check = cond.checkHTTP
+		case conditionTypeFile:
+			cond.check = cond.checkFile
+		default:
+			return fmt.Errorf("unsupported condition Type %s, supported types are %s, %s and %s", info.Type,
+				conditionTypeTCP, conditionTypeHTTP, conditionTypeFile)
+		}
+		c.conditions = append(c.conditions, cond)
+	}
+	return nil
+}
+
+func parsePositiveDuration(name string, value string, defaultValue string) (time.Duration, error) {
+	if value == "" {
+		value = defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse duration for %s: %s: %w", name, value, err)
+	} else if duration <= 0 {
+		return 0, fmt.Errorf("expect positive time duration (> 0) for %s: %s", name, value)
+	}
+	return duration, nil
+}
+
+// GetCommandName returns the name of this command
+func (c *cmd) GetCommandName() string {
+	return CommandName
+}
+
+// Execute implements Command and runs this command
+// command waitForReady waits for all the readiness conditions of the service to be met
+func (c *cmd) Execute() (int, error) {
+	c.loggingClient.Infof("Security bootstrapper running %s for service %s", CommandName, c.service)
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(c.conditions))
+	for i, cond := range c.conditions {
+		wg.Add(1)
+		go func(i int, cond condition) {
+			defer wg.Done()
+			errs[i] = c.waitFor(ctx, cond)
+		}(i, cond)
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("service %s isn't ready: %w", c.service, err)
+	}
+	c.loggingClient.Infof("All the readiness conditions of service %s are met", c.service)
+	return interfaces.StatusCodeExitNormal, nil
+}
+
+// waitFor checks the condition until it's met, the retries are exhausted or the context is done
+func (c *cmd) waitFor(ctx context.Context, cond condition) error {
+	for attempt := 0; ; attempt++ {
+		checkCtx, cancel := context.WithTimeout(ctx, cond.timeout)
+		err := cond.check(checkCtx)
+		cancel()
+		if err == nil {
+			c.loggingClient.Infof("Readiness condition %s %s is met", cond.Type, cond.Target)
+			return nil
+		}
+
+		if c.maxRetries > 0 && attempt >= c.maxRetries {
+			return fmt.Errorf("%s %s not ready after %d retries: %w", cond.Type, cond.Target, c.maxRetries, err)
+		}
+		c.loggingClient.Infof("Readiness condition %s %s not met: %v. Sleeping %s", cond.Type, cond.Target, err,
+			c.retryInterval)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s %s not ready after %s: %w", cond.Type, cond.Target, c.timeout, err)
+		case <-time.After(c.retryInterval):
+		}
+	}
+}
+
+func (cond condition) checkTCP(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", cond.Target)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (cond condition) checkHTTP(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cond.Target, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if len(cond.ExpectedStatusCodes) == 0 {
+		if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+			return nil
+		}
+	} else {
+		for _, code := range cond.ExpectedStatusCodes {
+			if resp.StatusCode == code {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+}
+
+func (cond condition) checkFile(_ context.Context) error {
+	_, err := os.Stat(cond.Target)
+	return err
+}
@@ -0,0 +1,174 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package waitforready
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/config"
+	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+)
+
+const testService = "core-data"
+
+func getTestConfig(readiness config.ServiceReadinessInfo) *config.ConfigurationStruct {
+	return &config.ConfigurationStruct{
+		StageGate: config.StageGateInfo{
+			WaitFor: config.WaitForInfo{
+				Timeout:       "2s",
+				RetryInterval: "100ms",
+			},
+			Readiness: map[string]config.ServiceReadinessInfo{testService: readiness},
+		},
+	}
+}
+
+func TestNewCommand(t *testing.T) {
+	tcpCondition := config.ReadinessConditionInfo{Type: "tcp", Target: "localhost:6379"}
+
+	tests := []struct {
+		name        string
+		cmdArgs     []string
+		readiness   config.ServiceReadinessInfo
+		expectedErr bool
+	}{
+		{"Good: waitForReady with default timeouts", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{tcpCondition}}, false},
+		{"Good: waitForReady with all condition types", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{
+				Conditions: []config.ReadinessConditionInfo{
+					tcpCondition,
+					{Type: "http", Target: "http://localhost:59880/api/v3/ping", ExpectedStatusCodes: []int{200}, Timeout: "1s"},
+					{Type: "file", Target: "/tmp/edgex/secrets/core-data/secrets-token.json"},
+				},
+				Timeout:       "1m",
+				RetryInterval: "5s",
+				MaxRetries:    3,
+			}, false},
+		{"Bad: waitForReady missing --service", []string{},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{tcpCondition}}, true},
+		{"Bad: waitForReady unknown service", []string{"--service=core-metadata"},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{tcpCondition}}, true},
+		{"Bad: waitForReady no condition", []string{"--service=" + testService}, config.ServiceReadinessInfo{}, true},
+		{"Bad: waitForReady unsupported condition type", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{{Type: "udp", Target: "localhost:53"}}}, true},
+		{"Bad: waitForReady condition without target", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{{Type: "file"}}}, true},
+		{"Bad: waitForReady invalid http target", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{{Type: "http", Target: "localhost"}}}, true},
+		{"Bad: waitForReady invalid timeout", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{tcpCondition}, Timeout: "10"}, true},
+		{"Bad: waitForReady negative retry interval", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{tcpCondition}, RetryInterval: "-1s"}, true},
+		{"Bad: waitForReady negative max retries", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{tcpCondition}, MaxRetries: -1}, true},
+		{"Bad: waitForReady invalid condition timeout", []string{"--service=" + testService},
+			config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{{Type: "tcp", Target: "localhost:6379", Timeout: "0s"}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command, err := NewCommand(context.Background(), &sync.WaitGroup{}, logger.MockLogger{},
+				getTestConfig(tt.readiness), tt.cmdArgs)
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, command)
+				require.Equal(t, CommandName, command.GetCommandName())
+			}
+		})
+	}
+}
+
+func TestExecute(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddress := closedListener.Addr().String()
+	require.NoError(t, closedListener.Close())
+
+	healthSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/unavailable" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthSrv.Close()
+
+	dir := t.TempDir()
+	existingFile := filepath.Join(dir, "secrets-token.json")
+	require.NoError(t, os.WriteFile(existingFile, []byte("{}"), 0600))
+	delayedFile := filepath.Join(dir, "delayed")
+
+	tests := []struct {
+		name        string
+		readiness   config.ServiceReadinessInfo
+		expectedErr bool
+	}{
+		{"Good: all conditions met", config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{
+			{Type: "tcp", Target: listener.Addr().String()},
+			{Type: "http", Target: healthSrv.URL + "/ping"},
+			{Type: "file", Target: existingFile},
+		}}, false},
+		{"Good: http expected status code", config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{
+			{Type: "http", Target: healthSrv.URL + "/unavailable", ExpectedStatusCodes: []int{http.StatusServiceUnavailable}},
+		}}, false},
+		{"Good: condition met after retries", config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{
+			{Type: "file", Target: delayedFile},
+		}}, false},
+		{"Bad: tcp condition not met before timeout", config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{
+			{Type: "tcp", Target: closedAddress},
+		}, Timeout: "500ms"}, true},
+		{"Bad: http condition not met after max retries", config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{
+			{Type: "http", Target: healthSrv.URL + "/unavailable"},
+		}, MaxRetries: 2, Timeout: "1m"}, true},
+		{"Bad: one of the conditions not met", config.ServiceReadinessInfo{Conditions: []config.ReadinessConditionInfo{
+			{Type: "file", Target: existingFile},
+			{Type: "file", Target: filepath.Join(dir, "missing")},
+		}, MaxRetries: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command, err := NewCommand(context.Background(), &sync.WaitGroup{}, logger.MockLogger{},
+				getTestConfig(tt.readiness), []string{"--service=" + testService})
+			require.NoError(t, err)
+
+			// the delayed file is created while waiting for it
+			go func() {
+				time.Sleep(300 * time.Millisecond)
+				_ = os.WriteFile(delayedFile, []byte("{}"), 0600)
+			}()
+
+			start := time.Now()
+			statusCode, err := command.Execute()
+			if tt.expectedErr {
+				require.Error(t, err)
+				require.Equal(t, interfaces.StatusCodeExitWithError, statusCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, interfaces.StatusCodeExitNormal, statusCode)
+			}
+			require.Less(t, time.Since(start), 2*time.Second, "waitForReady not returned before the timeout")
+		})
+	}
+}
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+// ServiceReadinessInfo defines the readiness conditions a service
+// waits for with the waitForReady subcommand of security-bootstrapper
+type ServiceReadinessInfo struct {
+	// Conditions that must all be met before the service starts
+	Conditions []ReadinessConditionInfo
+	// Timeout of waiting for the conditions, WaitFor.Timeout by default
+	Timeout string
+	// RetryInterval between the checks of a condition, WaitFor.RetryInterval by default
+	RetryInterval string
+	// MaxRetries of the check of a condition, which is retried until the timeout when 0
+	MaxRetries int
+}
+
+// ReadinessConditionInfo defines a readiness condition
+type ReadinessConditionInfo struct {
+	// Type of the condition: tcp, http or file
+	Type string
+	// Target of the condition: the host:port address for tcp, the URL of the health endpoint for http
+	// and the file path for file
+	Target string
+	// ExpectedStatusCodes of the http health endpoint, any 2xx status code by default
+	ExpectedStatusCodes []int
+	// Timeout of each check of the condition, 5s by default
+	Timeout string
+}
@@ -101,4 +101,6 @@ type StageGateInfo struct {
 	Registry         RegistryInfo
 	KongDB           KongDBInfo
 	WaitFor          WaitForInfo
+	// Readiness defines the readiness conditions of the services, keyed by service key
+	Readiness map[string]ServiceReadinessInfo
 }
@@ -30,6 +30,11 @@ type SecureMosquittoInfo struct {
 	PasswordFile     string
 	BrokerConfigFile string
 	SecretName       string
+	// UsersSecretName is the secret holding the password of each message bus user, which security-secretstore-setup
+	// creates when the topic authorization is enabled
+	UsersSecretName string
+	// ACLFile is the Mosquitto ACL file generated by security-secretstore-setup, which is only used when it exists
+	ACLFile string
 }
 
 // Implement interface.Configuration
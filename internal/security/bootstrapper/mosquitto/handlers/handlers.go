@@ -23,6 +23,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"sync"
 
 	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/mosquitto/container"
@@ -42,6 +43,8 @@ const (
 // Handler is the redis bootstrapping handler
 type Handler struct {
 	credentials bootstrapConfig.Credentials
+	// users maps the users of the topic authorization to their password
+	users map[string]string
 }
 
 // NewHandler instantiates a new Handler
@@ -79,6 +82,24 @@ func (handler *Handler) GetCredentials(ctx context.Context, _ *sync.WaitGroup, s
 	}
 
 	handler.credentials = *credentials
+
+	if config.SecureMosquitto.UsersSecretName == "" {
+		return true
+	}
+	exists, err := secretProvider.HasSecret(config.SecureMosquitto.UsersSecretName)
+	if err != nil {
+		lc.Errorf("Failed to check the message bus users secret: %s", err.Error())
+		return false
+	}
+	if !exists {
+		lc.Infof("No message bus users secret '%s', the topic authorization isn't enabled", config.SecureMosquitto.UsersSecretName)
+		return true
+	}
+	handler.users, err = secretProvider.GetSecret(config.SecureMosquitto.UsersSecretName)
+	if err != nil {
+		lc.Errorf("Failed to retrieve the message bus users: %s", err.Error())
+		return false
+	}
 	return true
 }
 
@@ -108,6 +129,21 @@ func (handler *Handler) SetupMosquittoPasswordFile(ctx context.Context, _ *sync.
 		return false
 	}
 
+	users := make([]string, 0, len(handler.users))
+	for user := range handler.users {
+		if user != handler.credentials.Username {
+			users = append(users, user)
+		}
+	}
+	sort.Strings(users)
+	for _, user := range users {
+		cmd = exec.Command("mosquitto_passwd", "-b", pwdFile, user, handler.users[user])
+		if _, err = cmd.Output(); err != nil {
+			lc.Errorf("failed to execute command mosquitto_passwd for user %s: %v", user, err)
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -124,11 +160,22 @@ func (handler *Handler) SetupMosquittoConfFile(ctx context.Context, _ *sync.Wait
 	}
 
 	configFileTemplate := `listener {{.MQTTPort}}
-password_file {{.PwdFilePath}}`
+password_file {{.PwdFilePath}}{{if .ACLFilePath}}
+acl_file {{.ACLFilePath}}{{end}}`
 
 	type mosquittoConfig struct {
 		MQTTPort    int
 		PwdFilePath string
+		ACLFilePath string
+	}
+
+	// the ACL file is only generated by security-secretstore-setup when the topic authorization is enabled
+	aclFile := config.SecureMosquitto.ACLFile
+	if aclFile != "" {
+		if _, err := os.Stat(aclFile); err != nil {
+			lc.Infof("ACL file %s not found, the topics aren't authorized", aclFile)
+			aclFile = ""
+		}
 	}
 
 	mosquittoConf, err := template.New("mosquitto-config").Parse(configFileTemplate + fmt.Sprintln())
@@ -153,6 +200,7 @@ password_file {{.PwdFilePath}}`
 	if err := mosquittoConf.Execute(fwriter, mosquittoConfig{
 		MQTTPort:    config.SecureMosquitto.Port,
 		PwdFilePath: config.SecureMosquitto.PasswordFile,
+		ACLFilePath: aclFile,
 	}); err != nil {
 		lc.Errorf("failed to execute mosquittoConfig template %s: %v", configFileTemplate, err)
 		return false
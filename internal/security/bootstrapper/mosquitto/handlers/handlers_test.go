@@ -18,6 +18,8 @@ package handlers
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/edgexfoundry/edgex-go/internal/security/bootstrapper/mosquitto/config"
@@ -28,6 +30,8 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type Testdata struct {
@@ -168,3 +172,65 @@ func TestHandler_SetupConfFile(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_GetCredentialsUsers(t *testing.T) {
+	users := map[string]string{"core-data": "core-data-password", "device-virtual": "device-virtual-password"}
+	mockSecretProvider := &mocks.SecretProvider{}
+	mockSecretProvider.On("GetSecret", "message-bus").Return(map[string]string{"username": "TEST_USER", "password": "TEST_PASS"}, nil)
+	mockSecretProvider.On("HasSecret", "message-bus-users").Return(true, nil)
+	mockSecretProvider.On("GetSecret", "message-bus-users").Return(users, nil)
+	mockSecretProvider.On("HasSecret", "notfound").Return(false, nil)
+
+	tests := []struct {
+		name            string
+		usersSecretName string
+		expectedUsers   map[string]string
+	}{
+		{"topic authorization", "message-bus-users", users},
+		{"no topic authorization", "notfound", nil},
+		{"users not configured", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testData := setUp(t, "message-bus", "", "")
+			messagebus.ConfigurationFrom(testData.dic.Get).SecureMosquitto.UsersSecretName = tt.usersSecretName
+			testData.dic.Update(di.ServiceConstructorMap{
+				container.SecretProviderName: func(get di.Get) interface{} {
+					return mockSecretProvider
+				},
+			})
+
+			handler := &Handler{}
+			require.True(t, handler.GetCredentials(testData.ctx, nil, startup.NewTimer(3, 1), testData.dic))
+			assert.Equal(t, tt.expectedUsers, handler.users)
+		})
+	}
+}
+
+func TestHandler_SetupConfFileACL(t *testing.T) {
+	dir := t.TempDir()
+	aclFile := filepath.Join(dir, "acl")
+	require.NoError(t, os.WriteFile(aclFile, []byte("user core-data\n"), 0644))
+
+	tests := []struct {
+		name            string
+		aclFile         string
+		expectedContent string
+	}{
+		{"ACL file", aclFile, "listener 0\npassword_file /mosquitto/config/pwdfile\nacl_file " + aclFile + "\n"},
+		{"ACL file not generated", filepath.Join(dir, "missing"), "listener 0\npassword_file /mosquitto/config/pwdfile\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			brokerConfigFile := filepath.Join(dir, "mosquitto.conf")
+			testData := setUp(t, "", brokerConfigFile, "/mosquitto/config/pwdfile")
+			messagebus.ConfigurationFrom(testData.dic.Get).SecureMosquitto.ACLFile = tt.aclFile
+
+			handler := &Handler{}
+			require.True(t, handler.SetupMosquittoConfFile(testData.ctx, nil, startup.NewTimer(3, 1), testData.dic))
+			content, err := os.ReadFile(brokerConfigFile)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedContent, string(content))
+		})
+	}
+}
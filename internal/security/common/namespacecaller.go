@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+)
+
+// NamespaceHeader is the header of the namespace of the requests to an enterprise secret store
+const NamespaceHeader = "X-Vault-Namespace"
+
+// rootNamespacePaths are the paths of the secret store only served in the root namespace
+var rootNamespacePaths = []string{
+	"/v1/sys/health",
+	"/v1/sys/init",
+	"/v1/sys/unseal",
+	"/v1/sys/seal-status",
+	"/v1/sys/generate-root",
+}
+
+type namespaceCaller struct {
+	caller    internal.HttpCaller
+	namespace string
+}
+
+// NewNamespaceCaller wraps the caller so that the requests to the secret store are sent in the namespace, the caller
+// is returned as is when the namespace is empty
+func NewNamespaceCaller(caller internal.HttpCaller, namespace string) internal.HttpCaller {
+	if namespace == "" {
+		return caller
+	}
+	return &namespaceCaller{caller: caller, namespace: namespace}
+}
+
+func (c *namespaceCaller) Do(req *http.Request) (*http.Response, error) {
+	for _, path := range rootNamespacePaths {
+		if strings.HasPrefix(req.URL.Path, path) {
+			return c.caller.Do(req)
+		}
+	}
+	req.Header.Set(NamespaceHeader, c.namespace)
+	return c.caller.Do(req)
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceCaller(t *testing.T) {
+	var namespace string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespace = r.Header.Get(NamespaceHeader)
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name              string
+		namespace         string
+		path              string
+		expectedNamespace string
+	}{
+		{"Valid - namespaced request", "edge/site-1", "/v1/secret/edgex/core-data/redisdb", "edge/site-1"},
+		{"Valid - root namespace request", "edge/site-1", "/v1/sys/health", ""},
+		{"Valid - no namespace", "", "/v1/secret/edgex/core-data/redisdb", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			namespace = ""
+			req, err := http.NewRequest(http.MethodGet, ts.URL+testCase.path, http.NoBody)
+			require.NoError(t, err)
+
+			resp, err := NewNamespaceCaller(http.DefaultClient, testCase.namespace).Do(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+
+			assert.Equal(t, testCase.expectedNamespace, namespace)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/common"
 	"github.com/edgexfoundry/edgex-go/internal/security/fileprovider/container"
 	"github.com/edgexfoundry/go-mod-secrets/v3/pkg"
 	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/types"
@@ -63,12 +64,14 @@ func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ sta
 		lc.Info("bypassing certificate verification for secret store connection")
 		requester = pkg.NewRequester(lc).Insecure()
 	}
+	requester = common.NewNamespaceCaller(requester, cfg.SecretStore.Namespace)
 
 	clientConfig := types.SecretConfig{
-		Type:     secrets.Vault,
-		Host:     cfg.SecretStore.Host,
-		Port:     cfg.SecretStore.Port,
-		Protocol: cfg.SecretStore.Protocol,
+		Type:      secrets.Vault,
+		Host:      cfg.SecretStore.Host,
+		Port:      cfg.SecretStore.Port,
+		Protocol:  cfg.SecretStore.Protocol,
+		Namespace: cfg.SecretStore.Namespace,
 	}
 	client, err := secrets.NewSecretStoreClient(clientConfig, lc, requester)
 	if err != nil {
@@ -25,6 +25,62 @@ type ConfigurationStruct struct {
 	Writable WritableInfo
 	Registry bootstrapConfig.RegistryInfo
 	Service  bootstrapConfig.ServiceInfo
+	// JWTPolicy authorizes the tokens issued by external identity providers per route
+	JWTPolicy JWTPolicyInfo
+	// ClientCredentials exchanges the client credentials of machine clients for tokens of an external identity provider
+	ClientCredentials ClientCredentialsInfo
+}
+
+// JWTPolicyInfo contains the external identity providers trusted by the service and the policies of the routes their
+// tokens are accepted on. The tokens of the other issuers are validated by the secret store as EdgeX tokens.
+type JWTPolicyInfo struct {
+	Enabled bool
+	Issuers []IssuerInfo
+	// Routes are matched in order against the original request URI, the first matching route applies
+	Routes []RoutePolicyInfo
+}
+
+// IssuerInfo defines an external identity provider, such as Keycloak or Auth0, and how its roles map to EdgeX roles
+type IssuerInfo struct {
+	// Issuer is the iss claim of the tokens of the identity provider
+	Issuer string
+	// KeysFile is the path of the JSON Web Key Set verifying the signature of the tokens
+	KeysFile string
+	// RoleClaim is the claim holding the roles of the identity provider, nested claims are separated by dots,
+	// e.g. realm_access.roles
+	RoleClaim string
+	// RoleMappings maps the roles of the identity provider to EdgeX roles
+	RoleMappings map[string]string
+}
+
+// ClientCredentialsInfo defines the OAuth2 client credentials grant the token endpoint of the service performs against
+// an external identity provider on behalf of the clients
+type ClientCredentialsInfo struct {
+	Enabled bool
+	// Issuer is the issuer of JWTPolicy.Issuers the tokens are acquired from, which verifies the acquired tokens
+	Issuer string
+	// TokenURL is the token endpoint of the identity provider
+	TokenURL string
+	// Scope requested for the tokens, when not empty
+	Scope string
+	// Audience requested for the tokens, when not empty, as required by some identity providers such as Auth0
+	Audience string
+	// Timeout of the requests to the identity provider
+	Timeout string
+}
+
+// RoutePolicyInfo defines the tokens of the external identity providers accepted on the routes matching PathPattern
+type RoutePolicyInfo struct {
+	// PathPattern is the regular expression matching the original request URI path, e.g. ^/core-command/
+	PathPattern string
+	// Issuers accepted on the route, all the configured issuers are accepted when empty
+	Issuers []string
+	// Audiences of which the token must contain at least one, when not empty
+	Audiences []string
+	// Claims the token must contain with the specified values, a claim holding an array must contain the value
+	Claims map[string]string
+	// Roles of which the token must be mapped to at least one EdgeX role, when not empty
+	Roles []string
 }
 
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/edgexfoundry/edgex-go"
+	proxyAuthContainer "github.com/edgexfoundry/edgex-go/internal/security/proxyauth/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/controller"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/handlers"
@@ -53,12 +54,38 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	secretProvider := container.SecretProviderExtFrom(dic.Get)
 	authenticationHook := handlers.VaultAuthenticationHandlerFunc(secretProvider, lc)
 
+	configuration := proxyAuthContainer.ConfigurationFrom(dic.Get)
+
+	// The tokens of the external identity providers are authorized according to the JWT policy of the route
+	var policy *jwtPolicy
+	if configuration.JWTPolicy.Enabled {
+		var err error
+		policy, err = newJWTPolicy(configuration.JWTPolicy, lc)
+		if err != nil {
+			lc.Errorf("failed to load the JWT policy: %v", err)
+			return false
+		}
+		authenticationHook = policy.authenticationHandlerFunc(authenticationHook)
+		lc.Infof("JWT policy enabled for %d issuer(s) and %d route(s)", len(configuration.JWTPolicy.Issuers), len(configuration.JWTPolicy.Routes))
+	}
+
 	// Common
 	_ = controller.NewCommonController(dic, b.router, b.serviceName, edgex.Version)
 
 	// Run authentication hook for a nil route
 	b.router.HandleFunc("/auth", authenticationHook(emptyHandler))
 
+	// Exchange the client credentials of machine clients for tokens of the external identity provider
+	if configuration.ClientCredentials.Enabled {
+		acquirer, err := newTokenAcquirer(configuration.ClientCredentials, policy, lc)
+		if err != nil {
+			lc.Errorf("failed to set up the client credentials token endpoint: %v", err)
+			return false
+		}
+		b.router.HandleFunc(TokenRoute, acquirer.tokenHandler).Methods(http.MethodPost)
+		lc.Infof("Client credentials token endpoint enabled for issuer %s", configuration.ClientCredentials.Issuer)
+	}
+
 	return true
 }
 
@@ -0,0 +1,264 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyauth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxyauth/config"
+)
+
+const (
+	// ForwardedURIHeader is the header holding the original request URI, set by the API gateway on the auth subrequest
+	ForwardedURIHeader = "X-Forwarded-URI"
+	// RolesHeader is the response header holding the EdgeX roles the token of an external identity provider maps to
+	RolesHeader = "X-EdgeX-Roles"
+)
+
+// issuer is an external identity provider with the keys verifying its tokens
+type issuer struct {
+	config.IssuerInfo
+	keys jose.JSONWebKeySet
+}
+
+// routePolicy is a route policy with its compiled path pattern
+type routePolicy struct {
+	config.RoutePolicyInfo
+	pathPattern *regexp.Regexp
+}
+
+// jwtPolicy authorizes the tokens of the external identity providers according to the policy of the requested route
+type jwtPolicy struct {
+	lc      logger.LoggingClient
+	issuers map[string]issuer
+	routes  []routePolicy
+}
+
+// newJWTPolicy loads the keys of the issuers and compiles the route patterns of the JWT policy configuration
+func newJWTPolicy(info config.JWTPolicyInfo, lc logger.LoggingClient) (*jwtPolicy, error) {
+	p := &jwtPolicy{lc: lc, issuers: make(map[string]issuer, len(info.Issuers))}
+	for _, i := range info.Issuers {
+		if i.Issuer == "" {
+			return nil, fmt.Errorf("the issuer of a JWT policy issuer must be specified")
+		}
+		content, err := os.ReadFile(i.KeysFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the keys file of issuer %s: %w", i.Issuer, err)
+		}
+		var keys jose.JSONWebKeySet
+		if err = json.Unmarshal(content, &keys); err != nil {
+			return nil, fmt.Errorf("failed to parse the keys file of issuer %s: %w", i.Issuer, err)
+		}
+		if len(keys.Keys) == 0 {
+			return nil, fmt.Errorf("the keys file of issuer %s doesn't contain any key", i.Issuer)
+		}
+		p.issuers[i.Issuer] = issuer{IssuerInfo: i, keys: keys}
+	}
+	for _, r := range info.Routes {
+		pattern, err := regexp.Compile(r.PathPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT policy route pattern %s: %w", r.PathPattern, err)
+		}
+		for _, name := range r.Issuers {
+			if _, ok := p.issuers[name]; !ok {
+				return nil, fmt.Errorf("JWT policy route %s refers to the unknown issuer %s", r.PathPattern, name)
+			}
+		}
+		p.routes = append(p.routes, routePolicy{RoutePolicyInfo: r, pathPattern: pattern})
+	}
+	return p, nil
+}
+
+// authenticationHandlerFunc authorizes the tokens of the external identity providers according to the policy of the
+// route, and the other tokens with the fallback authentication hook
+func (p *jwtPolicy) authenticationHandlerFunc(fallback func(inner http.HandlerFunc) http.HandlerFunc) func(inner http.HandlerFunc) http.HandlerFunc {
+	return func(inner http.HandlerFunc) http.HandlerFunc {
+		fallbackHandler := fallback(inner)
+		return func(w http.ResponseWriter, r *http.Request) {
+			authParts := strings.Split(r.Header.Get("Authorization"), " ")
+			if len(authParts) < 2 || !strings.EqualFold(authParts[0], "Bearer") {
+				fallbackHandler(w, r)
+				return
+			}
+			token, err := jwt.ParseSigned(authParts[1])
+			if err != nil {
+				fallbackHandler(w, r)
+				return
+			}
+			var claims jwt.Claims
+			if err = token.UnsafeClaimsWithoutVerification(&claims); err != nil {
+				fallbackHandler(w, r)
+				return
+			}
+			iss, ok := p.issuers[claims.Issuer]
+			if !ok {
+				fallbackHandler(w, r)
+				return
+			}
+
+			path := requestPath(r)
+			roles, statusCode, err := p.authorize(token, iss, path)
+			if err != nil {
+				p.lc.Warnf("Request to '%s' with a token of issuer %s UNAUTHORIZED: %v", path, iss.Issuer, err)
+				http.Error(w, http.StatusText(statusCode), statusCode)
+				return
+			}
+			p.lc.Debugf("Request to '%s' with a token of issuer %s authorized", path, iss.Issuer)
+			w.Header().Set(RolesHeader, strings.Join(roles, ","))
+			inner(w, r)
+		}
+	}
+}
+
+// authorize verifies the token of the issuer and checks it against the policy of the route matching the path, it
+// returns the EdgeX roles of the token, or the status code of the response and the error when the token isn't
+// authorized
+func (p *jwtPolicy) authorize(token *jwt.JSONWebToken, iss issuer, path string) ([]string, int, error) {
+	var claims jwt.Claims
+	var custom map[string]any
+	if err := verifyClaims(token, iss.keys, &claims, &custom); err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{Issuer: iss.Issuer, Time: time.Now()}, jwt.DefaultLeeway); err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+	roles := mapRoles(iss, custom)
+
+	route, ok := p.route(path)
+	if !ok {
+		return nil, http.StatusForbidden, fmt.Errorf("no JWT policy route matches the path")
+	}
+	if len(route.Issuers) > 0 && !contains(route.Issuers, iss.Issuer) {
+		return nil, http.StatusForbidden, fmt.Errorf("the issuer isn't accepted on route %s", route.PathPattern)
+	}
+	if len(route.Audiences) > 0 && !containsAny(claims.Audience, route.Audiences) {
+		return nil, http.StatusForbidden, fmt.Errorf("none of the audiences %v is accepted on route %s", []string(claims.Audience), route.PathPattern)
+	}
+	for name, value := range route.Claims {
+		if !claimMatches(custom[name], value) {
+			return nil, http.StatusForbidden, fmt.Errorf("claim %s doesn't match the value required on route %s", name, route.PathPattern)
+		}
+	}
+	if len(route.Roles) > 0 && !containsAny(roles, route.Roles) {
+		return nil, http.StatusForbidden, fmt.Errorf("none of the roles %v is accepted on route %s", roles, route.PathPattern)
+	}
+	return roles, http.StatusOK, nil
+}
+
+// route returns the first route policy matching the path
+func (p *jwtPolicy) route(path string) (routePolicy, bool) {
+	for _, r := range p.routes {
+		if r.pathPattern.MatchString(path) {
+			return r, true
+		}
+	}
+	return routePolicy{}, false
+}
+
+// verifyClaims verifies the signature of the token with the key of its key ID, or with each key of the key set when
+// the token doesn't specify its key ID, and deserializes its claims
+func verifyClaims(token *jwt.JSONWebToken, keys jose.JSONWebKeySet, out ...any) error {
+	candidates := keys.Keys
+	if len(token.Headers) > 0 && token.Headers[0].KeyID != "" {
+		candidates = keys.Key(token.Headers[0].KeyID)
+	}
+	err := fmt.Errorf("no key of the issuer matches the key ID of the token")
+	for _, key := range candidates {
+		if err = token.Claims(key.Key, out...); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// mapRoles returns the EdgeX roles the roles of the role claim of the issuer map to
+func mapRoles(iss issuer, claims map[string]any) []string {
+	if iss.RoleClaim == "" {
+		return nil
+	}
+	var value any = claims
+	for _, name := range strings.Split(iss.RoleClaim, ".") {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = nested[name]
+	}
+
+	var roles []string
+	for _, role := range claimValues(value) {
+		if mapped, ok := iss.RoleMappings[role]; ok && !contains(roles, mapped) {
+			roles = append(roles, mapped)
+		}
+	}
+	return roles
+}
+
+// claimMatches checks whether the claim is the value or an array containing the value
+func claimMatches(claim any, value string) bool {
+	return contains(claimValues(claim), value)
+}
+
+// claimValues returns the string values of a claim holding a string, a number, a boolean or an array of them
+func claimValues(claim any) []string {
+	switch c := claim.(type) {
+	case nil:
+		return nil
+	case []any:
+		values := make([]string, 0, len(c))
+		for _, v := range c {
+			values = append(values, claimValues(v)...)
+		}
+		return values
+	case string:
+		return []string{c}
+	default:
+		return []string{fmt.Sprint(c)}
+	}
+}
+
+// requestPath returns the path of the original request URI forwarded by the API gateway, or of the request URI
+func requestPath(r *http.Request) string {
+	forwarded := r.Header.Get(ForwardedURIHeader)
+	if forwarded == "" {
+		return r.URL.Path
+	}
+	u, err := url.ParseRequestURI(forwarded)
+	if err != nil {
+		return forwarded
+	}
+	return u.Path
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(values []string, candidates []string) bool {
+	for _, c := range candidates {
+		if contains(values, c) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyauth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxyauth/config"
+)
+
+const (
+	testIssuer      = "https://keycloak.example.com/realms/edgex"
+	testOtherIssuer = "https://edgex.auth0.com/"
+	testKeyID       = "test-key"
+)
+
+func testSigner(t *testing.T, key *rsa.PrivateKey, keyID string) jose.Signer {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithHeader(jose.HeaderKey("kid"), keyID))
+	require.NoError(t, err)
+	return signer
+}
+
+func testKeysFile(t *testing.T, key *rsa.PrivateKey) string {
+	keys := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: testKeyID, Algorithm: string(jose.RS256), Use: "sig"}}}
+	content, err := json.Marshal(keys)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, content, 0600))
+	return path
+}
+
+func TestJWTPolicy(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keysFile := testKeysFile(t, key)
+
+	policy, err := newJWTPolicy(config.JWTPolicyInfo{
+		Enabled: true,
+		Issuers: []config.IssuerInfo{
+			{Issuer: testIssuer, KeysFile: keysFile, RoleClaim: "realm_access.roles", RoleMappings: map[string]string{"plant-operator": "operator", "plant-admin": "admin"}},
+			{Issuer: testOtherIssuer, KeysFile: keysFile},
+		},
+		Routes: []config.RoutePolicyInfo{
+			{PathPattern: "^/core-command/", Issuers: []string{testIssuer}, Audiences: []string{"edgex"}, Claims: map[string]string{"site": "plant-1"}, Roles: []string{"operator"}},
+			{PathPattern: "^/core-data/"},
+		},
+	}, logger.NewMockClient())
+	require.NoError(t, err)
+
+	fallbackCalled := false
+	fallback := func(inner http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			fallbackCalled = true
+			inner(w, r)
+		}
+	}
+	handler := policy.authenticationHandlerFunc(fallback)(emptyHandler)
+
+	validClaims := map[string]any{
+		"site":         "plant-1",
+		"realm_access": map[string]any{"roles": []string{"plant-operator", "viewer"}},
+	}
+	standardClaims := func(issuer string, audience ...string) jwt.Claims {
+		return jwt.Claims{Issuer: issuer, Audience: audience, Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))}
+	}
+	expiredClaims := standardClaims(testIssuer, "edgex")
+	expiredClaims.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+	tests := []struct {
+		name               string
+		signer             jose.Signer
+		claims             jwt.Claims
+		custom             map[string]any
+		uri                string
+		expectedStatusCode int
+		expectedRoles      string
+		expectedFallback   bool
+	}{
+		{"Valid", testSigner(t, key, testKeyID), standardClaims(testIssuer, "edgex", "account"), validClaims, "/core-command/api/v3/device/all?offset=0", http.StatusOK, "operator", false},
+		{"Valid - route without policy constraints", testSigner(t, key, testKeyID), standardClaims(testOtherIssuer), nil, "/core-data/api/v3/event/all", http.StatusOK, "", false},
+		{"Valid - token of another issuer falls back", testSigner(t, otherKey, testKeyID), standardClaims("https://vault:8200/v1/identity/oidc"), nil, "/core-command/api/v3/device/all", http.StatusOK, "", true},
+		{"Invalid - signed by another key", testSigner(t, otherKey, testKeyID), standardClaims(testIssuer, "edgex"), validClaims, "/core-command/api/v3/device/all", http.StatusUnauthorized, "", false},
+		{"Invalid - unknown key ID", testSigner(t, key, "unknown"), standardClaims(testIssuer, "edgex"), validClaims, "/core-command/api/v3/device/all", http.StatusUnauthorized, "", false},
+		{"Invalid - expired", testSigner(t, key, testKeyID), expiredClaims, validClaims, "/core-command/api/v3/device/all", http.StatusUnauthorized, "", false},
+		{"Invalid - no route matches", testSigner(t, key, testKeyID), standardClaims(testIssuer, "edgex"), validClaims, "/core-metadata/api/v3/device/all", http.StatusForbidden, "", false},
+		{"Invalid - issuer not accepted", testSigner(t, key, testKeyID), standardClaims(testOtherIssuer, "edgex"), validClaims, "/core-command/api/v3/device/all", http.StatusForbidden, "", false},
+		{"Invalid - audience not accepted", testSigner(t, key, testKeyID), standardClaims(testIssuer, "account"), validClaims, "/core-command/api/v3/device/all", http.StatusForbidden, "", false},
+		{"Invalid - claim mismatch", testSigner(t, key, testKeyID), standardClaims(testIssuer, "edgex"),
+			map[string]any{"site": "plant-2", "realm_access": validClaims["realm_access"]}, "/core-command/api/v3/device/all", http.StatusForbidden, "", false},
+		{"Invalid - role not accepted", testSigner(t, key, testKeyID), standardClaims(testIssuer, "edgex"),
+			map[string]any{"site": "plant-1", "realm_access": map[string]any{"roles": []string{"plant-admin"}}}, "/core-command/api/v3/device/all", http.StatusForbidden, "", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			fallbackCalled = false
+			token, err := jwt.Signed(testCase.signer).Claims(testCase.claims).Claims(testCase.custom).CompactSerialize()
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodGet, "/auth", http.NoBody)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set(ForwardedURIHeader, testCase.uri)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedRoles, recorder.Header().Get(RolesHeader), "Roles not as expected")
+			assert.Equal(t, testCase.expectedFallback, fallbackCalled, "Fallback authentication not as expected")
+		})
+	}
+}
+
+func TestNewJWTPolicy(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keysFile := testKeysFile(t, key)
+	emptyKeysFile := filepath.Join(t.TempDir(), "empty.json")
+	require.NoError(t, os.WriteFile(emptyKeysFile, []byte(`{"keys":[]}`), 0600))
+
+	tests := []struct {
+		name          string
+		info          config.JWTPolicyInfo
+		errorExpected bool
+	}{
+		{"Valid", config.JWTPolicyInfo{Issuers: []config.IssuerInfo{{Issuer: testIssuer, KeysFile: keysFile}}, Routes: []config.RoutePolicyInfo{{PathPattern: "^/core-command/", Issuers: []string{testIssuer}}}}, false},
+		{"Invalid - no issuer", config.JWTPolicyInfo{Issuers: []config.IssuerInfo{{KeysFile: keysFile}}}, true},
+		{"Invalid - keys file not found", config.JWTPolicyInfo{Issuers: []config.IssuerInfo{{Issuer: testIssuer, KeysFile: "/not/found.json"}}}, true},
+		{"Invalid - no key", config.JWTPolicyInfo{Issuers: []config.IssuerInfo{{Issuer: testIssuer, KeysFile: emptyKeysFile}}}, true},
+		{"Invalid - invalid path pattern", config.JWTPolicyInfo{Routes: []config.RoutePolicyInfo{{PathPattern: "^/core-command/("}}}, true},
+		{"Invalid - unknown route issuer", config.JWTPolicyInfo{Routes: []config.RoutePolicyInfo{{PathPattern: "^/core-command/", Issuers: []string{testIssuer}}}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := newJWTPolicy(testCase.info, logger.NewMockClient())
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
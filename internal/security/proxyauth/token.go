@@ -0,0 +1,156 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyauth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxyauth/config"
+)
+
+const (
+	// TokenRoute is the route of the endpoint exchanging client credentials for tokens of the identity provider
+	TokenRoute                 = "/token"
+	grantTypeClientCredentials = "client_credentials"
+	defaultTokenTimeout        = 10 * time.Second
+	// maxTokenResponseSize limits the size of the token responses read from the identity provider
+	maxTokenResponseSize = 1 << 20
+)
+
+// tokenResponse is the successful response of the token endpoint, as defined by RFC 6749 section 5.1
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+// tokenAcquirer acquires tokens of an external identity provider with the OAuth2 client credentials grant on behalf
+// of the machine clients, and returns them once verified by the JWT policy
+type tokenAcquirer struct {
+	lc     logger.LoggingClient
+	info   config.ClientCredentialsInfo
+	issuer issuer
+	client *http.Client
+}
+
+// newTokenAcquirer creates a tokenAcquirer for the client credentials configuration, the issuer of which must be an
+// issuer of the JWT policy
+func newTokenAcquirer(info config.ClientCredentialsInfo, policy *jwtPolicy, lc logger.LoggingClient) (*tokenAcquirer, error) {
+	if policy == nil {
+		return nil, fmt.Errorf("the JWT policy must be enabled to verify the tokens acquired with the client credentials")
+	}
+	iss, ok := policy.issuers[info.Issuer]
+	if !ok {
+		return nil, fmt.Errorf("the client credentials issuer %s isn't an issuer of the JWT policy", info.Issuer)
+	}
+	if _, err := url.ParseRequestURI(info.TokenURL); err != nil {
+		return nil, fmt.Errorf("invalid client credentials token URL %s: %w", info.TokenURL, err)
+	}
+	timeout := defaultTokenTimeout
+	if info.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(info.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client credentials timeout %s: %w", info.Timeout, err)
+		}
+	}
+	return &tokenAcquirer{lc: lc, info: info, issuer: iss, client: &http.Client{Timeout: timeout}}, nil
+}
+
+// tokenHandler exchanges the client credentials of the request, sent with HTTP basic authentication or as the
+// client_id and client_secret form parameters, for a token of the identity provider
+func (a *tokenAcquirer) tokenHandler(w http.ResponseWriter, r *http.Request) {
+	clientId, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		clientId, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientId == "" || clientSecret == "" {
+		http.Error(w, "client credentials must be specified", http.StatusBadRequest)
+		return
+	}
+
+	token, statusCode, err := a.acquire(clientId, clientSecret)
+	if err != nil {
+		a.lc.Warnf("Failed to acquire a token of issuer %s for client %s: %v", a.issuer.Issuer, clientId, err)
+		http.Error(w, http.StatusText(statusCode), statusCode)
+		return
+	}
+	a.lc.Debugf("Token of issuer %s acquired for client %s", a.issuer.Issuer, clientId)
+
+	w.Header().Set(common.ContentType, common.ContentTypeJSON)
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(token)
+}
+
+// acquire requests a token for the client credentials from the identity provider and verifies it, it returns the
+// status code of the response and the error when the token can't be acquired
+func (a *tokenAcquirer) acquire(clientId, clientSecret string) (tokenResponse, int, error) {
+	form := url.Values{}
+	form.Set("grant_type", grantTypeClientCredentials)
+	form.Set("client_id", clientId)
+	form.Set("client_secret", clientSecret)
+	if a.info.Scope != "" {
+		form.Set("scope", a.info.Scope)
+	}
+	if a.info.Audience != "" {
+		form.Set("audience", a.info.Audience)
+	}
+
+	resp, err := a.client.Post(a.info.TokenURL, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return tokenResponse{}, http.StatusBadGateway, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponseSize))
+	if err != nil {
+		return tokenResponse{}, http.StatusBadGateway, fmt.Errorf("failed to read the token response: %w", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized:
+		// The identity provider rejected the client credentials
+		return tokenResponse{}, http.StatusUnauthorized, fmt.Errorf("token request rejected with status %d: %s", resp.StatusCode, body)
+	case resp.StatusCode != http.StatusOK:
+		return tokenResponse{}, http.StatusBadGateway, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, body)
+	}
+
+	var token tokenResponse
+	if err = json.Unmarshal(body, &token); err != nil {
+		return tokenResponse{}, http.StatusBadGateway, fmt.Errorf("failed to parse the token response: %w", err)
+	}
+	if err = a.verify(token.AccessToken); err != nil {
+		return tokenResponse{}, http.StatusBadGateway, fmt.Errorf("the acquired token isn't acceptable: %w", err)
+	}
+	return token, http.StatusOK, nil
+}
+
+// verify checks that the token is a valid JWT of the issuer, so that the JWT policy accepts it
+func (a *tokenAcquirer) verify(accessToken string) error {
+	token, err := jwt.ParseSigned(accessToken)
+	if err != nil {
+		return err
+	}
+	var claims jwt.Claims
+	if err = verifyClaims(token, a.issuer.keys, &claims); err != nil {
+		return err
+	}
+	return claims.ValidateWithLeeway(jwt.Expected{Issuer: a.issuer.Issuer, Time: time.Now()}, jwt.DefaultLeeway)
+}
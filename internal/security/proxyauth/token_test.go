@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxyauth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxyauth/config"
+)
+
+func TestTokenHandler(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// The identity provider issues the token of each client
+	clientTokens := make(map[string]string)
+	issueToken := func(t *testing.T, clientId string, key *rsa.PrivateKey, issuer string) {
+		claims := jwt.Claims{Issuer: issuer, Subject: clientId, Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))}
+		token, err := jwt.Signed(testSigner(t, key, testKeyID)).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		clientTokens[clientId] = token
+	}
+	issueToken(t, "valid", key, testIssuer)
+	issueToken(t, "otherKey", otherKey, testIssuer)
+	issueToken(t, "otherIssuer", key, testOtherIssuer)
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, grantTypeClientCredentials, r.PostForm.Get("grant_type"))
+		assert.Equal(t, "edgex", r.PostForm.Get("audience"))
+		token, ok := clientTokens[r.PostForm.Get("client_id")]
+		if !ok || r.PostForm.Get("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(tokenResponse{AccessToken: token, TokenType: "Bearer", ExpiresIn: 3600})
+	}))
+	defer idp.Close()
+
+	policy, err := newJWTPolicy(config.JWTPolicyInfo{
+		Issuers: []config.IssuerInfo{{Issuer: testIssuer, KeysFile: testKeysFile(t, key)}},
+	}, logger.NewMockClient())
+	require.NoError(t, err)
+	acquirer, err := newTokenAcquirer(config.ClientCredentialsInfo{
+		Enabled:  true,
+		Issuer:   testIssuer,
+		TokenURL: idp.URL,
+		Audience: "edgex",
+		Timeout:  "5s",
+	}, policy, logger.NewMockClient())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		clientId           string
+		clientSecret       string
+		basicAuth          bool
+		expectedStatusCode int
+	}{
+		{"Valid - basic authentication", "valid", "secret", true, http.StatusOK},
+		{"Valid - form parameters", "valid", "secret", false, http.StatusOK},
+		{"Invalid - no client credentials", "", "", false, http.StatusBadRequest},
+		{"Invalid - rejected client credentials", "valid", "wrong", true, http.StatusUnauthorized},
+		{"Invalid - token signed by another key", "otherKey", "secret", true, http.StatusBadGateway},
+		{"Invalid - token of another issuer", "otherIssuer", "secret", true, http.StatusBadGateway},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			form := url.Values{}
+			if !testCase.basicAuth {
+				form.Set("client_id", testCase.clientId)
+				form.Set("client_secret", testCase.clientSecret)
+			}
+			req, err := http.NewRequest(http.MethodPost, TokenRoute, strings.NewReader(form.Encode()))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if testCase.basicAuth {
+				req.SetBasicAuth(testCase.clientId, testCase.clientSecret)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(acquirer.tokenHandler)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			require.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res tokenResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				assert.Equal(t, clientTokens[testCase.clientId], res.AccessToken, "Access token not as expected")
+				assert.Equal(t, int64(3600), res.ExpiresIn, "Expiration not as expected")
+			}
+		})
+	}
+}
+
+func TestNewTokenAcquirer(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	policy, err := newJWTPolicy(config.JWTPolicyInfo{
+		Issuers: []config.IssuerInfo{{Issuer: testIssuer, KeysFile: testKeysFile(t, key)}},
+	}, logger.NewMockClient())
+	require.NoError(t, err)
+	valid := config.ClientCredentialsInfo{Issuer: testIssuer, TokenURL: "https://keycloak.example.com/token"}
+
+	unknownIssuer := valid
+	unknownIssuer.Issuer = testOtherIssuer
+	invalidURL := valid
+	invalidURL.TokenURL = "keycloak"
+	invalidTimeout := valid
+	invalidTimeout.Timeout = "ten seconds"
+
+	tests := []struct {
+		name          string
+		info          config.ClientCredentialsInfo
+		policy        *jwtPolicy
+		errorExpected bool
+	}{
+		{"Valid", valid, policy, false},
+		{"Invalid - JWT policy disabled", valid, nil, true},
+		{"Invalid - unknown issuer", unknownIssuer, policy, true},
+		{"Invalid - invalid token URL", invalidURL, policy, true},
+		{"Invalid - invalid timeout", invalidTimeout, policy, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := newTokenAcquirer(testCase.info, testCase.policy, logger.NewMockClient())
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
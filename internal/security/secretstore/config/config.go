@@ -27,6 +27,8 @@ type ConfigurationStruct struct {
 	SecretStore      SecretStoreInfo
 	Databases        map[string]Database
 	SecureMessageBus SecureMessageBusInfo
+	Rotation         RotationInfo
+	ServiceTLS       ServiceTLSInfo
 }
 
 type Database struct {
@@ -39,12 +41,78 @@ type SecureMessageBusInfo struct {
 	KuiperConfigPath      string
 	KuiperConnectionsPath string
 	Services              map[string]ServiceInfo
+	TopicAuthorization    TopicAuthorizationInfo
+}
+
+// TopicAuthorizationInfo configures the topics each service may publish and subscribe to on the mqtt message bus. Each
+// service is then given message bus credentials of its own, whose user is the service key.
+type TopicAuthorizationInfo struct {
+	Enabled bool
+	// MosquittoACLFile is the path of the generated Mosquitto ACL file, empty to not generate it
+	MosquittoACLFile string
+	// NATSAuthorizationFile is the path of the generated NATS server authorization block, empty to not generate it
+	NATSAuthorizationFile string
+	// Common permissions are granted to every user
+	Common TopicPermissions
+	// Default permissions are granted to the users without permissions of their own, such as the device services
+	// added with EDGEX_ADD_KNOWN_SECRETS
+	Default TopicPermissions
+	// Users maps the users, i.e. the service keys, to their permissions
+	Users map[string]TopicPermissions
+}
+
+// TopicPermissions lists the topic filters a user may publish and subscribe to, in which {user} is replaced by the user
+type TopicPermissions struct {
+	Publish   []string
+	Subscribe []string
 }
 
 type ServiceInfo struct {
 	Service string
 }
 
+// RotationInfo configures the periodic rotation of the secrets generated by the service, which keeps the service
+// running after the secret store setup
+type RotationInfo struct {
+	Enabled bool
+	// Interval between the rotations
+	Interval string
+	// Secrets to rotate: redisdb for the Redis credentials, service-tokens for the secret store tokens of the services
+	// and tls for the service TLS certificates
+	Secrets []string
+	// GracePeriod during which the previous Redis password remains valid, so that the services reload the new one
+	GracePeriod string
+	// RedisHost and RedisPort of the Redis server whose password is rotated
+	RedisHost string
+	RedisPort int
+	// ReloadCommand is run with ReloadCommandArgs and the name of the rotated secret, to signal the services to reload
+	// their secrets
+	ReloadCommand     string
+	ReloadCommandArgs []string
+	// AuditLogPath is the file the audit records of the rotations are appended to, as JSON lines
+	AuditLogPath string
+}
+
+// ServiceTLSInfo configures the issuance of the TLS certificates the services use for mutual TLS on their REST APIs,
+// from a local CA stored in the secret store
+type ServiceTLSInfo struct {
+	Enabled bool
+	// SecretName of the service secret the certificate, its key and the CA certificate are stored in
+	SecretName string
+	// CertificateTTL is the validity period of the service certificates, which are issued again by the rotation of
+	// the tls secret
+	CertificateTTL string
+	// CAValidity is the validity period of the CA, which is created again when it expires before a new certificate
+	CAValidity string
+	// Services maps the keys of the services the certificates are issued to, to their host names in addition to the
+	// service key and localhost
+	Services map[string]TLSServiceInfo
+}
+
+type TLSServiceInfo struct {
+	Hostnames []string
+}
+
 type SecretStoreInfo struct {
 	Type                        string
 	Protocol                    string
@@ -67,6 +135,24 @@ type SecretStoreInfo struct {
 	PasswordProviderArgs        []string
 	RevokeRootTokens            bool
 	ConsulSecretsAdminTokenPath string
+	// Namespace of an enterprise secret store the EdgeX secrets, policies and auth methods are created in
+	Namespace string
+	// External configures the use of a pre-existing secret store instead of the bundled one
+	External ExternalSecretStoreInfo
+}
+
+// ExternalSecretStoreInfo configures how the setup authenticates to a pre-existing secret store, which is neither
+// initialized nor unsealed by the setup
+type ExternalSecretStoreInfo struct {
+	Enabled bool
+	// AuthMethod is token to use the token of TokenFile, or approle to login with RoleId and the secret ID of
+	// SecretIdFile
+	AuthMethod string
+	// AuthMountPath is the path the AppRole auth method is mounted at
+	AuthMountPath string
+	TokenFile     string
+	RoleId        string
+	SecretIdFile  string
 }
 
 // GetBaseURL builds and returns the base URL for the SecretStore service
@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/token/fileioperformer"
+	"github.com/edgexfoundry/go-mod-secrets/v3/secrets"
+)
+
+const (
+	// ExternalAuthMethodToken authenticates to the external secret store with the token of a file
+	ExternalAuthMethodToken = "token"
+	// ExternalAuthMethodAppRole authenticates to the external secret store with the AppRole auth method
+	ExternalAuthMethodAppRole = "approle"
+)
+
+// PrivilegedTokenSource returns a privileged token of the secret store, and the function revoking it once no longer
+// needed
+type PrivilegedTokenSource func() (string, func(), error)
+
+// rootTokenSource regenerates a transient root token of the bundled secret store from the key shares
+func rootTokenSource(lc logger.LoggingClient, client secrets.SecretStoreClient, keyShares []string) PrivilegedTokenSource {
+	return func() (string, func(), error) {
+		rootToken, err := client.RegenRootToken(keyShares)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not regenerate root token: %w", err)
+		}
+		return rootToken, revokeTokenFunc(lc, client, rootToken, "transient root token"), nil
+	}
+}
+
+// externalTokenSource authenticates to the external secret store with the configured auth method
+func externalTokenSource(
+	lc logger.LoggingClient,
+	info config.ExternalSecretStoreInfo,
+	client secrets.SecretStoreClient,
+	httpCaller internal.HttpCaller,
+	fileOpener fileioperformer.FileIoPerformer,
+	baseURL string) (PrivilegedTokenSource, error) {
+
+	switch info.AuthMethod {
+	case ExternalAuthMethodToken:
+		return func() (string, func(), error) {
+			token, err := readSecretFile(fileOpener, info.TokenFile)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to read the external secret store token: %w", err)
+			}
+			// The token is owned by the operator of the external secret store, so it's never revoked
+			return token, func() {}, nil
+		}, nil
+	case ExternalAuthMethodAppRole:
+		if info.RoleId == "" || info.AuthMountPath == "" {
+			return nil, fmt.Errorf("the %s auth method requires a role ID and a mount path", info.AuthMethod)
+		}
+		loginURL := strings.TrimSuffix(baseURL, "/") + "/v1/auth/" + strings.Trim(info.AuthMountPath, "/") + "/login"
+		return func() (string, func(), error) {
+			secretId, err := readSecretFile(fileOpener, info.SecretIdFile)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to read the external secret store secret ID: %w", err)
+			}
+			token, err := appRoleLogin(httpCaller, loginURL, info.RoleId, secretId)
+			if err != nil {
+				return "", nil, err
+			}
+			return token, revokeTokenFunc(lc, client, token, "AppRole token"), nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported external secret store auth method '%s'", info.AuthMethod)
+	}
+}
+
+func revokeTokenFunc(lc logger.LoggingClient, client secrets.SecretStoreClient, token string, description string) func() {
+	return func() {
+		lc.Infof("revoking %s", description)
+		if err := client.RevokeToken(token); err != nil {
+			lc.Errorf("could not revoke %s: %s", description, err.Error())
+		}
+	}
+}
+
+func readSecretFile(fileOpener fileioperformer.FileIoPerformer, path string) (string, error) {
+	reader, err := fileOpener.OpenFileReader(path, os.O_RDONLY, 0400)
+	if err != nil {
+		return "", err
+	}
+	closeable := fileioperformer.MakeReadCloser(reader)
+	defer func() { _ = closeable.Close() }()
+
+	content, err := io.ReadAll(closeable)
+	if err != nil {
+		return "", err
+	}
+	secret := strings.TrimSpace(string(content))
+	if secret == "" {
+		return "", fmt.Errorf("%s is empty", path)
+	}
+	return secret, nil
+}
+
+// appRoleLogin logs in with the AppRole auth method and returns the client token
+func appRoleLogin(httpCaller internal.HttpCaller, loginURL string, roleId string, secretId string) (string, error) {
+	body, err := json.Marshal(map[string]string{"role_id": roleId, "secret_id": secretId})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodPost, loginURL, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("error creating http request: %w", err)
+	}
+	resp, err := httpCaller.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("AppRole login failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("AppRole login failed with status %s", resp.Status)
+	}
+
+	var login struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&login); err != nil {
+		return "", fmt.Errorf("failed to decode the AppRole login response: %w", err)
+	}
+	if login.Auth.ClientToken == "" {
+		return "", fmt.Errorf("AppRole login response without client token")
+	}
+	return login.Auth.ClientToken, nil
+}
@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/token/fileioperformer"
+	"github.com/edgexfoundry/go-mod-secrets/v3/secrets/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+)
+
+func TestExternalTokenSource(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("external-token\n"), 0600))
+	secretIdFile := filepath.Join(dir, "secret-id")
+	require.NoError(t, os.WriteFile(secretIdFile, []byte("secret-id"), 0600))
+	emptyFile := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyFile, nil, 0600))
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/auth/edge/approle/login", r.URL.Path)
+		var login map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&login))
+		if login["role_id"] != "edgex" || login["secret_id"] != "secret-id" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"auth": {"client_token": "approle-token"}}`))
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name          string
+		info          config.ExternalSecretStoreInfo
+		expectedToken string
+		revoked       bool
+		errorExpected bool
+	}{
+		{"Valid - token", config.ExternalSecretStoreInfo{AuthMethod: ExternalAuthMethodToken, TokenFile: tokenFile}, "external-token", false, false},
+		{"Valid - AppRole", config.ExternalSecretStoreInfo{AuthMethod: ExternalAuthMethodAppRole, AuthMountPath: "edge/approle", RoleId: "edgex", SecretIdFile: secretIdFile}, "approle-token", true, false},
+		{"Invalid - missing token file", config.ExternalSecretStoreInfo{AuthMethod: ExternalAuthMethodToken, TokenFile: filepath.Join(dir, "missing")}, "", false, true},
+		{"Invalid - empty token file", config.ExternalSecretStoreInfo{AuthMethod: ExternalAuthMethodToken, TokenFile: emptyFile}, "", false, true},
+		{"Invalid - AppRole login rejected", config.ExternalSecretStoreInfo{AuthMethod: ExternalAuthMethodAppRole, AuthMountPath: "edge/approle", RoleId: "other", SecretIdFile: secretIdFile}, "", false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			lc := logger.NewMockClient()
+			secretClient := &mocks.SecretStoreClient{}
+			secretClient.On("RevokeToken", testCase.expectedToken).Return(nil)
+			source, err := externalTokenSource(lc, testCase.info, secretClient, http.DefaultClient,
+				fileioperformer.NewDefaultFileIoPerformer(), ts.URL+"/")
+			require.NoError(t, err)
+
+			// Act
+			token, revoke, err := source()
+
+			// Assert
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedToken, token)
+			revoke()
+			if testCase.revoked {
+				secretClient.AssertCalled(t, "RevokeToken", testCase.expectedToken)
+			} else {
+				secretClient.AssertNotCalled(t, "RevokeToken", testCase.expectedToken)
+			}
+		})
+	}
+}
+
+func TestExternalTokenSourceInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		info config.ExternalSecretStoreInfo
+	}{
+		{"unsupported auth method", config.ExternalSecretStoreInfo{AuthMethod: "kubernetes"}},
+		{"AppRole without role ID", config.ExternalSecretStoreInfo{AuthMethod: ExternalAuthMethodAppRole, AuthMountPath: "approle"}},
+		{"AppRole without mount path", config.ExternalSecretStoreInfo{AuthMethod: ExternalAuthMethodAppRole, RoleId: "edgex"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := externalTokenSource(logger.NewMockClient(), testCase.info, &mocks.SecretStoreClient{},
+				http.DefaultClient, fileioperformer.NewDefaultFileIoPerformer(), "http://localhost:8200/")
+			assert.Error(t, err)
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/common"
 	"github.com/edgexfoundry/edgex-go/internal/security/kdf"
 	"github.com/edgexfoundry/edgex-go/internal/security/pipedhexreader"
 	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
@@ -79,7 +80,7 @@ func NewBootstrap(insecureSkipVerify bool, vaultInterval int) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the data service.
-func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	configuration := container.ConfigurationFrom(dic.Get)
 	secretStoreConfig := configuration.SecretStore
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -102,13 +103,15 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 		lc.Info("bypassing certificate verification for secret store connection")
 		httpCaller = pkg.NewRequester(lc).Insecure()
 	}
+	httpCaller = common.NewNamespaceCaller(httpCaller, secretStoreConfig.Namespace)
 
 	intervalDuration := time.Duration(b.vaultInterval) * time.Second
 	clientConfig := types.SecretConfig{
-		Type:     secretStoreConfig.Type,
-		Protocol: secretStoreConfig.Protocol,
-		Host:     secretStoreConfig.Host,
-		Port:     secretStoreConfig.Port,
+		Type:      secretStoreConfig.Type,
+		Protocol:  secretStoreConfig.Protocol,
+		Host:      secretStoreConfig.Host,
+		Port:      secretStoreConfig.Port,
+		Namespace: secretStoreConfig.Namespace,
 	}
 	client, err := secrets.NewSecretStoreClient(clientConfig, lc, httpCaller)
 	if err != nil {
@@ -136,9 +139,19 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 	}
 
 	var initResponse types.InitResponse // reused many places in below flow
+	var tokenSource PrivilegedTokenSource
+	if secretStoreConfig.External.Enabled {
+		lc.Infof("using external secret store %s", secretStoreConfig.GetBaseURL())
+		tokenSource, err = externalTokenSource(lc, secretStoreConfig.External, client, httpCaller, fileOpener,
+			secretStoreConfig.GetBaseURL())
+		if err != nil {
+			lc.Errorf("failed to configure the external secret store authentication: %s", err.Error())
+			return false
+		}
+	}
 
-	//step 3: initialize and unseal Vault
-	for shouldContinue := true; shouldContinue; {
+	//step 3: initialize and unseal Vault, an external secret store is already initialized and unsealed
+	for shouldContinue := !secretStoreConfig.External.Enabled; shouldContinue; {
 		// Anonymous function used to prevent file handles from accumulating
 		terminalFailure := func() bool {
 			sCode, _ := client.HealthCheck()
@@ -235,14 +248,16 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 	}
 
 	/* After vault is initialized and unsealed, it takes a while to get ready to accept any request. During which period any request will get http 500 error.
-	We need to check the status constantly until it return http StatusOK.
+	We need to check the status constantly until it return http StatusOK, or http StatusTooManyRequests for a standby
+	node of an external secret store.
 	*/
 	ticker := time.NewTicker(time.Second)
 	healthOkCh := make(chan struct{})
 	go func() {
 		for {
 			<-ticker.C
-			if sCode, _ := client.HealthCheck(); sCode == http.StatusOK {
+			sCode, _ := client.HealthCheck()
+			if sCode == http.StatusOK || (secretStoreConfig.External.Enabled && sCode == http.StatusTooManyRequests) {
 				close(healthOkCh)
 				ticker.Stop()
 				return
@@ -253,6 +268,10 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 	// Wait on a StatusOK response from client.HealthCheck()
 	<-healthOkCh
 
+	if !secretStoreConfig.External.Enabled {
+		tokenSource = rootTokenSource(lc, client, initResponse.Keys)
+	}
+
 	// create new root token
 	// defer revoke token
 	// optional: revoke other root token
@@ -263,25 +282,20 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 	// upload kong certificate
 	tokenMaintenance := NewTokenMaintenance(lc, client)
 
-	// Create a transient root token from the key shares
-	var rootToken string
-	rootToken, err = client.RegenRootToken(initResponse.Keys)
+	// Create a transient root token from the key shares, or a privileged token of the external secret store
+	rootToken, revokeRootToken, err := tokenSource()
 	if err != nil {
-		lc.Errorf("could not regenerate root token %s", err.Error())
+		lc.Errorf("could not obtain a privileged token: %s", err.Error())
 		return false
 	}
-	defer func() {
-		// Revoke transient root token at the end of this function
-		lc.Info("revoking temporary root token")
-		err := client.RevokeToken(rootToken)
-		if err != nil {
-			lc.Errorf("could not revoke temporary root token %s", err.Error())
-		}
-	}()
+	// Revoke transient root token at the end of this function
+	defer revokeRootToken()
 	lc.Info("generated transient root token")
 
-	// Revoke the other root tokens
-	if secretStoreConfig.RevokeRootTokens {
+	// Revoke the other root tokens, which are never owned by EdgeX in an external secret store
+	if secretStoreConfig.External.Enabled {
+		lc.Info("not revoking root tokens of the external secret store")
+	} else if secretStoreConfig.RevokeRootTokens {
 		if initResponse.RootToken != "" {
 			initResponse.RootToken = ""
 			if err := saveInitResponse(lc, fileOpener, secretStoreConfig, &initResponse); err != nil {
@@ -298,8 +312,15 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 		lc.Info("not revoking existing root tokens")
 	}
 
-	// Revoke non-root tokens from previous runs
-	if err := tokenMaintenance.RevokeNonRootTokens(rootToken); err != nil {
+	// Revoke non-root tokens from previous runs, only the service tokens in an external secret store as the others
+	// aren't owned by EdgeX
+	if secretStoreConfig.External.Enabled {
+		if accessors, err := tokenMaintenance.ServiceTokenAccessors(rootToken); err != nil {
+			lc.Warnf("failed to list service tokens: %s", err.Error())
+		} else if err := tokenMaintenance.RevokeTokenAccessors(rootToken, accessors); err != nil {
+			lc.Warnf("failed to revoke service tokens: %s", err.Error())
+		}
+	} else if err := tokenMaintenance.RevokeNonRootTokens(rootToken); err != nil {
 		lc.Warn("failed to revoke non-root tokens")
 	}
 	lc.Info("completed cleanup of old admin/service tokens")
@@ -449,6 +470,7 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 
 	// for secure message bus creds
 	var msgBusCredentials UserPasswordPair
+	var topicAuthorizer *TopicAuthorizer
 	if configuration.SecureMessageBus.Type != redisSecureMessageBusType &&
 		configuration.SecureMessageBus.Type != noneSecureMessageBusType &&
 		configuration.SecureMessageBus.Type != blankSecureMessageBusType {
@@ -474,11 +496,23 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 			lc.Infof("%s bus credentials already exist, skipping generating new password", configuration.SecureMessageBus.Type)
 		}
 
+		if configuration.SecureMessageBus.TopicAuthorization.Enabled {
+			if configuration.SecureMessageBus.Type != mqttSecureMessageBusType {
+				lc.Errorf("topic authorization isn't supported by the '%s' message bus", configuration.SecureMessageBus.Type)
+				return false
+			}
+			topicAuthorizer = NewTopicAuthorizer(lc, configuration.SecureMessageBus.TopicAuthorization, secretStore, msgBusCredentials)
+		}
+
 		lc.Infof("adding any additional services using %s for knownSecrets...", messagebusSecretName)
 		services, ok := knownSecretsToAdd[messagebusSecretName]
 		if ok {
 			for _, service := range services {
-				err = addServiceCredential(lc, messagebusSecretName, secretStore, service, msgBusCredentials)
+				if topicAuthorizer != nil {
+					err = topicAuthorizer.AddService(ctx, service)
+				} else {
+					err = addServiceCredential(lc, messagebusSecretName, secretStore, service, msgBusCredentials)
+				}
 				if err != nil {
 					lc.Error(err.Error())
 					return false
@@ -516,7 +550,11 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 
 			// add credentials to service path if specified and they're not already there
 			if len(service) != 0 {
-				err = addServiceCredential(lc, secretName, secretStore, service, creds)
+				if topicAuthorizer != nil {
+					err = topicAuthorizer.AddService(ctx, service)
+				} else {
+					err = addServiceCredential(lc, secretName, secretStore, service, creds)
+				}
 				if err != nil {
 					lc.Error(err.Error())
 					return false
@@ -525,6 +563,13 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 		}
 	}
 
+	if topicAuthorizer != nil {
+		if err = topicAuthorizer.ConfigureBrokers(); err != nil {
+			lc.Errorf("failed to configure the message bus topic authorization: %s", err.Error())
+			return false
+		}
+	}
+
 	err = ConfigureSecureMessageBus(configuration.SecureMessageBus, creds, lc)
 	if err != nil {
 		lc.Errorf("failed to configure for Secure Message Bus: %s", err.Error())
@@ -591,7 +636,39 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 		return false
 	}
 
+	// issue the TLS certificates the services use for mutual TLS on their REST APIs
+	var tlsIssuer *TLSIssuer
+	if configuration.ServiceTLS.Enabled {
+		tlsIssuer, err = NewTLSIssuer(lc, configuration.ServiceTLS, httpCaller, secretStoreConfig.GetBaseURL())
+		if err != nil {
+			lc.Errorf("failed to configure the service TLS certificate issuance: %s", err.Error())
+			return false
+		}
+		if err = tlsIssuer.IssueAll(rootToken); err != nil {
+			lc.Errorf("failed to issue the service TLS certificates: %s", err.Error())
+			return false
+		}
+	}
+
 	lc.Info("Vault init done successfully")
+
+	if configuration.Rotation.Enabled {
+		var rotationTokenProvider *TokenProvider
+		if secretStoreConfig.TokenProvider != "" {
+			rotationTokenProvider = tokenProvider
+		}
+		rotator, err := NewSecretRotator(lc, configuration.Rotation, secretStoreConfig, client, httpCaller, gen,
+			rotationTokenProvider, tlsIssuer, fileOpener, NewDefaultExecRunner(),
+			NewRedisACL(configuration.Rotation.RedisHost, configuration.Rotation.RedisPort),
+			tokenSource, redisServicesFromConfiguration(configuration, knownSecretsToAdd))
+		if err != nil {
+			lc.Errorf("failed to configure secret rotation: %s", err.Error())
+			return false
+		}
+		wg.Add(1)
+		go rotator.Run(ctx, wg)
+	}
+
 	return true
 
 }
@@ -66,7 +66,7 @@ func Main(ctx context.Context, cancel context.CancelFunc) {
 		},
 	})
 
-	_, _, success := bootstrap.RunAndReturnWaitGroup(
+	wg, _, success := bootstrap.RunAndReturnWaitGroup(
 		ctx,
 		cancel,
 		f,
@@ -86,4 +86,9 @@ func Main(ctx context.Context, cancel context.CancelFunc) {
 	if !success {
 		os.Exit(1)
 	}
+
+	// The secret rotation keeps running in the background until the service is stopped
+	if configuration.Rotation.Enabled {
+		wg.Wait()
+	}
 }
@@ -0,0 +1,381 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/tokenfilewriter"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg/token/fileioperformer"
+	"github.com/edgexfoundry/go-mod-secrets/v3/secrets"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// RotationSecretRedis rotates the password of the Redis default user shared by the services
+	RotationSecretRedis = redisSecretName
+	// RotationSecretServiceTokens rotates the secret store tokens of the services
+	RotationSecretServiceTokens = "service-tokens"
+	// RotationSecretTLS issues new TLS certificates to the services
+	RotationSecretTLS = "tls"
+
+	redisBootstrapperServiceKey = "security-bootstrapper-redis"
+)
+
+// RedisACL updates the passwords of a Redis user, which may have several valid passwords at the same time
+type RedisACL interface {
+	// AddPassword adds the new password of the user, authenticating with its current password
+	AddPassword(user string, currentPassword string, newPassword string) error
+	// RemovePassword removes the old password of the user, authenticating with its current password
+	RemovePassword(user string, currentPassword string, oldPassword string) error
+}
+
+type redisACL struct {
+	address string
+}
+
+// NewRedisACL creates a RedisACL updating the users of the Redis server at host:port
+func NewRedisACL(host string, port int) RedisACL {
+	return redisACL{address: fmt.Sprintf("%s:%d", host, port)}
+}
+
+func (r redisACL) AddPassword(user string, currentPassword string, newPassword string) error {
+	return r.setUser(user, currentPassword, ">"+newPassword)
+}
+
+func (r redisACL) RemovePassword(user string, currentPassword string, oldPassword string) error {
+	return r.setUser(user, currentPassword, "<"+oldPassword)
+}
+
+func (r redisACL) setUser(user string, password string, rule string) error {
+	conn, err := redis.Dial("tcp", r.address, redis.DialUsername(user), redis.DialPassword(password))
+	if err != nil {
+		return fmt.Errorf("failed to connect to Redis at %s: %w", r.address, err)
+	}
+	defer conn.Close()
+	if _, err = conn.Do("ACL", "SETUSER", user, rule); err != nil {
+		return fmt.Errorf("failed to update the ACL of Redis user %s: %w", user, err)
+	}
+	return nil
+}
+
+// rotationAuditRecord records the outcome of the rotation of a secret in the audit log
+type rotationAuditRecord struct {
+	Time     string   `json:"time"`
+	Secret   string   `json:"secret"`
+	Services []string `json:"services,omitempty"`
+	Status   string   `json:"status"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// SecretRotator periodically regenerates the secrets created by the secret store setup, distributes them through the
+// secret store and signals the services to reload them
+type SecretRotator struct {
+	lc               logger.LoggingClient
+	info             config.RotationInfo
+	secretStoreInfo  config.SecretStoreInfo
+	client           secrets.SecretStoreClient
+	httpCaller       internal.HttpCaller
+	generator        CredentialGenerator
+	tokenMaintenance *TokenMaintenance
+	tokenProvider    *TokenProvider
+	tlsIssuer        *TLSIssuer
+	fileOpener       fileioperformer.FileIoPerformer
+	execRunner       ExecRunner
+	redisACL         RedisACL
+	// tokenSource provides the transient privileged token of each rotation
+	tokenSource PrivilegedTokenSource
+	// redisServices are the services the Redis credentials are distributed to
+	redisServices []string
+	interval      time.Duration
+	gracePeriod   time.Duration
+	auditMutex    sync.Mutex
+}
+
+// NewSecretRotator creates a SecretRotator for the rotation configuration
+func NewSecretRotator(
+	lc logger.LoggingClient,
+	info config.RotationInfo,
+	secretStoreInfo config.SecretStoreInfo,
+	client secrets.SecretStoreClient,
+	httpCaller internal.HttpCaller,
+	generator CredentialGenerator,
+	tokenProvider *TokenProvider,
+	tlsIssuer *TLSIssuer,
+	fileOpener fileioperformer.FileIoPerformer,
+	execRunner ExecRunner,
+	redisACL RedisACL,
+	tokenSource PrivilegedTokenSource,
+	redisServices []string) (*SecretRotator, error) {
+
+	interval, err := time.ParseDuration(info.Interval)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid rotation interval '%s'", info.Interval)
+	}
+	gracePeriod, err := time.ParseDuration(info.GracePeriod)
+	if err != nil || gracePeriod < 0 || gracePeriod >= interval {
+		return nil, fmt.Errorf("invalid rotation grace period '%s', which must be shorter than the interval", info.GracePeriod)
+	}
+	for _, secret := range info.Secrets {
+		switch secret {
+		case RotationSecretRedis:
+		case RotationSecretServiceTokens:
+			if tokenProvider == nil {
+				return nil, fmt.Errorf("the %s rotation requires a token provider", secret)
+			}
+		case RotationSecretTLS:
+			if tlsIssuer == nil {
+				return nil, fmt.Errorf("the %s rotation requires the service TLS to be enabled", secret)
+			}
+		default:
+			return nil, fmt.Errorf("rotation of secret '%s' is not supported", secret)
+		}
+	}
+
+	return &SecretRotator{
+		lc:               lc,
+		info:             info,
+		secretStoreInfo:  secretStoreInfo,
+		client:           client,
+		httpCaller:       httpCaller,
+		generator:        generator,
+		tokenMaintenance: NewTokenMaintenance(lc, client),
+		tokenProvider:    tokenProvider,
+		tlsIssuer:        tlsIssuer,
+		fileOpener:       fileOpener,
+		execRunner:       execRunner,
+		redisACL:         redisACL,
+		tokenSource:      tokenSource,
+		redisServices:    redisServices,
+		interval:         interval,
+		gracePeriod:      gracePeriod,
+	}, nil
+}
+
+// Run rotates the secrets every interval until the context is done
+func (r *SecretRotator) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	r.lc.Infof("rotating secrets %v every %s", r.info.Secrets, r.interval)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			r.lc.Info("exiting secret rotation")
+			return
+		case <-ticker.C:
+			r.RotateAll(ctx, wg)
+		}
+	}
+}
+
+// RotateAll rotates each configured secret with a transient privileged token, the previous Redis password is removed once
+// the grace period has elapsed in the background
+func (r *SecretRotator) RotateAll(ctx context.Context, wg *sync.WaitGroup) {
+	rootToken, revokeRootToken, err := r.tokenSource()
+	if err != nil {
+		r.lc.Errorf("could not obtain a privileged token for the secret rotation: %s", err.Error())
+		for _, secret := range r.info.Secrets {
+			r.audit(secret, nil, err)
+		}
+		return
+	}
+	defer revokeRootToken()
+
+	for _, secret := range r.info.Secrets {
+		switch secret {
+		case RotationSecretRedis:
+			oldPair, newPair, err := r.rotateRedis(ctx, rootToken)
+			r.audit(secret, r.redisServices, err)
+			if err != nil {
+				continue
+			}
+			r.reload(ctx, secret)
+			wg.Add(1)
+			go r.removeRedisPasswordAfterGracePeriod(ctx, wg, oldPair, newPair)
+		case RotationSecretServiceTokens:
+			err := r.rotateServiceTokens(rootToken)
+			r.audit(secret, nil, err)
+			if err == nil {
+				r.reload(ctx, secret)
+			}
+		case RotationSecretTLS:
+			err := r.tlsIssuer.IssueAll(rootToken)
+			r.audit(secret, r.tlsIssuer.Services(), err)
+			if err == nil {
+				r.reload(ctx, secret)
+			}
+		}
+	}
+}
+
+// rotateRedis generates a new Redis password, adds it to the Redis default user and distributes it to the services,
+// it returns the previous and new credentials
+func (r *SecretRotator) rotateRedis(ctx context.Context, rootToken string) (UserPasswordPair, UserPasswordPair, error) {
+	secretStore := NewCred(r.httpCaller, rootToken, r.generator, r.secretStoreInfo.GetBaseURL(), r.lc)
+	oldPair, err := getCredential(redisBootstrapperServiceKey, secretStore, redisSecretName)
+	if err != nil {
+		return UserPasswordPair{}, UserPasswordPair{}, fmt.Errorf("failed to read the current Redis credentials: %w", err)
+	}
+	password, err := secretStore.GeneratePassword(ctx)
+	if err != nil {
+		return UserPasswordPair{}, UserPasswordPair{}, fmt.Errorf("failed to generate the Redis password: %w", err)
+	}
+	newPair := UserPasswordPair{User: oldPair.User, Password: password}
+
+	// Both passwords are valid until the end of the grace period, so that the services keep working until they reload
+	if err = r.redisACL.AddPassword(oldPair.User, oldPair.Password, newPair.Password); err != nil {
+		return UserPasswordPair{}, UserPasswordPair{}, err
+	}
+	for _, service := range append([]string{redisBootstrapperServiceKey}, r.redisServices...) {
+		path := fmt.Sprintf("%s/%s/%s", secretBasePath, service, redisSecretName)
+		if err = secretStore.UploadToStore(&newPair, path); err != nil {
+			return UserPasswordPair{}, UserPasswordPair{}, fmt.Errorf("failed to distribute the Redis credentials to %s: %w", service, err)
+		}
+	}
+	r.lc.Infof("Redis password rotated for %d service(s)", len(r.redisServices))
+	return oldPair, newPair, nil
+}
+
+// removeRedisPasswordAfterGracePeriod removes the previous Redis password once the grace period has elapsed, or
+// immediately when the context is done so that it doesn't remain valid
+func (r *SecretRotator) removeRedisPasswordAfterGracePeriod(ctx context.Context, wg *sync.WaitGroup, oldPair UserPasswordPair, newPair UserPasswordPair) {
+	defer wg.Done()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(r.gracePeriod):
+	}
+	if err := r.redisACL.RemovePassword(newPair.User, newPair.Password, oldPair.Password); err != nil {
+		r.lc.Errorf("failed to remove the previous Redis password: %s", err.Error())
+		r.audit(RotationSecretRedis+"-grace-period", nil, err)
+		return
+	}
+	r.lc.Info("previous Redis password removed at the end of the grace period")
+}
+
+// rotateServiceTokens launches the token provider to issue new tokens to the services, and revokes their previous
+// tokens
+func (r *SecretRotator) rotateServiceTokens(rootToken string) error {
+	previousAccessors, err := r.tokenMaintenance.ServiceTokenAccessors(rootToken)
+	if err != nil {
+		return fmt.Errorf("failed to list the service tokens: %w", err)
+	}
+
+	if r.secretStoreInfo.TokenProviderAdminTokenPath != "" {
+		revokeIssuingToken, err := tokenfilewriter.NewWriter(r.lc, r.client, r.fileOpener).
+			CreateAndWrite(rootToken, r.secretStoreInfo.TokenProviderAdminTokenPath, r.tokenMaintenance.CreateTokenIssuingToken)
+		if err != nil {
+			return fmt.Errorf("failed to create token issuing token: %w", err)
+		}
+		if r.secretStoreInfo.TokenProviderType == OneShotProvider {
+			defer revokeIssuingToken()
+		}
+	}
+	if err = r.tokenProvider.Launch(); err != nil {
+		return fmt.Errorf("token provider failed: %w", err)
+	}
+
+	if err = r.tokenMaintenance.RevokeTokenAccessors(rootToken, previousAccessors); err != nil {
+		return fmt.Errorf("failed to revoke the previous service tokens: %w", err)
+	}
+	r.lc.Infof("service tokens rotated, %d previous token(s) revoked", len(previousAccessors))
+	return nil
+}
+
+// reload runs the reload command with the name of the rotated secret, to signal the services to reload their secrets
+func (r *SecretRotator) reload(ctx context.Context, secret string) {
+	if r.info.ReloadCommand == "" {
+		return
+	}
+	args := append(append([]string{}, r.info.ReloadCommandArgs...), secret)
+	cmd := r.execRunner.CommandContext(ctx, r.info.ReloadCommand, args...)
+	err := cmd.Start()
+	if err == nil {
+		err = cmd.Wait()
+	}
+	if err != nil {
+		r.lc.Errorf("reload command %s failed after the rotation of %s: %s", r.info.ReloadCommand, secret, err.Error())
+		r.audit(secret+"-reload", nil, err)
+	}
+}
+
+// audit logs the outcome of the rotation of the secret and appends its record to the audit log
+func (r *SecretRotator) audit(secret string, services []string, rotationErr error) {
+	record := rotationAuditRecord{
+		Time:     time.Now().UTC().Format(time.RFC3339),
+		Secret:   secret,
+		Services: services,
+		Status:   "SUCCEEDED",
+	}
+	if rotationErr != nil {
+		record.Status = "FAILED"
+		record.Error = rotationErr.Error()
+		r.lc.Errorf("AUDIT: rotation of %s failed: %s", secret, rotationErr.Error())
+	} else {
+		r.lc.Infof("AUDIT: rotation of %s succeeded", secret)
+	}
+
+	if r.info.AuditLogPath == "" {
+		return
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		r.lc.Errorf("failed to encode the rotation audit record: %s", err.Error())
+		return
+	}
+	r.auditMutex.Lock()
+	defer r.auditMutex.Unlock()
+	if err = appendLine(r.info.AuditLogPath, line); err != nil {
+		r.lc.Errorf("failed to write the rotation audit log %s: %s", r.info.AuditLogPath, err.Error())
+	}
+}
+
+func appendLine(path string, line []byte) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(line, '\n'))
+	return errors.Join(err, file.Close())
+}
+
+// redisServicesFromConfiguration returns the services the Redis credentials are distributed to by the setup
+func redisServicesFromConfiguration(configuration *config.ConfigurationStruct, knownSecretsToAdd map[string][]string) []string {
+	var services []string
+	seen := make(map[string]bool)
+	add := func(service string) {
+		if len(service) != 0 && !seen[service] {
+			seen[service] = true
+			services = append(services, service)
+		}
+	}
+	for _, service := range knownSecretsToAdd[redisSecretName] {
+		add(service)
+	}
+	for _, info := range configuration.Databases {
+		add(info.Service)
+	}
+	if configuration.SecureMessageBus.Type == redisSecureMessageBusType {
+		for _, info := range configuration.SecureMessageBus.Services {
+			add(info.Service)
+		}
+	}
+	return services
+}
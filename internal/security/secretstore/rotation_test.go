@@ -0,0 +1,203 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-secrets/v3/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v3/secrets/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+)
+
+type mockRedisACL struct {
+	mock.Mock
+}
+
+func (m *mockRedisACL) AddPassword(user string, currentPassword string, newPassword string) error {
+	arguments := m.Called(user, currentPassword, newPassword)
+	return arguments.Error(0)
+}
+
+func (m *mockRedisACL) RemovePassword(user string, currentPassword string, oldPassword string) error {
+	arguments := m.Called(user, currentPassword, oldPassword)
+	return arguments.Error(0)
+}
+
+// testVault serves the credentials of the secret store from memory
+func testVault(t *testing.T, stored map[string]UserPasswordPair) (*httptest.Server, config.SecretStoreInfo) {
+	var mutex sync.Mutex
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			pair, ok := stored[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(CredCollect{Pair: pair})
+		case http.MethodPost:
+			var pair UserPasswordPair
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&pair))
+			stored[r.URL.Path] = pair
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	parsed, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(parsed.Port())
+	require.NoError(t, err)
+	return ts, config.SecretStoreInfo{Protocol: "http", Host: parsed.Hostname(), Port: port}
+}
+
+func TestRotateAllRedis(t *testing.T) {
+	oldPair := UserPasswordPair{User: "default", Password: "old-password"}
+	bootstrapperPath := secretBasePath + "/" + redisBootstrapperServiceKey + "/" + redisSecretName
+	services := []string{"core-data", "core-metadata"}
+
+	tests := []struct {
+		name           string
+		addPasswordErr error
+		expectedStatus string
+	}{
+		{"Valid", nil, "SUCCEEDED"},
+		{"Invalid - Redis ACL update failed", errors.New("connection refused"), "FAILED"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			stored := map[string]UserPasswordPair{bootstrapperPath: oldPair}
+			ts, secretStoreInfo := testVault(t, stored)
+			defer ts.Close()
+
+			secretClient := &mocks.SecretStoreClient{}
+			secretClient.On("RegenRootToken", []string{"key"}).Return("root-token", nil)
+			secretClient.On("RevokeToken", "root-token").Return(nil)
+			acl := &mockRedisACL{}
+			acl.On("AddPassword", "default", "old-password", mock.Anything).Return(testCase.addPasswordErr)
+			acl.On("RemovePassword", "default", mock.Anything, "old-password").Return(nil)
+			cmd := &mockCmd{}
+			cmd.On("Start").Return(nil)
+			cmd.On("Wait").Return(nil)
+			execRunner := &mockExecRunner{}
+			execRunner.On("CommandContext", mock.Anything, "/reload.sh", []string{"--all", redisSecretName}).Return(cmd)
+
+			auditLogPath := filepath.Join(t.TempDir(), "rotation-audit.log")
+			info := config.RotationInfo{
+				Enabled:           true,
+				Interval:          "1h",
+				Secrets:           []string{RotationSecretRedis},
+				GracePeriod:       "0s",
+				ReloadCommand:     "/reload.sh",
+				ReloadCommandArgs: []string{"--all"},
+				AuditLogPath:      auditLogPath,
+			}
+			lc := logger.NewMockClient()
+			rotator, err := NewSecretRotator(lc, info, secretStoreInfo, secretClient, pkg.NewRequester(lc).Insecure(),
+				NewPasswordGenerator(lc, "", nil), nil, nil, nil, execRunner, acl, rootTokenSource(lc, secretClient, []string{"key"}), services)
+			require.NoError(t, err)
+
+			// Act
+			var wg sync.WaitGroup
+			rotator.RotateAll(context.Background(), &wg)
+			wg.Wait()
+
+			// Assert
+			secretClient.AssertExpectations(t)
+			auditLog, err := os.ReadFile(auditLogPath)
+			require.NoError(t, err)
+			var record rotationAuditRecord
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(string(auditLog))), &record))
+			assert.Equal(t, RotationSecretRedis, record.Secret)
+			assert.Equal(t, testCase.expectedStatus, record.Status)
+
+			if testCase.addPasswordErr != nil {
+				assert.Equal(t, map[string]UserPasswordPair{bootstrapperPath: oldPair}, stored, "Credentials distributed despite the failure")
+				execRunner.AssertNotCalled(t, "CommandContext", mock.Anything, mock.Anything, mock.Anything)
+				acl.AssertNotCalled(t, "RemovePassword", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			newPair := stored[bootstrapperPath]
+			assert.Equal(t, "default", newPair.User)
+			assert.NotEqual(t, oldPair.Password, newPair.Password, "Redis password not rotated")
+			for _, service := range services {
+				assert.Equal(t, newPair, stored[secretBasePath+"/"+service+"/"+redisSecretName], "Credentials not distributed to %s", service)
+			}
+			acl.AssertCalled(t, "AddPassword", "default", "old-password", newPair.Password)
+			acl.AssertCalled(t, "RemovePassword", "default", newPair.Password, "old-password")
+			execRunner.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewSecretRotator(t *testing.T) {
+	valid := config.RotationInfo{
+		Interval:    "720h",
+		GracePeriod: "10m",
+		Secrets:     []string{RotationSecretRedis, RotationSecretServiceTokens},
+	}
+	invalidInterval := valid
+	invalidInterval.Interval = "monthly"
+	zeroInterval := valid
+	zeroInterval.Interval = "0s"
+	invalidGracePeriod := valid
+	invalidGracePeriod.GracePeriod = "ten minutes"
+	longGracePeriod := valid
+	longGracePeriod.GracePeriod = "720h"
+	unsupportedSecret := valid
+	unsupportedSecret.Secrets = []string{"postgres"}
+	tlsSecret := valid
+	tlsSecret.Secrets = []string{RotationSecretTLS}
+
+	lc := logger.NewMockClient()
+	tokenProvider := NewTokenProvider(context.Background(), lc, &mockExecRunner{})
+	tests := []struct {
+		name          string
+		info          config.RotationInfo
+		tokenProvider *TokenProvider
+		errorExpected bool
+	}{
+		{"Valid", valid, tokenProvider, false},
+		{"Invalid - invalid interval", invalidInterval, tokenProvider, true},
+		{"Invalid - zero interval", zeroInterval, tokenProvider, true},
+		{"Invalid - invalid grace period", invalidGracePeriod, tokenProvider, true},
+		{"Invalid - grace period not shorter than the interval", longGracePeriod, tokenProvider, true},
+		{"Invalid - unsupported secret", unsupportedSecret, tokenProvider, true},
+		{"Invalid - service tokens without token provider", valid, nil, true},
+		{"Invalid - tls without service TLS", tlsSecret, tokenProvider, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewSecretRotator(lc, testCase.info, config.SecretStoreInfo{}, &mocks.SecretStoreClient{}, nil,
+				nil, testCase.tokenProvider, nil, nil, &mockExecRunner{}, &mockRedisACL{}, nil, nil)
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
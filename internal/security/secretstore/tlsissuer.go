@@ -0,0 +1,277 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/messaging"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+)
+
+const (
+	// serviceCAPath is where the CA issuing the service certificates is stored in the secret store
+	serviceCAPath   = secretBasePath + "/security-ca/ca"
+	serviceCACN     = "EdgeX Foundry Service CA"
+	caCertKey       = "cacert"
+	caKeyKey        = "cakey"
+	clockSkew       = 5 * time.Minute
+	serialNumberLen = 128
+)
+
+// TLSIssuer issues the TLS certificates of the services from a local CA stored in the secret store, and stores each
+// certificate with its key and the CA certificate in the secret store of the service
+type TLSIssuer struct {
+	lc             logger.LoggingClient
+	info           config.ServiceTLSInfo
+	httpCaller     internal.HttpCaller
+	baseURL        string
+	certificateTTL time.Duration
+	caValidity     time.Duration
+}
+
+// NewTLSIssuer creates a TLSIssuer for the service TLS configuration
+func NewTLSIssuer(lc logger.LoggingClient, info config.ServiceTLSInfo, httpCaller internal.HttpCaller, baseURL string) (*TLSIssuer, error) {
+	certificateTTL, err := time.ParseDuration(info.CertificateTTL)
+	if err != nil || certificateTTL <= 0 {
+		return nil, fmt.Errorf("invalid service TLS certificate TTL '%s'", info.CertificateTTL)
+	}
+	caValidity, err := time.ParseDuration(info.CAValidity)
+	if err != nil || caValidity <= certificateTTL {
+		return nil, fmt.Errorf("invalid service TLS CA validity '%s', which must be longer than the certificate TTL", info.CAValidity)
+	}
+	if info.SecretName == "" {
+		return nil, errors.New("the service TLS secret name is required")
+	}
+	return &TLSIssuer{
+		lc:             lc,
+		info:           info,
+		httpCaller:     httpCaller,
+		baseURL:        baseURL,
+		certificateTTL: certificateTTL,
+		caValidity:     caValidity,
+	}, nil
+}
+
+// Services returns the keys of the services the certificates are issued to
+func (i *TLSIssuer) Services() []string {
+	services := make([]string, 0, len(i.info.Services))
+	for service := range i.info.Services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// IssueAll issues a new certificate to each service, creating the CA when it doesn't exist or expires before the
+// certificates
+func (i *TLSIssuer) IssueAll(rootToken string) error {
+	caCert, caKey, caPEM, err := i.loadOrCreateCA(rootToken)
+	if err != nil {
+		return err
+	}
+	for _, service := range i.Services() {
+		certPEM, keyPEM, err := i.issue(caCert, caKey, service, i.info.Services[service].Hostnames)
+		if err != nil {
+			return fmt.Errorf("failed to issue the TLS certificate of %s: %w", service, err)
+		}
+		secret := map[string]string{
+			messaging.SecretClientCert: string(certPEM),
+			messaging.SecretClientKey:  string(keyPEM),
+			messaging.SecretCACert:     string(caPEM),
+		}
+		path := fmt.Sprintf("%s/%s/%s", secretBasePath, service, i.info.SecretName)
+		if err = i.writeSecret(rootToken, path, secret); err != nil {
+			return fmt.Errorf("failed to store the TLS certificate of %s: %w", service, err)
+		}
+		i.lc.Infof("TLS certificate issued to %s, valid for %s", service, i.certificateTTL)
+	}
+	return nil
+}
+
+// loadOrCreateCA returns the CA stored in the secret store, or a new CA when there is none or it expires before the
+// certificates it would issue
+func (i *TLSIssuer) loadOrCreateCA(rootToken string) (*x509.Certificate, *ecdsa.PrivateKey, []byte, error) {
+	secret, err := i.readSecret(rootToken, serviceCAPath)
+	if err != nil && err != errNotFound {
+		return nil, nil, nil, fmt.Errorf("failed to read the service CA: %w", err)
+	}
+	if err == nil {
+		caCert, caKey, err := parseCA(secret)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse the service CA: %w", err)
+		}
+		if time.Now().Add(i.certificateTTL).Before(caCert.NotAfter) {
+			return caCert, caKey, []byte(secret[caCertKey]), nil
+		}
+		i.lc.Warnf("service CA expires at %s, creating a new one", caCert.NotAfter)
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: serviceCACN},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              now.Add(i.caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caCert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	secret = map[string]string{
+		caCertKey: string(caPEM),
+		caKeyKey:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+	if err = i.writeSecret(rootToken, serviceCAPath, secret); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to store the service CA: %w", err)
+	}
+	i.lc.Infof("service CA created, valid until %s", caCert.NotAfter)
+	return caCert, caKey, caPEM, nil
+}
+
+// issue issues a certificate for both server and client authentication of the service
+func (i *TLSIssuer) issue(caCert *x509.Certificate, caKey *ecdsa.PrivateKey, service string, hostnames []string) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: service},
+		NotBefore:    now.Add(-clockSkew),
+		NotAfter:     now.Add(i.certificateTTL),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     append([]string{service, "localhost"}, hostnames...),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+func parseCA(secret map[string]string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode([]byte(secret[caCertKey]))
+	keyBlock, _ := pem.Decode([]byte(secret[caKeyKey]))
+	if certBlock == nil || keyBlock == nil {
+		return nil, nil, errors.New("missing PEM block of the CA certificate or key")
+	}
+	caCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	caKey, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return caCert, caKey, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberLen))
+}
+
+// readSecret reads the secret at the path of the KV secrets engine, errNotFound is returned when it doesn't exist
+func (i *TLSIssuer) readSecret(rootToken string, path string) (map[string]string, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(i.baseURL, "/")+path, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating http request: %w", err)
+	}
+	req.Header.Set(VaultToken, rootToken)
+	resp, err := i.httpCaller.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errNotFound
+	default:
+		return nil, fmt.Errorf("failed to read secret %s with status %s", path, resp.Status)
+	}
+	var secret struct {
+		Data map[string]string `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("failed to decode secret %s: %w", path, err)
+	}
+	return secret.Data, nil
+}
+
+// writeSecret writes the secret at the path of the KV secrets engine, replacing the existing one
+func (i *TLSIssuer) writeSecret(rootToken string, path string, secret map[string]string) error {
+	body, err := json.Marshal(secret)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(i.baseURL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating http request: %w", err)
+	}
+	req.Header.Set(VaultToken, rootToken)
+	resp, err := i.httpCaller.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to write secret %s with status %s", path, resp.Status)
+	}
+	return nil
+}
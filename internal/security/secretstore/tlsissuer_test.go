@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+)
+
+// testKVStore serves the secrets of the KV secrets engine from memory
+func testKVStore(t *testing.T, stored map[string]map[string]string) *httptest.Server {
+	var mutex sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		assert.Equal(t, "root-token", r.Header.Get(VaultToken))
+		switch r.Method {
+		case http.MethodGet:
+			secret, ok := stored[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": secret})
+		case http.MethodPost:
+			var secret map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&secret))
+			stored[r.URL.Path] = secret
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+}
+
+func parseTestCertificate(t *testing.T, certPEM string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(certPEM))
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestTLSIssuerIssueAll(t *testing.T) {
+	stored := map[string]map[string]string{}
+	ts := testKVStore(t, stored)
+	defer ts.Close()
+
+	info := config.ServiceTLSInfo{
+		Enabled:        true,
+		SecretName:     "tls",
+		CertificateTTL: "72h",
+		CAValidity:     "8760h",
+		Services: map[string]config.TLSServiceInfo{
+			"core-data":     {Hostnames: []string{"edgex-core-data"}},
+			"core-metadata": {},
+		},
+	}
+	issuer, err := NewTLSIssuer(logger.NewMockClient(), info, http.DefaultClient, ts.URL+"/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"core-data", "core-metadata"}, issuer.Services())
+
+	// Act
+	require.NoError(t, issuer.IssueAll("root-token"))
+
+	// Assert
+	ca, ok := stored[serviceCAPath]
+	require.True(t, ok, "CA not stored")
+	caCert := parseTestCertificate(t, ca[caCertKey])
+	assert.True(t, caCert.IsCA)
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+
+	secret, ok := stored[secretBasePath+"/core-data/tls"]
+	require.True(t, ok, "certificate of core-data not stored")
+	assert.Equal(t, ca[caCertKey], secret["cacert"])
+	cert := parseTestCertificate(t, secret["clientcert"])
+	assert.ElementsMatch(t, []string{"core-data", "localhost", "edgex-core-data"}, cert.DNSNames)
+	assert.WithinDuration(t, time.Now().Add(72*time.Hour), cert.NotAfter, time.Minute)
+	for _, usage := range []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth} {
+		_, err = cert.Verify(x509.VerifyOptions{DNSName: "edgex-core-data", Roots: roots, KeyUsages: []x509.ExtKeyUsage{usage}})
+		assert.NoError(t, err)
+	}
+	keyBlock, _ := pem.Decode([]byte(secret["clientkey"]))
+	require.NotNil(t, keyBlock)
+	_, err = x509.ParseECPrivateKey(keyBlock.Bytes)
+	assert.NoError(t, err)
+	_, ok = stored[secretBasePath+"/core-metadata/tls"]
+	assert.True(t, ok, "certificate of core-metadata not stored")
+
+	// the CA is reused by the next issuance
+	require.NoError(t, issuer.IssueAll("root-token"))
+	assert.Equal(t, ca, stored[serviceCAPath])
+	assert.NotEqual(t, secret["clientcert"], stored[secretBasePath+"/core-data/tls"]["clientcert"], "certificate not issued again")
+
+	// the CA is created again when it expires before the certificates
+	issuer.certificateTTL = issuer.caValidity + time.Hour
+	require.NoError(t, issuer.IssueAll("root-token"))
+	assert.NotEqual(t, ca[caCertKey], stored[serviceCAPath][caCertKey], "expiring CA not created again")
+}
+
+func TestNewTLSIssuer(t *testing.T) {
+	valid := config.ServiceTLSInfo{SecretName: "tls", CertificateTTL: "72h", CAValidity: "87600h"}
+	invalidTTL := valid
+	invalidTTL.CertificateTTL = "3 days"
+	zeroTTL := valid
+	zeroTTL.CertificateTTL = "0s"
+	shortCAValidity := valid
+	shortCAValidity.CAValidity = "24h"
+	noSecretName := valid
+	noSecretName.SecretName = ""
+
+	tests := []struct {
+		name          string
+		info          config.ServiceTLSInfo
+		errorExpected bool
+	}{
+		{"Valid", valid, false},
+		{"Invalid - invalid certificate TTL", invalidTTL, true},
+		{"Invalid - zero certificate TTL", zeroTTL, true},
+		{"Invalid - CA validity not longer than the certificate TTL", shortCAValidity, true},
+		{"Invalid - no secret name", noSecretName, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewTLSIssuer(logger.NewMockClient(), testCase.info, http.DefaultClient, "http://localhost:8200/")
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -34,6 +34,8 @@ For Fuji.DOT/Geneva, all root tokens will be revoked.
 */
 
 import (
+	"strings"
+
 	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/tokencreatable"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
@@ -128,18 +130,8 @@ func (tm *TokenMaintenance) RevokeNonRootTokens(privilegedToken string) error {
 		}
 	}
 
-	var lastErr error
-
 	// Revoke all the accessors in the above list
-	for _, accessor := range accessorsToRevoke {
-		// Revoke as many as we can despite errors
-		err = tm.secretClient.RevokeTokenAccessor(privilegedToken, accessor)
-		if err != nil {
-			lastErr = err
-		}
-	}
-
-	return lastErr // return error if any revoke errored
+	return tm.RevokeTokenAccessors(privilegedToken, accessorsToRevoke)
 }
 
 // RevokeRootTokens revokes any root tokens found in the secret store.
@@ -178,3 +170,37 @@ func (tm *TokenMaintenance) RevokeRootTokens(privilegedToken string) error {
 	}
 	return nil
 }
+
+// ServiceTokenAccessors returns the accessors of the per-service tokens, which are issued by the logins of the
+// services to the userpass auth engine. Should be called with a high-privileged token.
+func (tm *TokenMaintenance) ServiceTokenAccessors(privilegedToken string) ([]string, error) {
+	allAccessors, err := tm.secretClient.ListTokenAccessors(privilegedToken)
+	if err != nil {
+		return nil, err // secret client already logged failure
+	}
+
+	loginPath := "auth/" + UPAuthMountPoint + "/login/"
+	serviceAccessors := make([]string, 0)
+	for _, accessor := range allAccessors {
+		tokenMetadata, err := tm.secretClient.LookupTokenAccessor(privilegedToken, accessor)
+		if err != nil {
+			return nil, err // secret client already logged failure
+		}
+		if strings.HasPrefix(tokenMetadata.Path, loginPath) {
+			serviceAccessors = append(serviceAccessors, accessor)
+		}
+	}
+	return serviceAccessors, nil
+}
+
+// RevokeTokenAccessors revokes the tokens of the accessors. Should be called with a high-privileged token.
+func (tm *TokenMaintenance) RevokeTokenAccessors(privilegedToken string, accessors []string) error {
+	var lastErr error
+	for _, accessor := range accessors {
+		// Revoke as many as we can despite errors
+		if err := tm.secretClient.RevokeTokenAccessor(privilegedToken, accessor); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr // return error if any revoke errored
+}
@@ -0,0 +1,224 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+)
+
+const (
+	// messagebusUsersSecretName is the secret of security-bootstrapper holding the password of each message bus user,
+	// with which it creates the Mosquitto password file
+	messagebusUsersSecretName = "message-bus-users"
+	topicUserPlaceholder      = "{user}"
+)
+
+// TopicAuthorizer gives each service message bus credentials of its own and generates the broker configurations which
+// restrict the topics each user may publish and subscribe to
+type TopicAuthorizer struct {
+	lc          logger.LoggingClient
+	info        config.TopicAuthorizationInfo
+	secretStore Cred
+	users       map[string]UserPasswordPair
+}
+
+// NewTopicAuthorizer creates the TopicAuthorizer, the shared message bus credentials remain valid for the clients
+// without credentials of their own, such as eKuiper
+func NewTopicAuthorizer(lc logger.LoggingClient, info config.TopicAuthorizationInfo, secretStore Cred,
+	sharedCredentials UserPasswordPair) *TopicAuthorizer {
+	return &TopicAuthorizer{
+		lc:          lc,
+		info:        info,
+		secretStore: secretStore,
+		users:       map[string]UserPasswordPair{sharedCredentials.User: sharedCredentials},
+	}
+}
+
+// AddService stores the message bus credentials of the service, whose user is the service key. The existing
+// credentials of the service are kept unless they are the shared ones.
+func (t *TopicAuthorizer) AddService(ctx context.Context, service string) error {
+	path := fmt.Sprintf("%s/%s/%s", secretBasePath, service, messagebusSecretName)
+	pair, err := t.secretStore.getUserPasswordPair(path)
+	if err != nil && err != errNotFound {
+		return err
+	}
+	if err == errNotFound || pair.User != service || pair.Password == "" {
+		password, err := t.secretStore.GeneratePassword(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to generate the message bus password of %s: %w", service, err)
+		}
+		pair = &UserPasswordPair{User: service, Password: password}
+		if err = t.secretStore.UploadToStore(pair, path); err != nil {
+			return fmt.Errorf("failed to upload the message bus credentials of %s: %w", service, err)
+		}
+		t.lc.Infof("message bus credentials of %s generated for the topic authorization", service)
+	}
+	t.users[service] = *pair
+	return nil
+}
+
+// ConfigureBrokers stores the message bus users for security-bootstrapper and generates the Mosquitto ACL file and the
+// NATS authorization block of the users
+func (t *TopicAuthorizer) ConfigureBrokers() error {
+	passwords := make(map[string]string, len(t.users))
+	for user, pair := range t.users {
+		passwords[user] = pair.Password
+	}
+	path := fmt.Sprintf("%s/%s/%s", secretBasePath, internal.BootstrapMessageBusServiceKey, messagebusUsersSecretName)
+	if err := t.uploadSecret(path, passwords); err != nil {
+		return fmt.Errorf("failed to upload the message bus users: %w", err)
+	}
+
+	if t.info.MosquittoACLFile != "" {
+		if err := writeBrokerFile(t.info.MosquittoACLFile, t.mosquittoACL(), 0644); err != nil {
+			return fmt.Errorf("failed to write the Mosquitto ACL file: %w", err)
+		}
+		t.lc.Infof("Mosquitto ACL file %s generated for %d users", t.info.MosquittoACLFile, len(t.users))
+	}
+	if t.info.NATSAuthorizationFile != "" {
+		authorization, err := t.natsAuthorization()
+		if err != nil {
+			return err
+		}
+		if err = writeBrokerFile(t.info.NATSAuthorizationFile, authorization, 0600); err != nil {
+			return fmt.Errorf("failed to write the NATS authorization file: %w", err)
+		}
+		t.lc.Infof("NATS authorization file %s generated for %d users", t.info.NATSAuthorizationFile, len(t.users))
+	}
+	return nil
+}
+
+// permissions returns the topic filters the user may publish and subscribe to
+func (t *TopicAuthorizer) permissions(user string) config.TopicPermissions {
+	own, ok := t.info.Users[user]
+	if !ok {
+		own = t.info.Default
+	}
+	expand := func(filters ...[]string) []string {
+		var expanded []string
+		for _, list := range filters {
+			for _, filter := range list {
+				expanded = append(expanded, strings.ReplaceAll(filter, topicUserPlaceholder, user))
+			}
+		}
+		return expanded
+	}
+	return config.TopicPermissions{
+		Publish:   expand(t.info.Common.Publish, own.Publish),
+		Subscribe: expand(t.info.Common.Subscribe, own.Subscribe),
+	}
+}
+
+func (t *TopicAuthorizer) sortedUsers() []string {
+	users := make([]string, 0, len(t.users))
+	for user := range t.users {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+	return users
+}
+
+// mosquittoACL returns the Mosquitto ACL file of the users, publishing being the write access and subscribing the
+// read access
+func (t *TopicAuthorizer) mosquittoACL() []byte {
+	var acl bytes.Buffer
+	acl.WriteString("# Generated by security-secretstore-setup\n")
+	for _, user := range t.sortedUsers() {
+		permissions := t.permissions(user)
+		fmt.Fprintf(&acl, "\nuser %s\n", user)
+		for _, topic := range permissions.Publish {
+			fmt.Fprintf(&acl, "topic write %s\n", topic)
+		}
+		for _, topic := range permissions.Subscribe {
+			fmt.Fprintf(&acl, "topic read %s\n", topic)
+		}
+	}
+	return acl.Bytes()
+}
+
+// natsAuthorization returns the NATS server authorization block of the users with their bcrypt hashed passwords, the
+// topic filters being converted to NATS subjects
+func (t *TopicAuthorizer) natsAuthorization() ([]byte, error) {
+	var authorization bytes.Buffer
+	authorization.WriteString("# Generated by security-secretstore-setup\nauthorization {\n  users = [\n")
+	for _, user := range t.sortedUsers() {
+		hash, err := bcrypt.GenerateFromPassword([]byte(t.users[user].Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash the message bus password of %s: %w", user, err)
+		}
+		permissions := t.permissions(user)
+		fmt.Fprintf(&authorization, "    {user: %s, password: %s, permissions: {publish: %s, subscribe: %s}}\n",
+			strconv.Quote(user), strconv.Quote(string(hash)), natsPermission(permissions.Publish),
+			natsPermission(permissions.Subscribe))
+	}
+	authorization.WriteString("  ]\n}\n")
+	return authorization.Bytes(), nil
+}
+
+// natsPermission returns the NATS permission allowing the subjects of the topic filters, an empty list denying all the
+// subjects since NATS allows all of them when the permission is omitted
+func natsPermission(filters []string) string {
+	if len(filters) == 0 {
+		return `{deny: [">"]}`
+	}
+	subjects := make([]string, 0, len(filters))
+	replacer := strings.NewReplacer("/", ".", "#", ">", "+", "*")
+	for _, filter := range filters {
+		subjects = append(subjects, strconv.Quote(replacer.Replace(filter)))
+	}
+	return "{allow: [" + strings.Join(subjects, ", ") + "]}"
+}
+
+// writeBrokerFile writes the broker file, the ACL file being readable by the broker while the NATS authorization block
+// holds the password hashes
+func writeBrokerFile(path string, content []byte, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, perm)
+}
+
+// uploadSecret writes the secret at the path of the KV secrets engine, replacing the existing one
+func (t *TopicAuthorizer) uploadSecret(path string, secret map[string]string) error {
+	body, err := json.Marshal(secret)
+	if err != nil {
+		return err
+	}
+	secretURL, err := t.secretStore.credPathURL(path)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, secretURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating http request: %w", err)
+	}
+	req.Header.Set(VaultToken, t.secretStore.rootToken)
+	resp, err := t.secretStore.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to write secret %s with status %s", path, resp.Status)
+	}
+	return nil
+}
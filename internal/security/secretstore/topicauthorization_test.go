@@ -0,0 +1,278 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+)
+
+func TestTopicAuthorizer(t *testing.T) {
+	shared := UserPasswordPair{User: defaultMsgBusUser, Password: "shared-password"}
+	stored := map[string]map[string]string{
+		// the existing credentials of core-data are kept while the shared ones of core-command are replaced
+		secretBasePath + "/core-data/message-bus":    {"username": "core-data", "password": "core-data-password"},
+		secretBasePath + "/core-command/message-bus": {"username": shared.User, "password": shared.Password},
+	}
+	ts := testKVStore(t, stored)
+	defer ts.Close()
+
+	dir := t.TempDir()
+	info := config.TopicAuthorizationInfo{
+		Enabled:               true,
+		MosquittoACLFile:      filepath.Join(dir, "mosquitto", "acl"),
+		NATSAuthorizationFile: filepath.Join(dir, "nats", "authorization.conf"),
+		Common: config.TopicPermissions{
+			Subscribe: []string{"edgex/response/{user}/#"},
+		},
+		Default: config.TopicPermissions{
+			Publish:   []string{"edgex/events/device/{user}/#", "edgex/response/core-command/#"},
+			Subscribe: []string{"edgex/device/command/request/{user}/#"},
+		},
+		Users: map[string]config.TopicPermissions{
+			"core-command": {Publish: []string{"edgex/device/command/request/#"}},
+			"core-data":    {Subscribe: []string{"edgex/events/device/+/#"}},
+		},
+	}
+	secretStore := NewCred(http.DefaultClient, "root-token", NewDefaultCredentialGenerator(), ts.URL, logger.NewMockClient())
+	authorizer := NewTopicAuthorizer(logger.NewMockClient(), info, secretStore, shared)
+
+	// Act
+	for _, service := range []string{"core-command", "core-data", "device-virtual"} {
+		require.NoError(t, authorizer.AddService(context.Background(), service))
+	}
+	require.NoError(t, authorizer.ConfigureBrokers())
+
+	// Assert
+	assert.Equal(t, map[string]string{"username": "core-data", "password": "core-data-password"}, stored[secretBasePath+"/core-data/message-bus"])
+	for _, service := range []string{"core-command", "device-virtual"} {
+		credentials := stored[secretBasePath+"/"+service+"/message-bus"]
+		assert.Equal(t, service, credentials["username"])
+		assert.NotEmpty(t, credentials["password"])
+		assert.NotEqual(t, shared.Password, credentials["password"])
+	}
+	users := stored[secretBasePath+"/"+internal.BootstrapMessageBusServiceKey+"/"+messagebusUsersSecretName]
+	assert.Len(t, users, 4)
+	assert.Equal(t, shared.Password, users[shared.User])
+	assert.Equal(t, "core-data-password", users["core-data"])
+
+	acl, err := os.ReadFile(info.MosquittoACLFile)
+	require.NoError(t, err)
+	assert.Equal(t, `# Generated by security-secretstore-setup
+
+user core-command
+topic write edgex/device/command/request/#
+topic read edgex/response/core-command/#
+
+user core-data
+topic read edgex/response/core-data/#
+topic read edgex/events/device/+/#
+
+user device-virtual
+topic write edgex/events/device/device-virtual/#
+topic write edgex/response/core-command/#
+topic read edgex/response/device-virtual/#
+topic read edgex/device/command/request/device-virtual/#
+
+user msgbususer
+topic write edgex/events/device/msgbususer/#
+topic write edgex/response/core-command/#
+topic read edgex/response/msgbususer/#
+topic read edgex/device/command/request/msgbususer/#
+`, string(acl))
+
+	authorization, err := os.ReadFile(info.NATSAuthorizationFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(authorization), `{user: "core-data", password: "$2a$`)
+	assert.Contains(t, string(authorization), `permissions: {publish: {deny: [">"]}, subscribe: {allow: ["edgex.response.core-data.>", "edgex.events.device.*.>"]}}}`)
+	hash := regexp.MustCompile(`user: "core-data", password: "([^"]+)"`).FindStringSubmatch(string(authorization))
+	require.Len(t, hash, 2)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash[1]), []byte("core-data-password")))
+}
+
+// TestDefaultTopicPermissions checks that the default permissions of the configuration permit every topic the services
+// publish and subscribe to, so that enabling the topic authorization doesn't break any of them
+func TestDefaultTopicPermissions(t *testing.T) {
+	content, err := os.ReadFile("../../../cmd/security-secretstore-setup/res/configuration.yaml")
+	require.NoError(t, err)
+	var raw map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &raw))
+	// the configuration keys are matched case insensitively like the service does
+	converted, err := json.Marshal(raw)
+	require.NoError(t, err)
+	var configuration struct {
+		SecureMessageBus struct {
+			TopicAuthorization config.TopicAuthorizationInfo
+		}
+	}
+	require.NoError(t, json.Unmarshal(converted, &configuration))
+
+	const (
+		base          = "edgex"
+		deviceService = "device-virtual"
+		profile       = "Random-Profile"
+		device        = "Random-Device"
+		source        = "Int8"
+		command       = "Int8"
+		requestId     = "0d6b1ea4-70e5-4e3a-8b0d-5b1a5c0e2d1f"
+	)
+	commandRequest := common.BuildTopic(base, strings.TrimSuffix(common.CoreCommandRequestSubscribeTopic, "/#"), device, command, "set")
+	deviceSystemEvent := common.BuildTopic(base, common.SystemEventPublishTopic, common.CoreMetaDataServiceKey,
+		common.DeviceSystemEventType, common.SystemEventActionAdd, deviceService, profile)
+	tests := []struct {
+		user      string
+		publish   []string
+		subscribe []string
+	}{
+		{deviceService,
+			[]string{
+				common.BuildTopic(base, common.EventsPublishTopic, "device", deviceService, profile, device, source),
+				common.BuildTopic(base, common.ResponseTopic, deviceService, requestId),
+				common.BuildTopic(base, pkgCommon.DeviceServiceHeartbeatPublishTopic, deviceService),
+				common.BuildTopic(base, "telemetry", deviceService, "ReadCommandsExecuted"),
+			},
+			[]string{
+				common.BuildTopic(base, common.CommandRequestSubscribeTopic, deviceService, device, command, "get"),
+				deviceSystemEvent,
+				common.BuildTopic(base, deviceService, common.ValidateDeviceSubscribeTopic),
+			}},
+		{common.CoreCommandServiceKey,
+			[]string{
+				common.BuildTopic(base, common.CoreCommandDeviceRequestPublishTopic, deviceService, device, command, "get"),
+				common.BuildTopic(base, common.ResponseTopic, common.CoreCommandServiceKey, requestId),
+				// the default CommandAudit.PublishTopic
+				common.BuildTopic(base, "core/commandaudit", device, command, "get"),
+				common.BuildTopic(base, pkgCommon.CoreCommandResultPublishTopic, requestId),
+			},
+			[]string{
+				commandRequest,
+				common.BuildTopic(base, strings.TrimSuffix(common.CoreCommandQueryRequestSubscribeTopic, "/#"), common.All),
+				common.BuildTopic(base, pkgCommon.CoreCommandBatchRequestSubscribeTopic),
+				common.BuildTopic(base, common.ResponseTopic, deviceService, requestId),
+			}},
+		{common.CoreDataServiceKey,
+			[]string{
+				common.BuildTopic(base, common.EventsPublishTopic, "core", deviceService, profile, device, source),
+				common.BuildTopic(base, pkgCommon.CoreDataQuarantinePublishTopic, profile, device, source),
+				common.BuildTopic(base, pkgCommon.CoreDataSchemaViolationPublishTopic, profile, device, source),
+				common.BuildTopic(base, pkgCommon.CoreDataLateDataPublishTopic, profile, device, source),
+				common.BuildTopic(base, pkgCommon.CoreDataReadingSubscriptionPublishTopic, requestId),
+			},
+			[]string{
+				common.BuildTopic(base, common.EventsPublishTopic, "device", deviceService, profile, device, source),
+				deviceSystemEvent,
+			}},
+		{common.CoreMetaDataServiceKey,
+			[]string{
+				deviceSystemEvent,
+				common.BuildTopic(base, "system-events-replay", common.CoreMetaDataServiceKey, common.DeviceSystemEventType,
+					common.SystemEventActionAdd, deviceService, profile),
+				common.BuildTopic(base, deviceService, common.ValidateDeviceSubscribeTopic),
+			},
+			[]string{
+				common.BuildTopic(base, pkgCommon.DeviceServiceHeartbeatPublishTopic, deviceService),
+				common.BuildTopic(base, common.ResponseTopic, deviceService, requestId),
+			}},
+		{"support-scheduler",
+			[]string{
+				common.BuildTopic(base, "scheduler", "trigger"),
+				commandRequest,
+			},
+			nil},
+		{"app-rules-engine",
+			[]string{common.BuildTopic(base, "rules-events", "alert")},
+			[]string{
+				common.BuildTopic(base, common.EventsPublishTopic, "device", deviceService, profile, device, source),
+				common.BuildTopic(base, common.EventsPublishTopic, "core", deviceService, profile, device, source),
+			}},
+		{defaultMsgBusUser,
+			[]string{commandRequest},
+			[]string{
+				common.BuildTopic(base, "rules-events", "alert"),
+				common.BuildTopic(base, common.ResponseTopic, common.CoreCommandServiceKey, requestId),
+			}},
+	}
+
+	authorizer := NewTopicAuthorizer(logger.NewMockClient(), configuration.SecureMessageBus.TopicAuthorization, Cred{},
+		UserPasswordPair{User: defaultMsgBusUser})
+	for _, tt := range tests {
+		authorizer.users[tt.user] = UserPasswordPair{User: tt.user}
+	}
+	write, read := parseMosquittoACL(t, authorizer.mosquittoACL())
+	for _, tt := range tests {
+		t.Run(tt.user, func(t *testing.T) {
+			for _, topic := range tt.publish {
+				assert.True(t, topicPermitted(write[tt.user], topic), "%s may not publish to %s", tt.user, topic)
+			}
+			for _, topic := range tt.subscribe {
+				assert.True(t, topicPermitted(read[tt.user], topic), "%s may not subscribe to %s", tt.user, topic)
+			}
+		})
+	}
+	// a device service may not publish the events of another device service
+	assert.False(t, topicPermitted(write[deviceService],
+		common.BuildTopic(base, common.EventsPublishTopic, "device", "device-other", profile, device, source)))
+}
+
+// parseMosquittoACL returns the topic filters of the write and read accesses of each user of the Mosquitto ACL file
+func parseMosquittoACL(t *testing.T, acl []byte) (map[string][]string, map[string][]string) {
+	write := make(map[string][]string)
+	read := make(map[string][]string)
+	var user string
+	scanner := bufio.NewScanner(bytes.NewReader(acl))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		switch {
+		case len(fields) == 2 && fields[0] == "user":
+			user = fields[1]
+		case len(fields) == 3 && fields[0] == "topic" && fields[1] == "write":
+			write[user] = append(write[user], fields[2])
+		case len(fields) == 3 && fields[0] == "topic" && fields[1] == "read":
+			read[user] = append(read[user], fields[2])
+		}
+	}
+	require.NoError(t, scanner.Err())
+	return write, read
+}
+
+// topicPermitted returns whether one of the MQTT topic filters matches the topic
+func topicPermitted(filters []string, topic string) bool {
+	topicLevels := strings.Split(topic, "/")
+	for _, filter := range filters {
+		filterLevels := strings.Split(filter, "/")
+		for i, level := range filterLevels {
+			if level == "#" {
+				return true
+			}
+			if i >= len(topicLevels) || (level != "+" && level != topicLevels[i]) {
+				break
+			}
+			if i == len(filterLevels)-1 && len(filterLevels) == len(topicLevels) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+)
+
+// UnacknowledgedNotifications queries the notifications not acknowledged yet with offset and limit, the most recent
+// first, only the notifications of the category and of the severity when they aren't empty
+func UnacknowledgedNotifications(offset, limit int, category string, severity string, dic *di.Container) (notifications []dtos.Notification, totalCount uint32, err errors.EdgeX) {
+	switch models.NotificationSeverity(severity) {
+	case "", models.Minor, models.Normal, models.Critical:
+	default:
+		return notifications, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid severity '%s'", severity), nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	notificationModels, err := dbClient.UnacknowledgedNotifications(offset, limit, category, severity)
+	if err == nil {
+		totalCount, err = dbClient.UnacknowledgedNotificationCount(category, severity)
+	}
+	if err != nil {
+		return notifications, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	notifications = make([]dtos.Notification, len(notificationModels))
+	for i, n := range notificationModels {
+		notifications[i] = dtos.FromNotificationModelToDTO(n)
+	}
+	return notifications, totalCount, nil
+}
+
+// StartAcknowledgedCleanup starts deleting the notifications acknowledged for longer than the configured max age
+// periodically, until the context is canceled
+func StartAcknowledgedCleanup(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get).AcknowledgedCleanup
+
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil || interval <= 0 {
+		lc.Errorf("Acknowledged notifications cleanup disabled, invalid interval '%s'", config.Interval)
+		return
+	}
+	maxAge, err := time.ParseDuration(config.MaxAge)
+	if err != nil || maxAge < 0 {
+		lc.Errorf("Acknowledged notifications cleanup disabled, invalid max age '%s'", config.MaxAge)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := container.DBClientFrom(dic.Get).DeleteAcknowledgedNotificationsByAge(maxAge.Milliseconds()); err != nil {
+					lc.Errorf("Failed to delete the acknowledged notifications: %s", err.Error())
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,166 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// ConnectorWebhookUrlKey is the key of the webhook URL in the secret of a connector
+const ConnectorWebhookUrlKey = "url"
+
+const (
+	connectorTimeout = 10 * time.Second
+	// the longest title and description of the Discord embeds
+	discordTitleLimit       = 256
+	discordDescriptionLimit = 4096
+)
+
+// the colors of the Teams cards and Discord embeds by severity
+var severityColors = map[models.NotificationSeverity]int{
+	models.Critical: 0xD32F2F,
+	models.Minor:    0xF9A825,
+	models.Normal:   0x2E7D32,
+}
+
+// ConnectorSender sends the notifications via the chat connectors
+type ConnectorSender interface {
+	Send(notification models.Notification, connector notificationModels.Connector, webhookUrl *url.URL) (res string, err errors.EdgeX)
+}
+
+// ChatSender is the implementation of the ConnectorSender, which posts the notifications to the Slack, Microsoft Teams
+// and Discord incoming webhooks
+type ChatSender struct {
+	client *http.Client
+}
+
+// NewChatSender creates the ChatSender instance
+func NewChatSender() ConnectorSender {
+	return &ChatSender{client: &http.Client{Timeout: connectorTimeout}}
+}
+
+// Send posts the notification formatted for the connector type to the webhook URL of the connector
+func (sender *ChatSender) Send(notification models.Notification, connector notificationModels.Connector, webhookUrl *url.URL) (res string, err errors.EdgeX) {
+	payload, err := connectorPayload(notification, connector.Type)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	req, reqErr := http.NewRequest(http.MethodPost, webhookUrl.String(), bytes.NewReader(payload))
+	if reqErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "fail to create http request", reqErr)
+	}
+	req.Header.Set(common.ContentType, common.ContentTypeJSON)
+	resp, reqErr := sender.client.Do(req)
+	if reqErr != nil {
+		// the error embeds the webhook URL, which must not be logged
+		return "", errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("fail to post the notification via connector %s to %s", connector.Name, webhookUrl.Host), nil)
+	}
+	defer resp.Body.Close()
+
+	body, reqErr := io.ReadAll(resp.Body)
+	if reqErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindIOError, "fail to read the response body", reqErr)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), fmt.Sprintf("request failed, status code: %d, err: %s", resp.StatusCode, string(body)), nil)
+	}
+	return string(body), nil
+}
+
+// ConnectorWebhookUrl retrieves the webhook URL of the connector from the secret store, under the ConnectorWebhookUrlKey
+// of the secret named after the SecretName of the connector
+func ConnectorWebhookUrl(dic *di.Container, connector notificationModels.Connector) (*url.URL, errors.EdgeX) {
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing", nil)
+	}
+	secrets, err := secretProvider.GetSecret(connector.SecretName, ConnectorWebhookUrlKey)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to retrieve the webhook URL of connector %s from the secret store", connector.Name), err)
+	}
+	webhookUrl, err := url.Parse(secrets[ConnectorWebhookUrlKey])
+	if err != nil || (webhookUrl.Scheme != "https" && webhookUrl.Scheme != "http") || webhookUrl.Host == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("webhook URL of connector %s is not a valid HTTP(S) URL", connector.Name), nil)
+	}
+	return webhookUrl, nil
+}
+
+// connectorPayload formats the notification as the JSON payload of the incoming webhook of the connector type
+func connectorPayload(n models.Notification, connectorType string) ([]byte, errors.EdgeX) {
+	title := connectorTitle(n)
+	color := severityColors[n.Severity]
+
+	var payload any
+	switch connectorType {
+	case notificationModels.ConnectorTypeSlack:
+		payload = map[string]any{
+			"text": fmt.Sprintf("*%s*\n%s", slackEscape(title), slackEscape(n.Content)),
+		}
+	case notificationModels.ConnectorTypeTeams:
+		payload = map[string]any{
+			"@type":      "MessageCard",
+			"@context":   "https://schema.org/extensions",
+			"summary":    title,
+			"themeColor": fmt.Sprintf("%06X", color),
+			"title":      title,
+			"text":       n.Content,
+		}
+	case notificationModels.ConnectorTypeDiscord:
+		payload = map[string]any{
+			"embeds": []map[string]any{{
+				"title":       truncate(title, discordTitleLimit),
+				"description": truncate(n.Content, discordDescriptionLimit),
+				"color":       color,
+			}},
+		}
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported connector type %s", connectorType), nil)
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to marshal the connector payload", err)
+	}
+	return b, nil
+}
+
+// connectorTitle returns the title of the chat message, e.g. "[CRITICAL] health-check from device-virtual"
+func connectorTitle(n models.Notification) string {
+	subject := n.Category
+	if subject == "" {
+		subject = "Notification"
+	}
+	return fmt.Sprintf("[%s] %s from %s", n.Severity, subject, n.Sender)
+}
+
+// slackEscape escapes the control characters of the Slack message formatting
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func truncate(s string, length int) string {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+	return string(runes[:length-1]) + "…"
+}
@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	secretMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestChatSenderSend(t *testing.T) {
+	requests := make(chan map[string]any, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, common.ContentTypeJSON, r.Header.Get(common.ContentType))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal(body, &payload))
+		requests <- payload
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	webhookUrl, err := url.Parse(server.URL + "/services/T000/B000/XXXX")
+	require.NoError(t, err)
+
+	notification := models.Notification{
+		Category: "health-check",
+		Sender:   "device-virtual",
+		Severity: models.Critical,
+		Content:  "temperature <too> high",
+	}
+	sender := NewChatSender()
+
+	t.Run("Slack", func(t *testing.T) {
+		res, err := sender.Send(notification, notificationModels.Connector{Name: "slack", Type: notificationModels.ConnectorTypeSlack}, webhookUrl)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", res)
+
+		payload := <-requests
+		assert.Equal(t, "*[CRITICAL] health-check from device-virtual*\ntemperature &lt;too&gt; high", payload["text"])
+	})
+	t.Run("Teams", func(t *testing.T) {
+		_, err := sender.Send(notification, notificationModels.Connector{Name: "teams", Type: notificationModels.ConnectorTypeTeams}, webhookUrl)
+		require.NoError(t, err)
+
+		payload := <-requests
+		assert.Equal(t, "MessageCard", payload["@type"])
+		assert.Equal(t, "[CRITICAL] health-check from device-virtual", payload["title"])
+		assert.Equal(t, "D32F2F", payload["themeColor"])
+		assert.Equal(t, notification.Content, payload["text"])
+	})
+	t.Run("Discord", func(t *testing.T) {
+		long := notification
+		long.Severity = models.Normal
+		long.Category = ""
+		long.Content = strings.Repeat("x", discordDescriptionLimit+1)
+		_, err := sender.Send(long, notificationModels.Connector{Name: "discord", Type: notificationModels.ConnectorTypeDiscord}, webhookUrl)
+		require.NoError(t, err)
+
+		payload := <-requests
+		embeds, ok := payload["embeds"].([]any)
+		require.True(t, ok)
+		require.Len(t, embeds, 1)
+		embed := embeds[0].(map[string]any)
+		assert.Equal(t, "[NORMAL] Notification from device-virtual", embed["title"])
+		assert.Len(t, []rune(embed["description"].(string)), discordDescriptionLimit)
+		assert.EqualValues(t, 0x2E7D32, embed["color"])
+	})
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := sender.Send(notification, notificationModels.Connector{Name: "unknown", Type: "UNKNOWN"}, webhookUrl)
+		require.Error(t, err)
+		assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	})
+}
+
+func TestConnectorWebhookUrl(t *testing.T) {
+	secretProvider := &secretMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "slack", ConnectorWebhookUrlKey).
+		Return(map[string]string{ConnectorWebhookUrlKey: "https://hooks.slack.com/services/T000/B000/XXXX"}, nil)
+	secretProvider.On("GetSecret", "invalid", ConnectorWebhookUrlKey).
+		Return(map[string]string{ConnectorWebhookUrlKey: "ftp://hooks.slack.com"}, nil)
+	secretProvider.On("GetSecret", "missing", ConnectorWebhookUrlKey).
+		Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "secret not found", nil))
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+	})
+
+	tests := []struct {
+		name          string
+		secretName    string
+		errorExpected bool
+		expectedKind  errors.ErrKind
+	}{
+		{"valid", "slack", false, ""},
+		{"invalid - not an HTTP(S) URL", "invalid", true, errors.KindContractInvalid},
+		{"invalid - secret not found", "missing", true, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			webhookUrl, err := ConnectorWebhookUrl(dic, notificationModels.Connector{Name: testCase.name, SecretName: testCase.secretName})
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "hooks.slack.com", webhookUrl.Host)
+		})
+	}
+}
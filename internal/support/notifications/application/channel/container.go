@@ -15,6 +15,12 @@ var RESTSenderName = di.TypeInstanceToName(RESTSender{})
 // EmailSenderName contains the name of the channel.EmailSender implementation in the DIC.
 var EmailSenderName = di.TypeInstanceToName(EmailSender{})
 
+// MQTTSenderName contains the name of the channel.MQTTSender implementation in the DIC.
+var MQTTSenderName = di.TypeInstanceToName(MQTTSender{})
+
+// ChatSenderName contains the name of the channel.ChatSender implementation in the DIC.
+var ChatSenderName = di.TypeInstanceToName(ChatSender{})
+
 // RESTSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
 func RESTSenderFrom(get di.Get) Sender {
 	return get(RESTSenderName).(Sender)
@@ -24,3 +30,23 @@ func RESTSenderFrom(get di.Get) Sender {
 func EmailSenderFrom(get di.Get) Sender {
 	return get(EmailSenderName).(Sender)
 }
+
+// MQTTSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func MQTTSenderFrom(get di.Get) Sender {
+	return get(MQTTSenderName).(Sender)
+}
+
+// ChatSenderFrom helper function queries the DIC and returns the channel.ConnectorSender implementation.
+func ChatSenderFrom(get di.Get) ConnectorSender {
+	return get(ChatSenderName).(ConnectorSender)
+}
+
+// SmsProviderInterfaceName contains the name of the channel.SmsProvider implementation in the DIC.
+var SmsProviderInterfaceName = di.TypeInstanceToName((*SmsProvider)(nil))
+
+// SmsProviderFrom helper function queries the DIC and returns the channel.SmsProvider implementation, nil when the
+// SMS channels are disabled.
+func SmsProviderFrom(get di.Get) SmsProvider {
+	provider, _ := get(SmsProviderInterfaceName).(SmsProvider)
+	return provider
+}
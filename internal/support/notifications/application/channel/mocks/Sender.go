@@ -14,20 +14,20 @@ type Sender struct {
 	mock.Mock
 }
 
-// Send provides a mock function with given fields: notification, address
-func (_m *Sender) Send(notification models.Notification, address models.Address) (string, errors.EdgeX) {
-	ret := _m.Called(notification, address)
+// Send provides a mock function with given fields: notification, subscriptionName, address
+func (_m *Sender) Send(notification models.Notification, subscriptionName string, address models.Address) (string, errors.EdgeX) {
+	ret := _m.Called(notification, subscriptionName, address)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(models.Notification, models.Address) string); ok {
-		r0 = rf(notification, address)
+	if rf, ok := ret.Get(0).(func(models.Notification, string, models.Address) string); ok {
+		r0 = rf(notification, subscriptionName, address)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(models.Notification, models.Address) errors.EdgeX); ok {
-		r1 = rf(notification, address)
+	if rf, ok := ret.Get(1).(func(models.Notification, string, models.Address) errors.EdgeX); ok {
+		r1 = rf(notification, subscriptionName, address)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -0,0 +1,164 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// The MQTT channels publish the content of the notifications to the topic of the address on the broker of the address,
+// either the broker of the EdgeX MessageBus or an external one, with the QoS and retained flag of the address. The
+// connection is authenticated with the username and password of the secret MQTTSecretNamePrefix + subscription name
+// when the subscription has one.
+const (
+	MQTTSecretNamePrefix = "mqtt-"
+	MQTTUsernameKey      = "username"
+	MQTTPasswordKey      = "password"
+
+	// mqttDefaultTimeout is the connect and publish timeout when the address has no ConnectTimeout
+	mqttDefaultTimeout = 10 * time.Second
+)
+
+// MQTTSecretName returns the name of the MQTT credentials secret of the subscription
+func MQTTSecretName(subscriptionName string) string {
+	return MQTTSecretNamePrefix + subscriptionName
+}
+
+// MQTTSender is the implementation of the interfaces.ChannelSender, which is used to publish the notifications via MQTT
+type MQTTSender struct {
+	dic *di.Container
+	// newClient creates the clients of the external brokers, replaced by the unit tests
+	newClient func(opts *mqtt.ClientOptions) mqtt.Client
+
+	mutex   sync.Mutex
+	clients map[string]mqtt.Client
+}
+
+// NewMQTTSender creates the MQTTSender instance
+func NewMQTTSender(dic *di.Container) Sender {
+	return &MQTTSender{dic: dic, newClient: mqtt.NewClient, clients: make(map[string]mqtt.Client)}
+}
+
+// Send publishes the notification content to the topic of the specified address
+func (sender *MQTTSender) Send(notification models.Notification, subscriptionName string, address models.Address) (res string, err errors.EdgeX) {
+	mqttAddress, ok := address.(models.MQTTPubAddress)
+	if !ok {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to MQTTPubAddress", nil)
+	}
+	if mqttAddress.QoS < 0 || mqttAddress.QoS > 2 {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid MQTT QoS %d", mqttAddress.QoS), nil)
+	}
+
+	timeout := mqttDefaultTimeout
+	if mqttAddress.ConnectTimeout > 0 {
+		timeout = time.Duration(mqttAddress.ConnectTimeout) * time.Second
+	}
+	client, err := sender.client(subscriptionName, mqttAddress, timeout)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	token := client.Publish(mqttAddress.Topic, byte(mqttAddress.QoS), mqttAddress.Retained, notification.Content)
+	if !token.WaitTimeout(timeout) {
+		return "", errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("timed out publishing the notification to topic %s", mqttAddress.Topic), nil)
+	}
+	if token.Error() != nil {
+		sender.dropClient(subscriptionName, mqttAddress, client)
+		return "", errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("fail to publish the notification to topic %s", mqttAddress.Topic), token.Error())
+	}
+	return fmt.Sprintf("published to topic %s of broker %s", mqttAddress.Topic, brokerUrl(mqttAddress)), nil
+}
+
+// client returns the connected client of the subscription to the broker of the address, the clients being kept
+// between the notifications so that the KeepAlive and AutoReconnect settings of the address apply
+func (sender *MQTTSender) client(subscriptionName string, address models.MQTTPubAddress, timeout time.Duration) (mqtt.Client, errors.EdgeX) {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+
+	key := clientKey(subscriptionName, address)
+	if client, ok := sender.clients[key]; ok {
+		if client.IsConnectionOpen() {
+			return client, nil
+		}
+		client.Disconnect(0)
+		delete(sender.clients, key)
+	}
+
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker(brokerUrl(address))
+	opts.SetClientID(address.Publisher)
+	opts.SetAutoReconnect(address.AutoReconnect)
+	opts.SetConnectTimeout(timeout)
+	if address.KeepAlive > 0 {
+		opts.SetKeepAlive(time.Duration(address.KeepAlive) * time.Second)
+	}
+	username, password, err := mqttCredentials(sender.dic, subscriptionName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	opts.SetUsername(username)
+	opts.SetPassword(password)
+
+	client := sender.newClient(opts)
+	token := client.Connect()
+	if !token.WaitTimeout(timeout) {
+		return nil, errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("timed out connecting to MQTT broker %s", brokerUrl(address)), nil)
+	}
+	if token.Error() != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("fail to connect to MQTT broker %s", brokerUrl(address)), token.Error())
+	}
+	sender.clients[key] = client
+	return client, nil
+}
+
+// dropClient disconnects the client after a publish failure, so that the next notification connects again
+func (sender *MQTTSender) dropClient(subscriptionName string, address models.MQTTPubAddress, client mqtt.Client) {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+
+	key := clientKey(subscriptionName, address)
+	if sender.clients[key] == client {
+		delete(sender.clients, key)
+	}
+	client.Disconnect(0)
+}
+
+func clientKey(subscriptionName string, address models.MQTTPubAddress) string {
+	return fmt.Sprintf("%s|%s|%s", subscriptionName, brokerUrl(address), address.Publisher)
+}
+
+func brokerUrl(address models.MQTTPubAddress) string {
+	return fmt.Sprintf("tcp://%s:%d", address.Host, address.Port)
+}
+
+// mqttCredentials returns the username and password of the subscription, empty when the subscription has none
+func mqttCredentials(dic *di.Container, subscriptionName string) (username string, password string, edgeXerr errors.EdgeX) {
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return "", "", nil
+	}
+	secretName := MQTTSecretName(subscriptionName)
+	exists, err := secretProvider.HasSecret(secretName)
+	if err != nil {
+		return "", "", errors.NewCommonEdgeX(errors.Kind(err), "fail to check the MQTT credentials existence", err)
+	} else if !exists {
+		return "", "", nil
+	}
+	secrets, err := secretProvider.GetSecret(secretName, MQTTUsernameKey, MQTTPasswordKey)
+	if err != nil {
+		return "", "", errors.NewCommonEdgeX(errors.Kind(err), "fail to retrieve the MQTT credentials from the secret store", err)
+	}
+	return secrets[MQTTUsernameKey], secrets[MQTTPasswordKey], nil
+}
@@ -0,0 +1,110 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	secretMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clientMocks "github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging/mocks"
+)
+
+const testMQTTSubscription = "dashboardSubscription"
+
+// testToken is an already completed mqtt.Token
+type testToken struct {
+	err error
+}
+
+func (t testToken) Wait() bool                     { return true }
+func (t testToken) WaitTimeout(time.Duration) bool { return true }
+func (t testToken) Done() <-chan struct{} {
+	done := make(chan struct{})
+	close(done)
+	return done
+}
+func (t testToken) Error() error { return t.err }
+
+func TestMQTTSender(t *testing.T) {
+	notification := models.Notification{Content: "temperature too high", ContentType: common.ContentTypeText}
+	address := models.MQTTPubAddress{
+		BaseAddress: models.BaseAddress{Type: common.MQTT, Host: "broker", Port: 1883},
+		Publisher:   "support-notifications",
+		Topic:       "alerts/temperature",
+		QoS:         1,
+		Retained:    true,
+	}
+	invalidQoS := address
+	invalidQoS.QoS = 3
+	publishFailed := address
+	publishFailed.Topic = "alerts/failed"
+
+	secretProvider := &secretMocks.SecretProvider{}
+	secretProvider.On("HasSecret", MQTTSecretName(testMQTTSubscription)).Return(true, nil)
+	secretProvider.On("GetSecret", MQTTSecretName(testMQTTSubscription), MQTTUsernameKey, MQTTPasswordKey).
+		Return(map[string]string{MQTTUsernameKey: "dashboard", MQTTPasswordKey: "password"}, nil)
+	client := &clientMocks.Client{}
+	client.On("Connect").Return(testToken{})
+	client.On("IsConnectionOpen").Return(true)
+	client.On("Publish", address.Topic, byte(1), true, notification.Content).Return(testToken{})
+	client.On("Publish", publishFailed.Topic, byte(1), true, notification.Content).Return(testToken{err: errors.New("publish failed")})
+	client.On("Disconnect", uint(0)).Return()
+
+	var options []*mqtt.ClientOptions
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+	})
+	sender := NewMQTTSender(dic).(*MQTTSender)
+	sender.newClient = func(opts *mqtt.ClientOptions) mqtt.Client {
+		options = append(options, opts)
+		return client
+	}
+
+	tests := []struct {
+		name            string
+		address         models.MQTTPubAddress
+		expectedError   bool
+		expectedClients int
+	}{
+		{"published", address, false, 1},
+		{"published with the connected client", address, false, 1},
+		{"invalid QoS", invalidQoS, true, 1},
+		{"publish failed", publishFailed, true, 1},
+		{"reconnected after the publish failure", address, false, 2},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := sender.Send(notification, testMQTTSubscription, testCase.address)
+			if testCase.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, options, testCase.expectedClients)
+		})
+	}
+	assert.Equal(t, "tcp://broker:1883", options[0].Servers[0].String())
+	assert.Equal(t, address.Publisher, options[0].ClientID)
+	assert.Equal(t, "dashboard", options[0].Username)
+	assert.Equal(t, "password", options[0].Password)
+	client.AssertNumberOfCalls(t, "Disconnect", 1)
+}
@@ -18,7 +18,7 @@ import (
 
 // Sender abstracts the notification sending via specified channel
 type Sender interface {
-	Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX)
+	Send(notification models.Notification, subscriptionName string, address models.Address) (res string, err errors.EdgeX)
 }
 
 // RESTSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via REST
@@ -31,16 +31,22 @@ func NewRESTSender(dic *di.Container) Sender {
 	return &RESTSender{dic: dic}
 }
 
-// Send sends the REST request to the specified address
-func (sender *RESTSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+// Send sends the REST request to the specified address, signed when the subscription has a webhook secret
+func (sender *RESTSender) Send(notification models.Notification, subscriptionName string, address models.Address) (res string, err errors.EdgeX) {
 	lc := container.LoggingClientFrom(sender.dic.Get)
 
 	restAddress, ok := address.(models.RESTAddress)
 	if !ok {
 		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to RESTAddress", nil)
 	}
-	// NOTE: Not currently passing an AuthenticationInjector here;
-	// no current notifications are calling EdgeX services
+	secret, err := webhookSecret(sender.dic, subscriptionName)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	// NOTE: Not passing the JWT AuthenticationInjector here, no current notifications are calling EdgeX services
+	if secret != nil {
+		return utils.SendRequestWithRESTAddress(lc, notification.Content, notification.ContentType, restAddress, webhookSigner{secret: secret})
+	}
 	return utils.SendRequestWithRESTAddress(lc, notification.Content, notification.ContentType, restAddress, nil)
 }
 
@@ -55,7 +61,7 @@ func NewEmailSender(dic *di.Container) Sender {
 }
 
 // Send sends the email to the specified address
-func (sender *EmailSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+func (sender *EmailSender) Send(notification models.Notification, subscriptionName string, address models.Address) (res string, err errors.EdgeX) {
 	smtpInfo := notificationContainer.ConfigurationFrom(sender.dic.Get).Smtp
 
 	emailAddress, ok := address.(models.EmailAddress)
@@ -0,0 +1,194 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // nolint:gosec
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+)
+
+// The SMS gateway providers
+const (
+	SmsProviderTwilio = "twilio"
+)
+
+// The secret keys of the Twilio credential
+const (
+	TwilioAccountSidKey = "accountSid"
+	TwilioAuthTokenKey  = "authToken"
+	// TwilioSignatureHeader carries the signature of the Twilio status callbacks
+	TwilioSignatureHeader = "X-Twilio-Signature"
+
+	// twilioBodyLimit is the longest body of a Twilio message, which is split in segments by Twilio
+	twilioBodyLimit = 1600
+)
+
+// SmsDeliveryStatus is the delivery status of an SMS message reported by the SMS gateway
+type SmsDeliveryStatus struct {
+	MessageId string
+	// Status is the transmission status of the delivered or undelivered messages, empty while the message is in flight
+	Status models.TransmissionStatus
+	// Response describes the delivery status as reported by the SMS gateway
+	Response string
+}
+
+// SmsProvider sends the SMS messages via an SMS gateway, and authenticates and parses its delivery status callbacks
+type SmsProvider interface {
+	// Send sends the SMS message to the phone number and returns the message id of the SMS gateway
+	Send(to string, body string) (messageId string, err errors.EdgeX)
+	// DeliveryStatus returns the delivery status reported by the status callback request of the SMS gateway
+	DeliveryStatus(r *http.Request) (SmsDeliveryStatus, errors.EdgeX)
+}
+
+// NewSmsProvider creates the SmsProvider of the configured SMS gateway, nil when the SMS channels are disabled
+func NewSmsProvider(dic *di.Container) (SmsProvider, errors.EdgeX) {
+	provider := notificationContainer.ConfigurationFrom(dic.Get).Sms.Provider
+	switch strings.ToLower(provider) {
+	case "":
+		return nil, nil
+	case SmsProviderTwilio:
+		return &TwilioProvider{dic: dic, client: &http.Client{Timeout: connectorTimeout}}, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported SMS provider %s", provider), nil)
+	}
+}
+
+// TwilioProvider is the implementation of the SmsProvider, which sends the SMS messages via the Twilio Messaging API
+type TwilioProvider struct {
+	dic    *di.Container
+	client *http.Client
+}
+
+type twilioMessage struct {
+	Sid     string `json:"sid"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// Send creates the Twilio message, with the status callback URL of the configuration if any
+func (provider *TwilioProvider) Send(to string, body string) (messageId string, err errors.EdgeX) {
+	config := notificationContainer.ConfigurationFrom(provider.dic.Get).Sms
+	accountSid, authToken, err := provider.credential()
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", config.From)
+	form.Set("Body", truncate(body, twilioBodyLimit))
+	if config.StatusCallbackUrl != "" {
+		form.Set("StatusCallback", config.StatusCallbackUrl)
+	}
+	endpoint := fmt.Sprintf("https://%s/2010-04-01/Accounts/%s/Messages.json", config.Host, url.PathEscape(accountSid))
+	req, reqErr := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if reqErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "fail to create http request", reqErr)
+	}
+	req.Header.Set(common.ContentType, pkgCommon.ContentTypeForm)
+	req.SetBasicAuth(accountSid, authToken)
+
+	resp, reqErr := provider.client.Do(req)
+	if reqErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindCommunicationError, "fail to send the SMS message via Twilio", reqErr)
+	}
+	defer resp.Body.Close()
+	respBody, reqErr := io.ReadAll(resp.Body)
+	if reqErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindIOError, "fail to read the response body", reqErr)
+	}
+	var message twilioMessage
+	_ = json.Unmarshal(respBody, &message)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), fmt.Sprintf("Twilio request failed, status code: %d, err: %s", resp.StatusCode, message.Message), nil)
+	}
+	if message.Sid == "" {
+		return "", errors.NewCommonEdgeX(errors.KindCommunicationError, "Twilio response has no message sid", nil)
+	}
+	return message.Sid, nil
+}
+
+// DeliveryStatus authenticates the Twilio status callback by its signature, and maps the delivered messages to the
+// ACKNOWLEDGED transmission status and the failed and undelivered messages to the FAILED transmission status
+func (provider *TwilioProvider) DeliveryStatus(r *http.Request) (status SmsDeliveryStatus, err errors.EdgeX) {
+	config := notificationContainer.ConfigurationFrom(provider.dic.Get).Sms
+	if parseErr := r.ParseForm(); parseErr != nil {
+		return status, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the Twilio status callback", parseErr)
+	}
+	_, authToken, err := provider.credential()
+	if err != nil {
+		return status, errors.NewCommonEdgeXWrapper(err)
+	}
+	expected := TwilioSignature([]byte(authToken), config.StatusCallbackUrl, r.PostForm)
+	if !hmac.Equal([]byte(expected), []byte(r.Header.Get(TwilioSignatureHeader))) {
+		return status, errors.NewCommonEdgeX(errors.KindContractInvalid, "Twilio status callback signature mismatch", nil)
+	}
+
+	status.MessageId = r.PostForm.Get("MessageSid")
+	if status.MessageId == "" {
+		return status, errors.NewCommonEdgeX(errors.KindContractInvalid, "Twilio status callback has no MessageSid", nil)
+	}
+	messageStatus := r.PostForm.Get("MessageStatus")
+	status.Response = fmt.Sprintf("Twilio message %s %s", status.MessageId, messageStatus)
+	switch messageStatus {
+	case "delivered":
+		status.Status = models.Acknowledged
+	case "failed", "undelivered":
+		status.Status = models.Failed
+		status.Response = fmt.Sprintf("%s, error code %s", status.Response, r.PostForm.Get("ErrorCode"))
+	}
+	return status, nil
+}
+
+func (provider *TwilioProvider) credential() (accountSid string, authToken string, err errors.EdgeX) {
+	secretName := notificationContainer.ConfigurationFrom(provider.dic.Get).Sms.SecretName
+	secrets, secretErr := container.SecretProviderFrom(provider.dic.Get).GetSecret(secretName, TwilioAccountSidKey, TwilioAuthTokenKey)
+	if secretErr != nil {
+		return "", "", errors.NewCommonEdgeX(errors.Kind(secretErr), "fail to retrieve the Twilio credential from the secret store", secretErr)
+	}
+	accountSid, authToken = secrets[TwilioAccountSidKey], secrets[TwilioAuthTokenKey]
+	if accountSid == "" || authToken == "" {
+		return "", "", errors.NewCommonEdgeX(errors.KindServerError, "Twilio credential is empty", nil)
+	}
+	return accountSid, authToken, nil
+}
+
+// TwilioSignature returns the signature of the Twilio request to the URL with the POST parameters, the base64 encoded
+// HMAC-SHA1 of the URL followed by the sorted parameter names each followed by its value
+func TwilioSignature(authToken []byte, requestUrl string, params url.Values) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mac := hmac.New(sha1.New, authToken)
+	mac.Write([]byte(requestUrl))
+	for _, name := range names {
+		for _, value := range params[name] {
+			mac.Write([]byte(name))
+			mac.Write([]byte(value))
+		}
+	}
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
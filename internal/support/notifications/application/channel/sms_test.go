@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	secretMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+)
+
+const (
+	testAccountSid        = "AC0123456789"
+	testAuthToken         = "auth token"
+	testStatusCallbackUrl = "https://edgex.example.com/api/v3/smschannel/status"
+)
+
+func mockSmsDic(host string) *di.Container {
+	secretProvider := &secretMocks.SecretProvider{}
+	secretProvider.On("GetSecret", "sms", TwilioAccountSidKey, TwilioAuthTokenKey).
+		Return(map[string]string{TwilioAccountSidKey: testAccountSid, TwilioAuthTokenKey: testAuthToken}, nil)
+	return di.NewContainer(di.ServiceConstructorMap{
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Sms: config.SmsInfo{
+					Provider:          SmsProviderTwilio,
+					Host:              host,
+					From:              "+15005550006",
+					SecretName:        "sms",
+					StatusCallbackUrl: testStatusCallbackUrl,
+				},
+			}
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+	})
+}
+
+func TestTwilioProviderSend(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2010-04-01/Accounts/"+testAccountSid+"/Messages.json", r.URL.Path)
+		accountSid, authToken, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, testAccountSid, accountSid)
+		assert.Equal(t, testAuthToken, authToken)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "+15005550006", r.PostForm.Get("From"))
+		assert.Equal(t, testStatusCallbackUrl, r.PostForm.Get("StatusCallback"))
+		if r.PostForm.Get("To") == "+15005550001" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": 21211, "message": "The 'To' number is not a valid phone number."}`))
+			return
+		}
+		assert.Equal(t, "temperature too high", r.PostForm.Get("Body"))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid": "SM0123456789", "status": "queued"}`))
+	}))
+	defer server.Close()
+	serverUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	provider := &TwilioProvider{dic: mockSmsDic(serverUrl.Host), client: server.Client()}
+
+	messageId, err := provider.Send("+15005550009", "temperature too high")
+	require.NoError(t, err)
+	assert.Equal(t, "SM0123456789", messageId)
+
+	_, err = provider.Send("+15005550001", "temperature too high")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Contains(t, err.Error(), "not a valid phone number")
+}
+
+func TestTwilioProviderDeliveryStatus(t *testing.T) {
+	provider := &TwilioProvider{dic: mockSmsDic("api.twilio.com")}
+	callback := func(messageStatus string, signature string) *http.Request {
+		form := url.Values{"MessageSid": {"SM0123456789"}, "MessageStatus": {messageStatus}}
+		if messageStatus == "undelivered" {
+			form.Set("ErrorCode", "30003")
+		}
+		if signature == "" {
+			signature = TwilioSignature([]byte(testAuthToken), testStatusCallbackUrl, form)
+		}
+		r := httptest.NewRequest(http.MethodPost, testStatusCallbackUrl, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set(TwilioSignatureHeader, signature)
+		return r
+	}
+
+	tests := []struct {
+		name             string
+		request          *http.Request
+		errorExpected    bool
+		expectedStatus   models.TransmissionStatus
+		expectedResponse string
+	}{
+		{"delivered", callback("delivered", ""), false, models.Acknowledged, "Twilio message SM0123456789 delivered"},
+		{"undelivered", callback("undelivered", ""), false, models.Failed, "Twilio message SM0123456789 undelivered, error code 30003"},
+		{"in flight", callback("sent", ""), false, "", "Twilio message SM0123456789 sent"},
+		{"invalid signature", callback("delivered", "forged"), true, "", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, err := provider.DeliveryStatus(testCase.request)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "SM0123456789", status.MessageId)
+			assert.Equal(t, testCase.expectedStatus, status.Status)
+			assert.Equal(t, testCase.expectedResponse, status.Response)
+		})
+	}
+}
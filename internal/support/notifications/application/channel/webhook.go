@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// The REST channels of a subscription are signed webhooks when the subscription has a webhook secret, stored in the
+// secret store under the secret name WebhookSecretNamePrefix + subscription name with the key WebhookSecretKey. The
+// receivers authenticate the notifications by computing the HMAC-SHA256 of the timestamp header, a dot and the body
+// with the secret, and comparing it with the signature header.
+const (
+	WebhookSecretNamePrefix = "webhook-"
+	WebhookSecretKey        = "secret"
+	// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 signature prefixed with sha256=
+	WebhookSignatureHeader = "X-EdgeX-Signature"
+	// WebhookTimestampHeader carries the Unix time in seconds the notification was signed at, so that the receivers
+	// can reject the replayed notifications
+	WebhookTimestampHeader = "X-EdgeX-Signature-Timestamp"
+
+	webhookSignaturePrefix = "sha256="
+)
+
+// WebhookSecretName returns the name of the webhook secret of the subscription
+func WebhookSecretName(subscriptionName string) string {
+	return WebhookSecretNamePrefix + subscriptionName
+}
+
+// WebhookSignature returns the signature of the body sent at the timestamp with the secret
+func WebhookSignature(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookSecret returns the webhook secret of the subscription, nil when the subscription has none
+func webhookSecret(dic *di.Container, subscriptionName string) ([]byte, errors.EdgeX) {
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	if secretProvider == nil {
+		return nil, nil
+	}
+	secretName := WebhookSecretName(subscriptionName)
+	exists, err := secretProvider.HasSecret(secretName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), "fail to check the webhook secret existence", err)
+	} else if !exists {
+		return nil, nil
+	}
+	secrets, err := secretProvider.GetSecret(secretName, WebhookSecretKey)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), "fail to retrieve the webhook secret from the secret store", err)
+	}
+	secret := secrets[WebhookSecretKey]
+	if secret == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "webhook secret is empty", nil)
+	}
+	return []byte(secret), nil
+}
+
+// webhookSigner implements the AuthenticationInjector interface, and signs the requests with the webhook secret
+type webhookSigner struct {
+	secret []byte
+}
+
+// AddAuthenticationData adds the timestamp and signature headers to the request
+func (s webhookSigner) AddAuthenticationData(req *http.Request) error {
+	var body []byte
+	if req.GetBody != nil {
+		reader, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		if body, err = io.ReadAll(reader); err != nil {
+			return err
+		}
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, WebhookSignature(s.secret, timestamp, body))
+	return nil
+}
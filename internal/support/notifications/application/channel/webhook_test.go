@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	secretMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	signedSubscription   = "signedSubscription"
+	unsignedSubscription = "unsignedSubscription"
+	testWebhookSecret    = "webhook secret"
+)
+
+func TestRESTSenderWebhookSignature(t *testing.T) {
+	type received struct {
+		body      []byte
+		timestamp string
+		signature string
+	}
+	requests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests <- received{body: body, timestamp: r.Header.Get(WebhookTimestampHeader), signature: r.Header.Get(WebhookSignatureHeader)}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+	address := models.RESTAddress{
+		BaseAddress: models.BaseAddress{Type: common.REST, Host: serverURL.Hostname(), Port: port},
+		Path:        "/alerts",
+		HTTPMethod:  http.MethodPost,
+	}
+
+	secretProvider := &secretMocks.SecretProvider{}
+	secretProvider.On("HasSecret", WebhookSecretName(signedSubscription)).Return(true, nil)
+	secretProvider.On("HasSecret", WebhookSecretName(unsignedSubscription)).Return(false, nil)
+	secretProvider.On("GetSecret", WebhookSecretName(signedSubscription), WebhookSecretKey).
+		Return(map[string]string{WebhookSecretKey: testWebhookSecret}, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+	})
+	sender := NewRESTSender(dic)
+	notification := models.Notification{Content: `{"alert": "temperature too high"}`, ContentType: common.ContentTypeJSON}
+
+	tests := []struct {
+		name             string
+		subscriptionName string
+		expectedSigned   bool
+	}{
+		{"signed webhook", signedSubscription, true},
+		{"unsigned REST", unsignedSubscription, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := sender.Send(notification, testCase.subscriptionName, address)
+			require.NoError(t, err)
+
+			r := <-requests
+			assert.Equal(t, notification.Content, string(r.body))
+			if !testCase.expectedSigned {
+				assert.Empty(t, r.timestamp)
+				assert.Empty(t, r.signature)
+				return
+			}
+			require.NotEmpty(t, r.timestamp)
+			assert.Equal(t, WebhookSignature([]byte(testWebhookSecret), r.timestamp, r.body), r.signature)
+			assert.NotEqual(t, WebhookSignature([]byte("other secret"), r.timestamp, r.body), r.signature)
+		})
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac 'secret'
+	expected := "sha256=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163"
+	assert.Equal(t, expected, WebhookSignature([]byte("secret"), "1700000000", []byte("{}")))
+	assert.NotEqual(t, expected, WebhookSignature([]byte("secret"), "1700000001", []byte("{}")))
+}
@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddConnector adds the connector after checking that its subscription exists and its webhook URL is in the secret store
+func AddConnector(c notificationModels.Connector, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if _, edgeXerr = dbClient.SubscriptionByName(c.SubscriptionName); edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if _, edgeXerr = channel.ConnectorWebhookUrl(dic, c); edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	addedConnector, edgeXerr := dbClient.AddConnector(c)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("Connector created on DB successfully. Connector ID: %s, Correlation-ID: %s ",
+		addedConnector.Id,
+		correlation.FromContext(ctx))
+
+	return addedConnector.Id, nil
+}
+
+// ConnectorByName queries the connector by name
+func ConnectorByName(name string, dic *di.Container) (connector notificationDTOs.Connector, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return connector, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	c, edgeXerr := container.DBClientFrom(dic.Get).ConnectorByName(name)
+	if edgeXerr != nil {
+		return connector, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notificationDTOs.FromConnectorModelToDTO(c), nil
+}
+
+// AllConnectors queries the connectors with offset and limit
+func AllConnectors(offset, limit int, dic *di.Container) (connectors []notificationDTOs.Connector, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	connectorModels, edgeXerr := dbClient.AllConnectors(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.ConnectorTotalCount()
+	}
+	if edgeXerr != nil {
+		return connectors, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	connectors = make([]notificationDTOs.Connector, len(connectorModels))
+	for i, c := range connectorModels {
+		connectors[i] = notificationDTOs.FromConnectorModelToDTO(c)
+	}
+	return connectors, totalCount, nil
+}
+
+// DeleteConnectorByName deletes the connector by name, its webhook URL is left in the secret store
+func DeleteConnectorByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	edgeXerr := container.DBClientFrom(dic.Get).DeleteConnectorByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("Connector %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// transmitViaConnectors asynchronously transmits the notification via the connectors of the subscription
+func transmitViaConnectors(dic *di.Container, n models.Notification, sub models.Subscription) {
+	connectors, err := container.DBClientFrom(dic.Get).ConnectorsBySubscriptionName(0, -1, sub.Name)
+	if err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("fail to query the connectors of subscription %s: %v", sub.Name, err)
+		return
+	}
+	for _, c := range connectors {
+		go transmitViaConnector(dic, n, sub, c) // nolint:errcheck
+	}
+}
+
+// transmitViaConnector transmits the notification via the connector and records the transmission. The transmission
+// channel is a REST address of the webhook host only, as the path of the webhook URL is a credential. The failed
+// transmissions aren't resent, the chat connectors being a best-effort complement to the subscription channels.
+func transmitViaConnector(dic *di.Container, n models.Notification, sub models.Subscription, c notificationModels.Connector) (models.Transmission, errors.EdgeX) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	webhookUrl, err := channel.ConnectorWebhookUrl(dic, c)
+	if err != nil {
+		lc.Errorf("fail to transmit the notification %s via connector %s: %v", n.Id, c.Name, err)
+		return models.Transmission{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	trans := models.NewTransmission(sub.Name, connectorAddress(webhookUrl), n.Id)
+	record := models.TransmissionRecord{Status: models.Sent}
+	n = renderNotification(dic, n, sub.Name, c.Type)
+	record.Response, err = channel.ChatSenderFrom(dic.Get).Send(n, c, webhookUrl)
+	if err != nil {
+		record.Status = models.Failed
+		record.Response = err.Error()
+	}
+	record.Sent = pkgCommon.MakeTimestamp()
+	trans.Records = append(trans.Records, record)
+	trans.Status = record.Status
+	lc.Debugf("sent the notification to %s via connector %s, transmission status %s", sub.Name, c.Name, trans.Status)
+
+	trans, err = container.DBClientFrom(dic.Get).AddTransmission(trans)
+	if err != nil {
+		lc.Error(err.Message())
+		return trans, errors.NewCommonEdgeXWrapper(err)
+	}
+	return trans, nil
+}
+
+// connectorAddress returns the REST address of the webhook host, with the default port of the URL scheme when the URL
+// has no port
+func connectorAddress(webhookUrl *url.URL) models.RESTAddress {
+	port := 443
+	if webhookUrl.Scheme == "http" {
+		port = 80
+	}
+	if p, err := strconv.Atoi(webhookUrl.Port()); err == nil {
+		port = p
+	}
+	return models.RESTAddress{
+		BaseAddress: models.BaseAddress{Type: common.REST, Host: webhookUrl.Hostname(), Port: port},
+		HTTPMethod:  http.MethodPost,
+	}
+}
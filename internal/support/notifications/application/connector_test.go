@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	secretMocks "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	testConnectorSecretName = "slack-oncall"
+	testConnectorWebhookUrl = "https://hooks.slack.com/services/T000/B000/XXXX"
+)
+
+func mockConnectorSecretProvider() *secretMocks.SecretProvider {
+	secretProvider := &secretMocks.SecretProvider{}
+	secretProvider.On("GetSecret", testConnectorSecretName, channel.ConnectorWebhookUrlKey).
+		Return(map[string]string{channel.ConnectorWebhookUrlKey: testConnectorWebhookUrl}, nil)
+	secretProvider.On("GetSecret", mock.Anything, channel.ConnectorWebhookUrlKey).
+		Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "secret not found", nil))
+	return secretProvider
+}
+
+func TestAddConnector(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", "notFound").Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddConnector", mock.Anything).Return(notificationModels.Connector{Id: exampleUUID}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return mockConnectorSecretProvider()
+		},
+	})
+
+	valid := notificationModels.Connector{Name: "slack", SubscriptionName: testSubscriptionName, Type: notificationModels.ConnectorTypeSlack, SecretName: testConnectorSecretName}
+	subscriptionNotFound := valid
+	subscriptionNotFound.SubscriptionName = "notFound"
+	secretNotFound := valid
+	secretNotFound.SecretName = "notFound"
+
+	tests := []struct {
+		name          string
+		connector     notificationModels.Connector
+		errorExpected bool
+		expectedKind  errors.ErrKind
+	}{
+		{"valid", valid, false, ""},
+		{"invalid - subscription not found", subscriptionNotFound, true, errors.KindEntityDoesNotExist},
+		{"invalid - secret not found", secretNotFound, true, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			id, err := AddConnector(testCase.connector, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, exampleUUID, id)
+		})
+	}
+}
+
+func TestTransmitViaConnector(t *testing.T) {
+	sent := notificationModels.Connector{Name: "sent", Type: notificationModels.ConnectorTypeSlack, SecretName: testConnectorSecretName}
+	failed := notificationModels.Connector{Name: "failed", Type: notificationModels.ConnectorTypeTeams, SecretName: testConnectorSecretName}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) models.Transmission { return trans }, nil)
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", mock.Anything, mock.Anything).Return(notificationModels.NotificationTemplate{}, templateNotFound)
+	chatSender := &senderMock.ConnectorSender{}
+	chatSender.On("Send", mock.Anything, sent, mock.Anything).Return("ok", nil)
+	chatSender.On("Send", mock.Anything, failed, mock.Anything).Return("", errors.NewCommonEdgeX(errors.KindCommunicationError, "timeout", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return mockConnectorSecretProvider()
+		},
+		channel.ChatSenderName: func(get di.Get) interface{} {
+			return chatSender
+		},
+	})
+
+	tests := []struct {
+		name           string
+		connector      notificationModels.Connector
+		expectedStatus models.TransmissionStatus
+	}{
+		{"sent", sent, models.Sent},
+		{"failed", failed, models.Failed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			trans, err := transmitViaConnector(dic, notification, sub, testCase.connector)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatus, trans.Status)
+			require.Len(t, trans.Records, 1)
+			// the webhook path is a credential, so only the host is recorded
+			assert.Equal(t, models.RESTAddress{
+				BaseAddress: models.BaseAddress{Type: common.REST, Host: "hooks.slack.com", Port: 443},
+				HTTPMethod:  http.MethodPost,
+			}, trans.Channel)
+		})
+	}
+}
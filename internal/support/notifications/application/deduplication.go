@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+)
+
+// deduplicationKey identifies the duplicated notifications by the hash of their category, labels and content
+func deduplicationKey(n models.Notification) string {
+	labels := make([]string, len(n.Labels))
+	copy(labels, n.Labels)
+	sort.Strings(labels)
+
+	hash := sha256.New()
+	hash.Write([]byte(n.Category))
+	hash.Write([]byte{0})
+	hash.Write([]byte(strings.Join(labels, "\x00")))
+	hash.Write([]byte{0})
+	hash.Write([]byte(n.Content))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// suppressDuplicate returns true when the deduplication is enabled and the notification is a duplicate of a
+// notification distributed within the suppression window. The first notification of the window schedules the
+// follow-up notification reporting the suppressed duplicates when the window elapses.
+func suppressDuplicate(dic *di.Container, n models.Notification) bool {
+	config := container.ConfigurationFrom(dic.Get).Writable.Deduplication
+	if !config.Enabled {
+		return false
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	window, err := time.ParseDuration(config.Window)
+	if err != nil || window <= 0 {
+		lc.Errorf("deduplication window '%s' must be a positive duration, distribute the notification %s", config.Window, n.Id)
+		return false
+	}
+	key := deduplicationKey(n)
+	suppressed, edgeXerr := container.DBClientFrom(dic.Get).AddNotificationOccurrence(key, n.Id, window)
+	if edgeXerr != nil {
+		lc.Errorf("fail to record the occurrence of notification %s, distribute the notification: %v", n.Id, edgeXerr)
+		return false
+	}
+	if suppressed {
+		lc.Debugf("notification %s is suppressed as a duplicate", n.Id)
+		return true
+	}
+
+	time.AfterFunc(window, func() {
+		reportSuppressedDuplicates(dic, n, key, window)
+	})
+	return false
+}
+
+// reportSuppressedDuplicates distributes the follow-up notification reporting the count of the duplicates of the
+// notification suppressed within the window, if any
+func reportSuppressedDuplicates(dic *di.Container, n models.Notification, key string, window time.Duration) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	count, err := dbClient.PopSuppressedNotificationCount(key)
+	if err != nil {
+		lc.Errorf("fail to query the suppressed duplicates of notification %s: %v", n.Id, err)
+		return
+	}
+	if count == 0 {
+		return
+	}
+
+	followUp, err := dbClient.AddNotification(suppressedNotification(n, count, window))
+	if err != nil {
+		lc.Errorf("fail to create the follow-up notification of the suppressed duplicates of notification %s: %v", n.Id, err)
+		return
+	}
+	lc.Infof("%d duplicates of notification %s suppressed within %s", count, n.Id, window)
+	if err := distribute(dic, followUp); err != nil {
+		lc.Errorf("fail to distribute the follow-up notification %s: %v", followUp.Id, err)
+	}
+}
+
+func suppressedNotification(n models.Notification, count uint32, window time.Duration) models.Notification {
+	id := n.Id
+	n.Id = ""
+	n.Created = 0
+	n.Modified = 0
+	n.Content = fmt.Sprintf("[%d occurrences of notification %s suppressed within %s] %s", count, id, window, n.Content)
+	n.ContentType = common.ContentTypeText
+	n.Status = models.New
+	return n
+}
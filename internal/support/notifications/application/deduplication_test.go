@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+)
+
+func TestDeduplicationKey(t *testing.T) {
+	n := notification
+	n.Labels = []string{"label1", "label2"}
+	reorderedLabels := n
+	reorderedLabels.Labels = []string{"label2", "label1"}
+	otherSender := n
+	otherSender.Sender = "senderB"
+	otherContent := n
+	otherContent.Content = "other"
+	otherCategory := n
+	otherCategory.Category = "other"
+	otherLabels := n
+	otherLabels.Labels = []string{"label1"}
+
+	assert.Equal(t, deduplicationKey(n), deduplicationKey(reorderedLabels))
+	assert.Equal(t, deduplicationKey(n), deduplicationKey(otherSender))
+	assert.NotEqual(t, deduplicationKey(n), deduplicationKey(otherContent))
+	assert.NotEqual(t, deduplicationKey(n), deduplicationKey(otherCategory))
+	assert.NotEqual(t, deduplicationKey(n), deduplicationKey(otherLabels))
+}
+
+func TestSuppressDuplicate(t *testing.T) {
+	first := notification
+	first.Id = "first"
+	duplicate := notification
+	duplicate.Id = "duplicate"
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddNotificationOccurrence", deduplicationKey(notification), first.Id, time.Hour).Return(false, nil)
+	dbClientMock.On("AddNotificationOccurrence", deduplicationKey(notification), duplicate.Id, time.Hour).Return(true, nil)
+
+	tests := []struct {
+		name               string
+		deduplication      config.DeduplicationInfo
+		notification       models.Notification
+		expectedSuppressed bool
+	}{
+		{"disabled", config.DeduplicationInfo{Enabled: false, Window: "1h"}, duplicate, false},
+		{"invalid window", config.DeduplicationInfo{Enabled: true, Window: "0s"}, duplicate, false},
+		{"first occurrence", config.DeduplicationInfo{Enabled: true, Window: "1h"}, first, false},
+		{"duplicate", config.DeduplicationInfo{Enabled: true, Window: "1h"}, duplicate, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			container.ConfigurationFrom(dic.Get).Writable.Deduplication = testCase.deduplication
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+
+			suppressed := suppressDuplicate(dic, testCase.notification)
+			assert.Equal(t, testCase.expectedSuppressed, suppressed)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddNotificationOccurrence", 2)
+}
+
+func TestReportSuppressedDuplicates(t *testing.T) {
+	n := notification
+	n.Id = "first"
+	suppressedKey := "suppressed"
+	noneKey := "none"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("PopSuppressedNotificationCount", suppressedKey).Return(uint32(3), nil)
+	dbClientMock.On("PopSuppressedNotificationCount", noneKey).Return(uint32(0), nil)
+	dbClientMock.On("AddNotification", mock.Anything).Return(func(n models.Notification) models.Notification {
+		n.Id = exampleUUID
+		return n
+	}, nil)
+	dbClientMock.On("SubscriptionsByCategoriesAndLabels", 0, -1, mock.Anything, mock.Anything).Return([]models.Subscription{}, nil)
+	dbClientMock.On("UpdateNotification", mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	reportSuppressedDuplicates(dic, n, noneKey, 5*time.Minute)
+	dbClientMock.AssertNotCalled(t, "AddNotification", mock.Anything)
+
+	reportSuppressedDuplicates(dic, n, suppressedKey, 5*time.Minute)
+	dbClientMock.AssertNumberOfCalls(t, "AddNotification", 1)
+	dbClientMock.AssertCalled(t, "AddNotification", mock.MatchedBy(func(followUp models.Notification) bool {
+		return followUp.Content == "[3 occurrences of notification first suppressed within 5m0s] test" &&
+			followUp.Category == n.Category && followUp.Status == models.New
+	}))
+	dbClientMock.AssertCalled(t, "UpdateNotification", mock.MatchedBy(func(processed models.Notification) bool {
+		return processed.Id == exampleUUID && processed.Status == models.Processed
+	}))
+}
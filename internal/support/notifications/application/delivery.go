@@ -0,0 +1,303 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// quietHoursLayout is the layout of the start and end of the quiet hours
+const quietHoursLayout = "15:04"
+
+// AddDeliveryPolicy adds the delivery policy after checking its rate limit interval, time zone and quiet hours, and
+// that its subscription exists
+func AddDeliveryPolicy(p notificationModels.DeliveryPolicy, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if p.RateLimit > 0 {
+		interval, err := time.ParseDuration(p.RateLimitInterval)
+		if err != nil || interval <= 0 {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("rate limit interval '%s' must be a positive duration", p.RateLimitInterval), err)
+		}
+	}
+	if _, _, err := quietHoursEnd(p, time.Now()); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid quiet hours of delivery policy %s", p.Name), err)
+	}
+	if _, edgeXerr = dbClient.SubscriptionByName(p.SubscriptionName); edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	addedPolicy, edgeXerr := dbClient.AddDeliveryPolicy(p)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("DeliveryPolicy created on DB successfully. DeliveryPolicy ID: %s, Correlation-ID: %s ",
+		addedPolicy.Id,
+		correlation.FromContext(ctx))
+
+	return addedPolicy.Id, nil
+}
+
+// DeliveryPolicyByName queries the delivery policy by name
+func DeliveryPolicyByName(name string, dic *di.Container) (policy notificationDTOs.DeliveryPolicy, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return policy, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	p, edgeXerr := container.DBClientFrom(dic.Get).DeliveryPolicyByName(name)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notificationDTOs.FromDeliveryPolicyModelToDTO(p), nil
+}
+
+// AllDeliveryPolicies queries the delivery policies with offset and limit
+func AllDeliveryPolicies(offset, limit int, dic *di.Container) (policies []notificationDTOs.DeliveryPolicy, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	policyModels, edgeXerr := dbClient.AllDeliveryPolicies(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.DeliveryPolicyTotalCount()
+	}
+	if edgeXerr != nil {
+		return policies, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	policies = make([]notificationDTOs.DeliveryPolicy, len(policyModels))
+	for i, p := range policyModels {
+		policies[i] = notificationDTOs.FromDeliveryPolicyModelToDTO(p)
+	}
+	return policies, totalCount, nil
+}
+
+// DeleteDeliveryPolicyByName deletes the delivery policy by name and sends the notifications queued by the policy
+// right away
+func DeleteDeliveryPolicyByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	p, edgeXerr := dbClient.DeliveryPolicyByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if edgeXerr = dbClient.DeleteDeliveryPolicyByName(name); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("DeliveryPolicy %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+
+	go func() {
+		if err := flushQueuedNotifications(dic, p.SubscriptionName); err != nil {
+			lc.Errorf("fail to send the notifications queued by the deleted delivery policy %s: %v", name, err)
+		}
+	}()
+	return nil
+}
+
+// ScheduleQueuedNotificationFlushes schedules the sending of the notifications queued during the quiet hours before
+// the service restarted, at the end of the quiet hours or right away when the quiet hours are over
+func ScheduleQueuedNotificationFlushes(dic *di.Container) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	policies, err := dbClient.AllDeliveryPolicies(0, -1)
+	if err != nil {
+		lc.Errorf("fail to query the delivery policies, the queued notifications will be sent after the next quiet hours: %v", err)
+		return
+	}
+	for _, p := range policies {
+		count, err := dbClient.QueuedNotificationCount(p.SubscriptionName)
+		if err != nil {
+			lc.Errorf("fail to count the queued notifications of delivery policy %s: %v", p.Name, err)
+			continue
+		}
+		if count == 0 {
+			continue
+		}
+		end, quiet, _ := quietHoursEnd(p, time.Now())
+		if !quiet {
+			end = time.Now()
+		}
+		scheduleQueuedNotificationFlush(dic, p.SubscriptionName, end)
+	}
+}
+
+// deliveryPolicyOf returns the delivery policy of the subscription, and false when the subscription has no policy or
+// the notification is CRITICAL and must be sent right away
+func deliveryPolicyOf(dic *di.Container, n models.Notification, sub models.Subscription) (notificationModels.DeliveryPolicy, bool) {
+	if n.Severity == models.Critical {
+		return notificationModels.DeliveryPolicy{}, false
+	}
+	p, err := container.DBClientFrom(dic.Get).DeliveryPolicyBySubscriptionName(sub.Name)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("fail to query the delivery policy of subscription %s, send the notification %s right away: %v", sub.Name, n.Id, err)
+		}
+		return p, false
+	}
+	return p, true
+}
+
+// holdDuringQuietHours suppresses or queues the notification distributed during the quiet hours of the delivery
+// policy, and returns false when the notification must be sent
+func holdDuringQuietHours(dic *di.Container, n models.Notification, sub models.Subscription, p notificationModels.DeliveryPolicy) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	end, quiet, err := quietHoursEnd(p, time.Now())
+	if err != nil {
+		lc.Errorf("delivery policy %s has invalid quiet hours, send the notification %s: %v", p.Name, n.Id, err)
+		return false
+	}
+	if !quiet {
+		return false
+	}
+	if p.QuietHoursAction != notificationModels.QuietHoursQueue {
+		lc.Debugf("notification %s is suppressed during the quiet hours of subscription %s", n.Id, sub.Name)
+		return true
+	}
+
+	queued, err := container.DBClientFrom(dic.Get).AddQueuedNotification(sub.Name, n)
+	if err != nil {
+		lc.Errorf("fail to queue the notification %s during the quiet hours of subscription %s, send it right away: %v", n.Id, sub.Name, err)
+		return false
+	}
+	// the first queued notification schedules the sending at the end of the quiet hours
+	if queued == 1 {
+		scheduleQueuedNotificationFlush(dic, sub.Name, end)
+	}
+	lc.Debugf("notification %s is queued until the quiet hours of subscription %s end at %s", n.Id, sub.Name, end)
+	return true
+}
+
+// exceedsRateLimit counts the notification sent to the subscription, and returns true when the notification exceeds
+// the rate limit of the delivery policy and must be dropped
+func exceedsRateLimit(dic *di.Container, n models.Notification, sub models.Subscription, p notificationModels.DeliveryPolicy) bool {
+	if p.RateLimit <= 0 {
+		return false
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	interval, err := time.ParseDuration(p.RateLimitInterval)
+	if err != nil || interval <= 0 {
+		lc.Errorf("delivery policy %s has an invalid rate limit interval '%s', send the notification %s", p.Name, p.RateLimitInterval, n.Id)
+		return false
+	}
+	count, edgeXerr := container.DBClientFrom(dic.Get).IncrNotificationRate(sub.Name, interval)
+	if edgeXerr != nil {
+		lc.Errorf("fail to count the notifications sent to subscription %s, send the notification %s: %v", sub.Name, n.Id, edgeXerr)
+		return false
+	}
+	if count > uint32(p.RateLimit) {
+		lc.Debugf("notification %s exceeds the rate limit of %d notifications per %s of subscription %s and is dropped", n.Id, p.RateLimit, interval, sub.Name)
+		return true
+	}
+	return false
+}
+
+// scheduleQueuedNotificationFlush schedules the sending of the notifications queued during the quiet hours of the
+// subscription at the end of the quiet hours
+func scheduleQueuedNotificationFlush(dic *di.Container, subscriptionName string, end time.Time) {
+	time.AfterFunc(time.Until(end), func() {
+		if err := flushQueuedNotifications(dic, subscriptionName); err != nil {
+			bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("fail to send the notifications queued during the quiet hours of subscription %s: %v", subscriptionName, err)
+		}
+	})
+}
+
+// flushQueuedNotifications sends the notifications queued during the quiet hours of the subscription, or reschedules
+// the sending when the quiet hours of the delivery policy were extended meanwhile
+func flushQueuedNotifications(dic *di.Container, subscriptionName string) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	p, err := dbClient.DeliveryPolicyBySubscriptionName(subscriptionName)
+	if err == nil && p.QuietHoursAction == notificationModels.QuietHoursQueue {
+		if end, quiet, _ := quietHoursEnd(p, time.Now()); quiet {
+			scheduleQueuedNotificationFlush(dic, subscriptionName, end)
+			return nil
+		}
+	} else if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	ids, err := dbClient.PopQueuedNotificationIds(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	sub, err := dbClient.SubscriptionByName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to query subscription %s, drop %d queued notifications", subscriptionName, len(ids)), err)
+	}
+	if sub.AdminState == models.Locked {
+		lc.Debugf("subscription %s is locked, skip the queued notifications transmission", sub.Name)
+		return nil
+	}
+
+	for _, id := range ids {
+		n, err := dbClient.NotificationById(id)
+		if err != nil {
+			lc.Debugf("skip the queued notification %s: %v", id, err)
+			continue
+		}
+		transmitToSubscription(dic, n, sub)
+	}
+	return nil
+}
+
+// quietHoursEnd returns the end of the quiet hours of the delivery policy which the time t is in, and false when t
+// isn't in the quiet hours. Overlapping quiet hours end with the latest one.
+func quietHoursEnd(p notificationModels.DeliveryPolicy, t time.Time) (end time.Time, quiet bool, err error) {
+	location, err := time.LoadLocation(p.TimeZone)
+	if err != nil {
+		return end, false, err
+	}
+	t = t.In(location)
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+
+	for _, q := range p.QuietHours {
+		from, err := time.Parse(quietHoursLayout, q.Start)
+		if err != nil {
+			return end, false, err
+		}
+		to, err := time.Parse(quietHoursLayout, q.End)
+		if err != nil {
+			return end, false, err
+		}
+		// the quiet hours which started yesterday may end today
+		for _, day := range []time.Time{today.AddDate(0, 0, -1), today} {
+			if len(q.Days) > 0 && !containsString(q.Days, strings.ToUpper(day.Weekday().String()[:3])) {
+				continue
+			}
+			start := time.Date(day.Year(), day.Month(), day.Day(), from.Hour(), from.Minute(), 0, 0, location)
+			stop := time.Date(day.Year(), day.Month(), day.Day(), to.Hour(), to.Minute(), 0, 0, location)
+			if !stop.After(start) {
+				stop = stop.AddDate(0, 0, 1)
+			}
+			if !t.Before(start) && t.Before(stop) && stop.After(end) {
+				end = stop
+				quiet = true
+			}
+		}
+	}
+	return end, quiet, nil
+}
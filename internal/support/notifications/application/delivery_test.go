@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestAddDeliveryPolicy(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", "notFound").Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddDeliveryPolicy", mock.Anything).Return(notificationModels.DeliveryPolicy{Id: exampleUUID}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	valid := notificationModels.DeliveryPolicy{
+		Name:              "nightly",
+		SubscriptionName:  testSubscriptionName,
+		RateLimit:         10,
+		RateLimitInterval: "1h",
+		TimeZone:          "Europe/Paris",
+		QuietHours:        []notificationModels.QuietHours{{Start: "22:00", End: "07:00"}},
+		QuietHoursAction:  notificationModels.QuietHoursQueue,
+	}
+	invalidInterval := valid
+	invalidInterval.RateLimitInterval = "-1h"
+	invalidTimeZone := valid
+	invalidTimeZone.TimeZone = "Mars/Olympus_Mons"
+	invalidQuietHours := valid
+	invalidQuietHours.QuietHours = []notificationModels.QuietHours{{Start: "25:00", End: "07:00"}}
+	subscriptionNotFound := valid
+	subscriptionNotFound.SubscriptionName = "notFound"
+
+	tests := []struct {
+		name          string
+		policy        notificationModels.DeliveryPolicy
+		errorExpected bool
+		expectedKind  errors.ErrKind
+	}{
+		{"valid", valid, false, ""},
+		{"invalid - rate limit interval", invalidInterval, true, errors.KindContractInvalid},
+		{"invalid - time zone", invalidTimeZone, true, errors.KindContractInvalid},
+		{"invalid - quiet hours", invalidQuietHours, true, errors.KindContractInvalid},
+		{"invalid - subscription not found", subscriptionNotFound, true, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			id, err := AddDeliveryPolicy(testCase.policy, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, exampleUUID, id)
+		})
+	}
+}
+
+func TestQuietHoursEnd(t *testing.T) {
+	nightly := notificationModels.QuietHours{Start: "22:00", End: "07:00"}
+	weekend := notificationModels.QuietHours{Start: "00:00", End: "00:00", Days: []string{"SAT", "SUN"}}
+	newYork := notificationModels.DeliveryPolicy{TimeZone: "America/New_York", QuietHours: []notificationModels.QuietHours{nightly}}
+	utc := notificationModels.DeliveryPolicy{QuietHours: []notificationModels.QuietHours{nightly, weekend}}
+
+	// 2023-06-10 is a Saturday, New York is UTC-4
+	saturday := func(hour, minute int) time.Time {
+		return time.Date(2023, 6, 10, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name          string
+		policy        notificationModels.DeliveryPolicy
+		time          time.Time
+		expectedQuiet bool
+		expectedEnd   time.Time
+	}{
+		{"quiet - started the day before", newYork, saturday(3, 0), true, saturday(11, 0)},
+		{"quiet - before the end", newYork, saturday(10, 59), true, saturday(11, 0)},
+		{"not quiet - at the end", newYork, saturday(11, 0), false, time.Time{}},
+		{"quiet - at the start", newYork, saturday(2, 0), true, saturday(11, 0)},
+		{"not quiet - before the start", newYork, saturday(1, 59), false, time.Time{}},
+		{"quiet - on the days", utc, saturday(12, 0), true, saturday(24, 0)},
+		{"quiet - overlapping quiet hours end with the latest", utc, saturday(23, 0), true, saturday(31, 0)},
+		{"quiet - started on the days", utc, saturday(24+23, 0), true, saturday(48+7, 0)},
+		{"not quiet - not on the days", utc, saturday(48+12, 0), false, time.Time{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			end, quiet, err := quietHoursEnd(testCase.policy, testCase.time)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedQuiet, quiet)
+			if testCase.expectedQuiet {
+				assert.True(t, testCase.expectedEnd.Equal(end), "expected %s, got %s", testCase.expectedEnd, end)
+			}
+		})
+	}
+}
+
+func TestExceedsRateLimit(t *testing.T) {
+	limitedSubscription := models.Subscription{Name: "limited"}
+	exceededSubscription := models.Subscription{Name: "exceeded"}
+	policy := notificationModels.DeliveryPolicy{Name: "limit", RateLimit: 3, RateLimitInterval: "1m"}
+	noLimit := notificationModels.DeliveryPolicy{Name: "noLimit"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("IncrNotificationRate", limitedSubscription.Name, time.Minute).Return(uint32(3), nil)
+	dbClientMock.On("IncrNotificationRate", exceededSubscription.Name, time.Minute).Return(uint32(4), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name             string
+		subscription     models.Subscription
+		policy           notificationModels.DeliveryPolicy
+		expectedExceeded bool
+	}{
+		{"within the rate limit", limitedSubscription, policy, false},
+		{"exceeds the rate limit", exceededSubscription, policy, true},
+		{"no rate limit", limitedSubscription, noLimit, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			exceeded := exceedsRateLimit(dic, notification, testCase.subscription, testCase.policy)
+			assert.Equal(t, testCase.expectedExceeded, exceeded)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "IncrNotificationRate", 2)
+}
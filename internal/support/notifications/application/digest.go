@@ -0,0 +1,253 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// DigestLabel labels the digest notifications, which aggregate the notifications distributed to a subscription
+const DigestLabel = "digest"
+
+// AddDigest adds the digest after checking that its window is positive and its subscription exists
+func AddDigest(d notificationModels.Digest, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	window, err := time.ParseDuration(d.Window)
+	if err != nil || window <= 0 {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("digest window '%s' must be a positive duration", d.Window), err)
+	}
+	if _, edgeXerr = dbClient.SubscriptionByName(d.SubscriptionName); edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	addedDigest, edgeXerr := dbClient.AddDigest(d)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("Digest created on DB successfully. Digest ID: %s, Correlation-ID: %s ",
+		addedDigest.Id,
+		correlation.FromContext(ctx))
+
+	return addedDigest.Id, nil
+}
+
+// DigestByName queries the digest by name
+func DigestByName(name string, dic *di.Container) (digest notificationDTOs.Digest, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return digest, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	d, edgeXerr := container.DBClientFrom(dic.Get).DigestByName(name)
+	if edgeXerr != nil {
+		return digest, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notificationDTOs.FromDigestModelToDTO(d), nil
+}
+
+// AllDigests queries the digests with offset and limit
+func AllDigests(offset, limit int, dic *di.Container) (digests []notificationDTOs.Digest, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	digestModels, edgeXerr := dbClient.AllDigests(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.DigestTotalCount()
+	}
+	if edgeXerr != nil {
+		return digests, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	digests = make([]notificationDTOs.Digest, len(digestModels))
+	for i, d := range digestModels {
+		digests[i] = notificationDTOs.FromDigestModelToDTO(d)
+	}
+	return digests, totalCount, nil
+}
+
+// DeleteDigestByName deletes the digest by name and sends the notifications pending in the digest right away
+func DeleteDigestByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	d, edgeXerr := dbClient.DigestByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if edgeXerr = dbClient.DeleteDigestByName(name); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("Digest %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+
+	go func() {
+		if err := flushDigest(dic, d.SubscriptionName, d.Window); err != nil {
+			lc.Errorf("fail to send the notifications pending in the deleted digest %s: %v", name, err)
+		}
+	}()
+	return nil
+}
+
+// ScheduleDigestFlushes schedules the sending of the digests with pending notifications, which were aggregated before
+// the service restarted, after their window
+func ScheduleDigestFlushes(dic *di.Container) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	digests, err := dbClient.AllDigests(0, -1)
+	if err != nil {
+		lc.Errorf("fail to query the digests, the pending notifications will be sent with the next digests: %v", err)
+		return
+	}
+	for _, d := range digests {
+		count, err := dbClient.DigestedNotificationCount(d.SubscriptionName)
+		if err != nil {
+			lc.Errorf("fail to count the pending notifications of digest %s: %v", d.Name, err)
+			continue
+		}
+		if count > 0 {
+			scheduleDigestFlush(dic, d.SubscriptionName, d.Window)
+		}
+	}
+}
+
+// digestNotification aggregates the notification in the digest of the subscription, and returns false when the
+// notification isn't aggregated and must be sent right away
+func digestNotification(dic *di.Container, n models.Notification, sub models.Subscription) bool {
+	if n.Severity == models.Critical {
+		return false
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	d, err := dbClient.DigestBySubscriptionName(sub.Name)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Errorf("fail to query the digest of subscription %s, send the notification %s right away: %v", sub.Name, n.Id, err)
+		}
+		return false
+	}
+	if len(d.Categories) > 0 && !containsString(d.Categories, n.Category) {
+		return false
+	}
+
+	pending, err := dbClient.AddDigestedNotification(sub.Name, n)
+	if err != nil {
+		lc.Errorf("fail to aggregate the notification %s in digest %s, send it right away: %v", n.Id, d.Name, err)
+		return false
+	}
+	// the first pending notification opens the window of the digest
+	if pending == 1 {
+		scheduleDigestFlush(dic, sub.Name, d.Window)
+	}
+	lc.Debugf("notification %s is aggregated in digest %s", n.Id, d.Name)
+	return true
+}
+
+// scheduleDigestFlush schedules the sending of the digest of the subscription after the window
+func scheduleDigestFlush(dic *di.Container, subscriptionName string, window string) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	duration, err := time.ParseDuration(window)
+	if err != nil {
+		lc.Errorf("digest of subscription %s has an invalid window '%s', send it right away: %v", subscriptionName, window, err)
+	}
+	time.AfterFunc(duration, func() {
+		if err := flushDigest(dic, subscriptionName, window); err != nil {
+			lc.Errorf("fail to send the digest of subscription %s: %v", subscriptionName, err)
+		}
+	})
+}
+
+// flushDigest sends the notifications pending in the digest of the subscription as one digest notification
+func flushDigest(dic *di.Container, subscriptionName string, window string) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	ids, err := dbClient.PopDigestedNotificationIds(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	var notifications []models.Notification
+	for _, id := range ids {
+		n, err := dbClient.NotificationById(id)
+		if err != nil {
+			lc.Debugf("skip the digested notification %s: %v", id, err)
+			continue
+		}
+		notifications = append(notifications, n)
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	sub, err := dbClient.SubscriptionByName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to query subscription %s, drop the digest of %d notifications", subscriptionName, len(notifications)), err)
+	}
+	if sub.AdminState == models.Locked {
+		lc.Debugf("subscription %s is locked, skip the digest transmission", sub.Name)
+		return nil
+	}
+
+	digest, err := dbClient.AddNotification(digestedNotification(notifications, subscriptionName, window))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "fail to create the digest notification", err)
+	}
+	transmitToSubscription(dic, digest, sub)
+	return nil
+}
+
+// digestedNotification summarizes the notifications in one digest notification, with the highest severity and the
+// category of the notifications when they share it
+func digestedNotification(notifications []models.Notification, subscriptionName string, window string) models.Notification {
+	digest := models.Notification{
+		Sender:      common.SupportNotificationsServiceKey,
+		Category:    notifications[0].Category,
+		Labels:      []string{DigestLabel},
+		Severity:    models.Normal,
+		ContentType: common.ContentTypeText,
+		Description: fmt.Sprintf("Digest of %d notifications", len(notifications)),
+		Status:      models.Processed,
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("%d notifications sent to subscription %s within %s:\n", len(notifications), subscriptionName, window))
+	for _, n := range notifications {
+		if n.Category != digest.Category {
+			digest.Category = ""
+		}
+		if n.Severity == models.Minor {
+			digest.Severity = models.Minor
+		}
+		content.WriteString(fmt.Sprintf("- [%s] %s from %s: %s\n", n.Severity, n.Category, n.Sender, n.Content))
+	}
+	digest.Content = content.String()
+	return digest
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestDigestNotification(t *testing.T) {
+	digestedSubscription := models.Subscription{Name: "digested"}
+	categoryDigestSubscription := models.Subscription{Name: "categoryDigest"}
+	pendingSubscription := models.Subscription{Name: "pending"}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DigestBySubscriptionName", digestedSubscription.Name).Return(notificationModels.Digest{Name: "all", Window: "1h"}, nil)
+	dbClientMock.On("DigestBySubscriptionName", pendingSubscription.Name).Return(notificationModels.Digest{Name: "pending", Window: "1h"}, nil)
+	dbClientMock.On("DigestBySubscriptionName", categoryDigestSubscription.Name).Return(notificationModels.Digest{Name: "category", Window: "1h", Categories: []string{"noisy"}}, nil)
+	dbClientMock.On("DigestBySubscriptionName", sub.Name).Return(notificationModels.Digest{}, notFound)
+	dbClientMock.On("AddDigestedNotification", digestedSubscription.Name, mock.Anything).Return(uint32(1), nil)
+	dbClientMock.On("AddDigestedNotification", pendingSubscription.Name, mock.Anything).Return(uint32(5), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	critical := notification
+	critical.Severity = models.Critical
+
+	tests := []struct {
+		name             string
+		notification     models.Notification
+		subscription     models.Subscription
+		expectedDigested bool
+	}{
+		{"digested", notification, digestedSubscription, true},
+		{"digested with pending notifications", notification, pendingSubscription, true},
+		{"not digested - critical", critical, digestedSubscription, false},
+		{"not digested - no digest", notification, sub, false},
+		{"not digested - category not digested", notification, categoryDigestSubscription, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			digested := digestNotification(dic, testCase.notification, testCase.subscription)
+			assert.Equal(t, testCase.expectedDigested, digested)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddDigestedNotification", 2)
+}
+
+func TestFlushDigest(t *testing.T) {
+	digestSubscription := models.Subscription{Name: "digest", Channels: []models.Address{testRestAddress}, AdminState: models.Unlocked}
+	minor := notification
+	minor.Id = "minor"
+	minor.Severity = models.Minor
+	minor.Content = "disk almost full"
+	normal := notification
+	normal.Id = "normal"
+	normal.Category = "other"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("PopDigestedNotificationIds", digestSubscription.Name).Return([]string{minor.Id, "deleted", normal.Id}, nil).Once()
+	dbClientMock.On("PopDigestedNotificationIds", digestSubscription.Name).Return([]string{}, nil)
+	dbClientMock.On("NotificationById", minor.Id).Return(minor, nil)
+	dbClientMock.On("NotificationById", normal.Id).Return(normal, nil)
+	dbClientMock.On("NotificationById", "deleted").Return(models.Notification{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("SubscriptionByName", digestSubscription.Name).Return(digestSubscription, nil)
+	dbClientMock.On("AddNotification", mock.Anything).Return(func(n models.Notification) models.Notification {
+		n.Id = exampleUUID
+		return n
+	}, nil)
+	dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) models.Transmission { return trans }, nil)
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", mock.Anything, mock.Anything).Return(notificationModels.NotificationTemplate{}, templateNotFound)
+	dbClientMock.On("ConnectorsBySubscriptionName", 0, -1, digestSubscription.Name).Return([]notificationModels.Connector{}, nil)
+	dbClientMock.On("SmsChannelsBySubscriptionName", 0, -1, digestSubscription.Name).Return([]notificationModels.SmsChannel{}, nil)
+
+	sent := make(chan models.Notification, 1)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", mock.Anything, digestSubscription.Name, testRestAddress).Return("", nil).Run(func(args mock.Arguments) {
+		sent <- args.Get(0).(models.Notification)
+	})
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+
+	err := flushDigest(dic, digestSubscription.Name, "15m")
+	require.NoError(t, err)
+
+	select {
+	case digest := <-sent:
+		assert.Equal(t, exampleUUID, digest.Id)
+		assert.Equal(t, common.SupportNotificationsServiceKey, digest.Sender)
+		assert.Equal(t, []string{DigestLabel}, digest.Labels)
+		assert.Empty(t, digest.Category)
+		assert.EqualValues(t, models.Minor, digest.Severity)
+		assert.Equal(t, "2 notifications sent to subscription digest within 15m:\n"+
+			"- [MINOR] health-check from senderA: disk almost full\n"+
+			"- [NORMAL] other from senderA: test\n", digest.Content)
+	case <-time.After(time.Second):
+		require.Fail(t, "the digest is not sent")
+	}
+
+	// nothing pending, no digest
+	err = flushDigest(dic, digestSubscription.Name, "15m")
+	require.NoError(t, err)
+	dbClientMock.AssertNumberOfCalls(t, "AddNotification", 1)
+}
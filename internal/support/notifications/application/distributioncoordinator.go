@@ -19,6 +19,10 @@ func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
+	if suppressDuplicate(dic, n) {
+		return markProcessed(dic, n)
+	}
+
 	var categories []string
 	if n.Category != "" {
 		categories = append(categories, n.Category)
@@ -34,16 +38,39 @@ func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
 			lc.Debugf("subscription %s is locked, skip the notification transmission", sub.Name)
 			continue
 		}
-		for _, address := range sub.Channels {
-			// Async transmit the notification to improve the performance
-			go transmit(dic, n, sub, address) // nolint:errcheck
+		policy, hasPolicy := deliveryPolicyOf(dic, n, sub)
+		if hasPolicy && holdDuringQuietHours(dic, n, sub, policy) {
+			continue
+		}
+		if digestNotification(dic, n, sub) {
+			continue
+		}
+		if hasPolicy && exceedsRateLimit(dic, n, sub, policy) {
+			continue
 		}
+		transmitToSubscription(dic, n, sub)
+		scheduleEscalations(dic, n, sub)
+	}
+
+	return markProcessed(dic, n)
+}
+
+// transmitToSubscription transmits the notification via the channels, connectors and SMS channels of the subscription
+func transmitToSubscription(dic *di.Container, n models.Notification, sub models.Subscription) {
+	for _, address := range sub.Channels {
+		// Async transmit the notification to improve the performance
+		go transmit(dic, n, sub, address) // nolint:errcheck
 	}
+	transmitViaConnectors(dic, n, sub)
+	transmitViaSmsChannels(dic, n, sub)
+}
 
+// markProcessed updates the notification status to processed
+func markProcessed(dic *di.Container, n models.Notification) errors.EdgeX {
 	n.Status = models.Processed
-	err = dbClient.UpdateNotification(n)
+	err := container.DBClientFrom(dic.Get).UpdateNotification(n)
 	if err != nil {
-		lc.Errorf("fail to update notification status to processed", err)
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("fail to update notification status to processed", err)
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	return nil
@@ -0,0 +1,218 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// UnacknowledgedContentNotice prefixes the content of the notifications escalated by an escalation policy, followed by
+// the id of the notification to acknowledge
+const UnacknowledgedContentNotice = "This notification is escalated as it was not acknowledged, acknowledge the notification"
+
+// AddEscalationPolicy adds the escalation policy after checking that its window is positive and its subscriptions exist
+func AddEscalationPolicy(p notificationModels.EscalationPolicy, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	window, err := time.ParseDuration(p.Window)
+	if err != nil || window <= 0 {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("escalation window '%s' must be a positive duration", p.Window), err)
+	}
+	if p.EscalationSubscriptionName == p.SubscriptionName {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "the escalation subscription must differ from the subscription", nil)
+	}
+	for _, name := range []string{p.SubscriptionName, p.EscalationSubscriptionName} {
+		if name == "" {
+			continue
+		}
+		if _, edgeXerr = dbClient.SubscriptionByName(name); edgeXerr != nil {
+			return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+
+	addedPolicy, edgeXerr := dbClient.AddEscalationPolicy(p)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("EscalationPolicy created on DB successfully. EscalationPolicy ID: %s, Correlation-ID: %s ",
+		addedPolicy.Id,
+		correlation.FromContext(ctx))
+
+	return addedPolicy.Id, nil
+}
+
+// EscalationPolicyByName queries the escalation policy by name
+func EscalationPolicyByName(name string, dic *di.Container) (policy notificationDTOs.EscalationPolicy, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return policy, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	p, edgeXerr := container.DBClientFrom(dic.Get).EscalationPolicyByName(name)
+	if edgeXerr != nil {
+		return policy, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notificationDTOs.FromEscalationPolicyModelToDTO(p), nil
+}
+
+// AllEscalationPolicies queries the escalation policies with offset and limit
+func AllEscalationPolicies(offset, limit int, dic *di.Container) (policies []notificationDTOs.EscalationPolicy, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	policyModels, edgeXerr := dbClient.AllEscalationPolicies(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.EscalationPolicyTotalCount()
+	}
+	if edgeXerr != nil {
+		return policies, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	policies = make([]notificationDTOs.EscalationPolicy, len(policyModels))
+	for i, p := range policyModels {
+		policies[i] = notificationDTOs.FromEscalationPolicyModelToDTO(p)
+	}
+	return policies, totalCount, nil
+}
+
+// DeleteEscalationPolicyByName deletes the escalation policy by name, the escalations already scheduled still happen
+func DeleteEscalationPolicyByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	edgeXerr := container.DBClientFrom(dic.Get).DeleteEscalationPolicyByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("EscalationPolicy %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// AcknowledgeNotification records the acknowledgement of the notification, which stops its escalation. Acknowledging
+// a notification again returns the first acknowledgement.
+func AcknowledgeNotification(id string, acknowledgedBy string, ctx context.Context, dic *di.Container) (ack notificationDTOs.NotificationAcknowledgement, edgeXerr errors.EdgeX) {
+	if id == "" {
+		return ack, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	if _, edgeXerr = dbClient.NotificationById(id); edgeXerr != nil {
+		return ack, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	a, edgeXerr := dbClient.AddNotificationAcknowledgement(notificationModels.NotificationAcknowledgement{NotificationId: id, AcknowledgedBy: acknowledgedBy})
+	if edgeXerr != nil {
+		return ack, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("Notification %s acknowledged. Correlation-ID: %s ", id, correlation.FromContext(ctx))
+	return notificationDTOs.FromNotificationAcknowledgementModelToDTO(a), nil
+}
+
+// NotificationAcknowledgement queries the acknowledgement of the notification, not found when it isn't acknowledged
+func NotificationAcknowledgement(id string, dic *di.Container) (ack notificationDTOs.NotificationAcknowledgement, edgeXerr errors.EdgeX) {
+	if id == "" {
+		return ack, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	a, edgeXerr := container.DBClientFrom(dic.Get).NotificationAcknowledgement(id)
+	if edgeXerr != nil {
+		return ack, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notificationDTOs.FromNotificationAcknowledgementModelToDTO(a), nil
+}
+
+// scheduleEscalations schedules the escalation of the CRITICAL notification distributed to the subscription by each
+// escalation policy of the subscription. The escalations are scheduled in memory, so they don't survive a restart.
+func scheduleEscalations(dic *di.Container, n models.Notification, sub models.Subscription) {
+	if n.Severity != models.Critical || n.Status == models.Escalated {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	policies, err := container.DBClientFrom(dic.Get).EscalationPoliciesBySubscriptionName(0, -1, sub.Name)
+	if err != nil {
+		lc.Errorf("fail to query the escalation policies of subscription %s, notification %s won't be escalated: %v", sub.Name, n.Id, err)
+		return
+	}
+	for _, p := range policies {
+		window, parseErr := time.ParseDuration(p.Window)
+		if parseErr != nil {
+			lc.Errorf("escalation policy %s has an invalid window '%s': %v", p.Name, p.Window, parseErr)
+			continue
+		}
+		policy := p
+		time.AfterFunc(window, func() {
+			if err := escalateUnacknowledged(dic, n, sub, policy); err != nil {
+				lc.Errorf("fail to escalate notification %s by escalation policy %s: %v", n.Id, policy.Name, err)
+			}
+		})
+	}
+}
+
+// escalateUnacknowledged sends the notification again via the alternate channels and to the alternate subscription of
+// the escalation policy, unless the notification was acknowledged or deleted in the meantime
+func escalateUnacknowledged(dic *di.Container, n models.Notification, sub models.Subscription, policy notificationModels.EscalationPolicy) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	_, err := dbClient.NotificationAcknowledgement(n.Id)
+	if err == nil {
+		lc.Debugf("notification %s is acknowledged, skip the escalation by escalation policy %s", n.Id, policy.Name)
+		return nil
+	} else if errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if _, err = dbClient.NotificationById(n.Id); err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			lc.Debugf("notification %s is deleted, skip the escalation by escalation policy %s", n.Id, policy.Name)
+			return nil
+		}
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	escalated := unacknowledgedNotification(n)
+	escalated, err = dbClient.AddNotification(escalated)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "fail to create the escalated notification", err)
+	}
+	lc.Warnf("notification %s is not acknowledged within %s, escalate it by escalation policy %s", n.Id, policy.Window, policy.Name)
+
+	for _, address := range policy.Channels {
+		go transmit(dic, escalated, sub, address) // nolint:errcheck
+	}
+	if policy.EscalationSubscriptionName == "" {
+		return nil
+	}
+	escalationSub, err := dbClient.SubscriptionByName(policy.EscalationSubscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if escalationSub.AdminState == models.Locked {
+		lc.Debugf("subscription %s is locked, skip the escalated notification transmission", escalationSub.Name)
+		return nil
+	}
+	for _, address := range escalationSub.Channels {
+		go transmit(dic, escalated, escalationSub, address) // nolint:errcheck
+	}
+	return nil
+}
+
+func unacknowledgedNotification(n models.Notification) models.Notification {
+	id := n.Id
+	n.Id = ""
+	n.Created = 0
+	n.Content = fmt.Sprintf("[%s %s] %s", UnacknowledgedContentNotice, id, n.Content)
+	n.ContentType = common.ContentTypeText
+	n.Status = models.Escalated
+	return n
+}
@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestAddEscalationPolicy(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", "oncall").Return(models.Subscription{Name: "oncall"}, nil)
+	dbClientMock.On("SubscriptionByName", "notFound").Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddEscalationPolicy", mock.Anything).Return(notificationModels.EscalationPolicy{Id: exampleUUID}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	valid := notificationModels.EscalationPolicy{Name: "policy", SubscriptionName: testSubscriptionName, Window: "15m", EscalationSubscriptionName: "oncall"}
+	zeroWindow := valid
+	zeroWindow.Window = "0s"
+	sameSubscription := valid
+	sameSubscription.EscalationSubscriptionName = testSubscriptionName
+	subscriptionNotFound := valid
+	subscriptionNotFound.SubscriptionName = "notFound"
+	escalationSubscriptionNotFound := valid
+	escalationSubscriptionNotFound.EscalationSubscriptionName = "notFound"
+
+	tests := []struct {
+		name          string
+		policy        notificationModels.EscalationPolicy
+		errorExpected bool
+		expectedKind  errors.ErrKind
+	}{
+		{"valid", valid, false, ""},
+		{"invalid - zero window", zeroWindow, true, errors.KindContractInvalid},
+		{"invalid - escalation to the same subscription", sameSubscription, true, errors.KindContractInvalid},
+		{"invalid - subscription not found", subscriptionNotFound, true, errors.KindEntityDoesNotExist},
+		{"invalid - escalation subscription not found", escalationSubscriptionNotFound, true, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			id, err := AddEscalationPolicy(testCase.policy, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, exampleUUID, id)
+		})
+	}
+}
+
+func TestEscalateUnacknowledged(t *testing.T) {
+	acknowledgedId := "acknowledged"
+	deletedId := "deleted"
+	unacknowledgedId := "unacknowledged"
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	escalationSubscription := models.Subscription{Name: "oncall", Channels: []models.Address{testEmailAddress}, AdminState: models.Unlocked}
+	policy := notificationModels.EscalationPolicy{
+		Name:                       "policy",
+		SubscriptionName:           sub.Name,
+		Window:                     "15m",
+		Channels:                   []models.Address{testRestAddress},
+		EscalationSubscriptionName: escalationSubscription.Name,
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationAcknowledgement", acknowledgedId).Return(notificationModels.NotificationAcknowledgement{NotificationId: acknowledgedId}, nil)
+	dbClientMock.On("NotificationAcknowledgement", mock.Anything).Return(notificationModels.NotificationAcknowledgement{}, notFound)
+	dbClientMock.On("NotificationById", deletedId).Return(models.Notification{}, notFound)
+	dbClientMock.On("NotificationById", unacknowledgedId).Return(models.Notification{Id: unacknowledgedId}, nil)
+	dbClientMock.On("AddNotification", mock.Anything).Return(func(n models.Notification) models.Notification {
+		n.Id = exampleUUID
+		return n
+	}, nil)
+	dbClientMock.On("SubscriptionByName", escalationSubscription.Name).Return(escalationSubscription, nil)
+	dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) models.Transmission { return trans }, nil)
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", mock.Anything, mock.Anything).Return(notificationModels.NotificationTemplate{}, templateNotFound)
+
+	sent := make(chan models.Address, 2)
+	sender := &senderMock.Sender{}
+	sender.On("Send", mock.Anything, mock.Anything, mock.Anything).Return("", nil).Run(func(args mock.Arguments) {
+		escalated := args.Get(0).(models.Notification)
+		assert.EqualValues(t, models.Escalated, escalated.Status)
+		assert.True(t, strings.Contains(escalated.Content, unacknowledgedId))
+		sent <- args.Get(2).(models.Address)
+	})
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return sender
+		},
+		channel.EmailSenderName: func(get di.Get) interface{} {
+			return sender
+		},
+	})
+
+	tests := []struct {
+		name              string
+		notificationId    string
+		expectedAddresses []models.Address
+	}{
+		{"acknowledged", acknowledgedId, nil},
+		{"deleted", deletedId, nil},
+		{"unacknowledged", unacknowledgedId, []models.Address{testRestAddress, testEmailAddress}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			n := notification
+			n.Id = testCase.notificationId
+			n.Severity = models.Critical
+			n.ContentType = common.ContentTypeJSON
+
+			err := escalateUnacknowledged(dic, n, sub, policy)
+			require.NoError(t, err)
+
+			var addresses []models.Address
+			for range testCase.expectedAddresses {
+				select {
+				case address := <-sent:
+					addresses = append(addresses, address)
+				case <-time.After(time.Second):
+					require.Fail(t, "the escalated notification is not sent")
+				}
+			}
+			assert.ElementsMatch(t, testCase.expectedAddresses, addresses)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddNotification", 1)
+}
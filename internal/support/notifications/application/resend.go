@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+)
+
+// ResendTransmission sends the notification of the failed or escalated transmission again, to the channel when it
+// isn't nil or else to the channel of the transmission, and returns the new transmission linked to the original one
+func ResendTransmission(id string, channel models.Address, ctx context.Context, dic *di.Container) (trans dtos.Transmission, edgeXerr errors.EdgeX) {
+	if id == "" {
+		return trans, errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is empty", nil)
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return trans, errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is not a valid UUID", err)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	original, edgeXerr := dbClient.TransmissionById(id)
+	if edgeXerr != nil {
+		return trans, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if original.Status != models.Failed && original.Status != models.Escalated {
+		return trans, errors.NewCommonEdgeX(errors.KindStatusConflict,
+			fmt.Sprintf("transmission %s is %s, only the %s or %s transmissions can be resent", id, original.Status, models.Failed, models.Escalated), nil)
+	}
+	n, edgeXerr := dbClient.NotificationById(original.NotificationId)
+	if edgeXerr != nil {
+		return trans, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	sub, edgeXerr := dbClient.SubscriptionByName(original.SubscriptionName)
+	if edgeXerr != nil {
+		return trans, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if sub.AdminState == models.Locked {
+		return trans, errors.NewCommonEdgeX(errors.KindServiceLocked, fmt.Sprintf("subscription %s is locked", sub.Name), nil)
+	}
+
+	if channel == nil {
+		// the connectors and SMS channels transmit via addresses built for each sending, which can't be sent to again
+		if !containsAddress(sub.Channels, original.Channel) {
+			return trans, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("transmission %s wasn't sent to a channel of subscription %s, specify the channel to resend it to", id, sub.Name), nil)
+		}
+		channel = original.Channel
+	}
+
+	resend := firstSend(dic, n, models.NewTransmission(sub.Name, channel, n.Id))
+	resend, edgeXerr = dbClient.AddTransmissionResend(original.Id, resend)
+	if edgeXerr != nil {
+		return trans, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("Transmission %s resent as transmission %s with status %s. Correlation-ID: %s ",
+		id,
+		resend.Id,
+		resend.Status,
+		correlation.FromContext(ctx))
+
+	return dtos.FromTransmissionModelToDTO(resend), nil
+}
+
+// TransmissionResends queries the transmissions resent from the transmission with offset and limit
+func TransmissionResends(offset, limit int, id string, dic *di.Container) (transmissions []dtos.Transmission, totalCount uint32, err errors.EdgeX) {
+	if id == "" {
+		return transmissions, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "ID is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	if _, err = dbClient.TransmissionById(id); err != nil {
+		return transmissions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	transModels, err := dbClient.TransmissionResends(offset, limit, id)
+	if err == nil {
+		totalCount, err = dbClient.TransmissionResendCount(id)
+	}
+	if err != nil {
+		return transmissions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromTransmissionModelsToDTOs(transModels), totalCount, nil
+}
+
+func containsAddress(addresses []models.Address, address models.Address) bool {
+	for _, a := range addresses {
+		if reflect.DeepEqual(a, address) {
+			return true
+		}
+	}
+	return false
+}
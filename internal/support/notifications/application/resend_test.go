@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestResendTransmission(t *testing.T) {
+	subscription := models.Subscription{Name: "resend", Channels: []models.Address{testRestAddress}, AdminState: models.Unlocked}
+	lockedSubscription := models.Subscription{Name: "locked", Channels: []models.Address{testRestAddress}, AdminState: models.Locked}
+	failed := models.Transmission{Id: "1208bbca-8521-434a-a923-66255a68ba01", SubscriptionName: subscription.Name, Channel: testRestAddress, NotificationId: exampleUUID, Status: models.Failed}
+	escalated := failed
+	escalated.Id = "1208bbca-8521-434a-a923-66255a68ba02"
+	escalated.Status = models.Escalated
+	sent := failed
+	sent.Id = "1208bbca-8521-434a-a923-66255a68ba03"
+	sent.Status = models.Sent
+	locked := failed
+	locked.Id = "1208bbca-8521-434a-a923-66255a68ba04"
+	locked.SubscriptionName = lockedSubscription.Name
+	removedChannel := failed
+	removedChannel.Id = "1208bbca-8521-434a-a923-66255a68ba05"
+	removedChannel.Channel = testRestAddress2
+	notFoundId := "1208bbca-8521-434a-a923-000000000000"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	for _, trans := range []models.Transmission{failed, escalated, sent, locked, removedChannel} {
+		dbClientMock.On("TransmissionById", trans.Id).Return(trans, nil)
+	}
+	dbClientMock.On("TransmissionById", notFoundId).Return(models.Transmission{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("NotificationById", exampleUUID).Return(notification, nil)
+	dbClientMock.On("SubscriptionByName", subscription.Name).Return(subscription, nil)
+	dbClientMock.On("SubscriptionByName", lockedSubscription.Name).Return(lockedSubscription, nil)
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", mock.Anything, mock.Anything).Return(notificationModels.NotificationTemplate{}, templateNotFound)
+	dbClientMock.On("AddTransmissionResend", mock.Anything, mock.Anything).Return(
+		func(originalId string, resend models.Transmission) models.Transmission {
+			resend.Id = exampleUUID
+			return resend
+		}, nil)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", notification, mock.Anything, testRestAddress).Return("", nil)
+	restSender.On("Send", notification, mock.Anything, testRestAddress2).Return("", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+
+	tests := []struct {
+		name            string
+		id              string
+		channel         models.Address
+		errorExpected   bool
+		expectedKind    errors.ErrKind
+		expectedChannel models.Address
+	}{
+		{"valid - failed transmission", failed.Id, nil, false, "", testRestAddress},
+		{"valid - escalated transmission", escalated.Id, nil, false, "", testRestAddress},
+		{"valid - alternate channel", removedChannel.Id, testRestAddress, false, "", testRestAddress},
+		{"invalid - id is not a valid UUID", "invalid", nil, true, errors.KindContractInvalid, nil},
+		{"invalid - transmission not found", notFoundId, nil, true, errors.KindEntityDoesNotExist, nil},
+		{"invalid - transmission sent", sent.Id, nil, true, errors.KindStatusConflict, nil},
+		{"invalid - subscription locked", locked.Id, nil, true, errors.KindServiceLocked, nil},
+		{"invalid - channel no longer subscribed", removedChannel.Id, nil, true, errors.KindContractInvalid, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			trans, err := ResendTransmission(testCase.id, testCase.channel, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, exampleUUID, trans.Id)
+			assert.EqualValues(t, models.Sent, trans.Status)
+			assert.Equal(t, dtos.FromAddressModelToDTO(testCase.expectedChannel), trans.Channel)
+			dbClientMock.AssertCalled(t, "AddTransmissionResend", testCase.id, mock.Anything)
+		})
+	}
+}
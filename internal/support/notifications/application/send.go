@@ -25,7 +25,7 @@ import (
 func firstSend(dic *di.Container, n models.Notification, trans models.Transmission) models.Transmission {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
-	record := sendNotificationViaChannel(dic, n, trans.Channel)
+	record := sendNotificationViaChannel(dic, n, trans.SubscriptionName, trans.Channel)
 	trans.Records = append(trans.Records, record)
 	trans.Status = record.Status
 	lc.Debugf("sent the notification to %s with address %v, transmission status %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Status)
@@ -48,7 +48,7 @@ func reSend(dic *di.Container, n models.Notification, sub models.Subscription, t
 		time.Sleep(resendInterval)
 		lc.Warn("fail to send the critical notification. Retry to send again...")
 
-		record := sendNotificationViaChannel(dic, n, trans.Channel)
+		record := sendNotificationViaChannel(dic, n, trans.SubscriptionName, trans.Channel)
 		if record.Status == models.Failed {
 			// fail to transmit the notification, keep resending
 			trans.Status = models.RESENDING
@@ -126,17 +126,21 @@ func escalatedNotification(n models.Notification, trans models.Transmission) mod
 	return n
 }
 
-// sendNotificationViaChannel sends notification via address and return the transmission record. The record status should be SENT or FAILED.
-func sendNotificationViaChannel(dic *di.Container, n models.Notification, address models.Address) (transRecord models.TransmissionRecord) {
+// sendNotificationViaChannel sends notification of the subscription via address and return the transmission record. The record status should be SENT or FAILED.
+func sendNotificationViaChannel(dic *di.Container, n models.Notification, subscriptionName string, address models.Address) (transRecord models.TransmissionRecord) {
 	var err errors.EdgeX
 	transRecord.Status = models.Sent
+	n = renderNotification(dic, n, subscriptionName, address.GetBaseAddress().Type)
 	switch address.GetBaseAddress().Type {
 	case common.REST:
 		restSender := channel.RESTSenderFrom(dic.Get)
-		transRecord.Response, err = restSender.Send(n, address)
+		transRecord.Response, err = restSender.Send(n, subscriptionName, address)
 	case common.EMAIL:
 		emailSender := channel.EmailSenderFrom(dic.Get)
-		transRecord.Response, err = emailSender.Send(n, address)
+		transRecord.Response, err = emailSender.Send(n, subscriptionName, address)
+	case common.MQTT:
+		mqttSender := channel.MQTTSenderFrom(dic.Get)
+		transRecord.Response, err = mqttSender.Send(n, subscriptionName, address)
 	default:
 		transRecord.Response = fmt.Sprintf("unsupported address type: %s", address.GetBaseAddress().Type)
 		return transRecord
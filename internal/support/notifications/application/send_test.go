@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -66,22 +67,45 @@ var testEmailAddress2 = models.EmailAddress{
 	BaseAddress: models.BaseAddress{Type: common.EMAIL, Host: testHost, Port: testPort},
 	Recipients:  []string{"test2@gamil.com"},
 }
+var testMQTTAddress = models.MQTTPubAddress{
+	BaseAddress: models.BaseAddress{Type: common.MQTT, Host: testHost, Port: 1883},
+	Publisher:   "publisher",
+	Topic:       "topic1",
+}
+var testMQTTAddress2 = models.MQTTPubAddress{
+	BaseAddress: models.BaseAddress{Type: common.MQTT, Host: testHost, Port: 1883},
+	Publisher:   "publisher",
+	Topic:       "topic2",
+}
+
+var templateNotFound = errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "notification template not found", nil)
 
 func TestFirstSend(t *testing.T) {
 	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", mock.Anything, mock.Anything).Return(notificationModels.NotificationTemplate{}, templateNotFound)
 	restSender := &senderMock.Sender{}
-	restSender.On("Send", notification, testRestAddress).Return("", nil)
-	restSender.On("Send", notification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
+	restSender.On("Send", notification, mock.Anything, testRestAddress).Return("", nil)
+	restSender.On("Send", notification, mock.Anything, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
 	emailSender := &senderMock.Sender{}
-	emailSender.On("Send", notification, testEmailAddress).Return("", nil)
-	emailSender.On("Send", notification, testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
+	emailSender.On("Send", notification, mock.Anything, testEmailAddress).Return("", nil)
+	emailSender.On("Send", notification, mock.Anything, testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
+	mqttSender := &senderMock.Sender{}
+	mqttSender.On("Send", notification, mock.Anything, testMQTTAddress).Return("", nil)
+	mqttSender.On("Send", notification, mock.Anything, testMQTTAddress2).Return("", errors.NewCommonEdgeX(errors.KindCommunicationError, "fail to publish the notification", nil))
 	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
 		},
 		channel.EmailSenderName: func(get di.Get) interface{} {
 			return emailSender
 		},
+		channel.MQTTSenderName: func(get di.Get) interface{} {
+			return mqttSender
+		},
 	})
 
 	tests := []struct {
@@ -93,6 +117,8 @@ func TestFirstSend(t *testing.T) {
 		{"sent email address successful", testEmailAddress, false},
 		{"sent rest failed", testRestAddress2, true},
 		{"sent email failed", testEmailAddress2, true},
+		{"published mqtt successful", testMQTTAddress, false},
+		{"published mqtt failed", testMQTTAddress2, true},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -116,6 +142,7 @@ func TestReSend(t *testing.T) {
 	config := notificationContainer.ConfigurationFrom(dic.Get)
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", mock.Anything, mock.Anything).Return(notificationModels.NotificationTemplate{}, templateNotFound)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -123,11 +150,11 @@ func TestReSend(t *testing.T) {
 	})
 
 	restSender := &senderMock.Sender{}
-	restSender.On("Send", notification, testRestAddress).Return("", nil)
-	restSender.On("Send", notification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
+	restSender.On("Send", notification, mock.Anything, testRestAddress).Return("", nil)
+	restSender.On("Send", notification, mock.Anything, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
 	emailSender := &senderMock.Sender{}
-	emailSender.On("Send", notification, testEmailAddress).Return("", nil)
-	emailSender.On("Send", notification, testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
+	emailSender.On("Send", notification, mock.Anything, testEmailAddress).Return("", nil)
+	emailSender.On("Send", notification, mock.Anything, testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
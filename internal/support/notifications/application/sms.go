@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddSmsChannel adds the SMS channel after checking that its subscription exists
+func AddSmsChannel(c notificationModels.SmsChannel, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if _, edgeXerr = dbClient.SubscriptionByName(c.SubscriptionName); edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	addedSmsChannel, edgeXerr := dbClient.AddSmsChannel(c)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("SmsChannel created on DB successfully. SmsChannel ID: %s, Correlation-ID: %s ",
+		addedSmsChannel.Id,
+		correlation.FromContext(ctx))
+
+	return addedSmsChannel.Id, nil
+}
+
+// SmsChannelByName queries the SMS channel by name
+func SmsChannelByName(name string, dic *di.Container) (smsChannel notificationDTOs.SmsChannel, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return smsChannel, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	c, edgeXerr := container.DBClientFrom(dic.Get).SmsChannelByName(name)
+	if edgeXerr != nil {
+		return smsChannel, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notificationDTOs.FromSmsChannelModelToDTO(c), nil
+}
+
+// AllSmsChannels queries the SMS channels with offset and limit
+func AllSmsChannels(offset, limit int, dic *di.Container) (smsChannels []notificationDTOs.SmsChannel, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	smsChannelModels, edgeXerr := dbClient.AllSmsChannels(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.SmsChannelTotalCount()
+	}
+	if edgeXerr != nil {
+		return smsChannels, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	smsChannels = make([]notificationDTOs.SmsChannel, len(smsChannelModels))
+	for i, c := range smsChannelModels {
+		smsChannels[i] = notificationDTOs.FromSmsChannelModelToDTO(c)
+	}
+	return smsChannels, totalCount, nil
+}
+
+// DeleteSmsChannelByName deletes the SMS channel by name
+func DeleteSmsChannelByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	edgeXerr := container.DBClientFrom(dic.Get).DeleteSmsChannelByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("SmsChannel %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// transmitViaSmsChannels asynchronously transmits the notification to the phone numbers of the SMS channels of the
+// subscription, one transmission per phone number
+func transmitViaSmsChannels(dic *di.Container, n models.Notification, sub models.Subscription) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	smsChannels, err := container.DBClientFrom(dic.Get).SmsChannelsBySubscriptionName(0, -1, sub.Name)
+	if err != nil {
+		lc.Errorf("fail to query the SMS channels of subscription %s: %v", sub.Name, err)
+		return
+	}
+	if len(smsChannels) == 0 {
+		return
+	}
+	if channel.SmsProviderFrom(dic.Get) == nil {
+		lc.Errorf("no SMS provider is configured, skip the SMS transmission of notification %s to subscription %s", n.Id, sub.Name)
+		return
+	}
+	n = renderNotification(dic, n, sub.Name, notificationModels.SmsChannelType)
+	for _, c := range smsChannels {
+		for _, phoneNumber := range c.PhoneNumbers {
+			go transmitSms(dic, n, sub, phoneNumber) // nolint:errcheck
+		}
+	}
+}
+
+// transmitSms sends the SMS message to the phone number and records the transmission, whose channel is the REST
+// address of the SMS gateway. The message id of the SMS gateway is recorded with the transmission, so that the delivery
+// status callbacks of the gateway are recorded against the transmission. The failed transmissions aren't resent.
+func transmitSms(dic *di.Container, n models.Notification, sub models.Subscription, phoneNumber string) (models.Transmission, errors.EdgeX) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get).Sms
+
+	address := models.RESTAddress{
+		BaseAddress: models.BaseAddress{Type: common.REST, Host: config.Host, Port: 443},
+		HTTPMethod:  http.MethodPost,
+	}
+	trans := models.NewTransmission(sub.Name, address, n.Id)
+	record := models.TransmissionRecord{Status: models.Sent}
+	messageId, err := channel.SmsProviderFrom(dic.Get).Send(phoneNumber, n.Content)
+	if err != nil {
+		record.Status = models.Failed
+		record.Response = fmt.Sprintf("fail to send the SMS message to %s: %s", phoneNumber, err.Error())
+	} else {
+		record.Response = fmt.Sprintf("SMS message %s sent to %s", messageId, phoneNumber)
+	}
+	record.Sent = pkgCommon.MakeTimestamp()
+	trans.Records = append(trans.Records, record)
+	trans.Status = record.Status
+	lc.Debugf("sent the notification to %s via SMS, transmission status %s", sub.Name, trans.Status)
+
+	trans, err = dbClient.AddTransmission(trans)
+	if err != nil {
+		lc.Error(err.Message())
+		return trans, errors.NewCommonEdgeXWrapper(err)
+	}
+	if messageId != "" {
+		if err = dbClient.AddSmsMessage(messageId, trans.Id); err != nil {
+			lc.Errorf("fail to record the SMS message %s, its delivery status will be ignored: %v", messageId, err)
+		}
+	}
+	return trans, nil
+}
+
+// UpdateSmsDeliveryStatus records the delivery status of the SMS message against its transmission, and updates the
+// transmission status when the message is delivered or undelivered
+func UpdateSmsDeliveryStatus(status channel.SmsDeliveryStatus, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	transmissionId, err := dbClient.TransmissionIdBySmsMessageId(status.MessageId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	trans, err := dbClient.TransmissionById(transmissionId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	record := models.TransmissionRecord{Status: trans.Status, Response: status.Response, Sent: pkgCommon.MakeTimestamp()}
+	if status.Status != "" {
+		record.Status = status.Status
+		trans.Status = status.Status
+	}
+	trans.Records = append(trans.Records, record)
+	if err = dbClient.UpdateTransmission(trans); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("SMS message %s delivery status recorded in transmission %s. Correlation-ID: %s ", status.MessageId, trans.Id, correlation.FromContext(ctx))
+	return nil
+}
@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+)
+
+func TestTransmitSms(t *testing.T) {
+	delivered := "+15005550009"
+	invalid := "+15005550001"
+	messageId := "SM0123456789"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) models.Transmission {
+		trans.Id = exampleUUID
+		return trans
+	}, nil)
+	dbClientMock.On("AddSmsMessage", messageId, exampleUUID).Return(nil)
+	provider := &senderMock.SmsProvider{}
+	provider.On("Send", delivered, notification.Content).Return(messageId, nil)
+	provider.On("Send", invalid, notification.Content).Return("", errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid phone number", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.SmsProviderInterfaceName: func(get di.Get) interface{} {
+			return provider
+		},
+	})
+
+	tests := []struct {
+		name           string
+		phoneNumber    string
+		expectedStatus models.TransmissionStatus
+	}{
+		{"sent", delivered, models.Sent},
+		{"failed", invalid, models.Failed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			trans, err := transmitSms(dic, notification, sub, testCase.phoneNumber)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatus, trans.Status)
+			require.Len(t, trans.Records, 1)
+			assert.Contains(t, trans.Records[0].Response, testCase.phoneNumber)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddSmsMessage", 1)
+}
+
+func TestUpdateSmsDeliveryStatus(t *testing.T) {
+	sent := models.Transmission{Id: exampleUUID, Status: models.Sent, Records: []models.TransmissionRecord{{Status: models.Sent}}}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TransmissionIdBySmsMessageId", "known").Return(exampleUUID, nil)
+	dbClientMock.On("TransmissionIdBySmsMessageId", "unknown").Return("", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("TransmissionById", exampleUUID).Return(sent, nil)
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name           string
+		status         channel.SmsDeliveryStatus
+		errorExpected  bool
+		expectedStatus models.TransmissionStatus
+	}{
+		{"delivered", channel.SmsDeliveryStatus{MessageId: "known", Status: models.Acknowledged, Response: "delivered"}, false, models.Acknowledged},
+		{"undelivered", channel.SmsDeliveryStatus{MessageId: "known", Status: models.Failed, Response: "undelivered"}, false, models.Failed},
+		{"in flight", channel.SmsDeliveryStatus{MessageId: "known", Response: "sent"}, false, models.Sent},
+		{"unknown message", channel.SmsDeliveryStatus{MessageId: "unknown", Status: models.Acknowledged}, true, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := UpdateSmsDeliveryStatus(testCase.status, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			dbClientMock.AssertCalled(t, "UpdateTransmission", mock.MatchedBy(func(trans models.Transmission) bool {
+				last := trans.Records[len(trans.Records)-1]
+				return trans.Status == testCase.expectedStatus && len(trans.Records) == 2 &&
+					last.Status == testCase.expectedStatus && last.Response == testCase.status.Response
+			}))
+		})
+	}
+}
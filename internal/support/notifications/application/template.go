@@ -0,0 +1,178 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmlTemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// contentTypeHTML is the content type of the templates executed with html/template, which escapes the variables
+const contentTypeHTML = "text/html"
+
+// templateFuncs are the functions available to the notification templates besides the builtin ones
+var templateFuncs = template.FuncMap{
+	"join":     strings.Join,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"hasLabel": containsString,
+	"truncate": func(length int, s string) string {
+		runes := []rune(s)
+		if length < 0 || len(runes) <= length {
+			return s
+		}
+		return string(runes[:length])
+	},
+}
+
+// executor is implemented by both text/template and html/template templates
+type executor interface {
+	Execute(wr io.Writer, data any) error
+}
+
+// parseTemplate parses the template of the notification template, with html/template when it renders HTML
+func parseTemplate(t notificationModels.NotificationTemplate) (executor, error) {
+	if strings.HasPrefix(t.ContentType, contentTypeHTML) {
+		return htmlTemplate.New(t.Name).Funcs(htmlTemplate.FuncMap(templateFuncs)).Parse(t.Template)
+	}
+	return template.New(t.Name).Funcs(templateFuncs).Parse(t.Template)
+}
+
+// AddNotificationTemplate adds the notification template after checking that its template parses and its subscription exists
+func AddNotificationTemplate(t notificationModels.NotificationTemplate, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if _, err := parseTemplate(t); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the template of notification template %s", t.Name), err)
+	}
+	if _, edgeXerr = dbClient.SubscriptionByName(t.SubscriptionName); edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	addedTemplate, edgeXerr := dbClient.AddNotificationTemplate(t)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("NotificationTemplate created on DB successfully. NotificationTemplate ID: %s, Correlation-ID: %s ",
+		addedTemplate.Id,
+		correlation.FromContext(ctx))
+
+	return addedTemplate.Id, nil
+}
+
+// NotificationTemplateByName queries the notification template by name
+func NotificationTemplateByName(name string, dic *di.Container) (t notificationDTOs.NotificationTemplate, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return t, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	model, edgeXerr := container.DBClientFrom(dic.Get).NotificationTemplateByName(name)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return notificationDTOs.FromNotificationTemplateModelToDTO(model), nil
+}
+
+// AllNotificationTemplates queries the notification templates with offset and limit
+func AllNotificationTemplates(offset, limit int, dic *di.Container) (templates []notificationDTOs.NotificationTemplate, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	templateModels, edgeXerr := dbClient.AllNotificationTemplates(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.NotificationTemplateTotalCount()
+	}
+	if edgeXerr != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	templates = make([]notificationDTOs.NotificationTemplate, len(templateModels))
+	for i, t := range templateModels {
+		templates[i] = notificationDTOs.FromNotificationTemplateModelToDTO(t)
+	}
+	return templates, totalCount, nil
+}
+
+// DeleteNotificationTemplateByName deletes the notification template by name
+func DeleteNotificationTemplateByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	edgeXerr := container.DBClientFrom(dic.Get).DeleteNotificationTemplateByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("NotificationTemplate %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// renderNotification renders the content of the notification sent to the subscription via the channel type with the
+// template of the subscription for the channel type, or else with the template of the subscription for all its
+// channels. The notification is sent as is when the subscription has no template or the rendering fails.
+func renderNotification(dic *di.Container, n models.Notification, subscriptionName string, channelType string) models.Notification {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	t, err := dbClient.NotificationTemplateBySubscriptionNameAndChannelType(subscriptionName, channelType)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		t, err = dbClient.NotificationTemplateBySubscriptionNameAndChannelType(subscriptionName, "")
+	}
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Errorf("fail to query the notification template of subscription %s, send the notification %s as is: %v", subscriptionName, n.Id, err)
+		}
+		return n
+	}
+
+	content, renderErr := renderTemplate(t, n, subscriptionName)
+	if renderErr != nil {
+		lc.Errorf("fail to render the notification %s with notification template %s, send the notification as is: %v", n.Id, t.Name, renderErr)
+		return n
+	}
+	n.Content = content
+	if t.ContentType != "" {
+		n.ContentType = t.ContentType
+	}
+	return n
+}
+
+// renderTemplate executes the template of the notification template with the variables of the notification
+func renderTemplate(t notificationModels.NotificationTemplate, n models.Notification, subscriptionName string) (string, error) {
+	tmpl, err := parseTemplate(t)
+	if err != nil {
+		return "", err
+	}
+	data := notificationModels.TemplateData{
+		Id:           n.Id,
+		Created:      n.Created,
+		Sender:       n.Sender,
+		Category:     n.Category,
+		Labels:       n.Labels,
+		Severity:     string(n.Severity),
+		Description:  n.Description,
+		Content:      n.Content,
+		ContentType:  n.ContentType,
+		Subscription: subscriptionName,
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
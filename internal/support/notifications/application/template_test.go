@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestAddNotificationTemplate(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", "notFound").Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddNotificationTemplate", mock.Anything).Return(notificationModels.NotificationTemplate{Id: exampleUUID}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	valid := notificationModels.NotificationTemplate{Name: "sms", SubscriptionName: testSubscriptionName, ChannelType: common.REST, Template: "[{{.Severity}}] {{.Content}}"}
+	validHTML := valid
+	validHTML.ContentType = "text/html"
+	validHTML.Template = "<p>{{.Content}}</p>"
+	invalidTemplate := valid
+	invalidTemplate.Template = "{{.Content"
+	unknownFunction := valid
+	unknownFunction.Template = "{{ unknown .Content }}"
+	subscriptionNotFound := valid
+	subscriptionNotFound.SubscriptionName = "notFound"
+
+	tests := []struct {
+		name          string
+		template      notificationModels.NotificationTemplate
+		errorExpected bool
+		expectedKind  errors.ErrKind
+	}{
+		{"valid", valid, false, ""},
+		{"valid - html", validHTML, false, ""},
+		{"invalid - template syntax", invalidTemplate, true, errors.KindContractInvalid},
+		{"invalid - unknown function", unknownFunction, true, errors.KindContractInvalid},
+		{"invalid - subscription not found", subscriptionNotFound, true, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			id, err := AddNotificationTemplate(testCase.template, context.Background(), dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, exampleUUID, id)
+		})
+	}
+}
+
+func TestRenderNotification(t *testing.T) {
+	smsSubscription := "sms"
+	emailSubscription := "email"
+	invalidSubscription := "invalid"
+
+	sms := notificationModels.NotificationTemplate{
+		Name:             "sms",
+		SubscriptionName: smsSubscription,
+		ContentType:      common.ContentTypeText,
+		Template:         `{{upper .Severity}} {{.Category}} from {{.Sender}}{{if hasLabel .Labels "urgent"}}!{{end}}: {{truncate 10 .Content}}`,
+	}
+	email := notificationModels.NotificationTemplate{
+		Name:             "email",
+		SubscriptionName: emailSubscription,
+		ChannelType:      common.EMAIL,
+		ContentType:      "text/html",
+		Template:         `<h1>{{.Category}}</h1><p>{{.Content}}</p><p>{{join .Labels ", "}}</p>`,
+	}
+	invalid := notificationModels.NotificationTemplate{
+		Name:             "invalid",
+		SubscriptionName: invalidSubscription,
+		Template:         "{{.Unknown}}",
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", smsSubscription, "").Return(sms, nil)
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", emailSubscription, common.EMAIL).Return(email, nil)
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", invalidSubscription, "").Return(invalid, nil)
+	dbClientMock.On("NotificationTemplateBySubscriptionNameAndChannelType", mock.Anything, mock.Anything).Return(notificationModels.NotificationTemplate{}, templateNotFound)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	n := notification
+	n.Labels = []string{"urgent", "temperature"}
+	n.Content = "temperature <too> high"
+
+	tests := []struct {
+		name                string
+		subscriptionName    string
+		channelType         string
+		expectedContent     string
+		expectedContentType string
+	}{
+		{"template for all channels", smsSubscription, common.REST, "NORMAL health-check from senderA!: temperatur", common.ContentTypeText},
+		{"template for the channel type", emailSubscription, common.EMAIL,
+			"<h1>health-check</h1><p>temperature &lt;too&gt; high</p><p>urgent, temperature</p>", "text/html"},
+		{"no template for the channel type", emailSubscription, common.REST, n.Content, n.ContentType},
+		{"no template", testSubscriptionName, common.REST, n.Content, n.ContentType},
+		{"rendering failed", invalidSubscription, common.REST, n.Content, n.ContentType},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			rendered := renderNotification(dic, n, testCase.subscriptionName, testCase.channelType)
+			assert.Equal(t, testCase.expectedContent, rendered.Content)
+			assert.Equal(t, testCase.expectedContentType, rendered.ContentType)
+		})
+	}
+}
@@ -18,15 +18,30 @@ package config
 
 import (
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 )
 
 type ConfigurationStruct struct {
-	Writable   WritableInfo
-	Database   bootstrapConfig.Database
-	Registry   bootstrapConfig.RegistryInfo
-	Service    bootstrapConfig.ServiceInfo
-	MessageBus bootstrapConfig.MessageBusInfo
-	Smtp       SmtpInfo
+	Writable     WritableInfo
+	Database     bootstrapConfig.Database
+	DatabasePool db.PoolInfo
+	Registry     bootstrapConfig.RegistryInfo
+	Service      bootstrapConfig.ServiceInfo
+	MessageBus   bootstrapConfig.MessageBusInfo
+	Smtp         SmtpInfo
+	Sms          SmsInfo
+	// AcknowledgedCleanup configures the deletion of the acknowledged notifications
+	AcknowledgedCleanup AcknowledgedCleanupInfo
+	// MutualTLS configures mutual TLS on the REST API and for the requests to the other services
+	MutualTLS pkgHandlers.MutualTLSInfo
+	// Audit configures the audit log of the security relevant operations
+	Audit audit.Info
+	// APIKey configures the authentication of the headless clients with the API keys of the secret store
+	APIKey apikey.Info
 }
 
 type WritableInfo struct {
@@ -35,10 +50,20 @@ type WritableInfo struct {
 	ResendLimit int
 	// ResendInterval is the default interval of resending the notification. The format of this field is to be an unsigned integer followed by a unit which may be "ns", "us" (or "µs"), "ms", "s", "m", "h" representing nanoseconds, microseconds, milliseconds, seconds, minutes or hours. Eg, "100ms", "24h"
 	ResendInterval  string
+	Deduplication   DeduplicationInfo
 	InsecureSecrets bootstrapConfig.InsecureSecrets
 	Telemetry       bootstrapConfig.TelemetryInfo
 }
 
+// DeduplicationInfo configures the suppression of the duplicated notifications, which have the same category, labels
+// and content as a notification distributed within the suppression window. The count of the suppressed notifications
+// is distributed as a follow-up notification when the window elapses.
+type DeduplicationInfo struct {
+	Enabled bool
+	// Window is the suppression window, starting with the distributed notification, e.g. "5m"
+	Window string
+}
+
 type SmtpInfo struct {
 	Host                 string
 	Port                 int
@@ -52,6 +77,21 @@ type SmtpInfo struct {
 	AuthMode string
 }
 
+// SmsInfo configures the SMS gateway sending the notifications of the SMS channels
+type SmsInfo struct {
+	// Provider is the SMS gateway, 'twilio' is the only Provider currently supported. The SMS channels are disabled when empty.
+	Provider string
+	// Host is the host of the SMS gateway API, e.g. api.twilio.com
+	Host string
+	// From is the phone number, in E.164 format, or the alphanumeric sender id the SMS messages are sent from
+	From string
+	// SecretName is the secret name of the credential of the SMS gateway, with the secret keys 'accountSid' and 'authToken' for Twilio
+	SecretName string
+	// StatusCallbackUrl is the public URL of the /smschannel/status endpoint of this service, which the SMS gateway
+	// posts the delivery status of the SMS messages to. No delivery status is recorded when empty.
+	StatusCallbackUrl string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -112,6 +152,11 @@ func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
 	return c.Database
 }
 
+// GetDatabasePoolInfo returns the configuration of the database connection pool.
+func (c *ConfigurationStruct) GetDatabasePoolInfo() db.PoolInfo {
+	return c.DatabasePool
+}
+
 // GetInsecureSecrets returns the service's InsecureSecrets.
 func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
 	return c.Writable.InsecureSecrets
@@ -121,3 +166,13 @@ func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecre
 func (c *ConfigurationStruct) GetTelemetryInfo() *bootstrapConfig.TelemetryInfo {
 	return &c.Writable.Telemetry
 }
+
+// AcknowledgedCleanupInfo configures the periodic deletion of the acknowledged notifications, with their
+// transmissions, some time after their acknowledgement
+type AcknowledgedCleanupInfo struct {
+	Enabled bool
+	// Interval is how often the acknowledged notifications are deleted, e.g. "10m"
+	Interval string
+	// MaxAge is the time after its acknowledgement when an acknowledged notification is deleted, e.g. "24h"
+	MaxAge string
+}
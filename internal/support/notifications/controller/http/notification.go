@@ -130,6 +130,30 @@ func (nc *NotificationController) NotificationsByCategory(w http.ResponseWriter,
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+func (nc *NotificationController) UnacknowledgedNotifications(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(nc.dic.Get)
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(nc.dic.Get)
+
+	// parse URL query string for offset, limit, category and severity
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	category := utils.ParseQueryStringToString(r, common.Category, "")
+	severity := utils.ParseQueryStringToString(r, common.Severity, "")
+	notifications, totalCount, err := application.UnacknowledgedNotifications(offset, limit, category, severity, nc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := responseDTO.NewMultiNotificationsResponse("", "", http.StatusOK, totalCount, notifications)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (nc *NotificationController) NotificationsByLabel(w http.ResponseWriter, r *http.Request) {
 	lc := container.LoggingClientFrom(nc.dic.Get)
 	ctx := r.Context()
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"testing"
 
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
 
@@ -427,6 +428,72 @@ func TestNotificationsByStatus(t *testing.T) {
 	}
 }
 
+func TestUnacknowledgedNotifications(t *testing.T) {
+	testCategory := "health-check"
+	expectedNotificationCount := uint32(2)
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UnacknowledgedNotificationCount", "", "").Return(expectedNotificationCount, nil)
+	dbClientMock.On("UnacknowledgedNotificationCount", testCategory, string(models.Critical)).Return(uint32(1), nil)
+	dbClientMock.On("UnacknowledgedNotifications", 0, 20, "", "").Return([]models.Notification{{}, {}}, nil)
+	dbClientMock.On("UnacknowledgedNotifications", 0, 20, testCategory, string(models.Critical)).Return([]models.Notification{{}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewNotificationController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		category           string
+		severity           string
+		errorExpected      bool
+		expectedTotalCount uint32
+		expectedStatusCode int
+	}{
+		{"Valid - get all unacknowledged notifications", "", "", false, expectedNotificationCount, http.StatusOK},
+		{"Valid - get unacknowledged notifications by category and severity", testCategory, string(models.Critical), false, 1, http.StatusOK},
+		{"Invalid - invalid severity", "", "URGENT", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiUnacknowledgedNotificationRoute, http.NoBody)
+			query := req.URL.Query()
+			if testCase.category != "" {
+				query.Add(common.Category, testCase.category)
+			}
+			if testCase.severity != "" {
+				query.Add(common.Severity, testCase.severity)
+			}
+			req.URL.RawQuery = query.Encode()
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.UnacknowledgedNotifications)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.MultiNotificationsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Response total count not as expected")
+				assert.Len(t, res.Notifications, int(testCase.expectedTotalCount))
+			}
+		})
+	}
+}
+
 func TestNotificationsByTimeRange(t *testing.T) {
 	expectedNotificationCount := uint32(0)
 	dic := mockDic()
@@ -15,13 +15,13 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
-	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 
 	"github.com/gorilla/mux"
@@ -50,13 +50,13 @@ func (sc *SubscriptionController) AddSubscription(w http.ResponseWriter, r *http
 	ctx := r.Context()
 	correlationId := correlation.FromContext(ctx)
 
-	var reqDTOs []requestDTO.AddSubscriptionRequest
+	var reqDTOs []notificationDTOs.AddSubscriptionRequest
 	err := sc.reader.Read(r.Body, &reqDTOs)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
 		return
 	}
-	subscriptions := requestDTO.AddSubscriptionReqToSubscriptionModels(reqDTOs)
+	subscriptions := notificationDTOs.AddSubscriptionReqToSubscriptionModels(reqDTOs)
 
 	var addResponses []interface{}
 	for i, s := range subscriptions {
@@ -222,7 +222,7 @@ func (sc *SubscriptionController) PatchSubscription(w http.ResponseWriter, r *ht
 	ctx := r.Context()
 	correlationId := correlation.FromContext(ctx)
 
-	var reqDTOs []requestDTO.UpdateSubscriptionRequest
+	var reqDTOs []notificationDTOs.UpdateSubscriptionRequest
 	err := sc.reader.Read(r.Body, &reqDTOs)
 	if err != nil {
 		utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -119,10 +119,18 @@ func TestAddSubscription(t *testing.T) {
 	model = dtos.ToSubscriptionModel(duplicatedName.Subscription)
 	dbClientMock.On("AddSubscription", model).Return(model, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("subscription name %s already exists", model.Name), nil))
 
-	unsupportedChannelType := addSubscriptionRequestData()
-	unsupportedChannelType.Subscription.Channels = []dtos.Address{
+	mqttChannel := addSubscriptionRequestData()
+	mqttChannel.Subscription.Name = "mqttChannel"
+	mqttChannel.Subscription.Channels = []dtos.Address{
 		dtos.NewMQTTAddress("mqtt-broker", 1883, "publisher", "topic"),
 	}
+	model = dtos.ToSubscriptionModel(mqttChannel.Subscription)
+	dbClientMock.On("AddSubscription", model).Return(model, nil)
+	invalidMQTTQoS := addSubscriptionRequestData()
+	invalidMQTTQoS.Subscription.Channels = []dtos.Address{
+		dtos.NewMQTTAddress("mqtt-broker", 1883, "publisher", "topic"),
+	}
+	invalidMQTTQoS.Subscription.Channels[0].QoS = 3
 	invalidEmailAddress := addSubscriptionRequestData()
 	invalidEmailAddress.Subscription.Channels = []dtos.Address{
 		dtos.NewEmailAddress([]string{"test.example.com"}),
@@ -158,7 +166,8 @@ func TestAddSubscription(t *testing.T) {
 		{"Valid - no request Id", []requests.AddSubscriptionRequest{noRequestId}, http.StatusCreated},
 		{"Invalid - no name", []requests.AddSubscriptionRequest{noName}, http.StatusBadRequest},
 		{"Invalid - duplicated name", []requests.AddSubscriptionRequest{duplicatedName}, http.StatusConflict},
-		{"Invalid - unsupported channel type", []requests.AddSubscriptionRequest{unsupportedChannelType}, http.StatusBadRequest},
+		{"Valid - MQTT channel", []requests.AddSubscriptionRequest{mqttChannel}, http.StatusCreated},
+		{"Invalid - invalid MQTT QoS", []requests.AddSubscriptionRequest{invalidMQTTQoS}, http.StatusBadRequest},
 		{"Invalid - invalid email address", []requests.AddSubscriptionRequest{invalidEmailAddress}, http.StatusBadRequest},
 		{"Invalid - invalid HTTP method", []requests.AddSubscriptionRequest{invalidHTTPMethod}, http.StatusBadRequest},
 		{"Invalid - no categories and labels", []requests.AddSubscriptionRequest{noCategoriesAndLabels}, http.StatusBadRequest},
@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func addNotificationTemplateRequestData() notificationDTOs.AddNotificationTemplateRequest {
+	return notificationDTOs.AddNotificationTemplateRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Template: notificationDTOs.NotificationTemplate{
+			Name:             "sms",
+			SubscriptionName: testSubscriptionName,
+			ChannelType:      common.REST,
+			ContentType:      common.ContentTypeText,
+			Template:         "[{{.Severity}}] {{.Content}}",
+		},
+	}
+}
+
+func TestAddNotificationTemplate(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("AddNotificationTemplate", mock.Anything).Return(notificationModels.NotificationTemplate{Id: ExampleUUID}, nil).Once()
+	dbClientMock.On("AddNotificationTemplate", mock.Anything).Return(notificationModels.NotificationTemplate{},
+		errors.NewCommonEdgeX(errors.KindDuplicateName, "notification template name sms already exists", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	valid := addNotificationTemplateRequestData()
+	noName := addNotificationTemplateRequestData()
+	noName.Template.Name = ""
+	noTemplate := addNotificationTemplateRequestData()
+	noTemplate.Template.Template = ""
+	invalidChannelType := addNotificationTemplateRequestData()
+	invalidChannelType.Template.ChannelType = "SNMP"
+	invalidTemplate := addNotificationTemplateRequestData()
+	invalidTemplate.Template.Template = "{{.Content"
+
+	controller := NewNotificationTemplateController(dic)
+	assert.NotNil(t, controller)
+	tests := []struct {
+		name               string
+		request            []notificationDTOs.AddNotificationTemplateRequest
+		expectedStatusCode int
+	}{
+		{"Valid", []notificationDTOs.AddNotificationTemplateRequest{valid}, http.StatusCreated},
+		{"Invalid - duplicated name", []notificationDTOs.AddNotificationTemplateRequest{valid}, http.StatusConflict},
+		{"Invalid - invalid template", []notificationDTOs.AddNotificationTemplateRequest{invalidTemplate}, http.StatusBadRequest},
+		{"Invalid - no name", []notificationDTOs.AddNotificationTemplateRequest{noName}, http.StatusBadRequest},
+		{"Invalid - no template", []notificationDTOs.AddNotificationTemplateRequest{noTemplate}, http.StatusBadRequest},
+		{"Invalid - invalid channel type", []notificationDTOs.AddNotificationTemplateRequest{invalidChannelType}, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiNotificationTemplateRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddNotificationTemplate)
+			handler.ServeHTTP(recorder, req)
+
+			// the request DTO validation fails the whole request, the other failures are reported per request
+			if recorder.Result().StatusCode != http.StatusMultiStatus {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.NotEmpty(t, res.Message, "Message is empty")
+				return
+			}
+			var res []commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+		})
+	}
+}
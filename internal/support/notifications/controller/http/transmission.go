@@ -10,29 +10,35 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationDTOs "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
 	"github.com/gorilla/mux"
 )
 
 type TransmissionController struct {
-	dic *di.Container
+	reader io.DtoReader
+	dic    *di.Container
 }
 
 // NewTransmissionController creates and initializes an TransmissionController
 func NewTransmissionController(dic *di.Container) *TransmissionController {
 	return &TransmissionController{
-		dic: dic,
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
 	}
 }
 
@@ -203,3 +209,66 @@ func (tc *TransmissionController) TransmissionsByNotificationId(w http.ResponseW
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// ResendTransmission sends the notification of the failed or escalated transmission again, optionally to an alternate
+// channel, and returns the new transmission
+func (tc *TransmissionController) ResendTransmission(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	var reqDTO notificationDTOs.ResendTransmissionRequest
+	err := tc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	var channel models.Address
+	if reqDTO.Channel != nil {
+		channel = dtos.ToAddressModel(*reqDTO.Channel)
+	}
+
+	trans, err := application.ResendTransmission(id, channel, ctx, tc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+		return
+	}
+
+	response := responseDTO.NewTransmissionResponse(reqDTO.RequestId, "", http.StatusCreated, trans)
+	utils.WriteHttpHeader(w, ctx, http.StatusCreated)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// TransmissionResends queries the transmissions resent from the transmission. Ordered by create timestamp descending.
+func (tc *TransmissionController) TransmissionResends(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(tc.dic.Get)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	id := vars[common.Id]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	transmissions, totalCount, err := application.TransmissionResends(offset, limit, id, tc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := responseDTO.NewMultiTransmissionsResponse("", "", http.StatusOK, totalCount, transmissions)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
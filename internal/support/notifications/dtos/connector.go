@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// Connector sends the notifications distributed to a subscription to a Slack, Microsoft Teams or Discord webhook
+type Connector struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string `json:"description,omitempty"`
+	SubscriptionName string `json:"subscriptionName" validate:"required,edgex-dto-none-empty-string"`
+	Type             string `json:"type" validate:"required,oneof='SLACK' 'TEAMS' 'DISCORD'"`
+	SecretName       string `json:"secretName" validate:"required,edgex-dto-none-empty-string"`
+}
+
+// ToConnectorModel transforms the Connector DTO to the Connector Model
+func ToConnectorModel(dto Connector) notificationModels.Connector {
+	return notificationModels.Connector{
+		DBTimestamp:      models.DBTimestamp(dto.DBTimestamp),
+		Id:               dto.Id,
+		Name:             dto.Name,
+		Description:      dto.Description,
+		SubscriptionName: dto.SubscriptionName,
+		Type:             dto.Type,
+		SecretName:       dto.SecretName,
+	}
+}
+
+// FromConnectorModelToDTO transforms the Connector Model to the Connector DTO
+func FromConnectorModelToDTO(c notificationModels.Connector) Connector {
+	return Connector{
+		DBTimestamp:      dtos.DBTimestamp(c.DBTimestamp),
+		Id:               c.Id,
+		Name:             c.Name,
+		Description:      c.Description,
+		SubscriptionName: c.SubscriptionName,
+		Type:             c.Type,
+		SecretName:       c.SecretName,
+	}
+}
+
+// AddConnectorRequest defines the Request Content for POST Connector DTO
+type AddConnectorRequest struct {
+	common.BaseRequest `json:",inline"`
+	Connector          Connector `json:"connector"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddConnectorRequest) Validate() error {
+	err := contractsCommon.Validate(r)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddConnectorRequest type
+func (r *AddConnectorRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Connector Connector
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddConnectorRequest(alias)
+	return r.Validate()
+}
+
+// ConnectorResponse defines the Response Content for GET Connector DTO
+type ConnectorResponse struct {
+	common.BaseResponse `json:",inline"`
+	Connector           Connector `json:"connector"`
+}
+
+func NewConnectorResponse(requestId string, message string, statusCode int, connector Connector) ConnectorResponse {
+	return ConnectorResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Connector:    connector,
+	}
+}
+
+// MultiConnectorsResponse defines the Response Content for GET multiple Connector DTOs
+type MultiConnectorsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Connectors                        []Connector `json:"connectors"`
+}
+
+func NewMultiConnectorsResponse(requestId string, message string, statusCode int, totalCount uint32, connectors []Connector) MultiConnectorsResponse {
+	return MultiConnectorsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Connectors:                 connectors,
+	}
+}
@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// DeliveryPolicy limits the rate of the notifications distributed to a subscription and defines its quiet hours
+type DeliveryPolicy struct {
+	dtos.DBTimestamp  `json:",inline"`
+	Id                string       `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name              string       `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description       string       `json:"description,omitempty"`
+	SubscriptionName  string       `json:"subscriptionName" validate:"required,edgex-dto-none-empty-string"`
+	RateLimit         int          `json:"rateLimit,omitempty" validate:"gte=0"`
+	RateLimitInterval string       `json:"rateLimitInterval,omitempty" validate:"required_with=RateLimit,omitempty,edgex-dto-duration"`
+	TimeZone          string       `json:"timeZone,omitempty"`
+	QuietHours        []QuietHours `json:"quietHours,omitempty" validate:"omitempty,dive"`
+	QuietHoursAction  string       `json:"quietHoursAction,omitempty" validate:"omitempty,oneof='SUPPRESS' 'QUEUE'"`
+}
+
+// QuietHours is a daily window of the quiet hours of a subscription
+type QuietHours struct {
+	Start string   `json:"start" validate:"required,datetime=15:04"`
+	End   string   `json:"end" validate:"required,datetime=15:04"`
+	Days  []string `json:"days,omitempty" validate:"omitempty,dive,oneof='SUN' 'MON' 'TUE' 'WED' 'THU' 'FRI' 'SAT'"`
+}
+
+// ToDeliveryPolicyModel transforms the DeliveryPolicy DTO to the DeliveryPolicy Model
+func ToDeliveryPolicyModel(dto DeliveryPolicy) notificationModels.DeliveryPolicy {
+	quietHours := make([]notificationModels.QuietHours, len(dto.QuietHours))
+	for i, q := range dto.QuietHours {
+		quietHours[i] = notificationModels.QuietHours(q)
+	}
+	return notificationModels.DeliveryPolicy{
+		DBTimestamp:       models.DBTimestamp(dto.DBTimestamp),
+		Id:                dto.Id,
+		Name:              dto.Name,
+		Description:       dto.Description,
+		SubscriptionName:  dto.SubscriptionName,
+		RateLimit:         dto.RateLimit,
+		RateLimitInterval: dto.RateLimitInterval,
+		TimeZone:          dto.TimeZone,
+		QuietHours:        quietHours,
+		QuietHoursAction:  dto.QuietHoursAction,
+	}
+}
+
+// FromDeliveryPolicyModelToDTO transforms the DeliveryPolicy Model to the DeliveryPolicy DTO
+func FromDeliveryPolicyModelToDTO(d notificationModels.DeliveryPolicy) DeliveryPolicy {
+	quietHours := make([]QuietHours, len(d.QuietHours))
+	for i, q := range d.QuietHours {
+		quietHours[i] = QuietHours(q)
+	}
+	return DeliveryPolicy{
+		DBTimestamp:       dtos.DBTimestamp(d.DBTimestamp),
+		Id:                d.Id,
+		Name:              d.Name,
+		Description:       d.Description,
+		SubscriptionName:  d.SubscriptionName,
+		RateLimit:         d.RateLimit,
+		RateLimitInterval: d.RateLimitInterval,
+		TimeZone:          d.TimeZone,
+		QuietHours:        quietHours,
+		QuietHoursAction:  d.QuietHoursAction,
+	}
+}
+
+// AddDeliveryPolicyRequest defines the Request Content for POST DeliveryPolicy DTO
+type AddDeliveryPolicyRequest struct {
+	common.BaseRequest `json:",inline"`
+	DeliveryPolicy     DeliveryPolicy `json:"deliveryPolicy"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddDeliveryPolicyRequest) Validate() error {
+	err := contractsCommon.Validate(r)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDeliveryPolicyRequest type
+func (r *AddDeliveryPolicyRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		DeliveryPolicy DeliveryPolicy
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddDeliveryPolicyRequest(alias)
+	return r.Validate()
+}
+
+// DeliveryPolicyResponse defines the Response Content for GET DeliveryPolicy DTO
+type DeliveryPolicyResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeliveryPolicy      DeliveryPolicy `json:"deliveryPolicy"`
+}
+
+func NewDeliveryPolicyResponse(requestId string, message string, statusCode int, policy DeliveryPolicy) DeliveryPolicyResponse {
+	return DeliveryPolicyResponse{
+		BaseResponse:   common.NewBaseResponse(requestId, message, statusCode),
+		DeliveryPolicy: policy,
+	}
+}
+
+// MultiDeliveryPoliciesResponse defines the Response Content for GET multiple DeliveryPolicy DTOs
+type MultiDeliveryPoliciesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	DeliveryPolicies                  []DeliveryPolicy `json:"deliveryPolicies"`
+}
+
+func NewMultiDeliveryPoliciesResponse(requestId string, message string, statusCode int, totalCount uint32, policies []DeliveryPolicy) MultiDeliveryPoliciesResponse {
+	return MultiDeliveryPoliciesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		DeliveryPolicies:           policies,
+	}
+}
@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// Digest aggregates the notifications distributed to a subscription over the window into one digest notification
+type Digest struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string   `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string   `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string   `json:"description,omitempty"`
+	SubscriptionName string   `json:"subscriptionName" validate:"required,edgex-dto-none-empty-string"`
+	Window           string   `json:"window" validate:"required,edgex-dto-duration"`
+	Categories       []string `json:"categories,omitempty" validate:"omitempty,gt=0,dive,required,edgex-dto-none-empty-string"`
+}
+
+// ToDigestModel transforms the Digest DTO to the Digest Model
+func ToDigestModel(dto Digest) notificationModels.Digest {
+	return notificationModels.Digest{
+		DBTimestamp:      models.DBTimestamp(dto.DBTimestamp),
+		Id:               dto.Id,
+		Name:             dto.Name,
+		Description:      dto.Description,
+		SubscriptionName: dto.SubscriptionName,
+		Window:           dto.Window,
+		Categories:       dto.Categories,
+	}
+}
+
+// FromDigestModelToDTO transforms the Digest Model to the Digest DTO
+func FromDigestModelToDTO(d notificationModels.Digest) Digest {
+	return Digest{
+		DBTimestamp:      dtos.DBTimestamp(d.DBTimestamp),
+		Id:               d.Id,
+		Name:             d.Name,
+		Description:      d.Description,
+		SubscriptionName: d.SubscriptionName,
+		Window:           d.Window,
+		Categories:       d.Categories,
+	}
+}
+
+// AddDigestRequest defines the Request Content for POST Digest DTO
+type AddDigestRequest struct {
+	common.BaseRequest `json:",inline"`
+	Digest             Digest `json:"digest"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddDigestRequest) Validate() error {
+	err := contractsCommon.Validate(r)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDigestRequest type
+func (r *AddDigestRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Digest Digest
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddDigestRequest(alias)
+	return r.Validate()
+}
+
+// DigestResponse defines the Response Content for GET Digest DTO
+type DigestResponse struct {
+	common.BaseResponse `json:",inline"`
+	Digest              Digest `json:"digest"`
+}
+
+func NewDigestResponse(requestId string, message string, statusCode int, digest Digest) DigestResponse {
+	return DigestResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Digest:       digest,
+	}
+}
+
+// MultiDigestsResponse defines the Response Content for GET multiple Digest DTOs
+type MultiDigestsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Digests                           []Digest `json:"digests"`
+}
+
+func NewMultiDigestsResponse(requestId string, message string, statusCode int, totalCount uint32, digests []Digest) MultiDigestsResponse {
+	return MultiDigestsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Digests:                    digests,
+	}
+}
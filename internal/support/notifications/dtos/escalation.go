@@ -0,0 +1,173 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// EscalationPolicy escalates the CRITICAL notifications distributed to a subscription which aren't acknowledged within
+// the window, by sending them again via the alternate channels and to the alternate subscription
+type EscalationPolicy struct {
+	dtos.DBTimestamp           `json:",inline"`
+	Id                         string         `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name                       string         `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description                string         `json:"description,omitempty"`
+	SubscriptionName           string         `json:"subscriptionName" validate:"required,edgex-dto-none-empty-string"`
+	Window                     string         `json:"window" validate:"required,edgex-dto-duration"`
+	Channels                   []dtos.Address `json:"channels,omitempty" validate:"dive"`
+	EscalationSubscriptionName string         `json:"escalationSubscriptionName,omitempty" validate:"omitempty,edgex-dto-none-empty-string"`
+}
+
+// ToEscalationPolicyModel transforms the EscalationPolicy DTO to the EscalationPolicy Model
+func ToEscalationPolicyModel(dto EscalationPolicy) notificationModels.EscalationPolicy {
+	return notificationModels.EscalationPolicy{
+		DBTimestamp:                models.DBTimestamp(dto.DBTimestamp),
+		Id:                         dto.Id,
+		Name:                       dto.Name,
+		Description:                dto.Description,
+		SubscriptionName:           dto.SubscriptionName,
+		Window:                     dto.Window,
+		Channels:                   dtos.ToAddressModels(dto.Channels),
+		EscalationSubscriptionName: dto.EscalationSubscriptionName,
+	}
+}
+
+// FromEscalationPolicyModelToDTO transforms the EscalationPolicy Model to the EscalationPolicy DTO
+func FromEscalationPolicyModelToDTO(p notificationModels.EscalationPolicy) EscalationPolicy {
+	return EscalationPolicy{
+		DBTimestamp:                dtos.DBTimestamp(p.DBTimestamp),
+		Id:                         p.Id,
+		Name:                       p.Name,
+		Description:                p.Description,
+		SubscriptionName:           p.SubscriptionName,
+		Window:                     p.Window,
+		Channels:                   dtos.FromAddressModelsToDTOs(p.Channels),
+		EscalationSubscriptionName: p.EscalationSubscriptionName,
+	}
+}
+
+// AddEscalationPolicyRequest defines the Request Content for POST EscalationPolicy DTO
+type AddEscalationPolicyRequest struct {
+	common.BaseRequest `json:",inline"`
+	Policy             EscalationPolicy `json:"policy"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddEscalationPolicyRequest) Validate() error {
+	if err := contractsCommon.Validate(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateChannels(r.Policy.Channels); err != nil {
+		return err
+	}
+	if len(r.Policy.Channels) == 0 && r.Policy.EscalationSubscriptionName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "channels or escalationSubscriptionName must be specified", nil)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddEscalationPolicyRequest type
+func (r *AddEscalationPolicyRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Policy EscalationPolicy
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddEscalationPolicyRequest(alias)
+	return r.Validate()
+}
+
+// EscalationPolicyResponse defines the Response Content for GET EscalationPolicy DTO
+type EscalationPolicyResponse struct {
+	common.BaseResponse `json:",inline"`
+	Policy              EscalationPolicy `json:"policy"`
+}
+
+func NewEscalationPolicyResponse(requestId string, message string, statusCode int, policy EscalationPolicy) EscalationPolicyResponse {
+	return EscalationPolicyResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Policy:       policy,
+	}
+}
+
+// MultiEscalationPoliciesResponse defines the Response Content for GET multiple EscalationPolicy DTOs
+type MultiEscalationPoliciesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Policies                          []EscalationPolicy `json:"policies"`
+}
+
+func NewMultiEscalationPoliciesResponse(requestId string, message string, statusCode int, totalCount uint32, policies []EscalationPolicy) MultiEscalationPoliciesResponse {
+	return MultiEscalationPoliciesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Policies:                   policies,
+	}
+}
+
+// NotificationAcknowledgement records that a notification was acknowledged, which stops its escalation
+type NotificationAcknowledgement struct {
+	NotificationId string `json:"notificationId"`
+	AcknowledgedBy string `json:"acknowledgedBy,omitempty"`
+	Acknowledged   int64  `json:"acknowledged"`
+}
+
+// FromNotificationAcknowledgementModelToDTO transforms the NotificationAcknowledgement Model to the DTO
+func FromNotificationAcknowledgementModelToDTO(a notificationModels.NotificationAcknowledgement) NotificationAcknowledgement {
+	return NotificationAcknowledgement{
+		NotificationId: a.NotificationId,
+		AcknowledgedBy: a.AcknowledgedBy,
+		Acknowledged:   a.Acknowledged,
+	}
+}
+
+// AcknowledgeNotificationRequest defines the Request Content to acknowledge a notification
+type AcknowledgeNotificationRequest struct {
+	common.BaseRequest `json:",inline"`
+	AcknowledgedBy     string `json:"acknowledgedBy,omitempty"`
+}
+
+// Validate satisfies the Validator interface
+func (r AcknowledgeNotificationRequest) Validate() error {
+	return contractsCommon.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AcknowledgeNotificationRequest type
+func (r *AcknowledgeNotificationRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		AcknowledgedBy string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AcknowledgeNotificationRequest(alias)
+	return r.Validate()
+}
+
+// NotificationAcknowledgementResponse defines the Response Content for GET NotificationAcknowledgement DTO
+type NotificationAcknowledgementResponse struct {
+	common.BaseResponse `json:",inline"`
+	Acknowledgement     NotificationAcknowledgement `json:"acknowledgement"`
+}
+
+func NewNotificationAcknowledgementResponse(requestId string, message string, statusCode int, acknowledgement NotificationAcknowledgement) NotificationAcknowledgementResponse {
+	return NotificationAcknowledgementResponse{
+		BaseResponse:    common.NewBaseResponse(requestId, message, statusCode),
+		Acknowledgement: acknowledgement,
+	}
+}
@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// SmsChannel sends the notifications distributed to a subscription as SMS messages to the phone numbers
+type SmsChannel struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string   `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string   `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string   `json:"description,omitempty"`
+	SubscriptionName string   `json:"subscriptionName" validate:"required,edgex-dto-none-empty-string"`
+	PhoneNumbers     []string `json:"phoneNumbers" validate:"required,gt=0,dive,e164"`
+}
+
+// ToSmsChannelModel transforms the SmsChannel DTO to the SmsChannel Model
+func ToSmsChannelModel(dto SmsChannel) notificationModels.SmsChannel {
+	return notificationModels.SmsChannel{
+		DBTimestamp:      models.DBTimestamp(dto.DBTimestamp),
+		Id:               dto.Id,
+		Name:             dto.Name,
+		Description:      dto.Description,
+		SubscriptionName: dto.SubscriptionName,
+		PhoneNumbers:     dto.PhoneNumbers,
+	}
+}
+
+// FromSmsChannelModelToDTO transforms the SmsChannel Model to the SmsChannel DTO
+func FromSmsChannelModelToDTO(c notificationModels.SmsChannel) SmsChannel {
+	return SmsChannel{
+		DBTimestamp:      dtos.DBTimestamp(c.DBTimestamp),
+		Id:               c.Id,
+		Name:             c.Name,
+		Description:      c.Description,
+		SubscriptionName: c.SubscriptionName,
+		PhoneNumbers:     c.PhoneNumbers,
+	}
+}
+
+// AddSmsChannelRequest defines the Request Content for POST SmsChannel DTO
+type AddSmsChannelRequest struct {
+	common.BaseRequest `json:",inline"`
+	SmsChannel         SmsChannel `json:"smsChannel"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddSmsChannelRequest) Validate() error {
+	err := contractsCommon.Validate(r)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddSmsChannelRequest type
+func (r *AddSmsChannelRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		SmsChannel SmsChannel
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddSmsChannelRequest(alias)
+	return r.Validate()
+}
+
+// SmsChannelResponse defines the Response Content for GET SmsChannel DTO
+type SmsChannelResponse struct {
+	common.BaseResponse `json:",inline"`
+	SmsChannel          SmsChannel `json:"smsChannel"`
+}
+
+func NewSmsChannelResponse(requestId string, message string, statusCode int, smsChannel SmsChannel) SmsChannelResponse {
+	return SmsChannelResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		SmsChannel:   smsChannel,
+	}
+}
+
+// MultiSmsChannelsResponse defines the Response Content for GET multiple SmsChannel DTOs
+type MultiSmsChannelsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	SmsChannels                       []SmsChannel `json:"smsChannels"`
+}
+
+func NewMultiSmsChannelsResponse(requestId string, message string, statusCode int, totalCount uint32, smsChannels []SmsChannel) MultiSmsChannelsResponse {
+	return MultiSmsChannelsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		SmsChannels:                smsChannels,
+	}
+}
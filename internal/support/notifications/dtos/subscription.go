@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"fmt"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// The subscription requests of go-mod-core-contracts reject the MQTT channels, which support-notifications publishes
+// the notifications to. These requests accept the same subscriptions with the MQTT channels.
+
+// AddSubscriptionRequest defines the Request Content for POST Subscription DTO
+type AddSubscriptionRequest struct {
+	common.BaseRequest `json:",inline"`
+	Subscription       dtos.Subscription `json:"subscription"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddSubscriptionRequest) Validate() error {
+	if err := contractsCommon.Validate(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return validateChannels(r.Subscription.Channels)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddSubscriptionRequest type
+func (r *AddSubscriptionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Subscription dtos.Subscription
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddSubscriptionRequest(alias)
+	return r.Validate()
+}
+
+// AddSubscriptionReqToSubscriptionModels transforms the AddSubscriptionRequest DTO array to the Subscription model array
+func AddSubscriptionReqToSubscriptionModels(reqs []AddSubscriptionRequest) []models.Subscription {
+	subscriptions := make([]models.Subscription, len(reqs))
+	for i, req := range reqs {
+		subscriptions[i] = dtos.ToSubscriptionModel(req.Subscription)
+	}
+	return subscriptions
+}
+
+// UpdateSubscriptionRequest defines the Request Content for PATCH Subscription DTO
+type UpdateSubscriptionRequest struct {
+	common.BaseRequest `json:",inline"`
+	Subscription       dtos.UpdateSubscription `json:"subscription"`
+}
+
+// Validate satisfies the Validator interface
+func (r UpdateSubscriptionRequest) Validate() error {
+	if err := contractsCommon.Validate(r); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := validateChannels(r.Subscription.Channels); err != nil {
+		return err
+	}
+	if r.Subscription.Categories != nil && r.Subscription.Labels != nil &&
+		len(r.Subscription.Categories) == 0 && len(r.Subscription.Labels) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "categories and labels can not be both empty", nil)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateSubscriptionRequest type
+func (r *UpdateSubscriptionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Subscription dtos.UpdateSubscription
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = UpdateSubscriptionRequest(alias)
+	return r.Validate()
+}
+
+// validateChannels validates the addresses of the channels, and the QoS of the MQTT channels
+func validateChannels(channels []dtos.Address) error {
+	for _, c := range channels {
+		if err := c.Validate(); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		if c.Type == contractsCommon.MQTT && (c.QoS < 0 || c.QoS > 2) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid MQTT QoS %d, must be 0, 1 or 2", c.QoS), nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// NotificationTemplate renders the content of the notifications sent to a subscription via all its channels, or via
+// the channels of channelType only
+type NotificationTemplate struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string `json:"description,omitempty"`
+	SubscriptionName string `json:"subscriptionName" validate:"required,edgex-dto-none-empty-string"`
+	ChannelType      string `json:"channelType,omitempty" validate:"omitempty,oneof='REST' 'EMAIL' 'MQTT' 'SLACK' 'TEAMS' 'DISCORD' 'SMS'"`
+	ContentType      string `json:"contentType,omitempty"`
+	Template         string `json:"template" validate:"required"`
+}
+
+// ToNotificationTemplateModel transforms the NotificationTemplate DTO to the NotificationTemplate Model
+func ToNotificationTemplateModel(dto NotificationTemplate) notificationModels.NotificationTemplate {
+	return notificationModels.NotificationTemplate{
+		DBTimestamp:      models.DBTimestamp(dto.DBTimestamp),
+		Id:               dto.Id,
+		Name:             dto.Name,
+		Description:      dto.Description,
+		SubscriptionName: dto.SubscriptionName,
+		ChannelType:      dto.ChannelType,
+		ContentType:      dto.ContentType,
+		Template:         dto.Template,
+	}
+}
+
+// FromNotificationTemplateModelToDTO transforms the NotificationTemplate Model to the NotificationTemplate DTO
+func FromNotificationTemplateModelToDTO(t notificationModels.NotificationTemplate) NotificationTemplate {
+	return NotificationTemplate{
+		DBTimestamp:      dtos.DBTimestamp(t.DBTimestamp),
+		Id:               t.Id,
+		Name:             t.Name,
+		Description:      t.Description,
+		SubscriptionName: t.SubscriptionName,
+		ChannelType:      t.ChannelType,
+		ContentType:      t.ContentType,
+		Template:         t.Template,
+	}
+}
+
+// AddNotificationTemplateRequest defines the Request Content for POST NotificationTemplate DTO
+type AddNotificationTemplateRequest struct {
+	common.BaseRequest `json:",inline"`
+	Template           NotificationTemplate `json:"template"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddNotificationTemplateRequest) Validate() error {
+	err := contractsCommon.Validate(r)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddNotificationTemplateRequest type
+func (r *AddNotificationTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Template NotificationTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddNotificationTemplateRequest(alias)
+	return r.Validate()
+}
+
+// NotificationTemplateResponse defines the Response Content for GET NotificationTemplate DTO
+type NotificationTemplateResponse struct {
+	common.BaseResponse `json:",inline"`
+	Template            NotificationTemplate `json:"template"`
+}
+
+func NewNotificationTemplateResponse(requestId string, message string, statusCode int, template NotificationTemplate) NotificationTemplateResponse {
+	return NotificationTemplateResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Template:     template,
+	}
+}
+
+// MultiNotificationTemplatesResponse defines the Response Content for GET multiple NotificationTemplate DTOs
+type MultiNotificationTemplatesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Templates                         []NotificationTemplate `json:"templates"`
+}
+
+func NewMultiNotificationTemplatesResponse(requestId string, message string, statusCode int, totalCount uint32, templates []NotificationTemplate) MultiNotificationTemplatesResponse {
+	return MultiNotificationTemplatesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Templates:                  templates,
+	}
+}
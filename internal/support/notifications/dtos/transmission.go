@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// ResendTransmissionRequest defines the Request Content to resend a transmission, to the channel of the transmission
+// unless the alternate channel is specified
+type ResendTransmissionRequest struct {
+	common.BaseRequest `json:",inline"`
+	Channel            *dtos.Address `json:"channel,omitempty" validate:"omitempty"`
+}
+
+// Validate satisfies the Validator interface
+func (r ResendTransmissionRequest) Validate() error {
+	return contractsCommon.Validate(r)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the ResendTransmissionRequest type
+func (r *ResendTransmissionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Channel *dtos.Address
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = ResendTransmissionRequest(alias)
+	return r.Validate()
+}
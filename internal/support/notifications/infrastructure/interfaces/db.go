@@ -6,8 +6,12 @@
 package interfaces
 
 import (
+	"time"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 )
 
 type DBClient interface {
@@ -59,4 +63,66 @@ type DBClient interface {
 	TransmissionCountByTimeRange(start int, end int) (uint32, errors.EdgeX)
 	TransmissionsByNotificationId(offset, limit int, id string) ([]models.Transmission, errors.EdgeX)
 	TransmissionCountByNotificationId(id string) (uint32, errors.EdgeX)
+	AddTransmissionResend(originalId string, resend models.Transmission) (models.Transmission, errors.EdgeX)
+	TransmissionResends(offset, limit int, originalId string) ([]models.Transmission, errors.EdgeX)
+	TransmissionResendCount(originalId string) (uint32, errors.EdgeX)
+
+	AddEscalationPolicy(p notificationModels.EscalationPolicy) (notificationModels.EscalationPolicy, errors.EdgeX)
+	EscalationPolicyByName(name string) (notificationModels.EscalationPolicy, errors.EdgeX)
+	AllEscalationPolicies(offset int, limit int) ([]notificationModels.EscalationPolicy, errors.EdgeX)
+	EscalationPoliciesBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.EscalationPolicy, errors.EdgeX)
+	EscalationPolicyTotalCount() (uint32, errors.EdgeX)
+	DeleteEscalationPolicyByName(name string) errors.EdgeX
+	AddNotificationAcknowledgement(ack notificationModels.NotificationAcknowledgement) (notificationModels.NotificationAcknowledgement, errors.EdgeX)
+	NotificationAcknowledgement(notificationId string) (notificationModels.NotificationAcknowledgement, errors.EdgeX)
+	UnacknowledgedNotifications(offset int, limit int, category string, severity string) ([]models.Notification, errors.EdgeX)
+	UnacknowledgedNotificationCount(category string, severity string) (uint32, errors.EdgeX)
+	DeleteAcknowledgedNotificationsByAge(age int64) errors.EdgeX
+
+	AddNotificationTemplate(t notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX)
+	NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX)
+	NotificationTemplateBySubscriptionNameAndChannelType(subscriptionName string, channelType string) (notificationModels.NotificationTemplate, errors.EdgeX)
+	AllNotificationTemplates(offset int, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX)
+	NotificationTemplateTotalCount() (uint32, errors.EdgeX)
+	DeleteNotificationTemplateByName(name string) errors.EdgeX
+
+	AddDigest(d notificationModels.Digest) (notificationModels.Digest, errors.EdgeX)
+	DigestByName(name string) (notificationModels.Digest, errors.EdgeX)
+	DigestBySubscriptionName(subscriptionName string) (notificationModels.Digest, errors.EdgeX)
+	AllDigests(offset int, limit int) ([]notificationModels.Digest, errors.EdgeX)
+	DigestTotalCount() (uint32, errors.EdgeX)
+	DeleteDigestByName(name string) errors.EdgeX
+	AddDigestedNotification(subscriptionName string, n models.Notification) (uint32, errors.EdgeX)
+	DigestedNotificationCount(subscriptionName string) (uint32, errors.EdgeX)
+	PopDigestedNotificationIds(subscriptionName string) ([]string, errors.EdgeX)
+
+	AddNotificationOccurrence(deduplicationKey string, notificationId string, window time.Duration) (bool, errors.EdgeX)
+	PopSuppressedNotificationCount(deduplicationKey string) (uint32, errors.EdgeX)
+
+	AddConnector(c notificationModels.Connector) (notificationModels.Connector, errors.EdgeX)
+	ConnectorByName(name string) (notificationModels.Connector, errors.EdgeX)
+	AllConnectors(offset int, limit int) ([]notificationModels.Connector, errors.EdgeX)
+	ConnectorsBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.Connector, errors.EdgeX)
+	ConnectorTotalCount() (uint32, errors.EdgeX)
+	DeleteConnectorByName(name string) errors.EdgeX
+
+	AddSmsChannel(c notificationModels.SmsChannel) (notificationModels.SmsChannel, errors.EdgeX)
+	SmsChannelByName(name string) (notificationModels.SmsChannel, errors.EdgeX)
+	AllSmsChannels(offset int, limit int) ([]notificationModels.SmsChannel, errors.EdgeX)
+	SmsChannelsBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.SmsChannel, errors.EdgeX)
+	SmsChannelTotalCount() (uint32, errors.EdgeX)
+	DeleteSmsChannelByName(name string) errors.EdgeX
+	AddSmsMessage(messageId string, transmissionId string) errors.EdgeX
+	TransmissionIdBySmsMessageId(messageId string) (string, errors.EdgeX)
+
+	AddDeliveryPolicy(d notificationModels.DeliveryPolicy) (notificationModels.DeliveryPolicy, errors.EdgeX)
+	DeliveryPolicyByName(name string) (notificationModels.DeliveryPolicy, errors.EdgeX)
+	DeliveryPolicyBySubscriptionName(subscriptionName string) (notificationModels.DeliveryPolicy, errors.EdgeX)
+	AllDeliveryPolicies(offset int, limit int) ([]notificationModels.DeliveryPolicy, errors.EdgeX)
+	DeliveryPolicyTotalCount() (uint32, errors.EdgeX)
+	DeleteDeliveryPolicyByName(name string) errors.EdgeX
+	AddQueuedNotification(subscriptionName string, n models.Notification) (uint32, errors.EdgeX)
+	QueuedNotificationCount(subscriptionName string) (uint32, errors.EdgeX)
+	PopQueuedNotificationIds(subscriptionName string) ([]string, errors.EdgeX)
+	IncrNotificationRate(subscriptionName string, interval time.Duration) (uint32, errors.EdgeX)
 }
@@ -8,27 +8,883 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	notificationModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	time "time"
 )
 
-// DBClient is an autogenerated mock type for the DBClient type
-type DBClient struct {
-	mock.Mock
+// DBClient is an autogenerated mock type for the DBClient type
+type DBClient struct {
+	mock.Mock
+}
+
+// AddConnector provides a mock function with given fields: c
+func (_m *DBClient) AddConnector(c notificationModels.Connector) (notificationModels.Connector, errors.EdgeX) {
+	ret := _m.Called(c)
+
+	var r0 notificationModels.Connector
+	if rf, ok := ret.Get(0).(func(notificationModels.Connector) notificationModels.Connector); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Get(0).(notificationModels.Connector)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(notificationModels.Connector) errors.EdgeX); ok {
+		r1 = rf(c)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDeliveryPolicy provides a mock function with given fields: d
+func (_m *DBClient) AddDeliveryPolicy(d notificationModels.DeliveryPolicy) (notificationModels.DeliveryPolicy, errors.EdgeX) {
+	ret := _m.Called(d)
+
+	var r0 notificationModels.DeliveryPolicy
+	if rf, ok := ret.Get(0).(func(notificationModels.DeliveryPolicy) notificationModels.DeliveryPolicy); ok {
+		r0 = rf(d)
+	} else {
+		r0 = ret.Get(0).(notificationModels.DeliveryPolicy)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(notificationModels.DeliveryPolicy) errors.EdgeX); ok {
+		r1 = rf(d)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDigest provides a mock function with given fields: d
+func (_m *DBClient) AddDigest(d notificationModels.Digest) (notificationModels.Digest, errors.EdgeX) {
+	ret := _m.Called(d)
+
+	var r0 notificationModels.Digest
+	if rf, ok := ret.Get(0).(func(notificationModels.Digest) notificationModels.Digest); ok {
+		r0 = rf(d)
+	} else {
+		r0 = ret.Get(0).(notificationModels.Digest)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(notificationModels.Digest) errors.EdgeX); ok {
+		r1 = rf(d)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDigestedNotification provides a mock function with given fields: subscriptionName, n
+func (_m *DBClient) AddDigestedNotification(subscriptionName string, n models.Notification) (uint32, errors.EdgeX) {
+	ret := _m.Called(subscriptionName, n)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string, models.Notification) uint32); ok {
+		r0 = rf(subscriptionName, n)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, models.Notification) errors.EdgeX); ok {
+		r1 = rf(subscriptionName, n)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddEscalationPolicy provides a mock function with given fields: p
+func (_m *DBClient) AddEscalationPolicy(p notificationModels.EscalationPolicy) (notificationModels.EscalationPolicy, errors.EdgeX) {
+	ret := _m.Called(p)
+
+	var r0 notificationModels.EscalationPolicy
+	if rf, ok := ret.Get(0).(func(notificationModels.EscalationPolicy) notificationModels.EscalationPolicy); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Get(0).(notificationModels.EscalationPolicy)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(notificationModels.EscalationPolicy) errors.EdgeX); ok {
+		r1 = rf(p)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddNotification provides a mock function with given fields: n
+func (_m *DBClient) AddNotification(n models.Notification) (models.Notification, errors.EdgeX) {
+	ret := _m.Called(n)
+
+	var r0 models.Notification
+	if rf, ok := ret.Get(0).(func(models.Notification) models.Notification); ok {
+		r0 = rf(n)
+	} else {
+		r0 = ret.Get(0).(models.Notification)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(models.Notification) errors.EdgeX); ok {
+		r1 = rf(n)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddNotificationAcknowledgement provides a mock function with given fields: ack
+func (_m *DBClient) AddNotificationAcknowledgement(ack notificationModels.NotificationAcknowledgement) (notificationModels.NotificationAcknowledgement, errors.EdgeX) {
+	ret := _m.Called(ack)
+
+	var r0 notificationModels.NotificationAcknowledgement
+	if rf, ok := ret.Get(0).(func(notificationModels.NotificationAcknowledgement) notificationModels.NotificationAcknowledgement); ok {
+		r0 = rf(ack)
+	} else {
+		r0 = ret.Get(0).(notificationModels.NotificationAcknowledgement)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(notificationModels.NotificationAcknowledgement) errors.EdgeX); ok {
+		r1 = rf(ack)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddNotificationOccurrence provides a mock function with given fields: deduplicationKey, notificationId, window
+func (_m *DBClient) AddNotificationOccurrence(deduplicationKey string, notificationId string, window time.Duration) (bool, errors.EdgeX) {
+	ret := _m.Called(deduplicationKey, notificationId, window)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) bool); ok {
+		r0 = rf(deduplicationKey, notificationId, window)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) errors.EdgeX); ok {
+		r1 = rf(deduplicationKey, notificationId, window)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddNotificationTemplate provides a mock function with given fields: t
+func (_m *DBClient) AddNotificationTemplate(t notificationModels.NotificationTemplate) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(t)
+
+	var r0 notificationModels.NotificationTemplate
+	if rf, ok := ret.Get(0).(func(notificationModels.NotificationTemplate) notificationModels.NotificationTemplate); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(notificationModels.NotificationTemplate)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(notificationModels.NotificationTemplate) errors.EdgeX); ok {
+		r1 = rf(t)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddQueuedNotification provides a mock function with given fields: subscriptionName, n
+func (_m *DBClient) AddQueuedNotification(subscriptionName string, n models.Notification) (uint32, errors.EdgeX) {
+	ret := _m.Called(subscriptionName, n)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string, models.Notification) uint32); ok {
+		r0 = rf(subscriptionName, n)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, models.Notification) errors.EdgeX); ok {
+		r1 = rf(subscriptionName, n)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddSmsChannel provides a mock function with given fields: c
+func (_m *DBClient) AddSmsChannel(c notificationModels.SmsChannel) (notificationModels.SmsChannel, errors.EdgeX) {
+	ret := _m.Called(c)
+
+	var r0 notificationModels.SmsChannel
+	if rf, ok := ret.Get(0).(func(notificationModels.SmsChannel) notificationModels.SmsChannel); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Get(0).(notificationModels.SmsChannel)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(notificationModels.SmsChannel) errors.EdgeX); ok {
+		r1 = rf(c)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddSmsMessage provides a mock function with given fields: messageId, transmissionId
+func (_m *DBClient) AddSmsMessage(messageId string, transmissionId string) errors.EdgeX {
+	ret := _m.Called(messageId, transmissionId)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(messageId, transmissionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// AddSubscription provides a mock function with given fields: e
+func (_m *DBClient) AddSubscription(e models.Subscription) (models.Subscription, errors.EdgeX) {
+	ret := _m.Called(e)
+
+	var r0 models.Subscription
+	if rf, ok := ret.Get(0).(func(models.Subscription) models.Subscription); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Get(0).(models.Subscription)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(models.Subscription) errors.EdgeX); ok {
+		r1 = rf(e)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddTransmission provides a mock function with given fields: trans
+func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmission, errors.EdgeX) {
+	ret := _m.Called(trans)
+
+	var r0 models.Transmission
+	if rf, ok := ret.Get(0).(func(models.Transmission) models.Transmission); ok {
+		r0 = rf(trans)
+	} else {
+		r0 = ret.Get(0).(models.Transmission)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(models.Transmission) errors.EdgeX); ok {
+		r1 = rf(trans)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddTransmissionResend provides a mock function with given fields: originalId, resend
+func (_m *DBClient) AddTransmissionResend(originalId string, resend models.Transmission) (models.Transmission, errors.EdgeX) {
+	ret := _m.Called(originalId, resend)
+
+	var r0 models.Transmission
+	if rf, ok := ret.Get(0).(func(string, models.Transmission) models.Transmission); ok {
+		r0 = rf(originalId, resend)
+	} else {
+		r0 = ret.Get(0).(models.Transmission)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, models.Transmission) errors.EdgeX); ok {
+		r1 = rf(originalId, resend)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllConnectors provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllConnectors(offset int, limit int) ([]notificationModels.Connector, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []notificationModels.Connector
+	if rf, ok := ret.Get(0).(func(int, int) []notificationModels.Connector); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.Connector)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllDeliveryPolicies provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeliveryPolicies(offset int, limit int) ([]notificationModels.DeliveryPolicy, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []notificationModels.DeliveryPolicy
+	if rf, ok := ret.Get(0).(func(int, int) []notificationModels.DeliveryPolicy); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.DeliveryPolicy)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllDigests provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDigests(offset int, limit int) ([]notificationModels.Digest, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []notificationModels.Digest
+	if rf, ok := ret.Get(0).(func(int, int) []notificationModels.Digest); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.Digest)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllEscalationPolicies provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllEscalationPolicies(offset int, limit int) ([]notificationModels.EscalationPolicy, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []notificationModels.EscalationPolicy
+	if rf, ok := ret.Get(0).(func(int, int) []notificationModels.EscalationPolicy); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.EscalationPolicy)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllNotificationTemplates provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllNotificationTemplates(offset int, limit int) ([]notificationModels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []notificationModels.NotificationTemplate
+	if rf, ok := ret.Get(0).(func(int, int) []notificationModels.NotificationTemplate); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.NotificationTemplate)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllSmsChannels provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllSmsChannels(offset int, limit int) ([]notificationModels.SmsChannel, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []notificationModels.SmsChannel
+	if rf, ok := ret.Get(0).(func(int, int) []notificationModels.SmsChannel); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.SmsChannel)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllSubscriptions provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscription, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []models.Subscription
+	if rf, ok := ret.Get(0).(func(int, int) []models.Subscription); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Subscription)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllTransmissions provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmission, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []models.Transmission
+	if rf, ok := ret.Get(0).(func(int, int) []models.Transmission); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transmission)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CleanupNotificationsByAge provides a mock function with given fields: age
+func (_m *DBClient) CleanupNotificationsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// CloseSession provides a mock function with given fields:
+func (_m *DBClient) CloseSession() {
+	_m.Called()
+}
+
+// ConnectorByName provides a mock function with given fields: name
+func (_m *DBClient) ConnectorByName(name string) (notificationModels.Connector, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 notificationModels.Connector
+	if rf, ok := ret.Get(0).(func(string) notificationModels.Connector); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(notificationModels.Connector)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ConnectorTotalCount provides a mock function with given fields:
+func (_m *DBClient) ConnectorTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ConnectorsBySubscriptionName provides a mock function with given fields: offset, limit, subscriptionName
+func (_m *DBClient) ConnectorsBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.Connector, errors.EdgeX) {
+	ret := _m.Called(offset, limit, subscriptionName)
+
+	var r0 []notificationModels.Connector
+	if rf, ok := ret.Get(0).(func(int, int, string) []notificationModels.Connector); ok {
+		r0 = rf(offset, limit, subscriptionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.Connector)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteAcknowledgedNotificationsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteAcknowledgedNotificationsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteConnectorByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteConnectorByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDeliveryPolicyByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeliveryPolicyByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDigestByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDigestByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEscalationPolicyByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteEscalationPolicyByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteNotificationById provides a mock function with given fields: id
+func (_m *DBClient) DeleteNotificationById(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteNotificationTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteNotificationTemplateByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteProcessedNotificationsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteProcessedNotificationsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteProcessedTransmissionsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteSmsChannelByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteSmsChannelByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteSubscriptionByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteSubscriptionByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeliveryPolicyByName provides a mock function with given fields: name
+func (_m *DBClient) DeliveryPolicyByName(name string) (notificationModels.DeliveryPolicy, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 notificationModels.DeliveryPolicy
+	if rf, ok := ret.Get(0).(func(string) notificationModels.DeliveryPolicy); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(notificationModels.DeliveryPolicy)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeliveryPolicyBySubscriptionName provides a mock function with given fields: subscriptionName
+func (_m *DBClient) DeliveryPolicyBySubscriptionName(subscriptionName string) (notificationModels.DeliveryPolicy, errors.EdgeX) {
+	ret := _m.Called(subscriptionName)
+
+	var r0 notificationModels.DeliveryPolicy
+	if rf, ok := ret.Get(0).(func(string) notificationModels.DeliveryPolicy); ok {
+		r0 = rf(subscriptionName)
+	} else {
+		r0 = ret.Get(0).(notificationModels.DeliveryPolicy)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
 }
 
-// AddNotification provides a mock function with given fields: n
-func (_m *DBClient) AddNotification(n models.Notification) (models.Notification, errors.EdgeX) {
-	ret := _m.Called(n)
+// DeliveryPolicyTotalCount provides a mock function with given fields:
+func (_m *DBClient) DeliveryPolicyTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
 
-	var r0 models.Notification
-	if rf, ok := ret.Get(0).(func(models.Notification) models.Notification); ok {
-		r0 = rf(n)
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(models.Notification)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(models.Notification) errors.EdgeX); ok {
-		r1 = rf(n)
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -38,20 +894,20 @@ func (_m *DBClient) AddNotification(n models.Notification) (models.Notification,
 	return r0, r1
 }
 
-// AddSubscription provides a mock function with given fields: e
-func (_m *DBClient) AddSubscription(e models.Subscription) (models.Subscription, errors.EdgeX) {
-	ret := _m.Called(e)
+// DigestByName provides a mock function with given fields: name
+func (_m *DBClient) DigestByName(name string) (notificationModels.Digest, errors.EdgeX) {
+	ret := _m.Called(name)
 
-	var r0 models.Subscription
-	if rf, ok := ret.Get(0).(func(models.Subscription) models.Subscription); ok {
-		r0 = rf(e)
+	var r0 notificationModels.Digest
+	if rf, ok := ret.Get(0).(func(string) notificationModels.Digest); ok {
+		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(models.Subscription)
+		r0 = ret.Get(0).(notificationModels.Digest)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(models.Subscription) errors.EdgeX); ok {
-		r1 = rf(e)
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -61,20 +917,20 @@ func (_m *DBClient) AddSubscription(e models.Subscription) (models.Subscription,
 	return r0, r1
 }
 
-// AddTransmission provides a mock function with given fields: trans
-func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmission, errors.EdgeX) {
-	ret := _m.Called(trans)
+// DigestBySubscriptionName provides a mock function with given fields: subscriptionName
+func (_m *DBClient) DigestBySubscriptionName(subscriptionName string) (notificationModels.Digest, errors.EdgeX) {
+	ret := _m.Called(subscriptionName)
 
-	var r0 models.Transmission
-	if rf, ok := ret.Get(0).(func(models.Transmission) models.Transmission); ok {
-		r0 = rf(trans)
+	var r0 notificationModels.Digest
+	if rf, ok := ret.Get(0).(func(string) notificationModels.Digest); ok {
+		r0 = rf(subscriptionName)
 	} else {
-		r0 = ret.Get(0).(models.Transmission)
+		r0 = ret.Get(0).(notificationModels.Digest)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(models.Transmission) errors.EdgeX); ok {
-		r1 = rf(trans)
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -84,22 +940,20 @@ func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmiss
 	return r0, r1
 }
 
-// AllSubscriptions provides a mock function with given fields: offset, limit
-func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscription, errors.EdgeX) {
-	ret := _m.Called(offset, limit)
+// DigestTotalCount provides a mock function with given fields:
+func (_m *DBClient) DigestTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
 
-	var r0 []models.Subscription
-	if rf, ok := ret.Get(0).(func(int, int) []models.Subscription); ok {
-		r0 = rf(offset, limit)
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Subscription)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -109,22 +963,20 @@ func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscripti
 	return r0, r1
 }
 
-// AllTransmissions provides a mock function with given fields: offset, limit
-func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmission, errors.EdgeX) {
-	ret := _m.Called(offset, limit)
+// DigestedNotificationCount provides a mock function with given fields: subscriptionName
+func (_m *DBClient) DigestedNotificationCount(subscriptionName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(subscriptionName)
 
-	var r0 []models.Transmission
-	if rf, ok := ret.Get(0).(func(int, int) []models.Transmission); ok {
-		r0 = rf(offset, limit)
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(subscriptionName)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Transmission)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -134,89 +986,121 @@ func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmissi
 	return r0, r1
 }
 
-// CleanupNotificationsByAge provides a mock function with given fields: age
-func (_m *DBClient) CleanupNotificationsByAge(age int64) errors.EdgeX {
-	ret := _m.Called(age)
+// EscalationPoliciesBySubscriptionName provides a mock function with given fields: offset, limit, subscriptionName
+func (_m *DBClient) EscalationPoliciesBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.EscalationPolicy, errors.EdgeX) {
+	ret := _m.Called(offset, limit, subscriptionName)
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
-		r0 = rf(age)
+	var r0 []notificationModels.EscalationPolicy
+	if rf, ok := ret.Get(0).(func(int, int, string) []notificationModels.EscalationPolicy); ok {
+		r0 = rf(offset, limit, subscriptionName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+			r0 = ret.Get(0).([]notificationModels.EscalationPolicy)
 		}
 	}
 
-	return r0
-}
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
 
-// CloseSession provides a mock function with given fields:
-func (_m *DBClient) CloseSession() {
-	_m.Called()
+	return r0, r1
 }
 
-// DeleteNotificationById provides a mock function with given fields: id
-func (_m *DBClient) DeleteNotificationById(id string) errors.EdgeX {
-	ret := _m.Called(id)
+// EscalationPolicyByName provides a mock function with given fields: name
+func (_m *DBClient) EscalationPolicyByName(name string) (notificationModels.EscalationPolicy, errors.EdgeX) {
+	ret := _m.Called(name)
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(id)
+	var r0 notificationModels.EscalationPolicy
+	if rf, ok := ret.Get(0).(func(string) notificationModels.EscalationPolicy); ok {
+		r0 = rf(name)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+		r0 = ret.Get(0).(notificationModels.EscalationPolicy)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
 		}
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DeleteProcessedNotificationsByAge provides a mock function with given fields: age
-func (_m *DBClient) DeleteProcessedNotificationsByAge(age int64) errors.EdgeX {
-	ret := _m.Called(age)
+// EscalationPolicyTotalCount provides a mock function with given fields:
+func (_m *DBClient) EscalationPolicyTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
-		r0 = rf(age)
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
 		}
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DeleteProcessedTransmissionsByAge provides a mock function with given fields: age
-func (_m *DBClient) DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX {
-	ret := _m.Called(age)
+// IncrNotificationRate provides a mock function with given fields: subscriptionName, interval
+func (_m *DBClient) IncrNotificationRate(subscriptionName string, interval time.Duration) (uint32, errors.EdgeX) {
+	ret := _m.Called(subscriptionName, interval)
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
-		r0 = rf(age)
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string, time.Duration) uint32); ok {
+		r0 = rf(subscriptionName, interval)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, time.Duration) errors.EdgeX); ok {
+		r1 = rf(subscriptionName, interval)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
 		}
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DeleteSubscriptionByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteSubscriptionByName(name string) errors.EdgeX {
-	ret := _m.Called(name)
+// NotificationAcknowledgement provides a mock function with given fields: notificationId
+func (_m *DBClient) NotificationAcknowledgement(notificationId string) (notificationModels.NotificationAcknowledgement, errors.EdgeX) {
+	ret := _m.Called(notificationId)
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
-		r0 = rf(name)
+	var r0 notificationModels.NotificationAcknowledgement
+	if rf, ok := ret.Get(0).(func(string) notificationModels.NotificationAcknowledgement); ok {
+		r0 = rf(notificationId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+		r0 = ret.Get(0).(notificationModels.NotificationAcknowledgement)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(notificationId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
 		}
 	}
 
-	return r0
+	return r0, r1
 }
 
 // NotificationById provides a mock function with given fields: id
@@ -292,16 +1176,85 @@ func (_m *DBClient) NotificationCountByCategory(category string) (uint32, errors
 func (_m *DBClient) NotificationCountByLabel(label string) (uint32, errors.EdgeX) {
 	ret := _m.Called(label)
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func(string) uint32); ok {
-		r0 = rf(label)
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(label)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(label)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationCountByStatus provides a mock function with given fields: status
+func (_m *DBClient) NotificationCountByStatus(status string) (uint32, errors.EdgeX) {
+	ret := _m.Called(status)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(status)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationCountByTimeRange provides a mock function with given fields: start, end
+func (_m *DBClient) NotificationCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
+	ret := _m.Called(start, end)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(int, int) uint32); ok {
+		r0 = rf(start, end)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) NotificationTemplateByName(name string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 notificationModels.NotificationTemplate
+	if rf, ok := ret.Get(0).(func(string) notificationModels.NotificationTemplate); ok {
+		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(notificationModels.NotificationTemplate)
 	}
 
 	var r1 errors.EdgeX
 	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(label)
+		r1 = rf(name)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -311,20 +1264,20 @@ func (_m *DBClient) NotificationCountByLabel(label string) (uint32, errors.EdgeX
 	return r0, r1
 }
 
-// NotificationCountByStatus provides a mock function with given fields: status
-func (_m *DBClient) NotificationCountByStatus(status string) (uint32, errors.EdgeX) {
-	ret := _m.Called(status)
+// NotificationTemplateBySubscriptionNameAndChannelType provides a mock function with given fields: subscriptionName, channelType
+func (_m *DBClient) NotificationTemplateBySubscriptionNameAndChannelType(subscriptionName string, channelType string) (notificationModels.NotificationTemplate, errors.EdgeX) {
+	ret := _m.Called(subscriptionName, channelType)
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func(string) uint32); ok {
-		r0 = rf(status)
+	var r0 notificationModels.NotificationTemplate
+	if rf, ok := ret.Get(0).(func(string, string) notificationModels.NotificationTemplate); ok {
+		r0 = rf(subscriptionName, channelType)
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(notificationModels.NotificationTemplate)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(status)
+	if rf, ok := ret.Get(1).(func(string, string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName, channelType)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -334,20 +1287,20 @@ func (_m *DBClient) NotificationCountByStatus(status string) (uint32, errors.Edg
 	return r0, r1
 }
 
-// NotificationCountByTimeRange provides a mock function with given fields: start, end
-func (_m *DBClient) NotificationCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
-	ret := _m.Called(start, end)
+// NotificationTemplateTotalCount provides a mock function with given fields:
+func (_m *DBClient) NotificationTemplateTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
 
 	var r0 uint32
-	if rf, ok := ret.Get(0).(func(int, int) uint32); ok {
-		r0 = rf(start, end)
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
 	} else {
 		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
-		r1 = rf(start, end)
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -482,6 +1435,173 @@ func (_m *DBClient) NotificationsByTimeRange(start int, end int, offset int, lim
 	return r0, r1
 }
 
+// PopDigestedNotificationIds provides a mock function with given fields: subscriptionName
+func (_m *DBClient) PopDigestedNotificationIds(subscriptionName string) ([]string, errors.EdgeX) {
+	ret := _m.Called(subscriptionName)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(subscriptionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// PopQueuedNotificationIds provides a mock function with given fields: subscriptionName
+func (_m *DBClient) PopQueuedNotificationIds(subscriptionName string) ([]string, errors.EdgeX) {
+	ret := _m.Called(subscriptionName)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(subscriptionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// PopSuppressedNotificationCount provides a mock function with given fields: deduplicationKey
+func (_m *DBClient) PopSuppressedNotificationCount(deduplicationKey string) (uint32, errors.EdgeX) {
+	ret := _m.Called(deduplicationKey)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(deduplicationKey)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(deduplicationKey)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// QueuedNotificationCount provides a mock function with given fields: subscriptionName
+func (_m *DBClient) QueuedNotificationCount(subscriptionName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(subscriptionName)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(subscriptionName)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SmsChannelByName provides a mock function with given fields: name
+func (_m *DBClient) SmsChannelByName(name string) (notificationModels.SmsChannel, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 notificationModels.SmsChannel
+	if rf, ok := ret.Get(0).(func(string) notificationModels.SmsChannel); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(notificationModels.SmsChannel)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SmsChannelTotalCount provides a mock function with given fields:
+func (_m *DBClient) SmsChannelTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SmsChannelsBySubscriptionName provides a mock function with given fields: offset, limit, subscriptionName
+func (_m *DBClient) SmsChannelsBySubscriptionName(offset int, limit int, subscriptionName string) ([]notificationModels.SmsChannel, errors.EdgeX) {
+	ret := _m.Called(offset, limit, subscriptionName)
+
+	var r0 []notificationModels.SmsChannel
+	if rf, ok := ret.Get(0).(func(int, int, string) []notificationModels.SmsChannel); ok {
+		r0 = rf(offset, limit, subscriptionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationModels.SmsChannel)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, subscriptionName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // SubscriptionById provides a mock function with given fields: id
 func (_m *DBClient) SubscriptionById(id string) (models.Subscription, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -835,6 +1955,77 @@ func (_m *DBClient) TransmissionCountByTimeRange(start int, end int) (uint32, er
 	return r0, r1
 }
 
+// TransmissionIdBySmsMessageId provides a mock function with given fields: messageId
+func (_m *DBClient) TransmissionIdBySmsMessageId(messageId string) (string, errors.EdgeX) {
+	ret := _m.Called(messageId)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(messageId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(messageId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// TransmissionResendCount provides a mock function with given fields: originalId
+func (_m *DBClient) TransmissionResendCount(originalId string) (uint32, errors.EdgeX) {
+	ret := _m.Called(originalId)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(originalId)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(originalId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// TransmissionResends provides a mock function with given fields: offset, limit, originalId
+func (_m *DBClient) TransmissionResends(offset int, limit int, originalId string) ([]models.Transmission, errors.EdgeX) {
+	ret := _m.Called(offset, limit, originalId)
+
+	var r0 []models.Transmission
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Transmission); ok {
+		r0 = rf(offset, limit, originalId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transmission)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, originalId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // TransmissionTotalCount provides a mock function with given fields:
 func (_m *DBClient) TransmissionTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -958,6 +2149,54 @@ func (_m *DBClient) TransmissionsByTimeRange(start int, end int, offset int, lim
 	return r0, r1
 }
 
+// UnacknowledgedNotificationCount provides a mock function with given fields: category, severity
+func (_m *DBClient) UnacknowledgedNotificationCount(category string, severity string) (uint32, errors.EdgeX) {
+	ret := _m.Called(category, severity)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string, string) uint32); ok {
+		r0 = rf(category, severity)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string) errors.EdgeX); ok {
+		r1 = rf(category, severity)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UnacknowledgedNotifications provides a mock function with given fields: offset, limit, category, severity
+func (_m *DBClient) UnacknowledgedNotifications(offset int, limit int, category string, severity string) ([]models.Notification, errors.EdgeX) {
+	ret := _m.Called(offset, limit, category, severity)
+
+	var r0 []models.Notification
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []models.Notification); ok {
+		r0 = rf(offset, limit, category, severity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, category, severity)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // UpdateNotification provides a mock function with given fields: s
 func (_m *DBClient) UpdateNotification(s models.Notification) errors.EdgeX {
 	ret := _m.Called(s)
@@ -20,8 +20,13 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 
@@ -43,11 +48,24 @@ func NewBootstrap(router *mux.Router, serviceName string) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization for the notifications service.
-func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !audit.BootstrapAuditor(container.ConfigurationFrom(dic.Get).Audit, b.serviceName, dic) {
+		return false
+	}
+	if !apikey.BootstrapValidator(ctx, wg, container.ConfigurationFrom(dic.Get).APIKey, dic) {
+		return false
+	}
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
 	restSender := channel.NewRESTSender(dic)
 	emailSender := channel.NewEmailSender(dic)
+	mqttSender := channel.NewMQTTSender(dic)
+	chatSender := channel.NewChatSender()
+	smsProvider, err := channel.NewSmsProvider(dic)
+	if err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("failed to create the SMS provider: %v", err)
+		return false
+	}
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
@@ -55,7 +73,22 @@ func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ sta
 		channel.EmailSenderName: func(get di.Get) interface{} {
 			return emailSender
 		},
+		channel.MQTTSenderName: func(get di.Get) interface{} {
+			return mqttSender
+		},
+		channel.ChatSenderName: func(get di.Get) interface{} {
+			return chatSender
+		},
+		channel.SmsProviderInterfaceName: func(get di.Get) interface{} {
+			return smsProvider
+		},
 	})
 
+	application.ScheduleDigestFlushes(dic)
+	application.ScheduleQueuedNotificationFlushes(dic)
+	if container.ConfigurationFrom(dic.Get).AcknowledgedCleanup.Enabled {
+		application.StartAcknowledgedCleanup(ctx, wg, dic)
+	}
+
 	return true
 }
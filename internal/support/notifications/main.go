@@ -63,7 +63,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		},
 	})
 
-	httpServer := handlers.NewHttpServer(router, true)
+	httpServer := pkgHandlers.NewHttpServer(router, true, &configuration.MutualTLS)
+	database := pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName)
 
 	bootstrap.Run(
 		ctx,
@@ -77,9 +78,10 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		true,
 		config.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
-			pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName).BootstrapHandler, // add db client bootstrap handler
+			database.BootstrapHandler, // add db client bootstrap handler
 			handlers.MessagingBootstrapHandler,
 			handlers.NewServiceMetrics(common.SupportNotificationsServiceKey).BootstrapHandler, // Must be after Messaging
+			database.MetricsBootstrapHandler, // Must be after Service Metrics
 			NewBootstrap(router, common.SupportNotificationsServiceKey).BootstrapHandler,
 			httpServer.BootstrapHandler,
 			handlers.NewStartMessage(common.SupportNotificationsServiceKey, edgex.Version).BootstrapHandler,
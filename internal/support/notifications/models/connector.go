@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// The types of the chat connectors, which are also the channel types of the notification templates rendering the
+// notifications sent via the connectors
+const (
+	ConnectorTypeSlack   = "SLACK"
+	ConnectorTypeTeams   = "TEAMS"
+	ConnectorTypeDiscord = "DISCORD"
+)
+
+// Connector sends the notifications distributed to a subscription to a chat service incoming webhook, formatted for
+// the chat service of Type. The webhook URL embeds its credentials, so it is stored in the secret store under
+// SecretName rather than with the connector.
+type Connector struct {
+	models.DBTimestamp
+	Id               string
+	Name             string
+	Description      string
+	SubscriptionName string
+	Type             string
+	SecretName       string
+}
@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// The actions on the notifications distributed to a subscription during its quiet hours
+const (
+	QuietHoursSuppress = "SUPPRESS"
+	QuietHoursQueue    = "QUEUE"
+)
+
+// DeliveryPolicy limits the delivery of the non-critical notifications distributed to a subscription. At most
+// RateLimit notifications are sent per RateLimitInterval, the next ones are dropped. The notifications distributed
+// during the QuietHours, in the TimeZone, are suppressed or queued until the quiet hours end according to the
+// QuietHoursAction. The CRITICAL notifications are always sent right away.
+type DeliveryPolicy struct {
+	models.DBTimestamp
+	Id                string
+	Name              string
+	Description       string
+	SubscriptionName  string
+	RateLimit         int
+	RateLimitInterval string
+	TimeZone          string
+	QuietHours        []QuietHours
+	QuietHoursAction  string
+}
+
+// QuietHours is a daily window from Start to End, in the 15:04 format, ending the next day when End isn't after
+// Start. The window only starts on the Days, e.g. SAT and SUN, when Days isn't empty.
+type QuietHours struct {
+	Start string
+	End   string
+	Days  []string
+}
@@ -0,0 +1,23 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// Digest aggregates the notifications distributed to a subscription over the window into one digest notification,
+// sent when the window of the first aggregated notification elapses. Only the notifications of Categories are
+// aggregated when Categories isn't empty, and the CRITICAL notifications are never aggregated.
+type Digest struct {
+	models.DBTimestamp
+	Id               string
+	Name             string
+	Description      string
+	SubscriptionName string
+	Window           string
+	Categories       []string
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// EscalationPolicy escalates the CRITICAL notifications distributed to a subscription which aren't acknowledged within
+// the window, by sending them again via the alternate channels and to the alternate subscription. The policies of a
+// subscription with different windows form its escalation chain.
+type EscalationPolicy struct {
+	models.DBTimestamp
+	Id                         string
+	Name                       string
+	Description                string
+	SubscriptionName           string
+	Window                     string
+	Channels                   []models.Address
+	EscalationSubscriptionName string
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the EscalationPolicy type, instantiating the channels by
+// their address type
+func (p *EscalationPolicy) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		models.DBTimestamp
+		Id                         string
+		Name                       string
+		Description                string
+		SubscriptionName           string
+		Window                     string
+		Channels                   json.RawMessage
+		EscalationSubscriptionName string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal escalation policy.", err)
+	}
+	// the Subscription model instantiates the addresses of its channels
+	var channels models.Subscription
+	if len(alias.Channels) > 0 {
+		if err := json.Unmarshal([]byte(`{"Channels":`+string(alias.Channels)+`}`), &channels); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	*p = EscalationPolicy{
+		DBTimestamp:                alias.DBTimestamp,
+		Id:                         alias.Id,
+		Name:                       alias.Name,
+		Description:                alias.Description,
+		SubscriptionName:           alias.SubscriptionName,
+		Window:                     alias.Window,
+		Channels:                   channels.Channels,
+		EscalationSubscriptionName: alias.EscalationSubscriptionName,
+	}
+	return nil
+}
+
+// NotificationAcknowledgement records that a notification was acknowledged, which stops its escalation
+type NotificationAcknowledgement struct {
+	NotificationId string
+	AcknowledgedBy string
+	Acknowledged   int64
+}
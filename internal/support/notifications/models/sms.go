@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// SmsChannelType is the channel type of the notification templates rendering the SMS messages
+const SmsChannelType = "SMS"
+
+// SmsChannel sends the notifications distributed to a subscription as SMS messages to the PhoneNumbers, in E.164
+// format, via the SMS gateway of the service configuration
+type SmsChannel struct {
+	models.DBTimestamp
+	Id               string
+	Name             string
+	Description      string
+	SubscriptionName string
+	PhoneNumbers     []string
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// NotificationTemplate renders the content of the notifications sent to a subscription, either via all its channels
+// or via the channels of ChannelType only, so that the same notification can be sent as a terse text to a channel
+// and as a rich HTML email to another. Template is a Go template executed with a TemplateData.
+type NotificationTemplate struct {
+	models.DBTimestamp
+	Id               string
+	Name             string
+	Description      string
+	SubscriptionName string
+	ChannelType      string
+	ContentType      string
+	Template         string
+}
+
+// TemplateData holds the variables of the notification templates
+type TemplateData struct {
+	Id           string
+	Created      int64
+	Sender       string
+	Category     string
+	Labels       []string
+	Severity     string
+	Description  string
+	Content      string
+	ContentType  string
+	Subscription string
+}
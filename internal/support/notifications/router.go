@@ -16,6 +16,9 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/gorilla/mux"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	notificationsController "github.com/edgexfoundry/edgex-go/internal/support/notifications/controller/http"
 )
@@ -23,7 +26,9 @@ import (
 func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	lc := container.LoggingClientFrom(dic.Get)
 	secretProvider := container.SecretProviderExtFrom(dic.Get)
-	authenticationHook := handlers.AutoConfigAuthenticationFunc(secretProvider, lc)
+	// the services without role based access control apply the default permission of the method to the API keys
+	authenticationHook := apikey.AuthenticationHandlerFunc(apikey.ValidatorFrom(dic.Get), nil,
+		handlers.AutoConfigAuthenticationFunc(secretProvider, lc), lc)
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
@@ -46,6 +51,7 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiNotificationByIdRoute, authenticationHook(nc.DeleteNotificationById)).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiNotificationByCategoryRoute, authenticationHook(nc.NotificationsByCategory)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiNotificationByLabelRoute, authenticationHook(nc.NotificationsByLabel)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiUnacknowledgedNotificationRoute, authenticationHook(nc.UnacknowledgedNotifications)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiNotificationByStatusRoute, authenticationHook(nc.NotificationsByStatus)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiNotificationByTimeRangeRoute, authenticationHook(nc.NotificationsByTimeRange)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiNotificationBySubscriptionNameRoute, authenticationHook(nc.NotificationsBySubscriptionName)).Methods(http.MethodGet)
@@ -53,6 +59,53 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiNotificationCleanupRoute, authenticationHook(nc.CleanupNotifications)).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiNotificationByAgeRoute, authenticationHook(nc.DeleteProcessedNotificationsByAge)).Methods(http.MethodDelete)
 
+	// Escalation
+	ec := notificationsController.NewEscalationController(dic)
+	r.HandleFunc(pkgCommon.ApiEscalationPolicyRoute, authenticationHook(ec.AddEscalationPolicy)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllEscalationPolicyRoute, authenticationHook(ec.AllEscalationPolicies)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiEscalationPolicyByNameRoute, authenticationHook(ec.EscalationPolicyByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiEscalationPolicyByNameRoute, authenticationHook(ec.DeleteEscalationPolicyByName)).Methods(http.MethodDelete)
+	r.HandleFunc(pkgCommon.ApiNotificationAcknowledgementByIdRoute, authenticationHook(ec.AcknowledgeNotification)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiNotificationAcknowledgementByIdRoute, authenticationHook(ec.NotificationAcknowledgement)).Methods(http.MethodGet)
+
+	// NotificationTemplate
+	tc := notificationsController.NewNotificationTemplateController(dic)
+	r.HandleFunc(pkgCommon.ApiNotificationTemplateRoute, authenticationHook(tc.AddNotificationTemplate)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllNotificationTemplateRoute, authenticationHook(tc.AllNotificationTemplates)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiNotificationTemplateByNameRoute, authenticationHook(tc.NotificationTemplateByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiNotificationTemplateByNameRoute, authenticationHook(tc.DeleteNotificationTemplateByName)).Methods(http.MethodDelete)
+
+	// Digest
+	dc := notificationsController.NewDigestController(dic)
+	r.HandleFunc(pkgCommon.ApiDigestRoute, authenticationHook(dc.AddDigest)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllDigestRoute, authenticationHook(dc.AllDigests)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDigestByNameRoute, authenticationHook(dc.DigestByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDigestByNameRoute, authenticationHook(dc.DeleteDigestByName)).Methods(http.MethodDelete)
+
+	// Connector
+	cc := notificationsController.NewConnectorController(dic)
+	r.HandleFunc(pkgCommon.ApiConnectorRoute, authenticationHook(cc.AddConnector)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllConnectorRoute, authenticationHook(cc.AllConnectors)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiConnectorByNameRoute, authenticationHook(cc.ConnectorByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiConnectorByNameRoute, authenticationHook(cc.DeleteConnectorByName)).Methods(http.MethodDelete)
+
+	// SMS Channel
+	smc := notificationsController.NewSmsChannelController(dic)
+	r.HandleFunc(pkgCommon.ApiSmsChannelRoute, authenticationHook(smc.AddSmsChannel)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllSmsChannelRoute, authenticationHook(smc.AllSmsChannels)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiSmsChannelByNameRoute, authenticationHook(smc.SmsChannelByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiSmsChannelByNameRoute, authenticationHook(smc.DeleteSmsChannelByName)).Methods(http.MethodDelete)
+	// The SMS gateway can't authenticate with an EdgeX token, the delivery status callbacks are authenticated by the
+	// signature of the gateway instead
+	r.HandleFunc(pkgCommon.ApiSmsChannelStatusRoute, smc.SmsDeliveryStatus).Methods(http.MethodPost)
+
+	// Delivery Policy
+	dpc := notificationsController.NewDeliveryPolicyController(dic)
+	r.HandleFunc(pkgCommon.ApiDeliveryPolicyRoute, authenticationHook(dpc.AddDeliveryPolicy)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllDeliveryPolicyRoute, authenticationHook(dpc.AllDeliveryPolicies)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeliveryPolicyByNameRoute, authenticationHook(dpc.DeliveryPolicyByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeliveryPolicyByNameRoute, authenticationHook(dpc.DeleteDeliveryPolicyByName)).Methods(http.MethodDelete)
+
 	// Transmission
 	trans := notificationsController.NewTransmissionController(dic)
 	r.HandleFunc(common.ApiTransmissionByIdRoute, authenticationHook(trans.TransmissionById)).Methods(http.MethodGet)
@@ -62,7 +115,22 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiTransmissionByAgeRoute, authenticationHook(trans.DeleteProcessedTransmissionsByAge)).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiTransmissionBySubscriptionNameRoute, authenticationHook(trans.TransmissionsBySubscriptionName)).Methods(http.MethodGet)
 	r.HandleFunc(common.ApiTransmissionByNotificationIdRoute, authenticationHook(trans.TransmissionsByNotificationId)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiTransmissionResendByIdRoute, authenticationHook(trans.ResendTransmission)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiTransmissionResendByIdRoute, authenticationHook(trans.TransmissionResends)).Methods(http.MethodGet)
+
+	// Audit
+	auditor := audit.AuditorFrom(dic.Get)
+	if auditor != nil {
+		maxResultCount := container.ConfigurationFrom(dic.Get).GetBootstrap().Service.MaxResultCount
+		r.HandleFunc(pkgCommon.ApiAuditRoute, authenticationHook(audit.QueryHandler(auditor, lc, maxResultCount))).Methods(http.MethodGet)
+	}
+
+	// API keys
+	if validator := apikey.ValidatorFrom(dic.Get); validator != nil {
+		r.HandleFunc(pkgCommon.ApiApiKeyRoute, authenticationHook(apikey.KeysHandler(validator, lc))).Methods(http.MethodGet)
+	}
 
 	r.Use(correlation.ManageHeader)
+	r.Use(audit.Middleware(auditor))
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 }
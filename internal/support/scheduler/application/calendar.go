@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerDTOs "github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// AddCalendar adds the calendar, so that the actions attached to it don't run on the days it blacks out
+func AddCalendar(calendar schedulerModels.Calendar, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	addedCalendar, edgeXerr := dbClient.AddCalendar(calendar)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = schedulerManager.AddCalendar(addedCalendar)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("Calendar created on DB successfully. Calendar ID: %s, Correlation-ID: %s ",
+		addedCalendar.Id,
+		correlation.FromContext(ctx))
+
+	return addedCalendar.Id, nil
+}
+
+// CalendarByName queries the calendar by name
+func CalendarByName(name string, dic *di.Container) (calendar schedulerDTOs.Calendar, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return calendar, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	c, edgeXerr := container.DBClientFrom(dic.Get).CalendarByName(name)
+	if edgeXerr != nil {
+		return calendar, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return schedulerDTOs.FromCalendarModelToDTO(c), nil
+}
+
+// AllCalendars queries the calendars with offset and limit
+func AllCalendars(offset, limit int, dic *di.Container) (calendars []schedulerDTOs.Calendar, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	calendarModels, edgeXerr := dbClient.AllCalendars(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.CalendarTotalCount()
+	}
+	if edgeXerr != nil {
+		return calendars, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	calendars = make([]schedulerDTOs.Calendar, len(calendarModels))
+	for i, c := range calendarModels {
+		calendars[i] = schedulerDTOs.FromCalendarModelToDTO(c)
+	}
+	return calendars, totalCount, nil
+}
+
+// PatchCalendar executes the PATCH operation with the calendar DTO to replace the old data, the blackout days of the
+// actions attached to the calendar change accordingly
+func PatchCalendar(dto schedulerDTOs.UpdateCalendar, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	calendar, edgeXerr := dbClient.CalendarByName(*dto.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	schedulerDTOs.ReplaceCalendarModelFieldsWithDTO(&calendar, dto)
+	if len(calendar.Dates) == 0 && len(calendar.Rules) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "dates or rules must be specified", nil)
+	}
+
+	calendar, edgeXerr = dbClient.UpdateCalendar(calendar)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = schedulerManager.UpdateCalendar(calendar)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("Calendar patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	return nil
+}
+
+// DeleteCalendarByName deletes the calendar by name, unless actions are attached to it
+func DeleteCalendarByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	edgeXerr := dbClient.DeleteCalendarByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = schedulerManager.DeleteCalendarByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("Calendar %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// LoadCalendarToSchedulerManager loads the calendars to SchedulerManager before running the interval job
+func LoadCalendarToSchedulerManager(dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+
+	calendars, edgeXerr := dbClient.AllCalendars(0, -1)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	for _, calendar := range calendars {
+		edgeXerr = schedulerManager.AddCalendar(calendar)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerDTOs "github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// AddDeviceCommandAction adds the device command action and schedules it with its interval, after checking
+// that its dependencies are actions of the same interval
+func AddDeviceCommandAction(action schedulerModels.DeviceCommandAction, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	edgeXerr = validateDependencies(action.Name, action.IntervalName, action.DependsOn, dic)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	addedAction, edgeXerr := dbClient.AddDeviceCommandAction(action)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = schedulerManager.AddDeviceCommandAction(addedAction)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("DeviceCommandAction created on DB successfully. DeviceCommandAction ID: %s, Correlation-ID: %s ",
+		addedAction.Id,
+		correlation.FromContext(ctx))
+
+	return addedAction.Id, nil
+}
+
+// DeviceCommandActionByName queries the device command action by name
+func DeviceCommandActionByName(name string, dic *di.Container) (action schedulerDTOs.DeviceCommandAction, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return action, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	a, edgeXerr := container.DBClientFrom(dic.Get).DeviceCommandActionByName(name)
+	if edgeXerr != nil {
+		return action, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return schedulerDTOs.FromDeviceCommandActionModelToDTO(a), nil
+}
+
+// AllDeviceCommandActions queries the device command actions with offset and limit
+func AllDeviceCommandActions(offset, limit int, dic *di.Container) (actions []schedulerDTOs.DeviceCommandAction, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	actionModels, edgeXerr := dbClient.AllDeviceCommandActions(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.DeviceCommandActionTotalCount()
+	}
+	if edgeXerr != nil {
+		return actions, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	actions = make([]schedulerDTOs.DeviceCommandAction, len(actionModels))
+	for i, a := range actionModels {
+		actions[i] = schedulerDTOs.FromDeviceCommandActionModelToDTO(a)
+	}
+	return actions, totalCount, nil
+}
+
+// DeleteDeviceCommandActionByName deletes the device command action by name and unschedules it
+func DeleteDeviceCommandActionByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	edgeXerr := dbClient.DeleteDeviceCommandActionByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = schedulerManager.DeleteDeviceCommandActionByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("DeviceCommandAction %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// LoadDeviceCommandActionToSchedulerManager loads the device command actions to SchedulerManager before running the
+// interval job
+func LoadDeviceCommandActionToSchedulerManager(dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+
+	actions, edgeXerr := dbClient.AllDeviceCommandActions(0, -1)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	for _, action := range actions {
+		edgeXerr = schedulerManager.AddDeviceCommandAction(action)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return nil
+}
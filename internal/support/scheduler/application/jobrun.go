@@ -0,0 +1,173 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerDTOs "github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// JobRuns queries the job runs matching the query with offset and limit, the most recent first, and counts the runs
+// matching the query by status
+func JobRuns(offset, limit int, query schedulerModels.JobRunQuery, dic *di.Container) (runs []schedulerDTOs.JobRun, totalCount uint32, statusCounts map[string]uint32, edgeXerr errors.EdgeX) {
+	switch query.Status {
+	case "", schedulerModels.JobRunSucceeded, schedulerModels.JobRunFailed, schedulerModels.JobRunSkipped:
+	default:
+		return runs, totalCount, statusCounts, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid status '%s'", query.Status), nil)
+	}
+	if query.End != 0 && query.End < query.Start {
+		return runs, totalCount, statusCounts, errors.NewCommonEdgeX(errors.KindContractInvalid, "end must be greater than or equal to start", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	runModels, edgeXerr := dbClient.JobRuns(offset, limit, query)
+	if edgeXerr != nil {
+		return runs, totalCount, statusCounts, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	statuses := []string{schedulerModels.JobRunSucceeded, schedulerModels.JobRunFailed, schedulerModels.JobRunSkipped}
+	if query.Status != "" {
+		statuses = []string{query.Status}
+	}
+	statusCounts = make(map[string]uint32, len(statuses))
+	for _, status := range statuses {
+		statusQuery := query
+		statusQuery.Status = status
+		count, edgeXerr := dbClient.JobRunCount(statusQuery)
+		if edgeXerr != nil {
+			return runs, totalCount, statusCounts, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		statusCounts[status] = count
+		totalCount += count
+	}
+	return fromJobRunModelsToDTOs(runModels), totalCount, statusCounts, nil
+}
+
+// JobRunsByIntervalName queries the runs of the jobs of the interval with offset and limit, the most recent first,
+// which combines the runs of the jobs depending on each other
+func JobRunsByIntervalName(offset, limit int, name string, dic *di.Container) (runs []schedulerDTOs.JobRun, totalCount uint32, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return runs, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	runModels, edgeXerr := dbClient.JobRunsByIntervalName(offset, limit, name)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.JobRunCountByIntervalName(name)
+	}
+	if edgeXerr != nil {
+		return runs, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return fromJobRunModelsToDTOs(runModels), totalCount, nil
+}
+
+// TriggerJob runs the jobs with the name immediately, out of band of their interval, and returns their runs marked as
+// manual
+func TriggerJob(name string, dic *di.Container) ([]schedulerDTOs.JobRun, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	runModels, edgeXerr := schedulerManager.TriggerJob(name)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return fromJobRunModelsToDTOs(runModels), nil
+}
+
+// StartJobRunCleanup starts deleting the job runs older than the configured max age periodically, until the context is
+// canceled
+func StartJobRunCleanup(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get).JobRunRetention
+
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil || interval <= 0 {
+		lc.Errorf("Job runs cleanup disabled, invalid interval '%s'", config.Interval)
+		return
+	}
+	maxAge, err := time.ParseDuration(config.MaxAge)
+	if err != nil || maxAge < 0 {
+		lc.Errorf("Job runs cleanup disabled, invalid max age '%s'", config.MaxAge)
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := container.DBClientFrom(dic.Get).DeleteJobRunsByAge(maxAge.Milliseconds()); err != nil {
+					lc.Errorf("Failed to delete the job runs older than %s: %s", config.MaxAge, err.Error())
+				}
+			}
+		}
+	}()
+}
+
+func fromJobRunModelsToDTOs(runModels []schedulerModels.JobRun) []schedulerDTOs.JobRun {
+	runs := make([]schedulerDTOs.JobRun, len(runModels))
+	for i, r := range runModels {
+		runs[i] = schedulerDTOs.FromJobRunModelToDTO(r)
+	}
+	return runs
+}
+
+// validateDependencies checks that the jobs the job depends on are actions of any type scheduled with the same
+// interval, so that they run in the same trigger
+func validateDependencies(name string, intervalName string, dependsOn []string, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	for _, dependency := range dependsOn {
+		if dependency == name {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("job %s can't depend on itself", name), nil)
+		}
+
+		var intervalNames []string
+		if action, edgeXerr := dbClient.IntervalActionByName(dependency); edgeXerr == nil {
+			intervalNames = append(intervalNames, action.IntervalName)
+		} else if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if action, edgeXerr := dbClient.DeviceCommandActionByName(dependency); edgeXerr == nil {
+			intervalNames = append(intervalNames, action.IntervalName)
+		} else if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if action, edgeXerr := dbClient.MessageBusActionByName(dependency); edgeXerr == nil {
+			intervalNames = append(intervalNames, action.IntervalName)
+		} else if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+
+		if !containsString(intervalNames, intervalName) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("dependency %s of job %s isn't an action of interval %s", dependency, name, intervalName), nil)
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
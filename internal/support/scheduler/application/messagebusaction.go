@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerDTOs "github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// AddMessageBusAction adds the message bus action and schedules it with its interval, after checking
+// that its dependencies are actions of the same interval
+func AddMessageBusAction(action schedulerModels.MessageBusAction, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	edgeXerr = validateDependencies(action.Name, action.IntervalName, action.DependsOn, dic)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	addedAction, edgeXerr := dbClient.AddMessageBusAction(action)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = schedulerManager.AddMessageBusAction(addedAction)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("MessageBusAction created on DB successfully. MessageBusAction ID: %s, Correlation-ID: %s ",
+		addedAction.Id,
+		correlation.FromContext(ctx))
+
+	return addedAction.Id, nil
+}
+
+// MessageBusActionByName queries the message bus action by name
+func MessageBusActionByName(name string, dic *di.Container) (action schedulerDTOs.MessageBusAction, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return action, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	a, edgeXerr := container.DBClientFrom(dic.Get).MessageBusActionByName(name)
+	if edgeXerr != nil {
+		return action, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return schedulerDTOs.FromMessageBusActionModelToDTO(a), nil
+}
+
+// AllMessageBusActions queries the message bus actions with offset and limit
+func AllMessageBusActions(offset, limit int, dic *di.Container) (actions []schedulerDTOs.MessageBusAction, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	actionModels, edgeXerr := dbClient.AllMessageBusActions(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.MessageBusActionTotalCount()
+	}
+	if edgeXerr != nil {
+		return actions, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	actions = make([]schedulerDTOs.MessageBusAction, len(actionModels))
+	for i, a := range actionModels {
+		actions[i] = schedulerDTOs.FromMessageBusActionModelToDTO(a)
+	}
+	return actions, totalCount, nil
+}
+
+// DeleteMessageBusActionByName deletes the message bus action by name and unschedules it
+func DeleteMessageBusActionByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	edgeXerr := dbClient.DeleteMessageBusActionByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = schedulerManager.DeleteMessageBusActionByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("MessageBusAction %s deleted on DB successfully. Correlation-ID: %s ", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// LoadMessageBusActionToSchedulerManager loads the message bus actions to SchedulerManager before running the
+// interval job
+func LoadMessageBusActionToSchedulerManager(dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+
+	actions, edgeXerr := dbClient.AllMessageBusActions(0, -1)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	for _, action := range actions {
+		edgeXerr = schedulerManager.AddMessageBusAction(action)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// calendar is a calendar with the location of its time zone
+type calendar struct {
+	schedulerModels.Calendar
+	location *time.Location
+}
+
+// AddCalendar adds the calendar, so that it blacks out the jobs attached to it
+func (m *manager) AddCalendar(c schedulerModels.Calendar) errors.EdgeX {
+	location, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid time zone '%s' of calendar %s", c.Timezone, c.Name), err)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.calendars[c.Name]; exists {
+		return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("calendar %s already exists", c.Name), nil)
+	}
+	m.calendars[c.Name] = calendar{Calendar: c, location: location}
+	m.lc.Debugf("the calendar %s is added", c.Name)
+	return nil
+}
+
+// UpdateCalendar replaces the calendar with the same name
+func (m *manager) UpdateCalendar(c schedulerModels.Calendar) errors.EdgeX {
+	location, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid time zone '%s' of calendar %s", c.Timezone, c.Name), err)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.calendars[c.Name]; !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("calendar %s not found", c.Name), nil)
+	}
+	m.calendars[c.Name] = calendar{Calendar: c, location: location}
+	m.lc.Debugf("the calendar %s is updated", c.Name)
+	return nil
+}
+
+// DeleteCalendarByName deletes the calendar by name
+func (m *manager) DeleteCalendarByName(name string) errors.EdgeX {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.calendars[name]; !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("calendar %s not found", name), nil)
+	}
+	delete(m.calendars, name)
+	m.lc.Debugf("the calendar %s is deleted", name)
+	return nil
+}
+
+// blackoutCalendar returns the name of the first calendar of the job blacking out the day of the time, or an empty
+// name when none does
+func (m *manager) blackoutCalendar(j job, t time.Time) string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, name := range j.calendars {
+		c, exists := m.calendars[name]
+		if !exists {
+			m.lc.Warnf("calendar %s of the %s %s not found", name, j.jobType, j.name)
+			continue
+		}
+		if c.blacksOut(t) {
+			return name
+		}
+	}
+	return ""
+}
+
+// blacksOut tells whether the day of the time, in the time zone of the calendar, is one of its dates or matches one
+// of its rules
+func (c calendar) blacksOut(t time.Time) bool {
+	t = t.In(c.location)
+	date := t.Format(schedulerModels.CalendarDateLayout)
+	if contains(c.Dates, date) {
+		return true
+	}
+	for _, r := range c.Rules {
+		if ruleMatches(r, t, date) {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleMatches tells whether the day matches all the non-empty fields of the rule, the dates having the same layout
+// compare in order
+func ruleMatches(r schedulerModels.CalendarRule, t time.Time, date string) bool {
+	switch {
+	case r.Start != "" && date < r.Start:
+		return false
+	case r.End != "" && date > r.End:
+		return false
+	case len(r.Weekdays) > 0 && !contains(r.Weekdays, strings.ToUpper(t.Weekday().String()[:3])):
+		return false
+	case len(r.MonthDays) > 0 && !contains(r.MonthDays, t.Day()):
+		return false
+	case len(r.Months) > 0 && !contains(r.Months, int(t.Month())):
+		return false
+	}
+	return true
+}
+
+func contains[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
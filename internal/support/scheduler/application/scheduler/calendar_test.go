@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarBlacksOut(t *testing.T) {
+	// 2023-12-25 is a Monday
+	christmas := time.Date(2023, 12, 25, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		calendar schedulerModels.Calendar
+		expected bool
+	}{
+		{"date", schedulerModels.Calendar{Dates: []string{"2023-12-25"}}, true},
+		{"other date", schedulerModels.Calendar{Dates: []string{"2023-12-26"}}, false},
+		{"date in the time zone", schedulerModels.Calendar{Timezone: "Etc/GMT+12", Dates: []string{"2023-12-24"}}, true},
+		{"period", schedulerModels.Calendar{Rules: []schedulerModels.CalendarRule{{Start: "2023-12-20", End: "2024-01-05"}}}, true},
+		{"period ended", schedulerModels.Calendar{Rules: []schedulerModels.CalendarRule{{Start: "2023-12-01", End: "2023-12-24"}}}, false},
+		{"yearly day", schedulerModels.Calendar{Rules: []schedulerModels.CalendarRule{{Months: []int{12}, MonthDays: []int{25}}}}, true},
+		{"weekdays", schedulerModels.Calendar{Rules: []schedulerModels.CalendarRule{{Weekdays: []string{"SAT", "SUN"}}}}, false},
+		{"weekday in a period", schedulerModels.Calendar{Rules: []schedulerModels.CalendarRule{{Start: "2023-12-01", Weekdays: []string{"MON"}}}}, true},
+		{"one of the rules", schedulerModels.Calendar{Rules: []schedulerModels.CalendarRule{{Months: []int{8}}, {MonthDays: []int{25}}}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			location, err := time.LoadLocation(testCase.calendar.Timezone)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, calendar{Calendar: testCase.calendar, location: location}.blacksOut(christmas))
+		})
+	}
+}
+
+func TestRunJobsBlackout(t *testing.T) {
+	today := time.Now().Format(schedulerModels.CalendarDateLayout)
+
+	statuses := make(map[string]string)
+	dbClient := &dbMock.DBClient{}
+	dbClient.On("AddJobRun", mock.Anything, mock.Anything).Return(func(run schedulerModels.JobRun, historyLimit int) schedulerModels.JobRun {
+		statuses[run.JobName] = run.Status
+		return run
+	}, nil)
+	m := NewManager(logger.NewMockClient(), &config.ConfigurationStruct{ScheduleIntervalTime: 500}, nil, nil, nil, dbClient).(*manager)
+	require.NoError(t, m.AddCalendar(schedulerModels.Calendar{Name: "freeze", Dates: []string{today}}))
+	require.NoError(t, m.AddCalendar(schedulerModels.Calendar{Name: "holidays", Dates: []string{"2000-01-01"}}))
+
+	succeed := func() errors.EdgeX { return nil }
+	m.runJobs(testIntervalName, []job{
+		{name: "purge", calendars: []string{"freeze"}, run: succeed},
+		{name: "report", dependsOn: []string{"purge"}, run: succeed},
+		{name: "notify", calendars: []string{"holidays"}, run: succeed},
+	})
+	assert.Equal(t, map[string]string{
+		"purge":  schedulerModels.JobRunSkipped,
+		"report": schedulerModels.JobRunSkipped,
+		"notify": schedulerModels.JobRunSucceeded,
+	}, statuses)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 const (
@@ -19,13 +21,15 @@ const (
 )
 
 type Executor struct {
-	Interval           models.Interval
-	IntervalActionsMap map[string]models.IntervalAction
-	StartTime          time.Time
-	EndTime            time.Time
-	NextTime           time.Time
-	Frequency          time.Duration
-	MarkedDeleted      bool
+	Interval                models.Interval
+	IntervalActionsMap      map[string]models.IntervalAction
+	DeviceCommandActionsMap map[string]schedulerModels.DeviceCommandAction
+	MessageBusActionsMap    map[string]schedulerModels.MessageBusAction
+	StartTime               time.Time
+	EndTime                 time.Time
+	NextTime                time.Time
+	Frequency               time.Duration
+	MarkedDeleted           bool
 }
 
 // Initialize initialize the Executor with interval. This function should be invoked after adding or updating the interval.
@@ -83,3 +87,25 @@ func (executor *Executor) UpdateNextTime() {
 		executor.NextTime = executor.NextTime.Add(executor.Frequency)
 	}
 }
+
+// TriggerCount counts the times the Executor triggers after the from time, until the to time
+func (executor *Executor) TriggerCount(from time.Time, to time.Time) int {
+	if executor.Frequency <= 0 {
+		return 0
+	}
+	if to.After(executor.EndTime) {
+		to = executor.EndTime
+	}
+	if to.Before(executor.StartTime) || !to.After(from) {
+		return 0
+	}
+	first := 0
+	if !from.Before(executor.StartTime) {
+		first = int(from.Sub(executor.StartTime)/executor.Frequency) + 1
+	}
+	last := int(to.Sub(executor.StartTime) / executor.Frequency)
+	if last < first {
+		return 0
+	}
+	return last - first + 1
+}
@@ -67,3 +67,28 @@ func TestInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestTriggerCount(t *testing.T) {
+	start := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	executor := Executor{StartTime: start, EndTime: start.Add(24 * time.Hour), Frequency: time.Hour}
+
+	tests := []struct {
+		name          string
+		from          time.Time
+		to            time.Time
+		expectedCount int
+	}{
+		{"triggers between", start.Add(90 * time.Minute), start.Add(5 * time.Hour), 4},
+		{"trigger at the to time", start.Add(time.Hour), start.Add(3 * time.Hour), 2},
+		{"from before the start time", start.Add(-time.Hour), start.Add(150 * time.Minute), 3},
+		{"to after the end time", start.Add(22*time.Hour + time.Minute), start.Add(48 * time.Hour), 2},
+		{"no trigger between", start.Add(61 * time.Minute), start.Add(119 * time.Minute), 0},
+		{"to before the start time", start.Add(-2 * time.Hour), start.Add(-time.Hour), 0},
+		{"to before from", start.Add(5 * time.Hour), start.Add(time.Hour), 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedCount, executor.TriggerCount(testCase.from, testCase.to))
+		})
+	}
+}
@@ -0,0 +1,393 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// job is an action of any type run each time its interval triggers
+type job struct {
+	name      string
+	jobType   string
+	dependsOn []string
+	calendars []string
+	locked    bool
+	run       func() errors.EdgeX
+	// catchUpPolicy and catchUpMaxRuns are the catch-up policy set by the action, empty for the default policy
+	catchUpPolicy  string
+	catchUpMaxRuns int
+	// catchUp is the catch-up policy applied to the missedRuns of the job when it runs on startup
+	catchUp    string
+	missedRuns int
+	// idle jobs don't run and don't succeed, like the jobs without missed run to catch up on startup
+	idle bool
+	// manual jobs are triggered through the API out of band of their interval
+	manual bool
+	// jitter is the maximum random delay of each run, and at most maxConcurrency runs are in progress at the same time,
+	// the other runs being skipped or queued according to the concurrencyPolicy
+	jitter            time.Duration
+	maxConcurrency    int
+	concurrencyPolicy string
+}
+
+// executorJobs returns the jobs of the actions of the executor
+func (m *manager) executorJobs(executor *Executor) []job {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	jobs := make([]job, 0, len(executor.IntervalActionsMap)+len(executor.DeviceCommandActionsMap)+len(executor.MessageBusActionsMap))
+	for _, action := range executor.IntervalActionsMap {
+		action := action
+		jobs = append(jobs, job{
+			name:    action.Name,
+			jobType: schedulerModels.IntervalActionJob,
+			locked:  action.AdminState == models.Locked,
+			run:     func() errors.EdgeX { return m.executeAction(action) },
+		}.withControl(m.lc, m.config.JobControl, "", 0, ""))
+	}
+	for _, action := range executor.DeviceCommandActionsMap {
+		action := action
+		jobs = append(jobs, job{
+			name:      action.Name,
+			jobType:   schedulerModels.DeviceCommandActionJob,
+			dependsOn: action.DependsOn,
+			calendars: action.Calendars,
+			locked:    action.AdminState == models.Locked,
+			run:       func() errors.EdgeX { return m.executeDeviceCommandAction(action) },
+
+			catchUpPolicy:  action.CatchUpPolicy,
+			catchUpMaxRuns: action.CatchUpMaxRuns,
+		}.withControl(m.lc, m.config.JobControl, action.Jitter, action.MaxConcurrency, action.ConcurrencyPolicy))
+	}
+	for _, action := range executor.MessageBusActionsMap {
+		action := action
+		jobs = append(jobs, job{
+			name:      action.Name,
+			jobType:   schedulerModels.MessageBusActionJob,
+			dependsOn: action.DependsOn,
+			calendars: action.Calendars,
+			locked:    action.AdminState == models.Locked,
+			run:       func() errors.EdgeX { return m.executeMessageBusAction(action) },
+
+			catchUpPolicy:  action.CatchUpPolicy,
+			catchUpMaxRuns: action.CatchUpMaxRuns,
+		}.withControl(m.lc, m.config.JobControl, action.Jitter, action.MaxConcurrency, action.ConcurrencyPolicy))
+	}
+	return jobs
+}
+
+// runJobs runs the jobs of the interval one by one, each job after the jobs it depends on. The jobs whose dependencies
+// didn't succeed are skipped, which propagates to the jobs depending on them, and the jobs depending on each other
+// fail. The jobs sharing a name succeed only when all of them succeed.
+func (m *manager) runJobs(intervalName string, jobs []job) {
+	// pending counts the jobs not completed yet by name, and succeeded tells whether all the completed jobs succeeded
+	pending := make(map[string]int, len(jobs))
+	succeeded := make(map[string]bool, len(jobs))
+	for _, j := range jobs {
+		pending[j.name]++
+		succeeded[j.name] = true
+	}
+	complete := func(j job, success bool) {
+		pending[j.name]--
+		succeeded[j.name] = succeeded[j.name] && success
+	}
+
+	for len(jobs) > 0 {
+		var waiting []job
+		for _, j := range jobs {
+			ready, skipReason := dependenciesState(j, intervalName, pending, succeeded)
+			var blackoutCalendar string
+			if ready && !j.idle && !j.locked && skipReason == "" {
+				blackoutCalendar = m.blackoutCalendar(j, time.Now())
+			}
+			switch {
+			case !ready:
+				waiting = append(waiting, j)
+			case j.idle:
+				complete(j, false)
+			case j.locked:
+				m.lc.Debugf("%s %s is locked, skip the job execution", j.jobType, j.name)
+				complete(j, false)
+			case skipReason != "":
+				m.lc.Debugf("skip the %s %s, %s", j.jobType, j.name, skipReason)
+				m.recordJobRun(j, intervalName, time.Now(), schedulerModels.JobRunSkipped, skipReason)
+				complete(j, false)
+			case blackoutCalendar != "":
+				m.lc.Debugf("skip the %s %s, blacked out by calendar %s", j.jobType, j.name, blackoutCalendar)
+				m.recordJobRun(j, intervalName, time.Now(), schedulerModels.JobRunSkipped, fmt.Sprintf("blacked out by calendar %s", blackoutCalendar))
+				complete(j, false)
+			default:
+				complete(j, m.runJob(j, intervalName).Status == schedulerModels.JobRunSucceeded)
+			}
+		}
+		if len(waiting) == len(jobs) {
+			for _, j := range waiting {
+				m.lc.Errorf("fail to execute the %s %s, its dependencies depend on it", j.jobType, j.name)
+				m.recordJobRun(j, intervalName, time.Now(), schedulerModels.JobRunFailed, "circular dependency")
+			}
+			return
+		}
+		jobs = waiting
+	}
+}
+
+// runJob runs the job once its runs in progress allow it and after its jitter, and records the run
+func (m *manager) runJob(j job, intervalName string) schedulerModels.JobRun {
+	if !m.acquireJobRun(j) {
+		m.lc.Debugf("skip the %s %s, its previous runs are still in progress", j.jobType, j.name)
+		return m.recordJobRun(j, intervalName, time.Now(), schedulerModels.JobRunSkipped, "previous runs still in progress")
+	}
+	defer m.releaseJobRun(j)
+
+	if j.jitter > 0 {
+		time.Sleep(time.Duration(rand.Int63n(int64(j.jitter))))
+	}
+	started := time.Now()
+	edgeXerr := j.run()
+	if edgeXerr != nil {
+		m.lc.Errorf("fail to execute the %s %s, err: %v", j.jobType, j.name, edgeXerr)
+		return m.recordJobRun(j, intervalName, started, schedulerModels.JobRunFailed, edgeXerr.Error())
+	}
+	return m.recordJobRun(j, intervalName, started, schedulerModels.JobRunSucceeded, "")
+}
+
+// acquireJobRun counts a new run of the job in progress, once the job has less than its maximum count of runs in
+// progress, or tells that the run is skipped according to the concurrency policy of the job
+func (m *manager) acquireJobRun(j job) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for j.maxConcurrency > 0 && m.runningJobs[j.name] >= j.maxConcurrency {
+		if j.concurrencyPolicy != schedulerModels.ConcurrencyQueue {
+			return false
+		}
+		m.jobRunCompleted.Wait()
+	}
+	m.runningJobs[j.name]++
+	return true
+}
+
+// releaseJobRun counts the completion of a run of the job, which resumes the runs of the job queued
+func (m *manager) releaseJobRun(j job) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.runningJobs[j.name]--
+	if m.runningJobs[j.name] <= 0 {
+		delete(m.runningJobs, j.name)
+	}
+	m.jobRunCompleted.Broadcast()
+}
+
+// withControl returns the job with the jitter and concurrency set by its action, or else the default ones of the
+// service
+func (j job) withControl(lc logger.LoggingClient, defaults config.JobControlInfo, jitter string, maxConcurrency int, concurrencyPolicy string) job {
+	if jitter == "" {
+		jitter = defaults.Jitter
+	}
+	if maxConcurrency == 0 {
+		maxConcurrency = defaults.MaxConcurrency
+	}
+	if concurrencyPolicy == "" {
+		concurrencyPolicy = defaults.ConcurrencyPolicy
+	}
+
+	if jitter != "" {
+		var err error
+		j.jitter, err = time.ParseDuration(jitter)
+		if err != nil || j.jitter < 0 {
+			lc.Warnf("invalid jitter '%s' of the %s %s, run the job without delay", jitter, j.jobType, j.name)
+			j.jitter = 0
+		}
+	}
+	j.maxConcurrency = maxConcurrency
+	j.concurrencyPolicy = concurrencyPolicy
+	return j
+}
+
+// dependenciesState tells whether the dependencies of the job completed, and the reason to skip the job when a
+// dependency didn't succeed
+func dependenciesState(j job, intervalName string, pending map[string]int, succeeded map[string]bool) (ready bool, skipReason string) {
+	for _, dependency := range j.dependsOn {
+		count, exists := pending[dependency]
+		switch {
+		case !exists:
+			if skipReason == "" {
+				skipReason = fmt.Sprintf("dependency %s isn't scheduled with interval %s", dependency, intervalName)
+			}
+		case count > 0:
+			return false, ""
+		case !succeeded[dependency]:
+			if skipReason == "" {
+				skipReason = fmt.Sprintf("dependency %s didn't succeed", dependency)
+			}
+		}
+	}
+	return true, skipReason
+}
+
+// recordJobRun adds the run of the job to the run history and returns it
+func (m *manager) recordJobRun(j job, intervalName string, started time.Time, status string, message string) schedulerModels.JobRun {
+	run := schedulerModels.JobRun{
+		JobName:      j.name,
+		JobType:      j.jobType,
+		IntervalName: intervalName,
+		Started:      started.UnixMilli(),
+		Status:       status,
+		Message:      message,
+		CatchUp:      j.catchUp,
+		MissedRuns:   j.missedRuns,
+		Manual:       j.manual,
+	}
+	if m.dbClient == nil {
+		return run
+	}
+	addedRun, edgeXerr := m.dbClient.AddJobRun(run, m.config.JobRunRetention.MaxRunsPerJob)
+	if edgeXerr != nil {
+		m.lc.Errorf("fail to record the run of the %s %s, err: %v", j.jobType, j.name, edgeXerr)
+		return run
+	}
+	return addedRun
+}
+
+// TriggerJob runs the jobs with the name immediately, out of band of their interval, without jitter and regardless of
+// their dependencies, and returns their runs marked as manual
+func (m *manager) TriggerJob(name string) ([]schedulerModels.JobRun, errors.EdgeX) {
+	m.mutex.Lock()
+	executors := make([]*Executor, 0, len(m.intervalToExecutorMap))
+	for _, executor := range m.intervalToExecutorMap {
+		executors = append(executors, executor)
+	}
+	m.mutex.Unlock()
+
+	var jobs []job
+	var intervalNames []string
+	for _, executor := range executors {
+		for _, j := range m.executorJobs(executor) {
+			if j.name != name {
+				continue
+			}
+			if j.locked {
+				return nil, errors.NewCommonEdgeX(errors.KindServiceLocked, fmt.Sprintf("%s %s is locked", j.jobType, j.name), nil)
+			}
+			j.manual = true
+			j.jitter = 0
+			jobs = append(jobs, j)
+			intervalNames = append(intervalNames, executor.Interval.Name)
+		}
+	}
+	if len(jobs) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("job %s isn't scheduled", name), nil)
+	}
+
+	runs := make([]schedulerModels.JobRun, len(jobs))
+	for i, j := range jobs {
+		m.lc.Infof("%s %s triggered manually", j.jobType, j.name)
+		runs[i] = m.runJob(j, intervalNames[i])
+	}
+	return runs, nil
+}
+
+// catchUpMissedRuns runs the jobs whose interval triggered while the service was down, since their latest recorded
+// run, according to their catch-up policy. The jobs caught up more than once run in rounds, so that each round runs
+// the jobs after the jobs they depend on.
+func (m *manager) catchUpMissedRuns() {
+	if m.dbClient == nil {
+		return
+	}
+	now := time.Now()
+
+	m.mutex.Lock()
+	executors := make([]*Executor, 0, len(m.intervalToExecutorMap))
+	for _, executor := range m.intervalToExecutorMap {
+		executors = append(executors, executor)
+	}
+	m.mutex.Unlock()
+
+	for _, executor := range executors {
+		jobs := m.executorJobs(executor)
+		rounds := make([]int, len(jobs))
+		maxRounds := 0
+		for i := range jobs {
+			if jobs[i].locked {
+				continue
+			}
+			missedRuns, edgeXerr := m.missedRuns(executor, jobs[i].name, now)
+			if edgeXerr != nil {
+				m.lc.Errorf("fail to count the missed runs of the %s %s, err: %v", jobs[i].jobType, jobs[i].name, edgeXerr)
+				continue
+			}
+			if missedRuns == 0 {
+				continue
+			}
+			jobs[i].catchUp, rounds[i] = m.catchUpRounds(jobs[i], missedRuns)
+			jobs[i].missedRuns = missedRuns
+			m.lc.Infof("%s %s missed %d runs, catch up with the %s policy", jobs[i].jobType, jobs[i].name, missedRuns, jobs[i].catchUp)
+			if rounds[i] == 0 {
+				m.recordJobRun(jobs[i], executor.Interval.Name, now, schedulerModels.JobRunSkipped,
+					fmt.Sprintf("%d missed runs skipped by the catch-up policy", missedRuns))
+			}
+			if rounds[i] > maxRounds {
+				maxRounds = rounds[i]
+			}
+		}
+
+		for round := 0; round < maxRounds; round++ {
+			roundJobs := make([]job, len(jobs))
+			for i, j := range jobs {
+				j.idle = rounds[i] <= round
+				roundJobs[i] = j
+			}
+			m.runJobs(executor.Interval.Name, roundJobs)
+		}
+	}
+}
+
+// missedRuns counts the times the interval triggered the job since its latest recorded run, the jobs without recorded
+// run haven't missed any run
+func (m *manager) missedRuns(executor *Executor, jobName string, now time.Time) (int, errors.EdgeX) {
+	runs, edgeXerr := m.dbClient.JobRuns(0, 1, schedulerModels.JobRunQuery{JobName: jobName})
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(runs) == 0 {
+		return 0, nil
+	}
+	return executor.TriggerCount(time.UnixMilli(runs[0].Started), now), nil
+}
+
+// catchUpRounds returns the catch-up policy of the job, its own or else the default one of the service, and the count
+// of runs the policy catches up of the missed runs
+func (m *manager) catchUpRounds(j job, missedRuns int) (policy string, rounds int) {
+	policy, maxRuns := j.catchUpPolicy, j.catchUpMaxRuns
+	if policy == "" {
+		policy, maxRuns = m.config.CatchUp.Policy, m.config.CatchUp.MaxRuns
+	}
+	switch policy {
+	case schedulerModels.CatchUpRunOnce:
+		return policy, 1
+	case schedulerModels.CatchUpBackfill:
+		if missedRuns < maxRuns {
+			return policy, missedRuns
+		}
+		return policy, maxRuns
+	case schedulerModels.CatchUpSkip:
+		return policy, 0
+	default:
+		m.lc.Warnf("unknown catch-up policy '%s' of the %s %s, skip the missed runs", policy, j.jobType, j.name)
+		return schedulerModels.CatchUpSkip, 0
+	}
+}
@@ -0,0 +1,257 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunJobs(t *testing.T) {
+	succeed := func() errors.EdgeX { return nil }
+	fail := func() errors.EdgeX { return errors.NewCommonEdgeX(errors.KindCommunicationError, "failed", nil) }
+
+	tests := []struct {
+		name             string
+		jobs             []job
+		expectedStatuses map[string]string
+	}{
+		{"dependency succeeded",
+			[]job{
+				{name: "report", dependsOn: []string{"purge"}, run: succeed},
+				{name: "purge", run: succeed},
+			},
+			map[string]string{"purge": schedulerModels.JobRunSucceeded, "report": schedulerModels.JobRunSucceeded}},
+		{"dependency failed",
+			[]job{
+				{name: "report", dependsOn: []string{"purge"}, run: succeed},
+				{name: "purge", run: fail},
+			},
+			map[string]string{"purge": schedulerModels.JobRunFailed, "report": schedulerModels.JobRunSkipped}},
+		{"failure propagates along the chain",
+			[]job{
+				{name: "notify", dependsOn: []string{"report"}, run: succeed},
+				{name: "report", dependsOn: []string{"purge"}, run: succeed},
+				{name: "purge", run: fail},
+			},
+			map[string]string{"purge": schedulerModels.JobRunFailed, "report": schedulerModels.JobRunSkipped, "notify": schedulerModels.JobRunSkipped}},
+		{"dependency locked",
+			[]job{
+				{name: "report", dependsOn: []string{"purge"}, run: succeed},
+				{name: "purge", locked: true, run: succeed},
+			},
+			map[string]string{"report": schedulerModels.JobRunSkipped}},
+		{"dependency not scheduled with the interval",
+			[]job{
+				{name: "report", dependsOn: []string{"unknown"}, run: succeed},
+			},
+			map[string]string{"report": schedulerModels.JobRunSkipped}},
+		{"circular dependency",
+			[]job{
+				{name: "a", dependsOn: []string{"b"}, run: succeed},
+				{name: "b", dependsOn: []string{"a"}, run: succeed},
+				{name: "c", run: succeed},
+			},
+			map[string]string{"a": schedulerModels.JobRunFailed, "b": schedulerModels.JobRunFailed, "c": schedulerModels.JobRunSucceeded}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			statuses := make(map[string]string)
+			dbClient := &dbMock.DBClient{}
+			dbClient.On("AddJobRun", mock.Anything, 10).Return(func(run schedulerModels.JobRun, historyLimit int) schedulerModels.JobRun {
+				statuses[run.JobName] = run.Status
+				return run
+			}, nil)
+			m := NewManager(logger.NewMockClient(), &config.ConfigurationStruct{ScheduleIntervalTime: 500, JobRunRetention: config.JobRunRetentionInfo{MaxRunsPerJob: 10}}, nil, nil, nil, dbClient).(*manager)
+
+			m.runJobs(testIntervalName, testCase.jobs)
+			assert.Equal(t, testCase.expectedStatuses, statuses)
+		})
+	}
+}
+
+func TestCatchUpMissedRuns(t *testing.T) {
+	lastRun := schedulerModels.JobRun{JobName: "trigger", Started: time.Now().Add(-time.Hour).UnixMilli()}
+
+	tests := []struct {
+		name               string
+		action             schedulerModels.MessageBusAction
+		history            []schedulerModels.JobRun
+		expectedPublishes  int
+		expectedStatus     string
+		expectedCatchUp    string
+		expectedMissedRuns int
+	}{
+		{"skip", schedulerModels.MessageBusAction{CatchUpPolicy: schedulerModels.CatchUpSkip}, []schedulerModels.JobRun{lastRun}, 0, schedulerModels.JobRunSkipped, schedulerModels.CatchUpSkip, 6},
+		{"run once", schedulerModels.MessageBusAction{CatchUpPolicy: schedulerModels.CatchUpRunOnce}, []schedulerModels.JobRun{lastRun}, 1, schedulerModels.JobRunSucceeded, schedulerModels.CatchUpRunOnce, 6},
+		{"backfill up to the max runs", schedulerModels.MessageBusAction{CatchUpPolicy: schedulerModels.CatchUpBackfill, CatchUpMaxRuns: 3}, []schedulerModels.JobRun{lastRun}, 3, schedulerModels.JobRunSucceeded, schedulerModels.CatchUpBackfill, 6},
+		{"backfill all the missed runs", schedulerModels.MessageBusAction{CatchUpPolicy: schedulerModels.CatchUpBackfill, CatchUpMaxRuns: 10}, []schedulerModels.JobRun{lastRun}, 6, schedulerModels.JobRunSucceeded, schedulerModels.CatchUpBackfill, 6},
+		{"default policy", schedulerModels.MessageBusAction{}, []schedulerModels.JobRun{lastRun}, 2, schedulerModels.JobRunSucceeded, schedulerModels.CatchUpBackfill, 6},
+		{"no run recorded", schedulerModels.MessageBusAction{CatchUpPolicy: schedulerModels.CatchUpRunOnce}, nil, 0, "", "", 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var runs []schedulerModels.JobRun
+			dbClient := &dbMock.DBClient{}
+			dbClient.On("JobRuns", 0, 1, schedulerModels.JobRunQuery{JobName: "trigger"}).Return(testCase.history, nil)
+			dbClient.On("AddJobRun", mock.Anything, mock.Anything).Return(func(run schedulerModels.JobRun, historyLimit int) schedulerModels.JobRun {
+				runs = append(runs, run)
+				return run
+			}, nil)
+			messagingClient := &messagingMocks.MessageClient{}
+			messagingClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			config := &config.ConfigurationStruct{ScheduleIntervalTime: 500, CatchUp: config.CatchUpInfo{Policy: schedulerModels.CatchUpBackfill, MaxRuns: 2}}
+			m := NewManager(logger.NewMockClient(), config, nil, nil, messagingClient, dbClient).(*manager)
+
+			action := testCase.action
+			action.Name = "trigger"
+			action.IntervalName = testIntervalName
+			action.Topic = "rules/trigger"
+			m.intervalToExecutorMap[testIntervalName] = &Executor{
+				Interval:             models.Interval{Name: testIntervalName},
+				MessageBusActionsMap: map[string]schedulerModels.MessageBusAction{action.Name: action},
+				StartTime:            time.Unix(0, 0),
+				EndTime:              time.Now().Add(time.Hour),
+				Frequency:            10 * time.Minute,
+			}
+
+			m.catchUpMissedRuns()
+			messagingClient.AssertNumberOfCalls(t, "Publish", testCase.expectedPublishes)
+			if testCase.expectedStatus == "" {
+				assert.Empty(t, runs)
+				return
+			}
+			require.NotEmpty(t, runs)
+			for _, run := range runs {
+				assert.Equal(t, testCase.expectedStatus, run.Status)
+				assert.Equal(t, testCase.expectedCatchUp, run.CatchUp)
+				assert.Equal(t, testCase.expectedMissedRuns, run.MissedRuns)
+			}
+		})
+	}
+}
+
+func TestRunJobConcurrency(t *testing.T) {
+	tests := []struct {
+		name              string
+		maxConcurrency    int
+		concurrencyPolicy string
+		expectedStatus    string
+	}{
+		{"skip while the previous run is in progress", 1, schedulerModels.ConcurrencySkip, schedulerModels.JobRunSkipped},
+		{"queue until the previous run completes", 1, schedulerModels.ConcurrencyQueue, schedulerModels.JobRunSucceeded},
+		{"run below the max concurrency", 2, schedulerModels.ConcurrencySkip, schedulerModels.JobRunSucceeded},
+		{"run without concurrency limit", 0, schedulerModels.ConcurrencySkip, schedulerModels.JobRunSucceeded},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var status string
+			dbClient := &dbMock.DBClient{}
+			dbClient.On("AddJobRun", mock.Anything, mock.Anything).Return(func(run schedulerModels.JobRun, historyLimit int) schedulerModels.JobRun {
+				status = run.Status
+				return run
+			}, nil)
+			m := NewManager(logger.NewMockClient(), &config.ConfigurationStruct{ScheduleIntervalTime: 500}, nil, nil, nil, dbClient).(*manager)
+
+			j := job{name: "report", run: func() errors.EdgeX { return nil }, maxConcurrency: testCase.maxConcurrency, concurrencyPolicy: testCase.concurrencyPolicy}
+			// a previous run of the job is in progress and completes shortly
+			require.True(t, m.acquireJobRun(j))
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				m.releaseJobRun(j)
+			}()
+
+			m.runJobs(testIntervalName, []job{j})
+			assert.Equal(t, testCase.expectedStatus, status)
+		})
+	}
+}
+
+func TestJobWithControl(t *testing.T) {
+	defaults := config.JobControlInfo{Jitter: "5s", MaxConcurrency: 1, ConcurrencyPolicy: schedulerModels.ConcurrencyQueue}
+
+	tests := []struct {
+		name                      string
+		jitter                    string
+		maxConcurrency            int
+		concurrencyPolicy         string
+		expectedJitter            time.Duration
+		expectedMaxConcurrency    int
+		expectedConcurrencyPolicy string
+	}{
+		{"default controls", "", 0, "", 5 * time.Second, 1, schedulerModels.ConcurrencyQueue},
+		{"job controls", "1m", 3, schedulerModels.ConcurrencySkip, time.Minute, 3, schedulerModels.ConcurrencySkip},
+		{"invalid jitter", "soon", 0, "", 0, 1, schedulerModels.ConcurrencyQueue},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			j := job{name: "report"}.withControl(logger.NewMockClient(), defaults, testCase.jitter, testCase.maxConcurrency, testCase.concurrencyPolicy)
+			assert.Equal(t, testCase.expectedJitter, j.jitter)
+			assert.Equal(t, testCase.expectedMaxConcurrency, j.maxConcurrency)
+			assert.Equal(t, testCase.expectedConcurrencyPolicy, j.concurrencyPolicy)
+		})
+	}
+}
+
+func TestTriggerJob(t *testing.T) {
+	tests := []struct {
+		name            string
+		jobName         string
+		locked          bool
+		expectedErrKind errors.ErrKind
+	}{
+		{"trigger", "trigger", false, ""},
+		{"job locked", "trigger", true, errors.KindServiceLocked},
+		{"job not scheduled", "unknown", false, errors.KindEntityDoesNotExist},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClient := &dbMock.DBClient{}
+			dbClient.On("AddJobRun", mock.Anything, mock.Anything).Return(func(run schedulerModels.JobRun, historyLimit int) schedulerModels.JobRun {
+				return run
+			}, nil)
+			messagingClient := &messagingMocks.MessageClient{}
+			messagingClient.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			m := NewManager(logger.NewMockClient(), &config.ConfigurationStruct{ScheduleIntervalTime: 500}, nil, nil, messagingClient, dbClient).(*manager)
+
+			action := schedulerModels.MessageBusAction{Name: "trigger", IntervalName: testIntervalName, Topic: "rules/trigger", DependsOn: []string{"purge"}, AdminState: models.Unlocked}
+			if testCase.locked {
+				action.AdminState = models.Locked
+			}
+			m.intervalToExecutorMap[testIntervalName] = &Executor{
+				Interval:             models.Interval{Name: testIntervalName},
+				MessageBusActionsMap: map[string]schedulerModels.MessageBusAction{action.Name: action},
+			}
+
+			runs, err := m.TriggerJob(testCase.jobName)
+			if testCase.expectedErrKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+				messagingClient.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, runs, 1)
+			assert.Equal(t, schedulerModels.JobRunSucceeded, runs[0].Status)
+			assert.Equal(t, testIntervalName, runs[0].IntervalName)
+			assert.True(t, runs[0].Manual)
+			messagingClient.AssertNumberOfCalls(t, "Publish", 1)
+		})
+	}
+}
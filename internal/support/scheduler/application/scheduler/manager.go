@@ -6,12 +6,15 @@
 package scheduler
 
 import (
+	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
 	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
@@ -23,6 +26,10 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/google/uuid"
 	"gopkg.in/eapache/queue.v1"
 )
 
@@ -35,26 +42,54 @@ type manager struct {
 	executorQueue         *queue.Queue
 	intervalToExecutorMap map[string]*Executor
 	actionToIntervalMap   map[string]string
-	secretProvider        bootstrapInterfaces.SecretProviderExt
+	// commandActionToIntervalMap maps the device command actions to their interval, the device command actions are
+	// named apart from the interval actions
+	commandActionToIntervalMap map[string]string
+	// busActionToIntervalMap maps the message bus actions to their interval
+	busActionToIntervalMap map[string]string
+	secretProvider         bootstrapInterfaces.SecretProviderExt
+	commandClient          clientInterfaces.CommandClient
+	messagingClient        messaging.MessageClient
+	dbClient               interfaces.DBClient
+	// runningJobs counts the runs in progress by job name, and jobRunCompleted signals when one of them completes
+	runningJobs     map[string]int
+	jobRunCompleted *sync.Cond
+	// calendars maps the calendars blacking out the jobs to their name
+	calendars map[string]calendar
 }
 
-// NewManager creates a new scheduler manager for running the interval job
-func NewManager(lc logger.LoggingClient, config *config.ConfigurationStruct, secretProvider bootstrapInterfaces.SecretProviderExt) interfaces.SchedulerManager {
-	return &manager{
-		ticker:                time.NewTicker(time.Duration(config.ScheduleIntervalTime) * time.Millisecond),
-		lc:                    lc,
-		config:                config,
-		executorQueue:         queue.New(),
-		intervalToExecutorMap: make(map[string]*Executor),
-		actionToIntervalMap:   make(map[string]string),
-		secretProvider:        secretProvider,
+// NewManager creates a new scheduler manager for running the interval job. The command client issues the device
+// commands of the device command actions and the messaging client publishes the payloads of the message bus actions,
+// these actions fail to execute when their client is nil. The DB client records the job runs, which aren't recorded
+// when it is nil.
+func NewManager(lc logger.LoggingClient, config *config.ConfigurationStruct, secretProvider bootstrapInterfaces.SecretProviderExt,
+	commandClient clientInterfaces.CommandClient, messagingClient messaging.MessageClient, dbClient interfaces.DBClient) interfaces.SchedulerManager {
+	m := &manager{
+		ticker:                     time.NewTicker(time.Duration(config.ScheduleIntervalTime) * time.Millisecond),
+		lc:                         lc,
+		config:                     config,
+		executorQueue:              queue.New(),
+		intervalToExecutorMap:      make(map[string]*Executor),
+		actionToIntervalMap:        make(map[string]string),
+		commandActionToIntervalMap: make(map[string]string),
+		busActionToIntervalMap:     make(map[string]string),
+		secretProvider:             secretProvider,
+		commandClient:              commandClient,
+		messagingClient:            messagingClient,
+		dbClient:                   dbClient,
+		runningJobs:                make(map[string]int),
+		calendars:                  make(map[string]calendar),
 	}
+	m.jobRunCompleted = sync.NewCond(&m.mutex)
+	return m
 }
 
-// StartTicker starts infinite loop with ticker to trigger the interval job
+// StartTicker starts infinite loop with ticker to trigger the interval job, after catching up the runs missed while
+// the service was down
 func (m *manager) StartTicker() {
 	m.once.Do(func() {
 		go func() {
+			m.catchUpMissedRuns()
 			for range m.ticker.C {
 				m.triggerInterval()
 			}
@@ -108,19 +143,11 @@ func (m *manager) execute(
 	wg *sync.WaitGroup) {
 	defer wg.Done()
 
-	m.lc.Debugf("%d action need to be executed with interval %s.", len(executor.IntervalActionsMap), executor.Interval.Name)
-
-	// execute interval action one by one
-	for _, action := range executor.IntervalActionsMap {
-		if action.AdminState == models.Locked {
-			m.lc.Debugf("interval action %s is locked, skip the job execution", action.Name)
-			continue
-		}
-		edgeXerr := m.executeAction(action)
-		if edgeXerr != nil {
-			m.lc.Errorf("fail to execute the interval action, err: %v", edgeXerr)
-		}
-	}
+	jobs := m.executorJobs(executor)
+	m.lc.Debugf("%d action need to be executed with interval %s.", len(jobs), executor.Interval.Name)
+	// the jobs run apart from the ticker, so that their jitter and the runs queued behind the runs in progress don't
+	// delay the other intervals
+	go m.runJobs(executor.Interval.Name, jobs)
 
 	executor.UpdateNextTime()
 
@@ -151,7 +178,7 @@ func (m *manager) executeAction(action models.IntervalAction) errors.EdgeX {
 
 		_, err := utils.SendRequestWithRESTAddress(m.lc, action.Content, action.ContentType, restAddress, jwtSecretProvider)
 		if err != nil {
-			m.lc.Errorf("fail to send request with RESTAddress, err: %v", err)
+			return errors.NewCommonEdgeX(errors.KindCommunicationError, "fail to send request with RESTAddress", err)
 		}
 	default:
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Unsupported address type", nil)
@@ -160,3 +187,61 @@ func (m *manager) executeAction(action models.IntervalAction) errors.EdgeX {
 	m.lc.Debugf("success to execute the action %s with interval %s", action.Name, action.IntervalName)
 	return nil
 }
+
+// executeDeviceCommandAction issues the device command of the action through core-command, with a new correlation ID
+// to trace the command
+func (m *manager) executeDeviceCommandAction(action schedulerModels.DeviceCommandAction) errors.EdgeX {
+	if m.commandClient == nil {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the core-command client is not configured", nil)
+	}
+	correlationId := uuid.New().String()
+	// lint:ignore SA1029 legacy
+	// nolint:staticcheck // See golangci-lint #741
+	ctx := context.WithValue(context.Background(), common.CorrelationHeader, correlationId)
+	m.lc.Debugf("issuing the %s command %s of device %s for action %s, Correlation-ID: %s", action.Method, action.CommandName, action.DeviceName, action.Name, correlationId)
+
+	var err errors.EdgeX
+	switch action.Method {
+	case schedulerModels.DeviceCommandGet:
+		_, err = m.commandClient.IssueGetCommandByNameWithQueryParams(ctx, action.DeviceName, action.CommandName, action.QueryParameters)
+	case schedulerModels.DeviceCommandSet:
+		_, err = m.commandClient.IssueSetCommandByNameWithObject(ctx, action.DeviceName, action.CommandName, action.Settings)
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported device command method %s", action.Method), nil)
+	}
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to issue the %s command %s of device %s", action.Method, action.CommandName, action.DeviceName), err)
+	}
+
+	m.lc.Debugf("success to execute the device command action %s with interval %s", action.Name, action.IntervalName)
+	return nil
+}
+
+// executeMessageBusAction publishes the payload of the action to its topic under the base topic of the message bus,
+// with a new correlation ID to trace the message
+func (m *manager) executeMessageBusAction(action schedulerModels.MessageBusAction) errors.EdgeX {
+	if m.messagingClient == nil {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the messaging client is not configured", nil)
+	}
+	contentType := action.ContentType
+	if contentType == "" {
+		contentType = common.ContentTypeJSON
+	}
+	correlationId := uuid.New().String()
+	// lint:ignore SA1029 legacy
+	// nolint:staticcheck // See golangci-lint #741
+	ctx := context.WithValue(context.Background(), common.CorrelationHeader, correlationId)
+	// lint:ignore SA1029 legacy
+	// nolint:staticcheck // See golangci-lint #741
+	ctx = context.WithValue(ctx, common.ContentType, contentType)
+
+	topic := common.BuildTopic(m.config.MessageBus.GetBaseTopicPrefix(), action.Topic)
+	m.lc.Debugf("publishing the payload of action %s to topic %s, Correlation-ID: %s", action.Name, topic, correlationId)
+	envelope := types.NewMessageEnvelope([]byte(action.Payload), ctx)
+	if err := m.messagingClient.Publish(envelope, topic); err != nil {
+		return errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("fail to publish the payload to topic %s", topic), err)
+	}
+
+	m.lc.Debugf("success to execute the message bus action %s with interval %s", action.Name, action.IntervalName)
+	return nil
+}
@@ -6,13 +6,25 @@
 package scheduler
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,6 +37,90 @@ func TestNewManager(t *testing.T) {
 		IntervalActions:      nil,
 		ScheduleIntervalTime: 500,
 	}
-	manager := NewManager(lc, config, nil)
+	manager := NewManager(lc, config, nil, nil, nil, nil)
 	require.NotNil(t, manager)
 }
+
+func TestExecuteDeviceCommandAction(t *testing.T) {
+	lc := logger.NewMockClient()
+	config := &config.ConfigurationStruct{ScheduleIntervalTime: 500}
+	queryParams := map[string]string{"ds-pushevent": "true"}
+	settings := map[string]any{"SwitchButton": true}
+
+	commandClient := &clientMocks.CommandClient{}
+	commandClient.On("IssueGetCommandByNameWithQueryParams", mock.Anything, "thermostat", "temperature", queryParams).Return(&responses.EventResponse{}, nil)
+	commandClient.On("IssueSetCommandByNameWithObject", mock.Anything, "thermostat", "switch", settings).Return(commonDTO.BaseResponse{}, nil)
+	commandClient.On("IssueGetCommandByNameWithQueryParams", mock.Anything, "unknown", mock.Anything, mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	tests := []struct {
+		name          string
+		commandClient *clientMocks.CommandClient
+		action        schedulerModels.DeviceCommandAction
+		errorExpected bool
+		expectedKind  errors.ErrKind
+	}{
+		{"valid - GET command", commandClient, schedulerModels.DeviceCommandAction{Name: "read", DeviceName: "thermostat", CommandName: "temperature", Method: schedulerModels.DeviceCommandGet, QueryParameters: queryParams}, false, ""},
+		{"valid - SET command", commandClient, schedulerModels.DeviceCommandAction{Name: "write", DeviceName: "thermostat", CommandName: "switch", Method: schedulerModels.DeviceCommandSet, Settings: settings}, false, ""},
+		{"invalid - device not found", commandClient, schedulerModels.DeviceCommandAction{Name: "unknown", DeviceName: "unknown", CommandName: "temperature", Method: schedulerModels.DeviceCommandGet}, true, errors.KindEntityDoesNotExist},
+		{"invalid - unsupported method", commandClient, schedulerModels.DeviceCommandAction{Name: "put", DeviceName: "thermostat", CommandName: "switch", Method: "PUT"}, true, errors.KindContractInvalid},
+		{"invalid - core-command client not configured", nil, schedulerModels.DeviceCommandAction{Name: "read", DeviceName: "thermostat", CommandName: "temperature", Method: schedulerModels.DeviceCommandGet}, true, errors.KindServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := NewManager(lc, config, nil, nil, nil, nil).(*manager)
+			if testCase.commandClient != nil {
+				m.commandClient = testCase.commandClient
+			}
+			err := m.executeDeviceCommandAction(testCase.action)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestExecuteMessageBusAction(t *testing.T) {
+	lc := logger.NewMockClient()
+	config := &config.ConfigurationStruct{ScheduleIntervalTime: 500}
+	config.MessageBus.BaseTopicPrefix = "edgex"
+
+	messagingClient := &messagingMocks.MessageClient{}
+	messagingClient.On("Publish", mock.MatchedBy(func(envelope types.MessageEnvelope) bool {
+		return string(envelope.Payload) == `{"trigger":true}` && envelope.ContentType == common.ContentTypeJSON && envelope.CorrelationID != ""
+	}), "edgex/rules/trigger").Return(nil)
+	messagingClient.On("Publish", mock.MatchedBy(func(envelope types.MessageEnvelope) bool {
+		return envelope.ContentType == common.ContentTypeText
+	}), "edgex/rules/text").Return(nil)
+	messagingClient.On("Publish", mock.Anything, "edgex/broken").Return(fmt.Errorf("connection lost"))
+
+	tests := []struct {
+		name            string
+		messagingClient *messagingMocks.MessageClient
+		action          schedulerModels.MessageBusAction
+		errorExpected   bool
+		expectedKind    errors.ErrKind
+	}{
+		{"valid - default JSON content type", messagingClient, schedulerModels.MessageBusAction{Name: "trigger", Topic: "rules/trigger", Payload: `{"trigger":true}`}, false, ""},
+		{"valid - text content type", messagingClient, schedulerModels.MessageBusAction{Name: "text", Topic: "rules/text", ContentType: common.ContentTypeText, Payload: "trigger"}, false, ""},
+		{"invalid - publish failed", messagingClient, schedulerModels.MessageBusAction{Name: "broken", Topic: "broken", Payload: "{}"}, true, errors.KindCommunicationError},
+		{"invalid - messaging client not configured", nil, schedulerModels.MessageBusAction{Name: "trigger", Topic: "rules/trigger", Payload: "{}"}, true, errors.KindServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := NewManager(lc, config, nil, nil, nil, nil).(*manager)
+			if testCase.messagingClient != nil {
+				m.messagingClient = testCase.messagingClient
+			}
+			err := m.executeMessageBusAction(testCase.action)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 func (m *manager) addIntervalAction(e *Executor, action models.IntervalAction) {
@@ -28,8 +30,10 @@ func (m *manager) AddInterval(interval models.Interval) errors.EdgeX {
 	}
 
 	executor := Executor{
-		IntervalActionsMap: make(map[string]models.IntervalAction),
-		MarkedDeleted:      false,
+		IntervalActionsMap:      make(map[string]models.IntervalAction),
+		DeviceCommandActionsMap: make(map[string]schedulerModels.DeviceCommandAction),
+		MessageBusActionsMap:    make(map[string]schedulerModels.MessageBusAction),
+		MarkedDeleted:           false,
 	}
 	err := executor.Initialize(interval, m.lc)
 	if err != nil {
@@ -165,3 +169,95 @@ func (m *manager) DeleteIntervalActionByName(actionName string) errors.EdgeX {
 	m.lc.Infof("removed the action with name: %s", actionName)
 	return nil
 }
+
+// AddDeviceCommandAction adds the device command action to the specified executor
+func (m *manager) AddDeviceCommandAction(action schedulerModels.DeviceCommandAction) errors.EdgeX {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.commandActionToIntervalMap[action.Name]; exists {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict,
+			fmt.Sprintf("the device command action with name : %s already exists", action.Name), nil)
+	}
+	executor, exists := m.intervalToExecutorMap[action.IntervalName]
+	if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("the executor with interval name %s does not exist", action.IntervalName), nil)
+	}
+
+	executor.DeviceCommandActionsMap[action.Name] = action
+	m.commandActionToIntervalMap[action.Name] = executor.Interval.Name
+
+	m.lc.Infof("added the device command action %s to interval %s executor", action.Name, action.IntervalName)
+	return nil
+}
+
+// DeleteDeviceCommandActionByName deletes the device command action by name
+func (m *manager) DeleteDeviceCommandActionByName(actionName string) errors.EdgeX {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	intervalName, exists := m.commandActionToIntervalMap[actionName]
+	if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("could not find interval name with device command action name : %s", actionName), nil)
+	}
+
+	executor, exists := m.intervalToExecutorMap[intervalName]
+	if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("the executor with interval name %s does not exist", intervalName), nil)
+	}
+
+	delete(executor.DeviceCommandActionsMap, actionName)
+	delete(m.commandActionToIntervalMap, actionName)
+
+	m.lc.Infof("removed the device command action with name: %s", actionName)
+	return nil
+}
+
+// AddMessageBusAction adds the message bus action to the specified executor
+func (m *manager) AddMessageBusAction(action schedulerModels.MessageBusAction) errors.EdgeX {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.busActionToIntervalMap[action.Name]; exists {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict,
+			fmt.Sprintf("the message bus action with name : %s already exists", action.Name), nil)
+	}
+	executor, exists := m.intervalToExecutorMap[action.IntervalName]
+	if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("the executor with interval name %s does not exist", action.IntervalName), nil)
+	}
+
+	executor.MessageBusActionsMap[action.Name] = action
+	m.busActionToIntervalMap[action.Name] = executor.Interval.Name
+
+	m.lc.Infof("added the message bus action %s to interval %s executor", action.Name, action.IntervalName)
+	return nil
+}
+
+// DeleteMessageBusActionByName deletes the message bus action by name
+func (m *manager) DeleteMessageBusActionByName(actionName string) errors.EdgeX {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	intervalName, exists := m.busActionToIntervalMap[actionName]
+	if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("could not find interval name with message bus action name : %s", actionName), nil)
+	}
+
+	executor, exists := m.intervalToExecutorMap[intervalName]
+	if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("the executor with interval name %s does not exist", intervalName), nil)
+	}
+
+	delete(executor.MessageBusActionsMap, actionName)
+	delete(m.busActionToIntervalMap, actionName)
+
+	m.lc.Infof("removed the message bus action with name: %s", actionName)
+	return nil
+}
@@ -19,12 +19,19 @@ import (
 	"fmt"
 
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 )
 
 // Configuration for the Support Scheduler Service
 type ConfigurationStruct struct {
 	Writable        WritableInfo
+	Clients         bootstrapConfig.ClientsCollection
 	Database        bootstrapConfig.Database
+	DatabasePool    db.PoolInfo
 	Registry        bootstrapConfig.RegistryInfo
 	Service         bootstrapConfig.ServiceInfo
 	MessageBus      bootstrapConfig.MessageBusInfo
@@ -32,6 +39,19 @@ type ConfigurationStruct struct {
 	IntervalActions map[string]IntervalActionInfo
 	// ScheduleIntervalTime is a time(Millisecond) to create a ticker to delay the scheduler loop
 	ScheduleIntervalTime int
+	// JobRunRetention configures how long the run history of the jobs is kept
+	JobRunRetention JobRunRetentionInfo
+	// CatchUp is the default catch-up policy of the jobs which don't set their own, including the interval actions
+	CatchUp CatchUpInfo
+	// JobControl is the default jitter and concurrency of the jobs which don't set their own, including the interval
+	// actions
+	JobControl JobControlInfo
+	// MutualTLS configures mutual TLS on the REST API and for the requests to the other services
+	MutualTLS pkgHandlers.MutualTLSInfo
+	// Audit configures the audit log of the security relevant operations
+	Audit audit.Info
+	// APIKey configures the authentication of the headless clients with the API keys of the secret store
+	APIKey apikey.Info
 }
 
 type WritableInfo struct {
@@ -40,6 +60,40 @@ type WritableInfo struct {
 	Telemetry       bootstrapConfig.TelemetryInfo
 }
 
+// CatchUpInfo defines how the runs of a job missed while the service was down are caught up on startup
+type CatchUpInfo struct {
+	// Policy is SKIP to skip the missed runs, RUN_ONCE to run the job once for all of them, or BACKFILL to run the job
+	// for each of them
+	Policy string
+	// MaxRuns is the maximum count of missed runs the BACKFILL policy runs
+	MaxRuns int
+}
+
+// JobRunRetentionInfo configures the deletion of the job runs from the run history, by count on each new run of a job
+// and by age periodically
+type JobRunRetentionInfo struct {
+	// MaxRunsPerJob is the count of the latest runs kept in the run history of each job, 0 keeps all the runs
+	MaxRunsPerJob int
+	// Enabled deletes the job runs older than MaxAge periodically
+	Enabled bool
+	// Interval is how often the job runs older than MaxAge are deleted, e.g. "1h"
+	Interval string
+	// MaxAge is the time after its start when a job run is deleted, e.g. "168h"
+	MaxAge string
+}
+
+// JobControlInfo defines how the runs of a job are spread and limited, so that many nodes sharing the same intervals
+// don't run their jobs all at once
+type JobControlInfo struct {
+	// Jitter is the maximum random delay of each run of a job after its interval triggers, e.g. "5s", empty for none
+	Jitter string
+	// MaxConcurrency is the maximum count of runs of a job in progress at the same time, 0 for no limit
+	MaxConcurrency int
+	// ConcurrencyPolicy is SKIP to skip the runs triggered while the job has MaxConcurrency runs in progress, or QUEUE
+	// to delay them until a run in progress completes
+	ConcurrencyPolicy string
+}
+
 type IntervalInfo struct {
 	// Name of the schedule must be unique?
 	Name string
@@ -133,6 +187,7 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 func (c *ConfigurationStruct) GetBootstrap() bootstrapConfig.BootstrapConfiguration {
 	// temporary until we can make backwards-breaking configuration.yaml change
 	return bootstrapConfig.BootstrapConfiguration{
+		Clients:    &c.Clients,
 		Service:    &c.Service,
 		Registry:   &c.Registry,
 		MessageBus: &c.MessageBus,
@@ -155,6 +210,11 @@ func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
 	return c.Database
 }
 
+// GetDatabasePoolInfo returns the configuration of the database connection pool.
+func (c *ConfigurationStruct) GetDatabasePoolInfo() db.PoolInfo {
+	return c.DatabasePool
+}
+
 // GetInsecureSecrets returns the service's InsecureSecrets.
 func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
 	return c.Writable.InsecureSecrets
@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerDTOs "github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const TestCalendarName = "TestCalendar"
+
+func addCalendarRequestData() schedulerDTOs.AddCalendarRequest {
+	return schedulerDTOs.AddCalendarRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Calendar: schedulerDTOs.Calendar{
+			Name:     TestCalendarName,
+			Timezone: "UTC",
+			Dates:    []string{"2023-12-25"},
+			Rules:    []schedulerDTOs.CalendarRule{{Start: "2023-08-01", End: "2023-08-15", Weekdays: []string{"SAT", "SUN"}}},
+		},
+	}
+}
+
+func TestAddCalendar(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	schedulerManagerMock := &dbMock.SchedulerManager{}
+
+	valid := addCalendarRequestData()
+	model := schedulerDTOs.ToCalendarModel(valid.Calendar)
+	dbClientMock.On("AddCalendar", model).Return(model, nil)
+	schedulerManagerMock.On("AddCalendar", model).Return(nil)
+
+	noName := addCalendarRequestData()
+	noName.Calendar.Name = ""
+	noDays := addCalendarRequestData()
+	noDays.Calendar.Dates = nil
+	noDays.Calendar.Rules = nil
+	invalidDate := addCalendarRequestData()
+	invalidDate.Calendar.Dates = []string{"2023-13-01"}
+	invalidTimezone := addCalendarRequestData()
+	invalidTimezone.Calendar.Timezone = "Mars/Olympus"
+	invalidWeekday := addCalendarRequestData()
+	invalidWeekday.Calendar.Rules[0].Weekdays = []string{"SUNDAY"}
+	emptyRule := addCalendarRequestData()
+	emptyRule.Calendar.Rules = []schedulerDTOs.CalendarRule{{}}
+	endBeforeStart := addCalendarRequestData()
+	endBeforeStart.Calendar.Rules[0].End = "2023-07-31"
+
+	duplicatedName := addCalendarRequestData()
+	duplicatedName.Calendar.Name = "duplicatedName"
+	model = schedulerDTOs.ToCalendarModel(duplicatedName.Calendar)
+	dbClientMock.On("AddCalendar", model).Return(model, errors.NewCommonEdgeX(errors.KindDuplicateName, "calendar name duplicatedName already exists", nil))
+
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.SchedulerManagerName: func(get di.Get) interface{} {
+			return schedulerManagerMock
+		},
+	})
+	controller := NewCalendarController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		request            []schedulerDTOs.AddCalendarRequest
+		expectedStatusCode int
+	}{
+		{"Valid", []schedulerDTOs.AddCalendarRequest{valid}, http.StatusCreated},
+		{"Invalid - no name", []schedulerDTOs.AddCalendarRequest{noName}, http.StatusBadRequest},
+		{"Invalid - no dates or rules", []schedulerDTOs.AddCalendarRequest{noDays}, http.StatusBadRequest},
+		{"Invalid - invalid date", []schedulerDTOs.AddCalendarRequest{invalidDate}, http.StatusBadRequest},
+		{"Invalid - invalid timezone", []schedulerDTOs.AddCalendarRequest{invalidTimezone}, http.StatusBadRequest},
+		{"Invalid - invalid weekday", []schedulerDTOs.AddCalendarRequest{invalidWeekday}, http.StatusBadRequest},
+		{"Invalid - empty rule", []schedulerDTOs.AddCalendarRequest{emptyRule}, http.StatusBadRequest},
+		{"Invalid - rule end before start", []schedulerDTOs.AddCalendarRequest{endBeforeStart}, http.StatusBadRequest},
+		{"Invalid - duplicated name", []schedulerDTOs.AddCalendarRequest{duplicatedName}, http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiCalendarRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddCalendar)
+			handler.ServeHTTP(recorder, req)
+			if testCase.expectedStatusCode == http.StatusBadRequest {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+
+				// Assert
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.NotEmpty(t, res.Message, "Message is empty")
+			} else {
+				var res []commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+
+				// Assert
+				assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
+	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerDTOs "github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+type JobRunController struct {
+	dic *di.Container
+}
+
+// NewJobRunController creates and initializes a JobRunController
+func NewJobRunController(dic *di.Container) *JobRunController {
+	return &JobRunController{
+		dic: dic,
+	}
+}
+
+func (jc *JobRunController) AllJobRuns(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	ctx := r.Context()
+	config := schedulerContainer.ConfigurationFrom(jc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	query, err := parseJobRunQuery(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	runs, totalCount, statusCounts, err := application.JobRuns(offset, limit, query, jc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := schedulerDTOs.NewMultiJobRunsResponse("", "", http.StatusOK, totalCount, runs)
+	response.StatusCounts = statusCounts
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (jc *JobRunController) JobRunsByJobName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	ctx := r.Context()
+	config := schedulerContainer.ConfigurationFrom(jc.dic.Get)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+	if name == "" {
+		utils.WriteErrorResponse(w, ctx, lc, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil), "")
+		return
+	}
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	query, err := parseJobRunQuery(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	query.JobName = name
+	runs, totalCount, statusCounts, err := application.JobRuns(offset, limit, query, jc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := schedulerDTOs.NewMultiJobRunsResponse("", "", http.StatusOK, totalCount, runs)
+	response.StatusCounts = statusCounts
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (jc *JobRunController) JobRunsByIntervalName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	ctx := r.Context()
+	config := schedulerContainer.ConfigurationFrom(jc.dic.Get)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+	runs, totalCount, err := application.JobRunsByIntervalName(offset, limit, name, jc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := schedulerDTOs.NewMultiJobRunsResponse("", "", http.StatusOK, totalCount, runs)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (jc *JobRunController) TriggerJob(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(jc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[common.Name]
+
+	runs, err := application.TriggerJob(name, jc.dic)
+	if err != nil {
+		utils.WriteErrorResponse(w, ctx, lc, err, "")
+		return
+	}
+
+	response := schedulerDTOs.NewMultiJobRunsResponse("", "", http.StatusOK, uint32(len(runs)), runs)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// parseJobRunQuery parses the status, start and end query strings filtering the job runs, the start and end of their
+// start time in milliseconds
+func parseJobRunQuery(r *http.Request) (query schedulerModels.JobRunQuery, edgeXerr errors.EdgeX) {
+	query.Status = utils.ParseQueryStringToString(r, common.Status, "")
+	start, edgeXerr := utils.ParseQueryStringToInt(r, common.Start, 0, 0, math.MaxInt)
+	if edgeXerr != nil {
+		return query, edgeXerr
+	}
+	end, edgeXerr := utils.ParseQueryStringToInt(r, common.End, 0, 0, math.MaxInt)
+	if edgeXerr != nil {
+		return query, edgeXerr
+	}
+	query.Start, query.End = int64(start), int64(end)
+	return query, nil
+}
@@ -0,0 +1,173 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerDTOs "github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllJobRuns(t *testing.T) {
+	failedRun := schedulerModels.JobRun{JobName: TestIntervalActionName, IntervalName: TestIntervalName, Started: 150, Status: schedulerModels.JobRunFailed}
+	timeRangeQuery := schedulerModels.JobRunQuery{Start: 100, End: 200}
+	failedQuery := schedulerModels.JobRunQuery{Status: schedulerModels.JobRunFailed}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("JobRuns", 0, 20, schedulerModels.JobRunQuery{}).Return([]schedulerModels.JobRun{failedRun}, nil)
+	dbClientMock.On("JobRunCount", schedulerModels.JobRunQuery{Status: schedulerModels.JobRunSucceeded}).Return(uint32(3), nil)
+	dbClientMock.On("JobRunCount", failedQuery).Return(uint32(1), nil)
+	dbClientMock.On("JobRunCount", schedulerModels.JobRunQuery{Status: schedulerModels.JobRunSkipped}).Return(uint32(0), nil)
+	dbClientMock.On("JobRuns", 0, 20, failedQuery).Return([]schedulerModels.JobRun{failedRun}, nil)
+	dbClientMock.On("JobRuns", 0, 20, timeRangeQuery).Return([]schedulerModels.JobRun{failedRun}, nil)
+	dbClientMock.On("JobRunCount", schedulerModels.JobRunQuery{Status: schedulerModels.JobRunSucceeded, Start: 100, End: 200}).Return(uint32(0), nil)
+	dbClientMock.On("JobRunCount", schedulerModels.JobRunQuery{Status: schedulerModels.JobRunFailed, Start: 100, End: 200}).Return(uint32(1), nil)
+	dbClientMock.On("JobRunCount", schedulerModels.JobRunQuery{Status: schedulerModels.JobRunSkipped, Start: 100, End: 200}).Return(uint32(0), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewJobRunController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                 string
+		status               string
+		start                string
+		end                  string
+		errorExpected        bool
+		expectedTotalCount   uint32
+		expectedStatusCounts map[string]uint32
+		expectedStatusCode   int
+	}{
+		{"Valid - get all job runs", "", "", "", false, 4,
+			map[string]uint32{schedulerModels.JobRunSucceeded: 3, schedulerModels.JobRunFailed: 1, schedulerModels.JobRunSkipped: 0}, http.StatusOK},
+		{"Valid - get job runs by status", schedulerModels.JobRunFailed, "", "", false, 1,
+			map[string]uint32{schedulerModels.JobRunFailed: 1}, http.StatusOK},
+		{"Valid - get job runs by time range", "", "100", "200", false, 1,
+			map[string]uint32{schedulerModels.JobRunSucceeded: 0, schedulerModels.JobRunFailed: 1, schedulerModels.JobRunSkipped: 0}, http.StatusOK},
+		{"Invalid - invalid status", "DONE", "", "", true, 0, nil, http.StatusBadRequest},
+		{"Invalid - invalid start format", "", "aaa", "", true, 0, nil, http.StatusBadRequest},
+		{"Invalid - end before start", "", "200", "100", true, 0, nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiAllJobRunRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.status != "" {
+				query.Add(common.Status, testCase.status)
+			}
+			if testCase.start != "" {
+				query.Add(common.Start, testCase.start)
+			}
+			if testCase.end != "" {
+				query.Add(common.End, testCase.end)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AllJobRuns)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res schedulerDTOs.MultiJobRunsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Response total count not as expected")
+				assert.Equal(t, testCase.expectedStatusCounts, res.StatusCounts, "Response status counts not as expected")
+				assert.Len(t, res.Runs, 1)
+			}
+		})
+	}
+}
+
+func TestTriggerJob(t *testing.T) {
+	run := schedulerModels.JobRun{Id: ExampleUUID, JobName: TestIntervalActionName, IntervalName: TestIntervalName, Status: schedulerModels.JobRunSucceeded, Manual: true}
+	lockedName := "lockedName"
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	schedulerManagerMock := &dbMock.SchedulerManager{}
+	schedulerManagerMock.On("TriggerJob", TestIntervalActionName).Return([]schedulerModels.JobRun{run}, nil)
+	schedulerManagerMock.On("TriggerJob", lockedName).Return(nil, errors.NewCommonEdgeX(errors.KindServiceLocked, "job is locked", nil))
+	schedulerManagerMock.On("TriggerJob", notFoundName).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "job isn't scheduled", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.SchedulerManagerName: func(get di.Get) interface{} {
+			return schedulerManagerMock
+		},
+	})
+	controller := NewJobRunController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - trigger job by name", TestIntervalActionName, false, http.StatusOK},
+		{"Invalid - name parameter is empty", "", true, http.StatusBadRequest},
+		{"Invalid - job locked", lockedName, true, http.StatusLocked},
+		{"Invalid - job not found by name", notFoundName, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/%s/%s/%s", common.ApiBase, pkgCommon.Job, common.Name, testCase.jobName, pkgCommon.Trigger)
+			req, err := http.NewRequest(http.MethodPost, reqPath, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{common.Name: testCase.jobName})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.TriggerJob)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res schedulerDTOs.MultiJobRunsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Len(t, res.Runs, 1)
+				assert.True(t, res.Runs[0].Manual, "Run not marked as manual")
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"fmt"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// Calendar defines the blackout days of the jobs attached to it, as dates or recurring rules
+type Calendar struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string         `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string         `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string         `json:"description,omitempty"`
+	Timezone         string         `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	Dates            []string       `json:"dates,omitempty" validate:"dive,datetime=2006-01-02"`
+	Rules            []CalendarRule `json:"rules,omitempty" validate:"dive"`
+}
+
+// CalendarRule matches the days matching all its non-empty fields
+type CalendarRule struct {
+	Start     string   `json:"start,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	End       string   `json:"end,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Weekdays  []string `json:"weekdays,omitempty" validate:"dive,oneof='SUN' 'MON' 'TUE' 'WED' 'THU' 'FRI' 'SAT'"`
+	MonthDays []int    `json:"monthDays,omitempty" validate:"dive,min=1,max=31"`
+	Months    []int    `json:"months,omitempty" validate:"dive,min=1,max=12"`
+}
+
+// UpdateCalendar defines the fields of the calendar which can be patched, the calendar is identified by its name
+type UpdateCalendar struct {
+	Name        *string        `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Description *string        `json:"description"`
+	Timezone    *string        `json:"timezone" validate:"omitempty,timezone"`
+	Dates       []string       `json:"dates" validate:"dive,datetime=2006-01-02"`
+	Rules       []CalendarRule `json:"rules" validate:"dive"`
+}
+
+// ToCalendarModel transforms the Calendar DTO to the Calendar Model
+func ToCalendarModel(dto Calendar) schedulerModels.Calendar {
+	rules := make([]schedulerModels.CalendarRule, len(dto.Rules))
+	for i, r := range dto.Rules {
+		rules[i] = schedulerModels.CalendarRule(r)
+	}
+	return schedulerModels.Calendar{
+		DBTimestamp: models.DBTimestamp(dto.DBTimestamp),
+		Id:          dto.Id,
+		Name:        dto.Name,
+		Description: dto.Description,
+		Timezone:    dto.Timezone,
+		Dates:       dto.Dates,
+		Rules:       rules,
+	}
+}
+
+// FromCalendarModelToDTO transforms the Calendar Model to the Calendar DTO
+func FromCalendarModelToDTO(c schedulerModels.Calendar) Calendar {
+	rules := make([]CalendarRule, len(c.Rules))
+	for i, r := range c.Rules {
+		rules[i] = CalendarRule(r)
+	}
+	return Calendar{
+		DBTimestamp: dtos.DBTimestamp(c.DBTimestamp),
+		Id:          c.Id,
+		Name:        c.Name,
+		Description: c.Description,
+		Timezone:    c.Timezone,
+		Dates:       c.Dates,
+		Rules:       rules,
+	}
+}
+
+// ReplaceCalendarModelFieldsWithDTO replaces the fields of the Calendar Model with the patched fields of the DTO
+func ReplaceCalendarModelFieldsWithDTO(c *schedulerModels.Calendar, patch UpdateCalendar) {
+	if patch.Description != nil {
+		c.Description = *patch.Description
+	}
+	if patch.Timezone != nil {
+		c.Timezone = *patch.Timezone
+	}
+	if patch.Dates != nil {
+		c.Dates = patch.Dates
+	}
+	if patch.Rules != nil {
+		c.Rules = make([]schedulerModels.CalendarRule, len(patch.Rules))
+		for i, r := range patch.Rules {
+			c.Rules[i] = schedulerModels.CalendarRule(r)
+		}
+	}
+}
+
+// validateCalendarRules checks that each rule matches some days, and that the days it matches start before they end
+func validateCalendarRules(rules []CalendarRule) error {
+	for i, r := range rules {
+		if r.Start == "" && r.End == "" && len(r.Weekdays) == 0 && len(r.MonthDays) == 0 && len(r.Months) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("calendar rule %d matches every day", i), nil)
+		}
+		// the dates have the same layout, so that they compare in order
+		if r.Start != "" && r.End != "" && r.End < r.Start {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("calendar rule %d ends before it starts", i), nil)
+		}
+	}
+	return nil
+}
+
+// AddCalendarRequest defines the Request Content for POST Calendar DTO
+type AddCalendarRequest struct {
+	common.BaseRequest `json:",inline"`
+	Calendar           Calendar `json:"calendar"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddCalendarRequest) Validate() error {
+	if err := contractsCommon.Validate(r); err != nil {
+		return err
+	}
+	if len(r.Calendar.Dates) == 0 && len(r.Calendar.Rules) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "dates or rules must be specified", nil)
+	}
+	return validateCalendarRules(r.Calendar.Rules)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddCalendarRequest type
+func (r *AddCalendarRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Calendar Calendar
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddCalendarRequest(alias)
+	return r.Validate()
+}
+
+// UpdateCalendarRequest defines the Request Content for PATCH Calendar DTO
+type UpdateCalendarRequest struct {
+	common.BaseRequest `json:",inline"`
+	Calendar           UpdateCalendar `json:"calendar"`
+}
+
+// Validate satisfies the Validator interface
+func (r UpdateCalendarRequest) Validate() error {
+	if err := contractsCommon.Validate(r); err != nil {
+		return err
+	}
+	return validateCalendarRules(r.Calendar.Rules)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateCalendarRequest type
+func (r *UpdateCalendarRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Calendar UpdateCalendar
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = UpdateCalendarRequest(alias)
+	return r.Validate()
+}
+
+// CalendarResponse defines the Response Content for GET Calendar DTO
+type CalendarResponse struct {
+	common.BaseResponse `json:",inline"`
+	Calendar            Calendar `json:"calendar"`
+}
+
+func NewCalendarResponse(requestId string, message string, statusCode int, calendar Calendar) CalendarResponse {
+	return CalendarResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Calendar:     calendar,
+	}
+}
+
+// MultiCalendarsResponse defines the Response Content for GET multiple Calendar DTOs
+type MultiCalendarsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Calendars                         []Calendar `json:"calendars"`
+}
+
+func NewMultiCalendarsResponse(requestId string, message string, statusCode int, totalCount uint32, calendars []Calendar) MultiCalendarsResponse {
+	return MultiCalendarsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Calendars:                  calendars,
+	}
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// DeviceCommandAction issues a read (GET) or write (SET) command of a device through core-command each time its
+// interval triggers
+type DeviceCommandAction struct {
+	dtos.DBTimestamp  `json:",inline"`
+	Id                string            `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name              string            `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	IntervalName      string            `json:"intervalName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	DependsOn         []string          `json:"dependsOn,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Calendars         []string          `json:"calendars,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	DeviceName        string            `json:"deviceName" validate:"required,edgex-dto-none-empty-string"`
+	CommandName       string            `json:"commandName" validate:"required,edgex-dto-none-empty-string"`
+	Method            string            `json:"method" validate:"oneof='GET' 'SET'"`
+	QueryParameters   map[string]string `json:"queryParameters,omitempty"`
+	Settings          map[string]any    `json:"settings,omitempty" validate:"required_if=Method SET"`
+	CatchUpPolicy     string            `json:"catchUpPolicy,omitempty" validate:"omitempty,oneof='SKIP' 'RUN_ONCE' 'BACKFILL'"`
+	CatchUpMaxRuns    int               `json:"catchUpMaxRuns,omitempty" validate:"gte=0,required_if=CatchUpPolicy BACKFILL"`
+	Jitter            string            `json:"jitter,omitempty" validate:"omitempty,edgex-dto-duration"`
+	MaxConcurrency    int               `json:"maxConcurrency,omitempty" validate:"gte=0"`
+	ConcurrencyPolicy string            `json:"concurrencyPolicy,omitempty" validate:"omitempty,oneof='SKIP' 'QUEUE'"`
+	AdminState        string            `json:"adminState" validate:"oneof='LOCKED' 'UNLOCKED'"`
+}
+
+// ToDeviceCommandActionModel transforms the DeviceCommandAction DTO to the DeviceCommandAction Model
+func ToDeviceCommandActionModel(dto DeviceCommandAction) schedulerModels.DeviceCommandAction {
+	return schedulerModels.DeviceCommandAction{
+		DBTimestamp:       models.DBTimestamp(dto.DBTimestamp),
+		Id:                dto.Id,
+		Name:              dto.Name,
+		IntervalName:      dto.IntervalName,
+		DependsOn:         dto.DependsOn,
+		Calendars:         dto.Calendars,
+		DeviceName:        dto.DeviceName,
+		CommandName:       dto.CommandName,
+		Method:            dto.Method,
+		QueryParameters:   dto.QueryParameters,
+		Settings:          dto.Settings,
+		CatchUpPolicy:     dto.CatchUpPolicy,
+		CatchUpMaxRuns:    dto.CatchUpMaxRuns,
+		Jitter:            dto.Jitter,
+		MaxConcurrency:    dto.MaxConcurrency,
+		ConcurrencyPolicy: dto.ConcurrencyPolicy,
+		AdminState:        models.AdminState(dto.AdminState),
+	}
+}
+
+// FromDeviceCommandActionModelToDTO transforms the DeviceCommandAction Model to the DeviceCommandAction DTO
+func FromDeviceCommandActionModelToDTO(a schedulerModels.DeviceCommandAction) DeviceCommandAction {
+	return DeviceCommandAction{
+		DBTimestamp:       dtos.DBTimestamp(a.DBTimestamp),
+		Id:                a.Id,
+		Name:              a.Name,
+		IntervalName:      a.IntervalName,
+		DependsOn:         a.DependsOn,
+		Calendars:         a.Calendars,
+		DeviceName:        a.DeviceName,
+		CommandName:       a.CommandName,
+		Method:            a.Method,
+		QueryParameters:   a.QueryParameters,
+		Settings:          a.Settings,
+		CatchUpPolicy:     a.CatchUpPolicy,
+		CatchUpMaxRuns:    a.CatchUpMaxRuns,
+		Jitter:            a.Jitter,
+		MaxConcurrency:    a.MaxConcurrency,
+		ConcurrencyPolicy: a.ConcurrencyPolicy,
+		AdminState:        string(a.AdminState),
+	}
+}
+
+// AddDeviceCommandActionRequest defines the Request Content for POST DeviceCommandAction DTO
+type AddDeviceCommandActionRequest struct {
+	common.BaseRequest  `json:",inline"`
+	DeviceCommandAction DeviceCommandAction `json:"action"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddDeviceCommandActionRequest) Validate() error {
+	err := contractsCommon.Validate(r)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDeviceCommandActionRequest type
+func (r *AddDeviceCommandActionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		DeviceCommandAction DeviceCommandAction `json:"action"`
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddDeviceCommandActionRequest(alias)
+	return r.Validate()
+}
+
+// DeviceCommandActionResponse defines the Response Content for GET DeviceCommandAction DTO
+type DeviceCommandActionResponse struct {
+	common.BaseResponse `json:",inline"`
+	Action              DeviceCommandAction `json:"action"`
+}
+
+func NewDeviceCommandActionResponse(requestId string, message string, statusCode int, action DeviceCommandAction) DeviceCommandActionResponse {
+	return DeviceCommandActionResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Action:       action,
+	}
+}
+
+// MultiDeviceCommandActionsResponse defines the Response Content for GET multiple DeviceCommandAction DTOs
+type MultiDeviceCommandActionsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Actions                           []DeviceCommandAction `json:"actions"`
+}
+
+func NewMultiDeviceCommandActionsResponse(requestId string, message string, statusCode int, totalCount uint32, actions []DeviceCommandAction) MultiDeviceCommandActionsResponse {
+	return MultiDeviceCommandActionsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Actions:                    actions,
+	}
+}
@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// JobRun records a run of a scheduled job
+type JobRun struct {
+	Id           string `json:"id"`
+	JobName      string `json:"jobName"`
+	JobType      string `json:"jobType"`
+	IntervalName string `json:"intervalName"`
+	Started      int64  `json:"started"`
+	Status       string `json:"status"`
+	Message      string `json:"message,omitempty"`
+	CatchUp      string `json:"catchUp,omitempty"`
+	MissedRuns   int    `json:"missedRuns,omitempty"`
+	Manual       bool   `json:"manual,omitempty"`
+}
+
+// FromJobRunModelToDTO transforms the JobRun Model to the JobRun DTO
+func FromJobRunModelToDTO(r schedulerModels.JobRun) JobRun {
+	return JobRun{
+		Id:           r.Id,
+		JobName:      r.JobName,
+		JobType:      r.JobType,
+		IntervalName: r.IntervalName,
+		Started:      r.Started,
+		Status:       r.Status,
+		Message:      r.Message,
+		CatchUp:      r.CatchUp,
+		MissedRuns:   r.MissedRuns,
+		Manual:       r.Manual,
+	}
+}
+
+// MultiJobRunsResponse defines the Response Content for GET multiple JobRun DTOs
+type MultiJobRunsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Runs                              []JobRun `json:"runs"`
+	// StatusCounts counts the runs matching the query by status, summing up to the total count
+	StatusCounts map[string]uint32 `json:"statusCounts,omitempty"`
+}
+
+func NewMultiJobRunsResponse(requestId string, message string, statusCode int, totalCount uint32, runs []JobRun) MultiJobRunsResponse {
+	return MultiJobRunsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Runs:                       runs,
+	}
+}
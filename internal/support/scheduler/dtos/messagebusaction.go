@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	contractsCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// MessageBusAction publishes a payload to a topic of the internal message bus each time its interval triggers
+type MessageBusAction struct {
+	dtos.DBTimestamp  `json:",inline"`
+	Id                string   `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name              string   `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	IntervalName      string   `json:"intervalName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	DependsOn         []string `json:"dependsOn,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Calendars         []string `json:"calendars,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Topic             string   `json:"topic" validate:"required,edgex-dto-none-empty-string,excludesall=#+"`
+	ContentType       string   `json:"contentType,omitempty"`
+	Payload           string   `json:"payload,omitempty"`
+	CatchUpPolicy     string   `json:"catchUpPolicy,omitempty" validate:"omitempty,oneof='SKIP' 'RUN_ONCE' 'BACKFILL'"`
+	CatchUpMaxRuns    int      `json:"catchUpMaxRuns,omitempty" validate:"gte=0,required_if=CatchUpPolicy BACKFILL"`
+	Jitter            string   `json:"jitter,omitempty" validate:"omitempty,edgex-dto-duration"`
+	MaxConcurrency    int      `json:"maxConcurrency,omitempty" validate:"gte=0"`
+	ConcurrencyPolicy string   `json:"concurrencyPolicy,omitempty" validate:"omitempty,oneof='SKIP' 'QUEUE'"`
+	AdminState        string   `json:"adminState" validate:"oneof='LOCKED' 'UNLOCKED'"`
+}
+
+// ToMessageBusActionModel transforms the MessageBusAction DTO to the MessageBusAction Model
+func ToMessageBusActionModel(dto MessageBusAction) schedulerModels.MessageBusAction {
+	return schedulerModels.MessageBusAction{
+		DBTimestamp:       models.DBTimestamp(dto.DBTimestamp),
+		Id:                dto.Id,
+		Name:              dto.Name,
+		IntervalName:      dto.IntervalName,
+		DependsOn:         dto.DependsOn,
+		Calendars:         dto.Calendars,
+		Topic:             dto.Topic,
+		ContentType:       dto.ContentType,
+		Payload:           dto.Payload,
+		CatchUpPolicy:     dto.CatchUpPolicy,
+		CatchUpMaxRuns:    dto.CatchUpMaxRuns,
+		Jitter:            dto.Jitter,
+		MaxConcurrency:    dto.MaxConcurrency,
+		ConcurrencyPolicy: dto.ConcurrencyPolicy,
+		AdminState:        models.AdminState(dto.AdminState),
+	}
+}
+
+// FromMessageBusActionModelToDTO transforms the MessageBusAction Model to the MessageBusAction DTO
+func FromMessageBusActionModelToDTO(a schedulerModels.MessageBusAction) MessageBusAction {
+	return MessageBusAction{
+		DBTimestamp:       dtos.DBTimestamp(a.DBTimestamp),
+		Id:                a.Id,
+		Name:              a.Name,
+		IntervalName:      a.IntervalName,
+		DependsOn:         a.DependsOn,
+		Calendars:         a.Calendars,
+		Topic:             a.Topic,
+		ContentType:       a.ContentType,
+		Payload:           a.Payload,
+		CatchUpPolicy:     a.CatchUpPolicy,
+		CatchUpMaxRuns:    a.CatchUpMaxRuns,
+		Jitter:            a.Jitter,
+		MaxConcurrency:    a.MaxConcurrency,
+		ConcurrencyPolicy: a.ConcurrencyPolicy,
+		AdminState:        string(a.AdminState),
+	}
+}
+
+// AddMessageBusActionRequest defines the Request Content for POST MessageBusAction DTO
+type AddMessageBusActionRequest struct {
+	common.BaseRequest `json:",inline"`
+	MessageBusAction   MessageBusAction `json:"action"`
+}
+
+// Validate satisfies the Validator interface
+func (r AddMessageBusActionRequest) Validate() error {
+	err := contractsCommon.Validate(r)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddMessageBusActionRequest type
+func (r *AddMessageBusActionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		MessageBusAction MessageBusAction `json:"action"`
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*r = AddMessageBusActionRequest(alias)
+	return r.Validate()
+}
+
+// MessageBusActionResponse defines the Response Content for GET MessageBusAction DTO
+type MessageBusActionResponse struct {
+	common.BaseResponse `json:",inline"`
+	Action              MessageBusAction `json:"action"`
+}
+
+func NewMessageBusActionResponse(requestId string, message string, statusCode int, action MessageBusAction) MessageBusActionResponse {
+	return MessageBusActionResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Action:       action,
+	}
+}
+
+// MultiMessageBusActionsResponse defines the Response Content for GET multiple MessageBusAction DTOs
+type MultiMessageBusActionsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Actions                           []MessageBusAction `json:"actions"`
+}
+
+func NewMultiMessageBusActionsResponse(requestId string, message string, statusCode int, totalCount uint32, actions []MessageBusAction) MultiMessageBusActionsResponse {
+	return MultiMessageBusActionsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Actions:                    actions,
+	}
+}
@@ -8,6 +8,8 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 type SchedulerManager interface {
@@ -21,4 +23,16 @@ type SchedulerManager interface {
 	AddIntervalAction(intervalAction models.IntervalAction) errors.EdgeX
 	UpdateIntervalAction(intervalAction models.IntervalAction) errors.EdgeX
 	DeleteIntervalActionByName(name string) errors.EdgeX
+
+	AddDeviceCommandAction(action schedulerModels.DeviceCommandAction) errors.EdgeX
+	DeleteDeviceCommandActionByName(name string) errors.EdgeX
+
+	AddMessageBusAction(action schedulerModels.MessageBusAction) errors.EdgeX
+	DeleteMessageBusActionByName(name string) errors.EdgeX
+
+	TriggerJob(name string) ([]schedulerModels.JobRun, errors.EdgeX)
+
+	AddCalendar(calendar schedulerModels.Calendar) errors.EdgeX
+	UpdateCalendar(calendar schedulerModels.Calendar) errors.EdgeX
+	DeleteCalendarByName(name string) errors.EdgeX
 }
@@ -8,6 +8,8 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 type DBClient interface {
@@ -29,4 +31,30 @@ type DBClient interface {
 	IntervalActionById(id string) (model.IntervalAction, errors.EdgeX)
 	UpdateIntervalAction(action model.IntervalAction) errors.EdgeX
 	IntervalActionTotalCount() (uint32, errors.EdgeX)
+
+	AddDeviceCommandAction(action schedulerModels.DeviceCommandAction) (schedulerModels.DeviceCommandAction, errors.EdgeX)
+	DeviceCommandActionByName(name string) (schedulerModels.DeviceCommandAction, errors.EdgeX)
+	AllDeviceCommandActions(offset int, limit int) ([]schedulerModels.DeviceCommandAction, errors.EdgeX)
+	DeviceCommandActionTotalCount() (uint32, errors.EdgeX)
+	DeleteDeviceCommandActionByName(name string) errors.EdgeX
+
+	AddMessageBusAction(action schedulerModels.MessageBusAction) (schedulerModels.MessageBusAction, errors.EdgeX)
+	MessageBusActionByName(name string) (schedulerModels.MessageBusAction, errors.EdgeX)
+	AllMessageBusActions(offset int, limit int) ([]schedulerModels.MessageBusAction, errors.EdgeX)
+	MessageBusActionTotalCount() (uint32, errors.EdgeX)
+	DeleteMessageBusActionByName(name string) errors.EdgeX
+
+	AddJobRun(run schedulerModels.JobRun, historyLimit int) (schedulerModels.JobRun, errors.EdgeX)
+	JobRuns(offset int, limit int, query schedulerModels.JobRunQuery) ([]schedulerModels.JobRun, errors.EdgeX)
+	JobRunCount(query schedulerModels.JobRunQuery) (uint32, errors.EdgeX)
+	JobRunsByIntervalName(offset int, limit int, intervalName string) ([]schedulerModels.JobRun, errors.EdgeX)
+	JobRunCountByIntervalName(intervalName string) (uint32, errors.EdgeX)
+	DeleteJobRunsByAge(age int64) errors.EdgeX
+
+	AddCalendar(calendar schedulerModels.Calendar) (schedulerModels.Calendar, errors.EdgeX)
+	CalendarByName(name string) (schedulerModels.Calendar, errors.EdgeX)
+	AllCalendars(offset int, limit int) ([]schedulerModels.Calendar, errors.EdgeX)
+	CalendarTotalCount() (uint32, errors.EdgeX)
+	UpdateCalendar(calendar schedulerModels.Calendar) (schedulerModels.Calendar, errors.EdgeX)
+	DeleteCalendarByName(name string) errors.EdgeX
 }
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -15,6 +17,52 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddCalendar provides a mock function with given fields: calendar
+func (_m *DBClient) AddCalendar(calendar schedulerModels.Calendar) (schedulerModels.Calendar, errors.EdgeX) {
+	ret := _m.Called(calendar)
+
+	var r0 schedulerModels.Calendar
+	if rf, ok := ret.Get(0).(func(schedulerModels.Calendar) schedulerModels.Calendar); ok {
+		r0 = rf(calendar)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.Calendar)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(schedulerModels.Calendar) errors.EdgeX); ok {
+		r1 = rf(calendar)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDeviceCommandAction provides a mock function with given fields: action
+func (_m *DBClient) AddDeviceCommandAction(action schedulerModels.DeviceCommandAction) (schedulerModels.DeviceCommandAction, errors.EdgeX) {
+	ret := _m.Called(action)
+
+	var r0 schedulerModels.DeviceCommandAction
+	if rf, ok := ret.Get(0).(func(schedulerModels.DeviceCommandAction) schedulerModels.DeviceCommandAction); ok {
+		r0 = rf(action)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.DeviceCommandAction)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(schedulerModels.DeviceCommandAction) errors.EdgeX); ok {
+		r1 = rf(action)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddInterval provides a mock function with given fields: interval
 func (_m *DBClient) AddInterval(interval models.Interval) (models.Interval, errors.EdgeX) {
 	ret := _m.Called(interval)
@@ -61,6 +109,102 @@ func (_m *DBClient) AddIntervalAction(e models.IntervalAction) (models.IntervalA
 	return r0, r1
 }
 
+// AddJobRun provides a mock function with given fields: run, historyLimit
+func (_m *DBClient) AddJobRun(run schedulerModels.JobRun, historyLimit int) (schedulerModels.JobRun, errors.EdgeX) {
+	ret := _m.Called(run, historyLimit)
+
+	var r0 schedulerModels.JobRun
+	if rf, ok := ret.Get(0).(func(schedulerModels.JobRun, int) schedulerModels.JobRun); ok {
+		r0 = rf(run, historyLimit)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.JobRun)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(schedulerModels.JobRun, int) errors.EdgeX); ok {
+		r1 = rf(run, historyLimit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddMessageBusAction provides a mock function with given fields: action
+func (_m *DBClient) AddMessageBusAction(action schedulerModels.MessageBusAction) (schedulerModels.MessageBusAction, errors.EdgeX) {
+	ret := _m.Called(action)
+
+	var r0 schedulerModels.MessageBusAction
+	if rf, ok := ret.Get(0).(func(schedulerModels.MessageBusAction) schedulerModels.MessageBusAction); ok {
+		r0 = rf(action)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.MessageBusAction)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(schedulerModels.MessageBusAction) errors.EdgeX); ok {
+		r1 = rf(action)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllCalendars provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllCalendars(offset int, limit int) ([]schedulerModels.Calendar, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []schedulerModels.Calendar
+	if rf, ok := ret.Get(0).(func(int, int) []schedulerModels.Calendar); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.Calendar)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllDeviceCommandActions provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceCommandActions(offset int, limit int) ([]schedulerModels.DeviceCommandAction, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []schedulerModels.DeviceCommandAction
+	if rf, ok := ret.Get(0).(func(int, int) []schedulerModels.DeviceCommandAction); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.DeviceCommandAction)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllIntervalActions provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllIntervalActions(offset int, limit int) ([]models.IntervalAction, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -111,11 +255,114 @@ func (_m *DBClient) AllIntervals(offset int, limit int) ([]models.Interval, erro
 	return r0, r1
 }
 
+// AllMessageBusActions provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllMessageBusActions(offset int, limit int) ([]schedulerModels.MessageBusAction, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []schedulerModels.MessageBusAction
+	if rf, ok := ret.Get(0).(func(int, int) []schedulerModels.MessageBusAction); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.MessageBusAction)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CalendarByName provides a mock function with given fields: name
+func (_m *DBClient) CalendarByName(name string) (schedulerModels.Calendar, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 schedulerModels.Calendar
+	if rf, ok := ret.Get(0).(func(string) schedulerModels.Calendar); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.Calendar)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CalendarTotalCount provides a mock function with given fields:
+func (_m *DBClient) CalendarTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeleteCalendarByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteCalendarByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDeviceCommandActionByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeviceCommandActionByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteIntervalActionByName provides a mock function with given fields: name
 func (_m *DBClient) DeleteIntervalActionByName(name string) errors.EdgeX {
 	ret := _m.Called(name)
@@ -148,6 +395,84 @@ func (_m *DBClient) DeleteIntervalByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteJobRunsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteJobRunsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteMessageBusActionByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteMessageBusActionByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeviceCommandActionByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceCommandActionByName(name string) (schedulerModels.DeviceCommandAction, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 schedulerModels.DeviceCommandAction
+	if rf, ok := ret.Get(0).(func(string) schedulerModels.DeviceCommandAction); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.DeviceCommandAction)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceCommandActionTotalCount provides a mock function with given fields:
+func (_m *DBClient) DeviceCommandActionTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // IntervalActionById provides a mock function with given fields: id
 func (_m *DBClient) IntervalActionById(id string) (models.IntervalAction, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -311,6 +636,171 @@ func (_m *DBClient) IntervalTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// JobRunCount provides a mock function with given fields: query
+func (_m *DBClient) JobRunCount(query schedulerModels.JobRunQuery) (uint32, errors.EdgeX) {
+	ret := _m.Called(query)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(schedulerModels.JobRunQuery) uint32); ok {
+		r0 = rf(query)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(schedulerModels.JobRunQuery) errors.EdgeX); ok {
+		r1 = rf(query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// JobRunCountByIntervalName provides a mock function with given fields: intervalName
+func (_m *DBClient) JobRunCountByIntervalName(intervalName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(intervalName)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(intervalName)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(intervalName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// JobRuns provides a mock function with given fields: offset, limit, query
+func (_m *DBClient) JobRuns(offset int, limit int, query schedulerModels.JobRunQuery) ([]schedulerModels.JobRun, errors.EdgeX) {
+	ret := _m.Called(offset, limit, query)
+
+	var r0 []schedulerModels.JobRun
+	if rf, ok := ret.Get(0).(func(int, int, schedulerModels.JobRunQuery) []schedulerModels.JobRun); ok {
+		r0 = rf(offset, limit, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.JobRun)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, schedulerModels.JobRunQuery) errors.EdgeX); ok {
+		r1 = rf(offset, limit, query)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// JobRunsByIntervalName provides a mock function with given fields: offset, limit, intervalName
+func (_m *DBClient) JobRunsByIntervalName(offset int, limit int, intervalName string) ([]schedulerModels.JobRun, errors.EdgeX) {
+	ret := _m.Called(offset, limit, intervalName)
+
+	var r0 []schedulerModels.JobRun
+	if rf, ok := ret.Get(0).(func(int, int, string) []schedulerModels.JobRun); ok {
+		r0 = rf(offset, limit, intervalName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.JobRun)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, intervalName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// MessageBusActionByName provides a mock function with given fields: name
+func (_m *DBClient) MessageBusActionByName(name string) (schedulerModels.MessageBusAction, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 schedulerModels.MessageBusAction
+	if rf, ok := ret.Get(0).(func(string) schedulerModels.MessageBusAction); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.MessageBusAction)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// MessageBusActionTotalCount provides a mock function with given fields:
+func (_m *DBClient) MessageBusActionTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateCalendar provides a mock function with given fields: calendar
+func (_m *DBClient) UpdateCalendar(calendar schedulerModels.Calendar) (schedulerModels.Calendar, errors.EdgeX) {
+	ret := _m.Called(calendar)
+
+	var r0 schedulerModels.Calendar
+	if rf, ok := ret.Get(0).(func(schedulerModels.Calendar) schedulerModels.Calendar); ok {
+		r0 = rf(calendar)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.Calendar)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(schedulerModels.Calendar) errors.EdgeX); ok {
+		r1 = rf(calendar)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // UpdateInterval provides a mock function with given fields: interval
 func (_m *DBClient) UpdateInterval(interval models.Interval) errors.EdgeX {
 	ret := _m.Called(interval)
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 // SchedulerManager is an autogenerated mock type for the SchedulerManager type
@@ -15,6 +17,38 @@ type SchedulerManager struct {
 	mock.Mock
 }
 
+// AddCalendar provides a mock function with given fields: calendar
+func (_m *SchedulerManager) AddCalendar(calendar schedulerModels.Calendar) errors.EdgeX {
+	ret := _m.Called(calendar)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(schedulerModels.Calendar) errors.EdgeX); ok {
+		r0 = rf(calendar)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// AddDeviceCommandAction provides a mock function with given fields: action
+func (_m *SchedulerManager) AddDeviceCommandAction(action schedulerModels.DeviceCommandAction) errors.EdgeX {
+	ret := _m.Called(action)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(schedulerModels.DeviceCommandAction) errors.EdgeX); ok {
+		r0 = rf(action)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // AddInterval provides a mock function with given fields: interval
 func (_m *SchedulerManager) AddInterval(interval models.Interval) errors.EdgeX {
 	ret := _m.Called(interval)
@@ -47,6 +81,54 @@ func (_m *SchedulerManager) AddIntervalAction(intervalAction models.IntervalActi
 	return r0
 }
 
+// AddMessageBusAction provides a mock function with given fields: action
+func (_m *SchedulerManager) AddMessageBusAction(action schedulerModels.MessageBusAction) errors.EdgeX {
+	ret := _m.Called(action)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(schedulerModels.MessageBusAction) errors.EdgeX); ok {
+		r0 = rf(action)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteCalendarByName provides a mock function with given fields: name
+func (_m *SchedulerManager) DeleteCalendarByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDeviceCommandActionByName provides a mock function with given fields: name
+func (_m *SchedulerManager) DeleteDeviceCommandActionByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteIntervalActionByName provides a mock function with given fields: name
 func (_m *SchedulerManager) DeleteIntervalActionByName(name string) errors.EdgeX {
 	ret := _m.Called(name)
@@ -79,6 +161,22 @@ func (_m *SchedulerManager) DeleteIntervalByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteMessageBusActionByName provides a mock function with given fields: name
+func (_m *SchedulerManager) DeleteMessageBusActionByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // StartTicker provides a mock function with given fields:
 func (_m *SchedulerManager) StartTicker() {
 	_m.Called()
@@ -89,6 +187,47 @@ func (_m *SchedulerManager) StopTicker() {
 	_m.Called()
 }
 
+// TriggerJob provides a mock function with given fields: name
+func (_m *SchedulerManager) TriggerJob(name string) ([]schedulerModels.JobRun, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 []schedulerModels.JobRun
+	if rf, ok := ret.Get(0).(func(string) []schedulerModels.JobRun); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.JobRun)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateCalendar provides a mock function with given fields: calendar
+func (_m *SchedulerManager) UpdateCalendar(calendar schedulerModels.Calendar) errors.EdgeX {
+	ret := _m.Called(calendar)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(schedulerModels.Calendar) errors.EdgeX); ok {
+		r0 = rf(calendar)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateInterval provides a mock function with given fields: interval
 func (_m *SchedulerManager) UpdateInterval(interval models.Interval) errors.EdgeX {
 	ret := _m.Called(interval)
@@ -19,6 +19,8 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application/scheduler"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
@@ -46,13 +48,24 @@ func NewBootstrap(router *mux.Router, serviceName string) *Bootstrap {
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the scheduler service.
 func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !audit.BootstrapAuditor(container.ConfigurationFrom(dic.Get).Audit, b.serviceName, dic) {
+		return false
+	}
+	if !apikey.BootstrapValidator(ctx, wg, container.ConfigurationFrom(dic.Get).APIKey, dic) {
+		return false
+	}
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	secretProvider := bootstrapContainer.SecretProviderExtFrom(dic.Get)
 	configuration := container.ConfigurationFrom(dic.Get)
 
-	schedulerManager := scheduler.NewManager(lc, configuration, secretProvider)
+	// the command client is nil when core-command isn't configured in the Clients
+	commandClient := bootstrapContainer.CommandClientFrom(dic.Get)
+	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+
+	schedulerManager := scheduler.NewManager(lc, configuration, secretProvider, commandClient, messagingClient, dbClient)
 	dic.Update(di.ServiceConstructorMap{
 		container.SchedulerManagerName: func(get di.Get) interface{} {
 			return schedulerManager
@@ -65,13 +78,34 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		return false
 	}
 
+	err = application.LoadCalendarToSchedulerManager(dic)
+	if err != nil {
+		lc.Errorf("Failed to load calendars to scheduler, %v", err)
+		return false
+	}
+
 	err = application.LoadIntervalActionToSchedulerManager(dic)
 	if err != nil {
 		lc.Errorf("Failed to load intervalAction to scheduler, %v", err)
 		return false
 	}
 
+	err = application.LoadDeviceCommandActionToSchedulerManager(dic)
+	if err != nil {
+		lc.Errorf("Failed to load device command actions to scheduler, %v", err)
+		return false
+	}
+
+	err = application.LoadMessageBusActionToSchedulerManager(dic)
+	if err != nil {
+		lc.Errorf("Failed to load message bus actions to scheduler, %v", err)
+		return false
+	}
+
 	schedulerManager.StartTicker()
+	if configuration.JobRunRetention.Enabled {
+		application.StartJobRunCleanup(ctx, wg, dic)
+	}
 
 	wg.Add(1)
 	go func() {
@@ -56,7 +56,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		},
 	})
 
-	httpServer := handlers.NewHttpServer(router, true)
+	httpServer := pkgHandlers.NewHttpServer(router, true, &configuration.MutualTLS)
+	database := pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName)
 
 	bootstrap.Run(
 		ctx,
@@ -70,9 +71,11 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router) {
 		true,
 		bootstrapConfig.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
-			pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName).BootstrapHandler, // add db client bootstrap handler
+			database.BootstrapHandler, // add db client bootstrap handler
 			handlers.MessagingBootstrapHandler,
+			handlers.NewClientsBootstrap(f.InDevMode()).BootstrapHandler,                   // Must be after Messaging
 			handlers.NewServiceMetrics(common.SupportSchedulerServiceKey).BootstrapHandler, // Must be after Messaging
+			database.MetricsBootstrapHandler,                                               // Must be after Service Metrics
 			NewBootstrap(router, common.SupportSchedulerServiceKey).BootstrapHandler,
 			httpServer.BootstrapHandler,
 			handlers.NewStartMessage(common.SupportSchedulerServiceKey, edgex.Version).BootstrapHandler,
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// CalendarDateLayout is the layout of the dates of the calendars
+const CalendarDateLayout = "2006-01-02"
+
+// Calendar defines the blackout days of the jobs attached to it, such as the holidays or the maintenance freezes of a
+// site, on which the jobs don't run when their interval triggers. A day is blacked out when it is one of the Dates or
+// when it matches one of the Rules, in the Timezone, or in the local time zone of the service when it is empty.
+type Calendar struct {
+	models.DBTimestamp
+	Id          string
+	Name        string
+	Description string
+	Timezone    string
+	Dates       []string
+	Rules       []CalendarRule
+}
+
+// CalendarRule matches the days matching all its non-empty fields: the days from Start to End included, the Weekdays,
+// such as "SAT", the days of month MonthDays, and the Months from 1 to 12. For instance, a rule with Months 12 and
+// MonthDays 25 matches Christmas Day every year.
+type CalendarRule struct {
+	Start     string
+	End       string
+	Weekdays  []string
+	MonthDays []int
+	Months    []int
+}
@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// The methods of the device commands issued by the device command actions
+const (
+	DeviceCommandGet = "GET"
+	DeviceCommandSet = "SET"
+)
+
+// DeviceCommandAction issues a read (GET) or write (SET) command of a device through core-command each time its
+// interval triggers. The GET commands are issued with the QueryParameters, such as ds-pushevent, and the SET commands
+// write the Settings. The action runs only after the jobs of the same interval named in DependsOn succeeded. The runs
+// missed while the service was down are caught up on startup according to the CatchUpPolicy, or the default policy
+// of the service when it is empty. Each run is delayed randomly up to the Jitter, and at most MaxConcurrency runs are
+// in progress at the same time, the other runs being skipped or queued according to the ConcurrencyPolicy, the empty
+// ones of these settings defaulting to the ones of the service. The action doesn't run on the days blacked out by its
+// Calendars.
+type DeviceCommandAction struct {
+	models.DBTimestamp
+	Id                string
+	Name              string
+	IntervalName      string
+	DependsOn         []string
+	Calendars         []string
+	DeviceName        string
+	CommandName       string
+	Method            string
+	QueryParameters   map[string]string
+	Settings          map[string]any
+	CatchUpPolicy     string
+	CatchUpMaxRuns    int
+	Jitter            string
+	MaxConcurrency    int
+	ConcurrencyPolicy string
+	AdminState        models.AdminState
+}
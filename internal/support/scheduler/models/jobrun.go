@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// The types of the jobs run by the scheduler
+const (
+	IntervalActionJob      = "IntervalAction"
+	DeviceCommandActionJob = "DeviceCommandAction"
+	MessageBusActionJob    = "MessageBusAction"
+)
+
+// The statuses of the job runs
+const (
+	JobRunSucceeded = "SUCCEEDED"
+	JobRunFailed    = "FAILED"
+	// JobRunSkipped is the status of the jobs not run because a dependency didn't succeed, their previous runs are
+	// still in progress or a calendar blacks them out
+	JobRunSkipped = "SKIPPED"
+)
+
+// The catch-up policies of the runs missed while the service was down
+const (
+	// CatchUpSkip skips the missed runs
+	CatchUpSkip = "SKIP"
+	// CatchUpRunOnce runs the job once on startup for all the missed runs
+	CatchUpRunOnce = "RUN_ONCE"
+	// CatchUpBackfill runs the job on startup for each missed run, up to a maximum count of runs
+	CatchUpBackfill = "BACKFILL"
+)
+
+// The concurrency policies of the runs of a job triggered while its maximum count of runs are in progress
+const (
+	// ConcurrencySkip skips the run
+	ConcurrencySkip = "SKIP"
+	// ConcurrencyQueue delays the run until a run in progress completes
+	ConcurrencyQueue = "QUEUE"
+)
+
+// JobRun records a run of a job, which is an action of any type, each time its interval triggers. The Message explains
+// why the job failed or was skipped. The runs catching up the MissedRuns on startup record the CatchUp policy applied,
+// and the runs triggered manually through the API out of band of their interval are marked as Manual.
+type JobRun struct {
+	Id           string
+	JobName      string
+	JobType      string
+	IntervalName string
+	Started      int64
+	Status       string
+	Message      string
+	CatchUp      string
+	MissedRuns   int
+	Manual       bool
+}
+
+// JobRunQuery filters the job runs by the name of their job and by their status when they aren't empty, and by their
+// start time in milliseconds from Start to End included, an End of 0 meaning no upper bound
+type JobRunQuery struct {
+	JobName string
+	Status  string
+	Start   int64
+	End     int64
+}
@@ -16,6 +16,9 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/gorilla/mux"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/apikey"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	schedulerController "github.com/edgexfoundry/edgex-go/internal/support/scheduler/controller/http"
 )
@@ -23,7 +26,9 @@ import (
 func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	lc := container.LoggingClientFrom(dic.Get)
 	secretProvider := container.SecretProviderExtFrom(dic.Get)
-	authenticationHook := handlers.AutoConfigAuthenticationFunc(secretProvider, lc)
+	// the services without role based access control apply the default permission of the method to the API keys
+	authenticationHook := apikey.AuthenticationHandlerFunc(apikey.ValidatorFrom(dic.Get), nil,
+		handlers.AutoConfigAuthenticationFunc(secretProvider, lc), lc)
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
@@ -44,6 +49,48 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceName string) {
 	r.HandleFunc(common.ApiIntervalActionByNameRoute, authenticationHook(action.DeleteIntervalActionByName)).Methods(http.MethodDelete)
 	r.HandleFunc(common.ApiIntervalActionRoute, authenticationHook(action.PatchIntervalAction)).Methods(http.MethodPatch)
 
+	// DeviceCommandAction
+	commandAction := schedulerController.NewDeviceCommandActionController(dic)
+	r.HandleFunc(pkgCommon.ApiDeviceCommandActionRoute, authenticationHook(commandAction.AddDeviceCommandAction)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllDeviceCommandActionRoute, authenticationHook(commandAction.AllDeviceCommandActions)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceCommandActionByNameRoute, authenticationHook(commandAction.DeviceCommandActionByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiDeviceCommandActionByNameRoute, authenticationHook(commandAction.DeleteDeviceCommandActionByName)).Methods(http.MethodDelete)
+
+	// MessageBusAction
+	busAction := schedulerController.NewMessageBusActionController(dic)
+	r.HandleFunc(pkgCommon.ApiMessageBusActionRoute, authenticationHook(busAction.AddMessageBusAction)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiAllMessageBusActionRoute, authenticationHook(busAction.AllMessageBusActions)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiMessageBusActionByNameRoute, authenticationHook(busAction.MessageBusActionByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiMessageBusActionByNameRoute, authenticationHook(busAction.DeleteMessageBusActionByName)).Methods(http.MethodDelete)
+
+	// Calendar
+	calendar := schedulerController.NewCalendarController(dic)
+	r.HandleFunc(pkgCommon.ApiCalendarRoute, authenticationHook(calendar.AddCalendar)).Methods(http.MethodPost)
+	r.HandleFunc(pkgCommon.ApiCalendarRoute, authenticationHook(calendar.PatchCalendar)).Methods(http.MethodPatch)
+	r.HandleFunc(pkgCommon.ApiAllCalendarRoute, authenticationHook(calendar.AllCalendars)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiCalendarByNameRoute, authenticationHook(calendar.CalendarByName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiCalendarByNameRoute, authenticationHook(calendar.DeleteCalendarByName)).Methods(http.MethodDelete)
+
+	// JobRun
+	jobRun := schedulerController.NewJobRunController(dic)
+	r.HandleFunc(pkgCommon.ApiAllJobRunRoute, authenticationHook(jobRun.AllJobRuns)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiJobRunByJobNameRoute, authenticationHook(jobRun.JobRunsByJobName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiJobRunByIntervalNameRoute, authenticationHook(jobRun.JobRunsByIntervalName)).Methods(http.MethodGet)
+	r.HandleFunc(pkgCommon.ApiJobTriggerByNameRoute, authenticationHook(jobRun.TriggerJob)).Methods(http.MethodPost)
+
+	// Audit
+	auditor := audit.AuditorFrom(dic.Get)
+	if auditor != nil {
+		maxResultCount := container.ConfigurationFrom(dic.Get).GetBootstrap().Service.MaxResultCount
+		r.HandleFunc(pkgCommon.ApiAuditRoute, authenticationHook(audit.QueryHandler(auditor, lc, maxResultCount))).Methods(http.MethodGet)
+	}
+
+	// API keys
+	if validator := apikey.ValidatorFrom(dic.Get); validator != nil {
+		r.HandleFunc(pkgCommon.ApiApiKeyRoute, authenticationHook(apikey.KeysHandler(validator, lc))).Methods(http.MethodGet)
+	}
+
 	r.Use(correlation.ManageHeader)
+	r.Use(audit.Middleware(auditor))
 	r.Use(correlation.LoggingMiddleware(container.LoggingClientFrom(dic.Get)))
 }